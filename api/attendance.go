@@ -0,0 +1,465 @@
+package api
+
+import (
+	"database/sql"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	db "github.com/ot07/coworker-backend/db/sqlc"
+)
+
+// monthLayout is the layout of the months attendance is summarized for.
+const monthLayout = "2006-01"
+
+var (
+	errAlreadyCheckedIn = errors.New("the member is already checked in")
+	errNotCheckedIn     = errors.New("the member is not checked in")
+	errAttendanceFuture = errors.New("attendance cannot be recorded in the future")
+)
+
+// parseMonth returns the range of the month given as YYYY-MM in UTC, the current month when it is empty.
+func parseMonth(month string) (from, to time.Time, err error) {
+	if len(month) == 0 {
+		from = today().AddDate(0, 0, 1-today().Day())
+	} else if from, err = time.Parse(monthLayout, month); err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	return from, from.AddDate(0, 1, 0), nil
+}
+
+type attendanceResponse struct {
+	ID           uuid.UUID     `json:"id"`
+	MemberID     uuid.UUID     `json:"member_id"`
+	LocationID   uuid.NullUUID `json:"location_id" swaggertype:"string"`
+	CheckedInAt  time.Time     `json:"checked_in_at"`
+	CheckedOutAt db.NullTime   `json:"checked_out_at" swaggertype:"string" format:"date-time"`
+	// AutoCheckedOut tells that the member did not check out and was checked out at the cutoff instead.
+	AutoCheckedOut bool `json:"auto_checked_out"`
+}
+
+func newAttendanceResponse(attendance db.Attendance) attendanceResponse {
+	return attendanceResponse{
+		ID:             attendance.ID,
+		MemberID:       attendance.MemberID,
+		LocationID:     attendance.LocationID,
+		CheckedInAt:    attendance.CheckedInAt,
+		CheckedOutAt:   db.NullTime{NullTime: attendance.CheckedOutAt},
+		AutoCheckedOut: attendance.AutoCheckedOut,
+	}
+}
+
+type attendanceRequestParams struct {
+	ID uuid.UUID `params:"id"`
+}
+
+type checkInMemberRequest struct {
+	LocationID uuid.UUID `json:"location_id" validate:"required"`
+	// CheckedInAt records a check-in made earlier, now by default.
+	CheckedInAt time.Time `json:"checked_in_at"`
+}
+
+// @Summary      Check in member
+// @Description  Records that the member arrived at a location. A member can only be checked in once at a time.
+// @Tags         attendance
+// @Param        id   path string               true "Member ID"
+// @Param        body body checkInMemberRequest true "Check-in"
+// @Success      200 {object} attendanceResponse
+// @Failure      400 {object} errorResponse
+// @Failure      403 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /members/{id}/check-in [post]
+func (server *Server) checkInMember(c *fiber.Ctx) error {
+	params := new(attendanceRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	body := new(checkInMemberRequest)
+	if err := c.BodyParser(body); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	validate := newValidator()
+	if err := validate.Struct(body); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	checkedInAt := time.Now()
+	if !body.CheckedInAt.IsZero() {
+		if body.CheckedInAt.After(checkedInAt) {
+			return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(errAttendanceFuture))
+		}
+		checkedInAt = body.CheckedInAt
+	}
+
+	if _, err := server.store.GetMember(c.Context(), params.ID); err != nil {
+		if err == sql.ErrNoRows {
+			return c.Status(fiber.StatusNotFound).JSON(newErrorResponse(err))
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	attendance, err := server.store.CheckInMember(c.Context(), db.CheckInMemberParams{
+		MemberID:    params.ID,
+		LocationID:  uuid.NullUUID{UUID: body.LocationID, Valid: true},
+		CheckedInAt: checkedInAt.UTC(),
+	})
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok {
+			switch pqErr.Code.Name() {
+			case "unique_violation":
+				return c.Status(fiber.StatusForbidden).JSON(newErrorResponse(errAlreadyCheckedIn))
+			case "foreign_key_violation":
+				return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+			}
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	rsp := newAttendanceResponse(attendance)
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+type checkOutMemberRequest struct {
+	// CheckedOutAt records a check-out made earlier, now by default.
+	CheckedOutAt time.Time `json:"checked_out_at"`
+}
+
+// @Summary      Check out member
+// @Description  Records that the member left, closing their current check-in. The body can be left out.
+// @Tags         attendance
+// @Param        id   path string                true  "Member ID"
+// @Param        body body checkOutMemberRequest false "Check-out"
+// @Success      200 {object} attendanceResponse
+// @Failure      400 {object} errorResponse
+// @Failure      403 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /members/{id}/check-out [post]
+func (server *Server) checkOutMember(c *fiber.Ctx) error {
+	params := new(attendanceRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	body := new(checkOutMemberRequest)
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(body); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+		}
+	}
+
+	checkedOutAt := time.Now()
+	if !body.CheckedOutAt.IsZero() {
+		if body.CheckedOutAt.After(checkedOutAt) {
+			return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(errAttendanceFuture))
+		}
+		checkedOutAt = body.CheckedOutAt
+	}
+
+	if _, err := server.store.GetMember(c.Context(), params.ID); err != nil {
+		if err == sql.ErrNoRows {
+			return c.Status(fiber.StatusNotFound).JSON(newErrorResponse(err))
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	attendance, err := server.store.CheckOutMember(c.Context(), db.CheckOutMemberParams{
+		CheckedOutAt: sql.NullTime{Time: checkedOutAt.UTC(), Valid: true},
+		MemberID:     params.ID,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return c.Status(fiber.StatusForbidden).JSON(newErrorResponse(errNotCheckedIn))
+		}
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code.Name() == "check_violation" {
+			err = errors.New("the member cannot check out before they checked in")
+			return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	rsp := newAttendanceResponse(attendance)
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+type listMemberAttendancesRequestQuery struct {
+	// Month is the month listed, as YYYY-MM in UTC. The current month by default.
+	Month string `query:"month" json:"month" validate:"omitempty,datetime=2006-01" example:"2023-05"`
+}
+
+// @Summary      List member attendances
+// @Description  Lists the check-ins of the member within a month, in order.
+// @Tags         attendance
+// @Param        id    path  string                            true "Member ID"
+// @Param        query query listMemberAttendancesRequestQuery true "query"
+// @Success      200 {array} attendanceResponse
+// @Failure      400 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /members/{id}/attendances [get]
+func (server *Server) listMemberAttendances(c *fiber.Ctx) error {
+	params := new(attendanceRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	query := new(listMemberAttendancesRequestQuery)
+	if err := c.QueryParser(query); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	validate := newValidator()
+	if err := validate.Struct(query); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	from, to, err := parseMonth(query.Month)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	if _, err := server.store.GetMember(c.Context(), params.ID); err != nil {
+		if err == sql.ErrNoRows {
+			return c.Status(fiber.StatusNotFound).JSON(newErrorResponse(err))
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	attendances, err := server.store.ListMemberAttendances(c.Context(), db.ListMemberAttendancesParams{
+		MemberID: params.ID,
+		FromTime: from,
+		ToTime:   to,
+	})
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	rsp := make([]attendanceResponse, 0, len(attendances))
+	for _, attendance := range attendances {
+		rsp = append(rsp, newAttendanceResponse(attendance))
+	}
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+type presenceResponse struct {
+	MemberID     uuid.UUID     `json:"member_id"`
+	FirstName    string        `json:"first_name"`
+	LastName     string        `json:"last_name"`
+	LocationID   uuid.NullUUID `json:"location_id" swaggertype:"string"`
+	LocationName db.NullString `json:"location_name" swaggertype:"string"`
+	// Present tells whether the member is checked in right now.
+	Present bool `json:"present"`
+	// CheckedInAt is the first check-in of the day, or the one the member is still checked in by since an earlier day.
+	CheckedInAt time.Time `json:"checked_in_at"`
+	// CheckedOutAt is the last check-out of the day, unless the member is present.
+	CheckedOutAt db.NullTime `json:"checked_out_at" swaggertype:"string" format:"date-time"`
+}
+
+// newPresenceResponses sums up the attendances of every member into the entry of the member on the presence board.
+// The attendances are ordered by member and check-in. The members present come first, then the others, by name.
+func newPresenceResponses(attendances []db.ListPresenceAttendancesRow) []presenceResponse {
+	rsp := []presenceResponse{}
+	for i, attendance := range attendances {
+		if i == 0 || attendances[i-1].MemberID != attendance.MemberID {
+			rsp = append(rsp, presenceResponse{
+				MemberID:    attendance.MemberID,
+				FirstName:   attendance.FirstName,
+				LastName:    attendance.LastName,
+				CheckedInAt: attendance.CheckedInAt,
+			})
+		}
+		presence := &rsp[len(rsp)-1]
+		presence.LocationID = attendance.LocationID
+		presence.LocationName = db.NullString{NullString: attendance.LocationName}
+		presence.Present = !attendance.CheckedOutAt.Valid
+		presence.CheckedOutAt = db.NullTime{NullTime: attendance.CheckedOutAt}
+	}
+
+	sort.SliceStable(rsp, func(i, j int) bool {
+		if rsp[i].Present != rsp[j].Present {
+			return rsp[i].Present
+		}
+		if rsp[i].LastName != rsp[j].LastName {
+			return rsp[i].LastName < rsp[j].LastName
+		}
+		return rsp[i].FirstName < rsp[j].FirstName
+	})
+	return rsp
+}
+
+type getPresenceRequestQuery struct {
+	LocationID string `query:"location_id" json:"location_id" validate:"omitempty,uuid"`
+}
+
+// @Summary      Get presence board
+// @Description  Lists who is in today: the members checked in right now, and then those who came in today and already left.
+// @Description  Days start at midnight UTC.
+// @Tags         attendance
+// @Param        query query getPresenceRequestQuery true "query"
+// @Success      200 {array} presenceResponse
+// @Failure      400 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /presence [get]
+func (server *Server) getPresence(c *fiber.Ctx) error {
+	query := new(getPresenceRequestQuery)
+	if err := c.QueryParser(query); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	validate := newValidator()
+	if err := validate.Struct(query); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	var locationID uuid.NullUUID
+	if len(query.LocationID) > 0 {
+		id, err := uuid.Parse(query.LocationID)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+		}
+		locationID = uuid.NullUUID{UUID: id, Valid: true}
+	}
+
+	attendances, err := server.store.ListPresenceAttendances(c.Context(), db.ListPresenceAttendancesParams{
+		Since:      today(),
+		LocationID: locationID,
+	})
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(newPresenceResponses(attendances))
+}
+
+type attendanceSummaryResponse struct {
+	MemberID  uuid.UUID `json:"member_id"`
+	FirstName string    `json:"first_name"`
+	LastName  string    `json:"last_name"`
+	// DaysPresent is the number of days the member checked in on.
+	DaysPresent int32 `json:"days_present"`
+	// HoursPresent adds up the time between the check-ins and check-outs of the member, rounded to the minute.
+	HoursPresent float64 `json:"hours_present"`
+	// AutoCheckouts is the number of times the member did not check out and was checked out at the cutoff.
+	AutoCheckouts int32 `json:"auto_checkouts"`
+}
+
+func newAttendanceSummaryResponse(summary db.ListAttendanceSummariesRow) attendanceSummaryResponse {
+	minutes := math.Round(float64(summary.SecondsPresent) / 60)
+	return attendanceSummaryResponse{
+		MemberID:      summary.MemberID,
+		FirstName:     summary.FirstName,
+		LastName:      summary.LastName,
+		DaysPresent:   summary.DaysPresent,
+		HoursPresent:  math.Round(minutes/60*100) / 100,
+		AutoCheckouts: summary.AutoCheckouts,
+	}
+}
+
+type listAttendanceSummariesRequestQuery struct {
+	// Month is the month summarized, as YYYY-MM in UTC. The current month by default.
+	Month    string `query:"month" json:"month" validate:"omitempty,datetime=2006-01" example:"2023-05"`
+	MemberID string `query:"member_id" json:"member_id" validate:"omitempty,uuid"`
+	Format   string `query:"format" json:"format" validate:"omitempty,oneof=json csv" enums:"json,csv"`
+}
+
+// writeAttendanceSummariesCSV writes the summaries of a month as CSV, starting with a byte order mark for Excel.
+func writeAttendanceSummariesCSV(w io.Writer, month string, summaries []attendanceSummaryResponse) error {
+	if _, err := io.WriteString(w, "\ufeff"); err != nil {
+		return err
+	}
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{"member_id", "first_name", "last_name", "month", "days_present", "hours_present", "auto_checkouts"}); err != nil {
+		return err
+	}
+	for _, summary := range summaries {
+		err := writer.Write([]string{
+			summary.MemberID.String(),
+			summary.FirstName,
+			summary.LastName,
+			month,
+			strconv.Itoa(int(summary.DaysPresent)),
+			strconv.FormatFloat(summary.HoursPresent, 'f', 2, 64),
+			strconv.Itoa(int(summary.AutoCheckouts)),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// @Summary      List attendance summaries
+// @Description  Sums up the attendance of every member, or of a single one, within a month: the days they came in,
+// @Description  the hours they were checked in for and how many times they were checked out automatically.
+// @Description  With format=csv, the summaries are downloaded as CSV with a UTF-8 BOM for Excel.
+// @Tags         attendance
+// @Produce      json,text/csv
+// @Param        query query listAttendanceSummariesRequestQuery true "query"
+// @Success      200 {array} attendanceSummaryResponse
+// @Failure      400 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /attendance-summaries [get]
+func (server *Server) listAttendanceSummaries(c *fiber.Ctx) error {
+	query := new(listAttendanceSummariesRequestQuery)
+	if err := c.QueryParser(query); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	validate := newValidator()
+	if err := validate.Struct(query); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	from, to, err := parseMonth(query.Month)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	var memberID uuid.NullUUID
+	if len(query.MemberID) > 0 {
+		id, err := uuid.Parse(query.MemberID)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+		}
+		memberID = uuid.NullUUID{UUID: id, Valid: true}
+	}
+
+	summaries, err := server.store.ListAttendanceSummaries(c.Context(), db.ListAttendanceSummariesParams{
+		FromTime: from,
+		ToTime:   to,
+		MemberID: memberID,
+	})
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	rsp := make([]attendanceSummaryResponse, 0, len(summaries))
+	for _, summary := range summaries {
+		rsp = append(rsp, newAttendanceSummaryResponse(summary))
+	}
+
+	if query.Format != "csv" {
+		return c.Status(fiber.StatusOK).JSON(rsp)
+	}
+
+	month := from.Format(monthLayout)
+	c.Attachment(fmt.Sprintf("attendance-%s.csv", month))
+	c.Set(fiber.HeaderContentType, "text/csv; charset=utf-8")
+	if err := writeAttendanceSummariesCSV(c, month, rsp); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+	return nil
+}
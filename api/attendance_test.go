@@ -0,0 +1,876 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	mockdb "github.com/ot07/coworker-backend/db/mock"
+	db "github.com/ot07/coworker-backend/db/sqlc"
+	"github.com/ot07/coworker-backend/util"
+	"github.com/stretchr/testify/require"
+)
+
+func randomAttendance(memberID uuid.UUID, locationID uuid.UUID, checkedInAt time.Time) db.Attendance {
+	return db.Attendance{
+		ID:          util.RandomUUID(),
+		MemberID:    memberID,
+		LocationID:  uuid.NullUUID{UUID: locationID, Valid: true},
+		CheckedInAt: checkedInAt,
+	}
+}
+
+func TestCheckInMemberAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	member := randomMember()
+	location := randomLocation()
+	checkedInAt := time.Date(2023, 5, 1, 9, 0, 0, 0, time.UTC)
+	attendance := randomAttendance(member.ID, location.ID, checkedInAt)
+
+	testCases := []struct {
+		name          string
+		body          fiber.Map
+		setupAuth     func(request *http.Request)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name: "OK",
+			body: fiber.Map{
+				"location_id": location.ID,
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					GetMember(gomock.Any(), gomock.Eq(member.ID)).
+					Times(1).
+					Return(member, nil)
+
+				store.EXPECT().
+					CheckInMember(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ interface{}, arg db.CheckInMemberParams) (db.Attendance, error) {
+						require.Equal(t, member.ID, arg.MemberID)
+						require.Equal(t, attendance.LocationID, arg.LocationID)
+						require.WithinDuration(t, time.Now(), arg.CheckedInAt, time.Second)
+						return attendance, nil
+					})
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+				requireBodyMatchAttendance(t, response.Body, attendance)
+			},
+		},
+		{
+			name: "OKEarlier",
+			body: fiber.Map{
+				"location_id":   location.ID,
+				"checked_in_at": "2023-05-01T18:00:00+09:00",
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					GetMember(gomock.Any(), gomock.Eq(member.ID)).
+					Times(1).
+					Return(member, nil)
+
+				arg := db.CheckInMemberParams{
+					MemberID:    member.ID,
+					LocationID:  attendance.LocationID,
+					CheckedInAt: checkedInAt,
+				}
+
+				store.EXPECT().
+					CheckInMember(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(attendance, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+			},
+		},
+		{
+			name: "NoAuthorization",
+			body: fiber.Map{
+				"location_id": location.ID,
+			},
+			setupAuth: func(request *http.Request) {
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CheckInMember(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusUnauthorized, response.StatusCode)
+			},
+		},
+		{
+			name: "NoLocation",
+			body: fiber.Map{},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					CheckInMember(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "InTheFuture",
+			body: fiber.Map{
+				"location_id":   location.ID,
+				"checked_in_at": time.Now().Add(time.Hour),
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					CheckInMember(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "MemberNotFound",
+			body: fiber.Map{
+				"location_id": location.ID,
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					GetMember(gomock.Any(), gomock.Eq(member.ID)).
+					Times(1).
+					Return(db.Member{}, sql.ErrNoRows)
+
+				store.EXPECT().
+					CheckInMember(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusNotFound, response.StatusCode)
+			},
+		},
+		{
+			name: "AlreadyCheckedIn",
+			body: fiber.Map{
+				"location_id": location.ID,
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					GetMember(gomock.Any(), gomock.Eq(member.ID)).
+					Times(1).
+					Return(member, nil)
+
+				store.EXPECT().
+					CheckInMember(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Attendance{}, &pq.Error{Code: "23505"})
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusForbidden, response.StatusCode)
+			},
+		},
+		{
+			name: "LocationNotFound",
+			body: fiber.Map{
+				"location_id": location.ID,
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					GetMember(gomock.Any(), gomock.Eq(member.ID)).
+					Times(1).
+					Return(member, nil)
+
+				store.EXPECT().
+					CheckInMember(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Attendance{}, &pq.Error{Code: "23503"})
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/api/v1/members/%s/check-in", member.ID)
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			request.Header.Set("Content-Type", "application/json")
+
+			tc.setupAuth(request)
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func TestCheckOutMemberAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	member := randomMember()
+	attendance := randomAttendance(member.ID, util.RandomUUID(), time.Date(2023, 5, 1, 9, 0, 0, 0, time.UTC))
+	checkedOutAt := time.Date(2023, 5, 1, 18, 0, 0, 0, time.UTC)
+	attendance.CheckedOutAt = sql.NullTime{Time: checkedOutAt, Valid: true}
+
+	testCases := []struct {
+		name          string
+		body          []byte
+		setupAuth     func(request *http.Request)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name: "OKWithoutBody",
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					GetMember(gomock.Any(), gomock.Eq(member.ID)).
+					Times(1).
+					Return(member, nil)
+
+				store.EXPECT().
+					CheckOutMember(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ interface{}, arg db.CheckOutMemberParams) (db.Attendance, error) {
+						require.Equal(t, member.ID, arg.MemberID)
+						require.True(t, arg.CheckedOutAt.Valid)
+						require.WithinDuration(t, time.Now(), arg.CheckedOutAt.Time, time.Second)
+						return attendance, nil
+					})
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+				requireBodyMatchAttendance(t, response.Body, attendance)
+			},
+		},
+		{
+			name: "OKEarlier",
+			body: []byte(`{"checked_out_at": "2023-05-02T03:00:00+09:00"}`),
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					GetMember(gomock.Any(), gomock.Eq(member.ID)).
+					Times(1).
+					Return(member, nil)
+
+				arg := db.CheckOutMemberParams{
+					CheckedOutAt: sql.NullTime{Time: checkedOutAt, Valid: true},
+					MemberID:     member.ID,
+				}
+
+				store.EXPECT().
+					CheckOutMember(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(attendance, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+			},
+		},
+		{
+			name: "NotCheckedIn",
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					GetMember(gomock.Any(), gomock.Eq(member.ID)).
+					Times(1).
+					Return(member, nil)
+
+				store.EXPECT().
+					CheckOutMember(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Attendance{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusForbidden, response.StatusCode)
+			},
+		},
+		{
+			name: "BeforeCheckIn",
+			body: []byte(`{"checked_out_at": "2023-05-01T08:00:00Z"}`),
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					GetMember(gomock.Any(), gomock.Eq(member.ID)).
+					Times(1).
+					Return(member, nil)
+
+				store.EXPECT().
+					CheckOutMember(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Attendance{}, &pq.Error{Code: "23514"})
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "MemberNotFound",
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					GetMember(gomock.Any(), gomock.Eq(member.ID)).
+					Times(1).
+					Return(db.Member{}, sql.ErrNoRows)
+
+				store.EXPECT().
+					CheckOutMember(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusNotFound, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			url := fmt.Sprintf("/api/v1/members/%s/check-out", member.ID)
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(tc.body))
+			require.NoError(t, err)
+
+			if tc.body != nil {
+				request.Header.Set("Content-Type", "application/json")
+			}
+
+			tc.setupAuth(request)
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func TestListMemberAttendancesAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	member := randomMember()
+	attendances := []db.Attendance{
+		randomAttendance(member.ID, util.RandomUUID(), time.Date(2023, 5, 1, 9, 0, 0, 0, time.UTC)),
+		randomAttendance(member.ID, util.RandomUUID(), time.Date(2023, 5, 2, 9, 0, 0, 0, time.UTC)),
+	}
+
+	testCases := []struct {
+		name          string
+		query         string
+		setupAuth     func(request *http.Request)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name:  "OK",
+			query: "?month=2023-05",
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					GetMember(gomock.Any(), gomock.Eq(member.ID)).
+					Times(1).
+					Return(member, nil)
+
+				arg := db.ListMemberAttendancesParams{
+					MemberID: member.ID,
+					FromTime: time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC),
+					ToTime:   time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC),
+				}
+
+				store.EXPECT().
+					ListMemberAttendances(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(attendances, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+
+				data, err := io.ReadAll(response.Body)
+				require.NoError(t, err)
+
+				var got []attendanceResponse
+				err = json.Unmarshal(data, &got)
+				require.NoError(t, err)
+
+				require.Len(t, got, len(attendances))
+				for i, attendance := range attendances {
+					require.Equal(t, attendance.ID, got[i].ID)
+					require.False(t, got[i].CheckedOutAt.Valid)
+				}
+			},
+		},
+		{
+			name:  "InvalidMonth",
+			query: "?month=2023-13",
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ListMemberAttendances(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "MemberNotFound",
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					GetMember(gomock.Any(), gomock.Eq(member.ID)).
+					Times(1).
+					Return(db.Member{}, sql.ErrNoRows)
+
+				store.EXPECT().
+					ListMemberAttendances(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusNotFound, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			url := fmt.Sprintf("/api/v1/members/%s/attendances%s", member.ID, tc.query)
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			tc.setupAuth(request)
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func TestGetPresenceAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	location := randomLocation()
+	locationID := uuid.NullUUID{UUID: location.ID, Valid: true}
+	locationName := sql.NullString{String: location.Name, Valid: true}
+	day := today()
+	left := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	present := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+
+	// The member who left came in twice, and the one present has been in since yesterday.
+	attendances := []db.ListPresenceAttendancesRow{
+		{
+			ID: util.RandomUUID(), MemberID: left, LocationID: locationID, LocationName: locationName,
+			CheckedInAt:  day.Add(8 * time.Hour),
+			CheckedOutAt: sql.NullTime{Time: day.Add(12 * time.Hour), Valid: true},
+			FirstName:    "Alice", LastName: "Adams",
+		},
+		{
+			ID: util.RandomUUID(), MemberID: left, LocationID: locationID, LocationName: locationName,
+			CheckedInAt:  day.Add(13 * time.Hour),
+			CheckedOutAt: sql.NullTime{Time: day.Add(17 * time.Hour), Valid: true},
+			FirstName:    "Alice", LastName: "Adams",
+		},
+		{
+			ID: util.RandomUUID(), MemberID: present, LocationID: locationID, LocationName: locationName,
+			CheckedInAt: day.Add(-2 * time.Hour),
+			FirstName:   "Bob", LastName: "Brown",
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		query         string
+		setupAuth     func(request *http.Request)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name:  "OK",
+			query: fmt.Sprintf("?location_id=%s", location.ID),
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				arg := db.ListPresenceAttendancesParams{
+					Since:      day,
+					LocationID: locationID,
+				}
+
+				store.EXPECT().
+					ListPresenceAttendances(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(attendances, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+
+				data, err := io.ReadAll(response.Body)
+				require.NoError(t, err)
+
+				var got []presenceResponse
+				err = json.Unmarshal(data, &got)
+				require.NoError(t, err)
+
+				require.Len(t, got, 2)
+				require.Equal(t, present, got[0].MemberID)
+				require.True(t, got[0].Present)
+				require.False(t, got[0].CheckedOutAt.Valid)
+				require.Equal(t, location.Name, got[0].LocationName.String)
+
+				require.Equal(t, left, got[1].MemberID)
+				require.False(t, got[1].Present)
+				require.True(t, day.Add(8*time.Hour).Equal(got[1].CheckedInAt))
+				require.True(t, day.Add(17*time.Hour).Equal(got[1].CheckedOutAt.Time))
+			},
+		},
+		{
+			name:  "InvalidLocationID",
+			query: "?location_id=invalid",
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ListPresenceAttendances(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "InternalError",
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ListPresenceAttendances(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.ListPresenceAttendancesRow{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusInternalServerError, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			request, err := http.NewRequest(http.MethodGet, "/api/v1/presence"+tc.query, nil)
+			require.NoError(t, err)
+
+			tc.setupAuth(request)
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func TestListAttendanceSummariesAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	member := randomMember()
+	summaries := []db.ListAttendanceSummariesRow{
+		{
+			MemberID:       member.ID,
+			FirstName:      member.FirstName,
+			LastName:       member.LastName,
+			DaysPresent:    12,
+			SecondsPresent: 12*8*60*60 + 20*60 + 10,
+			AutoCheckouts:  1,
+		},
+	}
+	arg := db.ListAttendanceSummariesParams{
+		FromTime: time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC),
+		ToTime:   time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	testCases := []struct {
+		name          string
+		query         string
+		setupAuth     func(request *http.Request)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name:  "JSON",
+			query: "?month=2023-05",
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ListAttendanceSummaries(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(summaries, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+
+				data, err := io.ReadAll(response.Body)
+				require.NoError(t, err)
+
+				var got []attendanceSummaryResponse
+				err = json.Unmarshal(data, &got)
+				require.NoError(t, err)
+
+				require.Len(t, got, 1)
+				require.Equal(t, member.ID, got[0].MemberID)
+				require.Equal(t, int32(12), got[0].DaysPresent)
+				require.Equal(t, 96.33, got[0].HoursPresent)
+				require.Equal(t, int32(1), got[0].AutoCheckouts)
+			},
+		},
+		{
+			name:  "CSV",
+			query: fmt.Sprintf("?month=2023-05&format=csv&member_id=%s", member.ID),
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				arg := arg
+				arg.MemberID = uuid.NullUUID{UUID: member.ID, Valid: true}
+
+				store.EXPECT().
+					ListAttendanceSummaries(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(summaries, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+				require.Equal(t, "text/csv; charset=utf-8", response.Header.Get("Content-Type"))
+				require.Contains(t, response.Header.Get("Content-Disposition"), "attendance-2023-05.csv")
+
+				data, err := io.ReadAll(response.Body)
+				require.NoError(t, err)
+
+				lines := strings.Split(strings.TrimPrefix(string(data), "\ufeff"), "\n")
+				require.Equal(t, "member_id,first_name,last_name,month,days_present,hours_present,auto_checkouts", lines[0])
+				require.Equal(t, fmt.Sprintf("%s,%s,%s,2023-05,12,96.33,1", member.ID, member.FirstName, member.LastName), lines[1])
+			},
+		},
+		{
+			name:  "InvalidFormat",
+			query: "?format=xlsx",
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ListAttendanceSummaries(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "NoAuthorization",
+			setupAuth: func(request *http.Request) {
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListAttendanceSummaries(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusUnauthorized, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			request, err := http.NewRequest(http.MethodGet, "/api/v1/attendance-summaries"+tc.query, nil)
+			require.NoError(t, err)
+
+			tc.setupAuth(request)
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func requireBodyMatchAttendance(t *testing.T, body io.ReadCloser, attendance db.Attendance) {
+	data, err := io.ReadAll(body)
+	require.NoError(t, err)
+
+	var got attendanceResponse
+	err = json.Unmarshal(data, &got)
+	require.NoError(t, err)
+
+	require.Equal(t, attendance.ID, got.ID)
+	require.Equal(t, attendance.MemberID, got.MemberID)
+	require.Equal(t, attendance.LocationID, got.LocationID)
+	require.True(t, attendance.CheckedInAt.Equal(got.CheckedInAt))
+	require.Equal(t, attendance.CheckedOutAt.Valid, got.CheckedOutAt.Valid)
+	require.True(t, attendance.CheckedOutAt.Time.Equal(got.CheckedOutAt.Time))
+	require.Equal(t, attendance.AutoCheckedOut, got.AutoCheckedOut)
+
+	err = body.Close()
+	require.NoError(t, err)
+}
@@ -0,0 +1,218 @@
+package api
+
+import (
+	"database/sql"
+	"encoding/json"
+	"math"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+	"github.com/google/uuid"
+	db "github.com/ot07/coworker-backend/db/sqlc"
+)
+
+// auditMeta identifies the logged-in user and the current request for the audit trail.
+// The request ID is copied since the meta can outlive the request, as in background import jobs.
+func auditMeta(c *fiber.Ctx) db.AuditMeta {
+	meta := db.AuditMeta{}
+	if userID, ok := c.Locals(sessionUserIDKey).(uuid.UUID); ok {
+		meta.ActorID = userID
+	}
+	if requestID, ok := c.Locals(requestIDKey).(string); ok {
+		meta.RequestID = utils.CopyString(requestID)
+	}
+	return meta
+}
+
+type auditEventResponse struct {
+	ID         uuid.UUID       `json:"id"`
+	ActorID    uuid.NullUUID   `json:"actor_id" swaggertype:"string"`
+	Action     string          `json:"action"`
+	EntityType string          `json:"entity_type"`
+	EntityID   uuid.UUID       `json:"entity_id"`
+	Changes    json.RawMessage `json:"changes" swaggertype:"object"`
+	RequestID  string          `json:"request_id"`
+	CreatedAt  time.Time       `json:"created_at"`
+}
+
+func newAuditEventResponse(event db.AuditEvent) auditEventResponse {
+	return auditEventResponse{
+		ID:         event.ID,
+		ActorID:    event.ActorID,
+		Action:     event.Action,
+		EntityType: event.EntityType,
+		EntityID:   event.EntityID,
+		Changes:    event.Changes,
+		RequestID:  event.RequestID,
+		CreatedAt:  event.CreatedAt,
+	}
+}
+
+type listAuditEventsResponse struct {
+	Meta listMembersResponseMeta `json:"meta"`
+	Data []auditEventResponse    `json:"data"`
+}
+
+type auditEventsPageQuery struct {
+	PageID   int32 `query:"page_id" json:"page_id" validate:"required,min=1"`
+	PageSize int32 `query:"page_size" json:"page_size" validate:"required,min=5,max=50"`
+}
+
+type listAuditEventsRequest struct {
+	auditEventsPageQuery
+	ActorID       string `query:"actor_id" json:"actor_id" validate:"omitempty,uuid"`
+	Action        string `query:"action" json:"action" example:"update"`
+	EntityType    string `query:"entity_type" json:"entity_type" example:"member"`
+	EntityID      string `query:"entity_id" json:"entity_id" validate:"omitempty,uuid"`
+	CreatedAfter  string `query:"created_after" json:"created_after" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00" format:"date-time"`
+	CreatedBefore string `query:"created_before" json:"created_before" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00" format:"date-time"`
+}
+
+// countAuditEventsParams converts the filtering parameters of the request into db.CountAuditEventsParams.
+func (req *listAuditEventsRequest) countAuditEventsParams() (db.CountAuditEventsParams, error) {
+	arg := db.CountAuditEventsParams{
+		Action:     sql.NullString{String: req.Action, Valid: len(req.Action) > 0},
+		EntityType: sql.NullString{String: req.EntityType, Valid: len(req.EntityType) > 0},
+	}
+
+	if len(req.ActorID) > 0 {
+		actorID, err := uuid.Parse(req.ActorID)
+		if err != nil {
+			return db.CountAuditEventsParams{}, err
+		}
+		arg.ActorID = uuid.NullUUID{UUID: actorID, Valid: true}
+	}
+
+	if len(req.EntityID) > 0 {
+		entityID, err := uuid.Parse(req.EntityID)
+		if err != nil {
+			return db.CountAuditEventsParams{}, err
+		}
+		arg.EntityID = uuid.NullUUID{UUID: entityID, Valid: true}
+	}
+
+	if len(req.CreatedAfter) > 0 {
+		createdAfter, err := time.Parse(time.RFC3339, req.CreatedAfter)
+		if err != nil {
+			return db.CountAuditEventsParams{}, err
+		}
+		arg.CreatedAfter = sql.NullTime{Time: createdAfter, Valid: true}
+	}
+
+	if len(req.CreatedBefore) > 0 {
+		createdBefore, err := time.Parse(time.RFC3339, req.CreatedBefore)
+		if err != nil {
+			return db.CountAuditEventsParams{}, err
+		}
+		arg.CreatedBefore = sql.NullTime{Time: createdBefore, Valid: true}
+	}
+
+	return arg, nil
+}
+
+// @Summary      List audit events
+// @Description  Lists the changes made in the workspace, most recent first.
+// @Tags         audit-events
+// @Param        query query listAuditEventsRequest true "query"
+// @Success      200 {object} listAuditEventsResponse
+// @Failure      400 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /audit-events [get]
+func (server *Server) listAuditEvents(c *fiber.Ctx) error {
+	req := new(listAuditEventsRequest)
+	if err := c.QueryParser(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	validate := newValidator()
+	if err := validate.Struct(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	filter, err := req.countAuditEventsParams()
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	return server.respondAuditEvents(c, req.auditEventsPageQuery, filter)
+}
+
+type getMemberHistoryRequestParams struct {
+	ID uuid.UUID `params:"id"`
+}
+
+// @Summary      Get member history
+// @Description  Lists the changes made to the member, most recent first.
+// @Tags         members
+// @Param        id    path  string               true "Member ID"
+// @Param        query query auditEventsPageQuery true "query"
+// @Success      200 {object} listAuditEventsResponse
+// @Failure      400 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /members/{id}/history [get]
+func (server *Server) getMemberHistory(c *fiber.Ctx) error {
+	params := new(getMemberHistoryRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	req := new(auditEventsPageQuery)
+	if err := c.QueryParser(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	validate := newValidator()
+	if err := validate.Struct(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	filter := db.CountAuditEventsParams{
+		EntityType: sql.NullString{String: db.AuditEntityMember, Valid: true},
+		EntityID:   uuid.NullUUID{UUID: params.ID, Valid: true},
+	}
+
+	return server.respondAuditEvents(c, *req, filter)
+}
+
+// respondAuditEvents writes the requested page of the audit events matching the filter.
+func (server *Server) respondAuditEvents(c *fiber.Ctx, page auditEventsPageQuery, filter db.CountAuditEventsParams) error {
+	arg := db.ListAuditEventsParams{
+		Limit:         page.PageSize,
+		Offset:        (page.PageID - 1) * page.PageSize,
+		ActorID:       filter.ActorID,
+		Action:        filter.Action,
+		EntityType:    filter.EntityType,
+		EntityID:      filter.EntityID,
+		CreatedAfter:  filter.CreatedAfter,
+		CreatedBefore: filter.CreatedBefore,
+	}
+
+	events, err := server.store.ListAuditEvents(c.Context(), arg)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	totalCount, err := server.store.CountAuditEvents(c.Context(), filter)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	pageCount := int64(math.Ceil(float64(totalCount) / float64(page.PageSize)))
+
+	data := make([]auditEventResponse, 0, len(events))
+	for _, event := range events {
+		data = append(data, newAuditEventResponse(event))
+	}
+
+	rsp := listAuditEventsResponse{
+		Meta: listMembersResponseMeta{
+			PageID:     page.PageID,
+			PageSize:   page.PageSize,
+			PageCount:  pageCount,
+			TotalCount: totalCount,
+		},
+		Data: data,
+	}
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
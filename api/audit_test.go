@@ -0,0 +1,358 @@
+package api
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	mockdb "github.com/ot07/coworker-backend/db/mock"
+	db "github.com/ot07/coworker-backend/db/sqlc"
+	"github.com/ot07/coworker-backend/util"
+	"github.com/stretchr/testify/require"
+)
+
+type eqAuditMetaMatcher struct {
+	actorID uuid.UUID
+}
+
+func (e eqAuditMetaMatcher) Matches(x interface{}) bool {
+	meta, ok := x.(db.AuditMeta)
+	if !ok {
+		return false
+	}
+	return meta.ActorID == e.actorID && len(meta.RequestID) > 0
+}
+
+func (e eqAuditMetaMatcher) String() string {
+	return fmt.Sprintf("is audit meta of actor %v with a request ID", e.actorID)
+}
+
+// eqAuditMeta matches the audit meta of a request made by the given user.
+func eqAuditMeta(actorID uuid.UUID) gomock.Matcher {
+	return eqAuditMetaMatcher{actorID}
+}
+
+func TestListAuditEventsAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+
+	n := 5
+	events := make([]db.AuditEvent, n)
+	for i := 0; i < n; i++ {
+		events[i] = randomAuditEvent(session.UserID)
+	}
+
+	testCases := []struct {
+		name          string
+		query         map[string]string
+		setupAuth     func(request *http.Request)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name:  "OK",
+			query: map[string]string{"page_id": "1", "page_size": "5"},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ListAuditEvents(gomock.Any(), gomock.Eq(db.ListAuditEventsParams{Limit: 5, Offset: 0})).
+					Times(1).
+					Return(events, nil)
+
+				store.EXPECT().
+					CountAuditEvents(gomock.Any(), gomock.Eq(db.CountAuditEventsParams{})).
+					Times(1).
+					Return(int64(n), nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+				requireBodyMatchAuditEvents(t, response.Body, events)
+			},
+		},
+		{
+			name: "Filter",
+			query: map[string]string{
+				"page_id":        "2",
+				"page_size":      "5",
+				"actor_id":       session.UserID.String(),
+				"action":         db.AuditActionUpdate,
+				"entity_type":    db.AuditEntityMember,
+				"created_after":  "2023-04-01T00:00:00Z",
+				"created_before": "2023-05-01T00:00:00Z",
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				filter := db.CountAuditEventsParams{
+					ActorID:       uuid.NullUUID{UUID: session.UserID, Valid: true},
+					Action:        sql.NullString{String: db.AuditActionUpdate, Valid: true},
+					EntityType:    sql.NullString{String: db.AuditEntityMember, Valid: true},
+					CreatedAfter:  sql.NullTime{Time: time.Date(2023, 4, 1, 0, 0, 0, 0, time.UTC), Valid: true},
+					CreatedBefore: sql.NullTime{Time: time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC), Valid: true},
+				}
+
+				store.EXPECT().
+					ListAuditEvents(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ interface{}, arg db.ListAuditEventsParams) ([]db.AuditEvent, error) {
+						require.Equal(t, int32(5), arg.Limit)
+						require.Equal(t, int32(5), arg.Offset)
+						require.Equal(t, filter.ActorID, arg.ActorID)
+						require.Equal(t, filter.Action, arg.Action)
+						require.Equal(t, filter.EntityType, arg.EntityType)
+						require.False(t, arg.EntityID.Valid)
+						require.True(t, filter.CreatedAfter.Time.Equal(arg.CreatedAfter.Time))
+						require.True(t, filter.CreatedBefore.Time.Equal(arg.CreatedBefore.Time))
+						return []db.AuditEvent{}, nil
+					})
+
+				store.EXPECT().
+					CountAuditEvents(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(int64(5), nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+				requireBodyMatchAuditEvents(t, response.Body, []db.AuditEvent{})
+			},
+		},
+		{
+			name:  "NoAuthorization",
+			query: map[string]string{"page_id": "1", "page_size": "5"},
+			setupAuth: func(request *http.Request) {
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListAuditEvents(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusUnauthorized, response.StatusCode)
+			},
+		},
+		{
+			name:  "InvalidActorID",
+			query: map[string]string{"page_id": "1", "page_size": "5", "actor_id": "InvalidID"},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ListAuditEvents(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name:  "InternalError",
+			query: map[string]string{"page_id": "1", "page_size": "5"},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ListAuditEvents(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.AuditEvent{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusInternalServerError, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			url := "/api/v1/audit-events"
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			// Add query parameters to request URL
+			q := request.URL.Query()
+			for key, value := range tc.query {
+				q.Add(key, value)
+			}
+			request.URL.RawQuery = q.Encode()
+
+			tc.setupAuth(request)
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func TestGetMemberHistoryAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	member := randomMember()
+	events := []db.AuditEvent{randomAuditEvent(session.UserID)}
+
+	testCases := []struct {
+		name          string
+		memberID      string
+		setupAuth     func(request *http.Request)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name:     "OK",
+			memberID: member.ID.String(),
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				arg := db.ListAuditEventsParams{
+					Limit:      5,
+					Offset:     0,
+					EntityType: sql.NullString{String: db.AuditEntityMember, Valid: true},
+					EntityID:   uuid.NullUUID{UUID: member.ID, Valid: true},
+				}
+
+				store.EXPECT().
+					ListAuditEvents(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(events, nil)
+
+				store.EXPECT().
+					CountAuditEvents(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(int64(len(events)), nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+				requireBodyMatchAuditEvents(t, response.Body, events)
+			},
+		},
+		{
+			name:     "NoAuthorization",
+			memberID: member.ID.String(),
+			setupAuth: func(request *http.Request) {
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListAuditEvents(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusUnauthorized, response.StatusCode)
+			},
+		},
+		{
+			name:     "InvalidID",
+			memberID: "InvalidID",
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ListAuditEvents(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			url := fmt.Sprintf("/api/v1/members/%s/history?page_id=1&page_size=5", tc.memberID)
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			tc.setupAuth(request)
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func randomAuditEvent(actorID uuid.UUID) db.AuditEvent {
+	return db.AuditEvent{
+		ID:         util.RandomUUID(),
+		ActorID:    uuid.NullUUID{UUID: actorID, Valid: true},
+		Action:     db.AuditActionUpdate,
+		EntityType: db.AuditEntityMember,
+		EntityID:   util.RandomUUID(),
+		Changes:    json.RawMessage(`{"first_name":{"before":"Taro","after":"Jiro"}}`),
+		RequestID:  util.RandomString(16),
+		CreatedAt:  time.Now().UTC().Truncate(time.Second),
+	}
+}
+
+func requireBodyMatchAuditEvents(t *testing.T, body io.ReadCloser, events []db.AuditEvent) {
+	data, err := io.ReadAll(body)
+	require.NoError(t, err)
+
+	var gotResponse listAuditEventsResponse
+	err = json.Unmarshal(data, &gotResponse)
+	require.NoError(t, err)
+
+	require.Len(t, gotResponse.Data, len(events))
+	for i, event := range events {
+		got := gotResponse.Data[i]
+		require.Equal(t, event.ID, got.ID)
+		require.Equal(t, event.ActorID, got.ActorID)
+		require.Equal(t, event.Action, got.Action)
+		require.Equal(t, event.EntityID, got.EntityID)
+		require.JSONEq(t, string(event.Changes), string(got.Changes))
+		require.Equal(t, event.RequestID, got.RequestID)
+		require.True(t, event.CreatedAt.Equal(got.CreatedAt))
+	}
+
+	err = body.Close()
+	require.NoError(t, err)
+}
@@ -0,0 +1,184 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/ot07/coworker-backend/calendar"
+	db "github.com/ot07/coworker-backend/db/sqlc"
+)
+
+// calendarFeedHistory is how long past reservations stay in the calendar feeds.
+const calendarFeedHistory = 90 * 24 * time.Hour
+
+type calendarTokenResponse struct {
+	Token uuid.UUID `json:"token"`
+	// URL is the address of the iCalendar feed to subscribe to from a calendar client. Anyone knowing it can read the feed.
+	URL string `json:"url" example:"https://example.com/api/v1/calendars/8d7c2a3e-3b1f-4a8e-9a57-2f0e8b1b0f6c.ics"`
+}
+
+func (server *Server) newCalendarTokenResponse(c *fiber.Ctx, token db.CalendarToken) calendarTokenResponse {
+	return calendarTokenResponse{
+		Token: token.Token,
+		URL:   fmt.Sprintf("%s/api/v1/calendars/%s.ics", c.BaseURL(), token.Token),
+	}
+}
+
+// @Summary      Rotate room calendar token
+// @Description  Creates the token of the iCalendar feed of the room's reservations, replacing the previous one, whose URL stops working.
+// @Tags         rooms
+// @Param        id path string true "Room ID"
+// @Success      200 {object} calendarTokenResponse
+// @Failure      400 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /rooms/{id}/calendar-token [post]
+func (server *Server) rotateRoomCalendarToken(c *fiber.Ctx) error {
+	params := new(roomRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	token, err := server.store.RotateRoomCalendarToken(c.Context(), uuid.NullUUID{UUID: params.ID, Valid: true})
+	if err != nil {
+		return c.Status(locationWriteStatus(err)).JSON(newErrorResponse(err))
+	}
+
+	rsp := server.newCalendarTokenResponse(c, token)
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+type rotateMemberCalendarTokenRequest struct {
+	ID uuid.UUID `params:"id"`
+}
+
+// @Summary      Rotate member calendar token
+// @Description  Creates the token of the iCalendar feed of the room reservations the member made, replacing the previous one,
+// @Description  whose URL stops working.
+// @Tags         members
+// @Param        id path string true "Member ID"
+// @Success      200 {object} calendarTokenResponse
+// @Failure      400 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /members/{id}/calendar-token [post]
+func (server *Server) rotateMemberCalendarToken(c *fiber.Ctx) error {
+	params := new(rotateMemberCalendarTokenRequest)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	if _, err := server.store.GetMember(c.Context(), params.ID); err != nil {
+		if err == sql.ErrNoRows {
+			return c.Status(fiber.StatusNotFound).JSON(newErrorResponse(err))
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	token, err := server.store.RotateMemberCalendarToken(c.Context(), uuid.NullUUID{UUID: params.ID, Valid: true})
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	rsp := server.newCalendarTokenResponse(c, token)
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+type getCalendarFeedRequest struct {
+	Token uuid.UUID `params:"token"`
+}
+
+// @Summary      Get calendar feed
+// @Description  Serves the reservations of a room, or those a member made, as an iCalendar feed for calendar clients to subscribe to.
+// @Description  The token in the URL authenticates the request in place of the session cookie, which calendar clients cannot send.
+// @Description  Reservations that ended more than 90 days ago are left out.
+// @Tags         rooms
+// @Produce      text/calendar
+// @Param        token path string true "Calendar token"
+// @Success      200 {string} string
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /calendars/{token}.ics [get]
+func (server *Server) getCalendarFeed(c *fiber.Ctx) error {
+	params := new(getCalendarFeedRequest)
+	if err := c.ParamsParser(params); err != nil {
+		// A malformed token is no different from an unknown one.
+		return c.Status(fiber.StatusNotFound).JSON(newErrorResponse(err))
+	}
+
+	token, err := server.store.GetCalendarToken(c.Context(), params.Token)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return c.Status(fiber.StatusNotFound).JSON(newErrorResponse(err))
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	var name string
+	if token.RoomID.Valid {
+		room, err := server.store.GetRoom(c.Context(), token.RoomID.UUID)
+		if err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+		}
+		name = room.Name
+	} else {
+		member, err := server.store.GetMember(c.Context(), token.MemberID.UUID)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return c.Status(fiber.StatusNotFound).JSON(newErrorResponse(err))
+			}
+			return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+		}
+		name = fmt.Sprintf("%s %s", member.FirstName, member.LastName)
+	}
+
+	reservations, err := server.store.ListCalendarFeedReservations(c.Context(), db.ListCalendarFeedReservationsParams{
+		RoomID:   token.RoomID,
+		MemberID: token.MemberID,
+		Since:    time.Now().Add(-calendarFeedHistory),
+	})
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	exDates := make(map[uuid.UUID][]time.Time)
+	if len(reservations) > 0 {
+		ids := make([]uuid.UUID, 0, len(reservations))
+		for _, reservation := range reservations {
+			ids = append(ids, reservation.ID)
+		}
+		exceptions, err := server.store.ListRoomReservationExceptions(c.Context(), ids)
+		if err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+		}
+		for _, exception := range exceptions {
+			exDates[exception.ReservationID] = append(exDates[exception.ReservationID], exception.OccurrenceStart)
+		}
+	}
+
+	events := make([]calendar.Event, 0, len(reservations))
+	for _, reservation := range reservations {
+		events = append(events, calendar.Event{
+			UID:      reservation.ID.String() + "@coworker",
+			Summary:  reservation.Title,
+			Location: reservation.RoomName,
+			Start:    reservation.StartsAt,
+			End:      reservation.EndsAt,
+			Rule:     reservation.Rrule.String,
+			ExDates:  exDates[reservation.ID],
+			Stamp:    reservation.CreatedAt,
+		})
+	}
+
+	var buf bytes.Buffer
+	if err := calendar.WriteCalendar(&buf, name, events); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	c.Set(fiber.HeaderContentType, calendar.ContentType)
+	return c.Status(fiber.StatusOK).Send(buf.Bytes())
+}
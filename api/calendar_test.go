@@ -0,0 +1,376 @@
+package api
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/ot07/coworker-backend/calendar"
+	mockdb "github.com/ot07/coworker-backend/db/mock"
+	db "github.com/ot07/coworker-backend/db/sqlc"
+	"github.com/ot07/coworker-backend/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRotateCalendarTokenAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	room := randomRoom(randomLocation())
+	member := randomMember()
+	roomToken := db.CalendarToken{
+		Token:     util.RandomUUID(),
+		RoomID:    uuid.NullUUID{UUID: room.ID, Valid: true},
+		CreatedAt: time.Now(),
+	}
+	memberToken := db.CalendarToken{
+		Token:     util.RandomUUID(),
+		MemberID:  uuid.NullUUID{UUID: member.ID, Valid: true},
+		CreatedAt: time.Now(),
+	}
+
+	testCases := []struct {
+		name          string
+		url           string
+		setupAuth     func(request *http.Request)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name: "Room",
+			url:  fmt.Sprintf("/api/v1/rooms/%s/calendar-token", room.ID),
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					RotateRoomCalendarToken(gomock.Any(), gomock.Eq(roomToken.RoomID)).
+					Times(1).
+					Return(roomToken, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+				requireBodyMatchCalendarToken(t, response.Body, roomToken)
+			},
+		},
+		{
+			name: "RoomNotFound",
+			url:  fmt.Sprintf("/api/v1/rooms/%s/calendar-token", room.ID),
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					RotateRoomCalendarToken(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.CalendarToken{}, &pq.Error{Code: "23503"})
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusNotFound, response.StatusCode)
+			},
+		},
+		{
+			name: "NoAuthorization",
+			url:  fmt.Sprintf("/api/v1/rooms/%s/calendar-token", room.ID),
+			setupAuth: func(request *http.Request) {
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					RotateRoomCalendarToken(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusUnauthorized, response.StatusCode)
+			},
+		},
+		{
+			name: "Member",
+			url:  fmt.Sprintf("/api/v1/members/%s/calendar-token", member.ID),
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					GetMember(gomock.Any(), gomock.Eq(member.ID)).
+					Times(1).
+					Return(member, nil)
+
+				store.EXPECT().
+					RotateMemberCalendarToken(gomock.Any(), gomock.Eq(memberToken.MemberID)).
+					Times(1).
+					Return(memberToken, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+				requireBodyMatchCalendarToken(t, response.Body, memberToken)
+			},
+		},
+		{
+			name: "MemberNotFound",
+			url:  fmt.Sprintf("/api/v1/members/%s/calendar-token", member.ID),
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					GetMember(gomock.Any(), gomock.Eq(member.ID)).
+					Times(1).
+					Return(db.Member{}, sql.ErrNoRows)
+
+				store.EXPECT().
+					RotateMemberCalendarToken(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusNotFound, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			request, err := http.NewRequest(http.MethodPost, tc.url, nil)
+			require.NoError(t, err)
+
+			tc.setupAuth(request)
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func TestGetCalendarFeedAPI(t *testing.T) {
+	t.Parallel()
+
+	room := randomRoom(randomLocation())
+	member := randomMember()
+	reservation := randomRoomReservation(room, member.ID, time.Date(2099, 5, 1, 10, 0, 0, 0, time.UTC), "FREQ=WEEKLY;COUNT=4")
+	row := db.ListCalendarFeedReservationsRow{
+		ID:           reservation.ID,
+		RoomID:       reservation.RoomID,
+		MemberID:     reservation.MemberID,
+		Title:        reservation.Title,
+		StartsAt:     reservation.StartsAt,
+		EndsAt:       reservation.EndsAt,
+		Rrule:        reservation.Rrule,
+		SeriesEndsAt: reservation.SeriesEndsAt,
+		CreatedAt:    reservation.CreatedAt,
+		RoomName:     room.Name,
+	}
+	exception := db.RoomReservationException{
+		ReservationID:   reservation.ID,
+		OccurrenceStart: reservation.StartsAt.AddDate(0, 0, 7),
+	}
+	roomToken := db.CalendarToken{
+		Token:  util.RandomUUID(),
+		RoomID: uuid.NullUUID{UUID: room.ID, Valid: true},
+	}
+	memberToken := db.CalendarToken{
+		Token:    util.RandomUUID(),
+		MemberID: uuid.NullUUID{UUID: member.ID, Valid: true},
+	}
+
+	testCases := []struct {
+		name          string
+		token         string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name:  "Room",
+			token: roomToken.Token.String(),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetCalendarToken(gomock.Any(), gomock.Eq(roomToken.Token)).
+					Times(1).
+					Return(roomToken, nil)
+
+				store.EXPECT().
+					GetRoom(gomock.Any(), gomock.Eq(room.ID)).
+					Times(1).
+					Return(room, nil)
+
+				store.EXPECT().
+					ListCalendarFeedReservations(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ interface{}, arg db.ListCalendarFeedReservationsParams) ([]db.ListCalendarFeedReservationsRow, error) {
+						require.Equal(t, roomToken.RoomID, arg.RoomID)
+						require.False(t, arg.MemberID.Valid)
+						require.WithinDuration(t, time.Now().Add(-calendarFeedHistory), arg.Since, time.Minute)
+						return []db.ListCalendarFeedReservationsRow{row}, nil
+					})
+
+				store.EXPECT().
+					ListRoomReservationExceptions(gomock.Any(), gomock.Eq([]uuid.UUID{reservation.ID})).
+					Times(1).
+					Return([]db.RoomReservationException{exception}, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+				require.Equal(t, calendar.ContentType, response.Header.Get("Content-Type"))
+
+				data, err := io.ReadAll(response.Body)
+				require.NoError(t, err)
+
+				body := string(data)
+				require.Contains(t, body, "X-WR-CALNAME:"+room.Name+"\r\n")
+				require.Contains(t, body, "UID:"+reservation.ID.String()+"@coworker\r\n")
+				require.Contains(t, body, "DTSTART:20990501T100000Z\r\n")
+				require.Contains(t, body, "RRULE:FREQ=WEEKLY;COUNT=4\r\n")
+				require.Contains(t, body, "EXDATE:20990508T100000Z\r\n")
+				require.Contains(t, body, "LOCATION:"+room.Name+"\r\n")
+			},
+		},
+		{
+			name:  "Member",
+			token: memberToken.Token.String(),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetCalendarToken(gomock.Any(), gomock.Eq(memberToken.Token)).
+					Times(1).
+					Return(memberToken, nil)
+
+				store.EXPECT().
+					GetMember(gomock.Any(), gomock.Eq(member.ID)).
+					Times(1).
+					Return(member, nil)
+
+				store.EXPECT().
+					ListCalendarFeedReservations(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.ListCalendarFeedReservationsRow{}, nil)
+
+				store.EXPECT().
+					ListRoomReservationExceptions(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+
+				data, err := io.ReadAll(response.Body)
+				require.NoError(t, err)
+
+				body := string(data)
+				require.Contains(t, body, fmt.Sprintf("X-WR-CALNAME:%s %s\r\n", member.FirstName, member.LastName))
+				require.False(t, strings.Contains(body, "BEGIN:VEVENT"))
+			},
+		},
+		{
+			name:  "MemberInTrash",
+			token: memberToken.Token.String(),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetCalendarToken(gomock.Any(), gomock.Eq(memberToken.Token)).
+					Times(1).
+					Return(memberToken, nil)
+
+				store.EXPECT().
+					GetMember(gomock.Any(), gomock.Eq(member.ID)).
+					Times(1).
+					Return(db.Member{}, sql.ErrNoRows)
+
+				store.EXPECT().
+					ListCalendarFeedReservations(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusNotFound, response.StatusCode)
+			},
+		},
+		{
+			name:  "UnknownToken",
+			token: util.RandomUUID().String(),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetCalendarToken(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.CalendarToken{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusNotFound, response.StatusCode)
+			},
+		},
+		{
+			name:  "InvalidToken",
+			token: "invalid",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetCalendarToken(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusNotFound, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			// The feed is read without a session, as calendar clients do.
+			request, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/calendars/%s.ics", tc.token), nil)
+			require.NoError(t, err)
+
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func requireBodyMatchCalendarToken(t *testing.T, body io.ReadCloser, token db.CalendarToken) {
+	data, err := io.ReadAll(body)
+	require.NoError(t, err)
+
+	var got calendarTokenResponse
+	err = json.Unmarshal(data, &got)
+	require.NoError(t, err)
+
+	require.Equal(t, token.Token, got.Token)
+	require.True(t, strings.HasSuffix(got.URL, fmt.Sprintf("/api/v1/calendars/%s.ics", token.Token)))
+
+	err = body.Close()
+	require.NoError(t, err)
+}
@@ -0,0 +1,138 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"math"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	db "github.com/ot07/coworker-backend/db/sqlc"
+)
+
+// maxCapacityRange is how long a range of days capacity can be planned over at once.
+const maxCapacityRange = 366 * 24 * time.Hour
+
+var errCapacityRange = errors.New("the range must end on or after the day it starts, within 366 days")
+
+// roundDays rounds a number of days to the hundredth.
+func roundDays(days float64) float64 {
+	return math.Round(days*100) / 100
+}
+
+type projectCapacityResponse struct {
+	ProjectID     uuid.UUID `json:"project_id"`
+	ProjectName   string    `json:"project_name"`
+	AllocatedDays float64   `json:"allocated_days"`
+}
+
+type memberCapacityResponse struct {
+	MemberID  uuid.UUID `json:"member_id"`
+	FirstName string    `json:"first_name"`
+	LastName  string    `json:"last_name"`
+	// WorkingDays counts the days from Monday to Friday of the range.
+	WorkingDays float64 `json:"working_days"`
+	// LeaveDays counts the working days taken by approved leaves.
+	LeaveDays float64 `json:"leave_days"`
+	// AvailableDays is what is left of the working days besides the leaves.
+	AvailableDays float64 `json:"available_days"`
+	// AllocatedDays sums up the allocations of the assignments of the member over the days they are available.
+	AllocatedDays float64 `json:"allocated_days"`
+	// FreeDays is what is left of the available days besides the allocated ones, negative when the member is overallocated.
+	FreeDays float64 `json:"free_days"`
+	// Utilization is the share of the available days allocated, in percent, null when the member is not available at all.
+	Utilization db.NullFloat64            `json:"utilization" swaggertype:"number"`
+	Projects    []projectCapacityResponse `json:"projects"`
+}
+
+func newMemberCapacityResponse(capacity db.MemberCapacity) memberCapacityResponse {
+	rsp := memberCapacityResponse{
+		MemberID:      capacity.MemberID,
+		FirstName:     capacity.FirstName,
+		LastName:      capacity.LastName,
+		WorkingDays:   roundDays(capacity.WorkingDays),
+		LeaveDays:     roundDays(capacity.LeaveDays),
+		AvailableDays: roundDays(capacity.AvailableDays),
+		AllocatedDays: roundDays(capacity.AllocatedDays),
+		FreeDays:      roundDays(capacity.AvailableDays - capacity.AllocatedDays),
+		Projects:      make([]projectCapacityResponse, 0, len(capacity.Projects)),
+	}
+	if capacity.AvailableDays > 0 {
+		utilization := math.Round(capacity.AllocatedDays / capacity.AvailableDays * 100)
+		rsp.Utilization = db.NullFloat64{NullFloat64: sql.NullFloat64{Float64: utilization, Valid: true}}
+	}
+	for _, project := range capacity.Projects {
+		rsp.Projects = append(rsp.Projects, projectCapacityResponse{
+			ProjectID:     project.ProjectID,
+			ProjectName:   project.ProjectName,
+			AllocatedDays: roundDays(project.AllocatedDays),
+		})
+	}
+	return rsp
+}
+
+type getCapacityRequestQuery struct {
+	// From and To are the first and the last day planned.
+	From string `query:"from" json:"from" validate:"required,datetime=2006-01-02" format:"date"`
+	To   string `query:"to" json:"to" validate:"required,datetime=2006-01-02" format:"date"`
+	// MemberID and TeamID narrow the capacities down to a member or to the direct members of a team.
+	MemberID string `query:"member_id" json:"member_id" validate:"omitempty,uuid"`
+	TeamID   string `query:"team_id" json:"team_id" validate:"omitempty,uuid"`
+}
+
+// @Summary      Get capacity
+// @Description  Returns, for every member, how many days of a range they are available, from Monday to Friday besides their
+// @Description  approved leaves, and how many of them are allocated to projects by their assignments. Members in the trash or
+// @Description  offboarded are left out. Days are rounded to the hundredth.
+// @Tags         capacity
+// @Param        query query getCapacityRequestQuery true "query"
+// @Success      200 {array} memberCapacityResponse
+// @Failure      400 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /capacity [get]
+func (server *Server) getCapacity(c *fiber.Ctx) error {
+	query := new(getCapacityRequestQuery)
+	if err := c.QueryParser(query); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	validate := newValidator()
+	if err := validate.Struct(query); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	from, err := time.Parse(db.DateLayout, query.From)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+	to, err := time.Parse(db.DateLayout, query.To)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+	if to.Before(from) || to.Sub(from) >= maxCapacityRange {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(errCapacityRange))
+	}
+
+	arg := db.ListMemberCapacitiesParams{
+		FromDate: from,
+		ToDate:   to,
+	}
+	if arg.MemberID, err = parseNullUUID(query.MemberID); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+	if arg.TeamID, err = parseNullUUID(query.TeamID); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	capacities, err := server.store.ListMemberCapacities(c.Context(), arg)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	rsp := make([]memberCapacityResponse, 0, len(capacities))
+	for _, capacity := range capacities {
+		rsp = append(rsp, newMemberCapacityResponse(capacity))
+	}
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
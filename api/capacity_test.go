@@ -0,0 +1,151 @@
+package api
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	mockdb "github.com/ot07/coworker-backend/db/mock"
+	db "github.com/ot07/coworker-backend/db/sqlc"
+	"github.com/ot07/coworker-backend/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetCapacityAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	teamID := util.RandomUUID()
+	capacities := []db.MemberCapacity{
+		{
+			MemberID:      util.RandomUUID(),
+			FirstName:     "Ada",
+			LastName:      "Lovelace",
+			WorkingDays:   10,
+			LeaveDays:     1.5,
+			AvailableDays: 8.5,
+			AllocatedDays: 9.35,
+			Projects: []db.ProjectCapacity{
+				{ProjectID: util.RandomUUID(), ProjectName: "Alpha", AllocatedDays: 6.8},
+				{ProjectID: util.RandomUUID(), ProjectName: "Beta", AllocatedDays: 2.55},
+			},
+		},
+		{
+			MemberID:    util.RandomUUID(),
+			FirstName:   "Alan",
+			LastName:    "Turing",
+			WorkingDays: 10,
+			LeaveDays:   10,
+			Projects:    []db.ProjectCapacity{},
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		query         string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name:  "OK",
+			query: "from=2023-08-07&to=2023-08-20&team_id=" + teamID.String(),
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.ListMemberCapacitiesParams{
+					FromDate: time.Date(2023, 8, 7, 0, 0, 0, 0, time.UTC),
+					ToDate:   time.Date(2023, 8, 20, 0, 0, 0, 0, time.UTC),
+					TeamID:   uuid.NullUUID{UUID: teamID, Valid: true},
+				}
+				store.EXPECT().
+					ListMemberCapacities(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(capacities, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+
+				data, err := io.ReadAll(response.Body)
+				require.NoError(t, err)
+
+				var got []memberCapacityResponse
+				err = json.Unmarshal(data, &got)
+				require.NoError(t, err)
+				require.Len(t, got, 2)
+				require.Equal(t, capacities[0].MemberID, got[0].MemberID)
+				require.Equal(t, 8.5, got[0].AvailableDays)
+				require.Equal(t, -0.85, got[0].FreeDays)
+				require.True(t, got[0].Utilization.Valid)
+				require.Equal(t, 110.0, got[0].Utilization.Float64)
+				require.Len(t, got[0].Projects, 2)
+				require.Equal(t, "Beta", got[0].Projects[1].ProjectName)
+				require.False(t, got[1].Utilization.Valid)
+				require.Empty(t, got[1].Projects)
+			},
+		},
+		{
+			name:  "MissingTo",
+			query: "from=2023-08-07",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListMemberCapacities(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name:  "RangeTooLong",
+			query: "from=2023-01-01&to=2024-01-02",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListMemberCapacities(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name:  "EndBeforeStart",
+			query: "from=2023-08-20&to=2023-08-07",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListMemberCapacities(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			buildValidSessionStubs(store, session)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			request, err := http.NewRequest(http.MethodGet, "/api/v1/capacity?"+tc.query, nil)
+			require.NoError(t, err)
+
+			addSessionTokenInCookie(request, session.SessionToken.String())
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
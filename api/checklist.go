@@ -0,0 +1,323 @@
+package api
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	db "github.com/ot07/coworker-backend/db/sqlc"
+)
+
+// checklistWriteStatus tells which status to respond with for an error writing checklist tasks.
+// An assignee who does not exist is a problem of the request body rather than of the URL.
+func checklistWriteStatus(err error) int {
+	if err == sql.ErrNoRows {
+		return fiber.StatusNotFound
+	}
+	if pqErr, ok := err.(*pq.Error); ok && pqErr.Code.Name() == "foreign_key_violation" {
+		return fiber.StatusBadRequest
+	}
+	return fiber.StatusInternalServerError
+}
+
+type checklistTemplateTaskResponse struct {
+	ID          uuid.UUID     `json:"id"`
+	Title       string        `json:"title"`
+	Description string        `json:"description"`
+	AssigneeID  uuid.NullUUID `json:"assignee_id" swaggertype:"string"`
+	// DueOffsetDays is the number of days after the start of onboarding or offboarding the task is due.
+	DueOffsetDays int32 `json:"due_offset_days"`
+}
+
+func newChecklistTemplateTaskResponse(task db.ChecklistTemplateTask) checklistTemplateTaskResponse {
+	return checklistTemplateTaskResponse{
+		ID:            task.ID,
+		Title:         task.Title,
+		Description:   task.Description,
+		AssigneeID:    task.AssigneeID,
+		DueOffsetDays: task.DueOffsetDays,
+	}
+}
+
+type checklistTemplateResponse struct {
+	Kind  string                          `json:"kind" enums:"onboarding,offboarding"`
+	Tasks []checklistTemplateTaskResponse `json:"tasks"`
+}
+
+func newChecklistTemplateResponse(kind string, tasks []db.ChecklistTemplateTask) checklistTemplateResponse {
+	rsp := checklistTemplateResponse{
+		Kind:  kind,
+		Tasks: make([]checklistTemplateTaskResponse, 0, len(tasks)),
+	}
+	for _, task := range tasks {
+		rsp.Tasks = append(rsp.Tasks, newChecklistTemplateTaskResponse(task))
+	}
+	return rsp
+}
+
+type checklistTaskResponse struct {
+	ID          uuid.UUID     `json:"id"`
+	MemberID    uuid.UUID     `json:"member_id"`
+	Kind        string        `json:"kind" enums:"onboarding,offboarding"`
+	Title       string        `json:"title"`
+	Description string        `json:"description"`
+	AssigneeID  uuid.NullUUID `json:"assignee_id" swaggertype:"string"`
+	DueDate     string        `json:"due_date" format:"date"`
+	CompletedAt db.NullTime   `json:"completed_at" swaggertype:"string" format:"date-time"`
+	CompletedBy uuid.NullUUID `json:"completed_by" swaggertype:"string"`
+	// Overdue tells whether the task was found still open after its due date.
+	Overdue   bool      `json:"overdue"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func newChecklistTaskResponse(task db.MemberChecklistTask) checklistTaskResponse {
+	return checklistTaskResponse{
+		ID:          task.ID,
+		MemberID:    task.MemberID,
+		Kind:        task.Kind,
+		Title:       task.Title,
+		Description: task.Description,
+		AssigneeID:  task.AssigneeID,
+		DueDate:     task.DueDate.Format(db.DateLayout),
+		CompletedAt: db.NullTime{NullTime: task.CompletedAt},
+		CompletedBy: task.CompletedBy,
+		Overdue:     task.OverdueAt.Valid && !task.CompletedAt.Valid,
+		CreatedAt:   task.CreatedAt,
+	}
+}
+
+func newChecklistTasksResponse(tasks []db.MemberChecklistTask) []checklistTaskResponse {
+	rsp := make([]checklistTaskResponse, 0, len(tasks))
+	for _, task := range tasks {
+		rsp = append(rsp, newChecklistTaskResponse(task))
+	}
+	return rsp
+}
+
+type checklistTemplateRequestParams struct {
+	Kind string `params:"kind" validate:"required,oneof=onboarding offboarding"`
+}
+
+// @Summary      Get checklist template
+// @Tags         checklists
+// @Param        kind path string true "Kind of checklist" Enums(onboarding, offboarding)
+// @Success      200 {object} checklistTemplateResponse
+// @Failure      400 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /checklist-templates/{kind} [get]
+func (server *Server) getChecklistTemplate(c *fiber.Ctx) error {
+	params := new(checklistTemplateRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	validate := newValidator()
+	if err := validate.Struct(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	tasks, err := server.store.ListChecklistTemplateTasks(c.Context(), params.Kind)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(newChecklistTemplateResponse(params.Kind, tasks))
+}
+
+type checklistTemplateTaskRequest struct {
+	Title         string        `json:"title" validate:"required,max=200"`
+	Description   string        `json:"description" validate:"max=2000"`
+	AssigneeID    uuid.NullUUID `json:"assignee_id" swaggertype:"string"`
+	DueOffsetDays int32         `json:"due_offset_days" validate:"min=-365,max=365"`
+}
+
+type replaceChecklistTemplateRequestBody struct {
+	// Tasks lists the tasks of the template in the order they are listed in the checklists.
+	Tasks []checklistTemplateTaskRequest `json:"tasks" validate:"max=100,dive"`
+}
+
+// @Summary      Replace checklist template
+// @Description  Replaces the tasks of the template from which the checklists of members moving to onboarding, or to offboarded, are generated.
+// @Description  Each task is due its offset in days after the effective date of the move. Checklists already generated are left untouched.
+// @Tags         checklists
+// @Param        kind path string                              true "Kind of checklist" Enums(onboarding, offboarding)
+// @Param        body body replaceChecklistTemplateRequestBody true "Template"
+// @Success      200 {object} checklistTemplateResponse
+// @Failure      400 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /checklist-templates/{kind} [put]
+func (server *Server) replaceChecklistTemplate(c *fiber.Ctx) error {
+	params := new(checklistTemplateRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	body := new(replaceChecklistTemplateRequestBody)
+	if err := c.BodyParser(body); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	validate := newValidator()
+	if err := validate.Struct(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+	if err := validate.Struct(body); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	args := make([]db.CreateChecklistTemplateTaskParams, 0, len(body.Tasks))
+	for _, task := range body.Tasks {
+		args = append(args, db.CreateChecklistTemplateTaskParams{
+			Title:         task.Title,
+			Description:   task.Description,
+			AssigneeID:    task.AssigneeID,
+			DueOffsetDays: task.DueOffsetDays,
+		})
+	}
+
+	tasks, err := server.store.ReplaceChecklistTemplateTx(c.Context(), params.Kind, args)
+	if err != nil {
+		return c.Status(checklistWriteStatus(err)).JSON(newErrorResponse(err))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(newChecklistTemplateResponse(params.Kind, tasks))
+}
+
+type listMemberChecklistRequestParams struct {
+	ID uuid.UUID `params:"id"`
+}
+
+type listMemberChecklistRequestQuery struct {
+	Kind string `query:"kind" json:"kind" validate:"omitempty,oneof=onboarding offboarding" enums:"onboarding,offboarding"`
+}
+
+// @Summary      List member checklist
+// @Description  Lists the checklist tasks of a member, the oldest checklist first.
+// @Tags         checklists
+// @Param        id    path  string                          true "Member ID"
+// @Param        query query listMemberChecklistRequestQuery true "query"
+// @Success      200 {array} checklistTaskResponse
+// @Failure      400 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /members/{id}/checklist [get]
+func (server *Server) listMemberChecklist(c *fiber.Ctx) error {
+	params := new(listMemberChecklistRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	query := new(listMemberChecklistRequestQuery)
+	if err := c.QueryParser(query); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	validate := newValidator()
+	if err := validate.Struct(query); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	if _, err := server.store.GetMember(c.Context(), params.ID); err != nil {
+		if err == sql.ErrNoRows {
+			return c.Status(fiber.StatusNotFound).JSON(newErrorResponse(err))
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	tasks, err := server.store.ListMemberChecklistTasks(c.Context(), db.ListMemberChecklistTasksParams{
+		MemberID: params.ID,
+		Kind:     sql.NullString{String: query.Kind, Valid: len(query.Kind) > 0},
+	})
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(newChecklistTasksResponse(tasks))
+}
+
+// @Summary      List my checklist tasks
+// @Description  Lists the open checklist tasks assigned to the logged-in user, the soonest due first.
+// @Tags         checklists
+// @Success      200 {array} checklistTaskResponse
+// @Failure      500 {object} errorResponse
+// @Router       /users/me/checklist-tasks [get]
+func (server *Server) listMyChecklistTasks(c *fiber.Ctx) error {
+	userID := c.Locals(sessionUserIDKey).(uuid.UUID)
+
+	tasks, err := server.store.ListOpenChecklistTasksByAssignee(c.Context(), uuid.NullUUID{UUID: userID, Valid: true})
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(newChecklistTasksResponse(tasks))
+}
+
+type checklistTaskRequestParams struct {
+	ID uuid.UUID `params:"id"`
+}
+
+// @Summary      Complete checklist task
+// @Description  Marks a task as completed by the logged-in user. Completing a completed task leaves it as it was.
+// @Tags         checklists
+// @Param        id path string true "Task ID"
+// @Success      200 {object} checklistTaskResponse
+// @Failure      400 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /checklist-tasks/{id}/complete [post]
+func (server *Server) completeChecklistTask(c *fiber.Ctx) error {
+	params := new(checklistTaskRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	userID := c.Locals(sessionUserIDKey).(uuid.UUID)
+
+	task, err := server.store.CompleteMemberChecklistTask(c.Context(), db.CompleteMemberChecklistTaskParams{
+		ID:          params.ID,
+		CompletedBy: uuid.NullUUID{UUID: userID, Valid: true},
+	})
+	if err != nil {
+		return c.Status(checklistWriteStatus(err)).JSON(newErrorResponse(err))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(newChecklistTaskResponse(task))
+}
+
+type reassignChecklistTaskRequestBody struct {
+	// AssigneeID is the user the task is assigned to; null leaves the task unassigned.
+	AssigneeID uuid.NullUUID `json:"assignee_id" swaggertype:"string"`
+}
+
+// @Summary      Reassign checklist task
+// @Tags         checklists
+// @Param        id   path string                           true "Task ID"
+// @Param        body body reassignChecklistTaskRequestBody true "Assignee"
+// @Success      200 {object} checklistTaskResponse
+// @Failure      400 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /checklist-tasks/{id}/assignee [put]
+func (server *Server) reassignChecklistTask(c *fiber.Ctx) error {
+	params := new(checklistTaskRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	body := new(reassignChecklistTaskRequestBody)
+	if err := c.BodyParser(body); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	task, err := server.store.ReassignMemberChecklistTask(c.Context(), db.ReassignMemberChecklistTaskParams{
+		ID:         params.ID,
+		AssigneeID: body.AssigneeID,
+	})
+	if err != nil {
+		return c.Status(checklistWriteStatus(err)).JSON(newErrorResponse(err))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(newChecklistTaskResponse(task))
+}
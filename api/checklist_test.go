@@ -0,0 +1,1012 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	mockdb "github.com/ot07/coworker-backend/db/mock"
+	db "github.com/ot07/coworker-backend/db/sqlc"
+	"github.com/ot07/coworker-backend/util"
+	"github.com/stretchr/testify/require"
+)
+
+func randomChecklistTemplateTask(kind string, position int32) db.ChecklistTemplateTask {
+	return db.ChecklistTemplateTask{
+		ID:            util.RandomUUID(),
+		Kind:          kind,
+		Title:         util.RandomName(),
+		Description:   util.RandomName(),
+		AssigneeID:    uuid.NullUUID{UUID: util.RandomUUID(), Valid: true},
+		DueOffsetDays: position * 7,
+		Position:      position,
+	}
+}
+
+func randomChecklistTask(memberID, assigneeID uuid.UUID) db.MemberChecklistTask {
+	return db.MemberChecklistTask{
+		ID:         util.RandomUUID(),
+		MemberID:   memberID,
+		Kind:       db.ChecklistKindOnboarding,
+		Title:      util.RandomName(),
+		AssigneeID: uuid.NullUUID{UUID: assigneeID, Valid: true},
+		DueDate:    time.Date(2023, 4, 7, 0, 0, 0, 0, time.UTC),
+		CreatedAt:  time.Date(2023, 4, 1, 9, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestGetChecklistTemplateAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	tasks := []db.ChecklistTemplateTask{
+		randomChecklistTemplateTask(db.ChecklistKindOnboarding, 0),
+		randomChecklistTemplateTask(db.ChecklistKindOnboarding, 1),
+	}
+
+	testCases := []struct {
+		name          string
+		kind          string
+		setupAuth     func(request *http.Request)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name: "OK",
+			kind: db.ChecklistKindOnboarding,
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ListChecklistTemplateTasks(gomock.Any(), gomock.Eq(db.ChecklistKindOnboarding)).
+					Times(1).
+					Return(tasks, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+				requireBodyMatchChecklistTemplate(t, response.Body, db.ChecklistKindOnboarding, tasks)
+			},
+		},
+		{
+			name: "NoAuthorization",
+			kind: db.ChecklistKindOnboarding,
+			setupAuth: func(request *http.Request) {
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListChecklistTemplateTasks(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusUnauthorized, response.StatusCode)
+			},
+		},
+		{
+			name: "InvalidKind",
+			kind: "training",
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ListChecklistTemplateTasks(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "InternalError",
+			kind: db.ChecklistKindOffboarding,
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ListChecklistTemplateTasks(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.ChecklistTemplateTask{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusInternalServerError, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			url := fmt.Sprintf("/api/v1/checklist-templates/%s", tc.kind)
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			tc.setupAuth(request)
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func TestReplaceChecklistTemplateAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	tasks := []db.ChecklistTemplateTask{
+		randomChecklistTemplateTask(db.ChecklistKindOffboarding, 0),
+		randomChecklistTemplateTask(db.ChecklistKindOffboarding, 1),
+	}
+	tasks[1].AssigneeID = uuid.NullUUID{}
+
+	body := fiber.Map{
+		"tasks": []fiber.Map{
+			{
+				"title":           tasks[0].Title,
+				"description":     tasks[0].Description,
+				"assignee_id":     tasks[0].AssigneeID.UUID,
+				"due_offset_days": tasks[0].DueOffsetDays,
+			},
+			{
+				"title":           tasks[1].Title,
+				"description":     tasks[1].Description,
+				"due_offset_days": tasks[1].DueOffsetDays,
+			},
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		kind          string
+		body          fiber.Map
+		setupAuth     func(request *http.Request)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name: "OK",
+			kind: db.ChecklistKindOffboarding,
+			body: body,
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				args := []db.CreateChecklistTemplateTaskParams{
+					{
+						Title:         tasks[0].Title,
+						Description:   tasks[0].Description,
+						AssigneeID:    tasks[0].AssigneeID,
+						DueOffsetDays: tasks[0].DueOffsetDays,
+					},
+					{
+						Title:         tasks[1].Title,
+						Description:   tasks[1].Description,
+						DueOffsetDays: tasks[1].DueOffsetDays,
+					},
+				}
+
+				store.EXPECT().
+					ReplaceChecklistTemplateTx(gomock.Any(), gomock.Eq(db.ChecklistKindOffboarding), gomock.Eq(args)).
+					Times(1).
+					Return(tasks, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+				requireBodyMatchChecklistTemplate(t, response.Body, db.ChecklistKindOffboarding, tasks)
+			},
+		},
+		{
+			name: "Empty",
+			kind: db.ChecklistKindOnboarding,
+			body: fiber.Map{"tasks": []fiber.Map{}},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ReplaceChecklistTemplateTx(gomock.Any(), gomock.Eq(db.ChecklistKindOnboarding), gomock.Len(0)).
+					Times(1).
+					Return([]db.ChecklistTemplateTask{}, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+				requireBodyMatchChecklistTemplate(t, response.Body, db.ChecklistKindOnboarding, nil)
+			},
+		},
+		{
+			name: "NoAuthorization",
+			kind: db.ChecklistKindOffboarding,
+			body: body,
+			setupAuth: func(request *http.Request) {
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ReplaceChecklistTemplateTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusUnauthorized, response.StatusCode)
+			},
+		},
+		{
+			name: "InvalidKind",
+			kind: "training",
+			body: body,
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ReplaceChecklistTemplateTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "MissingTitle",
+			kind: db.ChecklistKindOffboarding,
+			body: fiber.Map{"tasks": []fiber.Map{{"due_offset_days": 3}}},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ReplaceChecklistTemplateTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "DueOffsetOutOfRange",
+			kind: db.ChecklistKindOffboarding,
+			body: fiber.Map{"tasks": []fiber.Map{{"title": "Return laptop", "due_offset_days": 400}}},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ReplaceChecklistTemplateTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "AssigneeNotFound",
+			kind: db.ChecklistKindOffboarding,
+			body: body,
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ReplaceChecklistTemplateTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil, &pq.Error{Code: "23503"})
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "InternalError",
+			kind: db.ChecklistKindOffboarding,
+			body: body,
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ReplaceChecklistTemplateTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusInternalServerError, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/api/v1/checklist-templates/%s", tc.kind)
+			request, err := http.NewRequest(http.MethodPut, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			request.Header.Set("Content-Type", "application/json")
+
+			tc.setupAuth(request)
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func TestListMemberChecklistAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	member := randomMember()
+	tasks := []db.MemberChecklistTask{
+		randomChecklistTask(member.ID, util.RandomUUID()),
+		randomChecklistTask(member.ID, util.RandomUUID()),
+	}
+	tasks[0].CompletedAt = sql.NullTime{Time: time.Date(2023, 4, 3, 10, 0, 0, 0, time.UTC), Valid: true}
+	tasks[0].CompletedBy = uuid.NullUUID{UUID: session.UserID, Valid: true}
+	tasks[1].OverdueAt = sql.NullTime{Time: time.Date(2023, 4, 8, 0, 0, 0, 0, time.UTC), Valid: true}
+
+	testCases := []struct {
+		name          string
+		memberID      string
+		query         string
+		setupAuth     func(request *http.Request)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name:     "OK",
+			memberID: member.ID.String(),
+			query:    "kind=onboarding",
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					GetMember(gomock.Any(), gomock.Eq(member.ID)).
+					Times(1).
+					Return(member, nil)
+
+				arg := db.ListMemberChecklistTasksParams{
+					MemberID: member.ID,
+					Kind:     sql.NullString{String: db.ChecklistKindOnboarding, Valid: true},
+				}
+				store.EXPECT().
+					ListMemberChecklistTasks(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(tasks, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+
+				got := requireBodyMatchChecklistTasks(t, response.Body, tasks)
+				require.False(t, got[0].Overdue)
+				require.True(t, got[1].Overdue)
+				require.Equal(t, "2023-04-07", got[1].DueDate)
+			},
+		},
+		{
+			name:     "AllKinds",
+			memberID: member.ID.String(),
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					GetMember(gomock.Any(), gomock.Eq(member.ID)).
+					Times(1).
+					Return(member, nil)
+
+				store.EXPECT().
+					ListMemberChecklistTasks(gomock.Any(), gomock.Eq(db.ListMemberChecklistTasksParams{MemberID: member.ID})).
+					Times(1).
+					Return([]db.MemberChecklistTask{}, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+				requireBodyMatchChecklistTasks(t, response.Body, nil)
+			},
+		},
+		{
+			name:     "InvalidKind",
+			memberID: member.ID.String(),
+			query:    "kind=training",
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ListMemberChecklistTasks(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name:     "MemberNotFound",
+			memberID: member.ID.String(),
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					GetMember(gomock.Any(), gomock.Eq(member.ID)).
+					Times(1).
+					Return(db.Member{}, sql.ErrNoRows)
+
+				store.EXPECT().
+					ListMemberChecklistTasks(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusNotFound, response.StatusCode)
+			},
+		},
+		{
+			name:     "InternalError",
+			memberID: member.ID.String(),
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					GetMember(gomock.Any(), gomock.Eq(member.ID)).
+					Times(1).
+					Return(member, nil)
+
+				store.EXPECT().
+					ListMemberChecklistTasks(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.MemberChecklistTask{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusInternalServerError, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			url := fmt.Sprintf("/api/v1/members/%s/checklist?%s", tc.memberID, tc.query)
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			tc.setupAuth(request)
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func TestListMyChecklistTasksAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	tasks := []db.MemberChecklistTask{
+		randomChecklistTask(util.RandomUUID(), session.UserID),
+		randomChecklistTask(util.RandomUUID(), session.UserID),
+	}
+
+	testCases := []struct {
+		name          string
+		setupAuth     func(request *http.Request)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name: "OK",
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ListOpenChecklistTasksByAssignee(gomock.Any(), gomock.Eq(uuid.NullUUID{UUID: session.UserID, Valid: true})).
+					Times(1).
+					Return(tasks, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+				requireBodyMatchChecklistTasks(t, response.Body, tasks)
+			},
+		},
+		{
+			name: "NoAuthorization",
+			setupAuth: func(request *http.Request) {
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListOpenChecklistTasksByAssignee(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusUnauthorized, response.StatusCode)
+			},
+		},
+		{
+			name: "InternalError",
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ListOpenChecklistTasksByAssignee(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.MemberChecklistTask{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusInternalServerError, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			url := "/api/v1/users/me/checklist-tasks"
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			tc.setupAuth(request)
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func TestCompleteChecklistTaskAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	task := randomChecklistTask(util.RandomUUID(), session.UserID)
+	completed := task
+	completed.CompletedAt = sql.NullTime{Time: time.Date(2023, 4, 3, 10, 0, 0, 0, time.UTC), Valid: true}
+	completed.CompletedBy = uuid.NullUUID{UUID: session.UserID, Valid: true}
+
+	testCases := []struct {
+		name          string
+		taskID        string
+		setupAuth     func(request *http.Request)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name:   "OK",
+			taskID: task.ID.String(),
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				arg := db.CompleteMemberChecklistTaskParams{
+					ID:          task.ID,
+					CompletedBy: uuid.NullUUID{UUID: session.UserID, Valid: true},
+				}
+				store.EXPECT().
+					CompleteMemberChecklistTask(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(completed, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+				requireBodyMatchChecklistTask(t, response.Body, completed)
+			},
+		},
+		{
+			name:   "NoAuthorization",
+			taskID: task.ID.String(),
+			setupAuth: func(request *http.Request) {
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CompleteMemberChecklistTask(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusUnauthorized, response.StatusCode)
+			},
+		},
+		{
+			name:   "InvalidID",
+			taskID: "invalid",
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					CompleteMemberChecklistTask(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name:   "NotFound",
+			taskID: task.ID.String(),
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					CompleteMemberChecklistTask(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.MemberChecklistTask{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusNotFound, response.StatusCode)
+			},
+		},
+		{
+			name:   "InternalError",
+			taskID: task.ID.String(),
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					CompleteMemberChecklistTask(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.MemberChecklistTask{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusInternalServerError, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			url := fmt.Sprintf("/api/v1/checklist-tasks/%s/complete", tc.taskID)
+			request, err := http.NewRequest(http.MethodPost, url, nil)
+			require.NoError(t, err)
+
+			tc.setupAuth(request)
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func TestReassignChecklistTaskAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	assigneeID := util.RandomUUID()
+	task := randomChecklistTask(util.RandomUUID(), assigneeID)
+	unassigned := task
+	unassigned.AssigneeID = uuid.NullUUID{}
+
+	testCases := []struct {
+		name          string
+		taskID        string
+		body          fiber.Map
+		setupAuth     func(request *http.Request)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name:   "OK",
+			taskID: task.ID.String(),
+			body:   fiber.Map{"assignee_id": assigneeID},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				arg := db.ReassignMemberChecklistTaskParams{
+					ID:         task.ID,
+					AssigneeID: uuid.NullUUID{UUID: assigneeID, Valid: true},
+				}
+				store.EXPECT().
+					ReassignMemberChecklistTask(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(task, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+				requireBodyMatchChecklistTask(t, response.Body, task)
+			},
+		},
+		{
+			name:   "Unassign",
+			taskID: task.ID.String(),
+			body:   fiber.Map{"assignee_id": nil},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ReassignMemberChecklistTask(gomock.Any(), gomock.Eq(db.ReassignMemberChecklistTaskParams{ID: task.ID})).
+					Times(1).
+					Return(unassigned, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+				requireBodyMatchChecklistTask(t, response.Body, unassigned)
+			},
+		},
+		{
+			name:   "NoAuthorization",
+			taskID: task.ID.String(),
+			body:   fiber.Map{"assignee_id": assigneeID},
+			setupAuth: func(request *http.Request) {
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ReassignMemberChecklistTask(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusUnauthorized, response.StatusCode)
+			},
+		},
+		{
+			name:   "InvalidAssigneeID",
+			taskID: task.ID.String(),
+			body:   fiber.Map{"assignee_id": "invalid"},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ReassignMemberChecklistTask(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name:   "AssigneeNotFound",
+			taskID: task.ID.String(),
+			body:   fiber.Map{"assignee_id": assigneeID},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ReassignMemberChecklistTask(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.MemberChecklistTask{}, &pq.Error{Code: "23503"})
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name:   "NotFound",
+			taskID: task.ID.String(),
+			body:   fiber.Map{"assignee_id": assigneeID},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ReassignMemberChecklistTask(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.MemberChecklistTask{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusNotFound, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/api/v1/checklist-tasks/%s/assignee", tc.taskID)
+			request, err := http.NewRequest(http.MethodPut, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			request.Header.Set("Content-Type", "application/json")
+
+			tc.setupAuth(request)
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func requireBodyMatchChecklistTemplate(t *testing.T, body io.ReadCloser, kind string, tasks []db.ChecklistTemplateTask) {
+	data, err := io.ReadAll(body)
+	require.NoError(t, err)
+
+	var got checklistTemplateResponse
+	err = json.Unmarshal(data, &got)
+	require.NoError(t, err)
+
+	require.Equal(t, kind, got.Kind)
+	require.Len(t, got.Tasks, len(tasks))
+	for i, task := range tasks {
+		require.Equal(t, newChecklistTemplateTaskResponse(task), got.Tasks[i])
+	}
+
+	err = body.Close()
+	require.NoError(t, err)
+}
+
+func requireChecklistTaskResponseMatch(t *testing.T, got checklistTaskResponse, task db.MemberChecklistTask) {
+	require.Equal(t, task.ID, got.ID)
+	require.Equal(t, task.MemberID, got.MemberID)
+	require.Equal(t, task.Kind, got.Kind)
+	require.Equal(t, task.Title, got.Title)
+	require.Equal(t, task.AssigneeID, got.AssigneeID)
+	require.Equal(t, task.DueDate.Format(db.DateLayout), got.DueDate)
+	require.Equal(t, task.CompletedAt.Valid, got.CompletedAt.Valid)
+	require.True(t, task.CompletedAt.Time.Equal(got.CompletedAt.Time))
+	require.Equal(t, task.CompletedBy, got.CompletedBy)
+}
+
+func requireBodyMatchChecklistTask(t *testing.T, body io.ReadCloser, task db.MemberChecklistTask) {
+	data, err := io.ReadAll(body)
+	require.NoError(t, err)
+
+	var got checklistTaskResponse
+	err = json.Unmarshal(data, &got)
+	require.NoError(t, err)
+
+	requireChecklistTaskResponseMatch(t, got, task)
+
+	err = body.Close()
+	require.NoError(t, err)
+}
+
+func requireBodyMatchChecklistTasks(t *testing.T, body io.ReadCloser, tasks []db.MemberChecklistTask) []checklistTaskResponse {
+	data, err := io.ReadAll(body)
+	require.NoError(t, err)
+
+	var got []checklistTaskResponse
+	err = json.Unmarshal(data, &got)
+	require.NoError(t, err)
+
+	require.Len(t, got, len(tasks))
+	for i, task := range tasks {
+		requireChecklistTaskResponseMatch(t, got[i], task)
+	}
+
+	err = body.Close()
+	require.NoError(t, err)
+	return got
+}
@@ -0,0 +1,525 @@
+package api
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	db "github.com/ot07/coworker-backend/db/sqlc"
+)
+
+const (
+	customFieldTypeText        = "text"
+	customFieldTypeNumber      = "number"
+	customFieldTypeDate        = "date"
+	customFieldTypeSelect      = "select"
+	customFieldTypeMultiSelect = "multi_select"
+	customFieldTypeURL         = "url"
+)
+
+const customFieldDateLayout = "2006-01-02"
+
+type customFieldDefinitionResponse struct {
+	ID        uuid.UUID      `json:"id"`
+	Key       string         `json:"key"`
+	Label     string         `json:"label"`
+	Type      string         `json:"type"`
+	Required  bool           `json:"required"`
+	Options   []string       `json:"options"`
+	MinLength db.NullInt32   `json:"min_length" swaggertype:"integer"`
+	MaxLength db.NullInt32   `json:"max_length" swaggertype:"integer"`
+	Pattern   db.NullString  `json:"pattern" swaggertype:"string"`
+	MinValue  db.NullFloat64 `json:"min_value" swaggertype:"number"`
+	MaxValue  db.NullFloat64 `json:"max_value" swaggertype:"number"`
+	CreatedAt time.Time      `json:"created_at"`
+}
+
+func newCustomFieldDefinitionResponse(definition db.CustomFieldDefinition) customFieldDefinitionResponse {
+	options := definition.Options
+	if options == nil {
+		options = []string{}
+	}
+
+	return customFieldDefinitionResponse{
+		ID:        definition.ID,
+		Key:       definition.Key,
+		Label:     definition.Label,
+		Type:      definition.Type,
+		Required:  definition.Required,
+		Options:   options,
+		MinLength: db.NullInt32{NullInt32: definition.MinLength},
+		MaxLength: db.NullInt32{NullInt32: definition.MaxLength},
+		Pattern:   db.NullString{NullString: definition.Pattern},
+		MinValue:  db.NullFloat64{NullFloat64: definition.MinValue},
+		MaxValue:  db.NullFloat64{NullFloat64: definition.MaxValue},
+		CreatedAt: definition.CreatedAt,
+	}
+}
+
+// customFieldRules holds the validation rules of a custom field.
+// Options only apply to select fields, lengths and pattern to text fields and values to number fields.
+type customFieldRules struct {
+	Required  bool           `json:"required"`
+	Options   []string       `json:"options" validate:"omitempty,unique,dive,required"`
+	MinLength db.NullInt32   `json:"min_length" swaggertype:"integer"`
+	MaxLength db.NullInt32   `json:"max_length" swaggertype:"integer"`
+	Pattern   db.NullString  `json:"pattern" swaggertype:"string"`
+	MinValue  db.NullFloat64 `json:"min_value" swaggertype:"number"`
+	MaxValue  db.NullFloat64 `json:"max_value" swaggertype:"number"`
+}
+
+// validate checks that the rules make sense for the given type of field.
+func (rules *customFieldRules) validate(fieldType string) error {
+	isSelect := fieldType == customFieldTypeSelect || fieldType == customFieldTypeMultiSelect
+	if isSelect && len(rules.Options) == 0 {
+		return fmt.Errorf("options are required for %s fields", fieldType)
+	}
+	if !isSelect && len(rules.Options) > 0 {
+		return fmt.Errorf("options are not allowed for %s fields", fieldType)
+	}
+
+	if fieldType != customFieldTypeText && (rules.MinLength.Valid || rules.MaxLength.Valid || rules.Pattern.Valid) {
+		return fmt.Errorf("min_length, max_length and pattern are not allowed for %s fields", fieldType)
+	}
+	if rules.MinLength.Valid && rules.MinLength.Int32 < 0 {
+		return errors.New("min_length cannot be negative")
+	}
+	if rules.MinLength.Valid && rules.MaxLength.Valid && rules.MinLength.Int32 > rules.MaxLength.Int32 {
+		return errors.New("min_length cannot be greater than max_length")
+	}
+	if rules.Pattern.Valid {
+		if _, err := regexp.Compile(rules.Pattern.String); err != nil {
+			return fmt.Errorf("pattern: %w", err)
+		}
+	}
+
+	if fieldType != customFieldTypeNumber && (rules.MinValue.Valid || rules.MaxValue.Valid) {
+		return fmt.Errorf("min_value and max_value are not allowed for %s fields", fieldType)
+	}
+	if rules.MinValue.Valid && rules.MaxValue.Valid && rules.MinValue.Float64 > rules.MaxValue.Float64 {
+		return errors.New("min_value cannot be greater than max_value")
+	}
+
+	return nil
+}
+
+type createCustomFieldDefinitionRequest struct {
+	Key   string `json:"key" validate:"required,max=63,snake_case" example:"employee_number"`
+	Label string `json:"label" validate:"required,max=100" example:"Employee number"`
+	Type  string `json:"type" validate:"required,oneof=text number date select multi_select url" enums:"text,number,date,select,multi_select,url"`
+	customFieldRules
+}
+
+// @Summary      Create custom field
+// @Description  Defines a custom field that members can hold in their custom_fields.
+// @Description  The key and the type of a field cannot be changed afterwards.
+// @Tags         custom-fields
+// @Param        body body createCustomFieldDefinitionRequest true "Custom field definition"
+// @Success      200 {object} customFieldDefinitionResponse
+// @Failure      400 {object} errorResponse
+// @Failure      403 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /custom-fields [post]
+func (server *Server) createCustomFieldDefinition(c *fiber.Ctx) error {
+	req := new(createCustomFieldDefinitionRequest)
+	if err := c.BodyParser(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	validate := newValidator()
+	if err := validate.Struct(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+	if err := req.customFieldRules.validate(req.Type); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	arg := db.CreateCustomFieldDefinitionParams{
+		Key:       req.Key,
+		Label:     req.Label,
+		Type:      req.Type,
+		Required:  req.Required,
+		Options:   req.Options,
+		MinLength: req.MinLength.NullInt32,
+		MaxLength: req.MaxLength.NullInt32,
+		Pattern:   req.Pattern.NullString,
+		MinValue:  req.MinValue.NullFloat64,
+		MaxValue:  req.MaxValue.NullFloat64,
+	}
+	if arg.Options == nil {
+		arg.Options = []string{}
+	}
+
+	definition, err := server.store.CreateCustomFieldDefinition(c.Context(), arg)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok {
+			switch pqErr.Code.Name() {
+			case "unique_violation":
+				return c.Status(fiber.StatusForbidden).JSON(newErrorResponse(err))
+			}
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	rsp := newCustomFieldDefinitionResponse(definition)
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+// @Summary      List custom fields
+// @Tags         custom-fields
+// @Success      200 {array} customFieldDefinitionResponse
+// @Failure      500 {object} errorResponse
+// @Router       /custom-fields [get]
+func (server *Server) listCustomFieldDefinitions(c *fiber.Ctx) error {
+	definitions, err := server.store.ListCustomFieldDefinitions(c.Context())
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	rsp := make([]customFieldDefinitionResponse, 0, len(definitions))
+	for _, definition := range definitions {
+		rsp = append(rsp, newCustomFieldDefinitionResponse(definition))
+	}
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+type updateCustomFieldDefinitionRequestParams struct {
+	ID uuid.UUID `params:"id"`
+}
+
+type updateCustomFieldDefinitionRequestBody struct {
+	Label string `json:"label" validate:"required,max=100" example:"Employee number"`
+	customFieldRules
+}
+
+// @Summary      Update custom field
+// @Description  Replaces the label and the rules of a custom field.
+// @Description  The new rules apply to the values set from then on; values members already hold are kept as they are.
+// @Tags         custom-fields
+// @Param        id   path string                                 true "Custom field ID"
+// @Param        body body updateCustomFieldDefinitionRequestBody true "Custom field definition"
+// @Success      200 {object} customFieldDefinitionResponse
+// @Failure      400 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /custom-fields/{id} [put]
+func (server *Server) updateCustomFieldDefinition(c *fiber.Ctx) error {
+	params := new(updateCustomFieldDefinitionRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	body := new(updateCustomFieldDefinitionRequestBody)
+	if err := c.BodyParser(body); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	validate := newValidator()
+	if err := validate.Struct(body); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	definition, err := server.store.GetCustomFieldDefinition(c.Context(), params.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return c.Status(fiber.StatusNotFound).JSON(newErrorResponse(err))
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	if err := body.customFieldRules.validate(definition.Type); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	arg := db.UpdateCustomFieldDefinitionParams{
+		ID:        params.ID,
+		Label:     body.Label,
+		Required:  body.Required,
+		Options:   body.Options,
+		MinLength: body.MinLength.NullInt32,
+		MaxLength: body.MaxLength.NullInt32,
+		Pattern:   body.Pattern.NullString,
+		MinValue:  body.MinValue.NullFloat64,
+		MaxValue:  body.MaxValue.NullFloat64,
+	}
+	if arg.Options == nil {
+		arg.Options = []string{}
+	}
+
+	definition, err = server.store.UpdateCustomFieldDefinition(c.Context(), arg)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return c.Status(fiber.StatusNotFound).JSON(newErrorResponse(err))
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	rsp := newCustomFieldDefinitionResponse(definition)
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+type deleteCustomFieldDefinitionRequest struct {
+	ID uuid.UUID `params:"id"`
+}
+
+// @Summary      Delete custom field
+// @Description  Deletes a custom field together with the values members hold for it.
+// @Tags         custom-fields
+// @Param        id path string true "Custom field ID"
+// @Success      204 {object} nil
+// @Failure      400 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /custom-fields/{id} [delete]
+func (server *Server) deleteCustomFieldDefinition(c *fiber.Ctx) error {
+	req := new(deleteCustomFieldDefinitionRequest)
+	if err := c.ParamsParser(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	_, err := server.store.DeleteCustomFieldDefinitionTx(c.Context(), req.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return c.Status(fiber.StatusNotFound).JSON(newErrorResponse(err))
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	return c.Status(fiber.StatusNoContent).JSON(nil)
+}
+
+// validateCustomFields checks the custom field values of a member against their definitions
+// and encodes them as a JSON object for the db. Nothing to store is encoded as an empty string.
+// null removes a field from the member. Unless partial is set, as it is when the values
+// are merged into the existing ones, every required field must be given.
+func validateCustomFields(definitions []db.CustomFieldDefinition, values map[string]json.RawMessage, partial bool) (string, error) {
+	byKey := make(map[string]db.CustomFieldDefinition, len(definitions))
+	for _, definition := range definitions {
+		byKey[definition.Key] = definition
+	}
+
+	fields := make(map[string]interface{}, len(values))
+	for key, raw := range values {
+		definition, ok := byKey[key]
+		if !ok {
+			return "", fmt.Errorf("custom_fields.%s: unknown custom field", key)
+		}
+
+		if string(raw) == "null" {
+			if definition.Required {
+				return "", fmt.Errorf("custom_fields.%s: is required", key)
+			}
+			if partial {
+				fields[key] = nil
+			}
+			continue
+		}
+
+		value, err := parseCustomFieldValue(definition, raw)
+		if err != nil {
+			return "", fmt.Errorf("custom_fields.%s: %w", key, err)
+		}
+		fields[key] = value
+	}
+
+	if !partial {
+		for _, definition := range definitions {
+			if _, ok := fields[definition.Key]; definition.Required && !ok {
+				return "", fmt.Errorf("custom_fields.%s: is required", definition.Key)
+			}
+		}
+	}
+
+	if len(fields) == 0 {
+		return "", nil
+	}
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// parseCustomFieldValue decodes a JSON value of a custom field and checks it against the rules of the field.
+func parseCustomFieldValue(definition db.CustomFieldDefinition, raw json.RawMessage) (interface{}, error) {
+	switch definition.Type {
+	case customFieldTypeText:
+		var value string
+		if err := json.Unmarshal(raw, &value); err != nil {
+			return nil, errors.New("must be a string")
+		}
+		length := utf8.RuneCountInString(value)
+		if definition.MinLength.Valid && length < int(definition.MinLength.Int32) {
+			return nil, fmt.Errorf("must be at least %d characters long", definition.MinLength.Int32)
+		}
+		if definition.MaxLength.Valid && length > int(definition.MaxLength.Int32) {
+			return nil, fmt.Errorf("must be at most %d characters long", definition.MaxLength.Int32)
+		}
+		if definition.Pattern.Valid {
+			pattern, err := regexp.Compile(definition.Pattern.String)
+			if err != nil {
+				return nil, err
+			}
+			if !pattern.MatchString(value) {
+				return nil, fmt.Errorf("must match %s", definition.Pattern.String)
+			}
+		}
+		return value, nil
+
+	case customFieldTypeNumber:
+		var value float64
+		if err := json.Unmarshal(raw, &value); err != nil {
+			return nil, errors.New("must be a number")
+		}
+		if definition.MinValue.Valid && value < definition.MinValue.Float64 {
+			return nil, fmt.Errorf("must be at least %v", definition.MinValue.Float64)
+		}
+		if definition.MaxValue.Valid && value > definition.MaxValue.Float64 {
+			return nil, fmt.Errorf("must be at most %v", definition.MaxValue.Float64)
+		}
+		return value, nil
+
+	case customFieldTypeDate:
+		var value string
+		if err := json.Unmarshal(raw, &value); err != nil {
+			return nil, errors.New("must be a date string")
+		}
+		if _, err := time.Parse(customFieldDateLayout, value); err != nil {
+			return nil, errors.New("must be a date in the YYYY-MM-DD format")
+		}
+		return value, nil
+
+	case customFieldTypeSelect:
+		var value string
+		if err := json.Unmarshal(raw, &value); err != nil {
+			return nil, errors.New("must be a string")
+		}
+		if !containsString(definition.Options, value) {
+			return nil, fmt.Errorf("must be one of %s", strings.Join(definition.Options, ", "))
+		}
+		return value, nil
+
+	case customFieldTypeMultiSelect:
+		var values []string
+		if err := json.Unmarshal(raw, &values); err != nil {
+			return nil, errors.New("must be an array of strings")
+		}
+		seen := make(map[string]bool, len(values))
+		for _, value := range values {
+			if !containsString(definition.Options, value) {
+				return nil, fmt.Errorf("must only contain %s", strings.Join(definition.Options, ", "))
+			}
+			if seen[value] {
+				return nil, fmt.Errorf("contains %q more than once", value)
+			}
+			seen[value] = true
+		}
+		if values == nil {
+			values = []string{}
+		}
+		return values, nil
+
+	case customFieldTypeURL:
+		var value string
+		if err := json.Unmarshal(raw, &value); err != nil {
+			return nil, errors.New("must be a string")
+		}
+		u, err := url.ParseRequestURI(value)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || len(u.Host) == 0 {
+			return nil, errors.New("must be an http or https URL")
+		}
+		return value, nil
+	}
+
+	return nil, fmt.Errorf("unknown custom field type %q", definition.Type)
+}
+
+func containsString(values []string, s string) bool {
+	for _, value := range values {
+		if value == s {
+			return true
+		}
+	}
+	return false
+}
+
+// customFieldsQuery collects the custom_fields[<key>]=<value> query parameters filtering members.
+func customFieldsQuery(c *fiber.Ctx) map[string][]string {
+	query := map[string][]string{}
+	c.Context().QueryArgs().VisitAll(func(key, value []byte) {
+		k := string(key)
+		if strings.HasPrefix(k, "custom_fields[") && strings.HasSuffix(k, "]") {
+			k = k[len("custom_fields[") : len(k)-1]
+			query[k] = append(query[k], string(value))
+		}
+	})
+	return query
+}
+
+// customFieldsContainment converts the custom field filters into the JSON object members must contain.
+// A multi_select field matches when it contains every given value; other fields must equal the value.
+func customFieldsContainment(definitions []db.CustomFieldDefinition, query map[string][]string) (json.RawMessage, error) {
+	byKey := make(map[string]db.CustomFieldDefinition, len(definitions))
+	for _, definition := range definitions {
+		byKey[definition.Key] = definition
+	}
+
+	containment := make(map[string]interface{}, len(query))
+	for key, values := range query {
+		definition, ok := byKey[key]
+		if !ok {
+			return nil, fmt.Errorf("custom_fields[%s]: unknown custom field", key)
+		}
+
+		switch definition.Type {
+		case customFieldTypeMultiSelect:
+			containment[key] = values
+		case customFieldTypeNumber:
+			if len(values) > 1 {
+				return nil, fmt.Errorf("custom_fields[%s]: can only be given once", key)
+			}
+			value, err := strconv.ParseFloat(values[0], 64)
+			if err != nil {
+				return nil, fmt.Errorf("custom_fields[%s]: must be a number", key)
+			}
+			containment[key] = value
+		default:
+			if len(values) > 1 {
+				return nil, fmt.Errorf("custom_fields[%s]: can only be given once", key)
+			}
+			containment[key] = values[0]
+		}
+	}
+
+	return json.Marshal(containment)
+}
+
+// customFieldsFilter reads the custom field filters of the request, if any, into the member filter.
+// The returned status tells which status to respond with when an error is returned.
+func (server *Server) customFieldsFilter(c *fiber.Ctx, filter *db.MemberFilter) (int, error) {
+	query := customFieldsQuery(c)
+	if len(query) == 0 {
+		return fiber.StatusOK, nil
+	}
+
+	definitions, err := server.store.ListCustomFieldDefinitions(c.Context())
+	if err != nil {
+		return fiber.StatusInternalServerError, err
+	}
+
+	filter.CustomFields, err = customFieldsContainment(definitions, query)
+	if err != nil {
+		return fiber.StatusBadRequest, err
+	}
+	return fiber.StatusOK, nil
+}
@@ -0,0 +1,769 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/lib/pq"
+	mockdb "github.com/ot07/coworker-backend/db/mock"
+	db "github.com/ot07/coworker-backend/db/sqlc"
+	"github.com/ot07/coworker-backend/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateCustomFieldDefinitionAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	definition := randomCustomFieldDefinition("shirt_size", customFieldTypeSelect, true, "S", "M", "L")
+
+	testCases := []struct {
+		name          string
+		body          fiber.Map
+		setupAuth     func(request *http.Request)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name: "OK",
+			body: fiber.Map{
+				"key":      definition.Key,
+				"label":    definition.Label,
+				"type":     definition.Type,
+				"required": definition.Required,
+				"options":  definition.Options,
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				arg := db.CreateCustomFieldDefinitionParams{
+					Key:      definition.Key,
+					Label:    definition.Label,
+					Type:     definition.Type,
+					Required: definition.Required,
+					Options:  definition.Options,
+				}
+
+				store.EXPECT().
+					CreateCustomFieldDefinition(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(definition, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+				requireBodyMatchCustomFieldDefinition(t, response.Body, definition)
+			},
+		},
+		{
+			name: "NoAuthorization",
+			body: fiber.Map{
+				"key":     definition.Key,
+				"label":   definition.Label,
+				"type":    definition.Type,
+				"options": definition.Options,
+			},
+			setupAuth: func(request *http.Request) {
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateCustomFieldDefinition(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusUnauthorized, response.StatusCode)
+			},
+		},
+		{
+			name: "InvalidKey",
+			body: fiber.Map{
+				"key":     "Shirt Size",
+				"label":   definition.Label,
+				"type":    definition.Type,
+				"options": definition.Options,
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					CreateCustomFieldDefinition(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "InvalidType",
+			body: fiber.Map{
+				"key":   definition.Key,
+				"label": definition.Label,
+				"type":  "boolean",
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					CreateCustomFieldDefinition(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "OptionsNotFound",
+			body: fiber.Map{
+				"key":   definition.Key,
+				"label": definition.Label,
+				"type":  definition.Type,
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					CreateCustomFieldDefinition(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "RuleNotAllowedForType",
+			body: fiber.Map{
+				"key":        definition.Key,
+				"label":      definition.Label,
+				"type":       customFieldTypeNumber,
+				"max_length": 10,
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					CreateCustomFieldDefinition(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "DuplicateKey",
+			body: fiber.Map{
+				"key":     definition.Key,
+				"label":   definition.Label,
+				"type":    definition.Type,
+				"options": definition.Options,
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					CreateCustomFieldDefinition(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.CustomFieldDefinition{}, &pq.Error{Code: "23505"})
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusForbidden, response.StatusCode)
+			},
+		},
+		{
+			name: "InternalError",
+			body: fiber.Map{
+				"key":     definition.Key,
+				"label":   definition.Label,
+				"type":    definition.Type,
+				"options": definition.Options,
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					CreateCustomFieldDefinition(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.CustomFieldDefinition{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusInternalServerError, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := "/api/v1/custom-fields"
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			request.Header.Set("Content-Type", "application/json")
+
+			tc.setupAuth(request)
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func TestListCustomFieldDefinitionsAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	definitions := []db.CustomFieldDefinition{
+		randomCustomFieldDefinition("department", customFieldTypeSelect, true, "Sales", "Engineering"),
+		randomCustomFieldDefinition("employee_number", customFieldTypeText, false),
+	}
+
+	testCases := []struct {
+		name          string
+		setupAuth     func(request *http.Request)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name: "OK",
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ListCustomFieldDefinitions(gomock.Any()).
+					Times(1).
+					Return(definitions, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+
+				data, err := io.ReadAll(response.Body)
+				require.NoError(t, err)
+
+				var got []customFieldDefinitionResponse
+				err = json.Unmarshal(data, &got)
+				require.NoError(t, err)
+				require.Len(t, got, len(definitions))
+				for i, definition := range definitions {
+					requireCustomFieldDefinitionResponseMatch(t, got[i], definition)
+				}
+			},
+		},
+		{
+			name: "NoAuthorization",
+			setupAuth: func(request *http.Request) {
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListCustomFieldDefinitions(gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusUnauthorized, response.StatusCode)
+			},
+		},
+		{
+			name: "InternalError",
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ListCustomFieldDefinitions(gomock.Any()).
+					Times(1).
+					Return([]db.CustomFieldDefinition{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusInternalServerError, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			url := "/api/v1/custom-fields"
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			tc.setupAuth(request)
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func TestUpdateCustomFieldDefinitionAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	definition := randomCustomFieldDefinition("employee_number", customFieldTypeText, false)
+	updated := definition
+	updated.Label = "Staff number"
+	updated.MaxLength = sql.NullInt32{Int32: 8, Valid: true}
+
+	testCases := []struct {
+		name          string
+		definitionID  string
+		body          fiber.Map
+		setupAuth     func(request *http.Request)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name:         "OK",
+			definitionID: definition.ID.String(),
+			body: fiber.Map{
+				"label":      updated.Label,
+				"max_length": 8,
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					GetCustomFieldDefinition(gomock.Any(), gomock.Eq(definition.ID)).
+					Times(1).
+					Return(definition, nil)
+
+				arg := db.UpdateCustomFieldDefinitionParams{
+					ID:        definition.ID,
+					Label:     updated.Label,
+					Options:   []string{},
+					MaxLength: updated.MaxLength,
+				}
+
+				store.EXPECT().
+					UpdateCustomFieldDefinition(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(updated, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+				requireBodyMatchCustomFieldDefinition(t, response.Body, updated)
+			},
+		},
+		{
+			name:         "RuleNotAllowedForType",
+			definitionID: definition.ID.String(),
+			body: fiber.Map{
+				"label":   updated.Label,
+				"options": []string{"A", "B"},
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					GetCustomFieldDefinition(gomock.Any(), gomock.Eq(definition.ID)).
+					Times(1).
+					Return(definition, nil)
+
+				store.EXPECT().
+					UpdateCustomFieldDefinition(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name:         "NotFound",
+			definitionID: definition.ID.String(),
+			body: fiber.Map{
+				"label": updated.Label,
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					GetCustomFieldDefinition(gomock.Any(), gomock.Eq(definition.ID)).
+					Times(1).
+					Return(db.CustomFieldDefinition{}, sql.ErrNoRows)
+
+				store.EXPECT().
+					UpdateCustomFieldDefinition(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusNotFound, response.StatusCode)
+			},
+		},
+		{
+			name:         "LabelNotFound",
+			definitionID: definition.ID.String(),
+			body:         fiber.Map{},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					GetCustomFieldDefinition(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name:         "InvalidID",
+			definitionID: "InvalidID",
+			body: fiber.Map{
+				"label": updated.Label,
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					GetCustomFieldDefinition(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/api/v1/custom-fields/%s", tc.definitionID)
+			request, err := http.NewRequest(http.MethodPut, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			request.Header.Set("Content-Type", "application/json")
+
+			tc.setupAuth(request)
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func TestDeleteCustomFieldDefinitionAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	definition := randomCustomFieldDefinition("employee_number", customFieldTypeText, false)
+
+	testCases := []struct {
+		name          string
+		definitionID  string
+		setupAuth     func(request *http.Request)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name:         "NoContent",
+			definitionID: definition.ID.String(),
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					DeleteCustomFieldDefinitionTx(gomock.Any(), gomock.Eq(definition.ID)).
+					Times(1).
+					Return(definition, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusNoContent, response.StatusCode)
+			},
+		},
+		{
+			name:         "NotFound",
+			definitionID: definition.ID.String(),
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					DeleteCustomFieldDefinitionTx(gomock.Any(), gomock.Eq(definition.ID)).
+					Times(1).
+					Return(db.CustomFieldDefinition{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusNotFound, response.StatusCode)
+			},
+		},
+		{
+			name:         "InternalError",
+			definitionID: definition.ID.String(),
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					DeleteCustomFieldDefinitionTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.CustomFieldDefinition{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusInternalServerError, response.StatusCode)
+			},
+		},
+		{
+			name:         "InvalidID",
+			definitionID: "InvalidID",
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					DeleteCustomFieldDefinitionTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			url := fmt.Sprintf("/api/v1/custom-fields/%s", tc.definitionID)
+			request, err := http.NewRequest(http.MethodDelete, url, nil)
+			require.NoError(t, err)
+
+			tc.setupAuth(request)
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func TestValidateCustomFields(t *testing.T) {
+	t.Parallel()
+
+	text := randomCustomFieldDefinition("employee_number", customFieldTypeText, false)
+	text.MaxLength = sql.NullInt32{Int32: 5, Valid: true}
+	text.Pattern = sql.NullString{String: `^E-\d+$`, Valid: true}
+	number := randomCustomFieldDefinition("grade", customFieldTypeNumber, false)
+	number.MinValue = sql.NullFloat64{Float64: 1, Valid: true}
+	number.MaxValue = sql.NullFloat64{Float64: 5, Valid: true}
+	definitions := []db.CustomFieldDefinition{
+		text,
+		number,
+		randomCustomFieldDefinition("joined_on", customFieldTypeDate, false),
+		randomCustomFieldDefinition("department", customFieldTypeSelect, true, "Sales", "Engineering"),
+		randomCustomFieldDefinition("skills", customFieldTypeMultiSelect, false, "Go", "SQL"),
+		randomCustomFieldDefinition("website", customFieldTypeURL, false),
+	}
+
+	testCases := []struct {
+		name    string
+		values  string
+		partial bool
+		want    string
+		wantErr bool
+	}{
+		{
+			name:   "AllTypes",
+			values: `{"employee_number": "E-12", "grade": 3, "joined_on": "2023-04-01", "department": "Sales", "skills": ["Go", "SQL"], "website": "https://example.com"}`,
+			want:   `{"department":"Sales","employee_number":"E-12","grade":3,"joined_on":"2023-04-01","skills":["Go","SQL"],"website":"https://example.com"}`,
+		},
+		{
+			name:   "NullIsDroppedOnCreate",
+			values: `{"department": "Sales", "grade": null}`,
+			want:   `{"department":"Sales"}`,
+		},
+		{
+			name:    "NullIsKeptWhenPartial",
+			values:  `{"grade": null}`,
+			partial: true,
+			want:    `{"grade":null}`,
+		},
+		{
+			name:    "NothingWhenPartial",
+			values:  `{}`,
+			partial: true,
+			want:    ``,
+		},
+		{name: "RequiredNotFound", values: `{"grade": 3}`, wantErr: true},
+		{name: "RequiredNull", values: `{"department": null}`, partial: true, wantErr: true},
+		{name: "UnknownKey", values: `{"department": "Sales", "shirt_size": "L"}`, wantErr: true},
+		{name: "TextTooLong", values: `{"department": "Sales", "employee_number": "E-1234"}`, wantErr: true},
+		{name: "TextPatternMismatch", values: `{"department": "Sales", "employee_number": "X-1"}`, wantErr: true},
+		{name: "NumberNotNumber", values: `{"department": "Sales", "grade": "3"}`, wantErr: true},
+		{name: "NumberOutOfRange", values: `{"department": "Sales", "grade": 6}`, wantErr: true},
+		{name: "InvalidDate", values: `{"department": "Sales", "joined_on": "2023/04/01"}`, wantErr: true},
+		{name: "UnknownOption", values: `{"department": "Marketing"}`, wantErr: true},
+		{name: "DuplicateOption", values: `{"department": "Sales", "skills": ["Go", "Go"]}`, wantErr: true},
+		{name: "InvalidURL", values: `{"department": "Sales", "website": "javascript:alert(1)"}`, wantErr: true},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var values map[string]json.RawMessage
+			err := json.Unmarshal([]byte(tc.values), &values)
+			require.NoError(t, err)
+
+			got, err := validateCustomFields(definitions, values, tc.partial)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			if len(tc.want) == 0 {
+				require.Empty(t, got)
+				return
+			}
+			require.JSONEq(t, tc.want, got)
+		})
+	}
+}
+
+func randomCustomFieldDefinition(key string, fieldType string, required bool, options ...string) db.CustomFieldDefinition {
+	if options == nil {
+		options = []string{}
+	}
+
+	return db.CustomFieldDefinition{
+		ID:        util.RandomUUID(),
+		Key:       key,
+		Label:     util.RandomName(),
+		Type:      fieldType,
+		Required:  required,
+		Options:   options,
+		CreatedAt: time.Now().UTC().Truncate(time.Second),
+	}
+}
+
+func requireBodyMatchCustomFieldDefinition(t *testing.T, body io.ReadCloser, definition db.CustomFieldDefinition) {
+	data, err := io.ReadAll(body)
+	require.NoError(t, err)
+
+	var got customFieldDefinitionResponse
+	err = json.Unmarshal(data, &got)
+	require.NoError(t, err)
+
+	requireCustomFieldDefinitionResponseMatch(t, got, definition)
+
+	err = body.Close()
+	require.NoError(t, err)
+}
+
+func requireCustomFieldDefinitionResponseMatch(t *testing.T, got customFieldDefinitionResponse, definition db.CustomFieldDefinition) {
+	require.Equal(t, definition.ID, got.ID)
+	require.Equal(t, definition.Key, got.Key)
+	require.Equal(t, definition.Label, got.Label)
+	require.Equal(t, definition.Type, got.Type)
+	require.Equal(t, definition.Required, got.Required)
+	require.Equal(t, definition.Options, got.Options)
+	require.Equal(t, definition.MinLength, got.MinLength.NullInt32)
+	require.Equal(t, definition.MaxLength, got.MaxLength.NullInt32)
+	require.Equal(t, definition.Pattern, got.Pattern.NullString)
+	require.True(t, definition.CreatedAt.Equal(got.CreatedAt))
+}
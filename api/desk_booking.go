@@ -0,0 +1,258 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	db "github.com/ot07/coworker-backend/db/sqlc"
+)
+
+// deskBookingWriteStatus tells which status to respond with for an error booking a desk.
+// A booking overlapping another one of the desk or of the member is refused like other conflicting writes.
+func deskBookingWriteStatus(err error) int {
+	switch {
+	case err == sql.ErrNoRows:
+		return fiber.StatusNotFound
+	case err == db.ErrBookingInPast, errors.Is(err, db.ErrBookingTooFarAhead), errors.Is(err, db.ErrBookingWeeklyLimit):
+		return fiber.StatusBadRequest
+	}
+	if pqErr, ok := err.(*pq.Error); ok {
+		switch pqErr.Code.Name() {
+		case "exclusion_violation":
+			return fiber.StatusForbidden
+		}
+	}
+	return fiber.StatusInternalServerError
+}
+
+// today returns the current day in UTC, as dates are stored.
+func today() time.Time {
+	now := time.Now().UTC()
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+type deskBookingResponse struct {
+	ID       uuid.UUID `json:"id"`
+	DeskID   uuid.UUID `json:"desk_id"`
+	MemberID uuid.UUID `json:"member_id"`
+	Date     string    `json:"date" format:"date"`
+	Slot     string    `json:"slot" enums:"morning,afternoon,full_day"`
+	// CanceledAt is set once the booking is canceled, which frees the desk.
+	CanceledAt db.NullTime `json:"canceled_at" swaggertype:"string" format:"date-time"`
+	CreatedAt  time.Time   `json:"created_at"`
+}
+
+func newDeskBookingResponse(booking db.DeskBooking) deskBookingResponse {
+	return deskBookingResponse{
+		ID:         booking.ID,
+		DeskID:     booking.DeskID,
+		MemberID:   booking.MemberID,
+		Date:       booking.Date.Format(db.DateLayout),
+		Slot:       booking.Slot,
+		CanceledAt: db.NullTime{NullTime: booking.CanceledAt},
+		CreatedAt:  booking.CreatedAt,
+	}
+}
+
+type bookDeskRequest struct {
+	DeskID   uuid.UUID `json:"desk_id" validate:"required"`
+	MemberID uuid.UUID `json:"member_id" validate:"required"`
+	Date     string    `json:"date" validate:"required,datetime=2006-01-02" format:"date"`
+	Slot     string    `json:"slot" validate:"required,oneof=morning afternoon full_day" enums:"morning,afternoon,full_day"`
+}
+
+// @Summary      Book desk
+// @Description  Books a desk for a member for the morning, the afternoon or the full day.
+// @Description  A desk cannot be booked twice at the same time, and neither can a member, which is refused with 403.
+// @Description  The location of the desk limits how many days ahead it can be booked and how many bookings a member can hold within a week.
+// @Tags         desk-bookings
+// @Param        body body bookDeskRequest true "Booking"
+// @Success      200 {object} deskBookingResponse
+// @Failure      400 {object} errorResponse
+// @Failure      403 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /desk-bookings [post]
+func (server *Server) bookDesk(c *fiber.Ctx) error {
+	req := new(bookDeskRequest)
+	if err := c.BodyParser(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	validate := newValidator()
+	if err := validate.Struct(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	date, err := time.Parse(db.DateLayout, req.Date)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	arg := db.BookDeskTxParams{
+		DeskID:   req.DeskID,
+		MemberID: req.MemberID,
+		Date:     date,
+		Slot:     req.Slot,
+		Today:    today(),
+	}
+
+	booking, err := server.store.BookDeskTx(c.Context(), arg)
+	if err != nil {
+		return c.Status(deskBookingWriteStatus(err)).JSON(newErrorResponse(err))
+	}
+
+	rsp := newDeskBookingResponse(booking)
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+type cancelDeskBookingRequest struct {
+	ID uuid.UUID `params:"id"`
+}
+
+// @Summary      Cancel desk booking
+// @Description  Cancels the booking, which frees the desk for others. A booking already canceled is not found.
+// @Tags         desk-bookings
+// @Param        id path string true "Desk booking ID"
+// @Success      200 {object} deskBookingResponse
+// @Failure      400 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /desk-bookings/{id} [delete]
+func (server *Server) cancelDeskBooking(c *fiber.Ctx) error {
+	req := new(cancelDeskBookingRequest)
+	if err := c.ParamsParser(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	booking, err := server.store.CancelDeskBooking(c.Context(), req.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return c.Status(fiber.StatusNotFound).JSON(newErrorResponse(err))
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	rsp := newDeskBookingResponse(booking)
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+type getLocationAvailabilityRequestQuery struct {
+	// Date is the day availability is listed for, today by default.
+	Date string `query:"date" json:"date" validate:"omitempty,datetime=2006-01-02" format:"date"`
+}
+
+type deskAvailabilityResponse struct {
+	ID         uuid.UUID `json:"id"`
+	Name       string    `json:"name"`
+	FloorID    uuid.UUID `json:"floor_id"`
+	FloorName  string    `json:"floor_name"`
+	FloorLevel int32     `json:"floor_level"`
+	// AvailableSlots lists the slots the desk can still be booked for.
+	AvailableSlots []string              `json:"available_slots" enums:"morning,afternoon,full_day"`
+	Bookings       []deskBookingResponse `json:"bookings"`
+}
+
+type locationAvailabilityResponse struct {
+	LocationID uuid.UUID                  `json:"location_id"`
+	Date       string                     `json:"date" format:"date"`
+	Desks      []deskAvailabilityResponse `json:"desks"`
+}
+
+// @Summary      Get location availability
+// @Description  Lists the desks of the location floor by floor for a day, with their bookings and the slots they can still be booked for.
+// @Tags         desk-bookings
+// @Param        id    path  string                              true "Location ID"
+// @Param        query query getLocationAvailabilityRequestQuery true "query"
+// @Success      200 {object} locationAvailabilityResponse
+// @Failure      400 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /locations/{id}/availability [get]
+func (server *Server) getLocationAvailability(c *fiber.Ctx) error {
+	params := new(locationRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	query := new(getLocationAvailabilityRequestQuery)
+	if err := c.QueryParser(query); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	validate := newValidator()
+	if err := validate.Struct(query); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	date := today()
+	if len(query.Date) > 0 {
+		var err error
+		if date, err = time.Parse(db.DateLayout, query.Date); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+		}
+	}
+
+	if _, err := server.store.GetLocation(c.Context(), params.ID); err != nil {
+		if err == sql.ErrNoRows {
+			return c.Status(fiber.StatusNotFound).JSON(newErrorResponse(err))
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	desks, err := server.store.ListLocationDesks(c.Context(), params.ID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	bookings, err := server.store.ListLocationDeskBookings(c.Context(), db.ListLocationDeskBookingsParams{
+		LocationID: params.ID,
+		Date:       date,
+	})
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	bookingsByDesk := make(map[uuid.UUID][]db.DeskBooking)
+	for _, booking := range bookings {
+		bookingsByDesk[booking.DeskID] = append(bookingsByDesk[booking.DeskID], booking)
+	}
+
+	rsp := locationAvailabilityResponse{
+		LocationID: params.ID,
+		Date:       date.Format(db.DateLayout),
+		Desks:      make([]deskAvailabilityResponse, 0, len(desks)),
+	}
+	for _, desk := range desks {
+		availability := deskAvailabilityResponse{
+			ID:             desk.ID,
+			Name:           desk.Name,
+			FloorID:        desk.FloorID,
+			FloorName:      desk.FloorName,
+			FloorLevel:     desk.FloorLevel,
+			AvailableSlots: []string{},
+			Bookings:       make([]deskBookingResponse, 0, len(bookingsByDesk[desk.ID])),
+		}
+		for _, booking := range bookingsByDesk[desk.ID] {
+			availability.Bookings = append(availability.Bookings, newDeskBookingResponse(booking))
+		}
+		for _, slot := range db.DeskSlots {
+			free := true
+			for _, booking := range bookingsByDesk[desk.ID] {
+				if db.DeskSlotsOverlap(slot, booking.Slot) {
+					free = false
+					break
+				}
+			}
+			if free {
+				availability.AvailableSlots = append(availability.AvailableSlots, slot)
+			}
+		}
+		rsp.Desks = append(rsp.Desks, availability)
+	}
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
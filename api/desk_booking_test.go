@@ -0,0 +1,593 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	mockdb "github.com/ot07/coworker-backend/db/mock"
+	db "github.com/ot07/coworker-backend/db/sqlc"
+	"github.com/ot07/coworker-backend/util"
+	"github.com/stretchr/testify/require"
+)
+
+func randomDeskBooking(deskID uuid.UUID, date time.Time, slot string) db.DeskBooking {
+	return db.DeskBooking{
+		ID:        util.RandomUUID(),
+		DeskID:    deskID,
+		MemberID:  util.RandomUUID(),
+		Date:      date,
+		Slot:      slot,
+		CreatedAt: time.Date(2023, 4, 1, 9, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestBookDeskAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	date := today().AddDate(0, 0, 1)
+	booking := randomDeskBooking(util.RandomUUID(), date, db.DeskSlotMorning)
+
+	body := fiber.Map{
+		"desk_id":   booking.DeskID,
+		"member_id": booking.MemberID,
+		"date":      date.Format(db.DateLayout),
+		"slot":      booking.Slot,
+	}
+
+	testCases := []struct {
+		name          string
+		body          fiber.Map
+		setupAuth     func(request *http.Request)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name: "OK",
+			body: body,
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				arg := db.BookDeskTxParams{
+					DeskID:   booking.DeskID,
+					MemberID: booking.MemberID,
+					Date:     date,
+					Slot:     booking.Slot,
+					Today:    today(),
+				}
+
+				store.EXPECT().
+					BookDeskTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(booking, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+				requireBodyMatchDeskBooking(t, response.Body, booking)
+			},
+		},
+		{
+			name: "NoAuthorization",
+			body: body,
+			setupAuth: func(request *http.Request) {
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					BookDeskTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusUnauthorized, response.StatusCode)
+			},
+		},
+		{
+			name: "InvalidSlot",
+			body: fiber.Map{
+				"desk_id":   booking.DeskID,
+				"member_id": booking.MemberID,
+				"date":      date.Format(db.DateLayout),
+				"slot":      "evening",
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					BookDeskTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "InvalidDate",
+			body: fiber.Map{
+				"desk_id":   booking.DeskID,
+				"member_id": booking.MemberID,
+				"date":      "2023/04/01",
+				"slot":      booking.Slot,
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					BookDeskTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "NotFound",
+			body: body,
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					BookDeskTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.DeskBooking{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusNotFound, response.StatusCode)
+			},
+		},
+		{
+			name: "InPast",
+			body: body,
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					BookDeskTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.DeskBooking{}, db.ErrBookingInPast)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "TooFarAhead",
+			body: body,
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					BookDeskTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.DeskBooking{}, fmt.Errorf("%w: at most 14 days ahead", db.ErrBookingTooFarAhead))
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "WeeklyLimit",
+			body: body,
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					BookDeskTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.DeskBooking{}, fmt.Errorf("%w: at most 5 bookings a week", db.ErrBookingWeeklyLimit))
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "Overlap",
+			body: body,
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					BookDeskTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.DeskBooking{}, &pq.Error{Code: "23P01"})
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusForbidden, response.StatusCode)
+			},
+		},
+		{
+			name: "InternalError",
+			body: body,
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					BookDeskTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.DeskBooking{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusInternalServerError, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/api/v1/desk-bookings", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			request.Header.Set("Content-Type", "application/json")
+
+			tc.setupAuth(request)
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func TestCancelDeskBookingAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	booking := randomDeskBooking(util.RandomUUID(), today(), db.DeskSlotFullDay)
+	booking.CanceledAt = sql.NullTime{Time: time.Date(2023, 4, 1, 10, 0, 0, 0, time.UTC), Valid: true}
+
+	testCases := []struct {
+		name          string
+		bookingID     string
+		setupAuth     func(request *http.Request)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name:      "OK",
+			bookingID: booking.ID.String(),
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					CancelDeskBooking(gomock.Any(), gomock.Eq(booking.ID)).
+					Times(1).
+					Return(booking, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+				requireBodyMatchDeskBooking(t, response.Body, booking)
+			},
+		},
+		{
+			name:      "NotFound",
+			bookingID: booking.ID.String(),
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					CancelDeskBooking(gomock.Any(), gomock.Eq(booking.ID)).
+					Times(1).
+					Return(db.DeskBooking{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusNotFound, response.StatusCode)
+			},
+		},
+		{
+			name:      "InvalidID",
+			bookingID: "invalid",
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					CancelDeskBooking(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			url := fmt.Sprintf("/api/v1/desk-bookings/%s", tc.bookingID)
+			request, err := http.NewRequest(http.MethodDelete, url, nil)
+			require.NoError(t, err)
+
+			tc.setupAuth(request)
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func TestGetLocationAvailabilityAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	location := randomLocation()
+	floor := randomFloor(location, 2)
+	date := time.Date(2023, 4, 3, 0, 0, 0, 0, time.UTC)
+
+	desks := make([]db.ListLocationDesksRow, 3)
+	for i := range desks {
+		desk := randomDesk(floor)
+		desks[i] = db.ListLocationDesksRow{
+			ID:         desk.ID,
+			Name:       desk.Name,
+			FloorID:    floor.ID,
+			FloorName:  floor.Name,
+			FloorLevel: floor.Level,
+		}
+	}
+	bookings := []db.DeskBooking{
+		randomDeskBooking(desks[0].ID, date, db.DeskSlotMorning),
+		randomDeskBooking(desks[1].ID, date, db.DeskSlotFullDay),
+	}
+
+	testCases := []struct {
+		name          string
+		query         string
+		setupAuth     func(request *http.Request)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name:  "OK",
+			query: "?date=2023-04-03",
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					GetLocation(gomock.Any(), gomock.Eq(location.ID)).
+					Times(1).
+					Return(location, nil)
+				store.EXPECT().
+					ListLocationDesks(gomock.Any(), gomock.Eq(location.ID)).
+					Times(1).
+					Return(desks, nil)
+				store.EXPECT().
+					ListLocationDeskBookings(gomock.Any(), gomock.Eq(db.ListLocationDeskBookingsParams{LocationID: location.ID, Date: date})).
+					Times(1).
+					Return(bookings, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+
+				data, err := io.ReadAll(response.Body)
+				require.NoError(t, err)
+
+				var got locationAvailabilityResponse
+				err = json.Unmarshal(data, &got)
+				require.NoError(t, err)
+
+				require.Equal(t, location.ID, got.LocationID)
+				require.Equal(t, "2023-04-03", got.Date)
+				require.Len(t, got.Desks, len(desks))
+				for i, desk := range desks {
+					require.Equal(t, desk.ID, got.Desks[i].ID)
+					require.Equal(t, desk.FloorName, got.Desks[i].FloorName)
+				}
+
+				require.Equal(t, []string{db.DeskSlotAfternoon}, got.Desks[0].AvailableSlots)
+				require.Len(t, got.Desks[0].Bookings, 1)
+				require.Equal(t, bookings[0].ID, got.Desks[0].Bookings[0].ID)
+				require.Empty(t, got.Desks[1].AvailableSlots)
+				require.Equal(t, db.DeskSlots, got.Desks[2].AvailableSlots)
+				require.Empty(t, got.Desks[2].Bookings)
+			},
+		},
+		{
+			name: "Today",
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					GetLocation(gomock.Any(), gomock.Eq(location.ID)).
+					Times(1).
+					Return(location, nil)
+				store.EXPECT().
+					ListLocationDesks(gomock.Any(), gomock.Eq(location.ID)).
+					Times(1).
+					Return([]db.ListLocationDesksRow{}, nil)
+				store.EXPECT().
+					ListLocationDeskBookings(gomock.Any(), gomock.Eq(db.ListLocationDeskBookingsParams{LocationID: location.ID, Date: today()})).
+					Times(1).
+					Return([]db.DeskBooking{}, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+			},
+		},
+		{
+			name:  "InvalidDate",
+			query: "?date=04-03-2023",
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					GetLocation(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name:  "LocationNotFound",
+			query: "?date=2023-04-03",
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					GetLocation(gomock.Any(), gomock.Eq(location.ID)).
+					Times(1).
+					Return(db.Location{}, sql.ErrNoRows)
+				store.EXPECT().
+					ListLocationDesks(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusNotFound, response.StatusCode)
+			},
+		},
+		{
+			name:  "InternalError",
+			query: "?date=2023-04-03",
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					GetLocation(gomock.Any(), gomock.Eq(location.ID)).
+					Times(1).
+					Return(location, nil)
+				store.EXPECT().
+					ListLocationDesks(gomock.Any(), gomock.Eq(location.ID)).
+					Times(1).
+					Return(desks, nil)
+				store.EXPECT().
+					ListLocationDeskBookings(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.DeskBooking{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusInternalServerError, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			url := fmt.Sprintf("/api/v1/locations/%s/availability%s", location.ID, tc.query)
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			tc.setupAuth(request)
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func requireBodyMatchDeskBooking(t *testing.T, body io.ReadCloser, booking db.DeskBooking) {
+	data, err := io.ReadAll(body)
+	require.NoError(t, err)
+
+	var got deskBookingResponse
+	err = json.Unmarshal(data, &got)
+	require.NoError(t, err)
+
+	require.Equal(t, booking.ID, got.ID)
+	require.Equal(t, booking.DeskID, got.DeskID)
+	require.Equal(t, booking.MemberID, got.MemberID)
+	require.Equal(t, booking.Date.Format(db.DateLayout), got.Date)
+	require.Equal(t, booking.Slot, got.Slot)
+	require.Equal(t, booking.CanceledAt.Valid, got.CanceledAt.Valid)
+	require.True(t, booking.CanceledAt.Time.Equal(got.CanceledAt.Time))
+
+	err = body.Close()
+	require.NoError(t, err)
+}
@@ -0,0 +1,39 @@
+package api
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+var errIfMatchRequired = errors.New("If-Match header is required")
+
+// versionETag formats a row version as a strong entity tag.
+func versionETag(version int32) string {
+	return `"` + strconv.FormatInt(int64(version), 10) + `"`
+}
+
+// parseIfMatch returns the versions listed in an If-Match header.
+// A nil slice means that any version matches, either because the header is "*" or because it is absent.
+// Weak and unknown entity tags never match under the strong comparison required by If-Match,
+// so they are left out, possibly leaving an empty non-nil slice that no version matches.
+func parseIfMatch(header string) []int32 {
+	header = strings.TrimSpace(header)
+	if len(header) == 0 || header == "*" {
+		return nil
+	}
+
+	versions := []int32{}
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimSpace(tag)
+		if len(tag) < 2 || tag[0] != '"' || tag[len(tag)-1] != '"' {
+			continue
+		}
+		version, err := strconv.ParseInt(tag[1:len(tag)-1], 10, 32)
+		if err != nil {
+			continue
+		}
+		versions = append(versions, int32(version))
+	}
+	return versions
+}
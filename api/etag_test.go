@@ -0,0 +1,34 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseIfMatch(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		header string
+		want   []int32
+	}{
+		{header: "", want: nil},
+		{header: "*", want: nil},
+		{header: `"3"`, want: []int32{3}},
+		{header: `"3", "5"`, want: []int32{3, 5}},
+		{header: `W/"3"`, want: []int32{}},
+		{header: `"abc"`, want: []int32{}},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.want, parseIfMatch(tc.header), tc.header)
+	}
+}
+
+func TestVersionETag(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, `"42"`, versionETag(42))
+	require.Equal(t, []int32{42}, parseIfMatch(versionETag(42)))
+}
@@ -0,0 +1,739 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"math"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	db "github.com/ot07/coworker-backend/db/sqlc"
+)
+
+const (
+	// maxLeaveSpan is how long a single leave can last.
+	maxLeaveSpan = 366 * 24 * time.Hour
+	// defaultLeaveCalendarRange is how far the team calendar goes when no end is given.
+	defaultLeaveCalendarRange = 31 * 24 * time.Hour
+	// maxLeaveCalendarRange is how far the team calendar can go.
+	maxLeaveCalendarRange = 366 * 24 * time.Hour
+)
+
+var (
+	errLeaveEndBeforeStart       = errors.New("a leave cannot end before it starts")
+	errLeaveTooLong              = errors.New("a leave cannot last longer than 366 days")
+	errLeaveRequestNotCancelable = errors.New("only a pending or approved leave request can be canceled")
+	errLeaveCalendarRange        = errors.New("the calendar must end after it starts, within 366 days")
+)
+
+// leaveTypeWriteStatus tells which status to respond with for an error writing a leave type.
+// A leave type cannot be deleted while leave requests use it.
+func leaveTypeWriteStatus(err error) int {
+	if err == sql.ErrNoRows {
+		return fiber.StatusNotFound
+	}
+	if pqErr, ok := err.(*pq.Error); ok {
+		switch pqErr.Code.Name() {
+		case "unique_violation", "foreign_key_violation":
+			return fiber.StatusForbidden
+		}
+	}
+	return fiber.StatusInternalServerError
+}
+
+// leaveRequestWriteStatus tells which status to respond with for an error requesting or deciding on a leave.
+// A leave overlapping another active leave of the member is refused like other conflicting writes.
+func leaveRequestWriteStatus(err error) int {
+	switch {
+	case err == sql.ErrNoRows:
+		return fiber.StatusNotFound
+	case err == db.ErrNoLeaveDays:
+		return fiber.StatusBadRequest
+	case errors.Is(err, db.ErrInsufficientLeaveBalance), errors.Is(err, db.ErrLeaveRequestDecided), err == db.ErrNotLeaveApprover:
+		return fiber.StatusForbidden
+	}
+	if pqErr, ok := err.(*pq.Error); ok {
+		switch pqErr.Code.Name() {
+		case "exclusion_violation":
+			return fiber.StatusForbidden
+		case "check_violation":
+			return fiber.StatusBadRequest
+		}
+	}
+	return fiber.StatusInternalServerError
+}
+
+type leaveTypeResponse struct {
+	ID   uuid.UUID `json:"id"`
+	Name string    `json:"name"`
+	// AnnualAllowance is how many days a member can take in a year, null for a leave type that is not counted.
+	AnnualAllowance db.NullFloat64 `json:"annual_allowance" swaggertype:"number"`
+	// MaxCarryOver is how many days left at the end of a year can be taken the year after.
+	MaxCarryOver float64   `json:"max_carry_over"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+func newLeaveTypeResponse(leaveType db.LeaveType) leaveTypeResponse {
+	return leaveTypeResponse{
+		ID:              leaveType.ID,
+		Name:            leaveType.Name,
+		AnnualAllowance: db.NullFloat64{NullFloat64: leaveType.AnnualAllowance},
+		MaxCarryOver:    leaveType.MaxCarryOver,
+		CreatedAt:       leaveType.CreatedAt,
+	}
+}
+
+type leaveTypeRequest struct {
+	Name string `json:"name" validate:"required,max=100" example:"vacation"`
+	// AnnualAllowance is how many days a member can take in a year. Leaves of a type without one are not counted.
+	AnnualAllowance *float64 `json:"annual_allowance" validate:"omitempty,min=0,max=366" example:"20"`
+	// MaxCarryOver is how many days left at the end of a year can be taken the year after, none by default.
+	MaxCarryOver float64 `json:"max_carry_over" validate:"min=0,max=366" example:"5"`
+}
+
+func (req *leaveTypeRequest) annualAllowance() sql.NullFloat64 {
+	if req.AnnualAllowance == nil {
+		return sql.NullFloat64{}
+	}
+	return sql.NullFloat64{Float64: *req.AnnualAllowance, Valid: true}
+}
+
+// @Summary      Create leave type
+// @Description  A leave type, such as vacation, sick or remote, is counted against an annual allowance when it has one.
+// @Tags         leaves
+// @Param        body body leaveTypeRequest true "Leave type object"
+// @Success      200 {object} leaveTypeResponse
+// @Failure      400 {object} errorResponse
+// @Failure      403 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /leave-types [post]
+func (server *Server) createLeaveType(c *fiber.Ctx) error {
+	req := new(leaveTypeRequest)
+	if err := c.BodyParser(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	validate := newValidator()
+	if err := validate.Struct(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	arg := db.CreateLeaveTypeParams{
+		Name:            req.Name,
+		AnnualAllowance: req.annualAllowance(),
+		MaxCarryOver:    req.MaxCarryOver,
+	}
+
+	leaveType, err := server.store.CreateLeaveType(c.Context(), arg)
+	if err != nil {
+		return c.Status(leaveTypeWriteStatus(err)).JSON(newErrorResponse(err))
+	}
+
+	rsp := newLeaveTypeResponse(leaveType)
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+// @Summary      List leave types
+// @Tags         leaves
+// @Success      200 {array} leaveTypeResponse
+// @Failure      500 {object} errorResponse
+// @Router       /leave-types [get]
+func (server *Server) listLeaveTypes(c *fiber.Ctx) error {
+	leaveTypes, err := server.store.ListLeaveTypes(c.Context())
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	rsp := make([]leaveTypeResponse, 0, len(leaveTypes))
+	for _, leaveType := range leaveTypes {
+		rsp = append(rsp, newLeaveTypeResponse(leaveType))
+	}
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+type leaveTypeRequestParams struct {
+	ID uuid.UUID `params:"id"`
+}
+
+// @Summary      Update leave type
+// @Description  Replaces the name and the allowance of the leave type. Balances are computed again with the new allowance.
+// @Tags         leaves
+// @Param        id   path string           true "Leave type ID"
+// @Param        body body leaveTypeRequest true "Leave type object"
+// @Success      200 {object} leaveTypeResponse
+// @Failure      400 {object} errorResponse
+// @Failure      403 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /leave-types/{id} [put]
+func (server *Server) updateLeaveType(c *fiber.Ctx) error {
+	params := new(leaveTypeRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	req := new(leaveTypeRequest)
+	if err := c.BodyParser(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	validate := newValidator()
+	if err := validate.Struct(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	arg := db.UpdateLeaveTypeParams{
+		ID:              params.ID,
+		Name:            req.Name,
+		AnnualAllowance: req.annualAllowance(),
+		MaxCarryOver:    req.MaxCarryOver,
+	}
+
+	leaveType, err := server.store.UpdateLeaveType(c.Context(), arg)
+	if err != nil {
+		return c.Status(leaveTypeWriteStatus(err)).JSON(newErrorResponse(err))
+	}
+
+	rsp := newLeaveTypeResponse(leaveType)
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+// @Summary      Delete leave type
+// @Description  A leave type cannot be deleted while leave requests use it.
+// @Tags         leaves
+// @Param        id path string true "Leave type ID"
+// @Success      204
+// @Failure      400 {object} errorResponse
+// @Failure      403 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /leave-types/{id} [delete]
+func (server *Server) deleteLeaveType(c *fiber.Ctx) error {
+	params := new(leaveTypeRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	if _, err := server.store.DeleteLeaveType(c.Context(), params.ID); err != nil {
+		return c.Status(leaveTypeWriteStatus(err)).JSON(newErrorResponse(err))
+	}
+
+	return c.Status(fiber.StatusNoContent).JSON(nil)
+}
+
+type leaveRequestResponse struct {
+	ID          uuid.UUID `json:"id"`
+	MemberID    uuid.UUID `json:"member_id"`
+	LeaveTypeID uuid.UUID `json:"leave_type_id"`
+	StartDate   string    `json:"start_date" format:"date"`
+	// StartHalfDay tells that the leave starts at noon.
+	StartHalfDay bool   `json:"start_half_day"`
+	EndDate      string `json:"end_date" format:"date"`
+	// EndHalfDay tells that the leave ends at noon.
+	EndHalfDay bool `json:"end_half_day"`
+	// Days is how many working days the leave takes.
+	Days         float64       `json:"days"`
+	Status       string        `json:"status" enums:"pending,approved,rejected,canceled"`
+	Reason       string        `json:"reason"`
+	DecidedBy    uuid.NullUUID `json:"decided_by" swaggertype:"string"`
+	DecidedAt    db.NullTime   `json:"decided_at" swaggertype:"string" format:"date-time"`
+	DecisionNote string        `json:"decision_note"`
+	CreatedAt    time.Time     `json:"created_at"`
+}
+
+func newLeaveRequestResponse(request db.LeaveRequest) leaveRequestResponse {
+	return leaveRequestResponse{
+		ID:           request.ID,
+		MemberID:     request.MemberID,
+		LeaveTypeID:  request.LeaveTypeID,
+		StartDate:    request.StartDate.Format(db.DateLayout),
+		StartHalfDay: request.StartHalfDay,
+		EndDate:      request.EndDate.Format(db.DateLayout),
+		EndHalfDay:   request.EndHalfDay,
+		Days:         request.Days,
+		Status:       request.Status,
+		Reason:       request.Reason,
+		DecidedBy:    request.DecidedBy,
+		DecidedAt:    db.NullTime{NullTime: request.DecidedAt},
+		DecisionNote: request.DecisionNote,
+		CreatedAt:    request.CreatedAt,
+	}
+}
+
+type createLeaveRequestRequest struct {
+	MemberID    uuid.UUID `json:"member_id" validate:"required"`
+	LeaveTypeID uuid.UUID `json:"leave_type_id" validate:"required"`
+	StartDate   string    `json:"start_date" validate:"required,datetime=2006-01-02" format:"date"`
+	// StartHalfDay starts the leave at noon.
+	StartHalfDay bool   `json:"start_half_day"`
+	EndDate      string `json:"end_date" validate:"required,datetime=2006-01-02" format:"date"`
+	// EndHalfDay ends the leave at noon.
+	EndHalfDay bool   `json:"end_half_day"`
+	Reason     string `json:"reason" validate:"max=500"`
+}
+
+// @Summary      Create leave request
+// @Description  Requests a leave for the member, pending until the manager of the member or an admin decides on it.
+// @Description  Only working days, from Monday to Friday, are counted. A leave counted against an allowance is refused
+// @Description  when the balance of its year, less the pending leaves, cannot take it.
+// @Tags         leaves
+// @Param        body body createLeaveRequestRequest true "Leave request object"
+// @Success      200 {object} leaveRequestResponse
+// @Failure      400 {object} errorResponse
+// @Failure      403 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /leave-requests [post]
+func (server *Server) createLeaveRequest(c *fiber.Ctx) error {
+	req := new(createLeaveRequestRequest)
+	if err := c.BodyParser(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	validate := newValidator()
+	if err := validate.Struct(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	startDate, err := time.Parse(db.DateLayout, req.StartDate)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+	endDate, err := time.Parse(db.DateLayout, req.EndDate)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+	if endDate.Before(startDate) {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(errLeaveEndBeforeStart))
+	}
+	if endDate.Sub(startDate) >= maxLeaveSpan {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(errLeaveTooLong))
+	}
+
+	arg := db.CreateLeaveRequestParams{
+		MemberID:     req.MemberID,
+		LeaveTypeID:  req.LeaveTypeID,
+		StartDate:    startDate,
+		StartHalfDay: req.StartHalfDay,
+		EndDate:      endDate,
+		EndHalfDay:   req.EndHalfDay,
+		Reason:       req.Reason,
+	}
+
+	request, err := server.store.CreateLeaveRequestTx(c.Context(), arg)
+	if err != nil {
+		return c.Status(leaveRequestWriteStatus(err)).JSON(newErrorResponse(err))
+	}
+
+	rsp := newLeaveRequestResponse(request)
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+type listLeaveRequestsRequest struct {
+	PageID   int32  `query:"page_id" json:"page_id" validate:"required,min=1"`
+	PageSize int32  `query:"page_size" json:"page_size" validate:"required,min=5,max=50"`
+	MemberID string `query:"member_id" json:"member_id" validate:"omitempty,uuid"`
+	Status   string `query:"status" json:"status" validate:"omitempty,oneof=pending approved rejected canceled" enums:"pending,approved,rejected,canceled"`
+}
+
+type listLeaveRequestsResponse struct {
+	Meta listMembersResponseMeta `json:"meta"`
+	Data []leaveRequestResponse  `json:"data"`
+}
+
+// @Summary      List leave requests
+// @Description  Lists the leave requests, latest start first, optionally those of a member or with a status.
+// @Tags         leaves
+// @Param        query query listLeaveRequestsRequest true "query"
+// @Success      200 {object} listLeaveRequestsResponse
+// @Failure      400 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /leave-requests [get]
+func (server *Server) listLeaveRequests(c *fiber.Ctx) error {
+	req := new(listLeaveRequestsRequest)
+	if err := c.QueryParser(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	validate := newValidator()
+	if err := validate.Struct(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	filter := db.CountLeaveRequestsParams{
+		Status: sql.NullString{String: req.Status, Valid: len(req.Status) > 0},
+	}
+	if len(req.MemberID) > 0 {
+		memberID, err := uuid.Parse(req.MemberID)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+		}
+		filter.MemberID = uuid.NullUUID{UUID: memberID, Valid: true}
+	}
+
+	requests, err := server.store.ListLeaveRequests(c.Context(), db.ListLeaveRequestsParams{
+		Limit:    req.PageSize,
+		Offset:   (req.PageID - 1) * req.PageSize,
+		MemberID: filter.MemberID,
+		Status:   filter.Status,
+	})
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	totalCount, err := server.store.CountLeaveRequests(c.Context(), filter)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	pageCount := int64(math.Ceil(float64(totalCount) / float64(req.PageSize)))
+
+	data := make([]leaveRequestResponse, 0, len(requests))
+	for _, request := range requests {
+		data = append(data, newLeaveRequestResponse(request))
+	}
+
+	rsp := listLeaveRequestsResponse{
+		Meta: listMembersResponseMeta{
+			PageID:     req.PageID,
+			PageSize:   req.PageSize,
+			PageCount:  pageCount,
+			TotalCount: totalCount,
+		},
+		Data: data,
+	}
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+type leaveRequestRequestParams struct {
+	ID uuid.UUID `params:"id"`
+}
+
+// @Summary      Get leave request
+// @Tags         leaves
+// @Param        id path string true "Leave request ID"
+// @Success      200 {object} leaveRequestResponse
+// @Failure      400 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /leave-requests/{id} [get]
+func (server *Server) getLeaveRequest(c *fiber.Ctx) error {
+	params := new(leaveRequestRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	request, err := server.store.GetLeaveRequest(c.Context(), params.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return c.Status(fiber.StatusNotFound).JSON(newErrorResponse(err))
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	rsp := newLeaveRequestResponse(request)
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+type decideLeaveRequestRequest struct {
+	Note string `json:"note" validate:"max=500"`
+}
+
+// @Summary      Approve leave request
+// @Description  Approves a pending leave request. Only the manager of the member, the user with the email of the manager,
+// @Description  or an admin can approve it, and only while the balance of its leave type can take it.
+// @Tags         leaves
+// @Param        id   path string                    true  "Leave request ID"
+// @Param        body body decideLeaveRequestRequest false "Decision object"
+// @Success      200 {object} leaveRequestResponse
+// @Failure      400 {object} errorResponse
+// @Failure      403 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /leave-requests/{id}/approve [post]
+func (server *Server) approveLeaveRequest(c *fiber.Ctx) error {
+	return server.decideLeaveRequest(c, db.LeaveStatusApproved)
+}
+
+// @Summary      Reject leave request
+// @Description  Rejects a pending leave request. Only the manager of the member, the user with the email of the manager,
+// @Description  or an admin can reject it.
+// @Tags         leaves
+// @Param        id   path string                    true  "Leave request ID"
+// @Param        body body decideLeaveRequestRequest false "Decision object"
+// @Success      200 {object} leaveRequestResponse
+// @Failure      400 {object} errorResponse
+// @Failure      403 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /leave-requests/{id}/reject [post]
+func (server *Server) rejectLeaveRequest(c *fiber.Ctx) error {
+	return server.decideLeaveRequest(c, db.LeaveStatusRejected)
+}
+
+// decideLeaveRequest sets the status of a pending leave request on behalf of the logged-in user.
+func (server *Server) decideLeaveRequest(c *fiber.Ctx, status string) error {
+	params := new(leaveRequestRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	req := new(decideLeaveRequestRequest)
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(req); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+		}
+	}
+
+	validate := newValidator()
+	if err := validate.Struct(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	request, err := server.store.DecideLeaveRequestTx(c.Context(), db.DecideLeaveRequestTxParams{
+		ID:           params.ID,
+		UserID:       c.Locals(sessionUserIDKey).(uuid.UUID),
+		Status:       status,
+		DecisionNote: req.Note,
+	})
+	if err != nil {
+		return c.Status(leaveRequestWriteStatus(err)).JSON(newErrorResponse(err))
+	}
+
+	rsp := newLeaveRequestResponse(request)
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+// @Summary      Cancel leave request
+// @Description  Cancels a pending or approved leave request, giving its days back to the balance.
+// @Tags         leaves
+// @Param        id path string true "Leave request ID"
+// @Success      200 {object} leaveRequestResponse
+// @Failure      400 {object} errorResponse
+// @Failure      403 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /leave-requests/{id}/cancel [post]
+func (server *Server) cancelLeaveRequest(c *fiber.Ctx) error {
+	params := new(leaveRequestRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	if _, err := server.store.GetLeaveRequest(c.Context(), params.ID); err != nil {
+		if err == sql.ErrNoRows {
+			return c.Status(fiber.StatusNotFound).JSON(newErrorResponse(err))
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	// No row is canceled when the request was already rejected or canceled.
+	request, err := server.store.CancelLeaveRequest(c.Context(), params.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return c.Status(fiber.StatusForbidden).JSON(newErrorResponse(errLeaveRequestNotCancelable))
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	rsp := newLeaveRequestResponse(request)
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+type leaveBalanceResponse struct {
+	LeaveTypeID   uuid.UUID `json:"leave_type_id"`
+	LeaveTypeName string    `json:"leave_type_name"`
+	Year          int       `json:"year"`
+	Allowance     float64   `json:"allowance"`
+	// CarriedOver is what was left of the year before, up to the maximum carry-over of the leave type.
+	CarriedOver float64 `json:"carried_over"`
+	// Used counts the days of the approved leaves, and Pending those of the leaves waiting for a decision.
+	Used    float64 `json:"used"`
+	Pending float64 `json:"pending"`
+	// Remaining is allowance + carried_over - used.
+	Remaining float64 `json:"remaining"`
+}
+
+func newLeaveBalanceResponse(balance db.LeaveBalance) leaveBalanceResponse {
+	return leaveBalanceResponse{
+		LeaveTypeID:   balance.LeaveType.ID,
+		LeaveTypeName: balance.LeaveType.Name,
+		Year:          balance.Year,
+		Allowance:     balance.Allowance,
+		CarriedOver:   balance.CarriedOver,
+		Used:          balance.Used,
+		Pending:       balance.Pending,
+		Remaining:     balance.Remaining,
+	}
+}
+
+type leaveMemberRequestParams struct {
+	ID uuid.UUID `params:"id"`
+}
+
+type listLeaveBalancesRequestQuery struct {
+	// Year is the year of the balances, the current year in UTC by default.
+	Year int `query:"year" json:"year" validate:"omitempty,min=2000,max=2100" example:"2023"`
+}
+
+// @Summary      List member leave balances
+// @Description  Lists the balances of the member in a year, for every leave type counted against an annual allowance.
+// @Tags         leaves
+// @Param        id    path  string                        true "Member ID"
+// @Param        query query listLeaveBalancesRequestQuery true "query"
+// @Success      200 {array} leaveBalanceResponse
+// @Failure      400 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /members/{id}/leave-balances [get]
+func (server *Server) listMemberLeaveBalances(c *fiber.Ctx) error {
+	params := new(leaveMemberRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	query := new(listLeaveBalancesRequestQuery)
+	if err := c.QueryParser(query); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	validate := newValidator()
+	if err := validate.Struct(query); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	year := query.Year
+	if year == 0 {
+		year = today().Year()
+	}
+
+	balances, err := server.store.ListLeaveBalances(c.Context(), params.ID, year)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return c.Status(fiber.StatusNotFound).JSON(newErrorResponse(err))
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	rsp := make([]leaveBalanceResponse, 0, len(balances))
+	for _, balance := range balances {
+		rsp = append(rsp, newLeaveBalanceResponse(balance))
+	}
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+type teamLeaveResponse struct {
+	ID            uuid.UUID `json:"id"`
+	MemberID      uuid.UUID `json:"member_id"`
+	FirstName     string    `json:"first_name"`
+	LastName      string    `json:"last_name"`
+	LeaveTypeID   uuid.UUID `json:"leave_type_id"`
+	LeaveTypeName string    `json:"leave_type_name"`
+	StartDate     string    `json:"start_date" format:"date"`
+	StartHalfDay  bool      `json:"start_half_day"`
+	EndDate       string    `json:"end_date" format:"date"`
+	EndHalfDay    bool      `json:"end_half_day"`
+	Status        string    `json:"status" enums:"pending,approved"`
+}
+
+func newTeamLeaveResponse(row db.ListTeamLeaveRequestsRow) teamLeaveResponse {
+	return teamLeaveResponse{
+		ID:            row.ID,
+		MemberID:      row.MemberID,
+		FirstName:     row.FirstName,
+		LastName:      row.LastName,
+		LeaveTypeID:   row.LeaveTypeID,
+		LeaveTypeName: row.LeaveTypeName,
+		StartDate:     row.StartDate.Format(db.DateLayout),
+		StartHalfDay:  row.StartHalfDay,
+		EndDate:       row.EndDate.Format(db.DateLayout),
+		EndHalfDay:    row.EndHalfDay,
+		Status:        row.Status,
+	}
+}
+
+type getTeamLeaveCalendarRequestParams struct {
+	ID uuid.UUID `params:"id"`
+}
+
+type getTeamLeaveCalendarRequestQuery struct {
+	// From is the first day of the calendar, today by default.
+	From string `query:"from" json:"from" validate:"omitempty,datetime=2006-01-02" format:"date"`
+	// To is the last day of the calendar, a month after from by default. The calendar can span up to 366 days.
+	To string `query:"to" json:"to" validate:"omitempty,datetime=2006-01-02" format:"date"`
+	// Recursive includes the members of the subteams.
+	Recursive bool `query:"recursive" json:"recursive"`
+	// IncludePending includes the leaves waiting for a decision along with the approved ones.
+	IncludePending bool `query:"include_pending" json:"include_pending"`
+}
+
+// @Summary      Get team leave calendar
+// @Description  Lists the leaves of the members of the team overlapping a range of days, to tell who is away.
+// @Tags         teams
+// @Param        id    path  string                           true "Team ID"
+// @Param        query query getTeamLeaveCalendarRequestQuery true "query"
+// @Success      200 {array} teamLeaveResponse
+// @Failure      400 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /teams/{id}/leave-calendar [get]
+func (server *Server) getTeamLeaveCalendar(c *fiber.Ctx) error {
+	params := new(getTeamLeaveCalendarRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	query := new(getTeamLeaveCalendarRequestQuery)
+	if err := c.QueryParser(query); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	validate := newValidator()
+	if err := validate.Struct(query); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	from := today()
+	if len(query.From) > 0 {
+		var err error
+		if from, err = time.Parse(db.DateLayout, query.From); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+		}
+	}
+	to := from.Add(defaultLeaveCalendarRange)
+	if len(query.To) > 0 {
+		var err error
+		if to, err = time.Parse(db.DateLayout, query.To); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+		}
+	}
+	if to.Before(from) || to.Sub(from) >= maxLeaveCalendarRange {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(errLeaveCalendarRange))
+	}
+
+	if _, err := server.store.GetTeam(c.Context(), params.ID); err != nil {
+		if err == sql.ErrNoRows {
+			return c.Status(fiber.StatusNotFound).JSON(newErrorResponse(err))
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	rows, err := server.store.ListTeamLeaveRequests(c.Context(), db.ListTeamLeaveRequestsParams{
+		TeamID:         params.ID,
+		Recursive:      query.Recursive,
+		ToDate:         to,
+		FromDate:       from,
+		IncludePending: query.IncludePending,
+	})
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	rsp := make([]teamLeaveResponse, 0, len(rows))
+	for _, row := range rows {
+		rsp = append(rsp, newTeamLeaveResponse(row))
+	}
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
@@ -0,0 +1,1125 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	mockdb "github.com/ot07/coworker-backend/db/mock"
+	db "github.com/ot07/coworker-backend/db/sqlc"
+	"github.com/ot07/coworker-backend/util"
+	"github.com/stretchr/testify/require"
+)
+
+func randomLeaveType(annualAllowance sql.NullFloat64) db.LeaveType {
+	return db.LeaveType{
+		ID:              util.RandomUUID(),
+		Name:            util.RandomName(),
+		AnnualAllowance: annualAllowance,
+		MaxCarryOver:    5,
+		CreatedAt:       time.Now().UTC().Truncate(time.Second),
+	}
+}
+
+func randomLeaveRequest(memberID, leaveTypeID uuid.UUID, startDate, endDate time.Time) db.LeaveRequest {
+	return db.LeaveRequest{
+		ID:          util.RandomUUID(),
+		MemberID:    memberID,
+		LeaveTypeID: leaveTypeID,
+		StartDate:   startDate,
+		EndDate:     endDate,
+		Days:        db.LeaveDays(startDate, false, endDate, false),
+		Status:      db.LeaveStatusPending,
+		Reason:      util.RandomString(20),
+		CreatedAt:   time.Now().UTC().Truncate(time.Second),
+	}
+}
+
+func TestCreateLeaveTypeAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	leaveType := randomLeaveType(sql.NullFloat64{Float64: 20, Valid: true})
+
+	testCases := []struct {
+		name          string
+		body          fiber.Map
+		setupAuth     func(request *http.Request)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name: "OK",
+			body: fiber.Map{
+				"name":             leaveType.Name,
+				"annual_allowance": 20,
+				"max_carry_over":   5,
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				arg := db.CreateLeaveTypeParams{
+					Name:            leaveType.Name,
+					AnnualAllowance: leaveType.AnnualAllowance,
+					MaxCarryOver:    5,
+				}
+				store.EXPECT().
+					CreateLeaveType(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(leaveType, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+				requireBodyMatchLeaveType(t, response.Body, leaveType)
+			},
+		},
+		{
+			name: "WithoutAllowance",
+			body: fiber.Map{
+				"name": leaveType.Name,
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				arg := db.CreateLeaveTypeParams{
+					Name: leaveType.Name,
+				}
+				store.EXPECT().
+					CreateLeaveType(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.LeaveType{ID: leaveType.ID, Name: leaveType.Name}, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+			},
+		},
+		{
+			name: "NoAuthorization",
+			body: fiber.Map{
+				"name": leaveType.Name,
+			},
+			setupAuth: func(request *http.Request) {
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateLeaveType(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusUnauthorized, response.StatusCode)
+			},
+		},
+		{
+			name: "NegativeAllowance",
+			body: fiber.Map{
+				"name":             leaveType.Name,
+				"annual_allowance": -1,
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					CreateLeaveType(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "DuplicateName",
+			body: fiber.Map{
+				"name": leaveType.Name,
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					CreateLeaveType(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.LeaveType{}, &pq.Error{Code: "23505"})
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusForbidden, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/api/v1/leave-types", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			request.Header.Set("Content-Type", "application/json")
+
+			tc.setupAuth(request)
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func TestDeleteLeaveTypeAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	leaveType := randomLeaveType(sql.NullFloat64{})
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					DeleteLeaveType(gomock.Any(), gomock.Eq(leaveType.ID)).
+					Times(1).
+					Return(leaveType, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusNoContent, response.StatusCode)
+			},
+		},
+		{
+			name: "NotFound",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					DeleteLeaveType(gomock.Any(), gomock.Eq(leaveType.ID)).
+					Times(1).
+					Return(db.LeaveType{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusNotFound, response.StatusCode)
+			},
+		},
+		{
+			name: "InUse",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					DeleteLeaveType(gomock.Any(), gomock.Eq(leaveType.ID)).
+					Times(1).
+					Return(db.LeaveType{}, &pq.Error{Code: "23503"})
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusForbidden, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			buildValidSessionStubs(store, session)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			url := fmt.Sprintf("/api/v1/leave-types/%s", leaveType.ID)
+			request, err := http.NewRequest(http.MethodDelete, url, nil)
+			require.NoError(t, err)
+
+			addSessionTokenInCookie(request, session.SessionToken.String())
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func TestCreateLeaveRequestAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	member := randomMember()
+	leaveType := randomLeaveType(sql.NullFloat64{Float64: 20, Valid: true})
+	startDate := time.Date(2023, 8, 7, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2023, 8, 11, 0, 0, 0, 0, time.UTC)
+	leaveRequest := randomLeaveRequest(member.ID, leaveType.ID, startDate, endDate)
+
+	body := func(startDate, endDate string) fiber.Map {
+		return fiber.Map{
+			"member_id":     member.ID,
+			"leave_type_id": leaveType.ID,
+			"start_date":    startDate,
+			"end_date":      endDate,
+			"end_half_day":  true,
+			"reason":        leaveRequest.Reason,
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		body          fiber.Map
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name: "OK",
+			body: body("2023-08-07", "2023-08-11"),
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.CreateLeaveRequestParams{
+					MemberID:    member.ID,
+					LeaveTypeID: leaveType.ID,
+					StartDate:   startDate,
+					EndDate:     endDate,
+					EndHalfDay:  true,
+					Reason:      leaveRequest.Reason,
+				}
+				store.EXPECT().
+					CreateLeaveRequestTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(leaveRequest, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+				requireBodyMatchLeaveRequest(t, response.Body, leaveRequest)
+			},
+		},
+		{
+			name: "InvalidDate",
+			body: body("2023-08-07", "11/08/2023"),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateLeaveRequestTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "EndBeforeStart",
+			body: body("2023-08-11", "2023-08-07"),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateLeaveRequestTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "TooLong",
+			body: body("2023-08-07", "2024-08-07"),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateLeaveRequestTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "NoWorkingDay",
+			body: body("2023-08-12", "2023-08-13"),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateLeaveRequestTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.LeaveRequest{}, db.ErrNoLeaveDays)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "InsufficientBalance",
+			body: body("2023-08-07", "2023-08-11"),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateLeaveRequestTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.LeaveRequest{}, fmt.Errorf("%w: 2 days left in 2023", db.ErrInsufficientLeaveBalance))
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusForbidden, response.StatusCode)
+			},
+		},
+		{
+			name: "Overlap",
+			body: body("2023-08-07", "2023-08-11"),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateLeaveRequestTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.LeaveRequest{}, &pq.Error{Code: "23P01"})
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusForbidden, response.StatusCode)
+			},
+		},
+		{
+			name: "NotFound",
+			body: body("2023-08-07", "2023-08-11"),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateLeaveRequestTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.LeaveRequest{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusNotFound, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			buildValidSessionStubs(store, session)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/api/v1/leave-requests", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			request.Header.Set("Content-Type", "application/json")
+
+			addSessionTokenInCookie(request, session.SessionToken.String())
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func TestListLeaveRequestsAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	member := randomMember()
+	leaveType := randomLeaveType(sql.NullFloat64{})
+	startDate := time.Date(2023, 8, 7, 0, 0, 0, 0, time.UTC)
+	leaveRequests := []db.LeaveRequest{
+		randomLeaveRequest(member.ID, leaveType.ID, startDate, startDate),
+		randomLeaveRequest(member.ID, leaveType.ID, startDate.AddDate(0, 0, -7), startDate.AddDate(0, 0, -7)),
+	}
+
+	testCases := []struct {
+		name          string
+		query         string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name:  "OK",
+			query: fmt.Sprintf("?page_id=1&page_size=5&member_id=%s&status=pending", member.ID),
+			buildStubs: func(store *mockdb.MockStore) {
+				memberID := uuid.NullUUID{UUID: member.ID, Valid: true}
+				status := sql.NullString{String: db.LeaveStatusPending, Valid: true}
+
+				store.EXPECT().
+					ListLeaveRequests(gomock.Any(), gomock.Eq(db.ListLeaveRequestsParams{
+						Limit:    5,
+						Offset:   0,
+						MemberID: memberID,
+						Status:   status,
+					})).
+					Times(1).
+					Return(leaveRequests, nil)
+
+				store.EXPECT().
+					CountLeaveRequests(gomock.Any(), gomock.Eq(db.CountLeaveRequestsParams{
+						MemberID: memberID,
+						Status:   status,
+					})).
+					Times(1).
+					Return(int64(len(leaveRequests)), nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+
+				data, err := io.ReadAll(response.Body)
+				require.NoError(t, err)
+
+				var got listLeaveRequestsResponse
+				err = json.Unmarshal(data, &got)
+				require.NoError(t, err)
+
+				require.Equal(t, int64(1), got.Meta.PageCount)
+				require.Equal(t, int64(len(leaveRequests)), got.Meta.TotalCount)
+				require.Len(t, got.Data, len(leaveRequests))
+				for i, leaveRequest := range leaveRequests {
+					require.Equal(t, leaveRequest.ID, got.Data[i].ID)
+				}
+			},
+		},
+		{
+			name:  "InvalidStatus",
+			query: "?page_id=1&page_size=5&status=unknown",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListLeaveRequests(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name:  "InvalidMemberID",
+			query: "?page_id=1&page_size=5&member_id=1",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListLeaveRequests(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			buildValidSessionStubs(store, session)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			request, err := http.NewRequest(http.MethodGet, "/api/v1/leave-requests"+tc.query, nil)
+			require.NoError(t, err)
+
+			addSessionTokenInCookie(request, session.SessionToken.String())
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func TestDecideLeaveRequestAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	member := randomMember()
+	leaveType := randomLeaveType(sql.NullFloat64{})
+	startDate := time.Date(2023, 8, 7, 0, 0, 0, 0, time.UTC)
+	leaveRequest := randomLeaveRequest(member.ID, leaveType.ID, startDate, startDate)
+
+	decided := func(status string) db.LeaveRequest {
+		request := leaveRequest
+		request.Status = status
+		request.DecidedBy = uuid.NullUUID{UUID: session.UserID, Valid: true}
+		request.DecidedAt = sql.NullTime{Time: time.Now().UTC().Truncate(time.Second), Valid: true}
+		request.DecisionNote = "enjoy"
+		return request
+	}
+
+	testCases := []struct {
+		name          string
+		action        string
+		body          fiber.Map
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name:   "Approve",
+			action: "approve",
+			body:   fiber.Map{"note": "enjoy"},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.DecideLeaveRequestTxParams{
+					ID:           leaveRequest.ID,
+					UserID:       session.UserID,
+					Status:       db.LeaveStatusApproved,
+					DecisionNote: "enjoy",
+				}
+				store.EXPECT().
+					DecideLeaveRequestTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(decided(db.LeaveStatusApproved), nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+				requireBodyMatchLeaveRequest(t, response.Body, decided(db.LeaveStatusApproved))
+			},
+		},
+		{
+			name:   "RejectWithoutBody",
+			action: "reject",
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.DecideLeaveRequestTxParams{
+					ID:     leaveRequest.ID,
+					UserID: session.UserID,
+					Status: db.LeaveStatusRejected,
+				}
+				store.EXPECT().
+					DecideLeaveRequestTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(decided(db.LeaveStatusRejected), nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+			},
+		},
+		{
+			name:   "NotApprover",
+			action: "approve",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					DecideLeaveRequestTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.LeaveRequest{}, db.ErrNotLeaveApprover)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusForbidden, response.StatusCode)
+			},
+		},
+		{
+			name:   "AlreadyDecided",
+			action: "reject",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					DecideLeaveRequestTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.LeaveRequest{}, fmt.Errorf("%w: it is approved", db.ErrLeaveRequestDecided))
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusForbidden, response.StatusCode)
+			},
+		},
+		{
+			name:   "NotFound",
+			action: "approve",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					DecideLeaveRequestTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.LeaveRequest{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusNotFound, response.StatusCode)
+			},
+		},
+		{
+			name:   "NoteTooLong",
+			action: "reject",
+			body:   fiber.Map{"note": util.RandomString(501)},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					DecideLeaveRequestTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			buildValidSessionStubs(store, session)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			var body io.Reader
+			if tc.body != nil {
+				data, err := json.Marshal(tc.body)
+				require.NoError(t, err)
+				body = bytes.NewReader(data)
+			}
+
+			url := fmt.Sprintf("/api/v1/leave-requests/%s/%s", leaveRequest.ID, tc.action)
+			request, err := http.NewRequest(http.MethodPost, url, body)
+			require.NoError(t, err)
+
+			request.Header.Set("Content-Type", "application/json")
+
+			addSessionTokenInCookie(request, session.SessionToken.String())
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func TestCancelLeaveRequestAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	member := randomMember()
+	leaveType := randomLeaveType(sql.NullFloat64{})
+	startDate := time.Date(2023, 8, 7, 0, 0, 0, 0, time.UTC)
+	leaveRequest := randomLeaveRequest(member.ID, leaveType.ID, startDate, startDate)
+	canceled := leaveRequest
+	canceled.Status = db.LeaveStatusCanceled
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetLeaveRequest(gomock.Any(), gomock.Eq(leaveRequest.ID)).
+					Times(1).
+					Return(leaveRequest, nil)
+
+				store.EXPECT().
+					CancelLeaveRequest(gomock.Any(), gomock.Eq(leaveRequest.ID)).
+					Times(1).
+					Return(canceled, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+				requireBodyMatchLeaveRequest(t, response.Body, canceled)
+			},
+		},
+		{
+			name: "NotCancelable",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetLeaveRequest(gomock.Any(), gomock.Eq(leaveRequest.ID)).
+					Times(1).
+					Return(canceled, nil)
+
+				store.EXPECT().
+					CancelLeaveRequest(gomock.Any(), gomock.Eq(leaveRequest.ID)).
+					Times(1).
+					Return(db.LeaveRequest{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusForbidden, response.StatusCode)
+			},
+		},
+		{
+			name: "NotFound",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetLeaveRequest(gomock.Any(), gomock.Eq(leaveRequest.ID)).
+					Times(1).
+					Return(db.LeaveRequest{}, sql.ErrNoRows)
+
+				store.EXPECT().
+					CancelLeaveRequest(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusNotFound, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			buildValidSessionStubs(store, session)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			url := fmt.Sprintf("/api/v1/leave-requests/%s/cancel", leaveRequest.ID)
+			request, err := http.NewRequest(http.MethodPost, url, nil)
+			require.NoError(t, err)
+
+			addSessionTokenInCookie(request, session.SessionToken.String())
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func TestListMemberLeaveBalancesAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	member := randomMember()
+	leaveType := randomLeaveType(sql.NullFloat64{Float64: 20, Valid: true})
+	balance := db.LeaveBalance{
+		LeaveType:   leaveType,
+		Year:        2023,
+		Allowance:   20,
+		CarriedOver: 3,
+		Used:        5.5,
+		Pending:     2,
+		Remaining:   17.5,
+	}
+
+	testCases := []struct {
+		name          string
+		query         string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name:  "OK",
+			query: "?year=2023",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListLeaveBalances(gomock.Any(), gomock.Eq(member.ID), gomock.Eq(2023)).
+					Times(1).
+					Return([]db.LeaveBalance{balance}, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+
+				data, err := io.ReadAll(response.Body)
+				require.NoError(t, err)
+
+				var got []leaveBalanceResponse
+				err = json.Unmarshal(data, &got)
+				require.NoError(t, err)
+
+				require.Equal(t, []leaveBalanceResponse{newLeaveBalanceResponse(balance)}, got)
+			},
+		},
+		{
+			name: "CurrentYear",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListLeaveBalances(gomock.Any(), gomock.Eq(member.ID), gomock.Eq(today().Year())).
+					Times(1).
+					Return([]db.LeaveBalance{}, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+			},
+		},
+		{
+			name:  "InvalidYear",
+			query: "?year=20",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListLeaveBalances(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "NotFound",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListLeaveBalances(gomock.Any(), gomock.Eq(member.ID), gomock.Any()).
+					Times(1).
+					Return(nil, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusNotFound, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			buildValidSessionStubs(store, session)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			url := fmt.Sprintf("/api/v1/members/%s/leave-balances%s", member.ID, tc.query)
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			addSessionTokenInCookie(request, session.SessionToken.String())
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func TestGetTeamLeaveCalendarAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	team := randomTeam(uuid.NullUUID{})
+	member := randomMember()
+	row := db.ListTeamLeaveRequestsRow{
+		ID:            util.RandomUUID(),
+		MemberID:      member.ID,
+		FirstName:     member.FirstName,
+		LastName:      member.LastName,
+		LeaveTypeID:   util.RandomUUID(),
+		LeaveTypeName: "vacation",
+		StartDate:     time.Date(2023, 8, 7, 0, 0, 0, 0, time.UTC),
+		EndDate:       time.Date(2023, 8, 11, 0, 0, 0, 0, time.UTC),
+		StartHalfDay:  true,
+		Status:        db.LeaveStatusApproved,
+	}
+
+	testCases := []struct {
+		name          string
+		query         string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name:  "OK",
+			query: "?from=2023-08-01&to=2023-08-31&recursive=true&include_pending=true",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetTeam(gomock.Any(), gomock.Eq(team.ID)).
+					Times(1).
+					Return(team, nil)
+
+				arg := db.ListTeamLeaveRequestsParams{
+					TeamID:         team.ID,
+					Recursive:      true,
+					ToDate:         time.Date(2023, 8, 31, 0, 0, 0, 0, time.UTC),
+					FromDate:       time.Date(2023, 8, 1, 0, 0, 0, 0, time.UTC),
+					IncludePending: true,
+				}
+				store.EXPECT().
+					ListTeamLeaveRequests(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return([]db.ListTeamLeaveRequestsRow{row}, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+
+				data, err := io.ReadAll(response.Body)
+				require.NoError(t, err)
+
+				var got []teamLeaveResponse
+				err = json.Unmarshal(data, &got)
+				require.NoError(t, err)
+
+				require.Len(t, got, 1)
+				require.Equal(t, row.MemberID, got[0].MemberID)
+				require.Equal(t, "2023-08-07", got[0].StartDate)
+				require.Equal(t, "2023-08-11", got[0].EndDate)
+				require.True(t, got[0].StartHalfDay)
+				require.Equal(t, "vacation", got[0].LeaveTypeName)
+			},
+		},
+		{
+			name: "DefaultRange",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetTeam(gomock.Any(), gomock.Eq(team.ID)).
+					Times(1).
+					Return(team, nil)
+
+				store.EXPECT().
+					ListTeamLeaveRequests(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ interface{}, arg db.ListTeamLeaveRequestsParams) ([]db.ListTeamLeaveRequestsRow, error) {
+						require.Equal(t, today(), arg.FromDate)
+						require.Equal(t, today().Add(defaultLeaveCalendarRange), arg.ToDate)
+						require.False(t, arg.Recursive)
+						require.False(t, arg.IncludePending)
+						return []db.ListTeamLeaveRequestsRow{}, nil
+					})
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+			},
+		},
+		{
+			name:  "EndBeforeStart",
+			query: "?from=2023-08-31&to=2023-08-01",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListTeamLeaveRequests(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name:  "RangeTooLong",
+			query: "?from=2023-01-01&to=2024-06-01",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListTeamLeaveRequests(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name:  "TeamNotFound",
+			query: "?from=2023-08-01",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetTeam(gomock.Any(), gomock.Eq(team.ID)).
+					Times(1).
+					Return(db.Team{}, sql.ErrNoRows)
+
+				store.EXPECT().
+					ListTeamLeaveRequests(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusNotFound, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			buildValidSessionStubs(store, session)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			url := fmt.Sprintf("/api/v1/teams/%s/leave-calendar%s", team.ID, tc.query)
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			addSessionTokenInCookie(request, session.SessionToken.String())
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func requireBodyMatchLeaveType(t *testing.T, body io.ReadCloser, leaveType db.LeaveType) {
+	data, err := io.ReadAll(body)
+	require.NoError(t, err)
+
+	var got leaveTypeResponse
+	err = json.Unmarshal(data, &got)
+	require.NoError(t, err)
+
+	require.Equal(t, leaveType.ID, got.ID)
+	require.Equal(t, leaveType.Name, got.Name)
+	require.Equal(t, leaveType.AnnualAllowance, got.AnnualAllowance.NullFloat64)
+	require.Equal(t, leaveType.MaxCarryOver, got.MaxCarryOver)
+	require.True(t, leaveType.CreatedAt.Equal(got.CreatedAt))
+
+	err = body.Close()
+	require.NoError(t, err)
+}
+
+func requireBodyMatchLeaveRequest(t *testing.T, body io.ReadCloser, leaveRequest db.LeaveRequest) {
+	data, err := io.ReadAll(body)
+	require.NoError(t, err)
+
+	var got leaveRequestResponse
+	err = json.Unmarshal(data, &got)
+	require.NoError(t, err)
+
+	require.Equal(t, leaveRequest.ID, got.ID)
+	require.Equal(t, leaveRequest.MemberID, got.MemberID)
+	require.Equal(t, leaveRequest.LeaveTypeID, got.LeaveTypeID)
+	require.Equal(t, leaveRequest.StartDate.Format(db.DateLayout), got.StartDate)
+	require.Equal(t, leaveRequest.StartHalfDay, got.StartHalfDay)
+	require.Equal(t, leaveRequest.EndDate.Format(db.DateLayout), got.EndDate)
+	require.Equal(t, leaveRequest.EndHalfDay, got.EndHalfDay)
+	require.Equal(t, leaveRequest.Days, got.Days)
+	require.Equal(t, leaveRequest.Status, got.Status)
+	require.Equal(t, leaveRequest.Reason, got.Reason)
+	require.Equal(t, leaveRequest.DecidedBy, got.DecidedBy)
+	require.Equal(t, leaveRequest.DecidedAt.Valid, got.DecidedAt.Valid)
+	require.True(t, leaveRequest.DecidedAt.Time.Equal(got.DecidedAt.Time))
+	require.Equal(t, leaveRequest.DecisionNote, got.DecisionNote)
+
+	err = body.Close()
+	require.NoError(t, err)
+}
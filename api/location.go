@@ -0,0 +1,458 @@
+package api
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	db "github.com/ot07/coworker-backend/db/sqlc"
+)
+
+// locationWriteStatus tells which status to respond with for an error writing a location, a floor or a desk.
+// The location or floor a floor or desk is created in is part of the URL, so it not existing is a 404.
+func locationWriteStatus(err error) int {
+	if err == sql.ErrNoRows {
+		return fiber.StatusNotFound
+	}
+	if pqErr, ok := err.(*pq.Error); ok {
+		switch pqErr.Code.Name() {
+		case "foreign_key_violation":
+			return fiber.StatusNotFound
+		case "unique_violation":
+			return fiber.StatusForbidden
+		}
+	}
+	return fiber.StatusInternalServerError
+}
+
+type locationResponse struct {
+	ID   uuid.UUID `json:"id"`
+	Name string    `json:"name"`
+	// MaxAdvanceDays is how many days ahead a desk of the location can be booked.
+	MaxAdvanceDays int32 `json:"max_advance_days"`
+	// MaxBookingsPerWeek is how many desk bookings a member can hold at the location within a week.
+	MaxBookingsPerWeek int32     `json:"max_bookings_per_week"`
+	CreatedAt          time.Time `json:"created_at"`
+}
+
+func newLocationResponse(location db.Location) locationResponse {
+	return locationResponse{
+		ID:                 location.ID,
+		Name:               location.Name,
+		MaxAdvanceDays:     location.MaxAdvanceDays,
+		MaxBookingsPerWeek: location.MaxBookingsPerWeek,
+		CreatedAt:          location.CreatedAt,
+	}
+}
+
+type floorResponse struct {
+	ID         uuid.UUID `json:"id"`
+	LocationID uuid.UUID `json:"location_id"`
+	Name       string    `json:"name"`
+	Level      int32     `json:"level"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+func newFloorResponse(floor db.Floor) floorResponse {
+	return floorResponse{
+		ID:         floor.ID,
+		LocationID: floor.LocationID,
+		Name:       floor.Name,
+		Level:      floor.Level,
+		CreatedAt:  floor.CreatedAt,
+	}
+}
+
+type deskResponse struct {
+	ID        uuid.UUID `json:"id"`
+	FloorID   uuid.UUID `json:"floor_id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func newDeskResponse(desk db.Desk) deskResponse {
+	return deskResponse{
+		ID:        desk.ID,
+		FloorID:   desk.FloorID,
+		Name:      desk.Name,
+		CreatedAt: desk.CreatedAt,
+	}
+}
+
+type locationRequest struct {
+	Name string `json:"name" validate:"required,max=100" example:"Shibuya"`
+	// MaxAdvanceDays is how many days ahead a desk can be booked, 14 by default.
+	MaxAdvanceDays *int32 `json:"max_advance_days" validate:"omitempty,min=0,max=365" example:"14"`
+	// MaxBookingsPerWeek is how many desk bookings a member can hold within a week, 5 by default.
+	MaxBookingsPerWeek *int32 `json:"max_bookings_per_week" validate:"omitempty,min=1,max=14" example:"5"`
+}
+
+const (
+	defaultLocationMaxAdvanceDays     = 14
+	defaultLocationMaxBookingsPerWeek = 5
+)
+
+// rules returns the booking rules of the request, filling in the defaults.
+func (req *locationRequest) rules() (maxAdvanceDays, maxBookingsPerWeek int32) {
+	maxAdvanceDays, maxBookingsPerWeek = defaultLocationMaxAdvanceDays, defaultLocationMaxBookingsPerWeek
+	if req.MaxAdvanceDays != nil {
+		maxAdvanceDays = *req.MaxAdvanceDays
+	}
+	if req.MaxBookingsPerWeek != nil {
+		maxBookingsPerWeek = *req.MaxBookingsPerWeek
+	}
+	return
+}
+
+// @Summary      Create location
+// @Description  A location is a coworking space, made of floors of desks. It sets the rules desks are booked by.
+// @Tags         locations
+// @Param        body body locationRequest true "Location object"
+// @Success      200 {object} locationResponse
+// @Failure      400 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /locations [post]
+func (server *Server) createLocation(c *fiber.Ctx) error {
+	req := new(locationRequest)
+	if err := c.BodyParser(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	validate := newValidator()
+	if err := validate.Struct(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	maxAdvanceDays, maxBookingsPerWeek := req.rules()
+	arg := db.CreateLocationParams{
+		Name:               req.Name,
+		MaxAdvanceDays:     maxAdvanceDays,
+		MaxBookingsPerWeek: maxBookingsPerWeek,
+	}
+
+	location, err := server.store.CreateLocation(c.Context(), arg)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	rsp := newLocationResponse(location)
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+// @Summary      List locations
+// @Tags         locations
+// @Success      200 {array} locationResponse
+// @Failure      500 {object} errorResponse
+// @Router       /locations [get]
+func (server *Server) listLocations(c *fiber.Ctx) error {
+	locations, err := server.store.ListLocations(c.Context())
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	rsp := make([]locationResponse, 0, len(locations))
+	for _, location := range locations {
+		rsp = append(rsp, newLocationResponse(location))
+	}
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+type locationRequestParams struct {
+	ID uuid.UUID `params:"id"`
+}
+
+// @Summary      Get location
+// @Tags         locations
+// @Param        id path string true "Location ID"
+// @Success      200 {object} locationResponse
+// @Failure      400 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /locations/{id} [get]
+func (server *Server) getLocation(c *fiber.Ctx) error {
+	params := new(locationRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	location, err := server.store.GetLocation(c.Context(), params.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return c.Status(fiber.StatusNotFound).JSON(newErrorResponse(err))
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	rsp := newLocationResponse(location)
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+// @Summary      Update location
+// @Description  Replaces the name and booking rules of the location. Rules left out are reset to their defaults.
+// @Description  Bookings already made are kept when the rules become stricter.
+// @Tags         locations
+// @Param        id   path string          true "Location ID"
+// @Param        body body locationRequest true "Location object"
+// @Success      200 {object} locationResponse
+// @Failure      400 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /locations/{id} [put]
+func (server *Server) updateLocation(c *fiber.Ctx) error {
+	params := new(locationRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	body := new(locationRequest)
+	if err := c.BodyParser(body); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	validate := newValidator()
+	if err := validate.Struct(body); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	maxAdvanceDays, maxBookingsPerWeek := body.rules()
+	arg := db.UpdateLocationParams{
+		ID:                 params.ID,
+		Name:               body.Name,
+		MaxAdvanceDays:     maxAdvanceDays,
+		MaxBookingsPerWeek: maxBookingsPerWeek,
+	}
+
+	location, err := server.store.UpdateLocation(c.Context(), arg)
+	if err != nil {
+		return c.Status(locationWriteStatus(err)).JSON(newErrorResponse(err))
+	}
+
+	rsp := newLocationResponse(location)
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+// @Summary      Delete location
+// @Description  Deletes the location together with its floors, desks and desk bookings.
+// @Tags         locations
+// @Param        id path string true "Location ID"
+// @Success      204 {object} nil
+// @Failure      400 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /locations/{id} [delete]
+func (server *Server) deleteLocation(c *fiber.Ctx) error {
+	params := new(locationRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	if _, err := server.store.DeleteLocation(c.Context(), params.ID); err != nil {
+		return c.Status(locationWriteStatus(err)).JSON(newErrorResponse(err))
+	}
+
+	return c.Status(fiber.StatusNoContent).JSON(nil)
+}
+
+type createFloorRequest struct {
+	Name string `json:"name" validate:"required,max=100" example:"3F"`
+	// Level orders the floors of a location, from the lowest.
+	Level int32 `json:"level" example:"3"`
+}
+
+// @Summary      Create floor
+// @Tags         locations
+// @Param        id   path string             true "Location ID"
+// @Param        body body createFloorRequest true "Floor object"
+// @Success      200 {object} floorResponse
+// @Failure      400 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /locations/{id}/floors [post]
+func (server *Server) createFloor(c *fiber.Ctx) error {
+	params := new(locationRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	body := new(createFloorRequest)
+	if err := c.BodyParser(body); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	validate := newValidator()
+	if err := validate.Struct(body); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	arg := db.CreateFloorParams{
+		LocationID: params.ID,
+		Name:       body.Name,
+		Level:      body.Level,
+	}
+
+	floor, err := server.store.CreateFloor(c.Context(), arg)
+	if err != nil {
+		return c.Status(locationWriteStatus(err)).JSON(newErrorResponse(err))
+	}
+
+	rsp := newFloorResponse(floor)
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+// @Summary      List floors
+// @Description  Lists the floors of the location from the lowest level.
+// @Tags         locations
+// @Param        id path string true "Location ID"
+// @Success      200 {array} floorResponse
+// @Failure      400 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /locations/{id}/floors [get]
+func (server *Server) listFloors(c *fiber.Ctx) error {
+	params := new(locationRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	if _, err := server.store.GetLocation(c.Context(), params.ID); err != nil {
+		if err == sql.ErrNoRows {
+			return c.Status(fiber.StatusNotFound).JSON(newErrorResponse(err))
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	floors, err := server.store.ListFloors(c.Context(), params.ID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	rsp := make([]floorResponse, 0, len(floors))
+	for _, floor := range floors {
+		rsp = append(rsp, newFloorResponse(floor))
+	}
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+type floorRequestParams struct {
+	ID uuid.UUID `params:"id"`
+}
+
+// @Summary      Delete floor
+// @Description  Deletes the floor together with its desks and desk bookings.
+// @Tags         locations
+// @Param        id path string true "Floor ID"
+// @Success      204 {object} nil
+// @Failure      400 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /floors/{id} [delete]
+func (server *Server) deleteFloor(c *fiber.Ctx) error {
+	params := new(floorRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	if _, err := server.store.DeleteFloor(c.Context(), params.ID); err != nil {
+		return c.Status(locationWriteStatus(err)).JSON(newErrorResponse(err))
+	}
+
+	return c.Status(fiber.StatusNoContent).JSON(nil)
+}
+
+type createDeskRequest struct {
+	// Name is unique within the floor.
+	Name string `json:"name" validate:"required,max=100" example:"A-12"`
+}
+
+// @Summary      Create desk
+// @Tags         locations
+// @Param        id   path string            true "Floor ID"
+// @Param        body body createDeskRequest true "Desk object"
+// @Success      200 {object} deskResponse
+// @Failure      400 {object} errorResponse
+// @Failure      403 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /floors/{id}/desks [post]
+func (server *Server) createDesk(c *fiber.Ctx) error {
+	params := new(floorRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	body := new(createDeskRequest)
+	if err := c.BodyParser(body); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	validate := newValidator()
+	if err := validate.Struct(body); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	arg := db.CreateDeskParams{
+		FloorID: params.ID,
+		Name:    body.Name,
+	}
+
+	desk, err := server.store.CreateDesk(c.Context(), arg)
+	if err != nil {
+		return c.Status(locationWriteStatus(err)).JSON(newErrorResponse(err))
+	}
+
+	rsp := newDeskResponse(desk)
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+// @Summary      List desks
+// @Tags         locations
+// @Param        id path string true "Floor ID"
+// @Success      200 {array} deskResponse
+// @Failure      400 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /floors/{id}/desks [get]
+func (server *Server) listDesks(c *fiber.Ctx) error {
+	params := new(floorRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	desks, err := server.store.ListDesks(c.Context(), params.ID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	rsp := make([]deskResponse, 0, len(desks))
+	for _, desk := range desks {
+		rsp = append(rsp, newDeskResponse(desk))
+	}
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+type deskRequestParams struct {
+	ID uuid.UUID `params:"id"`
+}
+
+// @Summary      Delete desk
+// @Description  Deletes the desk together with its bookings.
+// @Tags         locations
+// @Param        id path string true "Desk ID"
+// @Success      204 {object} nil
+// @Failure      400 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /desks/{id} [delete]
+func (server *Server) deleteDesk(c *fiber.Ctx) error {
+	params := new(deskRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	if _, err := server.store.DeleteDesk(c.Context(), params.ID); err != nil {
+		return c.Status(locationWriteStatus(err)).JSON(newErrorResponse(err))
+	}
+
+	return c.Status(fiber.StatusNoContent).JSON(nil)
+}
@@ -0,0 +1,1064 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/lib/pq"
+	mockdb "github.com/ot07/coworker-backend/db/mock"
+	db "github.com/ot07/coworker-backend/db/sqlc"
+	"github.com/ot07/coworker-backend/util"
+	"github.com/stretchr/testify/require"
+)
+
+func randomLocation() db.Location {
+	return db.Location{
+		ID:                 util.RandomUUID(),
+		Name:               util.RandomName(),
+		MaxAdvanceDays:     defaultLocationMaxAdvanceDays,
+		MaxBookingsPerWeek: defaultLocationMaxBookingsPerWeek,
+		CreatedAt:          time.Date(2023, 4, 1, 9, 0, 0, 0, time.UTC),
+	}
+}
+
+func randomFloor(location db.Location, level int32) db.Floor {
+	return db.Floor{
+		ID:         util.RandomUUID(),
+		LocationID: location.ID,
+		Name:       util.RandomName(),
+		Level:      level,
+		CreatedAt:  time.Date(2023, 4, 1, 9, 0, 0, 0, time.UTC),
+	}
+}
+
+func randomDesk(floor db.Floor) db.Desk {
+	return db.Desk{
+		ID:        util.RandomUUID(),
+		FloorID:   floor.ID,
+		Name:      util.RandomName(),
+		CreatedAt: time.Date(2023, 4, 1, 9, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestCreateLocationAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	location := randomLocation()
+
+	testCases := []struct {
+		name          string
+		body          fiber.Map
+		setupAuth     func(request *http.Request)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name: "OK",
+			body: fiber.Map{
+				"name": location.Name,
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				arg := db.CreateLocationParams{
+					Name:               location.Name,
+					MaxAdvanceDays:     defaultLocationMaxAdvanceDays,
+					MaxBookingsPerWeek: defaultLocationMaxBookingsPerWeek,
+				}
+
+				store.EXPECT().
+					CreateLocation(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(location, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+				requireBodyMatchLocation(t, response.Body, location)
+			},
+		},
+		{
+			name: "OKWithRules",
+			body: fiber.Map{
+				"name":                  location.Name,
+				"max_advance_days":      0,
+				"max_bookings_per_week": 2,
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				arg := db.CreateLocationParams{
+					Name:               location.Name,
+					MaxAdvanceDays:     0,
+					MaxBookingsPerWeek: 2,
+				}
+
+				store.EXPECT().
+					CreateLocation(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(location, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+			},
+		},
+		{
+			name: "NoAuthorization",
+			body: fiber.Map{
+				"name": location.Name,
+			},
+			setupAuth: func(request *http.Request) {
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateLocation(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusUnauthorized, response.StatusCode)
+			},
+		},
+		{
+			name: "NoName",
+			body: fiber.Map{},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					CreateLocation(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "InvalidMaxBookingsPerWeek",
+			body: fiber.Map{
+				"name":                  location.Name,
+				"max_bookings_per_week": 0,
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					CreateLocation(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "InternalError",
+			body: fiber.Map{
+				"name": location.Name,
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					CreateLocation(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Location{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusInternalServerError, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/api/v1/locations", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			request.Header.Set("Content-Type", "application/json")
+
+			tc.setupAuth(request)
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func TestListLocationsAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	locations := []db.Location{randomLocation(), randomLocation()}
+
+	testCases := []struct {
+		name          string
+		setupAuth     func(request *http.Request)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name: "OK",
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ListLocations(gomock.Any()).
+					Times(1).
+					Return(locations, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+
+				data, err := io.ReadAll(response.Body)
+				require.NoError(t, err)
+
+				var got []locationResponse
+				err = json.Unmarshal(data, &got)
+				require.NoError(t, err)
+
+				require.Len(t, got, len(locations))
+				for i, location := range locations {
+					requireLocationResponseMatch(t, got[i], location)
+				}
+			},
+		},
+		{
+			name: "InternalError",
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ListLocations(gomock.Any()).
+					Times(1).
+					Return([]db.Location{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusInternalServerError, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			request, err := http.NewRequest(http.MethodGet, "/api/v1/locations", nil)
+			require.NoError(t, err)
+
+			tc.setupAuth(request)
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func TestGetLocationAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	location := randomLocation()
+
+	testCases := []struct {
+		name          string
+		locationID    string
+		setupAuth     func(request *http.Request)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name:       "OK",
+			locationID: location.ID.String(),
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					GetLocation(gomock.Any(), gomock.Eq(location.ID)).
+					Times(1).
+					Return(location, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+				requireBodyMatchLocation(t, response.Body, location)
+			},
+		},
+		{
+			name:       "NotFound",
+			locationID: location.ID.String(),
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					GetLocation(gomock.Any(), gomock.Eq(location.ID)).
+					Times(1).
+					Return(db.Location{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusNotFound, response.StatusCode)
+			},
+		},
+		{
+			name:       "InvalidID",
+			locationID: "invalid",
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					GetLocation(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			url := fmt.Sprintf("/api/v1/locations/%s", tc.locationID)
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			tc.setupAuth(request)
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func TestUpdateLocationAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	location := randomLocation()
+	location.MaxBookingsPerWeek = 3
+
+	testCases := []struct {
+		name          string
+		body          fiber.Map
+		setupAuth     func(request *http.Request)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name: "OK",
+			body: fiber.Map{
+				"name":                  location.Name,
+				"max_bookings_per_week": 3,
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				arg := db.UpdateLocationParams{
+					ID:                 location.ID,
+					Name:               location.Name,
+					MaxAdvanceDays:     defaultLocationMaxAdvanceDays,
+					MaxBookingsPerWeek: 3,
+				}
+
+				store.EXPECT().
+					UpdateLocation(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(location, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+				requireBodyMatchLocation(t, response.Body, location)
+			},
+		},
+		{
+			name: "NotFound",
+			body: fiber.Map{
+				"name": location.Name,
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					UpdateLocation(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Location{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusNotFound, response.StatusCode)
+			},
+		},
+		{
+			name: "InvalidMaxAdvanceDays",
+			body: fiber.Map{
+				"name":             location.Name,
+				"max_advance_days": -1,
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					UpdateLocation(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/api/v1/locations/%s", location.ID)
+			request, err := http.NewRequest(http.MethodPut, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			request.Header.Set("Content-Type", "application/json")
+
+			tc.setupAuth(request)
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func TestDeleteLocationResourcesAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	location := randomLocation()
+	floor := randomFloor(location, 1)
+	desk := randomDesk(floor)
+
+	testCases := []struct {
+		name          string
+		url           string
+		setupAuth     func(request *http.Request)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name: "Location",
+			url:  fmt.Sprintf("/api/v1/locations/%s", location.ID),
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					DeleteLocation(gomock.Any(), gomock.Eq(location.ID)).
+					Times(1).
+					Return(location, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusNoContent, response.StatusCode)
+			},
+		},
+		{
+			name: "LocationNotFound",
+			url:  fmt.Sprintf("/api/v1/locations/%s", location.ID),
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					DeleteLocation(gomock.Any(), gomock.Eq(location.ID)).
+					Times(1).
+					Return(db.Location{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusNotFound, response.StatusCode)
+			},
+		},
+		{
+			name: "Floor",
+			url:  fmt.Sprintf("/api/v1/floors/%s", floor.ID),
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					DeleteFloor(gomock.Any(), gomock.Eq(floor.ID)).
+					Times(1).
+					Return(floor, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusNoContent, response.StatusCode)
+			},
+		},
+		{
+			name: "Desk",
+			url:  fmt.Sprintf("/api/v1/desks/%s", desk.ID),
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					DeleteDesk(gomock.Any(), gomock.Eq(desk.ID)).
+					Times(1).
+					Return(desk, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusNoContent, response.StatusCode)
+			},
+		},
+		{
+			name: "DeskInternalError",
+			url:  fmt.Sprintf("/api/v1/desks/%s", desk.ID),
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					DeleteDesk(gomock.Any(), gomock.Eq(desk.ID)).
+					Times(1).
+					Return(db.Desk{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusInternalServerError, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			request, err := http.NewRequest(http.MethodDelete, tc.url, nil)
+			require.NoError(t, err)
+
+			tc.setupAuth(request)
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func TestCreateFloorAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	location := randomLocation()
+	floor := randomFloor(location, 3)
+
+	testCases := []struct {
+		name          string
+		body          fiber.Map
+		setupAuth     func(request *http.Request)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name: "OK",
+			body: fiber.Map{
+				"name":  floor.Name,
+				"level": floor.Level,
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				arg := db.CreateFloorParams{
+					LocationID: location.ID,
+					Name:       floor.Name,
+					Level:      floor.Level,
+				}
+
+				store.EXPECT().
+					CreateFloor(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(floor, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+
+				data, err := io.ReadAll(response.Body)
+				require.NoError(t, err)
+
+				var got floorResponse
+				err = json.Unmarshal(data, &got)
+				require.NoError(t, err)
+
+				require.Equal(t, floor.ID, got.ID)
+				require.Equal(t, floor.LocationID, got.LocationID)
+				require.Equal(t, floor.Name, got.Name)
+				require.Equal(t, floor.Level, got.Level)
+			},
+		},
+		{
+			name: "LocationNotFound",
+			body: fiber.Map{
+				"name": floor.Name,
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					CreateFloor(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Floor{}, &pq.Error{Code: "23503"})
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusNotFound, response.StatusCode)
+			},
+		},
+		{
+			name: "NoName",
+			body: fiber.Map{
+				"level": 1,
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					CreateFloor(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/api/v1/locations/%s/floors", location.ID)
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			request.Header.Set("Content-Type", "application/json")
+
+			tc.setupAuth(request)
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func TestListFloorsAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	location := randomLocation()
+	floors := []db.Floor{randomFloor(location, 1), randomFloor(location, 2)}
+
+	testCases := []struct {
+		name          string
+		setupAuth     func(request *http.Request)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name: "OK",
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					GetLocation(gomock.Any(), gomock.Eq(location.ID)).
+					Times(1).
+					Return(location, nil)
+				store.EXPECT().
+					ListFloors(gomock.Any(), gomock.Eq(location.ID)).
+					Times(1).
+					Return(floors, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+
+				data, err := io.ReadAll(response.Body)
+				require.NoError(t, err)
+
+				var got []floorResponse
+				err = json.Unmarshal(data, &got)
+				require.NoError(t, err)
+
+				require.Len(t, got, len(floors))
+				for i, floor := range floors {
+					require.Equal(t, floor.ID, got[i].ID)
+					require.Equal(t, floor.Level, got[i].Level)
+				}
+			},
+		},
+		{
+			name: "LocationNotFound",
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					GetLocation(gomock.Any(), gomock.Eq(location.ID)).
+					Times(1).
+					Return(db.Location{}, sql.ErrNoRows)
+				store.EXPECT().
+					ListFloors(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusNotFound, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			url := fmt.Sprintf("/api/v1/locations/%s/floors", location.ID)
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			tc.setupAuth(request)
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func TestCreateDeskAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	floor := randomFloor(randomLocation(), 1)
+	desk := randomDesk(floor)
+
+	testCases := []struct {
+		name          string
+		body          fiber.Map
+		setupAuth     func(request *http.Request)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name: "OK",
+			body: fiber.Map{
+				"name": desk.Name,
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				arg := db.CreateDeskParams{
+					FloorID: floor.ID,
+					Name:    desk.Name,
+				}
+
+				store.EXPECT().
+					CreateDesk(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(desk, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+
+				data, err := io.ReadAll(response.Body)
+				require.NoError(t, err)
+
+				var got deskResponse
+				err = json.Unmarshal(data, &got)
+				require.NoError(t, err)
+
+				require.Equal(t, desk.ID, got.ID)
+				require.Equal(t, desk.FloorID, got.FloorID)
+				require.Equal(t, desk.Name, got.Name)
+			},
+		},
+		{
+			name: "DuplicateName",
+			body: fiber.Map{
+				"name": desk.Name,
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					CreateDesk(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Desk{}, &pq.Error{Code: "23505"})
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusForbidden, response.StatusCode)
+			},
+		},
+		{
+			name: "FloorNotFound",
+			body: fiber.Map{
+				"name": desk.Name,
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					CreateDesk(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Desk{}, &pq.Error{Code: "23503"})
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusNotFound, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/api/v1/floors/%s/desks", floor.ID)
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			request.Header.Set("Content-Type", "application/json")
+
+			tc.setupAuth(request)
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func TestListDesksAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	floor := randomFloor(randomLocation(), 1)
+	desks := []db.Desk{randomDesk(floor), randomDesk(floor)}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	buildValidSessionStubs(store, session)
+	store.EXPECT().
+		ListDesks(gomock.Any(), gomock.Eq(floor.ID)).
+		Times(1).
+		Return(desks, nil)
+
+	server := newTestServer(t, store)
+
+	url := fmt.Sprintf("/api/v1/floors/%s/desks", floor.ID)
+	request, err := http.NewRequest(http.MethodGet, url, nil)
+	require.NoError(t, err)
+
+	addSessionTokenInCookie(request, session.SessionToken.String())
+	response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, response.StatusCode)
+
+	data, err := io.ReadAll(response.Body)
+	require.NoError(t, err)
+
+	var got []deskResponse
+	err = json.Unmarshal(data, &got)
+	require.NoError(t, err)
+
+	require.Len(t, got, len(desks))
+	for i, desk := range desks {
+		require.Equal(t, desk.ID, got[i].ID)
+	}
+}
+
+func requireLocationResponseMatch(t *testing.T, got locationResponse, location db.Location) {
+	require.Equal(t, location.ID, got.ID)
+	require.Equal(t, location.Name, got.Name)
+	require.Equal(t, location.MaxAdvanceDays, got.MaxAdvanceDays)
+	require.Equal(t, location.MaxBookingsPerWeek, got.MaxBookingsPerWeek)
+	require.True(t, location.CreatedAt.Equal(got.CreatedAt))
+}
+
+func requireBodyMatchLocation(t *testing.T, body io.ReadCloser, location db.Location) {
+	data, err := io.ReadAll(body)
+	require.NoError(t, err)
+
+	var got locationResponse
+	err = json.Unmarshal(data, &got)
+	require.NoError(t, err)
+
+	requireLocationResponseMatch(t, got, location)
+
+	err = body.Close()
+	require.NoError(t, err)
+}
@@ -5,17 +5,23 @@ import (
 	"time"
 
 	db "github.com/ot07/coworker-backend/db/sqlc"
+	"github.com/ot07/coworker-backend/storage"
 	"github.com/ot07/coworker-backend/util"
 	"github.com/stretchr/testify/require"
 )
 
+const testStoragePublicURL = "http://localhost:8080/uploads"
+
 func newTestServer(t *testing.T, store db.Store) *Server {
 	config := util.Config{
-		TokenSymmetricKey:    util.RandomString(32),
-		SessionTokenDuration: time.Minute,
+		TokenSymmetricKey:          util.RandomString(32),
+		SessionTokenDuration:       time.Minute,
+		MemberImportAsyncThreshold: 10,
+		MemberTrashRetention:       30 * 24 * time.Hour,
+		ProjectAllocationLimit:     100,
 	}
 
-	server, err := NewServer(config, store)
+	server, err := NewServer(config, store, storage.NewLocalStorage(t.TempDir(), testStoragePublicURL))
 	require.NoError(t, err)
 
 	return server
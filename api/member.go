@@ -2,44 +2,86 @@ package api
 
 import (
 	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"math"
 	"strings"
 	"time"
 
+	"github.com/go-playground/validator/v10"
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
 	db "github.com/ot07/coworker-backend/db/sqlc"
 )
 
 type createMemberRequest struct {
-	FirstName string `json:"first_name" validate:"required"`
-	LastName  string `json:"last_name" validate:"required"`
-	Email     string `json:"email" validate:"omitempty,email" swaggertype:"string" format:"email"`
+	FirstName    string                     `json:"first_name" validate:"required"`
+	LastName     string                     `json:"last_name" validate:"required"`
+	Email        string                     `json:"email" validate:"omitempty,email" swaggertype:"string" format:"email"`
+	CustomFields map[string]json.RawMessage `json:"custom_fields" swaggertype:"object"`
+	// Status is the lifecycle status the member starts in, active by default.
+	Status    string `json:"status" validate:"omitempty,oneof=invited onboarding active" enums:"invited,onboarding,active"`
+	StartDate string `json:"start_date" validate:"omitempty,datetime=2006-01-02" format:"date"`
+	memberContactDetailsRequest
 }
 
 type memberResponse struct {
-	ID        uuid.UUID     `json:"id"`
-	FirstName string        `json:"first_name"`
-	LastName  string        `json:"last_name"`
-	Email     db.NullString `json:"email" swaggertype:"string"`
+	ID           uuid.UUID       `json:"id"`
+	FirstName    string          `json:"first_name"`
+	LastName     string          `json:"last_name"`
+	Email        db.NullString   `json:"email" swaggertype:"string"`
+	CustomFields json.RawMessage `json:"custom_fields" swaggertype:"object"`
+	ManagerID    uuid.NullUUID   `json:"manager_id" swaggertype:"string"`
+	Status       string          `json:"status" enums:"invited,onboarding,active,on_leave,offboarded"`
+	StartDate    db.NullDate     `json:"start_date" swaggertype:"string" format:"date"`
+	EndDate      db.NullDate     `json:"end_date" swaggertype:"string" format:"date"`
+	// AvatarURL is the URL of the large avatar thumbnail, and AvatarThumbnails the URLs of every thumbnail by name.
+	AvatarURL        db.NullString     `json:"avatar_url" swaggertype:"string"`
+	AvatarThumbnails map[string]string `json:"avatar_thumbnails" swaggertype:"object"`
+	Tags             []tagResponse     `json:"tags"`
+	// TimeZone is the IANA time zone the member works in, and LocalTime the time it is there now.
+	TimeZone  db.NullString `json:"time_zone" swaggertype:"string" example:"Asia/Tokyo"`
+	LocalTime db.NullTime   `json:"local_time" swaggertype:"string" format:"date-time"`
 	CreatedAt time.Time     `json:"created_at"`
 }
 
 func newMemberResponse(member db.Member) memberResponse {
+	customFields := member.CustomFields
+	if len(customFields) == 0 {
+		customFields = json.RawMessage("{}")
+	}
+
+	avatarURL, avatarThumbnails := newAvatarURLs(member.AvatarUrl)
+
 	return memberResponse{
-		ID:        member.ID,
-		FirstName: member.FirstName,
-		LastName:  member.LastName,
-		Email:     db.NullString{NullString: member.Email},
-		CreatedAt: member.CreatedAt,
+		ID:               member.ID,
+		FirstName:        member.FirstName,
+		LastName:         member.LastName,
+		Email:            db.NullString{NullString: member.Email},
+		CustomFields:     customFields,
+		ManagerID:        member.ManagerID,
+		Status:           member.Status,
+		StartDate:        db.NullDate{NullTime: member.StartDate},
+		EndDate:          db.NullDate{NullTime: member.EndDate},
+		AvatarURL:        avatarURL,
+		AvatarThumbnails: avatarThumbnails,
+		Tags:             []tagResponse{},
+		TimeZone:         db.NullString{NullString: member.TimeZone},
+		LocalTime:        memberLocalTime(member),
+		CreatedAt:        member.CreatedAt,
 	}
 }
 
 // @Summary      Create member
+// @Description  custom_fields holds the values of the custom fields by key; every required custom field must be given.
+// @Description  Contact details are given either as a single email or as lists of emails, phones, addresses and links.
+// @Description  No two members can have the same primary email; members in the trash do not hold one.
 // @Tags         members
 // @Param        body body createMemberRequest true "Member object"
-// @Success      200 {object} memberResponse
+// @Success      200 {object} memberDetailResponse
 // @Failure      400 {object} errorResponse
+// @Failure      403 {object} errorResponse
 // @Failure      500 {object} errorResponse
 // @Router       /members [post]
 func (server *Server) createMember(c *fiber.Ctx) error {
@@ -52,19 +94,58 @@ func (server *Server) createMember(c *fiber.Ctx) error {
 	if err := validate.Struct(req); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
 	}
+	if len(req.Email) > 0 && req.Emails != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(errMemberEmailAndEmails))
+	}
+
+	contactDetails, err := req.memberContactDetailsRequest.params()
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	definitions, err := server.store.ListCustomFieldDefinitions(c.Context())
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	customFields, err := validateCustomFields(definitions, req.CustomFields, false)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	var startDate sql.NullTime
+	if len(req.StartDate) > 0 {
+		date, err := time.Parse(db.DateLayout, req.StartDate)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+		}
+		startDate = sql.NullTime{Time: date, Valid: true}
+	}
+
+	arg := db.CreateMemberTxParams{
+		CreateMemberParams: db.CreateMemberParams{
+			FirstName:    req.FirstName,
+			LastName:     req.LastName,
+			Email:        sql.NullString{String: req.Email, Valid: len(req.Email) > 0},
+			CustomFields: customFields,
+			Status:       req.Status,
+			StartDate:    startDate,
+		},
+		ContactDetails: contactDetails,
+	}
 
-	arg := db.CreateMemberParams{
-		FirstName: req.FirstName,
-		LastName:  req.LastName,
-		Email:     sql.NullString{String: req.Email, Valid: len(req.Email) > 0},
+	member, err := server.store.CreateMemberTx(c.Context(), arg, auditMeta(c))
+	if err != nil {
+		return c.Status(memberWriteStatus(err)).JSON(newErrorResponse(err))
 	}
 
-	member, err := server.store.CreateMember(c.Context(), arg)
+	details, err := server.store.GetMemberContactDetails(c.Context(), member.ID)
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
 	}
 
-	rsp := newMemberResponse(member)
+	c.Set(fiber.HeaderETag, versionETag(member.Version))
+	rsp := newMemberDetailResponse(newMemberResponse(member), details)
 	return c.Status(fiber.StatusOK).JSON(rsp)
 }
 
@@ -75,8 +156,10 @@ type getMemberRequest struct {
 // @Summary      Get member
 // @Tags         members
 // @Param        id path string true "Member ID"
-// @Success      200 {object} memberResponse
+// @Success      200 {object} memberDetailResponse
+// @Header       200 {string} ETag "Version of the member"
 // @Failure      400 {object} errorResponse
+// @Failure      404 {object} errorResponse
 // @Failure      500 {object} errorResponse
 // @Router       /members/{id} [get]
 func (server *Server) getMember(c *fiber.Ctx) error {
@@ -93,13 +176,76 @@ func (server *Server) getMember(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
 	}
 
-	rsp := newMemberResponse(member)
+	rsp, err := server.newMemberDetailResponseWithTags(c.Context(), member)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	c.Set(fiber.HeaderETag, versionETag(member.Version))
 	return c.Status(fiber.StatusOK).JSON(rsp)
 }
 
+// memberFilterQuery holds the query parameters narrowing down members, shared by the endpoints listing members.
+type memberFilterQuery struct {
+	Query         string `query:"q" json:"q" validate:"omitempty,max=100"`
+	HasEmail      *bool  `query:"has_email" json:"has_email"`
+	CreatedAfter  string `query:"created_after" json:"created_after" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00" format:"date-time"`
+	CreatedBefore string `query:"created_before" json:"created_before" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00" format:"date-time"`
+	Tags          string `query:"tags" json:"tags"`
+	TagsMatch     string `query:"tags_match" json:"tags_match" validate:"omitempty,oneof=any all" enums:"any,all"`
+	// Status is a comma separated list of lifecycle statuses, or "all" for members in any status. Only active members are listed by default.
+	Status string `query:"status" json:"status" example:"active,on_leave"`
+	Sort   string `query:"sort" json:"sort" example:"last_name,-created_at"`
+}
+
 type listMembersRequest struct {
 	PageID   int32 `query:"page_id" json:"page_id" validate:"required,min=1"`
 	PageSize int32 `query:"page_size" json:"page_size" validate:"required,min=5,max=10"`
+	memberFilterQuery
+}
+
+// memberFilter converts the filtering parameters of the request into a db.MemberFilter.
+func (req *memberFilterQuery) memberFilter() (db.MemberFilter, error) {
+	filter := db.MemberFilter{
+		Query: req.Query,
+	}
+
+	if req.HasEmail != nil {
+		filter.HasEmail = sql.NullBool{Bool: *req.HasEmail, Valid: true}
+	}
+
+	if len(req.CreatedAfter) > 0 {
+		createdAfter, err := time.Parse(time.RFC3339, req.CreatedAfter)
+		if err != nil {
+			return db.MemberFilter{}, err
+		}
+		filter.CreatedAfter = sql.NullTime{Time: createdAfter, Valid: true}
+	}
+
+	if len(req.CreatedBefore) > 0 {
+		createdBefore, err := time.Parse(time.RFC3339, req.CreatedBefore)
+		if err != nil {
+			return db.MemberFilter{}, err
+		}
+		filter.CreatedBefore = sql.NullTime{Time: createdBefore, Valid: true}
+	}
+
+	if len(req.Tags) > 0 {
+		tagIDs, err := memberIDsFromCommaSeparatedString(req.Tags)
+		if err != nil {
+			return db.MemberFilter{}, err
+		}
+		filter.TagIDs = tagIDs
+		filter.TagsMatchAll = req.TagsMatch == "all"
+	}
+
+	statuses, err := memberStatusesFromQuery(req.Status)
+	if err != nil {
+		return db.MemberFilter{}, err
+	}
+	filter.Statuses = statuses
+
+	return filter, nil
 }
 
 type membersResponse []memberResponse
@@ -125,6 +271,9 @@ type listMembersResponse struct {
 }
 
 // @Summary      List members
+// @Description  Members can be filtered by custom fields with custom_fields[<key>]=<value>.
+// @Description  A multi_select field matches when it contains every given value; other fields must equal the value.
+// @Description  tags narrows members down to those having any (default) or, with tags_match=all, all of the given tags.
 // @Tags         members
 // @Param        query query listMembersRequest true "query"
 // @Success      200 {object} listMembersResponse
@@ -142,23 +291,44 @@ func (server *Server) listMembers(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
 	}
 
-	arg := db.ListMembersParams{
+	filter, err := req.memberFilter()
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	if status, err := server.customFieldsFilter(c, &filter); err != nil {
+		return c.Status(status).JSON(newErrorResponse(err))
+	}
+
+	sort, err := db.ParseMemberSort(req.Sort)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	arg := db.ListMembersByFilterParams{
+		Filter: filter,
+		Sort:   sort,
 		Limit:  req.PageSize,
 		Offset: (req.PageID - 1) * req.PageSize,
 	}
 
-	members, err := server.store.ListMembers(c.Context(), arg)
+	members, err := server.store.ListMembersByFilter(c.Context(), arg)
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
 	}
 
-	totalCount, err := server.store.CountMembers(c.Context())
+	totalCount, err := server.store.CountMembersByFilter(c.Context(), filter)
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
 	}
 
 	pageCount := int64(math.Ceil(float64(totalCount) / float64(req.PageSize)))
 
+	data, err := server.newMemberResponsesWithTags(c.Context(), members)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
 	rsp := listMembersResponse{
 		Meta: listMembersResponseMeta{
 			PageID:     req.PageID,
@@ -166,7 +336,7 @@ func (server *Server) listMembers(c *fiber.Ctx) error {
 			PageCount:  pageCount,
 			TotalCount: totalCount,
 		},
-		Data: newMembersResponse(members),
+		Data: data,
 	}
 	return c.Status(fiber.StatusOK).JSON(rsp)
 }
@@ -179,14 +349,26 @@ type updateMemberRequestBody struct {
 	FirstName string `json:"first_name" validate:"omitempty" swaggertype:"string"`
 	LastName  string `json:"last_name" validate:"omitempty" swaggertype:"string"`
 	Email     string `json:"email" validate:"omitempty,email" swaggertype:"string" format:"email"`
+	// CustomFields is merged into the custom fields of the member, where null removes a field.
+	CustomFields map[string]json.RawMessage `json:"custom_fields" swaggertype:"object"`
+	memberContactDetailsRequest
 }
 
 // @Summary      Update member
+// @Description  The update only applies when If-Match holds the current ETag of the member.
+// @Description  Otherwise 412 is returned together with the current member.
+// @Description  Each list of contact details given replaces all the details of its kind.
 // @Tags         members
-// @Param        id   path string                  true "Member ID"
-// @Param        body body updateMemberRequestBody true "Member object"
-// @Success      200 {object} memberResponse
+// @Param        id       path   string                  true  "Member ID"
+// @Param        If-Match header string                  false "ETag of the member being updated"
+// @Param        body     body   updateMemberRequestBody true  "Member object"
+// @Success      200 {object} memberDetailResponse
+// @Header       200 {string} ETag "Version of the member"
 // @Failure      400 {object} errorResponse
+// @Failure      403 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      412 {object} memberDetailResponse
+// @Failure      428 {object} errorResponse
 // @Failure      500 {object} errorResponse
 // @Router       /members/{id} [put]
 func (server *Server) updateMember(c *fiber.Ctx) error {
@@ -207,20 +389,217 @@ func (server *Server) updateMember(c *fiber.Ctx) error {
 	if err := validate.Struct(body); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
 	}
+	if len(body.Email) > 0 && body.Emails != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(errMemberEmailAndEmails))
+	}
+
+	contactDetails, err := body.memberContactDetailsRequest.params()
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	ifMatch := c.Get(fiber.HeaderIfMatch)
+	if len(ifMatch) == 0 && server.config.MemberUpdateRequireIfMatch {
+		return c.Status(fiber.StatusPreconditionRequired).JSON(newErrorResponse(errIfMatchRequired))
+	}
+
+	customFields, status, err := server.mergeableCustomFields(c, body.CustomFields)
+	if err != nil {
+		return c.Status(status).JSON(newErrorResponse(err))
+	}
+
+	arg := db.UpdateMemberTxParams{
+		UpdateMemberParams: db.UpdateMemberParams{
+			ID:               params.ID,
+			FirstName:        sql.NullString{String: body.FirstName, Valid: len(body.FirstName) > 0},
+			LastName:         sql.NullString{String: body.LastName, Valid: len(body.LastName) > 0},
+			Email:            sql.NullString{String: body.Email, Valid: len(body.Email) > 0},
+			CustomFields:     customFields,
+			ExpectedVersions: parseIfMatch(ifMatch),
+		},
+		ContactDetails: contactDetails,
+	}
+
+	return server.applyMemberUpdate(c, arg.ID, arg.ExpectedVersions, func() (db.Member, error) {
+		return server.store.UpdateMemberTx(c.Context(), arg, auditMeta(c))
+	})
+}
+
+// optionalNullString is a member of a JSON Merge Patch document (RFC 7396).
+// Set tells whether the member is present at all, while a present null leaves NullString invalid.
+type optionalNullString struct {
+	Set bool
+	db.NullString
+}
+
+func (o *optionalNullString) UnmarshalJSON(data []byte) error {
+	// encoding/json calls UnmarshalJSON for null as well, so every present member ends up here.
+	o.Set = true
+	return o.NullString.UnmarshalJSON(data)
+}
+
+type patchMemberRequestParams struct {
+	ID uuid.UUID `params:"id" validate:"required"`
+}
+
+type patchMemberRequestBody struct {
+	FirstName optionalNullString `json:"first_name" swaggertype:"string"`
+	LastName  optionalNullString `json:"last_name" swaggertype:"string"`
+	Email     optionalNullString `json:"email" swaggertype:"string" format:"email" extensions:"x-nullable"`
+	// CustomFields is merged into the custom fields of the member, where null removes a field.
+	CustomFields map[string]json.RawMessage `json:"custom_fields" swaggertype:"object"`
+	memberContactDetailsRequest
+}
+
+func (body *patchMemberRequestBody) validate(validate *validator.Validate) error {
+	if body.FirstName.Set && len(body.FirstName.String) == 0 {
+		return errors.New("first_name cannot be empty or null")
+	}
+	if body.LastName.Set && len(body.LastName.String) == 0 {
+		return errors.New("last_name cannot be empty or null")
+	}
+	if body.Email.Set && body.Email.Valid {
+		if err := validate.Var(body.Email.String, "email"); err != nil {
+			return fmt.Errorf("email: %w", err)
+		}
+	}
+	if body.Email.Set && body.Emails != nil {
+		return errMemberEmailAndEmails
+	}
+	return validate.Struct(body.memberContactDetailsRequest)
+}
+
+// @Summary      Patch member
+// @Description  Applies a JSON Merge Patch (RFC 7396) to the member: absent fields are left untouched
+// @Description  and null clears a nullable field such as email.
+// @Description  A list of contact details replaces all the details of its kind, and null removes them.
+// @Description  The same If-Match rules as "Update member" apply.
+// @Tags         members
+// @Accept       application/merge-patch+json
+// @Param        id       path   string                 true  "Member ID"
+// @Param        If-Match header string                 false "ETag of the member being updated"
+// @Param        body     body   patchMemberRequestBody true  "Merge patch"
+// @Success      200 {object} memberDetailResponse
+// @Header       200 {string} ETag "Version of the member"
+// @Failure      400 {object} errorResponse
+// @Failure      403 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      412 {object} memberDetailResponse
+// @Failure      428 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /members/{id} [patch]
+func (server *Server) patchMember(c *fiber.Ctx) error {
+	params := new(patchMemberRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	// The body is decoded directly, since a merge patch must be a JSON object whatever its media type is.
+	body := new(patchMemberRequestBody)
+	if err := json.Unmarshal(c.Body(), body); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+	if err := body.clearNullLists(c.Body()); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	validate := newValidator()
+	if err := validate.Struct(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+	if err := body.validate(validate); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	contactDetails, err := body.memberContactDetailsRequest.params()
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	ifMatch := c.Get(fiber.HeaderIfMatch)
+	if len(ifMatch) == 0 && server.config.MemberUpdateRequireIfMatch {
+		return c.Status(fiber.StatusPreconditionRequired).JSON(newErrorResponse(errIfMatchRequired))
+	}
+
+	customFields, status, err := server.mergeableCustomFields(c, body.CustomFields)
+	if err != nil {
+		return c.Status(status).JSON(newErrorResponse(err))
+	}
+
+	arg := db.PatchMemberTxParams{
+		PatchMemberParams: db.PatchMemberParams{
+			ID:               params.ID,
+			FirstName:        body.FirstName.NullString.NullString,
+			LastName:         body.LastName.NullString.NullString,
+			SetEmail:         body.Email.Set,
+			Email:            body.Email.NullString.NullString,
+			CustomFields:     customFields,
+			ExpectedVersions: parseIfMatch(ifMatch),
+		},
+		ContactDetails: contactDetails,
+	}
+
+	return server.applyMemberUpdate(c, arg.ID, arg.ExpectedVersions, func() (db.Member, error) {
+		return server.store.PatchMemberTx(c.Context(), arg, auditMeta(c))
+	})
+}
+
+// mergeableCustomFields validates the custom fields to be merged into those of a member.
+// The definitions are only looked up when custom fields are given.
+// The returned status tells which status to respond with when an error is returned.
+func (server *Server) mergeableCustomFields(c *fiber.Ctx, values map[string]json.RawMessage) (string, int, error) {
+	if len(values) == 0 {
+		return "", fiber.StatusOK, nil
+	}
+
+	definitions, err := server.store.ListCustomFieldDefinitions(c.Context())
+	if err != nil {
+		return "", fiber.StatusInternalServerError, err
+	}
+
+	customFields, err := validateCustomFields(definitions, values, true)
+	if err != nil {
+		return "", fiber.StatusBadRequest, err
+	}
+	return customFields, fiber.StatusOK, nil
+}
+
+// applyMemberUpdate runs a conditional member update and writes the response.
+// When no row is updated, the member is looked up again to tell a missing member (404)
+// from a version that no longer matches (412 with the current member).
+func (server *Server) applyMemberUpdate(c *fiber.Ctx, id uuid.UUID, expectedVersions []int32, update func() (db.Member, error)) error {
+	member, err := update()
+	if err != nil {
+		if err != sql.ErrNoRows {
+			return c.Status(memberWriteStatus(err)).JSON(newErrorResponse(err))
+		}
+		if expectedVersions == nil {
+			return c.Status(fiber.StatusNotFound).JSON(newErrorResponse(err))
+		}
+
+		current, err := server.store.GetMember(c.Context(), id)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return c.Status(fiber.StatusNotFound).JSON(newErrorResponse(err))
+			}
+			return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+		}
+
+		rsp, err := server.newMemberDetailResponseWithTags(c.Context(), current)
+		if err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+		}
 
-	arg := db.UpdateMemberParams{
-		ID:        params.ID,
-		FirstName: sql.NullString{String: body.FirstName, Valid: len(body.FirstName) > 0},
-		LastName:  sql.NullString{String: body.LastName, Valid: len(body.LastName) > 0},
-		Email:     sql.NullString{String: body.Email, Valid: len(body.Email) > 0},
+		c.Set(fiber.HeaderETag, versionETag(current.Version))
+		return c.Status(fiber.StatusPreconditionFailed).JSON(rsp)
 	}
 
-	member, err := server.store.UpdateMember(c.Context(), arg)
+	rsp, err := server.newMemberDetailResponseWithTags(c.Context(), member)
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
 	}
 
-	rsp := newMemberResponse(member)
+	c.Set(fiber.HeaderETag, versionETag(member.Version))
 	return c.Status(fiber.StatusOK).JSON(rsp)
 }
 
@@ -229,6 +608,7 @@ type deleteMemberRequest struct {
 }
 
 // @Summary      Delete member
+// @Description  Moves the member to the trash, from where it can be restored until the retention period has passed.
 // @Tags         members
 // @Param        id path string true "Member ID"
 // @Success      204 {object} nil
@@ -241,7 +621,7 @@ func (server *Server) deleteMember(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
 	}
 
-	err := server.store.DeleteMember(c.Context(), req.ID)
+	_, err := server.store.DeleteMembersTx(c.Context(), []uuid.UUID{req.ID}, auditMeta(c))
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
 	}
@@ -254,6 +634,7 @@ type deleteMembersRequest struct {
 }
 
 // @Summary      Delete members
+// @Description  Moves the members to the trash, from where they can be restored until the retention period has passed.
 // @Tags         members
 // @Param        query query deleteMembersRequest true "query"
 // @Success      204 {object} nil
@@ -276,7 +657,7 @@ func (server *Server) deleteMembers(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
 	}
 
-	err = server.store.DeleteMembers(c.Context(), IDs)
+	_, err = server.store.DeleteMembersTx(c.Context(), IDs, auditMeta(c))
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
 	}
@@ -0,0 +1,192 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"io"
+	"net/http"
+
+	// The decoders of the accepted formats register themselves with the image package.
+	_ "image/gif"
+	_ "image/png"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	db "github.com/ot07/coworker-backend/db/sqlc"
+	"github.com/ot07/coworker-backend/storage"
+	"github.com/ot07/coworker-backend/util"
+)
+
+const (
+	avatarFormField = "avatar"
+	// avatarMaxSize is the largest avatar upload accepted, in bytes.
+	avatarMaxSize = 4 << 20
+	// avatarMaxPixels bounds the decoded size of an avatar, so that a small file cannot claim a huge image.
+	avatarMaxPixels   = 40_000_000
+	avatarJPEGQuality = 85
+	// avatarDefaultThumbnail is the thumbnail avatar_url points to.
+	avatarDefaultThumbnail = "large"
+)
+
+// avatarContentTypes maps the content types accepted for avatars to the name of their image format.
+var avatarContentTypes = map[string]string{
+	"image/jpeg": "jpeg",
+	"image/png":  "png",
+	"image/gif":  "gif",
+}
+
+var (
+	errAvatarTooLarge      = fmt.Errorf("avatar must not be larger than %d bytes", avatarMaxSize)
+	errAvatarContentType   = errors.New("avatar must be a JPEG, PNG or GIF image")
+	errAvatarTooManyPixels = fmt.Errorf("avatar must not have more than %d pixels", avatarMaxPixels)
+)
+
+// newAvatarURLs returns the URL of the default thumbnail and the URLs of all the thumbnails
+// of the avatar whose thumbnails are served under prefixURL.
+func newAvatarURLs(prefixURL sql.NullString) (db.NullString, map[string]string) {
+	if !prefixURL.Valid {
+		return db.NullString{}, nil
+	}
+
+	thumbnails := make(map[string]string, len(storage.AvatarThumbnails))
+	for _, thumbnail := range storage.AvatarThumbnails {
+		thumbnails[thumbnail.Name] = storage.AvatarThumbnailKey(prefixURL.String, thumbnail.Name)
+	}
+	url := sql.NullString{String: thumbnails[avatarDefaultThumbnail], Valid: true}
+	return db.NullString{NullString: url}, thumbnails
+}
+
+// decodeAvatar checks that data holds an image of the declared content type and decodes it upright.
+// The image is decoded into pixels only, which leaves behind the EXIF data and any other metadata.
+func decodeAvatar(data []byte, contentType string) (image.Image, error) {
+	format, ok := avatarContentTypes[contentType]
+	if !ok || http.DetectContentType(data) != contentType {
+		return nil, errAvatarContentType
+	}
+
+	config, configFormat, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil || configFormat != format {
+		return nil, errAvatarContentType
+	}
+	if int64(config.Width)*int64(config.Height) > avatarMaxPixels {
+		return nil, errAvatarTooManyPixels
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	if format == "jpeg" {
+		img = util.Orient(img, util.JPEGOrientation(data))
+	}
+	return img, nil
+}
+
+// storeAvatarThumbnails stores the thumbnails of an avatar under prefix,
+// removing those already stored when one of them fails.
+func (server *Server) storeAvatarThumbnails(ctx context.Context, prefix string, img image.Image) error {
+	for i, thumbnail := range storage.AvatarThumbnails {
+		var buf bytes.Buffer
+		err := jpeg.Encode(&buf, util.Thumbnail(img, thumbnail.Size), &jpeg.Options{Quality: avatarJPEGQuality})
+		if err == nil {
+			err = server.storage.Put(ctx, storage.AvatarThumbnailKey(prefix, thumbnail.Name), "image/jpeg", buf.Bytes())
+		}
+		if err != nil {
+			storage.DeleteAvatarThumbnails(ctx, server.storage, prefix, i)
+			return err
+		}
+	}
+	return nil
+}
+
+type uploadMemberAvatarRequest struct {
+	ID uuid.UUID `params:"id"`
+}
+
+// @Summary      Upload member avatar
+// @Description  Accepts a JPEG, PNG or GIF image of up to 4 MiB, which is turned into square thumbnails
+// @Description  of 64, 128 and 256 pixels with any EXIF data stripped. The previous avatar is removed.
+// @Tags         members
+// @Accept       multipart/form-data
+// @Param        id     path     string true "Member ID"
+// @Param        avatar formData file   true "Image"
+// @Success      200 {object} memberResponse
+// @Failure      400 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      413 {object} errorResponse
+// @Failure      415 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /members/{id}/avatar [put]
+func (server *Server) uploadMemberAvatar(c *fiber.Ctx) error {
+	req := new(uploadMemberAvatarRequest)
+	if err := c.ParamsParser(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	fileHeader, err := c.FormFile(avatarFormField)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+	if fileHeader.Size > avatarMaxSize {
+		return c.Status(fiber.StatusRequestEntityTooLarge).JSON(newErrorResponse(errAvatarTooLarge))
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	img, err := decodeAvatar(data, fileHeader.Header.Get(fiber.HeaderContentType))
+	if err != nil {
+		if err == errAvatarContentType {
+			return c.Status(fiber.StatusUnsupportedMediaType).JSON(newErrorResponse(err))
+		}
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	// Every upload gets its own prefix, so that caches never serve the previous avatar under the new URLs.
+	prefix := fmt.Sprintf("avatars/%s/%s", req.ID, util.RandomUUID())
+	if err := server.storeAvatarThumbnails(c.Context(), prefix, img); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	arg := db.SetMemberAvatarParams{
+		ID:        req.ID,
+		AvatarKey: sql.NullString{String: prefix, Valid: true},
+		AvatarUrl: sql.NullString{String: server.storage.URL(prefix), Valid: true},
+	}
+
+	result, err := server.store.SetMemberAvatarTx(c.Context(), arg, auditMeta(c))
+	if err != nil {
+		storage.DeleteAvatarThumbnails(c.Context(), server.storage, prefix, len(storage.AvatarThumbnails))
+		if err == sql.ErrNoRows {
+			return c.Status(fiber.StatusNotFound).JSON(newErrorResponse(err))
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	// The avatar removed is the one the update replaced, so that concurrent uploads never remove each other's avatar.
+	if result.PreviousAvatarKey.Valid {
+		storage.DeleteAvatarThumbnails(c.Context(), server.storage, result.PreviousAvatarKey.String, len(storage.AvatarThumbnails))
+	}
+
+	rsp, err := server.newMemberResponseWithTags(c.Context(), result.Member)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	c.Set(fiber.HeaderETag, versionETag(result.Member.Version))
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
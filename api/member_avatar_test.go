@@ -0,0 +1,431 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	mockdb "github.com/ot07/coworker-backend/db/mock"
+	db "github.com/ot07/coworker-backend/db/sqlc"
+	"github.com/ot07/coworker-backend/storage"
+	"github.com/stretchr/testify/require"
+)
+
+func randomAvatarPNG(t *testing.T, width int, height int) []byte {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.Set(x, y, color.RGBA{R: uint8(x), G: uint8(y), B: 128, A: 255})
+		}
+	}
+
+	var buf bytes.Buffer
+	err := png.Encode(&buf, img)
+	require.NoError(t, err)
+	return buf.Bytes()
+}
+
+func randomAvatarJPEG(t *testing.T, width int, height int) []byte {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+
+	var buf bytes.Buffer
+	err := jpeg.Encode(&buf, img, nil)
+	require.NoError(t, err)
+	return buf.Bytes()
+}
+
+func TestUploadMemberAvatarAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	member := randomMember()
+	member.AvatarKey = sql.NullString{String: fmt.Sprintf("avatars/%s/old", member.ID), Valid: true}
+	member.AvatarUrl = sql.NullString{String: testStoragePublicURL + "/" + member.AvatarKey.String, Valid: true}
+
+	pngAvatar := randomAvatarPNG(t, 300, 200)
+	jpegAvatar := randomAvatarJPEG(t, 100, 100)
+
+	testCases := []struct {
+		name          string
+		memberID      string
+		contentType   string
+		content       []byte
+		setupAuth     func(request *http.Request)
+		buildStubs    func(store *mockdb.MockStore, updated *db.Member)
+		checkResponse func(t *testing.T, response *http.Response, dir string, updated db.Member)
+	}{
+		{
+			name:        "OK",
+			memberID:    member.ID.String(),
+			contentType: "image/png",
+			content:     pngAvatar,
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore, updated *db.Member) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					SetMemberAvatarTx(gomock.Any(), gomock.Any(), eqAuditMeta(session.UserID)).
+					Times(1).
+					DoAndReturn(func(_ context.Context, arg db.SetMemberAvatarParams, _ db.AuditMeta) (db.SetMemberAvatarTxResult, error) {
+						require.Equal(t, member.ID, arg.ID)
+						*updated = member
+						updated.AvatarKey = arg.AvatarKey
+						updated.AvatarUrl = arg.AvatarUrl
+						updated.Version = member.Version + 1
+						return db.SetMemberAvatarTxResult{Member: *updated, PreviousAvatarKey: member.AvatarKey}, nil
+					})
+
+				store.EXPECT().
+					ListTagsByMemberIDs(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.ListTagsByMemberIDsRow{}, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response, dir string, updated db.Member) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+				require.Equal(t, versionETag(updated.Version), response.Header.Get(fiber.HeaderETag))
+				requireBodyMatchMember(t, response.Body, updated)
+
+				require.True(t, strings.HasPrefix(updated.AvatarKey.String, fmt.Sprintf("avatars/%s/", member.ID)))
+				require.Equal(t, testStoragePublicURL+"/"+updated.AvatarKey.String, updated.AvatarUrl.String)
+
+				for _, thumbnail := range storage.AvatarThumbnails {
+					data, err := os.ReadFile(filepath.Join(dir, storage.AvatarThumbnailKey(updated.AvatarKey.String, thumbnail.Name)))
+					require.NoError(t, err)
+
+					img, format, err := image.Decode(bytes.NewReader(data))
+					require.NoError(t, err)
+					require.Equal(t, "jpeg", format)
+					require.Equal(t, image.Rect(0, 0, thumbnail.Size, thumbnail.Size), img.Bounds())
+
+					// The previous avatar is removed.
+					_, err = os.Stat(filepath.Join(dir, storage.AvatarThumbnailKey(member.AvatarKey.String, thumbnail.Name)))
+					require.True(t, os.IsNotExist(err))
+				}
+			},
+		},
+		{
+			name:        "JPEG",
+			memberID:    member.ID.String(),
+			contentType: "image/jpeg",
+			content:     jpegAvatar,
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore, updated *db.Member) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					SetMemberAvatarTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, arg db.SetMemberAvatarParams, _ db.AuditMeta) (db.SetMemberAvatarTxResult, error) {
+						*updated = member
+						updated.AvatarKey = arg.AvatarKey
+						updated.AvatarUrl = arg.AvatarUrl
+						return db.SetMemberAvatarTxResult{Member: *updated, PreviousAvatarKey: member.AvatarKey}, nil
+					})
+
+				store.EXPECT().
+					ListTagsByMemberIDs(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.ListTagsByMemberIDsRow{}, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response, dir string, updated db.Member) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+				requireBodyMatchMember(t, response.Body, updated)
+			},
+		},
+		{
+			name:        "NoAuthorization",
+			memberID:    member.ID.String(),
+			contentType: "image/png",
+			content:     pngAvatar,
+			setupAuth: func(request *http.Request) {
+			},
+			buildStubs: func(store *mockdb.MockStore, updated *db.Member) {
+				store.EXPECT().
+					SetMemberAvatarTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response, dir string, updated db.Member) {
+				require.Equal(t, http.StatusUnauthorized, response.StatusCode)
+			},
+		},
+		{
+			name:     "NoFile",
+			memberID: member.ID.String(),
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore, updated *db.Member) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					SetMemberAvatarTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response, dir string, updated db.Member) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name:        "TooLarge",
+			memberID:    member.ID.String(),
+			contentType: "image/png",
+			content:     append(append([]byte{}, pngAvatar...), make([]byte, avatarMaxSize-len(pngAvatar)+1)...),
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore, updated *db.Member) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					SetMemberAvatarTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response, dir string, updated db.Member) {
+				require.Equal(t, http.StatusRequestEntityTooLarge, response.StatusCode)
+			},
+		},
+		{
+			name:        "UnsupportedContentType",
+			memberID:    member.ID.String(),
+			contentType: "image/svg+xml",
+			content:     []byte(`<svg xmlns="http://www.w3.org/2000/svg"></svg>`),
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore, updated *db.Member) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					SetMemberAvatarTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response, dir string, updated db.Member) {
+				require.Equal(t, http.StatusUnsupportedMediaType, response.StatusCode)
+			},
+		},
+		{
+			name:        "ContentTypeMismatch",
+			memberID:    member.ID.String(),
+			contentType: "image/jpeg",
+			content:     pngAvatar,
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore, updated *db.Member) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					SetMemberAvatarTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response, dir string, updated db.Member) {
+				require.Equal(t, http.StatusUnsupportedMediaType, response.StatusCode)
+			},
+		},
+		{
+			name:        "InvalidImage",
+			memberID:    member.ID.String(),
+			contentType: "image/png",
+			content:     pngAvatar[:len(pngAvatar)/2],
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore, updated *db.Member) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					SetMemberAvatarTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response, dir string, updated db.Member) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name:        "NotFound",
+			memberID:    member.ID.String(),
+			contentType: "image/png",
+			content:     pngAvatar,
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore, updated *db.Member) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					SetMemberAvatarTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.SetMemberAvatarTxResult{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, response *http.Response, dir string, updated db.Member) {
+				require.Equal(t, http.StatusNotFound, response.StatusCode)
+
+				avatars, err := os.ReadDir(filepath.Join(dir, "avatars", member.ID.String()))
+				require.NoError(t, err)
+				require.Len(t, avatars, 1)
+				require.Equal(t, "old", avatars[0].Name())
+			},
+		},
+		{
+			name:        "PreviousAvatarReplaced",
+			memberID:    member.ID.String(),
+			contentType: "image/png",
+			content:     pngAvatar,
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore, updated *db.Member) {
+				buildValidSessionStubs(store, session)
+
+				// A concurrent upload replaced the avatar first, so the update replaces the one it stored instead.
+				store.EXPECT().
+					SetMemberAvatarTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, arg db.SetMemberAvatarParams, _ db.AuditMeta) (db.SetMemberAvatarTxResult, error) {
+						*updated = member
+						updated.AvatarKey = arg.AvatarKey
+						updated.AvatarUrl = arg.AvatarUrl
+						previous := sql.NullString{String: fmt.Sprintf("avatars/%s/concurrent", member.ID), Valid: true}
+						return db.SetMemberAvatarTxResult{Member: *updated, PreviousAvatarKey: previous}, nil
+					})
+
+				store.EXPECT().
+					ListTagsByMemberIDs(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.ListTagsByMemberIDsRow{}, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response, dir string, updated db.Member) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+
+				// The avatar the concurrent upload replaced is left to that upload to remove.
+				for _, thumbnail := range storage.AvatarThumbnails {
+					_, err := os.Stat(filepath.Join(dir, storage.AvatarThumbnailKey(member.AvatarKey.String, thumbnail.Name)))
+					require.NoError(t, err)
+				}
+			},
+		},
+		{
+			name:        "InternalError",
+			memberID:    member.ID.String(),
+			contentType: "image/png",
+			content:     pngAvatar,
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore, updated *db.Member) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					SetMemberAvatarTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.SetMemberAvatarTxResult{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, response *http.Response, dir string, updated db.Member) {
+				require.Equal(t, http.StatusInternalServerError, response.StatusCode)
+
+				// Neither the new thumbnails are left behind nor the previous avatar removed.
+				avatars, err := os.ReadDir(filepath.Join(dir, "avatars", member.ID.String()))
+				require.NoError(t, err)
+				require.Len(t, avatars, 1)
+				require.Equal(t, "old", avatars[0].Name())
+			},
+		},
+		{
+			name:        "InvalidID",
+			memberID:    "invalid",
+			contentType: "image/png",
+			content:     pngAvatar,
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore, updated *db.Member) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					SetMemberAvatarTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response, dir string, updated db.Member) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			var updated db.Member
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store, &updated)
+
+			server := newTestServer(t, store)
+			fileStorage := server.storage.(*storage.LocalStorage)
+			for _, thumbnail := range storage.AvatarThumbnails {
+				err := fileStorage.Put(context.Background(), storage.AvatarThumbnailKey(member.AvatarKey.String, thumbnail.Name), "image/jpeg", jpegAvatar)
+				require.NoError(t, err)
+			}
+
+			body := new(bytes.Buffer)
+			writer := multipart.NewWriter(body)
+			if len(tc.content) > 0 {
+				header := make(textproto.MIMEHeader)
+				header.Set("Content-Disposition", `form-data; name="avatar"; filename="avatar"`)
+				header.Set("Content-Type", tc.contentType)
+				part, err := writer.CreatePart(header)
+				require.NoError(t, err)
+				_, err = part.Write(tc.content)
+				require.NoError(t, err)
+			}
+			err := writer.Close()
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/api/v1/members/%s/avatar", tc.memberID)
+			request, err := http.NewRequest(http.MethodPut, url, body)
+			require.NoError(t, err)
+			request.Header.Set("Content-Type", writer.FormDataContentType())
+
+			tc.setupAuth(request)
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response, fileStorage.Dir(), updated)
+		})
+	}
+}
+
+func TestDecodeAvatarTooManyPixels(t *testing.T) {
+	// A PNG header claiming a huge image is rejected before its pixels are decoded.
+	data := randomAvatarPNG(t, 1, 1)
+	copy(data[16:24], []byte{0, 0, 0x27, 0x10, 0, 0, 0x27, 0x10})
+	binary.BigEndian.PutUint32(data[29:33], crc32.ChecksumIEEE(data[12:29]))
+
+	_, err := decodeAvatar(data, "image/png")
+	require.ErrorIs(t, err, errAvatarTooManyPixels)
+}
@@ -0,0 +1,263 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	db "github.com/ot07/coworker-backend/db/sqlc"
+	"github.com/ot07/coworker-backend/util"
+)
+
+var (
+	errMemberEmailAndEmails  = errors.New("email and emails cannot both be given")
+	errMemberPrimaryEmails   = errors.New("emails can have only one primary email")
+	errMemberDuplicateEmails = errors.New("emails cannot hold the same email twice")
+)
+
+// memberWriteStatus tells which status to respond with for an error writing a member.
+// A primary email already held by another member is refused like any other duplicate.
+func memberWriteStatus(err error) int {
+	if pqErr, ok := err.(*pq.Error); ok {
+		switch pqErr.Code.Name() {
+		case "unique_violation":
+			return fiber.StatusForbidden
+		}
+	}
+	return fiber.StatusInternalServerError
+}
+
+type memberEmailRequest struct {
+	Label   string `json:"label" validate:"required,oneof=work personal other" enums:"work,personal,other"`
+	Email   string `json:"email" validate:"required,email,max=254" format:"email"`
+	Primary bool   `json:"primary"`
+}
+
+type memberPhoneRequest struct {
+	Label string `json:"label" validate:"required,oneof=work mobile home other" enums:"work,mobile,home,other"`
+	// Number is an international number, which is stored in the E.164 format.
+	Number string `json:"number" validate:"required,max=32" example:"+81 3-1234-5678"`
+}
+
+type memberAddressRequest struct {
+	Label      string `json:"label" validate:"required,oneof=work home other" enums:"work,home,other"`
+	Street     string `json:"street" validate:"max=200"`
+	City       string `json:"city" validate:"max=100"`
+	Region     string `json:"region" validate:"max=100"`
+	PostalCode string `json:"postal_code" validate:"max=20"`
+	Country    string `json:"country" validate:"required,iso3166_1_alpha2" example:"JP"`
+}
+
+type memberLinkRequest struct {
+	Label string `json:"label" validate:"required,max=50" example:"github"`
+	URL   string `json:"url" validate:"required,url,max=2048"`
+}
+
+// memberContactDetailsRequest holds the contact details given together with a member.
+// An absent list leaves the details of its kind untouched, while a given list replaces them all.
+type memberContactDetailsRequest struct {
+	// Emails may mark one email as primary; otherwise the first one is. The primary email is the email of the member.
+	Emails    *[]memberEmailRequest   `json:"emails" validate:"omitempty,max=10,dive"`
+	Phones    *[]memberPhoneRequest   `json:"phones" validate:"omitempty,max=10,dive"`
+	Addresses *[]memberAddressRequest `json:"addresses" validate:"omitempty,max=10,dive"`
+	Links     *[]memberLinkRequest    `json:"links" validate:"omitempty,max=20,dive"`
+}
+
+// clearNullLists makes the lists set to null in a merge patch empty, so that they remove the details of their kind.
+func (req *memberContactDetailsRequest) clearNullLists(body []byte) error {
+	var members map[string]json.RawMessage
+	if err := json.Unmarshal(body, &members); err != nil {
+		return err
+	}
+
+	if _, ok := members["emails"]; ok && req.Emails == nil {
+		req.Emails = &[]memberEmailRequest{}
+	}
+	if _, ok := members["phones"]; ok && req.Phones == nil {
+		req.Phones = &[]memberPhoneRequest{}
+	}
+	if _, ok := members["addresses"]; ok && req.Addresses == nil {
+		req.Addresses = &[]memberAddressRequest{}
+	}
+	if _, ok := members["links"]; ok && req.Links == nil {
+		req.Links = &[]memberLinkRequest{}
+	}
+	return nil
+}
+
+// params checks the contact details beyond what the validator covers, and converts them into db.MemberContactDetailsParams.
+func (req *memberContactDetailsRequest) params() (db.MemberContactDetailsParams, error) {
+	var arg db.MemberContactDetailsParams
+
+	if req.Emails != nil {
+		arg.Emails = make([]db.CreateMemberEmailParams, 0, len(*req.Emails))
+		seen := make(map[string]bool, len(*req.Emails))
+		primary := -1
+		for i, email := range *req.Emails {
+			address := strings.ToLower(email.Email)
+			if seen[address] {
+				return db.MemberContactDetailsParams{}, errMemberDuplicateEmails
+			}
+			seen[address] = true
+
+			if email.Primary {
+				if primary >= 0 {
+					return db.MemberContactDetailsParams{}, errMemberPrimaryEmails
+				}
+				primary = i
+			}
+			arg.Emails = append(arg.Emails, db.CreateMemberEmailParams{
+				Label: email.Label,
+				Email: email.Email,
+			})
+		}
+		if primary < 0 {
+			primary = 0
+		}
+		if len(arg.Emails) > 0 {
+			arg.Emails[primary].IsPrimary = true
+		}
+	}
+
+	if req.Phones != nil {
+		arg.Phones = make([]db.CreateMemberPhoneParams, 0, len(*req.Phones))
+		for i, phone := range *req.Phones {
+			number, err := util.NormalizePhoneNumber(phone.Number)
+			if err != nil {
+				return db.MemberContactDetailsParams{}, fmt.Errorf("phones[%d]: %w", i, err)
+			}
+			arg.Phones = append(arg.Phones, db.CreateMemberPhoneParams{
+				Label:  phone.Label,
+				Number: number,
+			})
+		}
+	}
+
+	if req.Addresses != nil {
+		arg.Addresses = make([]db.CreateMemberAddressParams, 0, len(*req.Addresses))
+		for _, address := range *req.Addresses {
+			arg.Addresses = append(arg.Addresses, db.CreateMemberAddressParams{
+				Label:      address.Label,
+				Street:     address.Street,
+				City:       address.City,
+				Region:     address.Region,
+				PostalCode: address.PostalCode,
+				Country:    address.Country,
+			})
+		}
+	}
+
+	if req.Links != nil {
+		arg.Links = make([]db.CreateMemberLinkParams, 0, len(*req.Links))
+		for _, link := range *req.Links {
+			arg.Links = append(arg.Links, db.CreateMemberLinkParams{
+				Label: link.Label,
+				Url:   link.URL,
+			})
+		}
+	}
+
+	return arg, nil
+}
+
+type memberEmailResponse struct {
+	ID      uuid.UUID `json:"id"`
+	Label   string    `json:"label"`
+	Email   string    `json:"email"`
+	Primary bool      `json:"primary"`
+}
+
+type memberPhoneResponse struct {
+	ID     uuid.UUID `json:"id"`
+	Label  string    `json:"label"`
+	Number string    `json:"number"`
+}
+
+type memberAddressResponse struct {
+	ID         uuid.UUID `json:"id"`
+	Label      string    `json:"label"`
+	Street     string    `json:"street"`
+	City       string    `json:"city"`
+	Region     string    `json:"region"`
+	PostalCode string    `json:"postal_code"`
+	Country    string    `json:"country"`
+}
+
+type memberLinkResponse struct {
+	ID    uuid.UUID `json:"id"`
+	Label string    `json:"label"`
+	URL   string    `json:"url"`
+}
+
+// memberDetailResponse is a member together with all its contact details.
+type memberDetailResponse struct {
+	memberResponse
+	Emails    []memberEmailResponse   `json:"emails"`
+	Phones    []memberPhoneResponse   `json:"phones"`
+	Addresses []memberAddressResponse `json:"addresses"`
+	Links     []memberLinkResponse    `json:"links"`
+}
+
+func newMemberDetailResponse(member memberResponse, details db.MemberContactDetails) memberDetailResponse {
+	rsp := memberDetailResponse{
+		memberResponse: member,
+		Emails:         make([]memberEmailResponse, 0, len(details.Emails)),
+		Phones:         make([]memberPhoneResponse, 0, len(details.Phones)),
+		Addresses:      make([]memberAddressResponse, 0, len(details.Addresses)),
+		Links:          make([]memberLinkResponse, 0, len(details.Links)),
+	}
+
+	for _, email := range details.Emails {
+		rsp.Emails = append(rsp.Emails, memberEmailResponse{
+			ID:      email.ID,
+			Label:   email.Label,
+			Email:   email.Email,
+			Primary: email.IsPrimary,
+		})
+	}
+	for _, phone := range details.Phones {
+		rsp.Phones = append(rsp.Phones, memberPhoneResponse{
+			ID:     phone.ID,
+			Label:  phone.Label,
+			Number: phone.Number,
+		})
+	}
+	for _, address := range details.Addresses {
+		rsp.Addresses = append(rsp.Addresses, memberAddressResponse{
+			ID:         address.ID,
+			Label:      address.Label,
+			Street:     address.Street,
+			City:       address.City,
+			Region:     address.Region,
+			PostalCode: address.PostalCode,
+			Country:    address.Country,
+		})
+	}
+	for _, link := range details.Links {
+		rsp.Links = append(rsp.Links, memberLinkResponse{
+			ID:    link.ID,
+			Label: link.Label,
+			URL:   link.Url,
+		})
+	}
+	return rsp
+}
+
+// newMemberDetailResponseWithTags converts the member into a response holding its tags and contact details.
+func (server *Server) newMemberDetailResponseWithTags(ctx context.Context, member db.Member) (memberDetailResponse, error) {
+	rsp, err := server.newMemberResponseWithTags(ctx, member)
+	if err != nil {
+		return memberDetailResponse{}, err
+	}
+
+	details, err := server.store.GetMemberContactDetails(ctx, member.ID)
+	if err != nil {
+		return memberDetailResponse{}, err
+	}
+	return newMemberDetailResponse(rsp, details), nil
+}
@@ -0,0 +1,478 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/lib/pq"
+	mockdb "github.com/ot07/coworker-backend/db/mock"
+	db "github.com/ot07/coworker-backend/db/sqlc"
+	"github.com/ot07/coworker-backend/util"
+	"github.com/stretchr/testify/require"
+)
+
+func randomMemberContactDetails(member db.Member) db.MemberContactDetails {
+	return db.MemberContactDetails{
+		Emails: []db.MemberEmail{
+			{ID: util.RandomUUID(), MemberID: member.ID, Label: "work", Email: member.Email.String, IsPrimary: true},
+			{ID: util.RandomUUID(), MemberID: member.ID, Label: "personal", Email: util.RandomEmail(), Position: 1},
+		},
+		Phones: []db.MemberPhone{
+			{ID: util.RandomUUID(), MemberID: member.ID, Label: "mobile", Number: "+819012345678"},
+		},
+		Addresses: []db.MemberAddress{
+			{ID: util.RandomUUID(), MemberID: member.ID, Label: "work", City: "Tokyo", Country: "JP"},
+		},
+		Links: []db.MemberLink{
+			{ID: util.RandomUUID(), MemberID: member.ID, Label: "github", Url: "https://github.com/" + util.RandomName()},
+		},
+	}
+}
+
+func buildMemberContactDetailsStubs(store *mockdb.MockStore, member db.Member, details db.MemberContactDetails) {
+	store.EXPECT().
+		GetMemberContactDetails(gomock.Any(), gomock.Eq(member.ID)).
+		Times(1).
+		Return(details, nil)
+}
+
+func TestCreateMemberContactDetailsAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	member := randomMember()
+	details := randomMemberContactDetails(member)
+	personalEmail := details.Emails[1].Email
+
+	testCases := []struct {
+		name          string
+		body          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name: "OK",
+			body: fmt.Sprintf(`{
+				"first_name": %q,
+				"last_name": %q,
+				"emails": [
+					{"label": "personal", "email": %q},
+					{"label": "work", "email": %q, "primary": true}
+				],
+				"phones": [{"label": "mobile", "number": "+81 90-1234-5678"}],
+				"addresses": [{"label": "work", "city": "Tokyo", "country": "JP"}],
+				"links": [{"label": "github", "url": %q}]
+			}`, member.FirstName, member.LastName, personalEmail, member.Email.String, details.Links[0].Url),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListCustomFieldDefinitions(gomock.Any()).
+					Times(1).
+					Return([]db.CustomFieldDefinition{}, nil)
+
+				arg := db.CreateMemberTxParams{
+					CreateMemberParams: db.CreateMemberParams{
+						FirstName: member.FirstName,
+						LastName:  member.LastName,
+					},
+					ContactDetails: db.MemberContactDetailsParams{
+						Emails: []db.CreateMemberEmailParams{
+							{Label: "personal", Email: personalEmail},
+							{Label: "work", Email: member.Email.String, IsPrimary: true},
+						},
+						Phones: []db.CreateMemberPhoneParams{
+							{Label: "mobile", Number: "+819012345678"},
+						},
+						Addresses: []db.CreateMemberAddressParams{
+							{Label: "work", City: "Tokyo", Country: "JP"},
+						},
+						Links: []db.CreateMemberLinkParams{
+							{Label: "github", Url: details.Links[0].Url},
+						},
+					},
+				}
+
+				store.EXPECT().
+					CreateMemberTx(gomock.Any(), gomock.Eq(arg), eqAuditMeta(session.UserID)).
+					Times(1).
+					Return(member, nil)
+
+				buildMemberContactDetailsStubs(store, member, details)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+				requireBodyMatchMemberDetail(t, response.Body, member, details)
+			},
+		},
+		{
+			name: "FirstEmailIsPrimary",
+			body: fmt.Sprintf(`{"first_name": %q, "last_name": %q, "emails": [{"label": "work", "email": %q}, {"label": "other", "email": %q}]}`,
+				member.FirstName, member.LastName, member.Email.String, personalEmail),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListCustomFieldDefinitions(gomock.Any()).
+					Times(1).
+					Return([]db.CustomFieldDefinition{}, nil)
+
+				arg := db.CreateMemberTxParams{
+					CreateMemberParams: db.CreateMemberParams{
+						FirstName: member.FirstName,
+						LastName:  member.LastName,
+					},
+					ContactDetails: db.MemberContactDetailsParams{
+						Emails: []db.CreateMemberEmailParams{
+							{Label: "work", Email: member.Email.String, IsPrimary: true},
+							{Label: "other", Email: personalEmail},
+						},
+					},
+				}
+
+				store.EXPECT().
+					CreateMemberTx(gomock.Any(), gomock.Eq(arg), gomock.Any()).
+					Times(1).
+					Return(member, nil)
+
+				buildMemberContactDetailsStubs(store, member, details)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+			},
+		},
+		{
+			name: "PrimaryEmailTaken",
+			body: fmt.Sprintf(`{"first_name": %q, "last_name": %q, "emails": [{"label": "work", "email": %q}]}`,
+				member.FirstName, member.LastName, member.Email.String),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListCustomFieldDefinitions(gomock.Any()).
+					Times(1).
+					Return([]db.CustomFieldDefinition{}, nil)
+
+				store.EXPECT().
+					CreateMemberTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Member{}, &pq.Error{Code: "23505"})
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusForbidden, response.StatusCode)
+			},
+		},
+		{
+			name: "EmailAndEmails",
+			body: fmt.Sprintf(`{"first_name": %q, "last_name": %q, "email": %q, "emails": [{"label": "work", "email": %q}]}`,
+				member.FirstName, member.LastName, member.Email.String, personalEmail),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateMemberTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "TwoPrimaryEmails",
+			body: fmt.Sprintf(`{"first_name": %q, "last_name": %q, "emails": [{"label": "work", "email": %q, "primary": true}, {"label": "personal", "email": %q, "primary": true}]}`,
+				member.FirstName, member.LastName, member.Email.String, personalEmail),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateMemberTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "DuplicateEmails",
+			body: fmt.Sprintf(`{"first_name": %q, "last_name": %q, "emails": [{"label": "work", "email": "a@example.com"}, {"label": "personal", "email": "A@example.com"}]}`,
+				member.FirstName, member.LastName),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateMemberTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "InvalidEmailLabel",
+			body: fmt.Sprintf(`{"first_name": %q, "last_name": %q, "emails": [{"label": "school", "email": %q}]}`,
+				member.FirstName, member.LastName, member.Email.String),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateMemberTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "NationalPhoneNumber",
+			body: fmt.Sprintf(`{"first_name": %q, "last_name": %q, "phones": [{"label": "work", "number": "03-1234-5678"}]}`,
+				member.FirstName, member.LastName),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateMemberTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "InvalidCountry",
+			body: fmt.Sprintf(`{"first_name": %q, "last_name": %q, "addresses": [{"label": "home", "country": "Japan"}]}`,
+				member.FirstName, member.LastName),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateMemberTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "InvalidLinkURL",
+			body: fmt.Sprintf(`{"first_name": %q, "last_name": %q, "links": [{"label": "github", "url": "github"}]}`,
+				member.FirstName, member.LastName),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateMemberTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			buildValidSessionStubs(store, session)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			url := "/api/v1/members"
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader([]byte(tc.body)))
+			require.NoError(t, err)
+
+			request.Header.Set("Content-Type", "application/json")
+
+			addSessionTokenInCookie(request, session.SessionToken.String())
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func TestUpdateMemberContactDetailsAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	member := randomMember()
+	details := randomMemberContactDetails(member)
+
+	testCases := []struct {
+		name          string
+		method        string
+		body          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name:   "UpdateReplacesGivenLists",
+			method: http.MethodPut,
+			body:   `{"phones": [{"label": "mobile", "number": "+81 90 1234 5678"}], "links": []}`,
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.UpdateMemberTxParams{
+					UpdateMemberParams: db.UpdateMemberParams{ID: member.ID},
+					ContactDetails: db.MemberContactDetailsParams{
+						Phones: []db.CreateMemberPhoneParams{
+							{Label: "mobile", Number: "+819012345678"},
+						},
+						Links: []db.CreateMemberLinkParams{},
+					},
+				}
+
+				store.EXPECT().
+					UpdateMemberTx(gomock.Any(), gomock.Eq(arg), eqAuditMeta(session.UserID)).
+					Times(1).
+					Return(member, nil)
+
+				buildMemberTagsStubs(store, []db.Member{member})
+				buildMemberContactDetailsStubs(store, member, details)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+				requireBodyMatchMemberDetail(t, response.Body, member, details)
+			},
+		},
+		{
+			name:   "UpdatePrimaryEmailTaken",
+			method: http.MethodPut,
+			body:   `{"emails": [{"label": "work", "email": "taken@example.com"}]}`,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UpdateMemberTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Member{}, &pq.Error{Code: "23505"})
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusForbidden, response.StatusCode)
+			},
+		},
+		{
+			name:   "UpdateEmailAndEmails",
+			method: http.MethodPut,
+			body:   `{"email": "a@example.com", "emails": []}`,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UpdateMemberTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name:   "PatchNullRemovesList",
+			method: http.MethodPatch,
+			body:   `{"addresses": null, "emails": [{"label": "personal", "email": "a@example.com"}]}`,
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.PatchMemberTxParams{
+					PatchMemberParams: db.PatchMemberParams{ID: member.ID},
+					ContactDetails: db.MemberContactDetailsParams{
+						Emails: []db.CreateMemberEmailParams{
+							{Label: "personal", Email: "a@example.com", IsPrimary: true},
+						},
+						Addresses: []db.CreateMemberAddressParams{},
+					},
+				}
+
+				store.EXPECT().
+					PatchMemberTx(gomock.Any(), gomock.Eq(arg), eqAuditMeta(session.UserID)).
+					Times(1).
+					Return(member, nil)
+
+				buildMemberTagsStubs(store, []db.Member{member})
+				buildMemberContactDetailsStubs(store, member, details)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+				requireBodyMatchMemberDetail(t, response.Body, member, details)
+			},
+		},
+		{
+			name:   "PatchEmailAndEmails",
+			method: http.MethodPatch,
+			body:   `{"email": null, "emails": []}`,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					PatchMemberTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name:   "PatchInvalidPhone",
+			method: http.MethodPatch,
+			body:   `{"phones": [{"label": "home", "number": "+81 3 1234 5678 ext 9"}]}`,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					PatchMemberTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			buildValidSessionStubs(store, session)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			url := fmt.Sprintf("/api/v1/members/%s", member.ID)
+			request, err := http.NewRequest(tc.method, url, bytes.NewReader([]byte(tc.body)))
+			require.NoError(t, err)
+
+			request.Header.Set("Content-Type", "application/json")
+
+			addSessionTokenInCookie(request, session.SessionToken.String())
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func requireBodyMatchMemberDetail(t *testing.T, body io.ReadCloser, member db.Member, details db.MemberContactDetails) {
+	data, err := io.ReadAll(body)
+	require.NoError(t, err)
+
+	var got memberDetailResponse
+	err = json.Unmarshal(data, &got)
+	require.NoError(t, err)
+
+	requireMemberResponseMatchMember(t, got.memberResponse, member)
+
+	require.Len(t, got.Emails, len(details.Emails))
+	for i, email := range details.Emails {
+		require.Equal(t, email.ID, got.Emails[i].ID)
+		require.Equal(t, email.Label, got.Emails[i].Label)
+		require.Equal(t, email.Email, got.Emails[i].Email)
+		require.Equal(t, email.IsPrimary, got.Emails[i].Primary)
+	}
+	require.Len(t, got.Phones, len(details.Phones))
+	for i, phone := range details.Phones {
+		require.Equal(t, phone.Label, got.Phones[i].Label)
+		require.Equal(t, phone.Number, got.Phones[i].Number)
+	}
+	require.Len(t, got.Addresses, len(details.Addresses))
+	for i, address := range details.Addresses {
+		require.Equal(t, address.City, got.Addresses[i].City)
+		require.Equal(t, address.Country, got.Addresses[i].Country)
+	}
+	require.Len(t, got.Links, len(details.Links))
+	for i, link := range details.Links {
+		require.Equal(t, link.Label, got.Links[i].Label)
+		require.Equal(t, link.Url, got.Links[i].URL)
+	}
+
+	err = body.Close()
+	require.NoError(t, err)
+}
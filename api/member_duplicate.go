@@ -0,0 +1,246 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	db "github.com/ot07/coworker-backend/db/sqlc"
+	"github.com/ot07/coworker-backend/storage"
+)
+
+const (
+	defaultMemberDuplicatesLimit         = 20
+	defaultMemberDuplicatesMinSimilarity = 0.6
+	// memberDuplicatePairLimit bounds the pairs of alike members looked at, from which the groups are formed.
+	memberDuplicatePairLimit = 1000
+)
+
+// Reasons why two members are considered duplicates.
+const (
+	memberDuplicateReasonEmail = "email"
+	memberDuplicateReasonName  = "name"
+)
+
+type listMemberDuplicatesRequest struct {
+	// MinSimilarity is the similarity, from 0.3 to 1, from which names are considered alike.
+	MinSimilarity float32 `query:"min_similarity" json:"min_similarity" validate:"omitempty,min=0.3,max=1" example:"0.6"`
+	Limit         int32   `query:"limit" json:"limit" validate:"omitempty,min=1,max=100"`
+}
+
+type memberDuplicateMatch struct {
+	MemberID    uuid.UUID `json:"member_id"`
+	DuplicateID uuid.UUID `json:"duplicate_id"`
+	// Reasons tells why the members look alike: they share a normalized email, or they have similar names.
+	Reasons        []string `json:"reasons" enums:"email,name"`
+	NameSimilarity float32  `json:"name_similarity"`
+}
+
+type memberDuplicateGroup struct {
+	// Members lists the members of the group, oldest first.
+	Members []memberResponse       `json:"members"`
+	Matches []memberDuplicateMatch `json:"matches"`
+}
+
+type listMemberDuplicatesResponse struct {
+	Data []memberDuplicateGroup `json:"data"`
+}
+
+// groupMemberDuplicates joins the pairs of alike members into groups, so that members alike through another member end up together.
+// Groups are ordered as their first pair is, and so are the pairs within a group.
+func groupMemberDuplicates(pairs []db.ListMemberDuplicatePairsRow) [][]db.ListMemberDuplicatePairsRow {
+	parent := make(map[uuid.UUID]uuid.UUID)
+	var find func(id uuid.UUID) uuid.UUID
+	find = func(id uuid.UUID) uuid.UUID {
+		p, ok := parent[id]
+		if !ok || p == id {
+			return id
+		}
+		root := find(p)
+		parent[id] = root
+		return root
+	}
+	for _, pair := range pairs {
+		a, b := find(pair.MemberID), find(pair.DuplicateID)
+		if a != b {
+			parent[b] = a
+		}
+	}
+
+	index := make(map[uuid.UUID]int)
+	var groups [][]db.ListMemberDuplicatePairsRow
+	for _, pair := range pairs {
+		root := find(pair.MemberID)
+		i, ok := index[root]
+		if !ok {
+			i = len(groups)
+			index[root] = i
+			groups = append(groups, nil)
+		}
+		groups[i] = append(groups[i], pair)
+	}
+	return groups
+}
+
+// @Summary      List member duplicates
+// @Description  Finds groups of members that are likely duplicates: members sharing an email once case and any "+tag" are ignored,
+// @Description  or members with similar names. Members in the trash are left out.
+// @Tags         members
+// @Param        query query listMemberDuplicatesRequest true "query"
+// @Success      200 {object} listMemberDuplicatesResponse
+// @Failure      400 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /members/duplicates [get]
+func (server *Server) listMemberDuplicates(c *fiber.Ctx) error {
+	req := new(listMemberDuplicatesRequest)
+	if err := c.QueryParser(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	validate := newValidator()
+	if err := validate.Struct(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+	if req.MinSimilarity == 0 {
+		req.MinSimilarity = defaultMemberDuplicatesMinSimilarity
+	}
+	if req.Limit == 0 {
+		req.Limit = defaultMemberDuplicatesLimit
+	}
+
+	pairs, err := server.store.ListMemberDuplicatePairs(c.Context(), db.ListMemberDuplicatePairsParams{
+		MinNameSimilarity: req.MinSimilarity,
+		PairLimit:         memberDuplicatePairLimit,
+	})
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	groups := groupMemberDuplicates(pairs)
+	if len(groups) > int(req.Limit) {
+		groups = groups[:req.Limit]
+	}
+
+	var memberIDs []uuid.UUID
+	seen := make(map[uuid.UUID]bool)
+	for _, group := range groups {
+		for _, pair := range group {
+			for _, id := range []uuid.UUID{pair.MemberID, pair.DuplicateID} {
+				if !seen[id] {
+					seen[id] = true
+					memberIDs = append(memberIDs, id)
+				}
+			}
+		}
+	}
+
+	members := []db.Member{}
+	if len(memberIDs) > 0 {
+		members, err = server.store.ListMembersByIDs(c.Context(), memberIDs)
+		if err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+		}
+	}
+
+	memberResponses, err := server.newMemberResponsesWithTags(c.Context(), members)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	rsp := listMemberDuplicatesResponse{Data: make([]memberDuplicateGroup, 0, len(groups))}
+	for _, group := range groups {
+		inGroup := make(map[uuid.UUID]bool)
+		result := memberDuplicateGroup{Matches: make([]memberDuplicateMatch, 0, len(group))}
+		for _, pair := range group {
+			match := memberDuplicateMatch{
+				MemberID:       pair.MemberID,
+				DuplicateID:    pair.DuplicateID,
+				Reasons:        []string{},
+				NameSimilarity: pair.NameSimilarity,
+			}
+			if pair.SameEmail {
+				match.Reasons = append(match.Reasons, memberDuplicateReasonEmail)
+			}
+			if pair.NameSimilarity >= req.MinSimilarity {
+				match.Reasons = append(match.Reasons, memberDuplicateReasonName)
+			}
+			result.Matches = append(result.Matches, match)
+			inGroup[pair.MemberID] = true
+			inGroup[pair.DuplicateID] = true
+		}
+
+		// Members are listed in the order ListMembersByIDs returns them, which is oldest first.
+		result.Members = make([]memberResponse, 0, len(inGroup))
+		for _, member := range memberResponses {
+			if inGroup[member.ID] {
+				result.Members = append(result.Members, member)
+			}
+		}
+		rsp.Data = append(rsp.Data, result)
+	}
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+type mergeMembersRequest struct {
+	// SurvivorID is the member the others are merged into.
+	SurvivorID uuid.UUID `json:"survivor_id" validate:"required"`
+	// MemberIDs lists the members merged into the survivor, which are moved to the trash.
+	MemberIDs []uuid.UUID `json:"member_ids" validate:"required,min=1,max=10,unique"`
+	// Fields maps a field to the member whose value the survivor takes. The fields are first_name, last_name, email,
+	// manager_id, avatar and custom_fields.<key>. A field left out keeps the value of the survivor or,
+	// when the survivor has none, takes that of the first member listed with one.
+	Fields map[string]uuid.UUID `json:"fields"`
+}
+
+// @Summary      Merge members
+// @Description  Merges members into a survivor in a single transaction. Their tags, teams, contact details, reports and history
+// @Description  move to the survivor, and they are moved to the trash. The merge is recorded in the audit log.
+// @Tags         members
+// @Param        body body mergeMembersRequest true "Merge"
+// @Success      200 {object} memberDetailResponse
+// @Failure      400 {object} errorResponse
+// @Failure      403 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /members/merge [post]
+func (server *Server) mergeMembers(c *fiber.Ctx) error {
+	req := new(mergeMembersRequest)
+	if err := c.BodyParser(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	validate := newValidator()
+	if err := validate.Struct(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	arg := db.MergeMembersTxParams{
+		SurvivorID: req.SurvivorID,
+		MemberIDs:  req.MemberIDs,
+		Fields:     req.Fields,
+	}
+
+	result, err := server.store.MergeMembersTx(c.Context(), arg, auditMeta(c))
+	if err != nil {
+		switch {
+		case err == sql.ErrNoRows:
+			return c.Status(fiber.StatusNotFound).JSON(newErrorResponse(err))
+		case errors.Is(err, db.ErrInvalidMergeField), err == db.ErrMergeSurvivor, err == db.ErrManagerCycle:
+			return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+		}
+		return c.Status(memberWriteStatus(err)).JSON(newErrorResponse(err))
+	}
+
+	for _, key := range result.UnusedAvatarKeys {
+		storage.DeleteAvatarThumbnails(c.Context(), server.storage, key, len(storage.AvatarThumbnails))
+	}
+
+	rsp, err := server.newMemberDetailResponseWithTags(c.Context(), result.Member)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	c.Set(fiber.HeaderETag, versionETag(result.Member.Version))
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
@@ -0,0 +1,566 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	mockdb "github.com/ot07/coworker-backend/db/mock"
+	db "github.com/ot07/coworker-backend/db/sqlc"
+	"github.com/ot07/coworker-backend/storage"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListMemberDuplicatesAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	member1 := randomMember()
+	member2 := randomMember()
+	member3 := randomMember()
+	member4 := randomMember()
+	member5 := randomMember()
+
+	pairs := []db.ListMemberDuplicatePairsRow{
+		{MemberID: member1.ID, DuplicateID: member2.ID, SameEmail: true, NameSimilarity: 0.2},
+		{MemberID: member4.ID, DuplicateID: member5.ID, NameSimilarity: 0.9},
+		{MemberID: member2.ID, DuplicateID: member3.ID, SameEmail: true, NameSimilarity: 0.7},
+	}
+
+	testCases := []struct {
+		name          string
+		query         string
+		setupAuth     func(request *http.Request)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name:  "OK",
+			query: "",
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				arg := db.ListMemberDuplicatePairsParams{
+					MinNameSimilarity: defaultMemberDuplicatesMinSimilarity,
+					PairLimit:         memberDuplicatePairLimit,
+				}
+				store.EXPECT().
+					ListMemberDuplicatePairs(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(pairs, nil)
+
+				members := []db.Member{member1, member2, member4, member5, member3}
+				store.EXPECT().
+					ListMembersByIDs(gomock.Any(), gomock.Eq([]uuid.UUID{member1.ID, member2.ID, member3.ID, member4.ID, member5.ID})).
+					Times(1).
+					Return(members, nil)
+
+				buildMemberTagsStubs(store, members)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+
+				rsp := requireBodyMemberDuplicates(t, response.Body)
+				require.Len(t, rsp.Data, 2)
+
+				group := rsp.Data[0]
+				require.Len(t, group.Members, 3)
+				require.Equal(t, member1.ID, group.Members[0].ID)
+				require.Equal(t, member2.ID, group.Members[1].ID)
+				require.Equal(t, member3.ID, group.Members[2].ID)
+				require.Len(t, group.Matches, 2)
+				require.Equal(t, []string{memberDuplicateReasonEmail}, group.Matches[0].Reasons)
+				require.Equal(t, []string{memberDuplicateReasonEmail, memberDuplicateReasonName}, group.Matches[1].Reasons)
+
+				group = rsp.Data[1]
+				require.Len(t, group.Members, 2)
+				require.Equal(t, member4.ID, group.Members[0].ID)
+				require.Equal(t, []string{memberDuplicateReasonName}, group.Matches[0].Reasons)
+			},
+		},
+		{
+			name:  "Limit",
+			query: "?limit=1&min_similarity=0.8",
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				arg := db.ListMemberDuplicatePairsParams{
+					MinNameSimilarity: 0.8,
+					PairLimit:         memberDuplicatePairLimit,
+				}
+				store.EXPECT().
+					ListMemberDuplicatePairs(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(pairs, nil)
+
+				members := []db.Member{member1, member2, member3}
+				store.EXPECT().
+					ListMembersByIDs(gomock.Any(), gomock.Eq([]uuid.UUID{member1.ID, member2.ID, member3.ID})).
+					Times(1).
+					Return(members, nil)
+
+				buildMemberTagsStubs(store, members)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+
+				rsp := requireBodyMemberDuplicates(t, response.Body)
+				require.Len(t, rsp.Data, 1)
+				require.Len(t, rsp.Data[0].Members, 3)
+				require.Equal(t, []string{memberDuplicateReasonEmail}, rsp.Data[0].Matches[1].Reasons)
+			},
+		},
+		{
+			name:  "NoDuplicates",
+			query: "",
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ListMemberDuplicatePairs(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.ListMemberDuplicatePairsRow{}, nil)
+
+				store.EXPECT().
+					ListMembersByIDs(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+
+				rsp := requireBodyMemberDuplicates(t, response.Body)
+				require.NotNil(t, rsp.Data)
+				require.Empty(t, rsp.Data)
+			},
+		},
+		{
+			name:  "NoAuthorization",
+			query: "",
+			setupAuth: func(request *http.Request) {
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListMemberDuplicatePairs(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusUnauthorized, response.StatusCode)
+			},
+		},
+		{
+			name:  "MinSimilarityTooLow",
+			query: "?min_similarity=0.1",
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ListMemberDuplicatePairs(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name:  "InternalError",
+			query: "",
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ListMemberDuplicatePairs(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusInternalServerError, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			url := "/api/v1/members/duplicates" + tc.query
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			tc.setupAuth(request)
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func TestMergeMembersAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	survivor := randomMember()
+	member := randomMember()
+	merged := member
+	merged.Email = sql.NullString{}
+	merged.DeletedAt = sql.NullTime{Time: time.Now(), Valid: true}
+
+	testCases := []struct {
+		name          string
+		body          fiber.Map
+		setupAuth     func(request *http.Request)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name: "OK",
+			body: fiber.Map{
+				"survivor_id": survivor.ID,
+				"member_ids":  []uuid.UUID{member.ID},
+				"fields": fiber.Map{
+					"email":                    member.ID,
+					"custom_fields.department": member.ID,
+				},
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				arg := db.MergeMembersTxParams{
+					SurvivorID: survivor.ID,
+					MemberIDs:  []uuid.UUID{member.ID},
+					Fields: map[string]uuid.UUID{
+						db.MergeFieldEmail: member.ID,
+						db.MergeFieldCustomFieldPrefix + "department": member.ID,
+					},
+				}
+				store.EXPECT().
+					MergeMembersTx(gomock.Any(), gomock.Eq(arg), eqAuditMeta(session.UserID)).
+					Times(1).
+					Return(db.MergeMembersTxResult{Member: survivor, Merged: []db.Member{merged}}, nil)
+
+				buildMemberTagsStubs(store, []db.Member{survivor})
+				buildMemberContactDetailsStubs(store, survivor, db.MemberContactDetails{})
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+				require.Equal(t, versionETag(survivor.Version), response.Header.Get(fiber.HeaderETag))
+				requireBodyMatchMemberDetail(t, response.Body, survivor, db.MemberContactDetails{})
+			},
+		},
+		{
+			name: "NoAuthorization",
+			body: fiber.Map{
+				"survivor_id": survivor.ID,
+				"member_ids":  []uuid.UUID{member.ID},
+			},
+			setupAuth: func(request *http.Request) {
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					MergeMembersTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusUnauthorized, response.StatusCode)
+			},
+		},
+		{
+			name: "NoMembers",
+			body: fiber.Map{
+				"survivor_id": survivor.ID,
+				"member_ids":  []uuid.UUID{},
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					MergeMembersTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "DuplicateMemberIDs",
+			body: fiber.Map{
+				"survivor_id": survivor.ID,
+				"member_ids":  []uuid.UUID{member.ID, member.ID},
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					MergeMembersTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "InvalidField",
+			body: fiber.Map{
+				"survivor_id": survivor.ID,
+				"member_ids":  []uuid.UUID{member.ID},
+				"fields":      fiber.Map{"created_at": member.ID},
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					MergeMembersTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.MergeMembersTxResult{}, fmt.Errorf("%w: unknown field %q", db.ErrInvalidMergeField, "created_at"))
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "MergeIntoItself",
+			body: fiber.Map{
+				"survivor_id": survivor.ID,
+				"member_ids":  []uuid.UUID{survivor.ID},
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					MergeMembersTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.MergeMembersTxResult{}, db.ErrMergeSurvivor)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "ManagerCycle",
+			body: fiber.Map{
+				"survivor_id": survivor.ID,
+				"member_ids":  []uuid.UUID{member.ID},
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					MergeMembersTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.MergeMembersTxResult{}, db.ErrManagerCycle)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "NotFound",
+			body: fiber.Map{
+				"survivor_id": survivor.ID,
+				"member_ids":  []uuid.UUID{member.ID},
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					MergeMembersTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.MergeMembersTxResult{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusNotFound, response.StatusCode)
+			},
+		},
+		{
+			name: "PrimaryEmailTaken",
+			body: fiber.Map{
+				"survivor_id": survivor.ID,
+				"member_ids":  []uuid.UUID{member.ID},
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					MergeMembersTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.MergeMembersTxResult{}, &pq.Error{Code: "23505"})
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusForbidden, response.StatusCode)
+			},
+		},
+		{
+			name: "InternalError",
+			body: fiber.Map{
+				"survivor_id": survivor.ID,
+				"member_ids":  []uuid.UUID{member.ID},
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					MergeMembersTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.MergeMembersTxResult{}, errors.New("connection reset"))
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusInternalServerError, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := "/api/v1/members/merge"
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			request.Header.Set("Content-Type", "application/json")
+
+			tc.setupAuth(request)
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func TestMergeMembersDeletesUnusedAvatars(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	session := randomSession()
+	survivor := randomMember()
+	member := randomMember()
+	unusedKey := fmt.Sprintf("avatars/%s/%s", member.ID, uuid.New())
+
+	store := mockdb.NewMockStore(ctrl)
+	buildValidSessionStubs(store, session)
+	store.EXPECT().
+		MergeMembersTx(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(db.MergeMembersTxResult{Member: survivor, UnusedAvatarKeys: []string{unusedKey}}, nil)
+	buildMemberTagsStubs(store, []db.Member{survivor})
+	buildMemberContactDetailsStubs(store, survivor, db.MemberContactDetails{})
+
+	server := newTestServer(t, store)
+	for _, thumbnail := range storage.AvatarThumbnails {
+		err := server.storage.Put(context.Background(), storage.AvatarThumbnailKey(unusedKey, thumbnail.Name), "image/jpeg", []byte("jpeg"))
+		require.NoError(t, err)
+	}
+
+	data, err := json.Marshal(fiber.Map{"survivor_id": survivor.ID, "member_ids": []uuid.UUID{member.ID}})
+	require.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodPost, "/api/v1/members/merge", bytes.NewReader(data))
+	require.NoError(t, err)
+	request.Header.Set("Content-Type", "application/json")
+	addSessionTokenInCookie(request, session.SessionToken.String())
+
+	response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, response.StatusCode)
+
+	dir := server.storage.(*storage.LocalStorage).Dir()
+	for _, thumbnail := range storage.AvatarThumbnails {
+		_, err := os.Stat(filepath.Join(dir, storage.AvatarThumbnailKey(unusedKey, thumbnail.Name)))
+		require.True(t, os.IsNotExist(err))
+	}
+}
+
+func requireBodyMemberDuplicates(t *testing.T, body io.ReadCloser) listMemberDuplicatesResponse {
+	data, err := io.ReadAll(body)
+	require.NoError(t, err)
+
+	var got listMemberDuplicatesResponse
+	err = json.Unmarshal(data, &got)
+	require.NoError(t, err)
+
+	err = body.Close()
+	require.NoError(t, err)
+
+	return got
+}
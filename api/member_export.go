@@ -0,0 +1,245 @@
+package api
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	db "github.com/ot07/coworker-backend/db/sqlc"
+	"github.com/ot07/coworker-backend/util/contentline"
+)
+
+type exportMembersRequest struct {
+	Format string `query:"format" json:"format" validate:"required,oneof=csv jsonl vcf" enums:"csv,jsonl,vcf"`
+	memberFilterQuery
+}
+
+// memberExportBatchSize is the number of members whose tags are looked up at once during an export.
+const memberExportBatchSize = 100
+
+// memberExporter writes members one by one in an export format.
+type memberExporter interface {
+	begin() error
+	write(member db.Member, tags []tagResponse) error
+	end() error
+}
+
+type memberExportFormat struct {
+	contentType string
+	extension   string
+	newExporter func(w io.Writer) memberExporter
+}
+
+var memberExportFormats = map[string]memberExportFormat{
+	"csv": {
+		contentType: "text/csv; charset=utf-8",
+		extension:   "csv",
+		newExporter: func(w io.Writer) memberExporter { return &csvMemberExporter{w: w, csv: csv.NewWriter(w)} },
+	},
+	"jsonl": {
+		contentType: "application/jsonl; charset=utf-8",
+		extension:   "jsonl",
+		newExporter: func(w io.Writer) memberExporter { return &jsonLinesMemberExporter{encoder: json.NewEncoder(w)} },
+	},
+	"vcf": {
+		contentType: "text/vcard; charset=utf-8",
+		extension:   "vcf",
+		newExporter: func(w io.Writer) memberExporter { return &vCardMemberExporter{w: w} },
+	},
+}
+
+type csvMemberExporter struct {
+	w   io.Writer
+	csv *csv.Writer
+}
+
+func (e *csvMemberExporter) begin() error {
+	// Excel only detects UTF-8 when the file starts with a byte order mark.
+	if _, err := io.WriteString(e.w, "\ufeff"); err != nil {
+		return err
+	}
+	return e.csv.Write([]string{"id", "first_name", "last_name", "email", "tags", "created_at"})
+}
+
+func (e *csvMemberExporter) write(member db.Member, tags []tagResponse) error {
+	tagNames := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tagNames = append(tagNames, tag.Name)
+	}
+
+	return e.csv.Write([]string{
+		member.ID.String(),
+		member.FirstName,
+		member.LastName,
+		member.Email.String,
+		strings.Join(tagNames, ", "),
+		member.CreatedAt.Format(time.RFC3339),
+	})
+}
+
+func (e *csvMemberExporter) end() error {
+	e.csv.Flush()
+	return e.csv.Error()
+}
+
+type jsonLinesMemberExporter struct {
+	encoder *json.Encoder
+}
+
+func (e *jsonLinesMemberExporter) begin() error {
+	return nil
+}
+
+func (e *jsonLinesMemberExporter) write(member db.Member, tags []tagResponse) error {
+	rsp := newMemberResponse(member)
+	if tags != nil {
+		rsp.Tags = tags
+	}
+	return e.encoder.Encode(rsp)
+}
+
+func (e *jsonLinesMemberExporter) end() error {
+	return nil
+}
+
+type vCardMemberExporter struct {
+	w io.Writer
+}
+
+func (e *vCardMemberExporter) begin() error {
+	return nil
+}
+
+func (e *vCardMemberExporter) write(member db.Member, tags []tagResponse) error {
+	lines := []string{
+		"BEGIN:VCARD",
+		"VERSION:4.0",
+		"UID:urn:uuid:" + member.ID.String(),
+		"FN:" + contentline.EscapeText(member.FirstName+" "+member.LastName),
+		"N:" + contentline.EscapeText(member.LastName) + ";" + contentline.EscapeText(member.FirstName) + ";;;",
+	}
+	if member.Email.Valid {
+		lines = append(lines, "EMAIL:"+contentline.EscapeText(member.Email.String))
+	}
+	if len(tags) > 0 {
+		categories := make([]string, 0, len(tags))
+		for _, tag := range tags {
+			categories = append(categories, contentline.EscapeText(tag.Name))
+		}
+		lines = append(lines, "CATEGORIES:"+strings.Join(categories, ","))
+	}
+	lines = append(lines,
+		"REV:"+member.CreatedAt.UTC().Format("20060102T150405Z"),
+		"END:VCARD",
+	)
+
+	for _, line := range lines {
+		if _, err := io.WriteString(e.w, contentline.FoldLine(line)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *vCardMemberExporter) end() error {
+	return nil
+}
+
+// @Summary      Export members
+// @Description  Streams all members matching the same filters as "List members" as CSV (with a UTF-8 BOM for Excel),
+// @Description  JSON Lines or vCard 4.0 with one card per member.
+// @Tags         members
+// @Produce      text/csv,application/jsonl,text/vcard
+// @Param        query query exportMembersRequest true "query"
+// @Success      200 {file} file
+// @Failure      400 {object} errorResponse
+// @Router       /members/export [get]
+func (server *Server) exportMembers(c *fiber.Ctx) error {
+	req := new(exportMembersRequest)
+	if err := c.QueryParser(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	validate := newValidator()
+	if err := validate.Struct(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	filter, err := req.memberFilter()
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	if status, err := server.customFieldsFilter(c, &filter); err != nil {
+		return c.Status(status).JSON(newErrorResponse(err))
+	}
+
+	sort, err := db.ParseMemberSort(req.Sort)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	format := memberExportFormats[req.Format]
+	arg := db.ForEachMemberByFilterParams{
+		Filter: filter,
+		Sort:   sort,
+	}
+
+	c.Attachment(fmt.Sprintf("members-%s.%s", time.Now().Format("20060102"), format.extension))
+	c.Set(fiber.HeaderContentType, format.contentType)
+
+	// The body is written after the handler returns, so the request context must not be used from here on.
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		exporter := format.newExporter(w)
+
+		// Members are written in batches, so that the tags of a whole batch can be looked up at once.
+		batch := make([]db.Member, 0, memberExportBatchSize)
+		writeBatch := func() error {
+			tags, err := server.memberTags(context.Background(), batch)
+			if err != nil {
+				return err
+			}
+			for _, member := range batch {
+				if err := exporter.write(member, tags[member.ID]); err != nil {
+					return err
+				}
+			}
+			batch = batch[:0]
+			return nil
+		}
+
+		err := exporter.begin()
+		if err == nil {
+			err = server.store.ForEachMemberByFilter(context.Background(), arg, func(member db.Member) error {
+				batch = append(batch, member)
+				if len(batch) < memberExportBatchSize {
+					return nil
+				}
+				return writeBatch()
+			})
+		}
+		if err == nil {
+			err = writeBatch()
+		}
+		if err == nil {
+			err = exporter.end()
+		}
+		if err == nil {
+			err = w.Flush()
+		}
+
+		// The status has already been sent, so the export can only be cut short.
+		if err != nil {
+			log.Printf("cannot export members: %v", err)
+		}
+	})
+
+	return nil
+}
@@ -0,0 +1,268 @@
+package api
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	mockdb "github.com/ot07/coworker-backend/db/mock"
+	db "github.com/ot07/coworker-backend/db/sqlc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportMembersAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	member1 := randomMember()
+	member1.CreatedAt = time.Date(2023, 4, 1, 9, 30, 0, 0, time.UTC)
+	member2 := randomMember()
+	member2.FirstName = "太郎"
+	member2.LastName = "山田, Jr."
+	member2.Email.Valid = false
+	member2.Email.String = ""
+	members := []db.Member{member1, member2}
+
+	remote := randomTag()
+	remote.Name = "Remote"
+	tokyo := randomTag()
+	tokyo.Name = "Tokyo"
+	tagRows := []db.ListTagsByMemberIDsRow{
+		memberTagRow(member1.ID, remote),
+		memberTagRow(member1.ID, tokyo),
+	}
+
+	forEachMember := func(ctx context.Context, arg db.ForEachMemberByFilterParams, fn func(db.Member) error) error {
+		for _, member := range members {
+			if err := fn(member); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	testCases := []struct {
+		name          string
+		query         map[string]string
+		setupAuth     func(request *http.Request)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name:  "CSV",
+			query: map[string]string{"format": "csv"},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ForEachMemberByFilter(gomock.Any(), gomock.Eq(db.ForEachMemberByFilterParams{Filter: db.MemberFilter{Statuses: []string{db.MemberStatusActive}}}), gomock.Any()).
+					Times(1).
+					DoAndReturn(forEachMember)
+
+				buildMemberTagsStubs(store, members, tagRows...)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+				require.Equal(t, "text/csv; charset=utf-8", response.Header.Get("Content-Type"))
+				require.Contains(t, response.Header.Get("Content-Disposition"), "attachment")
+
+				data, err := io.ReadAll(response.Body)
+				require.NoError(t, err)
+				require.True(t, strings.HasPrefix(string(data), "\ufeff"))
+
+				records, err := csv.NewReader(strings.NewReader(strings.TrimPrefix(string(data), "\ufeff"))).ReadAll()
+				require.NoError(t, err)
+				require.Equal(t, [][]string{
+					{"id", "first_name", "last_name", "email", "tags", "created_at"},
+					{member1.ID.String(), member1.FirstName, member1.LastName, member1.Email.String, "Remote, Tokyo", "2023-04-01T09:30:00Z"},
+					{member2.ID.String(), member2.FirstName, member2.LastName, "", "", member2.CreatedAt.Format(time.RFC3339)},
+				}, records)
+			},
+		},
+		{
+			name:  "JSONLines",
+			query: map[string]string{"format": "jsonl", "q": "yamada", "sort": "-created_at"},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				arg := db.ForEachMemberByFilterParams{
+					Filter: db.MemberFilter{Query: "yamada", Statuses: []string{db.MemberStatusActive}},
+					Sort:   []db.MemberSortKey{{Column: "created_at", Desc: true}},
+				}
+
+				store.EXPECT().
+					ForEachMemberByFilter(gomock.Any(), gomock.Eq(arg), gomock.Any()).
+					Times(1).
+					DoAndReturn(forEachMember)
+
+				buildMemberTagsStubs(store, members, tagRows...)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+				require.Equal(t, "application/jsonl; charset=utf-8", response.Header.Get("Content-Type"))
+
+				scanner := bufio.NewScanner(response.Body)
+				i := 0
+				for ; scanner.Scan(); i++ {
+					var gotMember memberResponse
+					err := json.Unmarshal(scanner.Bytes(), &gotMember)
+					require.NoError(t, err)
+					require.Equal(t, members[i].ID, gotMember.ID)
+					require.Equal(t, members[i].Email.Valid, gotMember.Email.Valid)
+					if i == 0 {
+						require.Len(t, gotMember.Tags, 2)
+					} else {
+						require.Empty(t, gotMember.Tags)
+					}
+				}
+				require.NoError(t, scanner.Err())
+				require.Equal(t, len(members), i)
+			},
+		},
+		{
+			name:  "VCard",
+			query: map[string]string{"format": "vcf"},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ForEachMemberByFilter(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(forEachMember)
+
+				buildMemberTagsStubs(store, members, tagRows...)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+				require.Equal(t, "text/vcard; charset=utf-8", response.Header.Get("Content-Type"))
+
+				data, err := io.ReadAll(response.Body)
+				require.NoError(t, err)
+
+				body := string(data)
+				require.Equal(t, len(members), strings.Count(body, "BEGIN:VCARD\r\nVERSION:4.0\r\n"))
+				require.Equal(t, len(members), strings.Count(body, "END:VCARD\r\n"))
+				require.Contains(t, body, "UID:urn:uuid:"+member1.ID.String()+"\r\n")
+				require.Contains(t, body, "EMAIL:"+member1.Email.String+"\r\n")
+				require.Contains(t, body, "REV:20230401T093000Z\r\n")
+				require.Contains(t, body, "N:山田\\, Jr.;太郎;;;\r\n")
+				require.Equal(t, 1, strings.Count(body, "EMAIL:"))
+				require.Equal(t, 1, strings.Count(body, "CATEGORIES:Remote,Tokyo\r\n"))
+			},
+		},
+		{
+			name:  "NoAuthorization",
+			query: map[string]string{"format": "csv"},
+			setupAuth: func(request *http.Request) {
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ForEachMemberByFilter(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusUnauthorized, response.StatusCode)
+			},
+		},
+		{
+			name:  "FormatNotFound",
+			query: map[string]string{},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ForEachMemberByFilter(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name:  "InvalidFormat",
+			query: map[string]string{"format": "xml"},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ForEachMemberByFilter(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name:  "InvalidSort",
+			query: map[string]string{"format": "csv", "sort": "unknown"},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ForEachMemberByFilter(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			url := "/api/v1/members/export"
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			// Add query parameters to request URL
+			q := request.URL.Query()
+			for key, value := range tc.query {
+				q.Add(key, value)
+			}
+			request.URL.RawQuery = q.Encode()
+
+			tc.setupAuth(request)
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
@@ -0,0 +1,538 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"math"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	db "github.com/ot07/coworker-backend/db/sqlc"
+	"github.com/ot07/coworker-backend/util"
+)
+
+const (
+	memberImportJobStatusRunning   = "running"
+	memberImportJobStatusSucceeded = "succeeded"
+	memberImportJobStatusFailed    = "failed"
+)
+
+type importMembersRequest struct {
+	DryRun bool `form:"dry_run" json:"dry_run"`
+	// Mapping maps the headers of the file to the member fields as a JSON object,
+	// e.g. {"First Name": "first_name", "Dept": "custom_fields.department"}. Unmapped headers are matched by name.
+	Mapping string `form:"mapping" json:"mapping"`
+}
+
+type memberImportRowError struct {
+	// Row is the 1-based row number in the file, or 0 for errors which are not caused by a single row.
+	Row     int    `json:"row"`
+	Field   string `json:"field,omitempty"`
+	Message string `json:"message"`
+}
+
+type memberImportReport struct {
+	DryRun       bool                   `json:"dry_run"`
+	TotalRows    int                    `json:"total_rows"`
+	ValidRows    int                    `json:"valid_rows"`
+	ImportedRows int                    `json:"imported_rows"`
+	Errors       []memberImportRowError `json:"errors"`
+}
+
+type memberImportJobResponse struct {
+	ID           uuid.UUID              `json:"id"`
+	Status       string                 `json:"status" enums:"running,succeeded,failed"`
+	DryRun       bool                   `json:"dry_run"`
+	TotalRows    int32                  `json:"total_rows"`
+	ImportedRows int32                  `json:"imported_rows"`
+	Errors       []memberImportRowError `json:"errors"`
+	CreatedAt    time.Time              `json:"created_at"`
+	FinishedAt   db.NullTime            `json:"finished_at" swaggertype:"string"`
+}
+
+func newMemberImportJobResponse(job db.MemberImportJob) (memberImportJobResponse, error) {
+	rsp := memberImportJobResponse{
+		ID:           job.ID,
+		Status:       job.Status,
+		DryRun:       job.DryRun,
+		TotalRows:    job.TotalRows,
+		ImportedRows: job.ImportedRows,
+		Errors:       []memberImportRowError{},
+		CreatedAt:    job.CreatedAt,
+		FinishedAt:   db.NullTime{NullTime: job.FinishedAt},
+	}
+
+	if len(job.Errors) > 0 {
+		if err := json.Unmarshal(job.Errors, &rsp.Errors); err != nil {
+			return memberImportJobResponse{}, err
+		}
+	}
+
+	return rsp, nil
+}
+
+// memberImportRow is a data row of an import file converted into a member creation request.
+type memberImportRow struct {
+	line int
+	req  createMemberRequest
+	// customFields holds the non-empty cells of the custom field columns by key, as they are written in the file.
+	customFields map[string]string
+}
+
+var memberImportFields = map[string]bool{
+	"first_name": true,
+	"last_name":  true,
+	"email":      true,
+}
+
+// memberImportCustomFieldPrefix prefixes the columns holding custom fields, as in "custom_fields.department".
+const memberImportCustomFieldPrefix = "custom_fields."
+
+// isMemberImportField tells whether a column can be mapped to field.
+func isMemberImportField(field string) bool {
+	return memberImportFields[field] ||
+		(strings.HasPrefix(field, memberImportCustomFieldPrefix) && len(field) > len(memberImportCustomFieldPrefix))
+}
+
+// normalizeMemberImportHeader turns headers such as "First Name" into "first_name".
+func normalizeMemberImportHeader(header string) string {
+	header = strings.TrimPrefix(header, "\ufeff")
+	return strings.Join(strings.Fields(strings.ToLower(header)), "_")
+}
+
+// readMemberImportRecords reads all records of an uploaded CSV or XLSX file.
+func readMemberImportRecords(filename string, content []byte) ([][]string, error) {
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".xlsx":
+		return util.ReadXLSX(bytes.NewReader(content), int64(len(content)))
+	case ".csv", "":
+		r := csv.NewReader(bytes.NewReader(content))
+		r.FieldsPerRecord = -1
+		return r.ReadAll()
+	default:
+		return nil, fmt.Errorf("unsupported file type %q: only CSV and XLSX files can be imported", filepath.Ext(filename))
+	}
+}
+
+// parseMemberImportRecords maps the records below the header row to member creation requests.
+func parseMemberImportRecords(records [][]string, mapping map[string]string) ([]memberImportRow, error) {
+	if len(records) == 0 {
+		return nil, errors.New("the file is empty")
+	}
+
+	normalizedMapping := make(map[string]string, len(mapping))
+	for header, field := range mapping {
+		if !isMemberImportField(field) {
+			return nil, fmt.Errorf("cannot map header %q to unknown field %q", header, field)
+		}
+		normalizedMapping[normalizeMemberImportHeader(header)] = field
+	}
+
+	columns := make(map[string]int)
+	for i, header := range records[0] {
+		header = normalizeMemberImportHeader(header)
+		field, ok := normalizedMapping[header]
+		if !ok && isMemberImportField(header) {
+			field = header
+		}
+		if len(field) == 0 {
+			continue
+		}
+		if _, ok := columns[field]; ok {
+			return nil, fmt.Errorf("more than one column is mapped to %q", field)
+		}
+		columns[field] = i
+	}
+
+	for _, field := range []string{"first_name", "last_name"} {
+		if _, ok := columns[field]; !ok {
+			return nil, fmt.Errorf("no column is mapped to the required field %q", field)
+		}
+	}
+
+	value := func(record []string, field string) string {
+		i, ok := columns[field]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	rows := make([]memberImportRow, 0, len(records)-1)
+	for i, record := range records[1:] {
+		if len(strings.TrimSpace(strings.Join(record, ""))) == 0 {
+			continue
+		}
+		customFields := make(map[string]string)
+		for field := range columns {
+			if key := strings.TrimPrefix(field, memberImportCustomFieldPrefix); key != field {
+				if v := value(record, field); len(v) > 0 {
+					customFields[key] = v
+				}
+			}
+		}
+		rows = append(rows, memberImportRow{
+			line: i + 2,
+			req: createMemberRequest{
+				FirstName: value(record, "first_name"),
+				LastName:  value(record, "last_name"),
+				Email:     value(record, "email"),
+			},
+			customFields: customFields,
+		})
+	}
+	return rows, nil
+}
+
+// memberImportCustomFields converts the custom field cells of a row into the JSON values "Create member" takes.
+// Numbers are parsed, the options of a multi_select field are separated by commas, and the other values are strings.
+func memberImportCustomFields(definitions []db.CustomFieldDefinition, cells map[string]string) (map[string]json.RawMessage, error) {
+	byKey := make(map[string]db.CustomFieldDefinition, len(definitions))
+	for _, definition := range definitions {
+		byKey[definition.Key] = definition
+	}
+
+	values := make(map[string]json.RawMessage, len(cells))
+	for key, cell := range cells {
+		var value interface{} = cell
+		switch byKey[key].Type {
+		case customFieldTypeNumber:
+			number, err := strconv.ParseFloat(cell, 64)
+			if err != nil || math.IsNaN(number) || math.IsInf(number, 0) {
+				return nil, fmt.Errorf("%s%s: must be a number", memberImportCustomFieldPrefix, key)
+			}
+			value = number
+		case customFieldTypeMultiSelect:
+			options := []string{}
+			for _, option := range strings.Split(cell, ",") {
+				if option = strings.TrimSpace(option); len(option) > 0 {
+					options = append(options, option)
+				}
+			}
+			value = options
+		}
+
+		raw, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		values[key] = raw
+	}
+	return values, nil
+}
+
+// newMemberImportCustomFieldError converts an error of the custom fields of a row, such as
+// "custom_fields.department: is required", into a row error reported on the field.
+func newMemberImportCustomFieldError(line int, err error) memberImportRowError {
+	field, message, ok := strings.Cut(err.Error(), ": ")
+	if !ok || !strings.HasPrefix(field, memberImportCustomFieldPrefix) {
+		return memberImportRowError{Row: line, Field: "custom_fields", Message: err.Error()}
+	}
+	return memberImportRowError{Row: line, Field: field, Message: message}
+}
+
+// newMemberImportRowErrors converts a validation error of a row into row errors reported per field.
+func newMemberImportRowErrors(line int, err error) []memberImportRowError {
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		return []memberImportRowError{{Row: line, Message: err.Error()}}
+	}
+
+	rowErrors := make([]memberImportRowError, 0, len(validationErrors))
+	for _, fieldError := range validationErrors {
+		field := fieldError.Field()
+		if f, ok := reflect.TypeOf(createMemberRequest{}).FieldByName(fieldError.StructField()); ok {
+			field = strings.Split(f.Tag.Get("json"), ",")[0]
+		}
+		rowErrors = append(rowErrors, memberImportRowError{
+			Row:     line,
+			Field:   field,
+			Message: fmt.Sprintf("failed on the '%s' tag", fieldError.Tag()),
+		})
+	}
+	return rowErrors
+}
+
+// runMemberImport validates all rows and, unless it is a dry run or any row is invalid,
+// creates the members in a single transaction.
+// Rows are checked like "Create member": their required custom fields must be given, and no two members,
+// whether in the file or already created, can have the same primary email.
+func (server *Server) runMemberImport(ctx context.Context, rows []memberImportRow, dryRun bool, meta db.AuditMeta) (memberImportReport, error) {
+	report := memberImportReport{
+		DryRun:    dryRun,
+		TotalRows: len(rows),
+		Errors:    []memberImportRowError{},
+	}
+
+	definitions, err := server.store.ListCustomFieldDefinitions(ctx)
+	if err != nil {
+		return report, err
+	}
+
+	emails := make([]string, 0, len(rows))
+	for _, row := range rows {
+		if len(row.req.Email) > 0 {
+			emails = append(emails, strings.ToLower(row.req.Email))
+		}
+	}
+	taken := make(map[string]bool)
+	if len(emails) > 0 {
+		primaryEmails, err := server.store.ListPrimaryMemberEmails(ctx, emails)
+		if err != nil {
+			return report, err
+		}
+		for _, email := range primaryEmails {
+			taken[strings.ToLower(email)] = true
+		}
+	}
+
+	validate := newValidator()
+	args := make([]db.CreateMemberParams, 0, len(rows))
+	emailRows := make(map[string]int, len(emails))
+	for _, row := range rows {
+		var rowErrors []memberImportRowError
+		if err := validate.Struct(row.req); err != nil {
+			rowErrors = append(rowErrors, newMemberImportRowErrors(row.line, err)...)
+		}
+
+		values, err := memberImportCustomFields(definitions, row.customFields)
+		var customFields string
+		if err == nil {
+			customFields, err = validateCustomFields(definitions, values, false)
+		}
+		if err != nil {
+			rowErrors = append(rowErrors, newMemberImportCustomFieldError(row.line, err))
+		}
+
+		if email := strings.ToLower(row.req.Email); len(email) > 0 {
+			if line, ok := emailRows[email]; ok {
+				rowErrors = append(rowErrors, memberImportRowError{
+					Row:     row.line,
+					Field:   "email",
+					Message: fmt.Sprintf("is already the email of row %d", line),
+				})
+			} else if taken[email] {
+				rowErrors = append(rowErrors, memberImportRowError{
+					Row:     row.line,
+					Field:   "email",
+					Message: "is already the primary email of another member",
+				})
+			} else {
+				emailRows[email] = row.line
+			}
+		}
+
+		if len(rowErrors) > 0 {
+			report.Errors = append(report.Errors, rowErrors...)
+			continue
+		}
+		args = append(args, db.CreateMemberParams{
+			FirstName:    row.req.FirstName,
+			LastName:     row.req.LastName,
+			Email:        sql.NullString{String: row.req.Email, Valid: len(row.req.Email) > 0},
+			CustomFields: customFields,
+		})
+	}
+	report.ValidRows = len(args)
+
+	if dryRun || len(report.Errors) > 0 {
+		return report, nil
+	}
+
+	members, err := server.store.ImportMembersTx(ctx, args, meta)
+	if err != nil {
+		return report, err
+	}
+	report.ImportedRows = len(members)
+
+	return report, nil
+}
+
+// memberImportJobInterrupted is the error of the import jobs that were still running when the server stopped.
+const memberImportJobInterrupted = "the import was interrupted because the server stopped"
+
+// runMemberImportJob runs the import in the background and records its outcome on the job.
+// The import is canceled when the server shuts down before it completes, and the job then fails.
+func (server *Server) runMemberImportJob(job db.MemberImportJob, rows []memberImportRow, meta db.AuditMeta) {
+	defer server.background.Done()
+
+	report, err := server.runMemberImport(server.backgroundCtx, rows, job.DryRun, meta)
+	if err != nil {
+		message := err.Error()
+		if server.backgroundCtx.Err() != nil {
+			message = memberImportJobInterrupted
+		}
+		report.Errors = append(report.Errors, memberImportRowError{Message: message})
+	}
+
+	status := memberImportJobStatusSucceeded
+	if len(report.Errors) > 0 {
+		status = memberImportJobStatusFailed
+	}
+
+	errorsJSON, err := json.Marshal(report.Errors)
+	if err != nil {
+		log.Printf("cannot marshal errors of member import job %s: %v", job.ID, err)
+		errorsJSON = []byte("[]")
+	}
+
+	arg := db.FinishMemberImportJobParams{
+		ID:           job.ID,
+		Status:       status,
+		ImportedRows: int32(report.ImportedRows),
+		Errors:       errorsJSON,
+	}
+
+	// The outcome is recorded even when the import was canceled.
+	if _, err := server.store.FinishMemberImportJob(context.Background(), arg); err != nil {
+		log.Printf("cannot finish member import job %s: %v", job.ID, err)
+	}
+}
+
+// FailInterruptedMemberImportJobs fails the import jobs left running by a previous run of the server, which stopped
+// before they completed. It must be called before the server starts, as jobs run within the server that created them.
+func (server *Server) FailInterruptedMemberImportJobs(ctx context.Context) (int64, error) {
+	errorsJSON, err := json.Marshal([]memberImportRowError{{Message: memberImportJobInterrupted}})
+	if err != nil {
+		return 0, err
+	}
+	return server.store.FailRunningMemberImportJobs(ctx, errorsJSON)
+}
+
+// @Summary      Import members
+// @Description  Imports members from a CSV or XLSX file whose first row is the header.
+// @Description  Every row is validated like "Create member", including its required custom fields, which are read from
+// @Description  columns named or mapped to custom_fields.<key>; the options of a multi_select field are separated by commas.
+// @Description  A primary email can neither appear twice in the file nor belong to an existing member.
+// @Description  With dry_run only the validation report is returned.
+// @Description  Otherwise the members are created all-or-nothing: a single invalid row aborts the whole import.
+// @Description  Files with more rows than the configured threshold are imported by a background job (202).
+// @Tags         members
+// @Accept       multipart/form-data
+// @Param        file    formData file   true  "CSV or XLSX file"
+// @Param        dry_run formData bool   false "Validate the rows without creating members"
+// @Param        mapping formData string false "JSON object mapping headers to member fields, e.g. {\"First Name\": \"first_name\"}"
+// @Success      200 {object} memberImportReport
+// @Success      202 {object} memberImportJobResponse
+// @Failure      400 {object} errorResponse
+// @Failure      403 {object} errorResponse
+// @Failure      422 {object} memberImportReport
+// @Failure      500 {object} errorResponse
+// @Router       /members/import [post]
+func (server *Server) importMembers(c *fiber.Ctx) error {
+	req := new(importMembersRequest)
+	if err := c.BodyParser(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	mapping := make(map[string]string)
+	if len(req.Mapping) > 0 {
+		if err := json.Unmarshal([]byte(req.Mapping), &mapping); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+		}
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+	defer file.Close()
+
+	content, err := io.ReadAll(file)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	records, err := readMemberImportRecords(fileHeader.Filename, content)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	rows, err := parseMemberImportRecords(records, mapping)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	if len(rows) > server.config.MemberImportAsyncThreshold {
+		arg := db.CreateMemberImportJobParams{
+			DryRun:    req.DryRun,
+			TotalRows: int32(len(rows)),
+		}
+
+		job, err := server.store.CreateMemberImportJob(c.Context(), arg)
+		if err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+		}
+
+		server.background.Add(1)
+		go server.runMemberImportJob(job, rows, auditMeta(c))
+
+		rsp, err := newMemberImportJobResponse(job)
+		if err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+		}
+		return c.Status(fiber.StatusAccepted).JSON(rsp)
+	}
+
+	report, err := server.runMemberImport(c.Context(), rows, req.DryRun, auditMeta(c))
+	if err != nil {
+		// A member may have taken one of the emails over since the rows were checked.
+		return c.Status(memberWriteStatus(err)).JSON(newErrorResponse(err))
+	}
+
+	if !report.DryRun && len(report.Errors) > 0 {
+		return c.Status(fiber.StatusUnprocessableEntity).JSON(report)
+	}
+	return c.Status(fiber.StatusOK).JSON(report)
+}
+
+type getMemberImportJobRequest struct {
+	ID uuid.UUID `params:"id"`
+}
+
+// @Summary      Get member import job
+// @Tags         members
+// @Param        id path string true "Member import job ID"
+// @Success      200 {object} memberImportJobResponse
+// @Failure      400 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /members/import/{id} [get]
+func (server *Server) getMemberImportJob(c *fiber.Ctx) error {
+	req := new(getMemberImportJobRequest)
+	if err := c.ParamsParser(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	job, err := server.store.GetMemberImportJob(c.Context(), req.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return c.Status(fiber.StatusNotFound).JSON(newErrorResponse(err))
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	rsp, err := newMemberImportJobResponse(job)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
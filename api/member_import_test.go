@@ -0,0 +1,893 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/lib/pq"
+	mockdb "github.com/ot07/coworker-backend/db/mock"
+	db "github.com/ot07/coworker-backend/db/sqlc"
+	"github.com/ot07/coworker-backend/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportMembersAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	member1 := randomMember()
+	member2 := randomMember()
+
+	validCSV := "\ufefffirst_name,last_name,email\n" +
+		fmt.Sprintf("%s,%s,%s\n", member1.FirstName, member1.LastName, member1.Email.String) +
+		"\n" +
+		fmt.Sprintf("%s,%s,\n", member2.FirstName, member2.LastName)
+	invalidCSV := "\ufefffirst_name,last_name,email\n" +
+		fmt.Sprintf("%s,%s,%s\n", member1.FirstName, member1.LastName, member1.Email.String) +
+		fmt.Sprintf(",%s,invalid-email\n", member2.LastName)
+	validArgs := []db.CreateMemberParams{
+		{FirstName: member1.FirstName, LastName: member1.LastName, Email: member1.Email},
+		{FirstName: member2.FirstName, LastName: member2.LastName},
+	}
+
+	var largeCSV strings.Builder
+	largeCSV.WriteString("first_name,last_name\n")
+	for i := 0; i < 11; i++ {
+		largeCSV.WriteString(fmt.Sprintf("%s,%s\n", util.RandomName(), util.RandomName()))
+	}
+	definitions := []db.CustomFieldDefinition{
+		randomCustomFieldDefinition("department", customFieldTypeSelect, true, "Sales", "Engineering"),
+		randomCustomFieldDefinition("grade", customFieldTypeNumber, false),
+		randomCustomFieldDefinition("skills", customFieldTypeMultiSelect, false, "Go", "SQL"),
+	}
+	customFieldsCSV := "first_name,last_name,custom_fields.department,Level,custom_fields.skills\n" +
+		fmt.Sprintf("%s,%s,Sales,3,\"Go, SQL\"\n", member1.FirstName, member1.LastName) +
+		fmt.Sprintf("%s,%s,,,\n", member2.FirstName, member2.LastName) +
+		fmt.Sprintf("%s,%s,Engineering,high,\n", member2.FirstName, member2.LastName)
+	duplicateEmailsCSV := "first_name,last_name,email\n" +
+		fmt.Sprintf("%s,%s,%s\n", member1.FirstName, member1.LastName, member1.Email.String) +
+		fmt.Sprintf("%s,%s,%s\n", member2.FirstName, member2.LastName, strings.ToUpper(member1.Email.String)) +
+		fmt.Sprintf("%s,%s,%s\n", member2.FirstName, member2.LastName, member2.Email.String)
+
+	job := db.MemberImportJob{
+		ID:        util.RandomUUID(),
+		Status:    memberImportJobStatusRunning,
+		TotalRows: 11,
+		Errors:    []byte("[]"),
+	}
+
+	testCases := []struct {
+		name          string
+		filename      string
+		content       string
+		fields        map[string]string
+		setupAuth     func(request *http.Request)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name:     "OK",
+			filename: "members.csv",
+			content:  validCSV,
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ListCustomFieldDefinitions(gomock.Any()).
+					Times(1).
+					Return([]db.CustomFieldDefinition{}, nil)
+
+				store.EXPECT().
+					ListPrimaryMemberEmails(gomock.Any(), gomock.Eq([]string{strings.ToLower(member1.Email.String)})).
+					Times(1).
+					Return([]string{}, nil)
+
+				store.EXPECT().
+					ImportMembersTx(gomock.Any(), gomock.Eq(validArgs), eqAuditMeta(session.UserID)).
+					Times(1).
+					Return([]db.Member{member1, member2}, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+
+				report := requireBodyMemberImportReport(t, response.Body)
+				require.False(t, report.DryRun)
+				require.Equal(t, 2, report.TotalRows)
+				require.Equal(t, 2, report.ValidRows)
+				require.Equal(t, 2, report.ImportedRows)
+				require.Empty(t, report.Errors)
+			},
+		},
+		{
+			name:     "Mapping",
+			filename: "members.csv",
+			content: "Mail,Given Name,Family Name\n" +
+				fmt.Sprintf("%s,%s,%s\n", member1.Email.String, member1.FirstName, member1.LastName),
+			fields: map[string]string{
+				"mapping": `{"Given Name": "first_name", "Family Name": "last_name", "mail": "email"}`,
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ListCustomFieldDefinitions(gomock.Any()).
+					Times(1).
+					Return([]db.CustomFieldDefinition{}, nil)
+
+				store.EXPECT().
+					ListPrimaryMemberEmails(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]string{}, nil)
+
+				store.EXPECT().
+					ImportMembersTx(gomock.Any(), gomock.Eq(validArgs[:1]), gomock.Any()).
+					Times(1).
+					Return([]db.Member{member1}, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+				require.Equal(t, 1, requireBodyMemberImportReport(t, response.Body).ImportedRows)
+			},
+		},
+		{
+			name:     "DryRun",
+			filename: "members.csv",
+			content:  invalidCSV,
+			fields: map[string]string{
+				"dry_run": "true",
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ListCustomFieldDefinitions(gomock.Any()).
+					Times(1).
+					Return([]db.CustomFieldDefinition{}, nil)
+
+				store.EXPECT().
+					ListPrimaryMemberEmails(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]string{}, nil)
+
+				store.EXPECT().
+					ImportMembersTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+
+				report := requireBodyMemberImportReport(t, response.Body)
+				require.True(t, report.DryRun)
+				require.Equal(t, 2, report.TotalRows)
+				require.Equal(t, 1, report.ValidRows)
+				require.Zero(t, report.ImportedRows)
+				require.Equal(t, []memberImportRowError{
+					{Row: 3, Field: "first_name", Message: "failed on the 'required' tag"},
+					{Row: 3, Field: "email", Message: "failed on the 'email' tag"},
+				}, report.Errors)
+			},
+		},
+		{
+			name:     "InvalidRow",
+			filename: "members.csv",
+			content:  invalidCSV,
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ListCustomFieldDefinitions(gomock.Any()).
+					Times(1).
+					Return([]db.CustomFieldDefinition{}, nil)
+
+				store.EXPECT().
+					ListPrimaryMemberEmails(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]string{}, nil)
+
+				store.EXPECT().
+					ImportMembersTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusUnprocessableEntity, response.StatusCode)
+
+				report := requireBodyMemberImportReport(t, response.Body)
+				require.Len(t, report.Errors, 2)
+				require.Zero(t, report.ImportedRows)
+			},
+		},
+		{
+			name:     "CustomFields",
+			filename: "members.csv",
+			content:  customFieldsCSV,
+			fields: map[string]string{
+				"mapping": `{"Level": "custom_fields.grade"}`,
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ListCustomFieldDefinitions(gomock.Any()).
+					Times(1).
+					Return(definitions, nil)
+
+				store.EXPECT().
+					ImportMembersTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusUnprocessableEntity, response.StatusCode)
+
+				report := requireBodyMemberImportReport(t, response.Body)
+				require.Equal(t, 3, report.TotalRows)
+				require.Equal(t, 1, report.ValidRows)
+				require.Equal(t, []memberImportRowError{
+					{Row: 3, Field: "custom_fields.department", Message: "is required"},
+					{Row: 4, Field: "custom_fields.grade", Message: "must be a number"},
+				}, report.Errors)
+			},
+		},
+		{
+			name:     "CustomFieldsOK",
+			filename: "members.csv",
+			content:  strings.Join(strings.Split(customFieldsCSV, "\n")[:2], "\n") + "\n",
+			fields: map[string]string{
+				"mapping": `{"Level": "custom_fields.grade"}`,
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ListCustomFieldDefinitions(gomock.Any()).
+					Times(1).
+					Return(definitions, nil)
+
+				arg := []db.CreateMemberParams{
+					{
+						FirstName:    member1.FirstName,
+						LastName:     member1.LastName,
+						CustomFields: `{"department":"Sales","grade":3,"skills":["Go","SQL"]}`,
+					},
+				}
+				store.EXPECT().
+					ImportMembersTx(gomock.Any(), gomock.Eq(arg), gomock.Any()).
+					Times(1).
+					Return([]db.Member{member1}, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+				require.Equal(t, 1, requireBodyMemberImportReport(t, response.Body).ImportedRows)
+			},
+		},
+		{
+			name:     "DuplicateEmails",
+			filename: "members.csv",
+			content:  duplicateEmailsCSV,
+			fields: map[string]string{
+				"dry_run": "true",
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ListCustomFieldDefinitions(gomock.Any()).
+					Times(1).
+					Return([]db.CustomFieldDefinition{}, nil)
+
+				emails := []string{
+					strings.ToLower(member1.Email.String),
+					strings.ToLower(member1.Email.String),
+					strings.ToLower(member2.Email.String),
+				}
+				store.EXPECT().
+					ListPrimaryMemberEmails(gomock.Any(), gomock.Eq(emails)).
+					Times(1).
+					Return([]string{member2.Email.String}, nil)
+
+				store.EXPECT().
+					ImportMembersTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+
+				report := requireBodyMemberImportReport(t, response.Body)
+				require.Equal(t, 1, report.ValidRows)
+				require.Equal(t, []memberImportRowError{
+					{Row: 3, Field: "email", Message: "is already the email of row 2"},
+					{Row: 4, Field: "email", Message: "is already the primary email of another member"},
+				}, report.Errors)
+			},
+		},
+		{
+			name:     "PrimaryEmailTaken",
+			filename: "members.csv",
+			content:  validCSV,
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ListCustomFieldDefinitions(gomock.Any()).
+					Times(1).
+					Return([]db.CustomFieldDefinition{}, nil)
+
+				store.EXPECT().
+					ListPrimaryMemberEmails(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]string{}, nil)
+
+				store.EXPECT().
+					ImportMembersTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil, &pq.Error{Code: "23505"})
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusForbidden, response.StatusCode)
+			},
+		},
+		{
+			name:     "Async",
+			filename: "members.csv",
+			content:  largeCSV.String(),
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ListCustomFieldDefinitions(gomock.Any()).
+					Times(1).
+					Return([]db.CustomFieldDefinition{}, nil)
+
+				store.EXPECT().
+					CreateMemberImportJob(gomock.Any(), gomock.Eq(db.CreateMemberImportJobParams{TotalRows: 11})).
+					Times(1).
+					Return(job, nil)
+
+				store.EXPECT().
+					ImportMembersTx(gomock.Any(), gomock.Len(11), gomock.Any()).
+					Times(1).
+					Return(make([]db.Member, 11), nil)
+
+				arg := db.FinishMemberImportJobParams{
+					ID:           job.ID,
+					Status:       memberImportJobStatusSucceeded,
+					ImportedRows: 11,
+					Errors:       []byte("[]"),
+				}
+
+				store.EXPECT().
+					FinishMemberImportJob(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.MemberImportJob{}, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusAccepted, response.StatusCode)
+
+				gotJob := requireBodyMemberImportJob(t, response.Body)
+				require.Equal(t, job.ID, gotJob.ID)
+				require.Equal(t, job.Status, gotJob.Status)
+				require.Equal(t, job.TotalRows, gotJob.TotalRows)
+			},
+		},
+		{
+			name:     "InvalidXLSXFile",
+			filename: "members.xlsx",
+			content:  "not a zip archive",
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ImportMembersTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name:     "NoAuthorization",
+			filename: "members.csv",
+			content:  validCSV,
+			setupAuth: func(request *http.Request) {
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ImportMembersTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusUnauthorized, response.StatusCode)
+			},
+		},
+		{
+			name: "FileNotFound",
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ImportMembersTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name:     "UnsupportedFileType",
+			filename: "members.txt",
+			content:  validCSV,
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ImportMembersTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name:     "RequiredColumnNotFound",
+			filename: "members.csv",
+			content:  "first_name,email\n" + fmt.Sprintf("%s,%s\n", member1.FirstName, member1.Email.String),
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ImportMembersTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name:     "InvalidMapping",
+			filename: "members.csv",
+			content:  validCSV,
+			fields: map[string]string{
+				"mapping": `{"first_name": "hashed_password"}`,
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ImportMembersTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name:     "InternalError",
+			filename: "members.csv",
+			content:  validCSV,
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ListCustomFieldDefinitions(gomock.Any()).
+					Times(1).
+					Return([]db.CustomFieldDefinition{}, nil)
+
+				store.EXPECT().
+					ListPrimaryMemberEmails(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]string{}, nil)
+
+				store.EXPECT().
+					ImportMembersTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusInternalServerError, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			body := new(bytes.Buffer)
+			writer := multipart.NewWriter(body)
+			if len(tc.filename) > 0 {
+				part, err := writer.CreateFormFile("file", tc.filename)
+				require.NoError(t, err)
+				_, err = part.Write([]byte(tc.content))
+				require.NoError(t, err)
+			}
+			for key, value := range tc.fields {
+				err := writer.WriteField(key, value)
+				require.NoError(t, err)
+			}
+			err := writer.Close()
+			require.NoError(t, err)
+
+			url := "/api/v1/members/import"
+			request, err := http.NewRequest(http.MethodPost, url, body)
+			require.NoError(t, err)
+			request.Header.Set("Content-Type", writer.FormDataContentType())
+
+			tc.setupAuth(request)
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			// wait for the background job so that the stubs it calls are verified
+			server.background.Wait()
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func TestGetMemberImportJobAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	job := db.MemberImportJob{
+		ID:           util.RandomUUID(),
+		Status:       memberImportJobStatusFailed,
+		TotalRows:    20,
+		ImportedRows: 0,
+		Errors:       []byte(`[{"row": 3, "field": "email", "message": "failed on the 'email' tag"}]`),
+		FinishedAt:   sql.NullTime{Time: time.Now(), Valid: true},
+	}
+
+	testCases := []struct {
+		name          string
+		jobID         string
+		setupAuth     func(request *http.Request)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name:  "OK",
+			jobID: job.ID.String(),
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					GetMemberImportJob(gomock.Any(), gomock.Eq(job.ID)).
+					Times(1).
+					Return(job, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+
+				gotJob := requireBodyMemberImportJob(t, response.Body)
+				require.Equal(t, job.ID, gotJob.ID)
+				require.Equal(t, job.Status, gotJob.Status)
+				require.True(t, gotJob.FinishedAt.Valid)
+				require.Equal(t, []memberImportRowError{
+					{Row: 3, Field: "email", Message: "failed on the 'email' tag"},
+				}, gotJob.Errors)
+			},
+		},
+		{
+			name:  "NoAuthorization",
+			jobID: job.ID.String(),
+			setupAuth: func(request *http.Request) {
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetMemberImportJob(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusUnauthorized, response.StatusCode)
+			},
+		},
+		{
+			name:  "NotFound",
+			jobID: job.ID.String(),
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					GetMemberImportJob(gomock.Any(), gomock.Eq(job.ID)).
+					Times(1).
+					Return(db.MemberImportJob{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusNotFound, response.StatusCode)
+			},
+		},
+		{
+			name:  "InvalidID",
+			jobID: "InvalidID",
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					GetMemberImportJob(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			url := fmt.Sprintf("/api/v1/members/import/%s", tc.jobID)
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			tc.setupAuth(request)
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func TestMemberImportJobShutdown(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	job := db.MemberImportJob{ID: util.RandomUUID(), Status: memberImportJobStatusRunning, TotalRows: 1}
+	rows := []memberImportRow{
+		{line: 2, req: createMemberRequest{FirstName: util.RandomName(), LastName: util.RandomName()}},
+	}
+
+	store.EXPECT().
+		ListCustomFieldDefinitions(gomock.Any()).
+		Times(1).
+		Return([]db.CustomFieldDefinition{}, nil)
+
+	// The import blocks until it is canceled.
+	store.EXPECT().
+		ImportMembersTx(gomock.Any(), gomock.Len(1), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(ctx context.Context, args []db.CreateMemberParams, meta db.AuditMeta) ([]db.Member, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		})
+
+	arg := db.FinishMemberImportJobParams{
+		ID:     job.ID,
+		Status: memberImportJobStatusFailed,
+		Errors: []byte(`[{"row":0,"message":"` + memberImportJobInterrupted + `"}]`),
+	}
+	store.EXPECT().
+		FinishMemberImportJob(gomock.Any(), gomock.Eq(arg)).
+		Times(1).
+		Return(db.MemberImportJob{}, nil)
+
+	server := newTestServer(t, store)
+	server.background.Add(1)
+	go server.runMemberImportJob(job, rows, db.AuditMeta{})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	err := server.Shutdown(ctx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestMemberImportJobRequestID(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	session := randomSession()
+	job := db.MemberImportJob{ID: util.RandomUUID(), Status: memberImportJobStatusRunning, TotalRows: 11, Errors: []byte("[]")}
+
+	buildValidSessionStubs(store, session)
+
+	store.EXPECT().
+		ListCustomFieldDefinitions(gomock.Any()).
+		Times(1).
+		Return([]db.CustomFieldDefinition{}, nil)
+
+	store.EXPECT().
+		CreateMemberImportJob(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(job, nil)
+
+	// The import waits for the next request to be handled before reading the request ID.
+	started := make(chan struct{})
+	release := make(chan struct{})
+	var requestID string
+	store.EXPECT().
+		ImportMembersTx(gomock.Any(), gomock.Len(11), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(ctx context.Context, args []db.CreateMemberParams, meta db.AuditMeta) ([]db.Member, error) {
+			close(started)
+			<-release
+			requestID = meta.RequestID
+			return make([]db.Member, 11), nil
+		})
+
+	store.EXPECT().
+		FinishMemberImportJob(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(db.MemberImportJob{}, nil)
+
+	server := newTestServer(t, store)
+
+	var content strings.Builder
+	content.WriteString("first_name,last_name\n")
+	for i := 0; i < 11; i++ {
+		content.WriteString(fmt.Sprintf("%s,%s\n", util.RandomName(), util.RandomName()))
+	}
+
+	body := new(bytes.Buffer)
+	writer := multipart.NewWriter(body)
+	part, err := writer.CreateFormFile("file", "members.csv")
+	require.NoError(t, err)
+	_, err = part.Write([]byte(content.String()))
+	require.NoError(t, err)
+	err = writer.Close()
+	require.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodPost, "/api/v1/members/import", body)
+	require.NoError(t, err)
+	request.Header.Set("Content-Type", writer.FormDataContentType())
+	request.Header.Set(fiber.HeaderXRequestID, "first-request-id")
+	addSessionTokenInCookie(request, session.SessionToken.String())
+
+	response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusAccepted, response.StatusCode)
+
+	<-started
+
+	url := fmt.Sprintf("/api/v1/members/import/%s", job.ID)
+	request, err = http.NewRequest(http.MethodGet, url, nil)
+	require.NoError(t, err)
+	request.Header.Set(fiber.HeaderXRequestID, "other-request-id")
+
+	response, err = server.app.Test(request, int(time.Second.Milliseconds()))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusUnauthorized, response.StatusCode)
+
+	close(release)
+	server.background.Wait()
+
+	require.Equal(t, "first-request-id", requestID)
+}
+
+func TestFailInterruptedMemberImportJobs(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().
+		FailRunningMemberImportJobs(gomock.Any(), gomock.Eq(json.RawMessage(`[{"row":0,"message":"`+memberImportJobInterrupted+`"}]`))).
+		Times(1).
+		Return(int64(2), nil)
+
+	server := newTestServer(t, store)
+
+	failed, err := server.FailInterruptedMemberImportJobs(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, int64(2), failed)
+}
+
+func requireBodyMemberImportReport(t *testing.T, body io.ReadCloser) memberImportReport {
+	data, err := io.ReadAll(body)
+	require.NoError(t, err)
+
+	var report memberImportReport
+	err = json.Unmarshal(data, &report)
+	require.NoError(t, err)
+
+	err = body.Close()
+	require.NoError(t, err)
+
+	return report
+}
+
+func requireBodyMemberImportJob(t *testing.T, body io.ReadCloser) memberImportJobResponse {
+	data, err := io.ReadAll(body)
+	require.NoError(t, err)
+
+	var job memberImportJobResponse
+	err = json.Unmarshal(data, &job)
+	require.NoError(t, err)
+
+	err = body.Close()
+	require.NoError(t, err)
+
+	return job
+}
@@ -0,0 +1,310 @@
+package api
+
+import (
+	"database/sql"
+	"math"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	db "github.com/ot07/coworker-backend/db/sqlc"
+)
+
+type memberManagerRequestParams struct {
+	ID uuid.UUID `params:"id"`
+}
+
+type setMemberManagerRequestBody struct {
+	ManagerID uuid.UUID `json:"manager_id" validate:"required"`
+}
+
+// @Summary      Set member manager
+// @Description  A member cannot report to themselves or to one of their direct or indirect reports.
+// @Tags         members
+// @Param        id   path string                      true "Member ID"
+// @Param        body body setMemberManagerRequestBody true "Manager"
+// @Success      200 {object} memberResponse
+// @Failure      400 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /members/{id}/manager [put]
+func (server *Server) setMemberManager(c *fiber.Ctx) error {
+	params := new(memberManagerRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	body := new(setMemberManagerRequestBody)
+	if err := c.BodyParser(body); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	validate := newValidator()
+	if err := validate.Struct(body); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	arg := db.SetMemberManagerParams{
+		ID:        params.ID,
+		ManagerID: uuid.NullUUID{UUID: body.ManagerID, Valid: true},
+	}
+	return server.applyMemberManager(c, arg)
+}
+
+// @Summary      Clear member manager
+// @Tags         members
+// @Param        id path string true "Member ID"
+// @Success      200 {object} memberResponse
+// @Failure      400 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /members/{id}/manager [delete]
+func (server *Server) clearMemberManager(c *fiber.Ctx) error {
+	params := new(memberManagerRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	arg := db.SetMemberManagerParams{
+		ID: params.ID,
+	}
+	return server.applyMemberManager(c, arg)
+}
+
+func (server *Server) applyMemberManager(c *fiber.Ctx, arg db.SetMemberManagerParams) error {
+	member, err := server.store.SetMemberManagerTx(c.Context(), arg, auditMeta(c))
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return c.Status(fiber.StatusNotFound).JSON(newErrorResponse(err))
+		case db.ErrManagerCycle, db.ErrManagerNotFound:
+			return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	rsp, err := server.newMemberResponseWithTags(c.Context(), member)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	c.Set(fiber.HeaderETag, versionETag(member.Version))
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+type listMemberReportsRequestQuery struct {
+	PageID    int32 `query:"page_id" json:"page_id" validate:"required,min=1"`
+	PageSize  int32 `query:"page_size" json:"page_size" validate:"required,min=5,max=10"`
+	Recursive bool  `query:"recursive" json:"recursive"`
+}
+
+type memberReportResponse struct {
+	memberResponse
+	// Depth is 1 for direct reports, 2 for their reports, and so on.
+	Depth int32 `json:"depth"`
+}
+
+type listMemberReportsResponse struct {
+	Meta listMembersResponseMeta `json:"meta"`
+	Data []memberReportResponse  `json:"data"`
+}
+
+// @Summary      List member reports
+// @Description  Lists the direct reports of the member, or with recursive=true all the members
+// @Description  reporting to the member directly or indirectly, nearest first.
+// @Tags         members
+// @Param        id    path  string                        true "Member ID"
+// @Param        query query listMemberReportsRequestQuery true "query"
+// @Success      200 {object} listMemberReportsResponse
+// @Failure      400 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /members/{id}/reports [get]
+func (server *Server) listMemberReports(c *fiber.Ctx) error {
+	params := new(memberManagerRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	query := new(listMemberReportsRequestQuery)
+	if err := c.QueryParser(query); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	validate := newValidator()
+	if err := validate.Struct(query); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	if _, err := server.store.GetMember(c.Context(), params.ID); err != nil {
+		if err == sql.ErrNoRows {
+			return c.Status(fiber.StatusNotFound).JSON(newErrorResponse(err))
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	arg := db.ListMemberReportsParams{
+		Limit:     query.PageSize,
+		Offset:    (query.PageID - 1) * query.PageSize,
+		ManagerID: params.ID,
+		Recursive: query.Recursive,
+	}
+
+	rows, err := server.store.ListMemberReports(c.Context(), arg)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	totalCount, err := server.store.CountMemberReports(c.Context(), db.CountMemberReportsParams{
+		ManagerID: params.ID,
+		Recursive: query.Recursive,
+	})
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	members := make([]db.Member, 0, len(rows))
+	for _, row := range rows {
+		members = append(members, db.Member{
+			ID:           row.ID,
+			FirstName:    row.FirstName,
+			LastName:     row.LastName,
+			Email:        row.Email,
+			CreatedAt:    row.CreatedAt,
+			CustomFields: row.CustomFields,
+			ManagerID:    row.ManagerID,
+			AvatarUrl:    row.AvatarUrl,
+			Status:       row.Status,
+			StartDate:    row.StartDate,
+			EndDate:      row.EndDate,
+			TimeZone:     row.TimeZone,
+		})
+	}
+
+	memberResponses, err := server.newMemberResponsesWithTags(c.Context(), members)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	data := make([]memberReportResponse, 0, len(rows))
+	for i, row := range rows {
+		data = append(data, memberReportResponse{
+			memberResponse: memberResponses[i],
+			Depth:          row.Depth,
+		})
+	}
+
+	pageCount := int64(math.Ceil(float64(totalCount) / float64(query.PageSize)))
+
+	rsp := listMemberReportsResponse{
+		Meta: listMembersResponseMeta{
+			PageID:     query.PageID,
+			PageSize:   query.PageSize,
+			PageCount:  pageCount,
+			TotalCount: totalCount,
+		},
+		Data: data,
+	}
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+// @Summary      Get member management chain
+// @Description  Lists the managers above the member, from the direct manager up to the top of the organization.
+// @Description  The chain stops at a manager who is in the trash.
+// @Tags         members
+// @Param        id path string true "Member ID"
+// @Success      200 {object} membersResponse
+// @Failure      400 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /members/{id}/chain [get]
+func (server *Server) getMemberChain(c *fiber.Ctx) error {
+	params := new(memberManagerRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	if _, err := server.store.GetMember(c.Context(), params.ID); err != nil {
+		if err == sql.ErrNoRows {
+			return c.Status(fiber.StatusNotFound).JSON(newErrorResponse(err))
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	rows, err := server.store.ListMemberChain(c.Context(), params.ID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	members := make([]db.Member, 0, len(rows))
+	for _, row := range rows {
+		members = append(members, db.Member{
+			ID:           row.ID,
+			FirstName:    row.FirstName,
+			LastName:     row.LastName,
+			Email:        row.Email,
+			CreatedAt:    row.CreatedAt,
+			CustomFields: row.CustomFields,
+			ManagerID:    row.ManagerID,
+			AvatarUrl:    row.AvatarUrl,
+			Status:       row.Status,
+			StartDate:    row.StartDate,
+			EndDate:      row.EndDate,
+			TimeZone:     row.TimeZone,
+		})
+	}
+
+	rsp, err := server.newMemberResponsesWithTags(c.Context(), members)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+type orgChartNode struct {
+	ID        uuid.UUID       `json:"id"`
+	FirstName string          `json:"first_name"`
+	LastName  string          `json:"last_name"`
+	Email     db.NullString   `json:"email" swaggertype:"string"`
+	Reports   []*orgChartNode `json:"reports"`
+}
+
+// newOrgChart builds the reporting tree of the members.
+// Members without a manager, or whose manager is not listed, are at the top level.
+func newOrgChart(rows []db.ListOrgChartMembersRow) []*orgChartNode {
+	nodes := make(map[uuid.UUID]*orgChartNode, len(rows))
+	for _, row := range rows {
+		nodes[row.ID] = &orgChartNode{
+			ID:        row.ID,
+			FirstName: row.FirstName,
+			LastName:  row.LastName,
+			Email:     db.NullString{NullString: row.Email},
+			Reports:   []*orgChartNode{},
+		}
+	}
+
+	roots := []*orgChartNode{}
+	for _, row := range rows {
+		node := nodes[row.ID]
+		if manager, ok := nodes[row.ManagerID.UUID]; row.ManagerID.Valid && ok {
+			manager.Reports = append(manager.Reports, node)
+		} else {
+			roots = append(roots, node)
+		}
+	}
+	return roots
+}
+
+// @Summary      Get org chart
+// @Description  Returns the whole organization as a tree of reporting lines, with the members sorted by name on each level.
+// @Tags         members
+// @Success      200 {array}  orgChartNode
+// @Failure      500 {object} errorResponse
+// @Router       /org-chart [get]
+func (server *Server) getOrgChart(c *fiber.Ctx) error {
+	rows, err := server.store.ListOrgChartMembers(c.Context())
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(newOrgChart(rows))
+}
@@ -0,0 +1,792 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	mockdb "github.com/ot07/coworker-backend/db/mock"
+	db "github.com/ot07/coworker-backend/db/sqlc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetMemberManagerAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	manager := randomMember()
+	member := randomMember()
+	updated := member
+	updated.ManagerID = uuid.NullUUID{UUID: manager.ID, Valid: true}
+	updated.Version = member.Version + 1
+
+	testCases := []struct {
+		name          string
+		body          fiber.Map
+		setupAuth     func(request *http.Request)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name: "OK",
+			body: fiber.Map{
+				"manager_id": manager.ID,
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				arg := db.SetMemberManagerParams{
+					ID:        member.ID,
+					ManagerID: uuid.NullUUID{UUID: manager.ID, Valid: true},
+				}
+
+				store.EXPECT().
+					SetMemberManagerTx(gomock.Any(), gomock.Eq(arg), eqAuditMeta(session.UserID)).
+					Times(1).
+					Return(updated, nil)
+
+				buildMemberTagsStubs(store, []db.Member{updated})
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+				require.Equal(t, versionETag(updated.Version), response.Header.Get(fiber.HeaderETag))
+				requireBodyMatchMember(t, response.Body, updated)
+			},
+		},
+		{
+			name: "NoAuthorization",
+			body: fiber.Map{
+				"manager_id": manager.ID,
+			},
+			setupAuth: func(request *http.Request) {
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					SetMemberManagerTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusUnauthorized, response.StatusCode)
+			},
+		},
+		{
+			name: "ManagerIDNotFound",
+			body: fiber.Map{},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					SetMemberManagerTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "Cycle",
+			body: fiber.Map{
+				"manager_id": manager.ID,
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					SetMemberManagerTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Member{}, db.ErrManagerCycle)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "ManagerNotFound",
+			body: fiber.Map{
+				"manager_id": manager.ID,
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					SetMemberManagerTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Member{}, db.ErrManagerNotFound)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "MemberNotFound",
+			body: fiber.Map{
+				"manager_id": manager.ID,
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					SetMemberManagerTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Member{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusNotFound, response.StatusCode)
+			},
+		},
+		{
+			name: "InternalError",
+			body: fiber.Map{
+				"manager_id": manager.ID,
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					SetMemberManagerTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Member{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusInternalServerError, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/api/v1/members/%s/manager", member.ID)
+			request, err := http.NewRequest(http.MethodPut, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			request.Header.Set("Content-Type", "application/json")
+
+			tc.setupAuth(request)
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func TestClearMemberManagerAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	member := randomMember()
+
+	testCases := []struct {
+		name          string
+		memberID      string
+		setupAuth     func(request *http.Request)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name:     "OK",
+			memberID: member.ID.String(),
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				arg := db.SetMemberManagerParams{
+					ID: member.ID,
+				}
+
+				store.EXPECT().
+					SetMemberManagerTx(gomock.Any(), gomock.Eq(arg), eqAuditMeta(session.UserID)).
+					Times(1).
+					Return(member, nil)
+
+				buildMemberTagsStubs(store, []db.Member{member})
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+				requireBodyMatchMember(t, response.Body, member)
+			},
+		},
+		{
+			name:     "NotFound",
+			memberID: member.ID.String(),
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					SetMemberManagerTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Member{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusNotFound, response.StatusCode)
+			},
+		},
+		{
+			name:     "InvalidID",
+			memberID: "InvalidID",
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					SetMemberManagerTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			url := fmt.Sprintf("/api/v1/members/%s/manager", tc.memberID)
+			request, err := http.NewRequest(http.MethodDelete, url, nil)
+			require.NoError(t, err)
+
+			tc.setupAuth(request)
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func TestListMemberReportsAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	manager := randomMember()
+	report := randomMember()
+	report.ManagerID = uuid.NullUUID{UUID: manager.ID, Valid: true}
+	indirectReport := randomMember()
+	indirectReport.ManagerID = uuid.NullUUID{UUID: report.ID, Valid: true}
+	rows := []db.ListMemberReportsRow{
+		memberReportRow(report, 1),
+		memberReportRow(indirectReport, 2),
+	}
+
+	testCases := []struct {
+		name          string
+		query         string
+		setupAuth     func(request *http.Request)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name:  "Recursive",
+			query: "page_id=1&page_size=5&recursive=true",
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					GetMember(gomock.Any(), gomock.Eq(manager.ID)).
+					Times(1).
+					Return(manager, nil)
+
+				arg := db.ListMemberReportsParams{
+					Limit:     5,
+					Offset:    0,
+					ManagerID: manager.ID,
+					Recursive: true,
+				}
+
+				store.EXPECT().
+					ListMemberReports(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(rows, nil)
+
+				store.EXPECT().
+					CountMemberReports(gomock.Any(), gomock.Eq(db.CountMemberReportsParams{ManagerID: manager.ID, Recursive: true})).
+					Times(1).
+					Return(int64(len(rows)), nil)
+
+				buildMemberTagsStubs(store, []db.Member{report, indirectReport})
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+
+				data, err := io.ReadAll(response.Body)
+				require.NoError(t, err)
+
+				var got listMemberReportsResponse
+				err = json.Unmarshal(data, &got)
+				require.NoError(t, err)
+				require.Equal(t, int64(2), got.Meta.TotalCount)
+				require.Len(t, got.Data, 2)
+				requireMemberResponseMatchMember(t, got.Data[0].memberResponse, report)
+				require.Equal(t, int32(1), got.Data[0].Depth)
+				requireMemberResponseMatchMember(t, got.Data[1].memberResponse, indirectReport)
+				require.Equal(t, int32(2), got.Data[1].Depth)
+			},
+		},
+		{
+			name:  "Direct",
+			query: "page_id=2&page_size=5",
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					GetMember(gomock.Any(), gomock.Eq(manager.ID)).
+					Times(1).
+					Return(manager, nil)
+
+				arg := db.ListMemberReportsParams{
+					Limit:     5,
+					Offset:    5,
+					ManagerID: manager.ID,
+				}
+
+				store.EXPECT().
+					ListMemberReports(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return([]db.ListMemberReportsRow{}, nil)
+
+				store.EXPECT().
+					CountMemberReports(gomock.Any(), gomock.Eq(db.CountMemberReportsParams{ManagerID: manager.ID})).
+					Times(1).
+					Return(int64(1), nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+			},
+		},
+		{
+			name:  "MemberNotFound",
+			query: "page_id=1&page_size=5",
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					GetMember(gomock.Any(), gomock.Eq(manager.ID)).
+					Times(1).
+					Return(db.Member{}, sql.ErrNoRows)
+
+				store.EXPECT().
+					ListMemberReports(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusNotFound, response.StatusCode)
+			},
+		},
+		{
+			name:  "InvalidPageSize",
+			query: "page_id=1&page_size=100",
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ListMemberReports(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			url := fmt.Sprintf("/api/v1/members/%s/reports?%s", manager.ID, tc.query)
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			tc.setupAuth(request)
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func TestGetMemberChainAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	root := randomMember()
+	manager := randomMember()
+	manager.ManagerID = uuid.NullUUID{UUID: root.ID, Valid: true}
+	member := randomMember()
+	member.ManagerID = uuid.NullUUID{UUID: manager.ID, Valid: true}
+
+	testCases := []struct {
+		name          string
+		setupAuth     func(request *http.Request)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name: "OK",
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					GetMember(gomock.Any(), gomock.Eq(member.ID)).
+					Times(1).
+					Return(member, nil)
+
+				rows := []db.ListMemberChainRow{
+					db.ListMemberChainRow(memberReportRow(manager, 1)),
+					db.ListMemberChainRow(memberReportRow(root, 2)),
+				}
+
+				store.EXPECT().
+					ListMemberChain(gomock.Any(), gomock.Eq(member.ID)).
+					Times(1).
+					Return(rows, nil)
+
+				buildMemberTagsStubs(store, []db.Member{manager, root})
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+				requireBodyMatchMembers(t, response.Body, []db.Member{manager, root})
+			},
+		},
+		{
+			name: "NoManager",
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					GetMember(gomock.Any(), gomock.Eq(member.ID)).
+					Times(1).
+					Return(member, nil)
+
+				store.EXPECT().
+					ListMemberChain(gomock.Any(), gomock.Eq(member.ID)).
+					Times(1).
+					Return([]db.ListMemberChainRow{}, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+				requireBodyMatchMembers(t, response.Body, []db.Member{})
+			},
+		},
+		{
+			name: "NotFound",
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					GetMember(gomock.Any(), gomock.Eq(member.ID)).
+					Times(1).
+					Return(db.Member{}, sql.ErrNoRows)
+
+				store.EXPECT().
+					ListMemberChain(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusNotFound, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			url := fmt.Sprintf("/api/v1/members/%s/chain", member.ID)
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			tc.setupAuth(request)
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func TestGetOrgChartAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	root := randomMember()
+	manager := randomMember()
+	manager.ManagerID = uuid.NullUUID{UUID: root.ID, Valid: true}
+	report := randomMember()
+	report.ManagerID = uuid.NullUUID{UUID: manager.ID, Valid: true}
+	// The manager of orphan is in the trash, so orphan is at the top level.
+	orphan := randomMember()
+	orphan.ManagerID = uuid.NullUUID{UUID: randomMember().ID, Valid: true}
+
+	testCases := []struct {
+		name          string
+		setupAuth     func(request *http.Request)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name: "OK",
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				rows := []db.ListOrgChartMembersRow{
+					orgChartRow(report),
+					orgChartRow(root),
+					orgChartRow(orphan),
+					orgChartRow(manager),
+				}
+
+				store.EXPECT().
+					ListOrgChartMembers(gomock.Any()).
+					Times(1).
+					Return(rows, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+
+				data, err := io.ReadAll(response.Body)
+				require.NoError(t, err)
+
+				var got []orgChartNode
+				err = json.Unmarshal(data, &got)
+				require.NoError(t, err)
+
+				require.Len(t, got, 2)
+				require.Equal(t, root.ID, got[0].ID)
+				require.Equal(t, orphan.ID, got[1].ID)
+				require.Empty(t, got[1].Reports)
+
+				require.Len(t, got[0].Reports, 1)
+				require.Equal(t, manager.ID, got[0].Reports[0].ID)
+				require.Len(t, got[0].Reports[0].Reports, 1)
+				require.Equal(t, report.ID, got[0].Reports[0].Reports[0].ID)
+				require.Equal(t, report.FirstName, got[0].Reports[0].Reports[0].FirstName)
+				require.Empty(t, got[0].Reports[0].Reports[0].Reports)
+			},
+		},
+		{
+			name: "Empty",
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ListOrgChartMembers(gomock.Any()).
+					Times(1).
+					Return([]db.ListOrgChartMembersRow{}, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+
+				data, err := io.ReadAll(response.Body)
+				require.NoError(t, err)
+				require.JSONEq(t, "[]", string(data))
+			},
+		},
+		{
+			name: "NoAuthorization",
+			setupAuth: func(request *http.Request) {
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListOrgChartMembers(gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusUnauthorized, response.StatusCode)
+			},
+		},
+		{
+			name: "InternalError",
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ListOrgChartMembers(gomock.Any()).
+					Times(1).
+					Return([]db.ListOrgChartMembersRow{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusInternalServerError, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			url := "/api/v1/org-chart"
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			tc.setupAuth(request)
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func memberReportRow(member db.Member, depth int32) db.ListMemberReportsRow {
+	return db.ListMemberReportsRow{
+		ID:           member.ID,
+		FirstName:    member.FirstName,
+		LastName:     member.LastName,
+		Email:        member.Email,
+		CreatedAt:    member.CreatedAt,
+		CustomFields: member.CustomFields,
+		ManagerID:    member.ManagerID,
+		Status:       member.Status,
+		StartDate:    member.StartDate,
+		EndDate:      member.EndDate,
+		Depth:        depth,
+	}
+}
+
+func orgChartRow(member db.Member) db.ListOrgChartMembersRow {
+	return db.ListOrgChartMembersRow{
+		ID:        member.ID,
+		FirstName: member.FirstName,
+		LastName:  member.LastName,
+		Email:     member.Email,
+		ManagerID: member.ManagerID,
+	}
+}
+
+func requireBodyMatchMembers(t *testing.T, body io.ReadCloser, members []db.Member) {
+	data, err := io.ReadAll(body)
+	require.NoError(t, err)
+
+	var gotMembers membersResponse
+	err = json.Unmarshal(data, &gotMembers)
+	require.NoError(t, err)
+
+	require.Len(t, gotMembers, len(members))
+	for i, member := range members {
+		require.Equal(t, member.ID, gotMembers[i].ID)
+		requireMemberResponseMatchMember(t, gotMembers[i], member)
+	}
+
+	err = body.Close()
+	require.NoError(t, err)
+}
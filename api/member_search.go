@@ -0,0 +1,175 @@
+package api
+
+import (
+	"html"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	db "github.com/ot07/coworker-backend/db/sqlc"
+	"golang.org/x/text/unicode/norm"
+)
+
+const defaultSearchMembersLimit = 20
+
+type searchMembersRequest struct {
+	Query string `query:"q" json:"q" validate:"required,max=100"`
+	Limit int32  `query:"limit" json:"limit" validate:"omitempty,min=1,max=50"`
+}
+
+type memberSearchResult struct {
+	Member  memberResponse `json:"member"`
+	Rank    float32        `json:"rank"`
+	Snippet string         `json:"snippet"`
+}
+
+type searchMembersResponse struct {
+	Data []memberSearchResult `json:"data"`
+}
+
+func newMemberSearchResult(row db.SearchMembersRow, terms []string) memberSearchResult {
+	member := searchMembersRowMember(row)
+
+	text := member.FirstName + " " + member.LastName
+	if member.Email.Valid {
+		text += " (" + member.Email.String + ")"
+	}
+
+	return memberSearchResult{
+		Member:  newMemberResponse(member),
+		Rank:    row.Rank,
+		Snippet: highlightSnippet(text, terms),
+	}
+}
+
+func searchMembersRowMember(row db.SearchMembersRow) db.Member {
+	return db.Member{
+		ID:           row.ID,
+		FirstName:    row.FirstName,
+		LastName:     row.LastName,
+		Email:        row.Email,
+		CreatedAt:    row.CreatedAt,
+		CustomFields: row.CustomFields,
+		ManagerID:    row.ManagerID,
+		AvatarUrl:    row.AvatarUrl,
+		Status:       row.Status,
+		StartDate:    row.StartDate,
+		EndDate:      row.EndDate,
+		TimeZone:     row.TimeZone,
+	}
+}
+
+// highlightSnippet HTML-escapes text and wraps every occurrence of terms in <mark> tags.
+// Text and terms are compared like the search does, after NFKC normalization and lower-casing.
+func highlightSnippet(text string, terms []string) string {
+	// The text is normalized one segment at a time, between normalization boundaries, so that
+	// every byte of the normalized text can be mapped back to the segment it comes from.
+	var haystack strings.Builder
+	var origins []int
+	bounds := []int{0}
+	for start := 0; start < len(text); {
+		end := start + norm.NFKC.NextBoundaryInString(text[start:], true)
+		if end <= start {
+			end = len(text)
+		}
+		segment := strings.ToLower(norm.NFKC.String(text[start:end]))
+		haystack.WriteString(segment)
+		for k := 0; k < len(segment); k++ {
+			origins = append(origins, len(bounds)-1)
+		}
+		bounds = append(bounds, end)
+		start = end
+	}
+
+	normalized := haystack.String()
+	marked := make([]bool, len(bounds)-1)
+	for _, term := range terms {
+		needle := strings.ToLower(norm.NFKC.String(term))
+		if len(needle) == 0 {
+			continue
+		}
+		for offset := 0; ; {
+			i := strings.Index(normalized[offset:], needle)
+			if i < 0 {
+				break
+			}
+			for j := offset + i; j < offset+i+len(needle); j++ {
+				marked[origins[j]] = true
+			}
+			offset += i + len(needle)
+		}
+	}
+
+	var sb strings.Builder
+	for first := 0; first < len(marked); {
+		last := first
+		for last < len(marked) && marked[last] == marked[first] {
+			last++
+		}
+		part := html.EscapeString(text[bounds[first]:bounds[last]])
+		if marked[first] {
+			sb.WriteString("<mark>" + part + "</mark>")
+		} else {
+			sb.WriteString(part)
+		}
+		first = last
+	}
+	return sb.String()
+}
+
+// @Summary      Search members
+// @Description  Full-text and fuzzy search across names and email. Results are ranked by relevance and
+// @Description  the snippet is HTML-escaped with the matched parts wrapped in <mark> tags.
+// @Tags         members
+// @Param        query query searchMembersRequest true "query"
+// @Success      200 {object} searchMembersResponse
+// @Failure      400 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /members/search [get]
+func (server *Server) searchMembers(c *fiber.Ctx) error {
+	req := new(searchMembersRequest)
+	if err := c.QueryParser(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	validate := newValidator()
+	if err := validate.Struct(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	if req.Limit == 0 {
+		req.Limit = defaultSearchMembersLimit
+	}
+
+	arg := db.SearchMembersParams{
+		Query:       req.Query,
+		ResultLimit: req.Limit,
+	}
+
+	rows, err := server.store.SearchMembers(c.Context(), arg)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	members := make([]db.Member, 0, len(rows))
+	for _, row := range rows {
+		members = append(members, searchMembersRowMember(row))
+	}
+
+	tags, err := server.memberTags(c.Context(), members)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	terms := strings.Fields(req.Query)
+	rsp := searchMembersResponse{
+		Data: make([]memberSearchResult, 0, len(rows)),
+	}
+	for _, row := range rows {
+		result := newMemberSearchResult(row, terms)
+		if memberTags, ok := tags[row.ID]; ok {
+			result.Member.Tags = memberTags
+		}
+		rsp.Data = append(rsp.Data, result)
+	}
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
@@ -0,0 +1,291 @@
+package api
+
+import (
+	"database/sql"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	mockdb "github.com/ot07/coworker-backend/db/mock"
+	db "github.com/ot07/coworker-backend/db/sqlc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchMembersAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	member := randomMember()
+	row := db.SearchMembersRow{
+		ID:        member.ID,
+		FirstName: member.FirstName,
+		LastName:  member.LastName,
+		Email:     member.Email,
+		CreatedAt: member.CreatedAt,
+		Status:    member.Status,
+		Rank:      0.8,
+	}
+
+	type Query struct {
+		q     string
+		limit string
+	}
+
+	testCases := []struct {
+		name          string
+		query         Query
+		setupAuth     func(request *http.Request)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name: "OK",
+			query: Query{
+				q: member.LastName,
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				arg := db.SearchMembersParams{
+					Query:       member.LastName,
+					ResultLimit: defaultSearchMembersLimit,
+				}
+
+				store.EXPECT().
+					SearchMembers(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return([]db.SearchMembersRow{row}, nil)
+
+				buildMemberTagsStubs(store, []db.Member{member})
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+
+				results := requireBodySearchMembersResults(t, response.Body)
+				require.Len(t, results, 1)
+				requireMemberResponseMatchMember(t, results[0].Member, member)
+				require.Equal(t, row.Rank, results[0].Rank)
+				require.Contains(t, results[0].Snippet, "<mark>"+member.LastName+"</mark>")
+			},
+		},
+		{
+			name: "WithLimit",
+			query: Query{
+				q:     member.FirstName,
+				limit: "5",
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				arg := db.SearchMembersParams{
+					Query:       member.FirstName,
+					ResultLimit: 5,
+				}
+
+				store.EXPECT().
+					SearchMembers(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return([]db.SearchMembersRow{}, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+				require.Empty(t, requireBodySearchMembersResults(t, response.Body))
+			},
+		},
+		{
+			name: "NoAuthorization",
+			query: Query{
+				q: member.LastName,
+			},
+			setupAuth: func(request *http.Request) {
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					SearchMembers(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusUnauthorized, response.StatusCode)
+			},
+		},
+		{
+			name:  "QueryNotFound",
+			query: Query{},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					SearchMembers(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "LimitMoreThanUpperLimit",
+			query: Query{
+				q:     member.LastName,
+				limit: "51",
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					SearchMembers(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "InternalError",
+			query: Query{
+				q: member.LastName,
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					SearchMembers(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.SearchMembersRow{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusInternalServerError, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			url := "/api/v1/members/search"
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			// Add query parameters to request URL
+			q := request.URL.Query()
+			if len(tc.query.q) > 0 {
+				q.Add("q", tc.query.q)
+			}
+			if len(tc.query.limit) > 0 {
+				q.Add("limit", tc.query.limit)
+			}
+			request.URL.RawQuery = q.Encode()
+
+			tc.setupAuth(request)
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func TestHighlightSnippet(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name  string
+		text  string
+		terms []string
+		want  string
+	}{
+		{
+			name:  "CaseInsensitive",
+			text:  "Taro Yamada",
+			terms: []string{"yamada"},
+			want:  "Taro <mark>Yamada</mark>",
+		},
+		{
+			name:  "MultipleTerms",
+			text:  "Taro Yamada (taro@email.com)",
+			terms: []string{"taro", "yama"},
+			want:  "<mark>Taro</mark> <mark>Yama</mark>da (<mark>taro</mark>@email.com)",
+		},
+		{
+			name:  "Japanese",
+			text:  "太郎 山田",
+			terms: []string{"山田"},
+			want:  "太郎 <mark>山田</mark>",
+		},
+		{
+			name:  "FullWidthQuery",
+			text:  "Taro Yamada (taro@email.com)",
+			terms: []string{"ＹＡＭＡ", "ｔａｒｏ＠"},
+			want:  "Taro <mark>Yama</mark>da (<mark>taro@</mark>email.com)",
+		},
+		{
+			name:  "FullWidthText",
+			text:  "Ｔａｒｏ ﾔﾏﾀﾞ",
+			terms: []string{"taro", "ヤマダ"},
+			want:  "<mark>Ｔａｒｏ</mark> <mark>ﾔﾏﾀﾞ</mark>",
+		},
+		{
+			name:  "EscapesHTML",
+			text:  "<b>Taro</b> Yamada",
+			terms: []string{"taro"},
+			want:  "&lt;b&gt;<mark>Taro</mark>&lt;/b&gt; Yamada",
+		},
+		{
+			name:  "NoMatch",
+			text:  "Taro Yamada",
+			terms: []string{"yamda"},
+			want:  "Taro Yamada",
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tc.want, highlightSnippet(tc.text, tc.terms))
+		})
+	}
+}
+
+func requireBodySearchMembersResults(t *testing.T, body io.ReadCloser) []memberSearchResult {
+	data, err := io.ReadAll(body)
+	require.NoError(t, err)
+
+	var gotResponse searchMembersResponse
+	err = json.Unmarshal(data, &gotResponse)
+	require.NoError(t, err)
+
+	err = body.Close()
+	require.NoError(t, err)
+
+	return gotResponse.Data
+}
@@ -0,0 +1,132 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	db "github.com/ot07/coworker-backend/db/sqlc"
+)
+
+// memberStatusesAll is the status query value listing members in any lifecycle status.
+const memberStatusesAll = "all"
+
+var memberStatuses = []string{
+	db.MemberStatusInvited,
+	db.MemberStatusOnboarding,
+	db.MemberStatusActive,
+	db.MemberStatusOnLeave,
+	db.MemberStatusOffboarded,
+}
+
+// memberStatusesFromQuery parses a comma separated list of lifecycle statuses.
+// Only active members are listed when it is empty, and members in any status when it is "all".
+func memberStatusesFromQuery(query string) ([]string, error) {
+	switch query {
+	case "":
+		return []string{db.MemberStatusActive}, nil
+	case memberStatusesAll:
+		return nil, nil
+	}
+
+	var statuses []string
+	for _, field := range strings.Split(query, ",") {
+		status := strings.TrimSpace(field)
+		if !isMemberStatus(status) {
+			return nil, fmt.Errorf("unknown member status %q", status)
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+func isMemberStatus(status string) bool {
+	for _, s := range memberStatuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+type transitionMemberRequestParams struct {
+	ID uuid.UUID `params:"id"`
+}
+
+type transitionMemberRequestBody struct {
+	Status string `json:"status" validate:"required,oneof=invited onboarding active on_leave offboarded" enums:"invited,onboarding,active,on_leave,offboarded"`
+	// EffectiveDate is the date the change takes effect, today by default.
+	EffectiveDate string `json:"effective_date" validate:"omitempty,datetime=2006-01-02" format:"date"`
+	Reason        string `json:"reason" validate:"omitempty,max=500"`
+}
+
+// @Summary      Transition member
+// @Description  Moves a member to another lifecycle status, which is recorded in the history of the member.
+// @Description  invited moves to onboarding, active or offboarded; onboarding to active or offboarded; active to on_leave or offboarded;
+// @Description  on_leave to active or offboarded; and offboarded back to onboarding or active for a rehire.
+// @Description  The effective date becomes the start date of a member starting work, and the end date of an offboarded member.
+// @Tags         members
+// @Param        id   path string                      true "Member ID"
+// @Param        body body transitionMemberRequestBody true "Transition"
+// @Success      200 {object} memberDetailResponse
+// @Header       200 {string} ETag "Version of the member"
+// @Failure      400 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /members/{id}/transitions [post]
+func (server *Server) transitionMember(c *fiber.Ctx) error {
+	params := new(transitionMemberRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	body := new(transitionMemberRequestBody)
+	if err := c.BodyParser(body); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	validate := newValidator()
+	if err := validate.Struct(body); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	now := time.Now()
+	effectiveDate := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	if len(body.EffectiveDate) > 0 {
+		date, err := time.Parse(db.DateLayout, body.EffectiveDate)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+		}
+		effectiveDate = date
+	}
+
+	arg := db.TransitionMemberTxParams{
+		ID:            params.ID,
+		Status:        body.Status,
+		EffectiveDate: effectiveDate,
+		Reason:        body.Reason,
+	}
+
+	member, err := server.store.TransitionMemberTx(c.Context(), arg, auditMeta(c))
+	if err != nil {
+		switch {
+		case err == sql.ErrNoRows:
+			return c.Status(fiber.StatusNotFound).JSON(newErrorResponse(err))
+		case errors.Is(err, db.ErrInvalidMemberTransition), err == db.ErrMemberEndBeforeStart:
+			return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	rsp, err := server.newMemberDetailResponseWithTags(c.Context(), member)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	c.Set(fiber.HeaderETag, versionETag(member.Version))
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
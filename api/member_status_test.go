@@ -0,0 +1,295 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	mockdb "github.com/ot07/coworker-backend/db/mock"
+	db "github.com/ot07/coworker-backend/db/sqlc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransitionMemberAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	member := randomMember()
+	member.Status = db.MemberStatusOnLeave
+	member.StartDate = sql.NullTime{Time: time.Date(2022, 4, 1, 0, 0, 0, 0, time.UTC), Valid: true}
+	member.Version = 2
+
+	effectiveDate := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name          string
+		memberID      string
+		body          fiber.Map
+		setupAuth     func(request *http.Request)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name:     "OK",
+			memberID: member.ID.String(),
+			body: fiber.Map{
+				"status":         db.MemberStatusOnLeave,
+				"effective_date": "2023-06-01",
+				"reason":         "Parental leave",
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				arg := db.TransitionMemberTxParams{
+					ID:            member.ID,
+					Status:        db.MemberStatusOnLeave,
+					EffectiveDate: effectiveDate,
+					Reason:        "Parental leave",
+				}
+				store.EXPECT().
+					TransitionMemberTx(gomock.Any(), gomock.Eq(arg), eqAuditMeta(session.UserID)).
+					Times(1).
+					Return(member, nil)
+
+				buildMemberTagsStubs(store, []db.Member{member})
+				buildMemberContactDetailsStubs(store, member, db.MemberContactDetails{})
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+				require.Equal(t, versionETag(member.Version), response.Header.Get(fiber.HeaderETag))
+				requireBodyMatchMemberDetail(t, response.Body, member, db.MemberContactDetails{})
+			},
+		},
+		{
+			name:     "EffectiveToday",
+			memberID: member.ID.String(),
+			body: fiber.Map{
+				"status": db.MemberStatusOnLeave,
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					TransitionMemberTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ interface{}, arg db.TransitionMemberTxParams, _ db.AuditMeta) (db.Member, error) {
+						require.Equal(t, time.Now().Format(db.DateLayout), arg.EffectiveDate.Format(db.DateLayout))
+						require.Equal(t, time.UTC, arg.EffectiveDate.Location())
+						return member, nil
+					})
+
+				buildMemberTagsStubs(store, []db.Member{member})
+				buildMemberContactDetailsStubs(store, member, db.MemberContactDetails{})
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+			},
+		},
+		{
+			name:     "NoAuthorization",
+			memberID: member.ID.String(),
+			body: fiber.Map{
+				"status": db.MemberStatusOnLeave,
+			},
+			setupAuth: func(request *http.Request) {
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					TransitionMemberTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusUnauthorized, response.StatusCode)
+			},
+		},
+		{
+			name:     "InvalidID",
+			memberID: "invalid",
+			body: fiber.Map{
+				"status": db.MemberStatusOnLeave,
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					TransitionMemberTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name:     "UnknownStatus",
+			memberID: member.ID.String(),
+			body: fiber.Map{
+				"status": "retired",
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					TransitionMemberTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name:     "InvalidEffectiveDate",
+			memberID: member.ID.String(),
+			body: fiber.Map{
+				"status":         db.MemberStatusOffboarded,
+				"effective_date": "2023-06-01T00:00:00Z",
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					TransitionMemberTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name:     "TransitionNotAllowed",
+			memberID: member.ID.String(),
+			body: fiber.Map{
+				"status": db.MemberStatusInvited,
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					TransitionMemberTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Member{}, fmt.Errorf("%w: from active to invited", db.ErrInvalidMemberTransition))
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name:     "EndBeforeStart",
+			memberID: member.ID.String(),
+			body: fiber.Map{
+				"status":         db.MemberStatusOffboarded,
+				"effective_date": "2021-03-31",
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					TransitionMemberTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Member{}, db.ErrMemberEndBeforeStart)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name:     "NotFound",
+			memberID: member.ID.String(),
+			body: fiber.Map{
+				"status": db.MemberStatusOnLeave,
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					TransitionMemberTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Member{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusNotFound, response.StatusCode)
+			},
+		},
+		{
+			name:     "InternalError",
+			memberID: member.ID.String(),
+			body: fiber.Map{
+				"status": db.MemberStatusOnLeave,
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					TransitionMemberTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Member{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusInternalServerError, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/api/v1/members/%s/transitions", tc.memberID)
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			request.Header.Set("Content-Type", "application/json")
+
+			tc.setupAuth(request)
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"reflect"
 	"testing"
 	"time"
 
@@ -45,9 +46,13 @@ func TestGetMemberAPI(t *testing.T) {
 					GetMember(gomock.Any(), gomock.Eq(member.ID)).
 					Times(1).
 					Return(member, nil)
+
+				buildMemberTagsStubs(store, []db.Member{member})
+				buildMemberContactDetailsStubs(store, member, db.MemberContactDetails{})
 			},
 			checkResponse: func(t *testing.T, response *http.Response) {
 				require.Equal(t, http.StatusOK, response.StatusCode)
+				require.Equal(t, versionETag(member.Version), response.Header.Get("ETag"))
 				requireBodyMatchMember(t, response.Body, member)
 			},
 		},
@@ -157,6 +162,16 @@ func TestCreateMemberAPI(t *testing.T) {
 		FirstName: member.FirstName,
 		LastName:  member.LastName,
 	}
+	invitedMember := randomMember()
+	invitedMember.Email = sql.NullString{}
+	invitedMember.Status = db.MemberStatusInvited
+	invitedMember.StartDate = sql.NullTime{Time: time.Date(2023, 4, 1, 0, 0, 0, 0, time.UTC), Valid: true}
+	memberWithCustomFields := memberOnlyRequiredFields
+	memberWithCustomFields.CustomFields = json.RawMessage(`{"department": "Sales", "employee_number": "E-001"}`)
+	definitions := []db.CustomFieldDefinition{
+		randomCustomFieldDefinition("department", customFieldTypeSelect, true, "Sales", "Engineering"),
+		randomCustomFieldDefinition("employee_number", customFieldTypeText, false),
+	}
 
 	testCases := []struct {
 		name          string
@@ -178,6 +193,11 @@ func TestCreateMemberAPI(t *testing.T) {
 			buildStubs: func(store *mockdb.MockStore) {
 				buildValidSessionStubs(store, session)
 
+				store.EXPECT().
+					ListCustomFieldDefinitions(gomock.Any()).
+					Times(1).
+					Return([]db.CustomFieldDefinition{}, nil)
+
 				arg := db.CreateMemberParams{
 					FirstName: member.FirstName,
 					LastName:  member.LastName,
@@ -185,15 +205,97 @@ func TestCreateMemberAPI(t *testing.T) {
 				}
 
 				store.EXPECT().
-					CreateMember(gomock.Any(), gomock.Eq(arg)).
+					CreateMemberTx(gomock.Any(), gomock.Eq(db.CreateMemberTxParams{CreateMemberParams: arg}), eqAuditMeta(session.UserID)).
 					Times(1).
 					Return(member, nil)
+
+				buildMemberContactDetailsStubs(store, member, db.MemberContactDetails{})
 			},
 			checkResponse: func(t *testing.T, response *http.Response) {
 				require.Equal(t, http.StatusOK, response.StatusCode)
 				requireBodyMatchMember(t, response.Body, member)
 			},
 		},
+		{
+			name: "InvitedWithStartDate",
+			body: fiber.Map{
+				"first_name": invitedMember.FirstName,
+				"last_name":  invitedMember.LastName,
+				"status":     db.MemberStatusInvited,
+				"start_date": "2023-04-01",
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ListCustomFieldDefinitions(gomock.Any()).
+					Times(1).
+					Return([]db.CustomFieldDefinition{}, nil)
+
+				arg := db.CreateMemberParams{
+					FirstName: invitedMember.FirstName,
+					LastName:  invitedMember.LastName,
+					Status:    db.MemberStatusInvited,
+					StartDate: invitedMember.StartDate,
+				}
+
+				store.EXPECT().
+					CreateMemberTx(gomock.Any(), gomock.Eq(db.CreateMemberTxParams{CreateMemberParams: arg}), eqAuditMeta(session.UserID)).
+					Times(1).
+					Return(invitedMember, nil)
+
+				buildMemberContactDetailsStubs(store, invitedMember, db.MemberContactDetails{})
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+				requireBodyMatchMember(t, response.Body, invitedMember)
+			},
+		},
+		{
+			name: "InvalidStatus",
+			body: fiber.Map{
+				"first_name": member.FirstName,
+				"last_name":  member.LastName,
+				"status":     db.MemberStatusOnLeave,
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					CreateMemberTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "InvalidStartDate",
+			body: fiber.Map{
+				"first_name": member.FirstName,
+				"last_name":  member.LastName,
+				"start_date": "2023/04/01",
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					CreateMemberTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
 		{
 			name: "NoAuthorization",
 			body: fiber.Map{
@@ -205,7 +307,7 @@ func TestCreateMemberAPI(t *testing.T) {
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					CreateMember(gomock.Any(), gomock.Any()).
+					CreateMemberTx(gomock.Any(), gomock.Any(), gomock.Any()).
 					Times(0)
 			},
 			checkResponse: func(t *testing.T, response *http.Response) {
@@ -224,15 +326,22 @@ func TestCreateMemberAPI(t *testing.T) {
 			buildStubs: func(store *mockdb.MockStore) {
 				buildValidSessionStubs(store, session)
 
+				store.EXPECT().
+					ListCustomFieldDefinitions(gomock.Any()).
+					Times(1).
+					Return([]db.CustomFieldDefinition{}, nil)
+
 				arg := db.CreateMemberParams{
 					FirstName: member.FirstName,
 					LastName:  member.LastName,
 				}
 
 				store.EXPECT().
-					CreateMember(gomock.Any(), gomock.Eq(arg)).
+					CreateMemberTx(gomock.Any(), gomock.Eq(db.CreateMemberTxParams{CreateMemberParams: arg}), gomock.Any()).
 					Times(1).
 					Return(memberOnlyRequiredFields, nil)
+
+				buildMemberContactDetailsStubs(store, memberOnlyRequiredFields, db.MemberContactDetails{})
 			},
 			checkResponse: func(t *testing.T, response *http.Response) {
 				require.Equal(t, http.StatusOK, response.StatusCode)
@@ -252,7 +361,7 @@ func TestCreateMemberAPI(t *testing.T) {
 				buildValidSessionStubs(store, session)
 
 				store.EXPECT().
-					CreateMember(gomock.Any(), gomock.Any()).
+					CreateMemberTx(gomock.Any(), gomock.Any(), gomock.Any()).
 					Times(0)
 			},
 			checkResponse: func(t *testing.T, response *http.Response) {
@@ -272,7 +381,7 @@ func TestCreateMemberAPI(t *testing.T) {
 				buildValidSessionStubs(store, session)
 
 				store.EXPECT().
-					CreateMember(gomock.Any(), gomock.Any()).
+					CreateMemberTx(gomock.Any(), gomock.Any(), gomock.Any()).
 					Times(0)
 			},
 			checkResponse: func(t *testing.T, response *http.Response) {
@@ -293,7 +402,7 @@ func TestCreateMemberAPI(t *testing.T) {
 				buildValidSessionStubs(store, session)
 
 				store.EXPECT().
-					CreateMember(gomock.Any(), gomock.Any()).
+					CreateMemberTx(gomock.Any(), gomock.Any(), gomock.Any()).
 					Times(0)
 			},
 			checkResponse: func(t *testing.T, response *http.Response) {
@@ -313,6 +422,11 @@ func TestCreateMemberAPI(t *testing.T) {
 			buildStubs: func(store *mockdb.MockStore) {
 				buildValidSessionStubs(store, session)
 
+				store.EXPECT().
+					ListCustomFieldDefinitions(gomock.Any()).
+					Times(1).
+					Return([]db.CustomFieldDefinition{}, nil)
+
 				arg := db.CreateMemberParams{
 					FirstName: member.FirstName,
 					LastName:  member.LastName,
@@ -320,7 +434,7 @@ func TestCreateMemberAPI(t *testing.T) {
 				}
 
 				store.EXPECT().
-					CreateMember(gomock.Any(), gomock.Eq(arg)).
+					CreateMemberTx(gomock.Any(), gomock.Eq(db.CreateMemberTxParams{CreateMemberParams: arg}), gomock.Any()).
 					Times(1).
 					Return(db.Member{}, sql.ErrConnDone)
 			},
@@ -328,6 +442,119 @@ func TestCreateMemberAPI(t *testing.T) {
 				require.Equal(t, http.StatusInternalServerError, response.StatusCode)
 			},
 		},
+		{
+			name: "CustomFields",
+			body: fiber.Map{
+				"first_name":    member.FirstName,
+				"last_name":     member.LastName,
+				"custom_fields": fiber.Map{"department": "Sales", "employee_number": "E-001"},
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ListCustomFieldDefinitions(gomock.Any()).
+					Times(1).
+					Return(definitions, nil)
+
+				arg := db.CreateMemberParams{
+					FirstName:    member.FirstName,
+					LastName:     member.LastName,
+					CustomFields: `{"department":"Sales","employee_number":"E-001"}`,
+				}
+
+				store.EXPECT().
+					CreateMemberTx(gomock.Any(), gomock.Eq(db.CreateMemberTxParams{CreateMemberParams: arg}), gomock.Any()).
+					Times(1).
+					Return(memberWithCustomFields, nil)
+
+				buildMemberContactDetailsStubs(store, memberWithCustomFields, db.MemberContactDetails{})
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+				requireBodyMatchMember(t, response.Body, memberWithCustomFields)
+			},
+		},
+		{
+			name: "RequiredCustomFieldNotFound",
+			body: fiber.Map{
+				"first_name":    member.FirstName,
+				"last_name":     member.LastName,
+				"custom_fields": fiber.Map{"employee_number": "E-001"},
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ListCustomFieldDefinitions(gomock.Any()).
+					Times(1).
+					Return(definitions, nil)
+
+				store.EXPECT().
+					CreateMemberTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "InvalidCustomField",
+			body: fiber.Map{
+				"first_name":    member.FirstName,
+				"last_name":     member.LastName,
+				"custom_fields": fiber.Map{"department": "Unknown"},
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ListCustomFieldDefinitions(gomock.Any()).
+					Times(1).
+					Return(definitions, nil)
+
+				store.EXPECT().
+					CreateMemberTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "ListCustomFieldDefinitionsError",
+			body: fiber.Map{
+				"first_name": member.FirstName,
+				"last_name":  member.LastName,
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ListCustomFieldDefinitions(gomock.Any()).
+					Times(1).
+					Return([]db.CustomFieldDefinition{}, sql.ErrConnDone)
+
+				store.EXPECT().
+					CreateMemberTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusInternalServerError, response.StatusCode)
+			},
+		},
 	}
 
 	for i := range testCases {
@@ -376,8 +603,27 @@ func TestListMembersAPI(t *testing.T) {
 	}
 
 	type Query struct {
-		pageID   int
-		pageSize int
+		pageID        int
+		pageSize      int
+		q             string
+		hasEmail      string
+		createdAfter  string
+		createdBefore string
+		sort          string
+		tags          string
+		tagsMatch     string
+		status        string
+		customFields  map[string]string
+	}
+
+	activeMembers := db.MemberFilter{Statuses: []string{db.MemberStatusActive}}
+
+	tagIDs := []uuid.UUID{util.RandomUUID(), util.RandomUUID()}
+
+	definitions := []db.CustomFieldDefinition{
+		randomCustomFieldDefinition("department", customFieldTypeSelect, false, "Sales", "Engineering"),
+		randomCustomFieldDefinition("grade", customFieldTypeNumber, false),
+		randomCustomFieldDefinition("skills", customFieldTypeMultiSelect, false, "Go", "SQL"),
 	}
 
 	testCases := []struct {
@@ -399,20 +645,23 @@ func TestListMembersAPI(t *testing.T) {
 			buildStubs: func(store *mockdb.MockStore) {
 				buildValidSessionStubs(store, session)
 
-				arg := db.ListMembersParams{
+				arg := db.ListMembersByFilterParams{
+					Filter: activeMembers,
 					Limit:  int32(n),
 					Offset: 0,
 				}
 
 				store.EXPECT().
-					ListMembers(gomock.Any(), gomock.Eq(arg)).
+					ListMembersByFilter(gomock.Any(), gomock.Eq(arg)).
 					Times(1).
 					Return(members, nil)
 
 				store.EXPECT().
-					CountMembers(gomock.Any()).
+					CountMembersByFilter(gomock.Any(), gomock.Eq(activeMembers)).
 					Times(1).
 					Return(int64(len(members)), nil)
+
+				buildMemberTagsStubs(store, members)
 			},
 			checkResponse: func(t *testing.T, response *http.Response) {
 				require.Equal(t, http.StatusOK, response.StatusCode)
@@ -429,11 +678,11 @@ func TestListMembersAPI(t *testing.T) {
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					ListMembers(gomock.Any(), gomock.Any()).
+					ListMembersByFilter(gomock.Any(), gomock.Any()).
 					Times(0)
 
 				store.EXPECT().
-					CountMembers(gomock.Any()).
+					CountMembersByFilter(gomock.Any(), gomock.Any()).
 					Times(0)
 			},
 			checkResponse: func(t *testing.T, response *http.Response) {
@@ -441,9 +690,15 @@ func TestListMembersAPI(t *testing.T) {
 			},
 		},
 		{
-			name: "PageIDNotFound",
+			name: "FilterAndSort",
 			query: Query{
-				pageSize: n,
+				pageID:        2,
+				pageSize:      n,
+				q:             "taro yamada",
+				hasEmail:      "true",
+				createdAfter:  "2023-01-01T00:00:00Z",
+				createdBefore: "2023-04-01T00:00:00+09:00",
+				sort:          "last_name,-created_at",
 			},
 			setupAuth: func(request *http.Request) {
 				addSessionTokenInCookie(request, session.SessionToken.String())
@@ -451,23 +706,136 @@ func TestListMembersAPI(t *testing.T) {
 			buildStubs: func(store *mockdb.MockStore) {
 				buildValidSessionStubs(store, session)
 
+				filter := db.MemberFilter{
+					Query:         "taro yamada",
+					HasEmail:      sql.NullBool{Bool: true, Valid: true},
+					CreatedAfter:  sql.NullTime{Time: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), Valid: true},
+					CreatedBefore: sql.NullTime{Time: time.Date(2023, 3, 31, 15, 0, 0, 0, time.UTC), Valid: true},
+					Statuses:      []string{db.MemberStatusActive},
+				}
+
+				arg := db.ListMembersByFilterParams{
+					Filter: filter,
+					Sort: []db.MemberSortKey{
+						{Column: "last_name"},
+						{Column: "created_at", Desc: true},
+					},
+					Limit:  int32(n),
+					Offset: int32(n),
+				}
+
 				store.EXPECT().
-					ListMembers(gomock.Any(), gomock.Any()).
-					Times(0)
+					ListMembersByFilter(gomock.Any(), eqListMembersByFilterParams(arg)).
+					Times(1).
+					Return(members, nil)
 
 				store.EXPECT().
-					CountMembers(gomock.Any()).
-					Times(0)
+					CountMembersByFilter(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(int64(2*n), nil)
+
+				buildMemberTagsStubs(store, members)
 			},
 			checkResponse: func(t *testing.T, response *http.Response) {
-				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+				require.Equal(t, http.StatusOK, response.StatusCode)
+				checkListMembersResponse(t, response.Body, members, 2, int32(n), 2, int64(2*n))
 			},
 		},
 		{
-			name: "PageIDLessThanLowerLimit",
+			name: "CustomFieldsFilter",
 			query: Query{
-				pageID:   0,
+				pageID:       1,
+				pageSize:     n,
+				customFields: map[string]string{"department": "Sales", "grade": "3", "skills": "Go"},
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ListCustomFieldDefinitions(gomock.Any()).
+					Times(1).
+					Return(definitions, nil)
+
+				filter := db.MemberFilter{
+					CustomFields: json.RawMessage(`{"department":"Sales","grade":3,"skills":["Go"]}`),
+					Statuses:     []string{db.MemberStatusActive},
+				}
+
+				arg := db.ListMembersByFilterParams{
+					Filter: filter,
+					Limit:  int32(n),
+					Offset: 0,
+				}
+
+				store.EXPECT().
+					ListMembersByFilter(gomock.Any(), eqListMembersByFilterParams(arg)).
+					Times(1).
+					Return(members, nil)
+
+				store.EXPECT().
+					CountMembersByFilter(gomock.Any(), gomock.Eq(filter)).
+					Times(1).
+					Return(int64(len(members)), nil)
+
+				buildMemberTagsStubs(store, members)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+				checkListMembersResponse(t, response.Body, members, 1, int32(n), 1, int64(n))
+			},
+		},
+		{
+			name: "TagsFilter",
+			query: Query{
+				pageID:    1,
+				pageSize:  n,
+				tags:      memberIDsToCommaSeparatedString(tagIDs),
+				tagsMatch: "all",
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				filter := db.MemberFilter{
+					TagIDs:       tagIDs,
+					TagsMatchAll: true,
+					Statuses:     []string{db.MemberStatusActive},
+				}
+
+				arg := db.ListMembersByFilterParams{
+					Filter: filter,
+					Limit:  int32(n),
+					Offset: 0,
+				}
+
+				store.EXPECT().
+					ListMembersByFilter(gomock.Any(), eqListMembersByFilterParams(arg)).
+					Times(1).
+					Return(members, nil)
+
+				store.EXPECT().
+					CountMembersByFilter(gomock.Any(), gomock.Eq(filter)).
+					Times(1).
+					Return(int64(len(members)), nil)
+
+				buildMemberTagsStubs(store, members)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+				checkListMembersResponse(t, response.Body, members, 1, int32(n), 1, int64(n))
+			},
+		},
+		{
+			name: "StatusFilter",
+			query: Query{
+				pageID:   1,
 				pageSize: n,
+				status:   "on_leave, offboarded",
 			},
 			setupAuth: func(request *http.Request) {
 				addSessionTokenInCookie(request, session.SessionToken.String())
@@ -475,23 +843,662 @@ func TestListMembersAPI(t *testing.T) {
 			buildStubs: func(store *mockdb.MockStore) {
 				buildValidSessionStubs(store, session)
 
+				filter := db.MemberFilter{
+					Statuses: []string{db.MemberStatusOnLeave, db.MemberStatusOffboarded},
+				}
+
+				arg := db.ListMembersByFilterParams{
+					Filter: filter,
+					Limit:  int32(n),
+					Offset: 0,
+				}
+
 				store.EXPECT().
-					ListMembers(gomock.Any(), gomock.Any()).
-					Times(0)
+					ListMembersByFilter(gomock.Any(), eqListMembersByFilterParams(arg)).
+					Times(1).
+					Return(members, nil)
 
 				store.EXPECT().
-					CountMembers(gomock.Any()).
-					Times(0)
+					CountMembersByFilter(gomock.Any(), gomock.Eq(filter)).
+					Times(1).
+					Return(int64(len(members)), nil)
+
+				buildMemberTagsStubs(store, members)
 			},
 			checkResponse: func(t *testing.T, response *http.Response) {
-				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+				require.Equal(t, http.StatusOK, response.StatusCode)
+				checkListMembersResponse(t, response.Body, members, 1, int32(n), 1, int64(n))
 			},
 		},
 		{
-			name: "PageSizeNotFound",
+			name: "AllStatuses",
 			query: Query{
-				pageID: 1,
+				pageID:   1,
+				pageSize: n,
+				status:   "all",
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				arg := db.ListMembersByFilterParams{
+					Limit:  int32(n),
+					Offset: 0,
+				}
+
+				store.EXPECT().
+					ListMembersByFilter(gomock.Any(), eqListMembersByFilterParams(arg)).
+					Times(1).
+					Return(members, nil)
+
+				store.EXPECT().
+					CountMembersByFilter(gomock.Any(), gomock.Eq(db.MemberFilter{})).
+					Times(1).
+					Return(int64(len(members)), nil)
+
+				buildMemberTagsStubs(store, members)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+				checkListMembersResponse(t, response.Body, members, 1, int32(n), 1, int64(n))
+			},
+		},
+		{
+			name: "InvalidStatus",
+			query: Query{
+				pageID:   1,
+				pageSize: n,
+				status:   "retired",
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ListMembersByFilter(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "InvalidTagsMatch",
+			query: Query{
+				pageID:    1,
+				pageSize:  n,
+				tags:      memberIDsToCommaSeparatedString(tagIDs),
+				tagsMatch: "some",
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ListMembersByFilter(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "UnknownCustomField",
+			query: Query{
+				pageID:       1,
+				pageSize:     n,
+				customFields: map[string]string{"shirt_size": "L"},
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ListCustomFieldDefinitions(gomock.Any()).
+					Times(1).
+					Return(definitions, nil)
+
+				store.EXPECT().
+					ListMembersByFilter(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "InvalidSort",
+			query: Query{
+				pageID:   1,
+				pageSize: n,
+				sort:     "hashed_password",
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ListMembersByFilter(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				store.EXPECT().
+					CountMembersByFilter(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "InvalidCreatedAfter",
+			query: Query{
+				pageID:       1,
+				pageSize:     n,
+				createdAfter: "2023-01-01",
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ListMembersByFilter(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				store.EXPECT().
+					CountMembersByFilter(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "PageIDNotFound",
+			query: Query{
+				pageSize: n,
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ListMembersByFilter(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				store.EXPECT().
+					CountMembersByFilter(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "PageIDLessThanLowerLimit",
+			query: Query{
+				pageID:   0,
+				pageSize: n,
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ListMembersByFilter(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				store.EXPECT().
+					CountMembersByFilter(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "PageSizeNotFound",
+			query: Query{
+				pageID: 1,
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ListMembersByFilter(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				store.EXPECT().
+					CountMembersByFilter(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "PageSizeLessThanLowerLimit",
+			query: Query{
+				pageID:   1,
+				pageSize: 4,
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ListMembersByFilter(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				store.EXPECT().
+					CountMembersByFilter(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "PageSizeMoreThanUpperLimit",
+			query: Query{
+				pageID:   1,
+				pageSize: 11,
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ListMembersByFilter(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				store.EXPECT().
+					CountMembersByFilter(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "ListMembersError",
+			query: Query{
+				pageID:   1,
+				pageSize: n,
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				arg := db.ListMembersByFilterParams{
+					Filter: activeMembers,
+					Limit:  int32(n),
+					Offset: 0,
+				}
+
+				store.EXPECT().
+					ListMembersByFilter(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return([]db.Member{}, sql.ErrConnDone)
+
+				store.EXPECT().
+					CountMembersByFilter(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusInternalServerError, response.StatusCode)
+			},
+		},
+		{
+			name: "CountMembersError",
+			query: Query{
+				pageID:   1,
+				pageSize: n,
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				arg := db.ListMembersByFilterParams{
+					Filter: activeMembers,
+					Limit:  int32(n),
+					Offset: 0,
+				}
+
+				store.EXPECT().
+					ListMembersByFilter(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(members, nil)
+
+				store.EXPECT().
+					CountMembersByFilter(gomock.Any(), gomock.Eq(activeMembers)).
+					Times(1).
+					Return(int64(0), sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusInternalServerError, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			url := "/api/v1/members"
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			// Add query parameters to request URL
+			q := request.URL.Query()
+			q.Add("page_id", fmt.Sprintf("%d", tc.query.pageID))
+			q.Add("page_size", fmt.Sprintf("%d", tc.query.pageSize))
+			for key, value := range map[string]string{
+				"q":              tc.query.q,
+				"has_email":      tc.query.hasEmail,
+				"created_after":  tc.query.createdAfter,
+				"created_before": tc.query.createdBefore,
+				"sort":           tc.query.sort,
+				"tags":           tc.query.tags,
+				"tags_match":     tc.query.tagsMatch,
+				"status":         tc.query.status,
+			} {
+				if len(value) > 0 {
+					q.Add(key, value)
+				}
+			}
+			for key, value := range tc.query.customFields {
+				q.Add(fmt.Sprintf("custom_fields[%s]", key), value)
+			}
+			request.URL.RawQuery = q.Encode()
+
+			tc.setupAuth(request)
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func TestUpdateMemberAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	member := randomMember()
+	memberOnlyRequiredFields := db.Member{
+		ID: member.ID,
+	}
+	definitions := []db.CustomFieldDefinition{
+		randomCustomFieldDefinition("department", customFieldTypeSelect, true, "Sales", "Engineering"),
+		randomCustomFieldDefinition("employee_number", customFieldTypeText, false),
+	}
+
+	testCases := []struct {
+		name           string
+		memberID       string
+		body           fiber.Map
+		ifMatch        string
+		requireIfMatch bool
+		setupAuth      func(request *http.Request)
+		buildStubs     func(store *mockdb.MockStore)
+		checkResponse  func(t *testing.T, response *http.Response)
+	}{
+		{
+			name:     "OK",
+			memberID: member.ID.String(),
+			body: fiber.Map{
+				"first_name": member.FirstName,
+				"last_name":  member.LastName,
+				"email":      member.Email.String,
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				arg := db.UpdateMemberParams{
+					ID:        member.ID,
+					FirstName: sql.NullString{String: member.FirstName, Valid: true},
+					LastName:  sql.NullString{String: member.LastName, Valid: true},
+					Email:     member.Email,
+				}
+
+				store.EXPECT().
+					UpdateMemberTx(gomock.Any(), gomock.Eq(db.UpdateMemberTxParams{UpdateMemberParams: arg}), eqAuditMeta(session.UserID)).
+					Times(1).
+					Return(member, nil)
+
+				buildMemberTagsStubs(store, []db.Member{member})
+				buildMemberContactDetailsStubs(store, member, db.MemberContactDetails{})
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+				requireBodyMatchMember(t, response.Body, member)
+			},
+		},
+		{
+			name:     "CustomFields",
+			memberID: member.ID.String(),
+			body: fiber.Map{
+				"custom_fields": fiber.Map{"department": "Engineering", "employee_number": nil},
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ListCustomFieldDefinitions(gomock.Any()).
+					Times(1).
+					Return(definitions, nil)
+
+				arg := db.UpdateMemberParams{
+					ID:           member.ID,
+					CustomFields: `{"department":"Engineering","employee_number":null}`,
+				}
+
+				store.EXPECT().
+					UpdateMemberTx(gomock.Any(), gomock.Eq(db.UpdateMemberTxParams{UpdateMemberParams: arg}), gomock.Any()).
+					Times(1).
+					Return(member, nil)
+
+				buildMemberTagsStubs(store, []db.Member{member})
+				buildMemberContactDetailsStubs(store, member, db.MemberContactDetails{})
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+				requireBodyMatchMember(t, response.Body, member)
+			},
+		},
+		{
+			name:     "RemoveRequiredCustomField",
+			memberID: member.ID.String(),
+			body: fiber.Map{
+				"custom_fields": fiber.Map{"department": nil},
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ListCustomFieldDefinitions(gomock.Any()).
+					Times(1).
+					Return(definitions, nil)
+
+				store.EXPECT().
+					UpdateMemberTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name:     "NoAuthorization",
+			memberID: member.ID.String(),
+			body: fiber.Map{
+				"first_name": member.FirstName,
+				"last_name":  member.LastName,
+				"email":      member.Email.String,
+			},
+			setupAuth: func(request *http.Request) {
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UpdateMemberTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusUnauthorized, response.StatusCode)
+			},
+		},
+		{
+			name:     "OptionalFieldsNotFound",
+			memberID: member.ID.String(),
+			body:     fiber.Map{},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				arg := db.UpdateMemberParams{
+					ID: member.ID,
+				}
+
+				store.EXPECT().
+					UpdateMemberTx(gomock.Any(), gomock.Eq(db.UpdateMemberTxParams{UpdateMemberParams: arg}), gomock.Any()).
+					Times(1).
+					Return(memberOnlyRequiredFields, nil)
+
+				buildMemberTagsStubs(store, []db.Member{memberOnlyRequiredFields})
+				buildMemberContactDetailsStubs(store, memberOnlyRequiredFields, db.MemberContactDetails{})
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+				requireBodyMatchMember(t, response.Body, memberOnlyRequiredFields)
+			},
+		},
+		{
+			name:     "IfMatch",
+			memberID: member.ID.String(),
+			body: fiber.Map{
+				"first_name": member.FirstName,
+			},
+			ifMatch:        `"3"`,
+			requireIfMatch: true,
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				arg := db.UpdateMemberParams{
+					ID:               member.ID,
+					FirstName:        sql.NullString{String: member.FirstName, Valid: true},
+					ExpectedVersions: []int32{3},
+				}
+
+				updated := member
+				updated.Version = 4
+
+				store.EXPECT().
+					UpdateMemberTx(gomock.Any(), gomock.Eq(db.UpdateMemberTxParams{UpdateMemberParams: arg}), gomock.Any()).
+					Times(1).
+					Return(updated, nil)
+
+				buildMemberTagsStubs(store, []db.Member{updated})
+				buildMemberContactDetailsStubs(store, updated, db.MemberContactDetails{})
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+				require.Equal(t, `"4"`, response.Header.Get("ETag"))
+				requireBodyMatchMember(t, response.Body, member)
+			},
+		},
+		{
+			name:     "PreconditionFailed",
+			memberID: member.ID.String(),
+			body: fiber.Map{
+				"first_name": member.FirstName,
+			},
+			ifMatch: `"2"`,
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				current := member
+				current.Version = 3
+
+				store.EXPECT().
+					UpdateMemberTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Member{}, sql.ErrNoRows)
+
+				store.EXPECT().
+					GetMember(gomock.Any(), gomock.Eq(member.ID)).
+					Times(1).
+					Return(current, nil)
+
+				buildMemberTagsStubs(store, []db.Member{current})
+				buildMemberContactDetailsStubs(store, current, db.MemberContactDetails{})
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusPreconditionFailed, response.StatusCode)
+				require.Equal(t, `"3"`, response.Header.Get("ETag"))
+				requireBodyMatchMember(t, response.Body, member)
+			},
+		},
+		{
+			name:     "PreconditionFailedNotFound",
+			memberID: member.ID.String(),
+			body: fiber.Map{
+				"first_name": member.FirstName,
 			},
+			ifMatch: `"2"`,
 			setupAuth: func(request *http.Request) {
 				addSessionTokenInCookie(request, session.SessionToken.String())
 			},
@@ -499,22 +1506,24 @@ func TestListMembersAPI(t *testing.T) {
 				buildValidSessionStubs(store, session)
 
 				store.EXPECT().
-					ListMembers(gomock.Any(), gomock.Any()).
-					Times(0)
+					UpdateMemberTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Member{}, sql.ErrNoRows)
 
 				store.EXPECT().
-					CountMembers(gomock.Any()).
-					Times(0)
+					GetMember(gomock.Any(), gomock.Eq(member.ID)).
+					Times(1).
+					Return(db.Member{}, sql.ErrNoRows)
 			},
 			checkResponse: func(t *testing.T, response *http.Response) {
-				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+				require.Equal(t, http.StatusNotFound, response.StatusCode)
 			},
 		},
 		{
-			name: "PageSizeLessThanLowerLimit",
-			query: Query{
-				pageID:   1,
-				pageSize: 4,
+			name:     "NotFound",
+			memberID: member.ID.String(),
+			body: fiber.Map{
+				"first_name": member.FirstName,
 			},
 			setupAuth: func(request *http.Request) {
 				addSessionTokenInCookie(request, session.SessionToken.String())
@@ -523,23 +1532,25 @@ func TestListMembersAPI(t *testing.T) {
 				buildValidSessionStubs(store, session)
 
 				store.EXPECT().
-					ListMembers(gomock.Any(), gomock.Any()).
-					Times(0)
+					UpdateMemberTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Member{}, sql.ErrNoRows)
 
 				store.EXPECT().
-					CountMembers(gomock.Any()).
+					GetMember(gomock.Any(), gomock.Any()).
 					Times(0)
 			},
 			checkResponse: func(t *testing.T, response *http.Response) {
-				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+				require.Equal(t, http.StatusNotFound, response.StatusCode)
 			},
 		},
 		{
-			name: "PageSizeMoreThanUpperLimit",
-			query: Query{
-				pageID:   1,
-				pageSize: 11,
+			name:     "PreconditionRequired",
+			memberID: member.ID.String(),
+			body: fiber.Map{
+				"first_name": member.FirstName,
 			},
+			requireIfMatch: true,
 			setupAuth: func(request *http.Request) {
 				addSessionTokenInCookie(request, session.SessionToken.String())
 			},
@@ -547,22 +1558,20 @@ func TestListMembersAPI(t *testing.T) {
 				buildValidSessionStubs(store, session)
 
 				store.EXPECT().
-					ListMembers(gomock.Any(), gomock.Any()).
-					Times(0)
-
-				store.EXPECT().
-					CountMembers(gomock.Any()).
+					UpdateMemberTx(gomock.Any(), gomock.Any(), gomock.Any()).
 					Times(0)
 			},
 			checkResponse: func(t *testing.T, response *http.Response) {
-				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+				require.Equal(t, http.StatusPreconditionRequired, response.StatusCode)
 			},
 		},
 		{
-			name: "ListMembersError",
-			query: Query{
-				pageID:   1,
-				pageSize: n,
+			name:     "InvalidEmail",
+			memberID: member.ID.String(),
+			body: fiber.Map{
+				"first_name": member.FirstName,
+				"last_name":  member.LastName,
+				"email":      "InvalidEmail",
 			},
 			setupAuth: func(request *http.Request) {
 				addSessionTokenInCookie(request, session.SessionToken.String())
@@ -570,29 +1579,21 @@ func TestListMembersAPI(t *testing.T) {
 			buildStubs: func(store *mockdb.MockStore) {
 				buildValidSessionStubs(store, session)
 
-				arg := db.ListMembersParams{
-					Limit:  int32(n),
-					Offset: 0,
-				}
-
-				store.EXPECT().
-					ListMembers(gomock.Any(), gomock.Eq(arg)).
-					Times(1).
-					Return([]db.Member{}, sql.ErrConnDone)
-
 				store.EXPECT().
-					CountMembers(gomock.Any()).
+					UpdateMemberTx(gomock.Any(), gomock.Any(), gomock.Any()).
 					Times(0)
 			},
 			checkResponse: func(t *testing.T, response *http.Response) {
-				require.Equal(t, http.StatusInternalServerError, response.StatusCode)
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
 			},
 		},
 		{
-			name: "CountMembersError",
-			query: Query{
-				pageID:   1,
-				pageSize: n,
+			name:     "UpdateMemberError",
+			memberID: member.ID.String(),
+			body: fiber.Map{
+				"first_name": member.FirstName,
+				"last_name":  member.LastName,
+				"email":      member.Email.String,
 			},
 			setupAuth: func(request *http.Request) {
 				addSessionTokenInCookie(request, session.SessionToken.String())
@@ -600,20 +1601,17 @@ func TestListMembersAPI(t *testing.T) {
 			buildStubs: func(store *mockdb.MockStore) {
 				buildValidSessionStubs(store, session)
 
-				arg := db.ListMembersParams{
-					Limit:  int32(n),
-					Offset: 0,
+				arg := db.UpdateMemberParams{
+					ID:        member.ID,
+					FirstName: sql.NullString{String: member.FirstName, Valid: true},
+					LastName:  sql.NullString{String: member.LastName, Valid: true},
+					Email:     member.Email,
 				}
 
 				store.EXPECT().
-					ListMembers(gomock.Any(), gomock.Eq(arg)).
-					Times(1).
-					Return(members, nil)
-
-				store.EXPECT().
-					CountMembers(gomock.Any()).
+					UpdateMemberTx(gomock.Any(), gomock.Eq(db.UpdateMemberTxParams{UpdateMemberParams: arg}), gomock.Any()).
 					Times(1).
-					Return(int64(0), sql.ErrConnDone)
+					Return(db.Member{}, sql.ErrConnDone)
 			},
 			checkResponse: func(t *testing.T, response *http.Response) {
 				require.Equal(t, http.StatusInternalServerError, response.StatusCode)
@@ -633,17 +1631,22 @@ func TestListMembersAPI(t *testing.T) {
 			store := mockdb.NewMockStore(ctrl)
 			tc.buildStubs(store)
 
+			// start test server and send request
 			server := newTestServer(t, store)
+			server.config.MemberUpdateRequireIfMatch = tc.requireIfMatch
 
-			url := "/api/v1/members"
-			request, err := http.NewRequest(http.MethodGet, url, nil)
+			// Marshal body data to JSON
+			data, err := json.Marshal(tc.body)
 			require.NoError(t, err)
 
-			// Add query parameters to request URL
-			q := request.URL.Query()
-			q.Add("page_id", fmt.Sprintf("%d", tc.query.pageID))
-			q.Add("page_size", fmt.Sprintf("%d", tc.query.pageSize))
-			request.URL.RawQuery = q.Encode()
+			url := fmt.Sprintf("/api/v1/members/%s", tc.memberID)
+			request, err := http.NewRequest(http.MethodPut, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			request.Header.Set("Content-Type", "application/json")
+			if len(tc.ifMatch) > 0 {
+				request.Header.Set("If-Match", tc.ifMatch)
+			}
 
 			tc.setupAuth(request)
 			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
@@ -654,19 +1657,17 @@ func TestListMembersAPI(t *testing.T) {
 	}
 }
 
-func TestUpdateMemberAPI(t *testing.T) {
+func TestPatchMemberAPI(t *testing.T) {
 	t.Parallel()
 
 	session := randomSession()
 	member := randomMember()
-	memberOnlyRequiredFields := db.Member{
-		ID: member.ID,
-	}
 
 	testCases := []struct {
 		name          string
 		memberID      string
-		body          fiber.Map
+		body          string
+		ifMatch       string
 		setupAuth     func(request *http.Request)
 		buildStubs    func(store *mockdb.MockStore)
 		checkResponse func(t *testing.T, response *http.Response)
@@ -674,28 +1675,25 @@ func TestUpdateMemberAPI(t *testing.T) {
 		{
 			name:     "OK",
 			memberID: member.ID.String(),
-			body: fiber.Map{
-				"first_name": member.FirstName,
-				"last_name":  member.LastName,
-				"email":      member.Email.String,
-			},
+			body:     fmt.Sprintf(`{"first_name": %q}`, member.FirstName),
 			setupAuth: func(request *http.Request) {
 				addSessionTokenInCookie(request, session.SessionToken.String())
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				buildValidSessionStubs(store, session)
 
-				arg := db.UpdateMemberParams{
+				arg := db.PatchMemberParams{
 					ID:        member.ID,
 					FirstName: sql.NullString{String: member.FirstName, Valid: true},
-					LastName:  sql.NullString{String: member.LastName, Valid: true},
-					Email:     member.Email,
 				}
 
 				store.EXPECT().
-					UpdateMember(gomock.Any(), gomock.Eq(arg)).
+					PatchMemberTx(gomock.Any(), gomock.Eq(db.PatchMemberTxParams{PatchMemberParams: arg}), eqAuditMeta(session.UserID)).
 					Times(1).
 					Return(member, nil)
+
+				buildMemberTagsStubs(store, []db.Member{member})
+				buildMemberContactDetailsStubs(store, member, db.MemberContactDetails{})
 			},
 			checkResponse: func(t *testing.T, response *http.Response) {
 				require.Equal(t, http.StatusOK, response.StatusCode)
@@ -703,56 +1701,143 @@ func TestUpdateMemberAPI(t *testing.T) {
 			},
 		},
 		{
-			name:     "NoAuthorization",
+			name:     "ClearEmail",
 			memberID: member.ID.String(),
-			body: fiber.Map{
-				"first_name": member.FirstName,
-				"last_name":  member.LastName,
-				"email":      member.Email.String,
-			},
+			body:     `{"email": null}`,
+			ifMatch:  `"1"`,
 			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
 			},
 			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				arg := db.PatchMemberParams{
+					ID:               member.ID,
+					SetEmail:         true,
+					ExpectedVersions: []int32{1},
+				}
+
+				withoutEmail := member
+				withoutEmail.Email = sql.NullString{}
+				withoutEmail.Version = 2
+
 				store.EXPECT().
-					UpdateMember(gomock.Any(), gomock.Any()).
-					Times(0)
+					PatchMemberTx(gomock.Any(), gomock.Eq(db.PatchMemberTxParams{PatchMemberParams: arg}), gomock.Any()).
+					Times(1).
+					Return(withoutEmail, nil)
+
+				buildMemberTagsStubs(store, []db.Member{withoutEmail})
+				buildMemberContactDetailsStubs(store, withoutEmail, db.MemberContactDetails{})
 			},
 			checkResponse: func(t *testing.T, response *http.Response) {
-				require.Equal(t, http.StatusUnauthorized, response.StatusCode)
+				require.Equal(t, http.StatusOK, response.StatusCode)
+				require.Equal(t, `"2"`, response.Header.Get("ETag"))
+
+				var gotMember memberResponse
+				err := json.NewDecoder(response.Body).Decode(&gotMember)
+				require.NoError(t, err)
+				require.False(t, gotMember.Email.Valid)
 			},
 		},
 		{
-			name:     "OptionalFieldsNotFound",
+			name:     "SetEmail",
 			memberID: member.ID.String(),
-			body:     fiber.Map{},
+			body:     fmt.Sprintf(`{"email": %q}`, member.Email.String),
 			setupAuth: func(request *http.Request) {
 				addSessionTokenInCookie(request, session.SessionToken.String())
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				buildValidSessionStubs(store, session)
 
-				arg := db.UpdateMemberParams{
-					ID: member.ID,
+				arg := db.PatchMemberParams{
+					ID:       member.ID,
+					SetEmail: true,
+					Email:    member.Email,
 				}
 
 				store.EXPECT().
-					UpdateMember(gomock.Any(), gomock.Eq(arg)).
+					PatchMemberTx(gomock.Any(), gomock.Eq(db.PatchMemberTxParams{PatchMemberParams: arg}), gomock.Any()).
 					Times(1).
-					Return(memberOnlyRequiredFields, nil)
+					Return(member, nil)
+
+				buildMemberTagsStubs(store, []db.Member{member})
+				buildMemberContactDetailsStubs(store, member, db.MemberContactDetails{})
 			},
 			checkResponse: func(t *testing.T, response *http.Response) {
 				require.Equal(t, http.StatusOK, response.StatusCode)
-				requireBodyMatchMember(t, response.Body, memberOnlyRequiredFields)
+				requireBodyMatchMember(t, response.Body, member)
 			},
 		},
 		{
-			name:     "InvalidEmail",
+			name:     "NoAuthorization",
 			memberID: member.ID.String(),
-			body: fiber.Map{
-				"first_name": member.FirstName,
-				"last_name":  member.LastName,
-				"email":      "InvalidEmail",
+			body:     `{}`,
+			setupAuth: func(request *http.Request) {
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					PatchMemberTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusUnauthorized, response.StatusCode)
+			},
+		},
+		{
+			name:     "NotFound",
+			memberID: member.ID.String(),
+			body:     `{"last_name": "Yamada"}`,
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					PatchMemberTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Member{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusNotFound, response.StatusCode)
+			},
+		},
+		{
+			name:     "PreconditionFailed",
+			memberID: member.ID.String(),
+			body:     `{"last_name": "Yamada"}`,
+			ifMatch:  `"1"`,
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				current := member
+				current.Version = 5
+
+				store.EXPECT().
+					PatchMemberTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Member{}, sql.ErrNoRows)
+
+				store.EXPECT().
+					GetMember(gomock.Any(), gomock.Eq(member.ID)).
+					Times(1).
+					Return(current, nil)
+
+				buildMemberTagsStubs(store, []db.Member{current})
+				buildMemberContactDetailsStubs(store, current, db.MemberContactDetails{})
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusPreconditionFailed, response.StatusCode)
+				require.Equal(t, `"5"`, response.Header.Get("ETag"))
 			},
+		},
+		{
+			name:     "ClearRequiredField",
+			memberID: member.ID.String(),
+			body:     `{"first_name": null}`,
 			setupAuth: func(request *http.Request) {
 				addSessionTokenInCookie(request, session.SessionToken.String())
 			},
@@ -760,7 +1845,7 @@ func TestUpdateMemberAPI(t *testing.T) {
 				buildValidSessionStubs(store, session)
 
 				store.EXPECT().
-					UpdateMember(gomock.Any(), gomock.Any()).
+					PatchMemberTx(gomock.Any(), gomock.Any(), gomock.Any()).
 					Times(0)
 			},
 			checkResponse: func(t *testing.T, response *http.Response) {
@@ -768,33 +1853,57 @@ func TestUpdateMemberAPI(t *testing.T) {
 			},
 		},
 		{
-			name:     "UpdateMemberError",
+			name:     "InvalidEmail",
 			memberID: member.ID.String(),
-			body: fiber.Map{
-				"first_name": member.FirstName,
-				"last_name":  member.LastName,
-				"email":      member.Email.String,
+			body:     `{"email": "InvalidEmail"}`,
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					PatchMemberTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
 			},
+		},
+		{
+			name:     "NotAnObject",
+			memberID: member.ID.String(),
+			body:     `["email"]`,
 			setupAuth: func(request *http.Request) {
 				addSessionTokenInCookie(request, session.SessionToken.String())
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				buildValidSessionStubs(store, session)
 
-				arg := db.UpdateMemberParams{
-					ID:        member.ID,
-					FirstName: sql.NullString{String: member.FirstName, Valid: true},
-					LastName:  sql.NullString{String: member.LastName, Valid: true},
-					Email:     member.Email,
-				}
+				store.EXPECT().
+					PatchMemberTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name:     "InvalidID",
+			memberID: "InvalidID",
+			body:     `{}`,
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
 
 				store.EXPECT().
-					UpdateMember(gomock.Any(), gomock.Eq(arg)).
-					Times(1).
-					Return(db.Member{}, sql.ErrConnDone)
+					PatchMemberTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
 			},
 			checkResponse: func(t *testing.T, response *http.Response) {
-				require.Equal(t, http.StatusInternalServerError, response.StatusCode)
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
 			},
 		},
 	}
@@ -814,15 +1923,14 @@ func TestUpdateMemberAPI(t *testing.T) {
 			// start test server and send request
 			server := newTestServer(t, store)
 
-			// Marshal body data to JSON
-			data, err := json.Marshal(tc.body)
-			require.NoError(t, err)
-
 			url := fmt.Sprintf("/api/v1/members/%s", tc.memberID)
-			request, err := http.NewRequest(http.MethodPut, url, bytes.NewReader(data))
+			request, err := http.NewRequest(http.MethodPatch, url, bytes.NewReader([]byte(tc.body)))
 			require.NoError(t, err)
 
-			request.Header.Set("Content-Type", "application/json")
+			request.Header.Set("Content-Type", "application/merge-patch+json")
+			if len(tc.ifMatch) > 0 {
+				request.Header.Set("If-Match", tc.ifMatch)
+			}
 
 			tc.setupAuth(request)
 			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
@@ -856,9 +1964,9 @@ func TestDeleteMemberAPI(t *testing.T) {
 				buildValidSessionStubs(store, session)
 
 				store.EXPECT().
-					DeleteMember(gomock.Any(), gomock.Eq(member.ID)).
+					DeleteMembersTx(gomock.Any(), gomock.Eq([]uuid.UUID{member.ID}), eqAuditMeta(session.UserID)).
 					Times(1).
-					Return(nil)
+					Return([]db.Member{}, nil)
 			},
 			checkResponse: func(t *testing.T, response *http.Response) {
 				require.Equal(t, http.StatusNoContent, response.StatusCode)
@@ -871,7 +1979,7 @@ func TestDeleteMemberAPI(t *testing.T) {
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					DeleteMember(gomock.Any(), gomock.Any()).
+					DeleteMembersTx(gomock.Any(), gomock.Any(), gomock.Any()).
 					Times(0)
 			},
 			checkResponse: func(t *testing.T, response *http.Response) {
@@ -888,7 +1996,7 @@ func TestDeleteMemberAPI(t *testing.T) {
 				buildValidSessionStubs(store, session)
 
 				store.EXPECT().
-					DeleteMember(gomock.Any(), gomock.Eq(member.ID)).
+					DeleteMembersTx(gomock.Any(), gomock.Eq([]uuid.UUID{member.ID}), gomock.Any()).
 					Times(0)
 			},
 			checkResponse: func(t *testing.T, response *http.Response) {
@@ -905,9 +2013,9 @@ func TestDeleteMemberAPI(t *testing.T) {
 				buildValidSessionStubs(store, session)
 
 				store.EXPECT().
-					DeleteMember(gomock.Any(), gomock.Eq(member.ID)).
+					DeleteMembersTx(gomock.Any(), gomock.Eq([]uuid.UUID{member.ID}), gomock.Any()).
 					Times(1).
-					Return(sql.ErrConnDone)
+					Return(nil, sql.ErrConnDone)
 			},
 			checkResponse: func(t *testing.T, response *http.Response) {
 				require.Equal(t, http.StatusInternalServerError, response.StatusCode)
@@ -974,9 +2082,9 @@ func TestDeleteMembersAPI(t *testing.T) {
 				buildValidSessionStubs(store, session)
 
 				store.EXPECT().
-					DeleteMembers(gomock.Any(), gomock.Eq(memberIDs)).
+					DeleteMembersTx(gomock.Any(), gomock.Eq(memberIDs), gomock.Any()).
 					Times(1).
-					Return(nil)
+					Return([]db.Member{}, nil)
 			},
 			checkResponse: func(t *testing.T, response *http.Response) {
 				require.Equal(t, http.StatusNoContent, response.StatusCode)
@@ -991,7 +2099,7 @@ func TestDeleteMembersAPI(t *testing.T) {
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					DeleteMembers(gomock.Any(), gomock.Any()).
+					DeleteMembersTx(gomock.Any(), gomock.Any(), gomock.Any()).
 					Times(0)
 			},
 			checkResponse: func(t *testing.T, response *http.Response) {
@@ -1008,7 +2116,7 @@ func TestDeleteMembersAPI(t *testing.T) {
 				buildValidSessionStubs(store, session)
 
 				store.EXPECT().
-					DeleteMembers(gomock.Any(), gomock.Eq(memberIDs)).
+					DeleteMembersTx(gomock.Any(), gomock.Eq(memberIDs), gomock.Any()).
 					Times(0)
 			},
 			checkResponse: func(t *testing.T, response *http.Response) {
@@ -1027,7 +2135,7 @@ func TestDeleteMembersAPI(t *testing.T) {
 				buildValidSessionStubs(store, session)
 
 				store.EXPECT().
-					DeleteMembers(gomock.Any(), gomock.Eq(memberIDs)).
+					DeleteMembersTx(gomock.Any(), gomock.Eq(memberIDs), gomock.Any()).
 					Times(0)
 			},
 			checkResponse: func(t *testing.T, response *http.Response) {
@@ -1075,7 +2183,38 @@ func randomMember() db.Member {
 		FirstName: util.RandomName(),
 		LastName:  util.RandomName(),
 		Email:     sql.NullString{String: util.RandomEmail(), Valid: true},
+		Status:    db.MemberStatusActive,
+		Version:   1,
+	}
+}
+
+type eqListMembersByFilterParamsMatcher struct {
+	arg db.ListMembersByFilterParams
+}
+
+func (e eqListMembersByFilterParamsMatcher) Matches(x interface{}) bool {
+	arg, ok := x.(db.ListMembersByFilterParams)
+	if !ok {
+		return false
 	}
+
+	// Times are compared by instant since the parsed location depends on the request.
+	if !e.arg.Filter.CreatedAfter.Time.Equal(arg.Filter.CreatedAfter.Time) ||
+		!e.arg.Filter.CreatedBefore.Time.Equal(arg.Filter.CreatedBefore.Time) {
+		return false
+	}
+	e.arg.Filter.CreatedAfter.Time = arg.Filter.CreatedAfter.Time
+	e.arg.Filter.CreatedBefore.Time = arg.Filter.CreatedBefore.Time
+
+	return reflect.DeepEqual(e.arg, arg)
+}
+
+func (e eqListMembersByFilterParamsMatcher) String() string {
+	return fmt.Sprintf("matches arg %v", e.arg)
+}
+
+func eqListMembersByFilterParams(arg db.ListMembersByFilterParams) gomock.Matcher {
+	return eqListMembersByFilterParamsMatcher{arg}
 }
 
 func requireBodyMatchMember(t *testing.T, body io.ReadCloser, member db.Member) {
@@ -1120,4 +2259,14 @@ func requireMemberResponseMatchMember(t *testing.T, gotMember memberResponse, me
 	require.Equal(t, member.LastName, gotMember.LastName)
 	require.Equal(t, member.Email.String, gotMember.Email.String)
 	require.Equal(t, member.CreatedAt, gotMember.CreatedAt)
+	require.Equal(t, member.ManagerID, gotMember.ManagerID)
+	require.Equal(t, member.Status, gotMember.Status)
+	require.Equal(t, member.StartDate, gotMember.StartDate.NullTime)
+	require.Equal(t, member.EndDate, gotMember.EndDate.NullTime)
+	avatarURL, avatarThumbnails := newAvatarURLs(member.AvatarUrl)
+	require.Equal(t, avatarURL, gotMember.AvatarURL)
+	require.Equal(t, avatarThumbnails, gotMember.AvatarThumbnails)
+	if len(member.CustomFields) > 0 {
+		require.JSONEq(t, string(member.CustomFields), string(gotMember.CustomFields))
+	}
 }
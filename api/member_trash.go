@@ -0,0 +1,173 @@
+package api
+
+import (
+	"database/sql"
+	"math"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	db "github.com/ot07/coworker-backend/db/sqlc"
+)
+
+type deletedMemberResponse struct {
+	memberResponse
+	DeletedAt time.Time `json:"deleted_at"`
+	// PurgeAt is the time after which the member is permanently deleted and can no longer be restored.
+	PurgeAt time.Time `json:"purge_at"`
+}
+
+func newDeletedMemberResponse(member db.Member, retention time.Duration) deletedMemberResponse {
+	return deletedMemberResponse{
+		memberResponse: newMemberResponse(member),
+		DeletedAt:      member.DeletedAt.Time,
+		PurgeAt:        member.DeletedAt.Time.Add(retention),
+	}
+}
+
+type listDeletedMembersRequest struct {
+	PageID   int32 `query:"page_id" json:"page_id" validate:"required,min=1"`
+	PageSize int32 `query:"page_size" json:"page_size" validate:"required,min=5,max=10"`
+}
+
+type listDeletedMembersResponse struct {
+	Meta listMembersResponseMeta `json:"meta"`
+	Data []deletedMemberResponse `json:"data"`
+}
+
+// @Summary      List deleted members
+// @Description  Lists the members in the trash, most recently deleted first.
+// @Description  They are permanently deleted once the retention period has passed.
+// @Tags         members
+// @Param        query query listDeletedMembersRequest true "query"
+// @Success      200 {object} listDeletedMembersResponse
+// @Failure      400 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /members/trash [get]
+func (server *Server) listDeletedMembers(c *fiber.Ctx) error {
+	req := new(listDeletedMembersRequest)
+	if err := c.QueryParser(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	validate := newValidator()
+	if err := validate.Struct(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	arg := db.ListDeletedMembersParams{
+		Limit:  req.PageSize,
+		Offset: (req.PageID - 1) * req.PageSize,
+	}
+
+	members, err := server.store.ListDeletedMembers(c.Context(), arg)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	totalCount, err := server.store.CountDeletedMembers(c.Context())
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	pageCount := int64(math.Ceil(float64(totalCount) / float64(req.PageSize)))
+
+	tags, err := server.memberTags(c.Context(), members)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	data := make([]deletedMemberResponse, 0, len(members))
+	for _, member := range members {
+		deletedMember := newDeletedMemberResponse(member, server.config.MemberTrashRetention)
+		if memberTags, ok := tags[member.ID]; ok {
+			deletedMember.Tags = memberTags
+		}
+		data = append(data, deletedMember)
+	}
+
+	rsp := listDeletedMembersResponse{
+		Meta: listMembersResponseMeta{
+			PageID:     req.PageID,
+			PageSize:   req.PageSize,
+			PageCount:  pageCount,
+			TotalCount: totalCount,
+		},
+		Data: data,
+	}
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+type restoreMemberRequest struct {
+	ID uuid.UUID `params:"id"`
+}
+
+// @Summary      Restore member
+// @Description  Moves a deleted member back out of the trash, where its email becomes its primary email again.
+// @Description  A member whose email another member has taken over as primary email cannot be restored.
+// @Tags         members
+// @Param        id path string true "Member ID"
+// @Success      200 {object} memberResponse
+// @Failure      400 {object} errorResponse
+// @Failure      403 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /members/{id}/restore [post]
+func (server *Server) restoreMember(c *fiber.Ctx) error {
+	req := new(restoreMemberRequest)
+	if err := c.ParamsParser(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	member, err := server.store.RestoreMemberTx(c.Context(), req.ID, auditMeta(c))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return c.Status(fiber.StatusNotFound).JSON(newErrorResponse(err))
+		}
+		return c.Status(memberWriteStatus(err)).JSON(newErrorResponse(err))
+	}
+
+	rsp, err := server.newMemberResponseWithTags(c.Context(), member)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+type restoreMembersRequest struct {
+	IDs string `query:"ids" json:"ids" validate:"required"`
+}
+
+// @Summary      Restore members
+// @Description  Moves deleted members back out of the trash. IDs of members that are not in the trash are ignored.
+// @Description  None of the members is restored when the email of one of them is now the primary email of another member.
+// @Tags         members
+// @Param        query query restoreMembersRequest true "query"
+// @Success      204 {object} nil
+// @Failure      400 {object} errorResponse
+// @Failure      403 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /members/restore [post]
+func (server *Server) restoreMembers(c *fiber.Ctx) error {
+	req := new(restoreMembersRequest)
+	if err := c.QueryParser(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	validate := newValidator()
+	if err := validate.Struct(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	IDs, err := memberIDsFromCommaSeparatedString(req.IDs)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	_, err = server.store.RestoreMembersTx(c.Context(), IDs, auditMeta(c))
+	if err != nil {
+		return c.Status(memberWriteStatus(err)).JSON(newErrorResponse(err))
+	}
+
+	return c.Status(fiber.StatusNoContent).JSON(nil)
+}
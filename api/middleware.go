@@ -10,7 +10,9 @@ import (
 )
 
 const (
-	sessionTokenKey = "session_token"
+	sessionTokenKey  = "session_token"
+	sessionUserIDKey = "session_user_id"
+	requestIDKey     = "request_id"
 )
 
 func authMiddleware(server *Server) fiber.Handler {
@@ -45,6 +47,7 @@ func authMiddleware(server *Server) fiber.Handler {
 		}
 
 		c.Locals(sessionTokenKey, parsedSessionToken)
+		c.Locals(sessionUserIDKey, session.UserID)
 		return c.Next()
 	}
 }
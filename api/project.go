@@ -0,0 +1,227 @@
+package api
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	db "github.com/ot07/coworker-backend/db/sqlc"
+)
+
+// projectWriteStatus tells which status to respond with for an error writing a project.
+// A project cannot be deleted once time is tracked against it.
+func projectWriteStatus(err error) int {
+	if err == sql.ErrNoRows {
+		return fiber.StatusNotFound
+	}
+	if pqErr, ok := err.(*pq.Error); ok {
+		switch pqErr.Code.Name() {
+		case "unique_violation", "foreign_key_violation":
+			return fiber.StatusForbidden
+		}
+	}
+	return fiber.StatusInternalServerError
+}
+
+type projectResponse struct {
+	ID          uuid.UUID `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	// Billable is what the time entries of the project are by default.
+	Billable bool `json:"billable"`
+	// ArchivedAt is when the project was archived. No time can be tracked against an archived project.
+	ArchivedAt db.NullTime `json:"archived_at" swaggertype:"string" format:"date-time"`
+	CreatedAt  time.Time   `json:"created_at"`
+}
+
+func newProjectResponse(project db.Project) projectResponse {
+	return projectResponse{
+		ID:          project.ID,
+		Name:        project.Name,
+		Description: project.Description,
+		Billable:    project.Billable,
+		ArchivedAt:  db.NullTime{NullTime: project.ArchivedAt},
+		CreatedAt:   project.CreatedAt,
+	}
+}
+
+type createProjectRequest struct {
+	Name        string `json:"name" validate:"required,max=100" example:"Website redesign"`
+	Description string `json:"description" validate:"max=1000"`
+	// Billable is what the time entries of the project are by default, true unless given.
+	Billable *bool `json:"billable"`
+}
+
+// @Summary      Create project
+// @Description  Time is tracked against projects.
+// @Tags         time-tracking
+// @Param        body body createProjectRequest true "Project object"
+// @Success      200 {object} projectResponse
+// @Failure      400 {object} errorResponse
+// @Failure      403 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /projects [post]
+func (server *Server) createProject(c *fiber.Ctx) error {
+	req := new(createProjectRequest)
+	if err := c.BodyParser(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	validate := newValidator()
+	if err := validate.Struct(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	arg := db.CreateProjectParams{
+		Name:        req.Name,
+		Description: req.Description,
+		Billable:    req.Billable == nil || *req.Billable,
+	}
+
+	project, err := server.store.CreateProject(c.Context(), arg)
+	if err != nil {
+		return c.Status(projectWriteStatus(err)).JSON(newErrorResponse(err))
+	}
+
+	rsp := newProjectResponse(project)
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+type listProjectsRequestQuery struct {
+	// IncludeArchived lists the archived projects too.
+	IncludeArchived bool `query:"include_archived" json:"include_archived"`
+}
+
+// @Summary      List projects
+// @Description  Lists the projects by name, leaving out the archived ones unless asked for.
+// @Tags         time-tracking
+// @Param        query query listProjectsRequestQuery true "query"
+// @Success      200 {array} projectResponse
+// @Failure      400 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /projects [get]
+func (server *Server) listProjects(c *fiber.Ctx) error {
+	query := new(listProjectsRequestQuery)
+	if err := c.QueryParser(query); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	projects, err := server.store.ListProjects(c.Context(), query.IncludeArchived)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	rsp := make([]projectResponse, 0, len(projects))
+	for _, project := range projects {
+		rsp = append(rsp, newProjectResponse(project))
+	}
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+type projectRequestParams struct {
+	ID uuid.UUID `params:"id"`
+}
+
+// @Summary      Get project
+// @Tags         time-tracking
+// @Param        id path string true "Project ID"
+// @Success      200 {object} projectResponse
+// @Failure      400 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /projects/{id} [get]
+func (server *Server) getProject(c *fiber.Ctx) error {
+	params := new(projectRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	project, err := server.store.GetProject(c.Context(), params.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return c.Status(fiber.StatusNotFound).JSON(newErrorResponse(err))
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	rsp := newProjectResponse(project)
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+type updateProjectRequest struct {
+	Name        string `json:"name" validate:"required,max=100" example:"Website redesign"`
+	Description string `json:"description" validate:"max=1000"`
+	// Billable is what the new time entries of the project are by default. Existing entries are left as they are.
+	Billable bool `json:"billable"`
+	// Archived archives the project, or brings it back.
+	Archived bool `json:"archived"`
+}
+
+// @Summary      Update project
+// @Description  Replaces the project. An archived project is kept for the reports, but no time can be tracked against it.
+// @Tags         time-tracking
+// @Param        id   path string               true "Project ID"
+// @Param        body body updateProjectRequest true "Project object"
+// @Success      200 {object} projectResponse
+// @Failure      400 {object} errorResponse
+// @Failure      403 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /projects/{id} [put]
+func (server *Server) updateProject(c *fiber.Ctx) error {
+	params := new(projectRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	req := new(updateProjectRequest)
+	if err := c.BodyParser(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	validate := newValidator()
+	if err := validate.Struct(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	arg := db.UpdateProjectParams{
+		Name:        req.Name,
+		Description: req.Description,
+		Billable:    req.Billable,
+		Archived:    req.Archived,
+		ID:          params.ID,
+	}
+
+	project, err := server.store.UpdateProject(c.Context(), arg)
+	if err != nil {
+		return c.Status(projectWriteStatus(err)).JSON(newErrorResponse(err))
+	}
+
+	rsp := newProjectResponse(project)
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+// @Summary      Delete project
+// @Description  A project cannot be deleted once time is tracked against it. It can be archived instead.
+// @Tags         time-tracking
+// @Param        id path string true "Project ID"
+// @Success      204
+// @Failure      400 {object} errorResponse
+// @Failure      403 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /projects/{id} [delete]
+func (server *Server) deleteProject(c *fiber.Ctx) error {
+	params := new(projectRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	if _, err := server.store.DeleteProject(c.Context(), params.ID); err != nil {
+		return c.Status(projectWriteStatus(err)).JSON(newErrorResponse(err))
+	}
+
+	return c.Status(fiber.StatusNoContent).JSON(nil)
+}
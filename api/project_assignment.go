@@ -0,0 +1,284 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	db "github.com/ot07/coworker-backend/db/sqlc"
+)
+
+var errAssignmentDates = errors.New("an assignment must end on or after the day it starts")
+
+// projectAssignmentWriteStatus tells which status to respond with for an error writing a project assignment.
+func projectAssignmentWriteStatus(err error) int {
+	switch {
+	case err == sql.ErrNoRows:
+		return fiber.StatusNotFound
+	case errors.Is(err, db.ErrProjectArchived), errors.Is(err, db.ErrAllocationExceeded):
+		return fiber.StatusForbidden
+	}
+	if pqErr, ok := err.(*pq.Error); ok && pqErr.Code.Name() == "check_violation" {
+		return fiber.StatusBadRequest
+	}
+	return fiber.StatusInternalServerError
+}
+
+type projectAssignmentResponse struct {
+	ID        uuid.UUID `json:"id"`
+	ProjectID uuid.UUID `json:"project_id"`
+	MemberID  uuid.UUID `json:"member_id"`
+	Role      string    `json:"role"`
+	// Allocation is the share of the working time of the member given to the project on every day of the assignment, in percent.
+	Allocation int32 `json:"allocation"`
+	// StartDate and EndDate are the first and the last day of the assignment.
+	StartDate string    `json:"start_date" format:"date"`
+	EndDate   string    `json:"end_date" format:"date"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func newProjectAssignmentResponse(assignment db.ProjectAssignment) projectAssignmentResponse {
+	return projectAssignmentResponse{
+		ID:         assignment.ID,
+		ProjectID:  assignment.ProjectID,
+		MemberID:   assignment.MemberID,
+		Role:       assignment.Role,
+		Allocation: assignment.Allocation,
+		StartDate:  assignment.StartDate.Format(db.DateLayout),
+		EndDate:    assignment.EndDate.Format(db.DateLayout),
+		CreatedAt:  assignment.CreatedAt,
+	}
+}
+
+type projectAssignmentRequest struct {
+	Role       string `json:"role" validate:"max=100" example:"Backend developer"`
+	Allocation int32  `json:"allocation" validate:"required,min=1,max=100" example:"50"`
+	StartDate  string `json:"start_date" validate:"required,datetime=2006-01-02" format:"date"`
+	EndDate    string `json:"end_date" validate:"required,datetime=2006-01-02" format:"date"`
+}
+
+// dates returns the first and the last day of the assignment, checking that it does not end before it starts.
+func (req *projectAssignmentRequest) dates() (startDate, endDate time.Time, err error) {
+	if startDate, err = time.Parse(db.DateLayout, req.StartDate); err != nil {
+		return startDate, endDate, err
+	}
+	if endDate, err = time.Parse(db.DateLayout, req.EndDate); err != nil {
+		return startDate, endDate, err
+	}
+	if endDate.Before(startDate) {
+		return startDate, endDate, errAssignmentDates
+	}
+	return startDate, endDate, nil
+}
+
+type createProjectAssignmentRequest struct {
+	ProjectID uuid.UUID `json:"project_id" validate:"required"`
+	MemberID  uuid.UUID `json:"member_id" validate:"required"`
+	projectAssignmentRequest
+}
+
+// @Summary      Create project assignment
+// @Description  Assigns a member to a project for a range of days, with a share of their working time.
+// @Description  The assignments of a member cannot allocate them beyond the configured limit on any day,
+// @Description  and no member can be assigned to an archived project.
+// @Tags         capacity
+// @Param        body body createProjectAssignmentRequest true "Project assignment object"
+// @Success      200 {object} projectAssignmentResponse
+// @Failure      400 {object} errorResponse
+// @Failure      403 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /project-assignments [post]
+func (server *Server) createProjectAssignment(c *fiber.Ctx) error {
+	req := new(createProjectAssignmentRequest)
+	if err := c.BodyParser(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	validate := newValidator()
+	if err := validate.Struct(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	startDate, endDate, err := req.dates()
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	arg := db.CreateProjectAssignmentParams{
+		ProjectID:  req.ProjectID,
+		MemberID:   req.MemberID,
+		Role:       req.Role,
+		Allocation: req.Allocation,
+		StartDate:  startDate,
+		EndDate:    endDate,
+	}
+
+	assignment, err := server.store.CreateProjectAssignmentTx(c.Context(), arg, int32(server.config.ProjectAllocationLimit))
+	if err != nil {
+		return c.Status(projectAssignmentWriteStatus(err)).JSON(newErrorResponse(err))
+	}
+
+	rsp := newProjectAssignmentResponse(assignment)
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+type listProjectAssignmentsRequestQuery struct {
+	ProjectID string `query:"project_id" json:"project_id" validate:"omitempty,uuid"`
+	MemberID  string `query:"member_id" json:"member_id" validate:"omitempty,uuid"`
+	// From and To list the assignments running on any day between them.
+	From string `query:"from" json:"from" validate:"omitempty,datetime=2006-01-02" format:"date"`
+	To   string `query:"to" json:"to" validate:"omitempty,datetime=2006-01-02" format:"date"`
+}
+
+// @Summary      List project assignments
+// @Description  Lists the assignments, by the day they start, optionally those of a project, of a member or running within a range of days.
+// @Tags         capacity
+// @Param        query query listProjectAssignmentsRequestQuery true "query"
+// @Success      200 {array} projectAssignmentResponse
+// @Failure      400 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /project-assignments [get]
+func (server *Server) listProjectAssignments(c *fiber.Ctx) error {
+	query := new(listProjectAssignmentsRequestQuery)
+	if err := c.QueryParser(query); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	validate := newValidator()
+	if err := validate.Struct(query); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	var arg db.ListProjectAssignmentsParams
+	var err error
+	if arg.ProjectID, err = parseNullUUID(query.ProjectID); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+	if arg.MemberID, err = parseNullUUID(query.MemberID); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+	if arg.FromDate, err = parseNullDate(query.From); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+	if arg.ToDate, err = parseNullDate(query.To); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	assignments, err := server.store.ListProjectAssignments(c.Context(), arg)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	rsp := make([]projectAssignmentResponse, 0, len(assignments))
+	for _, assignment := range assignments {
+		rsp = append(rsp, newProjectAssignmentResponse(assignment))
+	}
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+type projectAssignmentRequestParams struct {
+	ID uuid.UUID `params:"id"`
+}
+
+// @Summary      Get project assignment
+// @Tags         capacity
+// @Param        id path string true "Project assignment ID"
+// @Success      200 {object} projectAssignmentResponse
+// @Failure      400 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /project-assignments/{id} [get]
+func (server *Server) getProjectAssignment(c *fiber.Ctx) error {
+	params := new(projectAssignmentRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	assignment, err := server.store.GetProjectAssignment(c.Context(), params.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return c.Status(fiber.StatusNotFound).JSON(newErrorResponse(err))
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	rsp := newProjectAssignmentResponse(assignment)
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+// @Summary      Update project assignment
+// @Description  Changes the role, the allocation or the days of the assignment, checking the allocation limit as it is created.
+// @Tags         capacity
+// @Param        id   path string                   true "Project assignment ID"
+// @Param        body body projectAssignmentRequest true "Project assignment object"
+// @Success      200 {object} projectAssignmentResponse
+// @Failure      400 {object} errorResponse
+// @Failure      403 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /project-assignments/{id} [put]
+func (server *Server) updateProjectAssignment(c *fiber.Ctx) error {
+	params := new(projectAssignmentRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	req := new(projectAssignmentRequest)
+	if err := c.BodyParser(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	validate := newValidator()
+	if err := validate.Struct(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	startDate, endDate, err := req.dates()
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	arg := db.UpdateProjectAssignmentParams{
+		Role:       req.Role,
+		Allocation: req.Allocation,
+		StartDate:  startDate,
+		EndDate:    endDate,
+		ID:         params.ID,
+	}
+
+	assignment, err := server.store.UpdateProjectAssignmentTx(c.Context(), arg, int32(server.config.ProjectAllocationLimit))
+	if err != nil {
+		return c.Status(projectAssignmentWriteStatus(err)).JSON(newErrorResponse(err))
+	}
+
+	rsp := newProjectAssignmentResponse(assignment)
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+// @Summary      Delete project assignment
+// @Tags         capacity
+// @Param        id path string true "Project assignment ID"
+// @Success      204
+// @Failure      400 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /project-assignments/{id} [delete]
+func (server *Server) deleteProjectAssignment(c *fiber.Ctx) error {
+	params := new(projectAssignmentRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	if _, err := server.store.DeleteProjectAssignment(c.Context(), params.ID); err != nil {
+		if err == sql.ErrNoRows {
+			return c.Status(fiber.StatusNotFound).JSON(newErrorResponse(err))
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	return c.Status(fiber.StatusNoContent).JSON(nil)
+}
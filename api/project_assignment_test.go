@@ -0,0 +1,493 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	mockdb "github.com/ot07/coworker-backend/db/mock"
+	db "github.com/ot07/coworker-backend/db/sqlc"
+	"github.com/ot07/coworker-backend/util"
+	"github.com/stretchr/testify/require"
+)
+
+func randomProjectAssignment(projectID, memberID uuid.UUID) db.ProjectAssignment {
+	return db.ProjectAssignment{
+		ID:         util.RandomUUID(),
+		ProjectID:  projectID,
+		MemberID:   memberID,
+		Role:       "Backend developer",
+		Allocation: 50,
+		StartDate:  time.Date(2023, 8, 7, 0, 0, 0, 0, time.UTC),
+		EndDate:    time.Date(2023, 9, 29, 0, 0, 0, 0, time.UTC),
+		CreatedAt:  time.Now().UTC().Truncate(time.Second),
+	}
+}
+
+func TestCreateProjectAssignmentAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	assignment := randomProjectAssignment(util.RandomUUID(), util.RandomUUID())
+
+	testCases := []struct {
+		name          string
+		body          fiber.Map
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name: "OK",
+			body: fiber.Map{
+				"project_id": assignment.ProjectID,
+				"member_id":  assignment.MemberID,
+				"role":       assignment.Role,
+				"allocation": assignment.Allocation,
+				"start_date": "2023-08-07",
+				"end_date":   "2023-09-29",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.CreateProjectAssignmentParams{
+					ProjectID:  assignment.ProjectID,
+					MemberID:   assignment.MemberID,
+					Role:       assignment.Role,
+					Allocation: assignment.Allocation,
+					StartDate:  assignment.StartDate,
+					EndDate:    assignment.EndDate,
+				}
+				store.EXPECT().
+					CreateProjectAssignmentTx(gomock.Any(), gomock.Eq(arg), gomock.Eq(int32(100))).
+					Times(1).
+					Return(assignment, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+				requireBodyMatchProjectAssignment(t, response.Body, assignment)
+			},
+		},
+		{
+			name: "AllocationExceeded",
+			body: fiber.Map{
+				"project_id": assignment.ProjectID,
+				"member_id":  assignment.MemberID,
+				"allocation": 80,
+				"start_date": "2023-08-07",
+				"end_date":   "2023-09-29",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateProjectAssignmentTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ProjectAssignment{}, fmt.Errorf("%w: 130%% on 2023-08-07, at most 100%%", db.ErrAllocationExceeded))
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusForbidden, response.StatusCode)
+			},
+		},
+		{
+			name: "ProjectArchived",
+			body: fiber.Map{
+				"project_id": assignment.ProjectID,
+				"member_id":  assignment.MemberID,
+				"allocation": 20,
+				"start_date": "2023-08-07",
+				"end_date":   "2023-09-29",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateProjectAssignmentTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ProjectAssignment{}, db.ErrProjectArchived)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusForbidden, response.StatusCode)
+			},
+		},
+		{
+			name: "MemberNotFound",
+			body: fiber.Map{
+				"project_id": assignment.ProjectID,
+				"member_id":  assignment.MemberID,
+				"allocation": 20,
+				"start_date": "2023-08-07",
+				"end_date":   "2023-09-29",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateProjectAssignmentTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ProjectAssignment{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusNotFound, response.StatusCode)
+			},
+		},
+		{
+			name: "AllocationOverFull",
+			body: fiber.Map{
+				"project_id": assignment.ProjectID,
+				"member_id":  assignment.MemberID,
+				"allocation": 120,
+				"start_date": "2023-08-07",
+				"end_date":   "2023-09-29",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateProjectAssignmentTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "EndBeforeStart",
+			body: fiber.Map{
+				"project_id": assignment.ProjectID,
+				"member_id":  assignment.MemberID,
+				"allocation": 20,
+				"start_date": "2023-09-29",
+				"end_date":   "2023-08-07",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateProjectAssignmentTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			buildValidSessionStubs(store, session)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/api/v1/project-assignments", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			request.Header.Set("Content-Type", "application/json")
+
+			addSessionTokenInCookie(request, session.SessionToken.String())
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func TestListProjectAssignmentsAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	projectID := util.RandomUUID()
+	assignments := []db.ProjectAssignment{
+		randomProjectAssignment(projectID, util.RandomUUID()),
+		randomProjectAssignment(projectID, util.RandomUUID()),
+	}
+
+	testCases := []struct {
+		name          string
+		query         string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name:  "OK",
+			query: "project_id=" + projectID.String() + "&from=2023-08-01",
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.ListProjectAssignmentsParams{
+					ProjectID: uuid.NullUUID{UUID: projectID, Valid: true},
+					FromDate:  sql.NullTime{Time: time.Date(2023, 8, 1, 0, 0, 0, 0, time.UTC), Valid: true},
+				}
+				store.EXPECT().
+					ListProjectAssignments(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(assignments, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+
+				data, err := io.ReadAll(response.Body)
+				require.NoError(t, err)
+
+				var got []projectAssignmentResponse
+				err = json.Unmarshal(data, &got)
+				require.NoError(t, err)
+				require.Len(t, got, 2)
+				require.Equal(t, assignments[0].ID, got[0].ID)
+				require.Equal(t, "2023-08-07", got[0].StartDate)
+			},
+		},
+		{
+			name:  "InvalidMemberID",
+			query: "member_id=invalid",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListProjectAssignments(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			buildValidSessionStubs(store, session)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			request, err := http.NewRequest(http.MethodGet, "/api/v1/project-assignments?"+tc.query, nil)
+			require.NoError(t, err)
+
+			addSessionTokenInCookie(request, session.SessionToken.String())
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func TestUpdateProjectAssignmentAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	assignment := randomProjectAssignment(util.RandomUUID(), util.RandomUUID())
+
+	testCases := []struct {
+		name          string
+		body          fiber.Map
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name: "OK",
+			body: fiber.Map{
+				"role":       assignment.Role,
+				"allocation": assignment.Allocation,
+				"start_date": "2023-08-07",
+				"end_date":   "2023-09-29",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.UpdateProjectAssignmentParams{
+					Role:       assignment.Role,
+					Allocation: assignment.Allocation,
+					StartDate:  assignment.StartDate,
+					EndDate:    assignment.EndDate,
+					ID:         assignment.ID,
+				}
+				store.EXPECT().
+					UpdateProjectAssignmentTx(gomock.Any(), gomock.Eq(arg), gomock.Eq(int32(100))).
+					Times(1).
+					Return(assignment, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+				requireBodyMatchProjectAssignment(t, response.Body, assignment)
+			},
+		},
+		{
+			name: "AllocationExceeded",
+			body: fiber.Map{
+				"allocation": 90,
+				"start_date": "2023-08-07",
+				"end_date":   "2023-09-29",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UpdateProjectAssignmentTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ProjectAssignment{}, db.ErrAllocationExceeded)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusForbidden, response.StatusCode)
+			},
+		},
+		{
+			name: "NotFound",
+			body: fiber.Map{
+				"allocation": 20,
+				"start_date": "2023-08-07",
+				"end_date":   "2023-09-29",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UpdateProjectAssignmentTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ProjectAssignment{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusNotFound, response.StatusCode)
+			},
+		},
+		{
+			name: "MissingAllocation",
+			body: fiber.Map{
+				"start_date": "2023-08-07",
+				"end_date":   "2023-09-29",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UpdateProjectAssignmentTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			buildValidSessionStubs(store, session)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/api/v1/project-assignments/%s", assignment.ID)
+			request, err := http.NewRequest(http.MethodPut, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			request.Header.Set("Content-Type", "application/json")
+
+			addSessionTokenInCookie(request, session.SessionToken.String())
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func TestDeleteProjectAssignmentAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	assignment := randomProjectAssignment(util.RandomUUID(), util.RandomUUID())
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					DeleteProjectAssignment(gomock.Any(), gomock.Eq(assignment.ID)).
+					Times(1).
+					Return(assignment, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusNoContent, response.StatusCode)
+			},
+		},
+		{
+			name: "NotFound",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					DeleteProjectAssignment(gomock.Any(), gomock.Eq(assignment.ID)).
+					Times(1).
+					Return(db.ProjectAssignment{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusNotFound, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			buildValidSessionStubs(store, session)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			url := fmt.Sprintf("/api/v1/project-assignments/%s", assignment.ID)
+			request, err := http.NewRequest(http.MethodDelete, url, nil)
+			require.NoError(t, err)
+
+			addSessionTokenInCookie(request, session.SessionToken.String())
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func requireBodyMatchProjectAssignment(t *testing.T, body io.ReadCloser, assignment db.ProjectAssignment) {
+	data, err := io.ReadAll(body)
+	require.NoError(t, err)
+
+	var got projectAssignmentResponse
+	err = json.Unmarshal(data, &got)
+	require.NoError(t, err)
+
+	require.Equal(t, assignment.ID, got.ID)
+	require.Equal(t, assignment.ProjectID, got.ProjectID)
+	require.Equal(t, assignment.MemberID, got.MemberID)
+	require.Equal(t, assignment.Role, got.Role)
+	require.Equal(t, assignment.Allocation, got.Allocation)
+	require.Equal(t, assignment.StartDate.Format(db.DateLayout), got.StartDate)
+	require.Equal(t, assignment.EndDate.Format(db.DateLayout), got.EndDate)
+	require.True(t, assignment.CreatedAt.Equal(got.CreatedAt))
+
+	err = body.Close()
+	require.NoError(t, err)
+}
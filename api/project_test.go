@@ -0,0 +1,333 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/lib/pq"
+	mockdb "github.com/ot07/coworker-backend/db/mock"
+	db "github.com/ot07/coworker-backend/db/sqlc"
+	"github.com/ot07/coworker-backend/util"
+	"github.com/stretchr/testify/require"
+)
+
+func randomProject() db.Project {
+	return db.Project{
+		ID:          util.RandomUUID(),
+		Name:        util.RandomName(),
+		Description: util.RandomString(20),
+		Billable:    true,
+		CreatedAt:   time.Now().UTC().Truncate(time.Second),
+	}
+}
+
+func TestCreateProjectAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	project := randomProject()
+
+	testCases := []struct {
+		name          string
+		body          fiber.Map
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name: "OK",
+			body: fiber.Map{
+				"name":        project.Name,
+				"description": project.Description,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.CreateProjectParams{
+					Name:        project.Name,
+					Description: project.Description,
+					Billable:    true,
+				}
+				store.EXPECT().
+					CreateProject(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(project, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+				requireBodyMatchProject(t, response.Body, project)
+			},
+		},
+		{
+			name: "NotBillable",
+			body: fiber.Map{
+				"name":     project.Name,
+				"billable": false,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.CreateProjectParams{
+					Name: project.Name,
+				}
+				store.EXPECT().
+					CreateProject(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.Project{ID: project.ID, Name: project.Name}, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+			},
+		},
+		{
+			name: "MissingName",
+			body: fiber.Map{
+				"description": project.Description,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateProject(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "DuplicateName",
+			body: fiber.Map{
+				"name": project.Name,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateProject(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Project{}, &pq.Error{Code: "23505"})
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusForbidden, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			buildValidSessionStubs(store, session)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/api/v1/projects", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			request.Header.Set("Content-Type", "application/json")
+
+			addSessionTokenInCookie(request, session.SessionToken.String())
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func TestUpdateProjectAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	project := randomProject()
+	archived := project
+	archived.ArchivedAt = sql.NullTime{Time: time.Now().UTC().Truncate(time.Second), Valid: true}
+
+	testCases := []struct {
+		name          string
+		body          fiber.Map
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name: "Archive",
+			body: fiber.Map{
+				"name":        project.Name,
+				"description": project.Description,
+				"billable":    true,
+				"archived":    true,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.UpdateProjectParams{
+					Name:        project.Name,
+					Description: project.Description,
+					Billable:    true,
+					Archived:    true,
+					ID:          project.ID,
+				}
+				store.EXPECT().
+					UpdateProject(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(archived, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+				requireBodyMatchProject(t, response.Body, archived)
+			},
+		},
+		{
+			name: "NotFound",
+			body: fiber.Map{
+				"name": project.Name,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UpdateProject(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Project{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusNotFound, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			buildValidSessionStubs(store, session)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/api/v1/projects/%s", project.ID)
+			request, err := http.NewRequest(http.MethodPut, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			request.Header.Set("Content-Type", "application/json")
+
+			addSessionTokenInCookie(request, session.SessionToken.String())
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func TestDeleteProjectAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	project := randomProject()
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					DeleteProject(gomock.Any(), gomock.Eq(project.ID)).
+					Times(1).
+					Return(project, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusNoContent, response.StatusCode)
+			},
+		},
+		{
+			name: "TimeTracked",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					DeleteProject(gomock.Any(), gomock.Eq(project.ID)).
+					Times(1).
+					Return(db.Project{}, &pq.Error{Code: "23503"})
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusForbidden, response.StatusCode)
+			},
+		},
+		{
+			name: "NotFound",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					DeleteProject(gomock.Any(), gomock.Eq(project.ID)).
+					Times(1).
+					Return(db.Project{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusNotFound, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			buildValidSessionStubs(store, session)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			url := fmt.Sprintf("/api/v1/projects/%s", project.ID)
+			request, err := http.NewRequest(http.MethodDelete, url, nil)
+			require.NoError(t, err)
+
+			addSessionTokenInCookie(request, session.SessionToken.String())
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func requireBodyMatchProject(t *testing.T, body io.ReadCloser, project db.Project) {
+	data, err := io.ReadAll(body)
+	require.NoError(t, err)
+
+	var got projectResponse
+	err = json.Unmarshal(data, &got)
+	require.NoError(t, err)
+
+	require.Equal(t, project.ID, got.ID)
+	require.Equal(t, project.Name, got.Name)
+	require.Equal(t, project.Description, got.Description)
+	require.Equal(t, project.Billable, got.Billable)
+	require.Equal(t, project.ArchivedAt.Valid, got.ArchivedAt.Valid)
+	require.True(t, project.ArchivedAt.Time.Equal(got.ArchivedAt.Time))
+	require.True(t, project.CreatedAt.Equal(got.CreatedAt))
+
+	err = body.Close()
+	require.NoError(t, err)
+}
@@ -0,0 +1,249 @@
+package api
+
+import (
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	db "github.com/ot07/coworker-backend/db/sqlc"
+)
+
+type roomResponse struct {
+	ID         uuid.UUID `json:"id"`
+	LocationID uuid.UUID `json:"location_id"`
+	Name       string    `json:"name"`
+	Capacity   int32     `json:"capacity"`
+	Equipment  []string  `json:"equipment"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+func newRoomResponse(room db.Room) roomResponse {
+	equipment := room.Equipment
+	if equipment == nil {
+		equipment = []string{}
+	}
+	return roomResponse{
+		ID:         room.ID,
+		LocationID: room.LocationID,
+		Name:       room.Name,
+		Capacity:   room.Capacity,
+		Equipment:  equipment,
+		CreatedAt:  room.CreatedAt,
+	}
+}
+
+type roomRequestBody struct {
+	Name     string `json:"name" validate:"required,max=100" example:"Everest"`
+	Capacity int32  `json:"capacity" validate:"required,min=1,max=1000" example:"8"`
+	// Equipment lists what the room is equipped with, such as a projector or a whiteboard.
+	Equipment []string `json:"equipment" validate:"max=20,unique,dive,required,max=50" example:"projector,whiteboard"`
+}
+
+// equipment returns the equipment of the request, never nil since the column is not nullable.
+func (body *roomRequestBody) equipment() []string {
+	if body.Equipment == nil {
+		return []string{}
+	}
+	return body.Equipment
+}
+
+type createRoomRequest struct {
+	LocationID uuid.UUID `json:"location_id" validate:"required"`
+	roomRequestBody
+}
+
+// @Summary      Create room
+// @Description  A room of a location that members can reserve. Its name is unique within the location.
+// @Tags         rooms
+// @Param        body body createRoomRequest true "Room object"
+// @Success      200 {object} roomResponse
+// @Failure      400 {object} errorResponse
+// @Failure      403 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /rooms [post]
+func (server *Server) createRoom(c *fiber.Ctx) error {
+	req := new(createRoomRequest)
+	if err := c.BodyParser(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	validate := newValidator()
+	if err := validate.Struct(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	arg := db.CreateRoomParams{
+		LocationID: req.LocationID,
+		Name:       req.Name,
+		Capacity:   req.Capacity,
+		Equipment:  req.equipment(),
+	}
+
+	room, err := server.store.CreateRoom(c.Context(), arg)
+	if err != nil {
+		// The location is part of the request body here rather than of the URL.
+		status := locationWriteStatus(err)
+		if status == fiber.StatusNotFound {
+			status = fiber.StatusBadRequest
+		}
+		return c.Status(status).JSON(newErrorResponse(err))
+	}
+
+	rsp := newRoomResponse(room)
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+type listRoomsRequest struct {
+	LocationID  string `query:"location_id" json:"location_id" validate:"omitempty,uuid"`
+	MinCapacity int32  `query:"min_capacity" json:"min_capacity" validate:"omitempty,min=1"`
+	// Equipment is a comma separated list of the equipment the rooms must all have.
+	Equipment string `query:"equipment" json:"equipment" example:"projector,whiteboard"`
+}
+
+// @Summary      List rooms
+// @Description  Lists the rooms, optionally only those of a location, seating at least min_capacity and having all the equipment listed.
+// @Tags         rooms
+// @Param        query query listRoomsRequest true "query"
+// @Success      200 {array} roomResponse
+// @Failure      400 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /rooms [get]
+func (server *Server) listRooms(c *fiber.Ctx) error {
+	req := new(listRoomsRequest)
+	if err := c.QueryParser(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	validate := newValidator()
+	if err := validate.Struct(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	var locationID uuid.NullUUID
+	if len(req.LocationID) > 0 {
+		id, err := uuid.Parse(req.LocationID)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+		}
+		locationID = uuid.NullUUID{UUID: id, Valid: true}
+	}
+
+	equipment := []string{}
+	for _, field := range strings.Split(req.Equipment, ",") {
+		if item := strings.TrimSpace(field); len(item) > 0 {
+			equipment = append(equipment, item)
+		}
+	}
+
+	rooms, err := server.store.ListRooms(c.Context(), db.ListRoomsParams{
+		LocationID:  locationID,
+		MinCapacity: req.MinCapacity,
+		Equipment:   equipment,
+	})
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	rsp := make([]roomResponse, 0, len(rooms))
+	for _, room := range rooms {
+		rsp = append(rsp, newRoomResponse(room))
+	}
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+type roomRequestParams struct {
+	ID uuid.UUID `params:"id"`
+}
+
+// @Summary      Get room
+// @Tags         rooms
+// @Param        id path string true "Room ID"
+// @Success      200 {object} roomResponse
+// @Failure      400 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /rooms/{id} [get]
+func (server *Server) getRoom(c *fiber.Ctx) error {
+	params := new(roomRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	room, err := server.store.GetRoom(c.Context(), params.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return c.Status(fiber.StatusNotFound).JSON(newErrorResponse(err))
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	rsp := newRoomResponse(room)
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+// @Summary      Update room
+// @Description  Replaces the name, capacity and equipment of the room. Its reservations are kept.
+// @Tags         rooms
+// @Param        id   path string          true "Room ID"
+// @Param        body body roomRequestBody true "Room object"
+// @Success      200 {object} roomResponse
+// @Failure      400 {object} errorResponse
+// @Failure      403 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /rooms/{id} [put]
+func (server *Server) updateRoom(c *fiber.Ctx) error {
+	params := new(roomRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	body := new(roomRequestBody)
+	if err := c.BodyParser(body); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	validate := newValidator()
+	if err := validate.Struct(body); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	arg := db.UpdateRoomParams{
+		ID:        params.ID,
+		Name:      body.Name,
+		Capacity:  body.Capacity,
+		Equipment: body.equipment(),
+	}
+
+	room, err := server.store.UpdateRoom(c.Context(), arg)
+	if err != nil {
+		return c.Status(locationWriteStatus(err)).JSON(newErrorResponse(err))
+	}
+
+	rsp := newRoomResponse(room)
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+// @Summary      Delete room
+// @Description  Deletes the room together with its reservations and calendar feed.
+// @Tags         rooms
+// @Param        id path string true "Room ID"
+// @Success      204 {object} nil
+// @Failure      400 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /rooms/{id} [delete]
+func (server *Server) deleteRoom(c *fiber.Ctx) error {
+	params := new(roomRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	if _, err := server.store.DeleteRoom(c.Context(), params.ID); err != nil {
+		return c.Status(locationWriteStatus(err)).JSON(newErrorResponse(err))
+	}
+
+	return c.Status(fiber.StatusNoContent).JSON(nil)
+}
@@ -0,0 +1,313 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/ot07/coworker-backend/calendar"
+	db "github.com/ot07/coworker-backend/db/sqlc"
+)
+
+const (
+	// maxRoomReservationDuration is how long a single occurrence of a reservation can last.
+	maxRoomReservationDuration = 24 * time.Hour
+	// defaultRoomReservationsRange and maxRoomReservationsRange bound the range reservations are listed for.
+	defaultRoomReservationsRange = 7 * 24 * time.Hour
+	maxRoomReservationsRange     = 92 * 24 * time.Hour
+)
+
+var errRoomReservationTooLong = fmt.Errorf("a reservation cannot last longer than %s", maxRoomReservationDuration)
+
+// roomReservationWriteStatus tells which status to respond with for an error writing a room reservation.
+// A reservation overlapping another one is refused like other conflicting writes.
+func roomReservationWriteStatus(err error) int {
+	switch {
+	case err == sql.ErrNoRows:
+		return fiber.StatusNotFound
+	case errors.Is(err, calendar.ErrInvalidRule), err == db.ErrNotAnOccurrence:
+		return fiber.StatusBadRequest
+	case errors.Is(err, db.ErrRoomReservationConflict):
+		return fiber.StatusForbidden
+	}
+	return fiber.StatusInternalServerError
+}
+
+type roomReservationResponse struct {
+	ID       uuid.UUID `json:"id"`
+	RoomID   uuid.UUID `json:"room_id"`
+	MemberID uuid.UUID `json:"member_id"`
+	Title    string    `json:"title"`
+	// StartsAt and EndsAt are those of the first occurrence.
+	StartsAt time.Time `json:"starts_at"`
+	EndsAt   time.Time `json:"ends_at"`
+	// RRule repeats the reservation, as the RRULE of RFC 5545.
+	RRule db.NullString `json:"rrule" swaggertype:"string" example:"FREQ=WEEKLY;BYDAY=MO,WE;COUNT=10"`
+	// SeriesEndsAt is the end of the last occurrence.
+	SeriesEndsAt time.Time `json:"series_ends_at"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+func newRoomReservationResponse(reservation db.RoomReservation) roomReservationResponse {
+	return roomReservationResponse{
+		ID:           reservation.ID,
+		RoomID:       reservation.RoomID,
+		MemberID:     reservation.MemberID,
+		Title:        reservation.Title,
+		StartsAt:     reservation.StartsAt,
+		EndsAt:       reservation.EndsAt,
+		RRule:        db.NullString{NullString: reservation.Rrule},
+		SeriesEndsAt: reservation.SeriesEndsAt,
+		CreatedAt:    reservation.CreatedAt,
+	}
+}
+
+type roomReservationOccurrenceResponse struct {
+	ReservationID uuid.UUID `json:"reservation_id"`
+	RoomID        uuid.UUID `json:"room_id"`
+	MemberID      uuid.UUID `json:"member_id"`
+	Title         string    `json:"title"`
+	StartsAt      time.Time `json:"starts_at"`
+	EndsAt        time.Time `json:"ends_at"`
+	// Recurring tells whether the occurrence is one of a series.
+	Recurring bool `json:"recurring"`
+}
+
+func newRoomReservationOccurrenceResponse(occurrence db.RoomReservationOccurrence) roomReservationOccurrenceResponse {
+	return roomReservationOccurrenceResponse{
+		ReservationID: occurrence.Reservation.ID,
+		RoomID:        occurrence.Reservation.RoomID,
+		MemberID:      occurrence.Reservation.MemberID,
+		Title:         occurrence.Reservation.Title,
+		StartsAt:      occurrence.StartsAt,
+		EndsAt:        occurrence.EndsAt,
+		Recurring:     occurrence.Reservation.Rrule.Valid,
+	}
+}
+
+type createRoomReservationRequest struct {
+	RoomID   uuid.UUID `json:"room_id" validate:"required"`
+	MemberID uuid.UUID `json:"member_id" validate:"required"`
+	Title    string    `json:"title" validate:"required,max=200" example:"Weekly planning"`
+	// StartsAt and EndsAt are those of the first occurrence.
+	StartsAt time.Time `json:"starts_at" validate:"required"`
+	EndsAt   time.Time `json:"ends_at" validate:"required,gtfield=StartsAt"`
+	// RRule repeats the reservation, as the RRULE of RFC 5545. FREQ can be DAILY, WEEKLY or MONTHLY, with INTERVAL,
+	// BYDAY for a weekly rule, and either COUNT or UNTIL. Occurrences are expanded in UTC.
+	RRule string `json:"rrule" validate:"omitempty,max=200" example:"FREQ=WEEKLY;BYDAY=MO,WE;COUNT=10"`
+}
+
+// @Summary      Create room reservation
+// @Description  Reserves a room, once or repeatedly following an RRULE. Every occurrence is checked against the other reservations
+// @Description  of the room, and a reservation overlapping any of them is refused with 403.
+// @Description  A recurrence must end within two years and 500 occurrences.
+// @Tags         rooms
+// @Param        body body createRoomReservationRequest true "Reservation"
+// @Success      200 {object} roomReservationResponse
+// @Failure      400 {object} errorResponse
+// @Failure      403 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /room-reservations [post]
+func (server *Server) createRoomReservation(c *fiber.Ctx) error {
+	req := new(createRoomReservationRequest)
+	if err := c.BodyParser(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	validate := newValidator()
+	if err := validate.Struct(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+	if req.EndsAt.Sub(req.StartsAt) > maxRoomReservationDuration {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(errRoomReservationTooLong))
+	}
+
+	arg := db.CreateRoomReservationTxParams{
+		RoomID:   req.RoomID,
+		MemberID: req.MemberID,
+		Title:    req.Title,
+		StartsAt: req.StartsAt.UTC(),
+		EndsAt:   req.EndsAt.UTC(),
+		RRule:    sql.NullString{String: req.RRule, Valid: len(req.RRule) > 0},
+	}
+
+	reservation, err := server.store.CreateRoomReservationTx(c.Context(), arg)
+	if err != nil {
+		return c.Status(roomReservationWriteStatus(err)).JSON(newErrorResponse(err))
+	}
+
+	rsp := newRoomReservationResponse(reservation)
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+type roomReservationRequestParams struct {
+	ID uuid.UUID `params:"id"`
+}
+
+// @Summary      Get room reservation
+// @Tags         rooms
+// @Param        id path string true "Reservation ID"
+// @Success      200 {object} roomReservationResponse
+// @Failure      400 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /room-reservations/{id} [get]
+func (server *Server) getRoomReservation(c *fiber.Ctx) error {
+	params := new(roomReservationRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	reservation, err := server.store.GetRoomReservation(c.Context(), params.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return c.Status(fiber.StatusNotFound).JSON(newErrorResponse(err))
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	rsp := newRoomReservationResponse(reservation)
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+// @Summary      Delete room reservation
+// @Description  Deletes the reservation with all its occurrences.
+// @Tags         rooms
+// @Param        id path string true "Reservation ID"
+// @Success      204 {object} nil
+// @Failure      400 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /room-reservations/{id} [delete]
+func (server *Server) deleteRoomReservation(c *fiber.Ctx) error {
+	params := new(roomReservationRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	if _, err := server.store.DeleteRoomReservation(c.Context(), params.ID); err != nil {
+		if err == sql.ErrNoRows {
+			return c.Status(fiber.StatusNotFound).JSON(newErrorResponse(err))
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	return c.Status(fiber.StatusNoContent).JSON(nil)
+}
+
+type cancelRoomReservationOccurrenceRequest struct {
+	OccurrenceStart time.Time `json:"occurrence_start" validate:"required"`
+}
+
+// @Summary      Cancel room reservation occurrence
+// @Description  Cancels the single occurrence of the reservation starting at occurrence_start, keeping the others.
+// @Tags         rooms
+// @Param        id   path string                                 true "Reservation ID"
+// @Param        body body cancelRoomReservationOccurrenceRequest true "Occurrence"
+// @Success      204 {object} nil
+// @Failure      400 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /room-reservations/{id}/exceptions [post]
+func (server *Server) cancelRoomReservationOccurrence(c *fiber.Ctx) error {
+	params := new(roomReservationRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	body := new(cancelRoomReservationOccurrenceRequest)
+	if err := c.BodyParser(body); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	validate := newValidator()
+	if err := validate.Struct(body); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	err := server.store.CancelRoomReservationOccurrence(c.Context(), params.ID, body.OccurrenceStart.UTC())
+	if err != nil {
+		return c.Status(roomReservationWriteStatus(err)).JSON(newErrorResponse(err))
+	}
+
+	return c.Status(fiber.StatusNoContent).JSON(nil)
+}
+
+type listRoomReservationsRequestQuery struct {
+	// From is the start of the range listed, now by default.
+	From string `query:"from" json:"from" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00" format:"date-time"`
+	// To is the end of the range listed, a week after from by default. The range can span up to 92 days.
+	To string `query:"to" json:"to" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00" format:"date-time"`
+}
+
+// @Summary      List room reservations
+// @Description  Lists the occurrences of the reservations of the room overlapping a range, with recurring reservations expanded.
+// @Tags         rooms
+// @Param        id    path  string                           true "Room ID"
+// @Param        query query listRoomReservationsRequestQuery true "query"
+// @Success      200 {array} roomReservationOccurrenceResponse
+// @Failure      400 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /rooms/{id}/reservations [get]
+func (server *Server) listRoomReservations(c *fiber.Ctx) error {
+	params := new(roomRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	query := new(listRoomReservationsRequestQuery)
+	if err := c.QueryParser(query); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	validate := newValidator()
+	if err := validate.Struct(query); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	from := time.Now()
+	if len(query.From) > 0 {
+		var err error
+		if from, err = time.Parse(time.RFC3339, query.From); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+		}
+	}
+	to := from.Add(defaultRoomReservationsRange)
+	if len(query.To) > 0 {
+		var err error
+		if to, err = time.Parse(time.RFC3339, query.To); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+		}
+	}
+	if !to.After(from) || to.Sub(from) > maxRoomReservationsRange {
+		err := fmt.Errorf("to must be after from, by up to %d days", int(maxRoomReservationsRange.Hours()/24))
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	if _, err := server.store.GetRoom(c.Context(), params.ID); err != nil {
+		if err == sql.ErrNoRows {
+			return c.Status(fiber.StatusNotFound).JSON(newErrorResponse(err))
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	occurrences, err := server.store.ListRoomReservationOccurrences(c.Context(), db.ListRoomReservationOccurrencesParams{
+		RoomID: uuid.NullUUID{UUID: params.ID, Valid: true},
+		From:   from.UTC(),
+		To:     to.UTC(),
+	})
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	rsp := make([]roomReservationOccurrenceResponse, 0, len(occurrences))
+	for _, occurrence := range occurrences {
+		rsp = append(rsp, newRoomReservationOccurrenceResponse(occurrence))
+	}
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
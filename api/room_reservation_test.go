@@ -0,0 +1,665 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/ot07/coworker-backend/calendar"
+	mockdb "github.com/ot07/coworker-backend/db/mock"
+	db "github.com/ot07/coworker-backend/db/sqlc"
+	"github.com/ot07/coworker-backend/util"
+	"github.com/stretchr/testify/require"
+)
+
+func randomRoomReservation(room db.Room, memberID uuid.UUID, startsAt time.Time, rrule string) db.RoomReservation {
+	return db.RoomReservation{
+		ID:           util.RandomUUID(),
+		RoomID:       room.ID,
+		MemberID:     memberID,
+		Title:        util.RandomName(),
+		StartsAt:     startsAt,
+		EndsAt:       startsAt.Add(time.Hour),
+		Rrule:        sql.NullString{String: rrule, Valid: len(rrule) > 0},
+		SeriesEndsAt: startsAt.Add(time.Hour),
+		CreatedAt:    time.Date(2023, 4, 1, 9, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestCreateRoomReservationAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	room := randomRoom(randomLocation())
+	memberID := util.RandomUUID()
+	startsAt := time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)
+	reservation := randomRoomReservation(room, memberID, startsAt, "FREQ=WEEKLY;COUNT=4")
+
+	testCases := []struct {
+		name          string
+		body          fiber.Map
+		setupAuth     func(request *http.Request)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name: "OK",
+			body: fiber.Map{
+				"room_id":   room.ID,
+				"member_id": memberID,
+				"title":     reservation.Title,
+				"starts_at": "2023-05-01T19:00:00+09:00",
+				"ends_at":   "2023-05-01T20:00:00+09:00",
+				"rrule":     "FREQ=WEEKLY;COUNT=4",
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				arg := db.CreateRoomReservationTxParams{
+					RoomID:   room.ID,
+					MemberID: memberID,
+					Title:    reservation.Title,
+					StartsAt: startsAt,
+					EndsAt:   startsAt.Add(time.Hour),
+					RRule:    sql.NullString{String: "FREQ=WEEKLY;COUNT=4", Valid: true},
+				}
+
+				store.EXPECT().
+					CreateRoomReservationTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(reservation, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+
+				data, err := io.ReadAll(response.Body)
+				require.NoError(t, err)
+
+				var got roomReservationResponse
+				err = json.Unmarshal(data, &got)
+				require.NoError(t, err)
+
+				require.Equal(t, reservation.ID, got.ID)
+				require.Equal(t, reservation.Title, got.Title)
+				require.Equal(t, reservation.Rrule, got.RRule.NullString)
+				require.True(t, reservation.StartsAt.Equal(got.StartsAt))
+			},
+		},
+		{
+			name: "NoAuthorization",
+			body: fiber.Map{
+				"room_id":   room.ID,
+				"member_id": memberID,
+				"title":     reservation.Title,
+				"starts_at": startsAt,
+				"ends_at":   startsAt.Add(time.Hour),
+			},
+			setupAuth: func(request *http.Request) {
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateRoomReservationTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusUnauthorized, response.StatusCode)
+			},
+		},
+		{
+			name: "EndsBeforeStart",
+			body: fiber.Map{
+				"room_id":   room.ID,
+				"member_id": memberID,
+				"title":     reservation.Title,
+				"starts_at": startsAt,
+				"ends_at":   startsAt.Add(-time.Hour),
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					CreateRoomReservationTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "TooLong",
+			body: fiber.Map{
+				"room_id":   room.ID,
+				"member_id": memberID,
+				"title":     reservation.Title,
+				"starts_at": startsAt,
+				"ends_at":   startsAt.Add(25 * time.Hour),
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					CreateRoomReservationTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "InvalidRule",
+			body: fiber.Map{
+				"room_id":   room.ID,
+				"member_id": memberID,
+				"title":     reservation.Title,
+				"starts_at": startsAt,
+				"ends_at":   startsAt.Add(time.Hour),
+				"rrule":     "FREQ=YEARLY;COUNT=2",
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					CreateRoomReservationTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.RoomReservation{}, fmt.Errorf("%w: unsupported frequency", calendar.ErrInvalidRule))
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "Conflict",
+			body: fiber.Map{
+				"room_id":   room.ID,
+				"member_id": memberID,
+				"title":     reservation.Title,
+				"starts_at": startsAt,
+				"ends_at":   startsAt.Add(time.Hour),
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					CreateRoomReservationTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.RoomReservation{}, fmt.Errorf("%w: \"Standup\"", db.ErrRoomReservationConflict))
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusForbidden, response.StatusCode)
+			},
+		},
+		{
+			name: "NotFound",
+			body: fiber.Map{
+				"room_id":   room.ID,
+				"member_id": memberID,
+				"title":     reservation.Title,
+				"starts_at": startsAt,
+				"ends_at":   startsAt.Add(time.Hour),
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					CreateRoomReservationTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.RoomReservation{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusNotFound, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/api/v1/room-reservations", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			request.Header.Set("Content-Type", "application/json")
+
+			tc.setupAuth(request)
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func TestCancelRoomReservationOccurrenceAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	reservationID := util.RandomUUID()
+	occurrenceStart := time.Date(2023, 5, 8, 10, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name          string
+		body          fiber.Map
+		setupAuth     func(request *http.Request)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name: "OK",
+			body: fiber.Map{
+				"occurrence_start": "2023-05-08T19:00:00+09:00",
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					CancelRoomReservationOccurrence(gomock.Any(), gomock.Eq(reservationID), gomock.Eq(occurrenceStart)).
+					Times(1).
+					Return(nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusNoContent, response.StatusCode)
+			},
+		},
+		{
+			name: "NoOccurrenceStart",
+			body: fiber.Map{},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					CancelRoomReservationOccurrence(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "NotAnOccurrence",
+			body: fiber.Map{
+				"occurrence_start": occurrenceStart.Add(time.Minute),
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					CancelRoomReservationOccurrence(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ErrNotAnOccurrence)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "NotFound",
+			body: fiber.Map{
+				"occurrence_start": occurrenceStart,
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					CancelRoomReservationOccurrence(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusNotFound, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/api/v1/room-reservations/%s/exceptions", reservationID)
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			request.Header.Set("Content-Type", "application/json")
+
+			tc.setupAuth(request)
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func TestGetAndDeleteRoomReservationAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	reservation := randomRoomReservation(randomRoom(randomLocation()), util.RandomUUID(),
+		time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC), "")
+
+	testCases := []struct {
+		name          string
+		method        string
+		setupAuth     func(request *http.Request)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name:   "Get",
+			method: http.MethodGet,
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					GetRoomReservation(gomock.Any(), gomock.Eq(reservation.ID)).
+					Times(1).
+					Return(reservation, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+
+				data, err := io.ReadAll(response.Body)
+				require.NoError(t, err)
+
+				var got roomReservationResponse
+				err = json.Unmarshal(data, &got)
+				require.NoError(t, err)
+
+				require.Equal(t, reservation.ID, got.ID)
+				require.False(t, got.RRule.Valid)
+			},
+		},
+		{
+			name:   "GetNotFound",
+			method: http.MethodGet,
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					GetRoomReservation(gomock.Any(), gomock.Eq(reservation.ID)).
+					Times(1).
+					Return(db.RoomReservation{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusNotFound, response.StatusCode)
+			},
+		},
+		{
+			name:   "Delete",
+			method: http.MethodDelete,
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					DeleteRoomReservation(gomock.Any(), gomock.Eq(reservation.ID)).
+					Times(1).
+					Return(reservation, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusNoContent, response.StatusCode)
+			},
+		},
+		{
+			name:   "DeleteNotFound",
+			method: http.MethodDelete,
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					DeleteRoomReservation(gomock.Any(), gomock.Eq(reservation.ID)).
+					Times(1).
+					Return(db.RoomReservation{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusNotFound, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			request, err := http.NewRequest(tc.method, fmt.Sprintf("/api/v1/room-reservations/%s", reservation.ID), nil)
+			require.NoError(t, err)
+
+			tc.setupAuth(request)
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func TestListRoomReservationsAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	room := randomRoom(randomLocation())
+	from := time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC)
+	reservation := randomRoomReservation(room, util.RandomUUID(), from.Add(10*time.Hour), "FREQ=DAILY;COUNT=2")
+	occurrences := []db.RoomReservationOccurrence{
+		{Reservation: reservation, StartsAt: reservation.StartsAt, EndsAt: reservation.EndsAt},
+		{Reservation: reservation, StartsAt: reservation.StartsAt.AddDate(0, 0, 1), EndsAt: reservation.EndsAt.AddDate(0, 0, 1)},
+	}
+
+	testCases := []struct {
+		name          string
+		query         string
+		setupAuth     func(request *http.Request)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name:  "OK",
+			query: "?from=2023-05-01T09:00:00%2B09:00&to=2023-05-03T00:00:00Z",
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					GetRoom(gomock.Any(), gomock.Eq(room.ID)).
+					Times(1).
+					Return(room, nil)
+
+				arg := db.ListRoomReservationOccurrencesParams{
+					RoomID: uuid.NullUUID{UUID: room.ID, Valid: true},
+					From:   from,
+					To:     from.AddDate(0, 0, 2),
+				}
+
+				store.EXPECT().
+					ListRoomReservationOccurrences(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(occurrences, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+
+				data, err := io.ReadAll(response.Body)
+				require.NoError(t, err)
+
+				var got []roomReservationOccurrenceResponse
+				err = json.Unmarshal(data, &got)
+				require.NoError(t, err)
+
+				require.Len(t, got, len(occurrences))
+				for i, occurrence := range occurrences {
+					require.Equal(t, reservation.ID, got[i].ReservationID)
+					require.True(t, got[i].Recurring)
+					require.True(t, occurrence.StartsAt.Equal(got[i].StartsAt))
+					require.True(t, occurrence.EndsAt.Equal(got[i].EndsAt))
+				}
+			},
+		},
+		{
+			name:  "RangeTooLong",
+			query: "?from=2023-05-01T00:00:00Z&to=2023-09-01T00:00:00Z",
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ListRoomReservationOccurrences(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name:  "InvalidFrom",
+			query: "?from=2023-05-01",
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ListRoomReservationOccurrences(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "RoomNotFound",
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					GetRoom(gomock.Any(), gomock.Eq(room.ID)).
+					Times(1).
+					Return(db.Room{}, sql.ErrNoRows)
+
+				store.EXPECT().
+					ListRoomReservationOccurrences(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusNotFound, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			url := fmt.Sprintf("/api/v1/rooms/%s/reservations%s", room.ID, tc.query)
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			tc.setupAuth(request)
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
@@ -0,0 +1,705 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	mockdb "github.com/ot07/coworker-backend/db/mock"
+	db "github.com/ot07/coworker-backend/db/sqlc"
+	"github.com/ot07/coworker-backend/util"
+	"github.com/stretchr/testify/require"
+)
+
+func randomRoom(location db.Location) db.Room {
+	return db.Room{
+		ID:         util.RandomUUID(),
+		LocationID: location.ID,
+		Name:       util.RandomName(),
+		Capacity:   8,
+		Equipment:  []string{"projector", "whiteboard"},
+		CreatedAt:  time.Date(2023, 4, 1, 9, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestCreateRoomAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	room := randomRoom(randomLocation())
+
+	testCases := []struct {
+		name          string
+		body          fiber.Map
+		setupAuth     func(request *http.Request)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name: "OK",
+			body: fiber.Map{
+				"location_id": room.LocationID,
+				"name":        room.Name,
+				"capacity":    room.Capacity,
+				"equipment":   room.Equipment,
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				arg := db.CreateRoomParams{
+					LocationID: room.LocationID,
+					Name:       room.Name,
+					Capacity:   room.Capacity,
+					Equipment:  room.Equipment,
+				}
+
+				store.EXPECT().
+					CreateRoom(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(room, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+				requireBodyMatchRoom(t, response.Body, room)
+			},
+		},
+		{
+			name: "OKWithoutEquipment",
+			body: fiber.Map{
+				"location_id": room.LocationID,
+				"name":        room.Name,
+				"capacity":    room.Capacity,
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				arg := db.CreateRoomParams{
+					LocationID: room.LocationID,
+					Name:       room.Name,
+					Capacity:   room.Capacity,
+					Equipment:  []string{},
+				}
+
+				store.EXPECT().
+					CreateRoom(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(room, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+			},
+		},
+		{
+			name: "NoAuthorization",
+			body: fiber.Map{
+				"location_id": room.LocationID,
+				"name":        room.Name,
+				"capacity":    room.Capacity,
+			},
+			setupAuth: func(request *http.Request) {
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateRoom(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusUnauthorized, response.StatusCode)
+			},
+		},
+		{
+			name: "InvalidCapacity",
+			body: fiber.Map{
+				"location_id": room.LocationID,
+				"name":        room.Name,
+				"capacity":    0,
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					CreateRoom(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "DuplicateEquipment",
+			body: fiber.Map{
+				"location_id": room.LocationID,
+				"name":        room.Name,
+				"capacity":    room.Capacity,
+				"equipment":   []string{"projector", "projector"},
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					CreateRoom(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "LocationNotFound",
+			body: fiber.Map{
+				"location_id": room.LocationID,
+				"name":        room.Name,
+				"capacity":    room.Capacity,
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					CreateRoom(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Room{}, &pq.Error{Code: "23503"})
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "DuplicateName",
+			body: fiber.Map{
+				"location_id": room.LocationID,
+				"name":        room.Name,
+				"capacity":    room.Capacity,
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					CreateRoom(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Room{}, &pq.Error{Code: "23505"})
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusForbidden, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/api/v1/rooms", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			request.Header.Set("Content-Type", "application/json")
+
+			tc.setupAuth(request)
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func TestListRoomsAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	location := randomLocation()
+	rooms := []db.Room{randomRoom(location), randomRoom(location)}
+
+	testCases := []struct {
+		name          string
+		query         string
+		setupAuth     func(request *http.Request)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name: "OK",
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				arg := db.ListRoomsParams{
+					Equipment: []string{},
+				}
+
+				store.EXPECT().
+					ListRooms(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(rooms, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+
+				data, err := io.ReadAll(response.Body)
+				require.NoError(t, err)
+
+				var got []roomResponse
+				err = json.Unmarshal(data, &got)
+				require.NoError(t, err)
+
+				require.Len(t, got, len(rooms))
+				for i, room := range rooms {
+					requireRoomResponseMatch(t, got[i], room)
+				}
+			},
+		},
+		{
+			name:  "OKWithFilters",
+			query: fmt.Sprintf("?location_id=%s&min_capacity=6&equipment=projector,%%20whiteboard", location.ID),
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				arg := db.ListRoomsParams{
+					LocationID:  uuid.NullUUID{UUID: location.ID, Valid: true},
+					MinCapacity: 6,
+					Equipment:   []string{"projector", "whiteboard"},
+				}
+
+				store.EXPECT().
+					ListRooms(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(rooms, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+			},
+		},
+		{
+			name:  "InvalidLocationID",
+			query: "?location_id=invalid",
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ListRooms(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "InternalError",
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					ListRooms(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.Room{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusInternalServerError, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			request, err := http.NewRequest(http.MethodGet, "/api/v1/rooms"+tc.query, nil)
+			require.NoError(t, err)
+
+			tc.setupAuth(request)
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func TestGetRoomAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	room := randomRoom(randomLocation())
+
+	testCases := []struct {
+		name          string
+		roomID        string
+		setupAuth     func(request *http.Request)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name:   "OK",
+			roomID: room.ID.String(),
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					GetRoom(gomock.Any(), gomock.Eq(room.ID)).
+					Times(1).
+					Return(room, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+				requireBodyMatchRoom(t, response.Body, room)
+			},
+		},
+		{
+			name:   "NotFound",
+			roomID: room.ID.String(),
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					GetRoom(gomock.Any(), gomock.Eq(room.ID)).
+					Times(1).
+					Return(db.Room{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusNotFound, response.StatusCode)
+			},
+		},
+		{
+			name:   "InvalidID",
+			roomID: "invalid",
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					GetRoom(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			request, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/rooms/%s", tc.roomID), nil)
+			require.NoError(t, err)
+
+			tc.setupAuth(request)
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func TestUpdateRoomAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	room := randomRoom(randomLocation())
+
+	testCases := []struct {
+		name          string
+		body          fiber.Map
+		setupAuth     func(request *http.Request)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name: "OK",
+			body: fiber.Map{
+				"name":      room.Name,
+				"capacity":  room.Capacity,
+				"equipment": room.Equipment,
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				arg := db.UpdateRoomParams{
+					ID:        room.ID,
+					Name:      room.Name,
+					Capacity:  room.Capacity,
+					Equipment: room.Equipment,
+				}
+
+				store.EXPECT().
+					UpdateRoom(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(room, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+				requireBodyMatchRoom(t, response.Body, room)
+			},
+		},
+		{
+			name: "NoName",
+			body: fiber.Map{
+				"capacity": room.Capacity,
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					UpdateRoom(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "NotFound",
+			body: fiber.Map{
+				"name":     room.Name,
+				"capacity": room.Capacity,
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					UpdateRoom(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Room{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusNotFound, response.StatusCode)
+			},
+		},
+		{
+			name: "DuplicateName",
+			body: fiber.Map{
+				"name":     room.Name,
+				"capacity": room.Capacity,
+			},
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					UpdateRoom(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Room{}, &pq.Error{Code: "23505"})
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusForbidden, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPut, fmt.Sprintf("/api/v1/rooms/%s", room.ID), bytes.NewReader(data))
+			require.NoError(t, err)
+
+			request.Header.Set("Content-Type", "application/json")
+
+			tc.setupAuth(request)
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func TestDeleteRoomAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	room := randomRoom(randomLocation())
+
+	testCases := []struct {
+		name          string
+		setupAuth     func(request *http.Request)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name: "OK",
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					DeleteRoom(gomock.Any(), gomock.Eq(room.ID)).
+					Times(1).
+					Return(room, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusNoContent, response.StatusCode)
+			},
+		},
+		{
+			name: "NotFound",
+			setupAuth: func(request *http.Request) {
+				addSessionTokenInCookie(request, session.SessionToken.String())
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				buildValidSessionStubs(store, session)
+
+				store.EXPECT().
+					DeleteRoom(gomock.Any(), gomock.Eq(room.ID)).
+					Times(1).
+					Return(db.Room{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusNotFound, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			request, err := http.NewRequest(http.MethodDelete, fmt.Sprintf("/api/v1/rooms/%s", room.ID), nil)
+			require.NoError(t, err)
+
+			tc.setupAuth(request)
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func requireRoomResponseMatch(t *testing.T, got roomResponse, room db.Room) {
+	require.Equal(t, room.ID, got.ID)
+	require.Equal(t, room.LocationID, got.LocationID)
+	require.Equal(t, room.Name, got.Name)
+	require.Equal(t, room.Capacity, got.Capacity)
+	require.Equal(t, room.Equipment, got.Equipment)
+	require.True(t, room.CreatedAt.Equal(got.CreatedAt))
+}
+
+func requireBodyMatchRoom(t *testing.T, body io.ReadCloser, room db.Room) {
+	data, err := io.ReadAll(body)
+	require.NoError(t, err)
+
+	var got roomResponse
+	err = json.Unmarshal(data, &got)
+	require.NoError(t, err)
+
+	requireRoomResponseMatch(t, got, room)
+
+	err = body.Close()
+	require.NoError(t, err)
+}
@@ -1,33 +1,59 @@
 package api
 
 import (
+	"context"
+	"sync"
+
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/cors"
+	"github.com/gofiber/fiber/v2/middleware/requestid"
 	"github.com/gofiber/swagger"
 	db "github.com/ot07/coworker-backend/db/sqlc"
+	"github.com/ot07/coworker-backend/storage"
 	"github.com/ot07/coworker-backend/util"
 )
 
+// localStoragePath is where the files of a local storage are served.
+const localStoragePath = "/uploads"
+
+// bodyLimit is the largest request body accepted, leaving room for the largest avatar with its multipart framing.
+const bodyLimit = avatarMaxSize + 1<<20
+
 // Server serves HTTP requests for this app service.
 type Server struct {
 	config util.Config
 	store  db.Store
-	app    *fiber.App
+	// storage keeps the uploaded files, such as the avatars of the members.
+	storage storage.Storage
+	app     *fiber.App
+	// background tracks the goroutines running jobs after their request has been answered.
+	background sync.WaitGroup
+	// backgroundCtx is the context of the background jobs, which cancelBackground cancels when shutting down.
+	backgroundCtx    context.Context
+	cancelBackground context.CancelFunc
 }
 
 // NewServer creates a new HTTP server and setup routing.
-func NewServer(config util.Config, store db.Store) (*Server, error) {
-	app := fiber.New()
+func NewServer(config util.Config, store db.Store, fileStorage storage.Storage) (*Server, error) {
+	app := fiber.New(fiber.Config{BodyLimit: bodyLimit})
 	app.Use(cors.New(cors.Config{
 		AllowOrigins:     "http://localhost:3000,https://coworker-frontend.vercel.app",
 		AllowCredentials: true,
+		ExposeHeaders:    fiber.HeaderETag,
 	}))
+	app.Use(requestid.New(requestid.Config{ContextKey: requestIDKey}))
+
+	if local, ok := fileStorage.(*storage.LocalStorage); ok {
+		app.Static(localStoragePath, local.Dir())
+	}
 
 	server := &Server{
-		config: config,
-		store:  store,
-		app:    app,
+		config:  config,
+		store:   store,
+		storage: fileStorage,
+		app:     app,
 	}
+	server.backgroundCtx, server.cancelBackground = context.WithCancel(context.Background())
 
 	server.setupRouter()
 	return server, nil
@@ -41,18 +67,158 @@ func (server *Server) setupRouter() {
 
 	v1.Post("/users", server.createUser)
 	v1.Post("/users/login", server.loginUser)
+	// Calendar clients cannot log in, so the feeds are authenticated by the token in their URL instead.
+	v1.Get("/calendars/:token.ics", server.getCalendarFeed)
 
 	v1.Use(authMiddleware(server))
 
 	v1.Post("/users/logout", server.logoutUser)
 	v1.Get("/users/me", server.getLoggedInUser)
+	v1.Get("/users/me/checklist-tasks", server.listMyChecklistTasks)
 
 	v1.Post("/members", server.createMember)
+	v1.Get("/members/search", server.searchMembers)
+	v1.Get("/members/export", server.exportMembers)
+	v1.Post("/members/import", server.importMembers)
+	v1.Get("/members/import/:id", server.getMemberImportJob)
+	v1.Get("/members/trash", server.listDeletedMembers)
+	v1.Post("/members/restore", server.restoreMembers)
+	v1.Post("/members/tags", server.addMembersTags)
+	v1.Get("/members/duplicates", server.listMemberDuplicates)
+	v1.Post("/members/merge", server.mergeMembers)
+	v1.Delete("/members/tags", server.removeMembersTags)
+	v1.Post("/members/:id/restore", server.restoreMember)
 	v1.Get("/members/:id", server.getMember)
 	v1.Get("/members", server.listMembers)
 	v1.Put("/members/:id", server.updateMember)
+	v1.Patch("/members/:id", server.patchMember)
 	v1.Delete("/members/:id", server.deleteMember)
 	v1.Delete("/members", server.deleteMembers)
+	v1.Get("/members/:id/history", server.getMemberHistory)
+	v1.Put("/members/:id/tags/:tag_id", server.attachMemberTag)
+	v1.Delete("/members/:id/tags/:tag_id", server.detachMemberTag)
+	v1.Put("/members/:id/manager", server.setMemberManager)
+	v1.Delete("/members/:id/manager", server.clearMemberManager)
+	v1.Put("/members/:id/avatar", server.uploadMemberAvatar)
+	v1.Get("/members/:id/reports", server.listMemberReports)
+	v1.Get("/members/:id/chain", server.getMemberChain)
+	v1.Post("/members/:id/transitions", server.transitionMember)
+	v1.Get("/members/:id/checklist", server.listMemberChecklist)
+	v1.Post("/members/:id/calendar-token", server.rotateMemberCalendarToken)
+	v1.Post("/members/:id/check-in", server.checkInMember)
+	v1.Post("/members/:id/check-out", server.checkOutMember)
+	v1.Get("/members/:id/attendances", server.listMemberAttendances)
+	v1.Get("/members/:id/leave-balances", server.listMemberLeaveBalances)
+	v1.Get("/members/:id/working-hours", server.getMemberWorkingHours)
+	v1.Put("/members/:id/working-hours", server.setMemberWorkingHours)
+	v1.Get("/members/:id/timer", server.getMemberTimer)
+	v1.Post("/members/:id/timer/start", server.startMemberTimer)
+	v1.Post("/members/:id/timer/stop", server.stopMemberTimer)
+	v1.Get("/members/:id/timesheets/:week", server.getMemberTimesheet)
+	v1.Post("/members/:id/timesheets/:week/submit", server.submitMemberTimesheet)
+	v1.Post("/members/:id/timesheets/:week/reopen", server.reopenMemberTimesheet)
+	v1.Get("/members/:id/skills", server.listMemberSkills)
+	v1.Put("/members/:id/skills/:skill_id", server.setMemberSkill)
+	v1.Delete("/members/:id/skills/:skill_id", server.removeMemberSkill)
+	v1.Put("/members/:id/skills/:skill_id/endorsement", server.endorseMemberSkill)
+	v1.Delete("/members/:id/skills/:skill_id/endorsement", server.withdrawMemberSkillEndorsement)
+	v1.Get("/org-chart", server.getOrgChart)
+	v1.Get("/audit-events", server.listAuditEvents)
+
+	v1.Post("/custom-fields", server.createCustomFieldDefinition)
+	v1.Get("/custom-fields", server.listCustomFieldDefinitions)
+	v1.Put("/custom-fields/:id", server.updateCustomFieldDefinition)
+	v1.Delete("/custom-fields/:id", server.deleteCustomFieldDefinition)
+
+	v1.Post("/tags", server.createTag)
+	v1.Get("/tags", server.listTags)
+	v1.Put("/tags/:id", server.renameTag)
+	v1.Delete("/tags/:id", server.deleteTag)
+
+	v1.Post("/skills", server.createSkill)
+	v1.Get("/skills", server.listSkills)
+	v1.Get("/skills/search", server.searchMembersBySkills)
+	v1.Put("/skills/:id", server.renameSkill)
+	v1.Delete("/skills/:id", server.deleteSkill)
+
+	v1.Get("/checklist-templates/:kind", server.getChecklistTemplate)
+	v1.Put("/checklist-templates/:kind", server.replaceChecklistTemplate)
+	v1.Post("/checklist-tasks/:id/complete", server.completeChecklistTask)
+	v1.Put("/checklist-tasks/:id/assignee", server.reassignChecklistTask)
+
+	v1.Post("/locations", server.createLocation)
+	v1.Get("/locations", server.listLocations)
+	v1.Get("/locations/:id", server.getLocation)
+	v1.Put("/locations/:id", server.updateLocation)
+	v1.Delete("/locations/:id", server.deleteLocation)
+	v1.Post("/locations/:id/floors", server.createFloor)
+	v1.Get("/locations/:id/floors", server.listFloors)
+	v1.Get("/locations/:id/availability", server.getLocationAvailability)
+	v1.Delete("/floors/:id", server.deleteFloor)
+	v1.Post("/floors/:id/desks", server.createDesk)
+	v1.Get("/floors/:id/desks", server.listDesks)
+	v1.Delete("/desks/:id", server.deleteDesk)
+
+	v1.Post("/desk-bookings", server.bookDesk)
+	v1.Delete("/desk-bookings/:id", server.cancelDeskBooking)
+
+	v1.Post("/rooms", server.createRoom)
+	v1.Get("/rooms", server.listRooms)
+	v1.Get("/rooms/:id", server.getRoom)
+	v1.Put("/rooms/:id", server.updateRoom)
+	v1.Delete("/rooms/:id", server.deleteRoom)
+	v1.Get("/rooms/:id/reservations", server.listRoomReservations)
+	v1.Post("/rooms/:id/calendar-token", server.rotateRoomCalendarToken)
+	v1.Post("/room-reservations", server.createRoomReservation)
+	v1.Get("/room-reservations/:id", server.getRoomReservation)
+	v1.Delete("/room-reservations/:id", server.deleteRoomReservation)
+	v1.Post("/room-reservations/:id/exceptions", server.cancelRoomReservationOccurrence)
+
+	v1.Get("/presence", server.getPresence)
+	v1.Get("/attendance-summaries", server.listAttendanceSummaries)
+
+	v1.Post("/leave-types", server.createLeaveType)
+	v1.Get("/leave-types", server.listLeaveTypes)
+	v1.Put("/leave-types/:id", server.updateLeaveType)
+	v1.Delete("/leave-types/:id", server.deleteLeaveType)
+	v1.Post("/leave-requests", server.createLeaveRequest)
+	v1.Get("/leave-requests", server.listLeaveRequests)
+	v1.Get("/leave-requests/:id", server.getLeaveRequest)
+	v1.Post("/leave-requests/:id/approve", server.approveLeaveRequest)
+	v1.Post("/leave-requests/:id/reject", server.rejectLeaveRequest)
+	v1.Post("/leave-requests/:id/cancel", server.cancelLeaveRequest)
+
+	v1.Get("/working-hours/overlap", server.getWorkingHoursOverlap)
+
+	v1.Post("/projects", server.createProject)
+	v1.Get("/projects", server.listProjects)
+	v1.Get("/projects/:id", server.getProject)
+	v1.Put("/projects/:id", server.updateProject)
+	v1.Delete("/projects/:id", server.deleteProject)
+	v1.Post("/time-entries", server.createTimeEntry)
+	v1.Get("/time-entries", server.listTimeEntries)
+	v1.Get("/time-entries/:id", server.getTimeEntry)
+	v1.Put("/time-entries/:id", server.updateTimeEntry)
+	v1.Delete("/time-entries/:id", server.deleteTimeEntry)
+	v1.Get("/time-reports", server.getTimeReport)
+
+	v1.Post("/project-assignments", server.createProjectAssignment)
+	v1.Get("/project-assignments", server.listProjectAssignments)
+	v1.Get("/project-assignments/:id", server.getProjectAssignment)
+	v1.Put("/project-assignments/:id", server.updateProjectAssignment)
+	v1.Delete("/project-assignments/:id", server.deleteProjectAssignment)
+	v1.Get("/capacity", server.getCapacity)
+
+	v1.Post("/teams", server.createTeam)
+	v1.Get("/teams", server.listTeams)
+	v1.Get("/teams/:id", server.getTeam)
+	v1.Put("/teams/:id", server.updateTeam)
+	v1.Delete("/teams/:id", server.deleteTeam)
+	v1.Get("/teams/:id/members", server.listTeamMembers)
+	v1.Put("/teams/:id/members/:member_id", server.addTeamMember)
+	v1.Delete("/teams/:id/members/:member_id", server.removeTeamMember)
+	v1.Post("/teams/:id/members/:member_id/move", server.moveTeamMember)
+	v1.Get("/teams/:id/leave-calendar", server.getTeamLeaveCalendar)
 
 	app.Get("/swagger/*", swagger.HandlerDefault)
 }
@@ -62,6 +228,29 @@ func (server *Server) Start(address string) error {
 	return server.app.Listen(address)
 }
 
+// Shutdown stops the HTTP server and waits for the background jobs to finish.
+// Jobs still running when ctx is done are canceled, which they record as a failure before Shutdown returns ctx.Err().
+func (server *Server) Shutdown(ctx context.Context) error {
+	defer server.cancelBackground()
+
+	err := server.app.Shutdown()
+
+	done := make(chan struct{})
+	go func() {
+		server.background.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return err
+	case <-ctx.Done():
+		server.cancelBackground()
+		<-done
+		return ctx.Err()
+	}
+}
+
 type errorResponse struct {
 	Error string `json:"error"`
 }
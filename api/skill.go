@@ -0,0 +1,552 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	db "github.com/ot07/coworker-backend/db/sqlc"
+)
+
+const (
+	// maxSkillRequirements is how many skills members can be searched by at once.
+	maxSkillRequirements = 10
+	// defaultSearchSkillsLimit is how many members a skill search returns unless told otherwise.
+	defaultSearchSkillsLimit = 20
+)
+
+var (
+	errSkillRequirements = fmt.Errorf("from 1 to %d requirements must be given as skill_id:level, with levels from 1 to 5", maxSkillRequirements)
+	errDuplicateSkill    = errors.New("a skill can only be required once")
+)
+
+// skillWriteStatus tells which status to respond with for an error writing a skill.
+func skillWriteStatus(err error) int {
+	if err == sql.ErrNoRows {
+		return fiber.StatusNotFound
+	}
+	if pqErr, ok := err.(*pq.Error); ok && pqErr.Code.Name() == "unique_violation" {
+		return fiber.StatusForbidden
+	}
+	return fiber.StatusInternalServerError
+}
+
+type skillResponse struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func newSkillResponse(skill db.Skill) skillResponse {
+	return skillResponse{
+		ID:        skill.ID,
+		Name:      skill.Name,
+		CreatedAt: skill.CreatedAt,
+	}
+}
+
+type skillRequest struct {
+	Name string `json:"name" validate:"required,max=50" example:"Go"`
+}
+
+// @Summary      Create skill
+// @Description  Adds a skill to the catalog of the workspace. Skill names are unique regardless of case.
+// @Tags         skills
+// @Param        body body skillRequest true "Skill object"
+// @Success      200 {object} skillResponse
+// @Failure      400 {object} errorResponse
+// @Failure      403 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /skills [post]
+func (server *Server) createSkill(c *fiber.Ctx) error {
+	req := new(skillRequest)
+	if err := c.BodyParser(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	validate := newValidator()
+	if err := validate.Struct(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	skill, err := server.store.CreateSkill(c.Context(), req.Name)
+	if err != nil {
+		return c.Status(skillWriteStatus(err)).JSON(newErrorResponse(err))
+	}
+
+	rsp := newSkillResponse(skill)
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+// @Summary      List skills
+// @Tags         skills
+// @Success      200 {array} skillResponse
+// @Failure      500 {object} errorResponse
+// @Router       /skills [get]
+func (server *Server) listSkills(c *fiber.Ctx) error {
+	skills, err := server.store.ListSkills(c.Context())
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	rsp := make([]skillResponse, 0, len(skills))
+	for _, skill := range skills {
+		rsp = append(rsp, newSkillResponse(skill))
+	}
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+type skillRequestParams struct {
+	ID uuid.UUID `params:"id"`
+}
+
+// @Summary      Rename skill
+// @Tags         skills
+// @Param        id   path string       true "Skill ID"
+// @Param        body body skillRequest true "Skill object"
+// @Success      200 {object} skillResponse
+// @Failure      400 {object} errorResponse
+// @Failure      403 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /skills/{id} [put]
+func (server *Server) renameSkill(c *fiber.Ctx) error {
+	params := new(skillRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	req := new(skillRequest)
+	if err := c.BodyParser(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	validate := newValidator()
+	if err := validate.Struct(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	skill, err := server.store.RenameSkill(c.Context(), db.RenameSkillParams{ID: params.ID, Name: req.Name})
+	if err != nil {
+		return c.Status(skillWriteStatus(err)).JSON(newErrorResponse(err))
+	}
+
+	rsp := newSkillResponse(skill)
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+// @Summary      Delete skill
+// @Description  Deletes the skill, along with the levels members have in it and their endorsements.
+// @Tags         skills
+// @Param        id path string true "Skill ID"
+// @Success      204 {object} nil
+// @Failure      400 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /skills/{id} [delete]
+func (server *Server) deleteSkill(c *fiber.Ctx) error {
+	params := new(skillRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	if _, err := server.store.DeleteSkill(c.Context(), params.ID); err != nil {
+		if err == sql.ErrNoRows {
+			return c.Status(fiber.StatusNotFound).JSON(newErrorResponse(err))
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	return c.Status(fiber.StatusNoContent).JSON(nil)
+}
+
+type memberSkillResponse struct {
+	SkillID   uuid.UUID `json:"skill_id"`
+	SkillName string    `json:"skill_name"`
+	// Level is the proficiency of the member, from 1 (novice) to 5 (expert).
+	Level      int32       `json:"level"`
+	LastUsedOn db.NullDate `json:"last_used_on" swaggertype:"string" format:"date"`
+	// Endorsements counts the users vouching for the skill of the member.
+	Endorsements int32 `json:"endorsements"`
+	// Endorsed tells whether the signed-in user is one of them.
+	Endorsed bool `json:"endorsed"`
+}
+
+// listMemberSkillsResponse lists the skills of a member, as the signed-in user sees them.
+func (server *Server) listMemberSkillsResponse(c *fiber.Ctx, memberID uuid.UUID) ([]memberSkillResponse, error) {
+	skills, err := server.store.ListMemberSkills(c.Context(), db.ListMemberSkillsParams{
+		UserID:   c.Locals(sessionUserIDKey).(uuid.UUID),
+		MemberID: memberID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := make([]memberSkillResponse, 0, len(skills))
+	for _, skill := range skills {
+		rsp = append(rsp, memberSkillResponse{
+			SkillID:      skill.SkillID,
+			SkillName:    skill.SkillName,
+			Level:        skill.Level,
+			LastUsedOn:   db.NullDate{NullTime: skill.LastUsedOn},
+			Endorsements: skill.Endorsements,
+			Endorsed:     skill.Endorsed,
+		})
+	}
+	return rsp, nil
+}
+
+type memberSkillsRequestParams struct {
+	ID uuid.UUID `params:"id"`
+}
+
+// @Summary      List member skills
+// @Description  Lists the skills of the member, the most proficient first.
+// @Tags         skills
+// @Param        id path string true "Member ID"
+// @Success      200 {array} memberSkillResponse
+// @Failure      400 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /members/{id}/skills [get]
+func (server *Server) listMemberSkills(c *fiber.Ctx) error {
+	params := new(memberSkillsRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	if _, err := server.store.GetMember(c.Context(), params.ID); err != nil {
+		if err == sql.ErrNoRows {
+			return c.Status(fiber.StatusNotFound).JSON(newErrorResponse(err))
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	rsp, err := server.listMemberSkillsResponse(c, params.ID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+type memberSkillRequestParams struct {
+	ID      uuid.UUID `params:"id"`
+	SkillID uuid.UUID `params:"skill_id"`
+}
+
+type setMemberSkillRequest struct {
+	Level      int32  `json:"level" validate:"required,min=1,max=5" example:"4"`
+	LastUsedOn string `json:"last_used_on" validate:"omitempty,datetime=2006-01-02" format:"date"`
+}
+
+// @Summary      Set member skill
+// @Description  Gives the member the skill at a level, or changes the level and the last day they used it.
+// @Description  Members in the trash cannot be given skills.
+// @Tags         skills
+// @Param        id       path string                true "Member ID"
+// @Param        skill_id path string                true "Skill ID"
+// @Param        body     body setMemberSkillRequest true "Member skill object"
+// @Success      200 {array} memberSkillResponse
+// @Failure      400 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /members/{id}/skills/{skill_id} [put]
+func (server *Server) setMemberSkill(c *fiber.Ctx) error {
+	params := new(memberSkillRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	req := new(setMemberSkillRequest)
+	if err := c.BodyParser(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	validate := newValidator()
+	if err := validate.Struct(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	lastUsedOn, err := parseNullDate(req.LastUsedOn)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	member, err := server.store.GetMember(c.Context(), params.ID)
+	if err == nil && member.DeletedAt.Valid {
+		err = sql.ErrNoRows
+	}
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return c.Status(fiber.StatusNotFound).JSON(newErrorResponse(err))
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	if _, err := server.store.GetSkill(c.Context(), params.SkillID); err != nil {
+		if err == sql.ErrNoRows {
+			return c.Status(fiber.StatusNotFound).JSON(newErrorResponse(err))
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	arg := db.UpsertMemberSkillParams{
+		MemberID:   params.ID,
+		SkillID:    params.SkillID,
+		Level:      req.Level,
+		LastUsedOn: lastUsedOn,
+	}
+	if _, err := server.store.UpsertMemberSkill(c.Context(), arg); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	rsp, err := server.listMemberSkillsResponse(c, params.ID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+// @Summary      Remove member skill
+// @Description  Removes the skill from the member, along with its endorsements.
+// @Tags         skills
+// @Param        id       path string true "Member ID"
+// @Param        skill_id path string true "Skill ID"
+// @Success      204 {object} nil
+// @Failure      400 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /members/{id}/skills/{skill_id} [delete]
+func (server *Server) removeMemberSkill(c *fiber.Ctx) error {
+	params := new(memberSkillRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	arg := db.DeleteMemberSkillParams{
+		MemberID: params.ID,
+		SkillID:  params.SkillID,
+	}
+	if _, err := server.store.DeleteMemberSkill(c.Context(), arg); err != nil {
+		if err == sql.ErrNoRows {
+			return c.Status(fiber.StatusNotFound).JSON(newErrorResponse(err))
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	return c.Status(fiber.StatusNoContent).JSON(nil)
+}
+
+// @Summary      Endorse member skill
+// @Description  Vouches for the skill of the member as the signed-in user. Members cannot endorse their own skills,
+// @Description  a user being a member when they share an email. Endorsing a skill twice is a no-op.
+// @Tags         skills
+// @Param        id       path string true "Member ID"
+// @Param        skill_id path string true "Skill ID"
+// @Success      200 {array} memberSkillResponse
+// @Failure      400 {object} errorResponse
+// @Failure      403 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /members/{id}/skills/{skill_id}/endorsement [put]
+func (server *Server) endorseMemberSkill(c *fiber.Ctx) error {
+	params := new(memberSkillRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	arg := db.EndorseMemberSkillTxParams{
+		MemberID: params.ID,
+		SkillID:  params.SkillID,
+		UserID:   c.Locals(sessionUserIDKey).(uuid.UUID),
+	}
+	if err := server.store.EndorseMemberSkillTx(c.Context(), arg); err != nil {
+		switch {
+		case err == sql.ErrNoRows:
+			return c.Status(fiber.StatusNotFound).JSON(newErrorResponse(err))
+		case errors.Is(err, db.ErrSelfEndorsement):
+			return c.Status(fiber.StatusForbidden).JSON(newErrorResponse(err))
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	rsp, err := server.listMemberSkillsResponse(c, params.ID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+// @Summary      Withdraw member skill endorsement
+// @Description  Withdraws the endorsement of the signed-in user. Withdrawing an endorsement that was not made is a no-op.
+// @Tags         skills
+// @Param        id       path string true "Member ID"
+// @Param        skill_id path string true "Skill ID"
+// @Success      200 {array} memberSkillResponse
+// @Failure      400 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /members/{id}/skills/{skill_id}/endorsement [delete]
+func (server *Server) withdrawMemberSkillEndorsement(c *fiber.Ctx) error {
+	params := new(memberSkillRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	arg := db.DeleteSkillEndorsementParams{
+		MemberID: params.ID,
+		SkillID:  params.SkillID,
+		UserID:   c.Locals(sessionUserIDKey).(uuid.UUID),
+	}
+	if _, err := server.store.DeleteSkillEndorsement(c.Context(), arg); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	rsp, err := server.listMemberSkillsResponse(c, params.ID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+// parseSkillRequirements parses comma separated requirements given as skill_id:level, such as "<go>:4,<postgres>:3".
+func parseSkillRequirements(value string) ([]db.SkillRequirement, error) {
+	parts := strings.Split(value, ",")
+	if len(parts) > maxSkillRequirements {
+		return nil, errSkillRequirements
+	}
+
+	requirements := make([]db.SkillRequirement, 0, len(parts))
+	seen := make(map[uuid.UUID]bool, len(parts))
+	for _, part := range parts {
+		skill, level, ok := strings.Cut(strings.TrimSpace(part), ":")
+		if !ok {
+			return nil, errSkillRequirements
+		}
+		skillID, err := uuid.Parse(skill)
+		if err != nil {
+			return nil, errSkillRequirements
+		}
+		minLevel, err := strconv.Atoi(level)
+		if err != nil || minLevel < 1 || minLevel > 5 {
+			return nil, errSkillRequirements
+		}
+		if seen[skillID] {
+			return nil, errDuplicateSkill
+		}
+		seen[skillID] = true
+		requirements = append(requirements, db.SkillRequirement{SkillID: skillID, MinLevel: int32(minLevel)})
+	}
+	return requirements, nil
+}
+
+type searchSkillsRequestQuery struct {
+	// Requirements are comma separated skill_id:level pairs, the level being the lowest one accepted.
+	Requirements string `query:"requirements" json:"requirements" validate:"required" example:"3f0e0f0c-8d4a-4a4e-9d44-8d7b0c6f5e21:4"`
+	Limit        int    `query:"limit" json:"limit" validate:"omitempty,min=1,max=50"`
+}
+
+type skillMatchSkillResponse struct {
+	SkillID      uuid.UUID   `json:"skill_id"`
+	SkillName    string      `json:"skill_name"`
+	Level        int32       `json:"level"`
+	LastUsedOn   db.NullDate `json:"last_used_on" swaggertype:"string" format:"date"`
+	Endorsements int32       `json:"endorsements"`
+	// Met tells whether the level of the member reaches the level asked for.
+	Met bool `json:"met"`
+}
+
+type skillMatchResponse struct {
+	MemberID  uuid.UUID `json:"member_id"`
+	FirstName string    `json:"first_name"`
+	LastName  string    `json:"last_name"`
+	// Matched counts the requirements the member meets, out of Required.
+	Matched  int32 `json:"matched"`
+	Required int32 `json:"required"`
+	// Score averages the level of the member over the level asked for, rounded to the hundredth.
+	Score        float64                   `json:"score"`
+	Endorsements int32                     `json:"endorsements"`
+	Skills       []skillMatchSkillResponse `json:"skills"`
+}
+
+func newSkillMatchResponse(match db.SkillMatch, requirements []db.SkillRequirement) skillMatchResponse {
+	minLevels := make(map[uuid.UUID]int32, len(requirements))
+	for _, requirement := range requirements {
+		minLevels[requirement.SkillID] = requirement.MinLevel
+	}
+
+	rsp := skillMatchResponse{
+		MemberID:     match.MemberID,
+		FirstName:    match.FirstName,
+		LastName:     match.LastName,
+		Matched:      match.Matched,
+		Required:     int32(len(requirements)),
+		Score:        math.Round(match.Score*100) / 100,
+		Endorsements: match.Endorsements,
+		Skills:       make([]skillMatchSkillResponse, 0, len(match.Skills)),
+	}
+	for _, skill := range match.Skills {
+		rsp.Skills = append(rsp.Skills, skillMatchSkillResponse{
+			SkillID:      skill.SkillID,
+			SkillName:    skill.SkillName,
+			Level:        skill.Level,
+			LastUsedOn:   db.NullDate{NullTime: skill.LastUsedOn},
+			Endorsements: skill.Endorsements,
+			Met:          skill.Level >= minLevels[skill.SkillID],
+		})
+	}
+	return rsp
+}
+
+// @Summary      Search members by skills
+// @Description  Finds the members having any of the required skills, such as Go at level 4 or more and Postgres at level 3 or more.
+// @Description  Members meeting the most requirements come first, then those scoring the highest levels over the levels asked for,
+// @Description  then the most endorsed. Members in the trash or offboarded are left out.
+// @Tags         skills
+// @Param        query query searchSkillsRequestQuery true "query"
+// @Success      200 {array} skillMatchResponse
+// @Failure      400 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /skills/search [get]
+func (server *Server) searchMembersBySkills(c *fiber.Ctx) error {
+	query := new(searchSkillsRequestQuery)
+	if err := c.QueryParser(query); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	validate := newValidator()
+	if err := validate.Struct(query); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	requirements, err := parseSkillRequirements(query.Requirements)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	if query.Limit == 0 {
+		query.Limit = defaultSearchSkillsLimit
+	}
+
+	arg := db.SearchMembersBySkillsParams{
+		Requirements: requirements,
+		Limit:        query.Limit,
+	}
+
+	matches, err := server.store.SearchMembersBySkills(c.Context(), arg)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	rsp := make([]skillMatchResponse, 0, len(matches))
+	for _, match := range matches {
+		rsp = append(rsp, newSkillMatchResponse(match, requirements))
+	}
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
@@ -0,0 +1,531 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/lib/pq"
+	mockdb "github.com/ot07/coworker-backend/db/mock"
+	db "github.com/ot07/coworker-backend/db/sqlc"
+	"github.com/ot07/coworker-backend/util"
+	"github.com/stretchr/testify/require"
+)
+
+func randomSkill() db.Skill {
+	return db.Skill{
+		ID:        util.RandomUUID(),
+		Name:      util.RandomName(),
+		CreatedAt: time.Now().UTC().Truncate(time.Second),
+	}
+}
+
+func TestParseSkillRequirements(t *testing.T) {
+	t.Parallel()
+
+	golang := util.RandomUUID()
+	postgres := util.RandomUUID()
+
+	requirements, err := parseSkillRequirements(fmt.Sprintf("%s:4, %s:3", golang, postgres))
+	require.NoError(t, err)
+	require.Equal(t, []db.SkillRequirement{
+		{SkillID: golang, MinLevel: 4},
+		{SkillID: postgres, MinLevel: 3},
+	}, requirements)
+
+	for _, value := range []string{
+		golang.String(),
+		golang.String() + ":0",
+		golang.String() + ":6",
+		"go:4",
+	} {
+		_, err = parseSkillRequirements(value)
+		require.ErrorIs(t, err, errSkillRequirements, value)
+	}
+
+	_, err = parseSkillRequirements(fmt.Sprintf("%s:4,%s:2", golang, golang))
+	require.ErrorIs(t, err, errDuplicateSkill)
+}
+
+func TestCreateSkillAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	skill := randomSkill()
+
+	testCases := []struct {
+		name          string
+		body          fiber.Map
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name: "OK",
+			body: fiber.Map{
+				"name": skill.Name,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateSkill(gomock.Any(), gomock.Eq(skill.Name)).
+					Times(1).
+					Return(skill, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+
+				data, err := io.ReadAll(response.Body)
+				require.NoError(t, err)
+
+				var got skillResponse
+				err = json.Unmarshal(data, &got)
+				require.NoError(t, err)
+				require.Equal(t, skill.ID, got.ID)
+				require.Equal(t, skill.Name, got.Name)
+			},
+		},
+		{
+			name: "DuplicateName",
+			body: fiber.Map{
+				"name": skill.Name,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateSkill(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Skill{}, &pq.Error{Code: "23505"})
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusForbidden, response.StatusCode)
+			},
+		},
+		{
+			name: "MissingName",
+			body: fiber.Map{},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateSkill(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			buildValidSessionStubs(store, session)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/api/v1/skills", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			request.Header.Set("Content-Type", "application/json")
+
+			addSessionTokenInCookie(request, session.SessionToken.String())
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func TestSetMemberSkillAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	member := randomMember()
+	deleted := member
+	deleted.DeletedAt = sql.NullTime{Time: time.Now().UTC().Truncate(time.Second), Valid: true}
+	skill := randomSkill()
+	lastUsedOn := time.Date(2023, 8, 7, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name          string
+		body          fiber.Map
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name: "OK",
+			body: fiber.Map{
+				"level":        4,
+				"last_used_on": "2023-08-07",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetMember(gomock.Any(), gomock.Eq(member.ID)).
+					Times(1).
+					Return(member, nil)
+				store.EXPECT().
+					GetSkill(gomock.Any(), gomock.Eq(skill.ID)).
+					Times(1).
+					Return(skill, nil)
+
+				arg := db.UpsertMemberSkillParams{
+					MemberID:   member.ID,
+					SkillID:    skill.ID,
+					Level:      4,
+					LastUsedOn: sql.NullTime{Time: lastUsedOn, Valid: true},
+				}
+				store.EXPECT().
+					UpsertMemberSkill(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.MemberSkill{MemberID: member.ID, SkillID: skill.ID, Level: 4}, nil)
+
+				listArg := db.ListMemberSkillsParams{
+					UserID:   session.UserID,
+					MemberID: member.ID,
+				}
+				store.EXPECT().
+					ListMemberSkills(gomock.Any(), gomock.Eq(listArg)).
+					Times(1).
+					Return([]db.ListMemberSkillsRow{{
+						SkillID:      skill.ID,
+						SkillName:    skill.Name,
+						Level:        4,
+						LastUsedOn:   sql.NullTime{Time: lastUsedOn, Valid: true},
+						Endorsements: 2,
+						Endorsed:     true,
+					}}, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+
+				data, err := io.ReadAll(response.Body)
+				require.NoError(t, err)
+
+				var got []memberSkillResponse
+				err = json.Unmarshal(data, &got)
+				require.NoError(t, err)
+				require.Len(t, got, 1)
+				require.Equal(t, skill.Name, got[0].SkillName)
+				require.Equal(t, int32(4), got[0].Level)
+				require.True(t, lastUsedOn.Equal(got[0].LastUsedOn.Time))
+				require.Equal(t, int32(2), got[0].Endorsements)
+				require.True(t, got[0].Endorsed)
+			},
+		},
+		{
+			name: "MemberInTrash",
+			body: fiber.Map{
+				"level": 4,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetMember(gomock.Any(), gomock.Eq(member.ID)).
+					Times(1).
+					Return(deleted, nil)
+				store.EXPECT().
+					UpsertMemberSkill(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusNotFound, response.StatusCode)
+			},
+		},
+		{
+			name: "SkillNotFound",
+			body: fiber.Map{
+				"level": 4,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetMember(gomock.Any(), gomock.Eq(member.ID)).
+					Times(1).
+					Return(member, nil)
+				store.EXPECT().
+					GetSkill(gomock.Any(), gomock.Eq(skill.ID)).
+					Times(1).
+					Return(db.Skill{}, sql.ErrNoRows)
+				store.EXPECT().
+					UpsertMemberSkill(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusNotFound, response.StatusCode)
+			},
+		},
+		{
+			name: "InvalidLevel",
+			body: fiber.Map{
+				"level": 6,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UpsertMemberSkill(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			buildValidSessionStubs(store, session)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/api/v1/members/%s/skills/%s", member.ID, skill.ID)
+			request, err := http.NewRequest(http.MethodPut, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			request.Header.Set("Content-Type", "application/json")
+
+			addSessionTokenInCookie(request, session.SessionToken.String())
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func TestEndorseMemberSkillAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	member := randomMember()
+	skill := randomSkill()
+	arg := db.EndorseMemberSkillTxParams{
+		MemberID: member.ID,
+		SkillID:  skill.ID,
+		UserID:   session.UserID,
+	}
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					EndorseMemberSkillTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(nil)
+				store.EXPECT().
+					ListMemberSkills(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.ListMemberSkillsRow{{SkillID: skill.ID, SkillName: skill.Name, Level: 3, Endorsements: 1, Endorsed: true}}, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+
+				data, err := io.ReadAll(response.Body)
+				require.NoError(t, err)
+
+				var got []memberSkillResponse
+				err = json.Unmarshal(data, &got)
+				require.NoError(t, err)
+				require.Len(t, got, 1)
+				require.True(t, got[0].Endorsed)
+				require.False(t, got[0].LastUsedOn.Valid)
+			},
+		},
+		{
+			name: "SelfEndorsement",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					EndorseMemberSkillTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.ErrSelfEndorsement)
+				store.EXPECT().
+					ListMemberSkills(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusForbidden, response.StatusCode)
+			},
+		},
+		{
+			name: "SkillNotHeld",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					EndorseMemberSkillTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusNotFound, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			buildValidSessionStubs(store, session)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			url := fmt.Sprintf("/api/v1/members/%s/skills/%s/endorsement", member.ID, skill.ID)
+			request, err := http.NewRequest(http.MethodPut, url, nil)
+			require.NoError(t, err)
+
+			addSessionTokenInCookie(request, session.SessionToken.String())
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func TestSearchMembersBySkillsAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	golang := randomSkill()
+	postgres := randomSkill()
+	requirements := []db.SkillRequirement{
+		{SkillID: golang.ID, MinLevel: 4},
+		{SkillID: postgres.ID, MinLevel: 3},
+	}
+	match := db.SkillMatch{
+		MemberID:     util.RandomUUID(),
+		FirstName:    "Grace",
+		LastName:     "Hopper",
+		Matched:      1,
+		Score:        2.0 / 3,
+		Endorsements: 2,
+		Skills: []db.ListSkillSearchRowsRow{
+			{SkillID: golang.ID, SkillName: golang.Name, Level: 4, Endorsements: 2},
+			{SkillID: postgres.ID, SkillName: postgres.Name, Level: 1},
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		query         string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name:  "OK",
+			query: fmt.Sprintf("requirements=%s:4,%s:3", golang.ID, postgres.ID),
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.SearchMembersBySkillsParams{
+					Requirements: requirements,
+					Limit:        defaultSearchSkillsLimit,
+				}
+				store.EXPECT().
+					SearchMembersBySkills(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return([]db.SkillMatch{match}, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+
+				data, err := io.ReadAll(response.Body)
+				require.NoError(t, err)
+
+				var got []skillMatchResponse
+				err = json.Unmarshal(data, &got)
+				require.NoError(t, err)
+				require.Len(t, got, 1)
+				require.Equal(t, match.MemberID, got[0].MemberID)
+				require.Equal(t, int32(1), got[0].Matched)
+				require.Equal(t, int32(2), got[0].Required)
+				require.Equal(t, 0.67, got[0].Score)
+				require.Len(t, got[0].Skills, 2)
+				require.True(t, got[0].Skills[0].Met)
+				require.False(t, got[0].Skills[1].Met)
+			},
+		},
+		{
+			name:  "InvalidLevel",
+			query: fmt.Sprintf("requirements=%s:7", golang.ID),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					SearchMembersBySkills(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name:  "MissingRequirements",
+			query: "limit=10",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					SearchMembersBySkills(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			buildValidSessionStubs(store, session)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			request, err := http.NewRequest(http.MethodGet, "/api/v1/skills/search?"+tc.query, nil)
+			require.NoError(t, err)
+
+			addSessionTokenInCookie(request, session.SessionToken.String())
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
@@ -0,0 +1,396 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	db "github.com/ot07/coworker-backend/db/sqlc"
+)
+
+type tagResponse struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func newTagResponse(tag db.Tag) tagResponse {
+	return tagResponse{
+		ID:        tag.ID,
+		Name:      tag.Name,
+		CreatedAt: tag.CreatedAt,
+	}
+}
+
+// memberTags looks up the tags of all the given members at once, keyed by member ID.
+func (server *Server) memberTags(ctx context.Context, members []db.Member) (map[uuid.UUID][]tagResponse, error) {
+	tags := make(map[uuid.UUID][]tagResponse, len(members))
+	if len(members) == 0 {
+		return tags, nil
+	}
+
+	memberIDs := make([]uuid.UUID, 0, len(members))
+	for _, member := range members {
+		memberIDs = append(memberIDs, member.ID)
+	}
+
+	rows, err := server.store.ListTagsByMemberIDs(ctx, memberIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		tags[row.MemberID] = append(tags[row.MemberID], tagResponse{
+			ID:        row.ID,
+			Name:      row.Name,
+			CreatedAt: row.CreatedAt,
+		})
+	}
+	return tags, nil
+}
+
+// newMemberResponsesWithTags converts the members into responses holding their tags.
+func (server *Server) newMemberResponsesWithTags(ctx context.Context, members []db.Member) (membersResponse, error) {
+	tags, err := server.memberTags(ctx, members)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := newMembersResponse(members)
+	for i := range rsp {
+		if memberTags, ok := tags[rsp[i].ID]; ok {
+			rsp[i].Tags = memberTags
+		}
+	}
+	return rsp, nil
+}
+
+// newMemberResponseWithTags converts the member into a response holding its tags.
+func (server *Server) newMemberResponseWithTags(ctx context.Context, member db.Member) (memberResponse, error) {
+	rsp, err := server.newMemberResponsesWithTags(ctx, []db.Member{member})
+	if err != nil {
+		return memberResponse{}, err
+	}
+	return rsp[0], nil
+}
+
+type createTagRequest struct {
+	Name string `json:"name" validate:"required,max=50" example:"Remote"`
+}
+
+// @Summary      Create tag
+// @Description  Tag names are unique regardless of case.
+// @Tags         tags
+// @Param        body body createTagRequest true "Tag object"
+// @Success      200 {object} tagResponse
+// @Failure      400 {object} errorResponse
+// @Failure      403 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /tags [post]
+func (server *Server) createTag(c *fiber.Ctx) error {
+	req := new(createTagRequest)
+	if err := c.BodyParser(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	validate := newValidator()
+	if err := validate.Struct(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	tag, err := server.store.CreateTag(c.Context(), req.Name)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok {
+			switch pqErr.Code.Name() {
+			case "unique_violation":
+				return c.Status(fiber.StatusForbidden).JSON(newErrorResponse(err))
+			}
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	rsp := newTagResponse(tag)
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+// @Summary      List tags
+// @Tags         tags
+// @Success      200 {array} tagResponse
+// @Failure      500 {object} errorResponse
+// @Router       /tags [get]
+func (server *Server) listTags(c *fiber.Ctx) error {
+	tags, err := server.store.ListTags(c.Context())
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	rsp := make([]tagResponse, 0, len(tags))
+	for _, tag := range tags {
+		rsp = append(rsp, newTagResponse(tag))
+	}
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+type renameTagRequestParams struct {
+	ID uuid.UUID `params:"id"`
+}
+
+type renameTagRequestBody struct {
+	Name string `json:"name" validate:"required,max=50" example:"Remote"`
+}
+
+// @Summary      Rename tag
+// @Tags         tags
+// @Param        id   path string               true "Tag ID"
+// @Param        body body renameTagRequestBody true "Tag object"
+// @Success      200 {object} tagResponse
+// @Failure      400 {object} errorResponse
+// @Failure      403 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /tags/{id} [put]
+func (server *Server) renameTag(c *fiber.Ctx) error {
+	params := new(renameTagRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	body := new(renameTagRequestBody)
+	if err := c.BodyParser(body); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	validate := newValidator()
+	if err := validate.Struct(body); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	tag, err := server.store.RenameTag(c.Context(), db.RenameTagParams{ID: params.ID, Name: body.Name})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return c.Status(fiber.StatusNotFound).JSON(newErrorResponse(err))
+		}
+		if pqErr, ok := err.(*pq.Error); ok {
+			switch pqErr.Code.Name() {
+			case "unique_violation":
+				return c.Status(fiber.StatusForbidden).JSON(newErrorResponse(err))
+			}
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	rsp := newTagResponse(tag)
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+type deleteTagRequest struct {
+	ID uuid.UUID `params:"id"`
+}
+
+// @Summary      Delete tag
+// @Description  Deletes the tag and detaches it from every member.
+// @Tags         tags
+// @Param        id path string true "Tag ID"
+// @Success      204 {object} nil
+// @Failure      400 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /tags/{id} [delete]
+func (server *Server) deleteTag(c *fiber.Ctx) error {
+	req := new(deleteTagRequest)
+	if err := c.ParamsParser(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	_, err := server.store.DeleteTag(c.Context(), req.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return c.Status(fiber.StatusNotFound).JSON(newErrorResponse(err))
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	return c.Status(fiber.StatusNoContent).JSON(nil)
+}
+
+type memberTagRequest struct {
+	ID    uuid.UUID `params:"id"`
+	TagID uuid.UUID `params:"tag_id"`
+}
+
+// @Summary      Attach tag to member
+// @Description  Attaching a tag the member already has is a no-op.
+// @Tags         members
+// @Param        id     path string true "Member ID"
+// @Param        tag_id path string true "Tag ID"
+// @Success      200 {object} memberResponse
+// @Failure      400 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /members/{id}/tags/{tag_id} [put]
+func (server *Server) attachMemberTag(c *fiber.Ctx) error {
+	req := new(memberTagRequest)
+	if err := c.ParamsParser(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	member, err := server.store.GetMember(c.Context(), req.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return c.Status(fiber.StatusNotFound).JSON(newErrorResponse(err))
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	_, err = server.store.GetTag(c.Context(), req.TagID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return c.Status(fiber.StatusNotFound).JSON(newErrorResponse(err))
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	arg := db.AddMemberTagsParams{
+		MemberIds: []uuid.UUID{req.ID},
+		TagIds:    []uuid.UUID{req.TagID},
+	}
+	if _, err := server.store.AddMemberTags(c.Context(), arg); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	rsp, err := server.newMemberResponseWithTags(c.Context(), member)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+// @Summary      Detach tag from member
+// @Description  Detaching a tag the member does not have is a no-op.
+// @Tags         members
+// @Param        id     path string true "Member ID"
+// @Param        tag_id path string true "Tag ID"
+// @Success      200 {object} memberResponse
+// @Failure      400 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /members/{id}/tags/{tag_id} [delete]
+func (server *Server) detachMemberTag(c *fiber.Ctx) error {
+	req := new(memberTagRequest)
+	if err := c.ParamsParser(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	member, err := server.store.GetMember(c.Context(), req.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return c.Status(fiber.StatusNotFound).JSON(newErrorResponse(err))
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	arg := db.RemoveMemberTagsParams{
+		MemberIds: []uuid.UUID{req.ID},
+		TagIds:    []uuid.UUID{req.TagID},
+	}
+	if _, err := server.store.RemoveMemberTags(c.Context(), arg); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	rsp, err := server.newMemberResponseWithTags(c.Context(), member)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+type bulkMemberTagsRequest struct {
+	IDs    string `query:"ids" json:"ids" validate:"required"`
+	TagIDs string `query:"tag_ids" json:"tag_ids" validate:"required"`
+}
+
+// memberAndTagIDs parses the comma separated member and tag IDs of the request.
+func (req *bulkMemberTagsRequest) memberAndTagIDs() ([]uuid.UUID, []uuid.UUID, error) {
+	memberIDs, err := memberIDsFromCommaSeparatedString(req.IDs)
+	if err != nil {
+		return nil, nil, err
+	}
+	tagIDs, err := memberIDsFromCommaSeparatedString(req.TagIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+	return memberIDs, tagIDs, nil
+}
+
+// @Summary      Tag members
+// @Description  Attaches every tag to every member. IDs of members in the trash or that do not exist are ignored,
+// @Description  as are IDs of tags that do not exist.
+// @Tags         members
+// @Param        query query bulkMemberTagsRequest true "query"
+// @Success      204 {object} nil
+// @Failure      400 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /members/tags [post]
+func (server *Server) addMembersTags(c *fiber.Ctx) error {
+	req := new(bulkMemberTagsRequest)
+	if err := c.QueryParser(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	validate := newValidator()
+	if err := validate.Struct(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	memberIDs, tagIDs, err := req.memberAndTagIDs()
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	arg := db.AddMemberTagsParams{
+		MemberIds: memberIDs,
+		TagIds:    tagIDs,
+	}
+	if _, err := server.store.AddMemberTags(c.Context(), arg); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	return c.Status(fiber.StatusNoContent).JSON(nil)
+}
+
+// @Summary      Untag members
+// @Description  Detaches every tag from every member.
+// @Tags         members
+// @Param        query query bulkMemberTagsRequest true "query"
+// @Success      204 {object} nil
+// @Failure      400 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /members/tags [delete]
+func (server *Server) removeMembersTags(c *fiber.Ctx) error {
+	req := new(bulkMemberTagsRequest)
+	if err := c.QueryParser(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	validate := newValidator()
+	if err := validate.Struct(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	memberIDs, tagIDs, err := req.memberAndTagIDs()
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	arg := db.RemoveMemberTagsParams{
+		MemberIds: memberIDs,
+		TagIds:    tagIDs,
+	}
+	if _, err := server.store.RemoveMemberTags(c.Context(), arg); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	return c.Status(fiber.StatusNoContent).JSON(nil)
+}
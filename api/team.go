@@ -0,0 +1,508 @@
+package api
+
+import (
+	"database/sql"
+	"math"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	db "github.com/ot07/coworker-backend/db/sqlc"
+)
+
+const (
+	teamRoleLead   = "lead"
+	teamRoleMember = "member"
+)
+
+type teamResponse struct {
+	ID        uuid.UUID     `json:"id"`
+	Name      string        `json:"name"`
+	ParentID  uuid.NullUUID `json:"parent_id" swaggertype:"string"`
+	CreatedAt time.Time     `json:"created_at"`
+}
+
+func newTeamResponse(team db.Team) teamResponse {
+	return teamResponse{
+		ID:        team.ID,
+		Name:      team.Name,
+		ParentID:  team.ParentID,
+		CreatedAt: team.CreatedAt,
+	}
+}
+
+type teamMembershipResponse struct {
+	TeamID    uuid.UUID `json:"team_id"`
+	MemberID  uuid.UUID `json:"member_id"`
+	Role      string    `json:"role"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func newTeamMembershipResponse(teamMember db.TeamMember) teamMembershipResponse {
+	return teamMembershipResponse{
+		TeamID:    teamMember.TeamID,
+		MemberID:  teamMember.MemberID,
+		Role:      teamMember.Role,
+		CreatedAt: teamMember.CreatedAt,
+	}
+}
+
+// teamStatus tells which status to respond with for an error writing a team.
+// A parent team that does not exist is a problem of the request body rather than of the URL.
+func teamStatus(err error) int {
+	if err == sql.ErrNoRows {
+		return fiber.StatusNotFound
+	}
+	if err == db.ErrTeamCycle {
+		return fiber.StatusBadRequest
+	}
+	if pqErr, ok := err.(*pq.Error); ok {
+		switch pqErr.Code.Name() {
+		case "foreign_key_violation":
+			return fiber.StatusBadRequest
+		}
+	}
+	return fiber.StatusInternalServerError
+}
+
+type createTeamRequest struct {
+	Name     string        `json:"name" validate:"required,max=100" example:"Platform"`
+	ParentID uuid.NullUUID `json:"parent_id" swaggertype:"string"`
+}
+
+// @Summary      Create team
+// @Description  Teams form a tree through parent_id; a team without a parent is at the top level.
+// @Tags         teams
+// @Param        body body createTeamRequest true "Team object"
+// @Success      200 {object} teamResponse
+// @Failure      400 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /teams [post]
+func (server *Server) createTeam(c *fiber.Ctx) error {
+	req := new(createTeamRequest)
+	if err := c.BodyParser(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	validate := newValidator()
+	if err := validate.Struct(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	arg := db.CreateTeamParams{
+		Name:     req.Name,
+		ParentID: req.ParentID,
+	}
+
+	team, err := server.store.CreateTeam(c.Context(), arg)
+	if err != nil {
+		return c.Status(teamStatus(err)).JSON(newErrorResponse(err))
+	}
+
+	rsp := newTeamResponse(team)
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+// @Summary      List teams
+// @Tags         teams
+// @Success      200 {array} teamResponse
+// @Failure      500 {object} errorResponse
+// @Router       /teams [get]
+func (server *Server) listTeams(c *fiber.Ctx) error {
+	teams, err := server.store.ListTeams(c.Context())
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	rsp := make([]teamResponse, 0, len(teams))
+	for _, team := range teams {
+		rsp = append(rsp, newTeamResponse(team))
+	}
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+type getTeamRequest struct {
+	ID uuid.UUID `params:"id"`
+}
+
+// @Summary      Get team
+// @Tags         teams
+// @Param        id path string true "Team ID"
+// @Success      200 {object} teamResponse
+// @Failure      400 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /teams/{id} [get]
+func (server *Server) getTeam(c *fiber.Ctx) error {
+	req := new(getTeamRequest)
+	if err := c.ParamsParser(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	team, err := server.store.GetTeam(c.Context(), req.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return c.Status(fiber.StatusNotFound).JSON(newErrorResponse(err))
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	rsp := newTeamResponse(team)
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+type updateTeamRequestParams struct {
+	ID uuid.UUID `params:"id"`
+}
+
+type updateTeamRequestBody struct {
+	Name     string        `json:"name" validate:"required,max=100" example:"Platform"`
+	ParentID uuid.NullUUID `json:"parent_id" swaggertype:"string"`
+}
+
+// @Summary      Update team
+// @Description  Replaces the name and parent of the team. Moving a team under itself or one of its subteams is refused.
+// @Tags         teams
+// @Param        id   path string                true "Team ID"
+// @Param        body body updateTeamRequestBody true "Team object"
+// @Success      200 {object} teamResponse
+// @Failure      400 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /teams/{id} [put]
+func (server *Server) updateTeam(c *fiber.Ctx) error {
+	params := new(updateTeamRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	body := new(updateTeamRequestBody)
+	if err := c.BodyParser(body); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	validate := newValidator()
+	if err := validate.Struct(body); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	arg := db.UpdateTeamParams{
+		ID:       params.ID,
+		Name:     body.Name,
+		ParentID: body.ParentID,
+	}
+
+	team, err := server.store.UpdateTeamTx(c.Context(), arg)
+	if err != nil {
+		return c.Status(teamStatus(err)).JSON(newErrorResponse(err))
+	}
+
+	rsp := newTeamResponse(team)
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+type deleteTeamRequest struct {
+	ID uuid.UUID `params:"id"`
+}
+
+// @Summary      Delete team
+// @Description  Deletes the team without deleting its members. Its subteams are moved up to its parent.
+// @Tags         teams
+// @Param        id path string true "Team ID"
+// @Success      204 {object} nil
+// @Failure      400 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /teams/{id} [delete]
+func (server *Server) deleteTeam(c *fiber.Ctx) error {
+	req := new(deleteTeamRequest)
+	if err := c.ParamsParser(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	_, err := server.store.DeleteTeamTx(c.Context(), req.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return c.Status(fiber.StatusNotFound).JSON(newErrorResponse(err))
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	return c.Status(fiber.StatusNoContent).JSON(nil)
+}
+
+type listTeamMembersRequestParams struct {
+	ID uuid.UUID `params:"id"`
+}
+
+type listTeamMembersRequestQuery struct {
+	PageID    int32 `query:"page_id" json:"page_id" validate:"required,min=1"`
+	PageSize  int32 `query:"page_size" json:"page_size" validate:"required,min=5,max=10"`
+	Recursive bool  `query:"recursive" json:"recursive"`
+}
+
+type teamMemberResponse struct {
+	memberResponse
+	TeamID uuid.UUID `json:"team_id"`
+	Role   string    `json:"role"`
+}
+
+type listTeamMembersResponse struct {
+	Meta listMembersResponseMeta `json:"meta"`
+	Data []teamMemberResponse    `json:"data"`
+}
+
+// @Summary      List team members
+// @Description  With recursive=true the members of all the subteams are listed as well,
+// @Description  once for every team they belong to. team_id tells which team that is.
+// @Tags         teams
+// @Param        id    path  string                      true "Team ID"
+// @Param        query query listTeamMembersRequestQuery true "query"
+// @Success      200 {object} listTeamMembersResponse
+// @Failure      400 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /teams/{id}/members [get]
+func (server *Server) listTeamMembers(c *fiber.Ctx) error {
+	params := new(listTeamMembersRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	query := new(listTeamMembersRequestQuery)
+	if err := c.QueryParser(query); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	validate := newValidator()
+	if err := validate.Struct(query); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	if _, err := server.store.GetTeam(c.Context(), params.ID); err != nil {
+		if err == sql.ErrNoRows {
+			return c.Status(fiber.StatusNotFound).JSON(newErrorResponse(err))
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	arg := db.ListTeamMembersParams{
+		Limit:     query.PageSize,
+		Offset:    (query.PageID - 1) * query.PageSize,
+		TeamID:    params.ID,
+		Recursive: query.Recursive,
+	}
+
+	rows, err := server.store.ListTeamMembers(c.Context(), arg)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	totalCount, err := server.store.CountTeamMembers(c.Context(), db.CountTeamMembersParams{
+		TeamID:    params.ID,
+		Recursive: query.Recursive,
+	})
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	members := make([]db.Member, 0, len(rows))
+	for _, row := range rows {
+		members = append(members, db.Member{
+			ID:           row.ID,
+			FirstName:    row.FirstName,
+			LastName:     row.LastName,
+			Email:        row.Email,
+			CreatedAt:    row.CreatedAt,
+			CustomFields: row.CustomFields,
+			ManagerID:    row.ManagerID,
+			AvatarUrl:    row.AvatarUrl,
+			Status:       row.Status,
+			StartDate:    row.StartDate,
+			EndDate:      row.EndDate,
+			TimeZone:     row.TimeZone,
+		})
+	}
+
+	memberResponses, err := server.newMemberResponsesWithTags(c.Context(), members)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	data := make([]teamMemberResponse, 0, len(rows))
+	for i, row := range rows {
+		data = append(data, teamMemberResponse{
+			memberResponse: memberResponses[i],
+			TeamID:         row.TeamID,
+			Role:           row.Role,
+		})
+	}
+
+	pageCount := int64(math.Ceil(float64(totalCount) / float64(query.PageSize)))
+
+	rsp := listTeamMembersResponse{
+		Meta: listMembersResponseMeta{
+			PageID:     query.PageID,
+			PageSize:   query.PageSize,
+			PageCount:  pageCount,
+			TotalCount: totalCount,
+		},
+		Data: data,
+	}
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+type teamMemberRequestParams struct {
+	ID       uuid.UUID `params:"id"`
+	MemberID uuid.UUID `params:"member_id"`
+}
+
+type addTeamMemberRequestBody struct {
+	Role string `json:"role" validate:"omitempty,oneof=lead member" enums:"lead,member" default:"member"`
+}
+
+// @Summary      Add team member
+// @Description  Adds the member to the team, or changes its role when it already belongs to the team.
+// @Tags         teams
+// @Param        id        path string                   true  "Team ID"
+// @Param        member_id path string                   true  "Member ID"
+// @Param        body      body addTeamMemberRequestBody false "Membership object"
+// @Success      200 {object} teamMembershipResponse
+// @Failure      400 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /teams/{id}/members/{member_id} [put]
+func (server *Server) addTeamMember(c *fiber.Ctx) error {
+	params := new(teamMemberRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	body := new(addTeamMemberRequestBody)
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(body); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+		}
+	}
+
+	validate := newValidator()
+	if err := validate.Struct(body); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	if len(body.Role) == 0 {
+		body.Role = teamRoleMember
+	}
+
+	if _, err := server.store.GetMember(c.Context(), params.MemberID); err != nil {
+		if err == sql.ErrNoRows {
+			return c.Status(fiber.StatusNotFound).JSON(newErrorResponse(err))
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	arg := db.AddTeamMemberParams{
+		TeamID:   params.ID,
+		MemberID: params.MemberID,
+		Role:     body.Role,
+	}
+
+	teamMember, err := server.store.AddTeamMember(c.Context(), arg)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok {
+			switch pqErr.Code.Name() {
+			case "foreign_key_violation":
+				return c.Status(fiber.StatusNotFound).JSON(newErrorResponse(err))
+			}
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	rsp := newTeamMembershipResponse(teamMember)
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+// @Summary      Remove team member
+// @Description  Removes the member from the team without deleting the member.
+// @Tags         teams
+// @Param        id        path string true "Team ID"
+// @Param        member_id path string true "Member ID"
+// @Success      204 {object} nil
+// @Failure      400 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /teams/{id}/members/{member_id} [delete]
+func (server *Server) removeTeamMember(c *fiber.Ctx) error {
+	params := new(teamMemberRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	arg := db.RemoveTeamMemberParams{
+		TeamID:   params.ID,
+		MemberID: params.MemberID,
+	}
+
+	removed, err := server.store.RemoveTeamMember(c.Context(), arg)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+	if removed == 0 {
+		return c.Status(fiber.StatusNotFound).JSON(newErrorResponse(sql.ErrNoRows))
+	}
+
+	return c.Status(fiber.StatusNoContent).JSON(nil)
+}
+
+type moveTeamMemberRequestBody struct {
+	TeamID uuid.UUID `json:"team_id" validate:"required"`
+	Role   string    `json:"role" validate:"omitempty,oneof=lead member" enums:"lead,member" default:"member"`
+}
+
+// @Summary      Move team member
+// @Description  Moves the member from the team to the team given in the body.
+// @Tags         teams
+// @Param        id        path string                    true "Team ID"
+// @Param        member_id path string                    true "Member ID"
+// @Param        body      body moveTeamMemberRequestBody true "Target team"
+// @Success      200 {object} teamMembershipResponse
+// @Failure      400 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /teams/{id}/members/{member_id}/move [post]
+func (server *Server) moveTeamMember(c *fiber.Ctx) error {
+	params := new(teamMemberRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	body := new(moveTeamMemberRequestBody)
+	if err := c.BodyParser(body); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	validate := newValidator()
+	if err := validate.Struct(body); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	if len(body.Role) == 0 {
+		body.Role = teamRoleMember
+	}
+
+	arg := db.MoveTeamMemberTxParams{
+		FromTeamID: params.ID,
+		ToTeamID:   body.TeamID,
+		MemberID:   params.MemberID,
+		Role:       body.Role,
+	}
+
+	teamMember, err := server.store.MoveTeamMemberTx(c.Context(), arg)
+	if err != nil {
+		return c.Status(teamStatus(err)).JSON(newErrorResponse(err))
+	}
+
+	rsp := newTeamMembershipResponse(teamMember)
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
@@ -0,0 +1,522 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"math"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	db "github.com/ot07/coworker-backend/db/sqlc"
+)
+
+var (
+	errTimeEntryTimes   = errors.New("a time entry is given either as started_at, and ended_at unless it is running, or as date and minutes")
+	errTimeEntryFuture  = errors.New("a timer cannot start in the future")
+	errTimeEntryOverlap = errors.New("the time entry overlaps another time entry of the member")
+)
+
+// timeEntryWriteStatus tells which status to respond with for an error writing a time entry or a timesheet.
+// Time entries overlapping each other and changes to the weeks of submitted timesheets are refused like other conflicting writes.
+func timeEntryWriteStatus(err error) int {
+	switch {
+	case err == sql.ErrNoRows:
+		return fiber.StatusNotFound
+	case err == db.ErrTimeEntryEndBeforeStart:
+		return fiber.StatusBadRequest
+	case errors.Is(err, db.ErrProjectArchived), errors.Is(err, db.ErrTimesheetLocked), err == db.ErrTimesheetNotSubmitted,
+		err == db.ErrTimerRunning, err == db.ErrNoRunningTimer, err == db.ErrNotTimesheetApprover:
+		return fiber.StatusForbidden
+	}
+	if pqErr, ok := err.(*pq.Error); ok {
+		switch pqErr.Code.Name() {
+		case "exclusion_violation", "unique_violation":
+			return fiber.StatusForbidden
+		case "check_violation":
+			return fiber.StatusBadRequest
+		}
+	}
+	return fiber.StatusInternalServerError
+}
+
+// timeEntryWriteError returns the error to respond with for an error writing a time entry.
+func timeEntryWriteError(err error) error {
+	if pqErr, ok := err.(*pq.Error); ok && pqErr.Code.Name() == "exclusion_violation" {
+		return errTimeEntryOverlap
+	}
+	return err
+}
+
+type timeEntryResponse struct {
+	ID        uuid.UUID `json:"id"`
+	MemberID  uuid.UUID `json:"member_id"`
+	ProjectID uuid.UUID `json:"project_id"`
+	// Date is the day the time is tracked on: the day in UTC an entry with a start and an end started on.
+	Date string `json:"date" format:"date"`
+	// StartedAt and EndedAt are null for an entry given as a duration.
+	StartedAt db.NullTime `json:"started_at" swaggertype:"string" format:"date-time"`
+	EndedAt   db.NullTime `json:"ended_at" swaggertype:"string" format:"date-time"`
+	// Minutes is the duration of the entry, null while its timer is running.
+	Minutes db.NullInt32 `json:"minutes" swaggertype:"integer"`
+	// Running tells that the timer of the entry has not been stopped yet.
+	Running   bool      `json:"running"`
+	Note      string    `json:"note"`
+	Billable  bool      `json:"billable"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func newTimeEntryResponse(entry db.TimeEntry) timeEntryResponse {
+	return timeEntryResponse{
+		ID:        entry.ID,
+		MemberID:  entry.MemberID,
+		ProjectID: entry.ProjectID,
+		Date:      entry.Date.Format(db.DateLayout),
+		StartedAt: db.NullTime{NullTime: entry.StartedAt},
+		EndedAt:   db.NullTime{NullTime: entry.EndedAt},
+		Minutes:   db.NullInt32{NullInt32: entry.Minutes},
+		Running:   entry.StartedAt.Valid && !entry.EndedAt.Valid,
+		Note:      entry.Note,
+		Billable:  entry.Billable,
+		CreatedAt: entry.CreatedAt,
+	}
+}
+
+type timeEntryRequest struct {
+	ProjectID uuid.UUID `json:"project_id" validate:"required"`
+	// StartedAt and EndedAt give the entry as a range of time. An entry without an end is a running timer.
+	StartedAt *time.Time `json:"started_at"`
+	EndedAt   *time.Time `json:"ended_at"`
+	// Date and Minutes give the entry as a duration on a day instead.
+	Date    string `json:"date" validate:"omitempty,datetime=2006-01-02" format:"date"`
+	Minutes int32  `json:"minutes" validate:"min=0,max=1440" example:"90"`
+	Note    string `json:"note" validate:"max=500"`
+	// Billable defaults to what the project is.
+	Billable *bool `json:"billable"`
+}
+
+// times returns the date, the start, the end and the minutes of the entry, checking that it is given either
+// as a range of time or as a duration.
+func (req *timeEntryRequest) times() (date time.Time, startedAt, endedAt sql.NullTime, minutes sql.NullInt32, err error) {
+	if req.StartedAt != nil {
+		if len(req.Date) > 0 || req.Minutes != 0 {
+			return date, startedAt, endedAt, minutes, errTimeEntryTimes
+		}
+		startedAt = sql.NullTime{Time: req.StartedAt.UTC(), Valid: true}
+		if req.EndedAt != nil {
+			endedAt = sql.NullTime{Time: req.EndedAt.UTC(), Valid: true}
+		} else if req.StartedAt.After(time.Now()) {
+			return date, startedAt, endedAt, minutes, errTimeEntryFuture
+		}
+		return date, startedAt, endedAt, minutes, nil
+	}
+
+	if req.EndedAt != nil || len(req.Date) == 0 || req.Minutes == 0 {
+		return date, startedAt, endedAt, minutes, errTimeEntryTimes
+	}
+	if date, err = time.Parse(db.DateLayout, req.Date); err != nil {
+		return date, startedAt, endedAt, minutes, err
+	}
+	return date, startedAt, endedAt, sql.NullInt32{Int32: req.Minutes, Valid: true}, nil
+}
+
+// timeEntryBillable tells whether a time entry is billable, looking up the default of the project when it is not given.
+func (server *Server) timeEntryBillable(c *fiber.Ctx, projectID uuid.UUID, billable *bool) (bool, error) {
+	if billable != nil {
+		return *billable, nil
+	}
+	project, err := server.store.GetProject(c.Context(), projectID)
+	if err != nil {
+		return false, err
+	}
+	return project.Billable, nil
+}
+
+type createTimeEntryRequest struct {
+	MemberID uuid.UUID `json:"member_id" validate:"required"`
+	timeEntryRequest
+}
+
+// @Summary      Create time entry
+// @Description  Tracks time of the member against a project, given either as started_at and ended_at, or as date and minutes.
+// @Description  An entry without an end is a running timer. Entries cannot overlap, nor be added to the week of a submitted
+// @Description  timesheet or to an archived project.
+// @Tags         time-tracking
+// @Param        body body createTimeEntryRequest true "Time entry object"
+// @Success      200 {object} timeEntryResponse
+// @Failure      400 {object} errorResponse
+// @Failure      403 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /time-entries [post]
+func (server *Server) createTimeEntry(c *fiber.Ctx) error {
+	req := new(createTimeEntryRequest)
+	if err := c.BodyParser(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	validate := newValidator()
+	if err := validate.Struct(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	date, startedAt, endedAt, minutes, err := req.times()
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	billable, err := server.timeEntryBillable(c, req.ProjectID, req.Billable)
+	if err != nil {
+		return c.Status(timeEntryWriteStatus(err)).JSON(newErrorResponse(err))
+	}
+
+	arg := db.CreateTimeEntryParams{
+		MemberID:  req.MemberID,
+		ProjectID: req.ProjectID,
+		Date:      date,
+		StartedAt: startedAt,
+		EndedAt:   endedAt,
+		Minutes:   minutes,
+		Note:      req.Note,
+		Billable:  billable,
+	}
+
+	entry, err := server.store.CreateTimeEntryTx(c.Context(), arg)
+	if err != nil {
+		return c.Status(timeEntryWriteStatus(err)).JSON(newErrorResponse(timeEntryWriteError(err)))
+	}
+
+	rsp := newTimeEntryResponse(entry)
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+type listTimeEntriesRequest struct {
+	PageID    int32  `query:"page_id" json:"page_id" validate:"required,min=1"`
+	PageSize  int32  `query:"page_size" json:"page_size" validate:"required,min=5,max=50"`
+	MemberID  string `query:"member_id" json:"member_id" validate:"omitempty,uuid"`
+	ProjectID string `query:"project_id" json:"project_id" validate:"omitempty,uuid"`
+	// From and To are the first and the last day listed.
+	From string `query:"from" json:"from" validate:"omitempty,datetime=2006-01-02" format:"date"`
+	To   string `query:"to" json:"to" validate:"omitempty,datetime=2006-01-02" format:"date"`
+}
+
+type listTimeEntriesResponse struct {
+	Meta listMembersResponseMeta `json:"meta"`
+	Data []timeEntryResponse     `json:"data"`
+}
+
+// parseNullUUID parses an optional UUID, validated beforehand.
+func parseNullUUID(value string) (uuid.NullUUID, error) {
+	if len(value) == 0 {
+		return uuid.NullUUID{}, nil
+	}
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.NullUUID{}, err
+	}
+	return uuid.NullUUID{UUID: id, Valid: true}, nil
+}
+
+// parseNullDate parses an optional date, validated beforehand.
+func parseNullDate(value string) (sql.NullTime, error) {
+	if len(value) == 0 {
+		return sql.NullTime{}, nil
+	}
+	date, err := time.Parse(db.DateLayout, value)
+	if err != nil {
+		return sql.NullTime{}, err
+	}
+	return sql.NullTime{Time: date, Valid: true}, nil
+}
+
+// @Summary      List time entries
+// @Description  Lists the time entries, latest first, optionally those of a member, of a project or within a range of days.
+// @Tags         time-tracking
+// @Param        query query listTimeEntriesRequest true "query"
+// @Success      200 {object} listTimeEntriesResponse
+// @Failure      400 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /time-entries [get]
+func (server *Server) listTimeEntries(c *fiber.Ctx) error {
+	req := new(listTimeEntriesRequest)
+	if err := c.QueryParser(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	validate := newValidator()
+	if err := validate.Struct(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	var filter db.CountTimeEntriesParams
+	var err error
+	if filter.MemberID, err = parseNullUUID(req.MemberID); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+	if filter.ProjectID, err = parseNullUUID(req.ProjectID); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+	if filter.FromDate, err = parseNullDate(req.From); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+	if filter.ToDate, err = parseNullDate(req.To); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	entries, err := server.store.ListTimeEntries(c.Context(), db.ListTimeEntriesParams{
+		Limit:     req.PageSize,
+		Offset:    (req.PageID - 1) * req.PageSize,
+		MemberID:  filter.MemberID,
+		ProjectID: filter.ProjectID,
+		FromDate:  filter.FromDate,
+		ToDate:    filter.ToDate,
+	})
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	totalCount, err := server.store.CountTimeEntries(c.Context(), filter)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	pageCount := int64(math.Ceil(float64(totalCount) / float64(req.PageSize)))
+
+	data := make([]timeEntryResponse, 0, len(entries))
+	for _, entry := range entries {
+		data = append(data, newTimeEntryResponse(entry))
+	}
+
+	rsp := listTimeEntriesResponse{
+		Meta: listMembersResponseMeta{
+			PageID:     req.PageID,
+			PageSize:   req.PageSize,
+			PageCount:  pageCount,
+			TotalCount: totalCount,
+		},
+		Data: data,
+	}
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+type timeEntryRequestParams struct {
+	ID uuid.UUID `params:"id"`
+}
+
+// @Summary      Get time entry
+// @Tags         time-tracking
+// @Param        id path string true "Time entry ID"
+// @Success      200 {object} timeEntryResponse
+// @Failure      400 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /time-entries/{id} [get]
+func (server *Server) getTimeEntry(c *fiber.Ctx) error {
+	params := new(timeEntryRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	entry, err := server.store.GetTimeEntry(c.Context(), params.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return c.Status(fiber.StatusNotFound).JSON(newErrorResponse(err))
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	rsp := newTimeEntryResponse(entry)
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+// @Summary      Update time entry
+// @Description  Replaces the time entry, as it is created. Neither the week it was in nor the week it moves to can be submitted.
+// @Tags         time-tracking
+// @Param        id   path string           true "Time entry ID"
+// @Param        body body timeEntryRequest true "Time entry object"
+// @Success      200 {object} timeEntryResponse
+// @Failure      400 {object} errorResponse
+// @Failure      403 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /time-entries/{id} [put]
+func (server *Server) updateTimeEntry(c *fiber.Ctx) error {
+	params := new(timeEntryRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	req := new(timeEntryRequest)
+	if err := c.BodyParser(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	validate := newValidator()
+	if err := validate.Struct(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	date, startedAt, endedAt, minutes, err := req.times()
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	billable, err := server.timeEntryBillable(c, req.ProjectID, req.Billable)
+	if err != nil {
+		return c.Status(timeEntryWriteStatus(err)).JSON(newErrorResponse(err))
+	}
+
+	arg := db.UpdateTimeEntryParams{
+		ProjectID: req.ProjectID,
+		Date:      date,
+		StartedAt: startedAt,
+		EndedAt:   endedAt,
+		Minutes:   minutes,
+		Note:      req.Note,
+		Billable:  billable,
+		ID:        params.ID,
+	}
+
+	entry, err := server.store.UpdateTimeEntryTx(c.Context(), arg)
+	if err != nil {
+		return c.Status(timeEntryWriteStatus(err)).JSON(newErrorResponse(timeEntryWriteError(err)))
+	}
+
+	rsp := newTimeEntryResponse(entry)
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+// @Summary      Delete time entry
+// @Description  A time entry cannot be deleted once the timesheet of its week is submitted.
+// @Tags         time-tracking
+// @Param        id path string true "Time entry ID"
+// @Success      204
+// @Failure      400 {object} errorResponse
+// @Failure      403 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /time-entries/{id} [delete]
+func (server *Server) deleteTimeEntry(c *fiber.Ctx) error {
+	params := new(timeEntryRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	if _, err := server.store.DeleteTimeEntryTx(c.Context(), params.ID); err != nil {
+		return c.Status(timeEntryWriteStatus(err)).JSON(newErrorResponse(err))
+	}
+
+	return c.Status(fiber.StatusNoContent).JSON(nil)
+}
+
+type memberTimerRequestParams struct {
+	ID uuid.UUID `params:"id"`
+}
+
+// @Summary      Get member timer
+// @Description  Returns the time entry of the running timer of the member.
+// @Tags         time-tracking
+// @Param        id path string true "Member ID"
+// @Success      200 {object} timeEntryResponse
+// @Failure      400 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /members/{id}/timer [get]
+func (server *Server) getMemberTimer(c *fiber.Ctx) error {
+	params := new(memberTimerRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	entry, err := server.store.GetRunningTimeEntry(c.Context(), params.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return c.Status(fiber.StatusNotFound).JSON(newErrorResponse(db.ErrNoRunningTimer))
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	rsp := newTimeEntryResponse(entry)
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+type startMemberTimerRequest struct {
+	ProjectID uuid.UUID `json:"project_id" validate:"required"`
+	Note      string    `json:"note" validate:"max=500"`
+	// Billable defaults to what the project is.
+	Billable *bool `json:"billable"`
+}
+
+// @Summary      Start member timer
+// @Description  Starts tracking time of the member against a project now, until the timer is stopped.
+// @Description  A member can only have one timer running.
+// @Tags         time-tracking
+// @Param        id   path string                  true "Member ID"
+// @Param        body body startMemberTimerRequest true "Timer"
+// @Success      200 {object} timeEntryResponse
+// @Failure      400 {object} errorResponse
+// @Failure      403 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /members/{id}/timer/start [post]
+func (server *Server) startMemberTimer(c *fiber.Ctx) error {
+	params := new(memberTimerRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	req := new(startMemberTimerRequest)
+	if err := c.BodyParser(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	validate := newValidator()
+	if err := validate.Struct(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	billable, err := server.timeEntryBillable(c, req.ProjectID, req.Billable)
+	if err != nil {
+		return c.Status(timeEntryWriteStatus(err)).JSON(newErrorResponse(err))
+	}
+
+	entry, err := server.store.CreateTimeEntryTx(c.Context(), db.CreateTimeEntryParams{
+		MemberID:  params.ID,
+		ProjectID: req.ProjectID,
+		StartedAt: sql.NullTime{Time: time.Now().UTC(), Valid: true},
+		Note:      req.Note,
+		Billable:  billable,
+	})
+	if err != nil {
+		return c.Status(timeEntryWriteStatus(err)).JSON(newErrorResponse(timeEntryWriteError(err)))
+	}
+
+	rsp := newTimeEntryResponse(entry)
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+// @Summary      Stop member timer
+// @Description  Stops the running timer of the member now.
+// @Tags         time-tracking
+// @Param        id path string true "Member ID"
+// @Success      200 {object} timeEntryResponse
+// @Failure      400 {object} errorResponse
+// @Failure      403 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /members/{id}/timer/stop [post]
+func (server *Server) stopMemberTimer(c *fiber.Ctx) error {
+	params := new(memberTimerRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	entry, err := server.store.StopTimerTx(c.Context(), params.ID, time.Now().UTC())
+	if err != nil {
+		return c.Status(timeEntryWriteStatus(err)).JSON(newErrorResponse(err))
+	}
+
+	rsp := newTimeEntryResponse(entry)
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
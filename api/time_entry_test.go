@@ -0,0 +1,607 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	mockdb "github.com/ot07/coworker-backend/db/mock"
+	db "github.com/ot07/coworker-backend/db/sqlc"
+	"github.com/ot07/coworker-backend/util"
+	"github.com/stretchr/testify/require"
+)
+
+func randomTimeEntry(memberID, projectID uuid.UUID, startedAt time.Time, minutes int32) db.TimeEntry {
+	startedAt = startedAt.UTC().Truncate(time.Second)
+	return db.TimeEntry{
+		ID:        util.RandomUUID(),
+		MemberID:  memberID,
+		ProjectID: projectID,
+		Date:      time.Date(startedAt.Year(), startedAt.Month(), startedAt.Day(), 0, 0, 0, 0, time.UTC),
+		StartedAt: sql.NullTime{Time: startedAt, Valid: true},
+		EndedAt:   sql.NullTime{Time: startedAt.Add(time.Duration(minutes) * time.Minute), Valid: true},
+		Minutes:   sql.NullInt32{Int32: minutes, Valid: true},
+		Note:      util.RandomString(10),
+		Billable:  true,
+		CreatedAt: time.Now().UTC().Truncate(time.Second),
+	}
+}
+
+func TestCreateTimeEntryAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	member := randomMember()
+	project := randomProject()
+	startedAt := time.Date(2023, 8, 7, 9, 0, 0, 0, time.UTC)
+	entry := randomTimeEntry(member.ID, project.ID, startedAt, 90)
+
+	testCases := []struct {
+		name          string
+		body          fiber.Map
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name: "OK",
+			body: fiber.Map{
+				"member_id":  member.ID,
+				"project_id": project.ID,
+				"started_at": entry.StartedAt.Time,
+				"ended_at":   entry.EndedAt.Time,
+				"note":       entry.Note,
+				"billable":   true,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.CreateTimeEntryParams{
+					MemberID:  member.ID,
+					ProjectID: project.ID,
+					StartedAt: entry.StartedAt,
+					EndedAt:   entry.EndedAt,
+					Note:      entry.Note,
+					Billable:  true,
+				}
+				store.EXPECT().
+					GetProject(gomock.Any(), gomock.Any()).
+					Times(0)
+				store.EXPECT().
+					CreateTimeEntryTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(entry, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+				requireBodyMatchTimeEntry(t, response.Body, entry)
+			},
+		},
+		{
+			name: "Duration",
+			body: fiber.Map{
+				"member_id":  member.ID,
+				"project_id": project.ID,
+				"date":       "2023-08-07",
+				"minutes":    45,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				notBillable := project
+				notBillable.Billable = false
+				store.EXPECT().
+					GetProject(gomock.Any(), gomock.Eq(project.ID)).
+					Times(1).
+					Return(notBillable, nil)
+
+				arg := db.CreateTimeEntryParams{
+					MemberID:  member.ID,
+					ProjectID: project.ID,
+					Date:      entry.Date,
+					Minutes:   sql.NullInt32{Int32: 45, Valid: true},
+				}
+				store.EXPECT().
+					CreateTimeEntryTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(entry, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+			},
+		},
+		{
+			name: "RangeAndDuration",
+			body: fiber.Map{
+				"member_id":  member.ID,
+				"project_id": project.ID,
+				"started_at": entry.StartedAt.Time,
+				"minutes":    45,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateTimeEntryTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "FutureTimer",
+			body: fiber.Map{
+				"member_id":  member.ID,
+				"project_id": project.ID,
+				"started_at": time.Now().Add(time.Hour),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateTimeEntryTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "Overlap",
+			body: fiber.Map{
+				"member_id":  member.ID,
+				"project_id": project.ID,
+				"started_at": entry.StartedAt.Time,
+				"ended_at":   entry.EndedAt.Time,
+				"billable":   true,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateTimeEntryTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.TimeEntry{}, &pq.Error{Code: "23P01"})
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusForbidden, response.StatusCode)
+			},
+		},
+		{
+			name: "TimesheetLocked",
+			body: fiber.Map{
+				"member_id":  member.ID,
+				"project_id": project.ID,
+				"date":       "2023-08-07",
+				"minutes":    45,
+				"billable":   true,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateTimeEntryTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.TimeEntry{}, fmt.Errorf("%w: the week of 2023-08-07", db.ErrTimesheetLocked))
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusForbidden, response.StatusCode)
+			},
+		},
+		{
+			name: "ProjectNotFound",
+			body: fiber.Map{
+				"member_id":  member.ID,
+				"project_id": project.ID,
+				"date":       "2023-08-07",
+				"minutes":    45,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetProject(gomock.Any(), gomock.Eq(project.ID)).
+					Times(1).
+					Return(db.Project{}, sql.ErrNoRows)
+				store.EXPECT().
+					CreateTimeEntryTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusNotFound, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			buildValidSessionStubs(store, session)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/api/v1/time-entries", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			request.Header.Set("Content-Type", "application/json")
+
+			addSessionTokenInCookie(request, session.SessionToken.String())
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func TestListTimeEntriesAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	member := randomMember()
+	project := randomProject()
+	entries := []db.TimeEntry{
+		randomTimeEntry(member.ID, project.ID, time.Date(2023, 8, 8, 9, 0, 0, 0, time.UTC), 60),
+		randomTimeEntry(member.ID, project.ID, time.Date(2023, 8, 7, 9, 0, 0, 0, time.UTC), 30),
+	}
+
+	testCases := []struct {
+		name          string
+		query         string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name:  "OK",
+			query: fmt.Sprintf("page_id=1&page_size=5&member_id=%s&from=2023-08-07&to=2023-08-13", member.ID),
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.ListTimeEntriesParams{
+					Limit:    5,
+					Offset:   0,
+					MemberID: uuid.NullUUID{UUID: member.ID, Valid: true},
+					FromDate: sql.NullTime{Time: time.Date(2023, 8, 7, 0, 0, 0, 0, time.UTC), Valid: true},
+					ToDate:   sql.NullTime{Time: time.Date(2023, 8, 13, 0, 0, 0, 0, time.UTC), Valid: true},
+				}
+				store.EXPECT().
+					ListTimeEntries(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(entries, nil)
+				store.EXPECT().
+					CountTimeEntries(gomock.Any(), gomock.Eq(db.CountTimeEntriesParams{
+						MemberID: arg.MemberID,
+						FromDate: arg.FromDate,
+						ToDate:   arg.ToDate,
+					})).
+					Times(1).
+					Return(int64(len(entries)), nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+
+				data, err := io.ReadAll(response.Body)
+				require.NoError(t, err)
+
+				var got listTimeEntriesResponse
+				err = json.Unmarshal(data, &got)
+				require.NoError(t, err)
+				require.Equal(t, int64(2), got.Meta.TotalCount)
+				require.Equal(t, int64(1), got.Meta.PageCount)
+				require.Len(t, got.Data, 2)
+				require.Equal(t, entries[0].ID, got.Data[0].ID)
+				require.Equal(t, "2023-08-08", got.Data[0].Date)
+			},
+		},
+		{
+			name:  "InvalidProjectID",
+			query: "page_id=1&page_size=5&project_id=invalid",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListTimeEntries(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			buildValidSessionStubs(store, session)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			request, err := http.NewRequest(http.MethodGet, "/api/v1/time-entries?"+tc.query, nil)
+			require.NoError(t, err)
+
+			addSessionTokenInCookie(request, session.SessionToken.String())
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func TestDeleteTimeEntryAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	entry := randomTimeEntry(util.RandomUUID(), util.RandomUUID(), time.Date(2023, 8, 7, 9, 0, 0, 0, time.UTC), 30)
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					DeleteTimeEntryTx(gomock.Any(), gomock.Eq(entry.ID)).
+					Times(1).
+					Return(entry, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusNoContent, response.StatusCode)
+			},
+		},
+		{
+			name: "TimesheetLocked",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					DeleteTimeEntryTx(gomock.Any(), gomock.Eq(entry.ID)).
+					Times(1).
+					Return(db.TimeEntry{}, db.ErrTimesheetLocked)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusForbidden, response.StatusCode)
+			},
+		},
+		{
+			name: "NotFound",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					DeleteTimeEntryTx(gomock.Any(), gomock.Eq(entry.ID)).
+					Times(1).
+					Return(db.TimeEntry{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusNotFound, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			buildValidSessionStubs(store, session)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			url := fmt.Sprintf("/api/v1/time-entries/%s", entry.ID)
+			request, err := http.NewRequest(http.MethodDelete, url, nil)
+			require.NoError(t, err)
+
+			addSessionTokenInCookie(request, session.SessionToken.String())
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func TestMemberTimerAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	member := randomMember()
+	project := randomProject()
+	running := randomTimeEntry(member.ID, project.ID, time.Now().Add(-time.Hour), 0)
+	running.EndedAt = sql.NullTime{}
+	running.Minutes = sql.NullInt32{}
+
+	testCases := []struct {
+		name          string
+		method        string
+		path          string
+		body          fiber.Map
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name:   "Get",
+			method: http.MethodGet,
+			path:   "timer",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetRunningTimeEntry(gomock.Any(), gomock.Eq(member.ID)).
+					Times(1).
+					Return(running, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+				requireBodyMatchTimeEntry(t, response.Body, running)
+			},
+		},
+		{
+			name:   "GetNoneRunning",
+			method: http.MethodGet,
+			path:   "timer",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetRunningTimeEntry(gomock.Any(), gomock.Eq(member.ID)).
+					Times(1).
+					Return(db.TimeEntry{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusNotFound, response.StatusCode)
+			},
+		},
+		{
+			name:   "Start",
+			method: http.MethodPost,
+			path:   "timer/start",
+			body: fiber.Map{
+				"project_id": project.ID,
+				"note":       running.Note,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetProject(gomock.Any(), gomock.Eq(project.ID)).
+					Times(1).
+					Return(project, nil)
+				store.EXPECT().
+					CreateTimeEntryTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ interface{}, arg db.CreateTimeEntryParams) (db.TimeEntry, error) {
+						require.Equal(t, member.ID, arg.MemberID)
+						require.Equal(t, project.ID, arg.ProjectID)
+						require.True(t, arg.StartedAt.Valid)
+						require.WithinDuration(t, time.Now(), arg.StartedAt.Time, time.Minute)
+						require.False(t, arg.EndedAt.Valid)
+						require.Equal(t, running.Note, arg.Note)
+						require.True(t, arg.Billable)
+						return running, nil
+					})
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+			},
+		},
+		{
+			name:   "StartAlreadyRunning",
+			method: http.MethodPost,
+			path:   "timer/start",
+			body: fiber.Map{
+				"project_id": project.ID,
+				"billable":   false,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateTimeEntryTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.TimeEntry{}, db.ErrTimerRunning)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusForbidden, response.StatusCode)
+			},
+		},
+		{
+			name:   "Stop",
+			method: http.MethodPost,
+			path:   "timer/stop",
+			buildStubs: func(store *mockdb.MockStore) {
+				stopped := running
+				stopped.EndedAt = sql.NullTime{Time: running.StartedAt.Time.Add(time.Hour), Valid: true}
+				stopped.Minutes = sql.NullInt32{Int32: 60, Valid: true}
+				store.EXPECT().
+					StopTimerTx(gomock.Any(), gomock.Eq(member.ID), gomock.Any()).
+					Times(1).
+					Return(stopped, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+			},
+		},
+		{
+			name:   "StopNoneRunning",
+			method: http.MethodPost,
+			path:   "timer/stop",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					StopTimerTx(gomock.Any(), gomock.Eq(member.ID), gomock.Any()).
+					Times(1).
+					Return(db.TimeEntry{}, db.ErrNoRunningTimer)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusForbidden, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			buildValidSessionStubs(store, session)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			var body io.Reader
+			if tc.body != nil {
+				data, err := json.Marshal(tc.body)
+				require.NoError(t, err)
+				body = bytes.NewReader(data)
+			}
+
+			url := fmt.Sprintf("/api/v1/members/%s/%s", member.ID, tc.path)
+			request, err := http.NewRequest(tc.method, url, body)
+			require.NoError(t, err)
+
+			request.Header.Set("Content-Type", "application/json")
+
+			addSessionTokenInCookie(request, session.SessionToken.String())
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func requireBodyMatchTimeEntry(t *testing.T, body io.ReadCloser, entry db.TimeEntry) {
+	data, err := io.ReadAll(body)
+	require.NoError(t, err)
+
+	var got timeEntryResponse
+	err = json.Unmarshal(data, &got)
+	require.NoError(t, err)
+
+	require.Equal(t, entry.ID, got.ID)
+	require.Equal(t, entry.MemberID, got.MemberID)
+	require.Equal(t, entry.ProjectID, got.ProjectID)
+	require.Equal(t, entry.Date.Format(db.DateLayout), got.Date)
+	require.Equal(t, entry.StartedAt.Valid, got.StartedAt.Valid)
+	require.True(t, entry.StartedAt.Time.Equal(got.StartedAt.Time))
+	require.Equal(t, entry.EndedAt.Valid, got.EndedAt.Valid)
+	require.True(t, entry.EndedAt.Time.Equal(got.EndedAt.Time))
+	require.Equal(t, entry.Minutes, got.Minutes.NullInt32)
+	require.Equal(t, entry.StartedAt.Valid && !entry.EndedAt.Valid, got.Running)
+	require.Equal(t, entry.Note, got.Note)
+	require.Equal(t, entry.Billable, got.Billable)
+
+	err = body.Close()
+	require.NoError(t, err)
+}
@@ -0,0 +1,268 @@
+package api
+
+import (
+	"database/sql"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	db "github.com/ot07/coworker-backend/db/sqlc"
+)
+
+const (
+	// maxTimeReportRange is how long a time report can span.
+	maxTimeReportRange = 366 * 24 * time.Hour
+	// defaultTimeReportGroupBy is what a time report is grouped by when nothing is given.
+	defaultTimeReportGroupBy = "project,member,week"
+)
+
+var (
+	errTimeReportRange      = errors.New("the report must end after it starts, within 366 days")
+	errTimeReportDimensions = errors.New("a time report is grouped by project, member or week")
+)
+
+// minutesToHours converts minutes to hours, rounded to the hundredth.
+func minutesToHours(minutes int64) float64 {
+	return math.Round(float64(minutes)/60*100) / 100
+}
+
+type timeReportRowResponse struct {
+	// The fields of what the report is not grouped by are null.
+	ProjectID   uuid.NullUUID `json:"project_id" swaggertype:"string"`
+	ProjectName db.NullString `json:"project_name" swaggertype:"string"`
+	MemberID    uuid.NullUUID `json:"member_id" swaggertype:"string"`
+	FirstName   db.NullString `json:"first_name" swaggertype:"string"`
+	LastName    db.NullString `json:"last_name" swaggertype:"string"`
+	// WeekStart is the Monday the week starts on.
+	WeekStart db.NullString `json:"week_start" swaggertype:"string" format:"date"`
+	Entries   int32         `json:"entries"`
+	Minutes   int64         `json:"minutes"`
+	// Hours and BillableHours are rounded to the hundredth.
+	Hours           float64 `json:"hours"`
+	BillableMinutes int64   `json:"billable_minutes"`
+	BillableHours   float64 `json:"billable_hours"`
+}
+
+// timeReportGroupBy tells what a time report is grouped by.
+type timeReportGroupBy struct {
+	project, member, week bool
+}
+
+func parseTimeReportGroupBy(value string) (timeReportGroupBy, error) {
+	var groupBy timeReportGroupBy
+	for _, dimension := range strings.Split(value, ",") {
+		switch strings.TrimSpace(dimension) {
+		case "project":
+			groupBy.project = true
+		case "member":
+			groupBy.member = true
+		case "week":
+			groupBy.week = true
+		default:
+			return groupBy, errTimeReportDimensions
+		}
+	}
+	return groupBy, nil
+}
+
+// aggregateTimeReport sums up the rows of a report, per project, member and week, into the rows of what it is grouped by.
+// The rows are ordered by week, project name and member name.
+func aggregateTimeReport(rows []db.ListTimeReportRowsRow, groupBy timeReportGroupBy) []timeReportRowResponse {
+	type key struct {
+		projectID, memberID uuid.UUID
+		weekStart           time.Time
+	}
+
+	index := make(map[key]int)
+	report := []timeReportRowResponse{}
+	for _, row := range rows {
+		var k key
+		if groupBy.project {
+			k.projectID = row.ProjectID
+		}
+		if groupBy.member {
+			k.memberID = row.MemberID
+		}
+		if groupBy.week {
+			k.weekStart = row.WeekStart
+		}
+
+		i, ok := index[k]
+		if !ok {
+			i = len(report)
+			index[k] = i
+
+			var reportRow timeReportRowResponse
+			if groupBy.project {
+				reportRow.ProjectID = uuid.NullUUID{UUID: row.ProjectID, Valid: true}
+				reportRow.ProjectName = db.NullString{NullString: sql.NullString{String: row.ProjectName, Valid: true}}
+			}
+			if groupBy.member {
+				reportRow.MemberID = uuid.NullUUID{UUID: row.MemberID, Valid: true}
+				reportRow.FirstName = db.NullString{NullString: sql.NullString{String: row.FirstName, Valid: true}}
+				reportRow.LastName = db.NullString{NullString: sql.NullString{String: row.LastName, Valid: true}}
+			}
+			if groupBy.week {
+				reportRow.WeekStart = db.NullString{NullString: sql.NullString{String: row.WeekStart.Format(db.DateLayout), Valid: true}}
+			}
+			report = append(report, reportRow)
+		}
+
+		report[i].Entries += row.Entries
+		report[i].Minutes += row.Minutes
+		report[i].BillableMinutes += row.BillableMinutes
+	}
+
+	for i := range report {
+		report[i].Hours = minutesToHours(report[i].Minutes)
+		report[i].BillableHours = minutesToHours(report[i].BillableMinutes)
+	}
+
+	sort.SliceStable(report, func(i, j int) bool {
+		a, b := report[i], report[j]
+		if a.WeekStart.String != b.WeekStart.String {
+			return a.WeekStart.String < b.WeekStart.String
+		}
+		if !strings.EqualFold(a.ProjectName.String, b.ProjectName.String) {
+			return strings.ToLower(a.ProjectName.String) < strings.ToLower(b.ProjectName.String)
+		}
+		if !strings.EqualFold(a.LastName.String, b.LastName.String) {
+			return strings.ToLower(a.LastName.String) < strings.ToLower(b.LastName.String)
+		}
+		return strings.ToLower(a.FirstName.String) < strings.ToLower(b.FirstName.String)
+	})
+	return report
+}
+
+// writeTimeReportCSV writes a time report as CSV, starting with a byte order mark for Excel.
+// The columns of what the report is not grouped by are left empty.
+func writeTimeReportCSV(w io.Writer, report []timeReportRowResponse) error {
+	if _, err := io.WriteString(w, "\ufeff"); err != nil {
+		return err
+	}
+
+	nullUUID := func(id uuid.NullUUID) string {
+		if !id.Valid {
+			return ""
+		}
+		return id.UUID.String()
+	}
+
+	writer := csv.NewWriter(w)
+	header := []string{"project_id", "project_name", "member_id", "first_name", "last_name", "week_start", "entries",
+		"minutes", "hours", "billable_minutes", "billable_hours"}
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+	for _, row := range report {
+		err := writer.Write([]string{
+			nullUUID(row.ProjectID),
+			row.ProjectName.String,
+			nullUUID(row.MemberID),
+			row.FirstName.String,
+			row.LastName.String,
+			row.WeekStart.String,
+			strconv.Itoa(int(row.Entries)),
+			strconv.FormatInt(row.Minutes, 10),
+			strconv.FormatFloat(row.Hours, 'f', 2, 64),
+			strconv.FormatInt(row.BillableMinutes, 10),
+			strconv.FormatFloat(row.BillableHours, 'f', 2, 64),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+type getTimeReportRequestQuery struct {
+	// From and To are the first and the last day reported.
+	From      string `query:"from" json:"from" validate:"required,datetime=2006-01-02" format:"date"`
+	To        string `query:"to" json:"to" validate:"required,datetime=2006-01-02" format:"date"`
+	MemberID  string `query:"member_id" json:"member_id" validate:"omitempty,uuid"`
+	ProjectID string `query:"project_id" json:"project_id" validate:"omitempty,uuid"`
+	// GroupBy is a comma-separated list of project, member and week, all of them by default.
+	GroupBy string `query:"group_by" json:"group_by" example:"project,week"`
+	Format  string `query:"format" json:"format" validate:"omitempty,oneof=json csv" enums:"json,csv"`
+}
+
+// @Summary      Get time report
+// @Description  Sums up the time tracked within a range of days, per project, member or week, or any of them together.
+// @Description  Weeks start on Monday. Running timers are not counted.
+// @Description  With format=csv, the report is downloaded as CSV with a UTF-8 BOM for Excel.
+// @Tags         time-tracking
+// @Produce      json,text/csv
+// @Param        query query getTimeReportRequestQuery true "query"
+// @Success      200 {array} timeReportRowResponse
+// @Failure      400 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /time-reports [get]
+func (server *Server) getTimeReport(c *fiber.Ctx) error {
+	query := new(getTimeReportRequestQuery)
+	if err := c.QueryParser(query); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	validate := newValidator()
+	if err := validate.Struct(query); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	from, err := time.Parse(db.DateLayout, query.From)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+	to, err := time.Parse(db.DateLayout, query.To)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+	if to.Before(from) || to.Sub(from) >= maxTimeReportRange {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(errTimeReportRange))
+	}
+
+	if len(query.GroupBy) == 0 {
+		query.GroupBy = defaultTimeReportGroupBy
+	}
+	groupBy, err := parseTimeReportGroupBy(query.GroupBy)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	arg := db.ListTimeReportRowsParams{
+		FromDate: from,
+		ToDate:   to,
+	}
+	if arg.MemberID, err = parseNullUUID(query.MemberID); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+	if arg.ProjectID, err = parseNullUUID(query.ProjectID); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	rows, err := server.store.ListTimeReportRows(c.Context(), arg)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	report := aggregateTimeReport(rows, groupBy)
+
+	if query.Format != "csv" {
+		return c.Status(fiber.StatusOK).JSON(report)
+	}
+
+	c.Attachment(fmt.Sprintf("time-report-%s-%s.csv", query.From, query.To))
+	c.Set(fiber.HeaderContentType, "text/csv; charset=utf-8")
+	if err := writeTimeReportCSV(c, report); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+	return nil
+}
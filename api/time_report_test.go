@@ -0,0 +1,194 @@
+package api
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	mockdb "github.com/ot07/coworker-backend/db/mock"
+	db "github.com/ot07/coworker-backend/db/sqlc"
+	"github.com/ot07/coworker-backend/util"
+	"github.com/stretchr/testify/require"
+)
+
+func randomTimeReportRows() []db.ListTimeReportRowsRow {
+	monday := time.Date(2023, 8, 7, 0, 0, 0, 0, time.UTC)
+	alpha := db.ListTimeReportRowsRow{ProjectID: util.RandomUUID(), ProjectName: "Alpha"}
+	beta := db.ListTimeReportRowsRow{ProjectID: util.RandomUUID(), ProjectName: "beta"}
+
+	row := func(project db.ListTimeReportRowsRow, member db.Member, weekStart time.Time, minutes, billableMinutes int64) db.ListTimeReportRowsRow {
+		project.MemberID = member.ID
+		project.FirstName = member.FirstName
+		project.LastName = member.LastName
+		project.WeekStart = weekStart
+		project.Entries = 1
+		project.Minutes = minutes
+		project.BillableMinutes = billableMinutes
+		return project
+	}
+
+	ada := db.Member{ID: util.RandomUUID(), FirstName: "Ada", LastName: "Lovelace"}
+	alan := db.Member{ID: util.RandomUUID(), FirstName: "Alan", LastName: "Turing"}
+	return []db.ListTimeReportRowsRow{
+		row(alpha, ada, monday, 60, 60),
+		row(alpha, alan, monday, 30, 0),
+		row(beta, ada, monday, 45, 45),
+		row(alpha, ada, monday.AddDate(0, 0, 7), 100, 100),
+	}
+}
+
+func TestAggregateTimeReport(t *testing.T) {
+	t.Parallel()
+
+	rows := randomTimeReportRows()
+
+	report := aggregateTimeReport(rows, timeReportGroupBy{project: true})
+	require.Len(t, report, 2)
+	require.Equal(t, "Alpha", report[0].ProjectName.String)
+	require.Equal(t, int32(3), report[0].Entries)
+	require.Equal(t, int64(190), report[0].Minutes)
+	require.Equal(t, 3.17, report[0].Hours)
+	require.Equal(t, int64(160), report[0].BillableMinutes)
+	require.False(t, report[0].MemberID.Valid)
+	require.False(t, report[0].WeekStart.Valid)
+	require.Equal(t, "beta", report[1].ProjectName.String)
+
+	report = aggregateTimeReport(rows, timeReportGroupBy{member: true, week: true})
+	require.Len(t, report, 3)
+	require.Equal(t, "2023-08-07", report[0].WeekStart.String)
+	require.Equal(t, "Lovelace", report[0].LastName.String)
+	require.Equal(t, int64(105), report[0].Minutes)
+	require.Equal(t, "Turing", report[1].LastName.String)
+	require.Equal(t, "2023-08-14", report[2].WeekStart.String)
+	require.False(t, report[0].ProjectID.Valid)
+}
+
+func TestGetTimeReportAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	rows := randomTimeReportRows()
+	memberID := rows[0].MemberID
+
+	testCases := []struct {
+		name          string
+		query         string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name:  "OK",
+			query: "from=2023-08-07&to=2023-08-20&group_by=project",
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.ListTimeReportRowsParams{
+					FromDate: time.Date(2023, 8, 7, 0, 0, 0, 0, time.UTC),
+					ToDate:   time.Date(2023, 8, 20, 0, 0, 0, 0, time.UTC),
+				}
+				store.EXPECT().
+					ListTimeReportRows(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(rows, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+
+				data, err := io.ReadAll(response.Body)
+				require.NoError(t, err)
+
+				var got []timeReportRowResponse
+				err = json.Unmarshal(data, &got)
+				require.NoError(t, err)
+				require.Len(t, got, 2)
+				require.Equal(t, int64(190), got[0].Minutes)
+			},
+		},
+		{
+			name:  "CSV",
+			query: "from=2023-08-07&to=2023-08-20&format=csv&member_id=" + memberID.String(),
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.ListTimeReportRowsParams{
+					FromDate: time.Date(2023, 8, 7, 0, 0, 0, 0, time.UTC),
+					ToDate:   time.Date(2023, 8, 20, 0, 0, 0, 0, time.UTC),
+					MemberID: uuid.NullUUID{UUID: memberID, Valid: true},
+				}
+				store.EXPECT().
+					ListTimeReportRows(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return([]db.ListTimeReportRowsRow{rows[0], rows[2]}, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+				require.Equal(t, "text/csv; charset=utf-8", response.Header.Get("Content-Type"))
+				require.Contains(t, response.Header.Get("Content-Disposition"), "time-report-2023-08-07-2023-08-20.csv")
+
+				data, err := io.ReadAll(response.Body)
+				require.NoError(t, err)
+
+				records, err := csv.NewReader(strings.NewReader(strings.TrimPrefix(string(data), "\ufeff"))).ReadAll()
+				require.NoError(t, err)
+				require.Len(t, records, 3)
+				require.Equal(t, "project_id", records[0][0])
+				require.Equal(t, "Alpha", records[1][1])
+				require.Equal(t, "Lovelace", records[1][4])
+				require.Equal(t, "2023-08-07", records[1][5])
+				require.Equal(t, "1.00", records[1][8])
+			},
+		},
+		{
+			name:  "InvalidGroupBy",
+			query: "from=2023-08-07&to=2023-08-20&group_by=team",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListTimeReportRows(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name:  "InvalidRange",
+			query: "from=2023-08-20&to=2023-08-07",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListTimeReportRows(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			buildValidSessionStubs(store, session)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			request, err := http.NewRequest(http.MethodGet, "/api/v1/time-reports?"+tc.query, nil)
+			require.NoError(t, err)
+
+			addSessionTokenInCookie(request, session.SessionToken.String())
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
@@ -0,0 +1,206 @@
+package api
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	db "github.com/ot07/coworker-backend/db/sqlc"
+)
+
+type timesheetDayResponse struct {
+	Date            string `json:"date" format:"date"`
+	Minutes         int64  `json:"minutes"`
+	BillableMinutes int64  `json:"billable_minutes"`
+}
+
+type timesheetResponse struct {
+	MemberID uuid.UUID `json:"member_id"`
+	// WeekStart is the Monday the week starts on.
+	WeekStart string `json:"week_start" format:"date"`
+	// Submitted tells that the timesheet is submitted, which locks the time entries of the week.
+	Submitted   bool          `json:"submitted"`
+	SubmittedBy uuid.NullUUID `json:"submitted_by" swaggertype:"string"`
+	SubmittedAt db.NullTime   `json:"submitted_at" swaggertype:"string" format:"date-time"`
+	// Days sums up the time entries of every day of the week, from Monday to Sunday. Running timers are not counted.
+	Days            []timesheetDayResponse `json:"days"`
+	Minutes         int64                  `json:"minutes"`
+	BillableMinutes int64                  `json:"billable_minutes"`
+	Entries         []timeEntryResponse    `json:"entries"`
+}
+
+func newTimesheetResponse(memberID uuid.UUID, weekStart time.Time, timesheet *db.Timesheet, entries []db.TimeEntry) timesheetResponse {
+	rsp := timesheetResponse{
+		MemberID:  memberID,
+		WeekStart: weekStart.Format(db.DateLayout),
+		Days:      make([]timesheetDayResponse, 7),
+		Entries:   make([]timeEntryResponse, 0, len(entries)),
+	}
+	if timesheet != nil {
+		rsp.Submitted = true
+		rsp.SubmittedBy = timesheet.SubmittedBy
+		rsp.SubmittedAt = db.NullTime{NullTime: sql.NullTime{Time: timesheet.SubmittedAt, Valid: true}}
+	}
+	for i := range rsp.Days {
+		rsp.Days[i].Date = weekStart.AddDate(0, 0, i).Format(db.DateLayout)
+	}
+
+	for _, entry := range entries {
+		rsp.Entries = append(rsp.Entries, newTimeEntryResponse(entry))
+		day := int(entry.Date.Sub(weekStart).Hours() / 24)
+		if !entry.Minutes.Valid || day < 0 || day >= len(rsp.Days) {
+			continue
+		}
+		minutes := int64(entry.Minutes.Int32)
+		rsp.Days[day].Minutes += minutes
+		rsp.Minutes += minutes
+		if entry.Billable {
+			rsp.Days[day].BillableMinutes += minutes
+			rsp.BillableMinutes += minutes
+		}
+	}
+	return rsp
+}
+
+type timesheetRequestParams struct {
+	ID uuid.UUID `params:"id"`
+	// Week is any day of the week, as YYYY-MM-DD.
+	Week string `params:"week"`
+}
+
+// weekStart returns the Monday starting the week of the timesheet.
+func (params *timesheetRequestParams) weekStart() (time.Time, error) {
+	date, err := time.Parse(db.DateLayout, params.Week)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return db.WeekStart(date), nil
+}
+
+// respondTimesheet responds with the timesheet of the member for a week, along with its time entries.
+func (server *Server) respondTimesheet(c *fiber.Ctx, memberID uuid.UUID, weekStart time.Time) error {
+	var timesheet *db.Timesheet
+	submitted, err := server.store.GetMemberTimesheet(c.Context(), db.GetMemberTimesheetParams{
+		MemberID:  memberID,
+		WeekStart: weekStart,
+	})
+	if err == nil {
+		timesheet = &submitted
+	} else if err != sql.ErrNoRows {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	entries, err := server.store.ListMemberTimeEntries(c.Context(), db.ListMemberTimeEntriesParams{
+		MemberID: memberID,
+		FromDate: weekStart,
+		ToDate:   weekStart.AddDate(0, 0, 6),
+	})
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	rsp := newTimesheetResponse(memberID, weekStart, timesheet, entries)
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+// @Summary      Get member timesheet
+// @Description  Returns the timesheet of the member for the week of a day, from Monday to Sunday: its time entries and their totals.
+// @Tags         time-tracking
+// @Param        id   path string true "Member ID"
+// @Param        week path string true "Any day of the week, as YYYY-MM-DD"
+// @Success      200 {object} timesheetResponse
+// @Failure      400 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /members/{id}/timesheets/{week} [get]
+func (server *Server) getMemberTimesheet(c *fiber.Ctx) error {
+	params := new(timesheetRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	weekStart, err := params.weekStart()
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	if _, err := server.store.GetMember(c.Context(), params.ID); err != nil {
+		if err == sql.ErrNoRows {
+			return c.Status(fiber.StatusNotFound).JSON(newErrorResponse(err))
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	return server.respondTimesheet(c, params.ID, weekStart)
+}
+
+// @Summary      Submit member timesheet
+// @Description  Submits the timesheet of the member for the week of a day, locking its time entries until it is reopened.
+// @Description  A week cannot be submitted while a timer started within it is running.
+// @Tags         time-tracking
+// @Param        id   path string true "Member ID"
+// @Param        week path string true "Any day of the week, as YYYY-MM-DD"
+// @Success      200 {object} timesheetResponse
+// @Failure      400 {object} errorResponse
+// @Failure      403 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /members/{id}/timesheets/{week}/submit [post]
+func (server *Server) submitMemberTimesheet(c *fiber.Ctx) error {
+	params := new(timesheetRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	weekStart, err := params.weekStart()
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	_, err = server.store.SubmitTimesheetTx(c.Context(), db.SubmitTimesheetTxParams{
+		MemberID:  params.ID,
+		WeekStart: weekStart,
+		UserID:    c.Locals(sessionUserIDKey).(uuid.UUID),
+	})
+	if err != nil {
+		return c.Status(timeEntryWriteStatus(err)).JSON(newErrorResponse(err))
+	}
+
+	return server.respondTimesheet(c, params.ID, weekStart)
+}
+
+// @Summary      Reopen member timesheet
+// @Description  Reopens the submitted timesheet of the member for the week of a day, unlocking its time entries.
+// @Description  Only an admin or the manager of the member can reopen a timesheet.
+// @Tags         time-tracking
+// @Param        id   path string true "Member ID"
+// @Param        week path string true "Any day of the week, as YYYY-MM-DD"
+// @Success      200 {object} timesheetResponse
+// @Failure      400 {object} errorResponse
+// @Failure      403 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /members/{id}/timesheets/{week}/reopen [post]
+func (server *Server) reopenMemberTimesheet(c *fiber.Ctx) error {
+	params := new(timesheetRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	weekStart, err := params.weekStart()
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	_, err = server.store.ReopenTimesheetTx(c.Context(), db.ReopenTimesheetTxParams{
+		MemberID:  params.ID,
+		WeekStart: weekStart,
+		UserID:    c.Locals(sessionUserIDKey).(uuid.UUID),
+	})
+	if err != nil {
+		return c.Status(timeEntryWriteStatus(err)).JSON(newErrorResponse(err))
+	}
+
+	return server.respondTimesheet(c, params.ID, weekStart)
+}
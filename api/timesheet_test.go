@@ -0,0 +1,274 @@
+package api
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	mockdb "github.com/ot07/coworker-backend/db/mock"
+	db "github.com/ot07/coworker-backend/db/sqlc"
+	"github.com/ot07/coworker-backend/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTimesheetResponse(t *testing.T) {
+	t.Parallel()
+
+	memberID := util.RandomUUID()
+	projectID := util.RandomUUID()
+	monday := time.Date(2023, 8, 7, 0, 0, 0, 0, time.UTC)
+
+	billable := randomTimeEntry(memberID, projectID, monday.Add(9*time.Hour), 60)
+	notBillable := randomTimeEntry(memberID, projectID, monday.Add(11*time.Hour), 30)
+	notBillable.Billable = false
+	sunday := randomTimeEntry(memberID, projectID, monday.AddDate(0, 0, 6).Add(9*time.Hour), 45)
+	running := randomTimeEntry(memberID, projectID, monday.AddDate(0, 0, 6).Add(12*time.Hour), 0)
+	running.EndedAt = sql.NullTime{}
+	running.Minutes = sql.NullInt32{}
+
+	rsp := newTimesheetResponse(memberID, monday, nil, []db.TimeEntry{billable, notBillable, sunday, running})
+	require.False(t, rsp.Submitted)
+	require.Equal(t, "2023-08-07", rsp.WeekStart)
+	require.Len(t, rsp.Days, 7)
+	require.Equal(t, "2023-08-07", rsp.Days[0].Date)
+	require.Equal(t, int64(90), rsp.Days[0].Minutes)
+	require.Equal(t, int64(60), rsp.Days[0].BillableMinutes)
+	require.Equal(t, "2023-08-13", rsp.Days[6].Date)
+	require.Equal(t, int64(45), rsp.Days[6].Minutes)
+	require.Equal(t, int64(135), rsp.Minutes)
+	require.Equal(t, int64(105), rsp.BillableMinutes)
+	require.Len(t, rsp.Entries, 4)
+
+	timesheet := db.Timesheet{
+		ID:          util.RandomUUID(),
+		MemberID:    memberID,
+		WeekStart:   monday,
+		SubmittedBy: uuid.NullUUID{UUID: util.RandomUUID(), Valid: true},
+		SubmittedAt: time.Now().UTC(),
+	}
+	rsp = newTimesheetResponse(memberID, monday, &timesheet, nil)
+	require.True(t, rsp.Submitted)
+	require.Equal(t, timesheet.SubmittedBy, rsp.SubmittedBy)
+	require.True(t, rsp.SubmittedAt.Valid)
+	require.Empty(t, rsp.Entries)
+}
+
+func TestSubmitMemberTimesheetAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	member := randomMember()
+	monday := time.Date(2023, 8, 7, 0, 0, 0, 0, time.UTC)
+	timesheet := db.Timesheet{
+		ID:          util.RandomUUID(),
+		MemberID:    member.ID,
+		WeekStart:   monday,
+		SubmittedBy: uuid.NullUUID{UUID: session.UserID, Valid: true},
+		SubmittedAt: time.Now().UTC().Truncate(time.Second),
+	}
+
+	testCases := []struct {
+		name          string
+		week          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name: "OK",
+			week: "2023-08-10",
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.SubmitTimesheetTxParams{
+					MemberID:  member.ID,
+					WeekStart: monday,
+					UserID:    session.UserID,
+				}
+				store.EXPECT().
+					SubmitTimesheetTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(timesheet, nil)
+				store.EXPECT().
+					GetMemberTimesheet(gomock.Any(), gomock.Eq(db.GetMemberTimesheetParams{MemberID: member.ID, WeekStart: monday})).
+					Times(1).
+					Return(timesheet, nil)
+				store.EXPECT().
+					ListMemberTimeEntries(gomock.Any(), gomock.Eq(db.ListMemberTimeEntriesParams{
+						MemberID: member.ID,
+						FromDate: monday,
+						ToDate:   monday.AddDate(0, 0, 6),
+					})).
+					Times(1).
+					Return([]db.TimeEntry{}, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+
+				data, err := io.ReadAll(response.Body)
+				require.NoError(t, err)
+
+				var got timesheetResponse
+				err = json.Unmarshal(data, &got)
+				require.NoError(t, err)
+				require.Equal(t, member.ID, got.MemberID)
+				require.Equal(t, "2023-08-07", got.WeekStart)
+				require.True(t, got.Submitted)
+			},
+		},
+		{
+			name: "AlreadySubmitted",
+			week: "2023-08-07",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					SubmitTimesheetTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Timesheet{}, fmt.Errorf("%w: the week of 2023-08-07", db.ErrTimesheetLocked))
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusForbidden, response.StatusCode)
+			},
+		},
+		{
+			name: "InvalidWeek",
+			week: "2023-13-01",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					SubmitTimesheetTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			buildValidSessionStubs(store, session)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			url := fmt.Sprintf("/api/v1/members/%s/timesheets/%s/submit", member.ID, tc.week)
+			request, err := http.NewRequest(http.MethodPost, url, nil)
+			require.NoError(t, err)
+
+			addSessionTokenInCookie(request, session.SessionToken.String())
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func TestReopenMemberTimesheetAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	member := randomMember()
+	monday := time.Date(2023, 8, 7, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.ReopenTimesheetTxParams{
+					MemberID:  member.ID,
+					WeekStart: monday,
+					UserID:    session.UserID,
+				}
+				store.EXPECT().
+					ReopenTimesheetTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.Timesheet{MemberID: member.ID, WeekStart: monday}, nil)
+				store.EXPECT().
+					GetMemberTimesheet(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Timesheet{}, sql.ErrNoRows)
+				store.EXPECT().
+					ListMemberTimeEntries(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.TimeEntry{}, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+
+				data, err := io.ReadAll(response.Body)
+				require.NoError(t, err)
+
+				var got timesheetResponse
+				err = json.Unmarshal(data, &got)
+				require.NoError(t, err)
+				require.False(t, got.Submitted)
+			},
+		},
+		{
+			name: "NotApprover",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ReopenTimesheetTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Timesheet{}, db.ErrNotTimesheetApprover)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusForbidden, response.StatusCode)
+			},
+		},
+		{
+			name: "NotSubmitted",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ReopenTimesheetTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Timesheet{}, db.ErrTimesheetNotSubmitted)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusForbidden, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			buildValidSessionStubs(store, session)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			url := fmt.Sprintf("/api/v1/members/%s/timesheets/2023-08-07/reopen", member.ID)
+			request, err := http.NewRequest(http.MethodPost, url, nil)
+			require.NoError(t, err)
+
+			addSessionTokenInCookie(request, session.SessionToken.String())
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
@@ -23,6 +23,8 @@ type userResponse struct {
 	FirstName string    `json:"first_name"`
 	LastName  string    `json:"last_name"`
 	Email     string    `json:"email" validate:"required,email" swaggertype:"string"`
+	// IsAdmin tells that the user can decide on the leave requests and reopen the timesheets of every member.
+	IsAdmin bool `json:"is_admin"`
 }
 
 func newUserResponse(user db.User) userResponse {
@@ -31,10 +33,12 @@ func newUserResponse(user db.User) userResponse {
 		FirstName: user.FirstName,
 		LastName:  user.LastName,
 		Email:     user.Email,
+		IsAdmin:   user.IsAdmin,
 	}
 }
 
 // @Summary      Create user
+// @Description  The first user registered becomes an admin.
 // @Tags         users
 // @Param        body body createUserRequest true "User object"
 // @Success      200 {object} userResponse
@@ -65,7 +69,7 @@ func (server *Server) createUser(c *fiber.Ctx) error {
 		HashedPassword: hashedPassword,
 	}
 
-	user, err := server.store.CreateUser(c.Context(), arg)
+	user, err := server.store.CreateUserTx(c.Context(), arg)
 	if err != nil {
 		if pqErr, ok := err.(*pq.Error); ok {
 			switch pqErr.Code.Name() {
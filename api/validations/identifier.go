@@ -0,0 +1,14 @@
+package validations
+
+import (
+	"regexp"
+
+	"github.com/go-playground/validator/v10"
+)
+
+var snakeCaseRegexp = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// SnakeCase checks if the field value is a snake_case identifier starting with a lowercase letter
+func SnakeCase(fl validator.FieldLevel) bool {
+	return snakeCaseRegexp.MatchString(fl.Field().String())
+}
@@ -11,6 +11,7 @@ func registerValidations(v *validator.Validate) {
 	v.RegisterValidation("without_number", validations.WithoutNumber)
 	v.RegisterValidation("without_punct", validations.WithoutPunct)
 	v.RegisterValidation("without_symbol", validations.WithoutSymbol)
+	v.RegisterValidation("snake_case", validations.SnakeCase)
 }
 
 // newValidator func for create a new validator for api requests.
@@ -0,0 +1,353 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	db "github.com/ot07/coworker-backend/db/sqlc"
+)
+
+const (
+	// workingHoursLayout is the layout of the local times working hours start and end at.
+	workingHoursLayout = "15:04"
+	// endOfDay ends a working window at midnight.
+	endOfDay = "24:00"
+	// maxWorkingHoursOverlapMembers is how many members working hours can be compared for at once.
+	maxWorkingHoursOverlapMembers = 50
+)
+
+var (
+	errInvalidWorkingHours    = errors.New("working hours must be given as HH:MM, and end after they start")
+	errMemberWithoutTimeZone  = errors.New("the member has no time zone")
+	errWorkingHoursMemberList = fmt.Errorf("from 1 to %d member IDs must be given", maxWorkingHoursOverlapMembers)
+)
+
+// memberLocalTime returns the time it is now in the time zone of the member, unless they have none.
+func memberLocalTime(member db.Member) db.NullTime {
+	if !member.TimeZone.Valid {
+		return db.NullTime{}
+	}
+	location, err := db.LoadTimeZone(member.TimeZone.String)
+	if err != nil {
+		return db.NullTime{}
+	}
+	return db.NullTime{NullTime: sql.NullTime{Time: time.Now().In(location).Truncate(time.Second), Valid: true}}
+}
+
+// parseMinuteOfDay parses a local time given as HH:MM into minutes from midnight, 24:00 being the midnight ending the day.
+func parseMinuteOfDay(value string) (int32, error) {
+	if value == endOfDay {
+		return db.MinutesPerDay, nil
+	}
+	t, err := time.Parse(workingHoursLayout, value)
+	if err != nil || len(value) != len(workingHoursLayout) {
+		return 0, errInvalidWorkingHours
+	}
+	return int32(t.Hour()*60 + t.Minute()), nil
+}
+
+func formatMinuteOfDay(minute int32) string {
+	return fmt.Sprintf("%02d:%02d", minute/60, minute%60)
+}
+
+// parseWeekday parses the lower-case English name of a weekday.
+func parseWeekday(name string) (time.Weekday, bool) {
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		if strings.ToLower(day.String()) == name {
+			return day, true
+		}
+	}
+	return 0, false
+}
+
+type workingHoursWindow struct {
+	Weekday string `json:"weekday" validate:"required,oneof=monday tuesday wednesday thursday friday saturday sunday" enums:"monday,tuesday,wednesday,thursday,friday,saturday,sunday"`
+	// Start and End are local times as HH:MM. A window ending at midnight ends at 24:00.
+	Start string `json:"start" validate:"required" example:"09:00"`
+	End   string `json:"end" validate:"required" example:"17:30"`
+}
+
+type memberWorkingHoursResponse struct {
+	MemberID uuid.UUID `json:"member_id"`
+	// TimeZone is the IANA time zone the member works in, and LocalTime the time it is there now.
+	TimeZone  db.NullString        `json:"time_zone" swaggertype:"string" example:"Asia/Tokyo"`
+	LocalTime db.NullTime          `json:"local_time" swaggertype:"string" format:"date-time"`
+	Hours     []workingHoursWindow `json:"hours"`
+}
+
+func newMemberWorkingHoursResponse(member db.Member, hours []db.MemberWorkingHour) memberWorkingHoursResponse {
+	windows := make([]workingHoursWindow, 0, len(hours))
+	for _, hour := range hours {
+		windows = append(windows, workingHoursWindow{
+			Weekday: strings.ToLower(time.Weekday(hour.Weekday).String()),
+			Start:   formatMinuteOfDay(hour.StartMinute),
+			End:     formatMinuteOfDay(hour.EndMinute),
+		})
+	}
+
+	return memberWorkingHoursResponse{
+		MemberID:  member.ID,
+		TimeZone:  db.NullString{NullString: member.TimeZone},
+		LocalTime: memberLocalTime(member),
+		Hours:     windows,
+	}
+}
+
+type memberWorkingHoursRequestParams struct {
+	ID uuid.UUID `params:"id"`
+}
+
+// @Summary      Get member working hours
+// @Description  Returns the time zone of the member and their weekly working hours in local time.
+// @Tags         members
+// @Param        id path string true "Member ID"
+// @Success      200 {object} memberWorkingHoursResponse
+// @Failure      400 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /members/{id}/working-hours [get]
+func (server *Server) getMemberWorkingHours(c *fiber.Ctx) error {
+	params := new(memberWorkingHoursRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	member, err := server.store.GetMember(c.Context(), params.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return c.Status(fiber.StatusNotFound).JSON(newErrorResponse(err))
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	hours, err := server.store.ListMemberWorkingHours(c.Context(), []uuid.UUID{member.ID})
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	rsp := newMemberWorkingHoursResponse(member, hours)
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+type setMemberWorkingHoursRequestBody struct {
+	// TimeZone is an IANA time zone of the tz database.
+	TimeZone string `json:"time_zone" validate:"required,max=64" example:"Asia/Tokyo"`
+	// Hours are the weekly working windows, in local time. No two windows of a weekday can overlap.
+	Hours []workingHoursWindow `json:"hours" validate:"max=50,dive"`
+}
+
+// @Summary      Set member working hours
+// @Description  Sets the time zone of the member and replaces their weekly working hours, given in local time.
+// @Tags         members
+// @Param        id   path string                           true "Member ID"
+// @Param        body body setMemberWorkingHoursRequestBody true "Working hours object"
+// @Success      200 {object} memberWorkingHoursResponse
+// @Failure      400 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /members/{id}/working-hours [put]
+func (server *Server) setMemberWorkingHours(c *fiber.Ctx) error {
+	params := new(memberWorkingHoursRequestParams)
+	if err := c.ParamsParser(params); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	req := new(setMemberWorkingHoursRequestBody)
+	if err := c.BodyParser(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	validate := newValidator()
+	if err := validate.Struct(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	arg := db.SetMemberWorkingHoursTxParams{
+		MemberID: params.ID,
+		TimeZone: sql.NullString{String: req.TimeZone, Valid: true},
+		Hours:    make([]db.CreateMemberWorkingHoursParams, 0, len(req.Hours)),
+	}
+	for _, window := range req.Hours {
+		weekday, _ := parseWeekday(window.Weekday)
+		start, err := parseMinuteOfDay(window.Start)
+		if err != nil || start == db.MinutesPerDay {
+			return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(errInvalidWorkingHours))
+		}
+		end, err := parseMinuteOfDay(window.End)
+		if err != nil || end <= start {
+			return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(errInvalidWorkingHours))
+		}
+		arg.Hours = append(arg.Hours, db.CreateMemberWorkingHoursParams{
+			Weekday:     int32(weekday),
+			StartMinute: start,
+			EndMinute:   end,
+		})
+	}
+
+	result, err := server.store.SetMemberWorkingHoursTx(c.Context(), arg, auditMeta(c))
+	if err != nil {
+		switch {
+		case err == sql.ErrNoRows:
+			return c.Status(fiber.StatusNotFound).JSON(newErrorResponse(err))
+		case errors.Is(err, db.ErrInvalidTimeZone), errors.Is(err, db.ErrWorkingHoursOverlap):
+			return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+		}
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code.Name() == "check_violation" {
+			return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+
+	rsp := newMemberWorkingHoursResponse(result.Member, result.WorkingHours)
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+type getWorkingHoursOverlapRequestQuery struct {
+	// MemberIDs is a comma-separated list of the IDs of the members.
+	MemberIDs string `query:"member_ids" json:"member_ids" validate:"required"`
+	Date      string `query:"date" json:"date" validate:"required,datetime=2006-01-02" format:"date"`
+	// TimeZone is the IANA time zone the date is in, UTC by default.
+	TimeZone string `query:"time_zone" json:"time_zone" validate:"omitempty,max=64" example:"Europe/Paris"`
+}
+
+type memberLocalWindowResponse struct {
+	MemberID uuid.UUID `json:"member_id"`
+	TimeZone string    `json:"time_zone"`
+	// Start and End are the window in the local time of the member, with its UTC offset.
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+type workingWindowResponse struct {
+	// Start and End are the window in UTC.
+	Start time.Time                   `json:"start"`
+	End   time.Time                   `json:"end"`
+	Local []memberLocalWindowResponse `json:"local"`
+}
+
+type workingHoursOverlapResponse struct {
+	// From and To are the day the windows are searched in, in UTC.
+	From    time.Time               `json:"from"`
+	To      time.Time               `json:"to"`
+	Windows []workingWindowResponse `json:"windows"`
+}
+
+// @Summary      Get working hours overlap
+// @Description  Returns the windows of a day in which all the members work, from their weekly working hours and time zones.
+// @Description  Windows are given in UTC and in the local time of every member. Every member must have a time zone.
+// @Tags         members
+// @Param        query query getWorkingHoursOverlapRequestQuery true "query"
+// @Success      200 {object} workingHoursOverlapResponse
+// @Failure      400 {object} errorResponse
+// @Failure      403 {object} errorResponse
+// @Failure      404 {object} errorResponse
+// @Failure      500 {object} errorResponse
+// @Router       /working-hours/overlap [get]
+func (server *Server) getWorkingHoursOverlap(c *fiber.Ctx) error {
+	query := new(getWorkingHoursOverlapRequestQuery)
+	if err := c.QueryParser(query); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	validate := newValidator()
+	if err := validate.Struct(query); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+
+	ids, err := memberIDsFromCommaSeparatedString(query.MemberIDs)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+	ids = distinctMemberIDs(ids)
+	if len(ids) > maxWorkingHoursOverlapMembers {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(errWorkingHoursMemberList))
+	}
+
+	location := time.UTC
+	if len(query.TimeZone) > 0 {
+		if location, err = db.LoadTimeZone(query.TimeZone); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+		}
+	}
+	from, err := time.ParseInLocation(db.DateLayout, query.Date, location)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(newErrorResponse(err))
+	}
+	to := from.AddDate(0, 0, 1)
+
+	members, err := server.store.ListMembersByIDs(c.Context(), ids)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+	if len(members) != len(ids) {
+		return c.Status(fiber.StatusNotFound).JSON(newErrorResponse(sql.ErrNoRows))
+	}
+
+	locations := make(map[uuid.UUID]*time.Location, len(members))
+	for _, member := range members {
+		if !member.TimeZone.Valid {
+			err := fmt.Errorf("%w: %s %s", errMemberWithoutTimeZone, member.FirstName, member.LastName)
+			return c.Status(fiber.StatusForbidden).JSON(newErrorResponse(err))
+		}
+		if locations[member.ID], err = db.LoadTimeZone(member.TimeZone.String); err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+		}
+	}
+
+	hours, err := server.store.ListMemberWorkingHours(c.Context(), ids)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(newErrorResponse(err))
+	}
+	hoursByMember := make(map[uuid.UUID][]db.MemberWorkingHour)
+	for _, hour := range hours {
+		hoursByMember[hour.MemberID] = append(hoursByMember[hour.MemberID], hour)
+	}
+
+	overlap := []db.TimeWindow{{Start: from, End: to}}
+	for _, member := range members {
+		windows := db.WorkingWindows(hoursByMember[member.ID], locations[member.ID], from, to)
+		overlap = db.IntersectTimeWindows(overlap, windows)
+	}
+
+	rsp := workingHoursOverlapResponse{
+		From:    from.UTC(),
+		To:      to.UTC(),
+		Windows: make([]workingWindowResponse, 0, len(overlap)),
+	}
+	for _, window := range overlap {
+		local := make([]memberLocalWindowResponse, 0, len(members))
+		for _, member := range members {
+			local = append(local, memberLocalWindowResponse{
+				MemberID: member.ID,
+				TimeZone: member.TimeZone.String,
+				Start:    window.Start.In(locations[member.ID]),
+				End:      window.End.In(locations[member.ID]),
+			})
+		}
+		rsp.Windows = append(rsp.Windows, workingWindowResponse{
+			Start: window.Start.UTC(),
+			End:   window.End.UTC(),
+			Local: local,
+		})
+	}
+	return c.Status(fiber.StatusOK).JSON(rsp)
+}
+
+// distinctMemberIDs returns ids without the IDs given more than once, in the order first given.
+func distinctMemberIDs(ids []uuid.UUID) []uuid.UUID {
+	seen := make(map[uuid.UUID]bool, len(ids))
+	distinct := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			distinct = append(distinct, id)
+		}
+	}
+	return distinct
+}
@@ -0,0 +1,568 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	mockdb "github.com/ot07/coworker-backend/db/mock"
+	db "github.com/ot07/coworker-backend/db/sqlc"
+	"github.com/ot07/coworker-backend/util"
+	"github.com/stretchr/testify/require"
+)
+
+func randomMemberWorkingHour(memberID uuid.UUID, weekday time.Weekday, startMinute, endMinute int32) db.MemberWorkingHour {
+	return db.MemberWorkingHour{
+		ID:          util.RandomUUID(),
+		MemberID:    memberID,
+		Weekday:     int32(weekday),
+		StartMinute: startMinute,
+		EndMinute:   endMinute,
+	}
+}
+
+func TestParseMinuteOfDay(t *testing.T) {
+	testCases := []struct {
+		value  string
+		minute int32
+		ok     bool
+	}{
+		{value: "00:00", minute: 0, ok: true},
+		{value: "09:30", minute: 570, ok: true},
+		{value: "23:59", minute: 1439, ok: true},
+		{value: "24:00", minute: db.MinutesPerDay, ok: true},
+		{value: "24:01"},
+		{value: "9:30"},
+		{value: "09:60"},
+		{value: ""},
+	}
+
+	for _, tc := range testCases {
+		minute, err := parseMinuteOfDay(tc.value)
+		if !tc.ok {
+			require.ErrorIs(t, err, errInvalidWorkingHours, tc.value)
+			continue
+		}
+		require.NoError(t, err, tc.value)
+		require.Equal(t, tc.minute, minute, tc.value)
+		require.Equal(t, tc.value, formatMinuteOfDay(minute))
+	}
+}
+
+func TestGetMemberWorkingHoursAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	member := randomMember()
+	member.TimeZone = sql.NullString{String: "Asia/Tokyo", Valid: true}
+	hours := []db.MemberWorkingHour{
+		randomMemberWorkingHour(member.ID, time.Monday, 9*60, 12*60),
+		randomMemberWorkingHour(member.ID, time.Monday, 13*60, db.MinutesPerDay),
+	}
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetMember(gomock.Any(), gomock.Eq(member.ID)).
+					Times(1).
+					Return(member, nil)
+
+				store.EXPECT().
+					ListMemberWorkingHours(gomock.Any(), gomock.Eq([]uuid.UUID{member.ID})).
+					Times(1).
+					Return(hours, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+
+				got := requireBodyMemberWorkingHours(t, response.Body)
+				require.Equal(t, member.ID, got.MemberID)
+				require.Equal(t, member.TimeZone, got.TimeZone.NullString)
+				require.True(t, got.LocalTime.Valid)
+				_, offset := got.LocalTime.Time.Zone()
+				require.Equal(t, 9*60*60, offset)
+				require.Equal(t, []workingHoursWindow{
+					{Weekday: "monday", Start: "09:00", End: "12:00"},
+					{Weekday: "monday", Start: "13:00", End: "24:00"},
+				}, got.Hours)
+			},
+		},
+		{
+			name: "NotFound",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetMember(gomock.Any(), gomock.Eq(member.ID)).
+					Times(1).
+					Return(db.Member{}, sql.ErrNoRows)
+
+				store.EXPECT().
+					ListMemberWorkingHours(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusNotFound, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			buildValidSessionStubs(store, session)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			url := fmt.Sprintf("/api/v1/members/%s/working-hours", member.ID)
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			addSessionTokenInCookie(request, session.SessionToken.String())
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func TestSetMemberWorkingHoursAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	member := randomMember()
+	member.TimeZone = sql.NullString{String: "America/New_York", Valid: true}
+	hour := randomMemberWorkingHour(member.ID, time.Friday, 8*60+30, 17*60)
+
+	testCases := []struct {
+		name          string
+		body          fiber.Map
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name: "OK",
+			body: fiber.Map{
+				"time_zone": "America/New_York",
+				"hours": []fiber.Map{
+					{"weekday": "friday", "start": "08:30", "end": "17:00"},
+				},
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.SetMemberWorkingHoursTxParams{
+					MemberID: member.ID,
+					TimeZone: member.TimeZone,
+					Hours: []db.CreateMemberWorkingHoursParams{
+						{Weekday: int32(time.Friday), StartMinute: 8*60 + 30, EndMinute: 17 * 60},
+					},
+				}
+				store.EXPECT().
+					SetMemberWorkingHoursTx(gomock.Any(), gomock.Eq(arg), gomock.Any()).
+					Times(1).
+					Return(db.SetMemberWorkingHoursTxResult{Member: member, WorkingHours: []db.MemberWorkingHour{hour}}, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+
+				got := requireBodyMemberWorkingHours(t, response.Body)
+				require.Equal(t, member.TimeZone, got.TimeZone.NullString)
+				require.Equal(t, []workingHoursWindow{{Weekday: "friday", Start: "08:30", End: "17:00"}}, got.Hours)
+			},
+		},
+		{
+			name: "InvalidWeekday",
+			body: fiber.Map{
+				"time_zone": "America/New_York",
+				"hours": []fiber.Map{
+					{"weekday": "fri", "start": "08:30", "end": "17:00"},
+				},
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					SetMemberWorkingHoursTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "EndBeforeStart",
+			body: fiber.Map{
+				"time_zone": "America/New_York",
+				"hours": []fiber.Map{
+					{"weekday": "friday", "start": "17:00", "end": "08:30"},
+				},
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					SetMemberWorkingHoursTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "StartAtEndOfDay",
+			body: fiber.Map{
+				"time_zone": "America/New_York",
+				"hours": []fiber.Map{
+					{"weekday": "friday", "start": "24:00", "end": "24:00"},
+				},
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					SetMemberWorkingHoursTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "MissingTimeZone",
+			body: fiber.Map{
+				"hours": []fiber.Map{},
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					SetMemberWorkingHoursTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "InvalidTimeZone",
+			body: fiber.Map{
+				"time_zone": "Mars/Olympus_Mons",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					SetMemberWorkingHoursTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.SetMemberWorkingHoursTxResult{}, db.ErrInvalidTimeZone)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "Overlap",
+			body: fiber.Map{
+				"time_zone": "America/New_York",
+				"hours": []fiber.Map{
+					{"weekday": "friday", "start": "08:30", "end": "17:00"},
+					{"weekday": "friday", "start": "16:00", "end": "18:00"},
+				},
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					SetMemberWorkingHoursTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.SetMemberWorkingHoursTxResult{}, db.ErrWorkingHoursOverlap)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name: "NotFound",
+			body: fiber.Map{
+				"time_zone": "America/New_York",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					SetMemberWorkingHoursTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.SetMemberWorkingHoursTxResult{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusNotFound, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			buildValidSessionStubs(store, session)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/api/v1/members/%s/working-hours", member.ID)
+			request, err := http.NewRequest(http.MethodPut, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			request.Header.Set("Content-Type", "application/json")
+
+			addSessionTokenInCookie(request, session.SessionToken.String())
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func TestGetWorkingHoursOverlapAPI(t *testing.T) {
+	t.Parallel()
+
+	session := randomSession()
+	tokyo := randomMember()
+	tokyo.TimeZone = sql.NullString{String: "Asia/Tokyo", Valid: true}
+	london := randomMember()
+	london.TimeZone = sql.NullString{String: "Europe/London", Valid: true}
+	// 09:00 to 18:00 in Tokyo is 00:00 to 09:00 UTC, and 08:00 to 17:00 in London in summer is 07:00 to 16:00 UTC.
+	hours := []db.MemberWorkingHour{
+		randomMemberWorkingHour(tokyo.ID, time.Monday, 9*60, 18*60),
+		randomMemberWorkingHour(london.ID, time.Monday, 8*60, 17*60),
+	}
+	ids := []uuid.UUID{tokyo.ID, london.ID}
+
+	testCases := []struct {
+		name          string
+		query         string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, response *http.Response)
+	}{
+		{
+			name:  "OK",
+			query: fmt.Sprintf("?member_ids=%s,%s,%s&date=2023-08-07", tokyo.ID, london.ID, tokyo.ID),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListMembersByIDs(gomock.Any(), gomock.Eq(ids)).
+					Times(1).
+					Return([]db.Member{tokyo, london}, nil)
+
+				store.EXPECT().
+					ListMemberWorkingHours(gomock.Any(), gomock.Eq(ids)).
+					Times(1).
+					Return(hours, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+
+				got := requireBodyWorkingHoursOverlap(t, response.Body)
+				require.True(t, time.Date(2023, 8, 7, 0, 0, 0, 0, time.UTC).Equal(got.From))
+				require.True(t, time.Date(2023, 8, 8, 0, 0, 0, 0, time.UTC).Equal(got.To))
+				require.Len(t, got.Windows, 1)
+
+				window := got.Windows[0]
+				require.True(t, time.Date(2023, 8, 7, 7, 0, 0, 0, time.UTC).Equal(window.Start))
+				require.True(t, time.Date(2023, 8, 7, 9, 0, 0, 0, time.UTC).Equal(window.End))
+				require.Len(t, window.Local, 2)
+				require.Equal(t, tokyo.ID, window.Local[0].MemberID)
+				require.Equal(t, "Asia/Tokyo", window.Local[0].TimeZone)
+				require.Equal(t, 16, window.Local[0].Start.Hour())
+				require.Equal(t, 18, window.Local[0].End.Hour())
+				require.Equal(t, london.ID, window.Local[1].MemberID)
+				require.Equal(t, 8, window.Local[1].Start.Hour())
+				require.Equal(t, 10, window.Local[1].End.Hour())
+			},
+		},
+		{
+			name:  "InTimeZone",
+			query: fmt.Sprintf("?member_ids=%s,%s&date=2023-08-07&time_zone=Asia/Tokyo", tokyo.ID, london.ID),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListMembersByIDs(gomock.Any(), gomock.Eq(ids)).
+					Times(1).
+					Return([]db.Member{tokyo, london}, nil)
+
+				store.EXPECT().
+					ListMemberWorkingHours(gomock.Any(), gomock.Eq(ids)).
+					Times(1).
+					Return(hours, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+
+				got := requireBodyWorkingHoursOverlap(t, response.Body)
+				require.True(t, time.Date(2023, 8, 6, 15, 0, 0, 0, time.UTC).Equal(got.From))
+				require.True(t, time.Date(2023, 8, 7, 15, 0, 0, 0, time.UTC).Equal(got.To))
+				require.Len(t, got.Windows, 1)
+				require.True(t, time.Date(2023, 8, 7, 7, 0, 0, 0, time.UTC).Equal(got.Windows[0].Start))
+				require.True(t, time.Date(2023, 8, 7, 9, 0, 0, 0, time.UTC).Equal(got.Windows[0].End))
+			},
+		},
+		{
+			name:  "NoOverlap",
+			query: fmt.Sprintf("?member_ids=%s,%s&date=2023-08-08", tokyo.ID, london.ID),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListMembersByIDs(gomock.Any(), gomock.Eq(ids)).
+					Times(1).
+					Return([]db.Member{tokyo, london}, nil)
+
+				store.EXPECT().
+					ListMemberWorkingHours(gomock.Any(), gomock.Eq(ids)).
+					Times(1).
+					Return(hours, nil)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusOK, response.StatusCode)
+
+				got := requireBodyWorkingHoursOverlap(t, response.Body)
+				require.Empty(t, got.Windows)
+			},
+		},
+		{
+			name:  "WithoutTimeZone",
+			query: fmt.Sprintf("?member_ids=%s&date=2023-08-07", tokyo.ID),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListMembersByIDs(gomock.Any(), gomock.Eq([]uuid.UUID{tokyo.ID})).
+					Times(1).
+					Return([]db.Member{{ID: tokyo.ID}}, nil)
+
+				store.EXPECT().
+					ListMemberWorkingHours(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusForbidden, response.StatusCode)
+			},
+		},
+		{
+			name:  "MemberNotFound",
+			query: fmt.Sprintf("?member_ids=%s,%s&date=2023-08-07", tokyo.ID, london.ID),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListMembersByIDs(gomock.Any(), gomock.Eq(ids)).
+					Times(1).
+					Return([]db.Member{tokyo}, nil)
+
+				store.EXPECT().
+					ListMemberWorkingHours(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusNotFound, response.StatusCode)
+			},
+		},
+		{
+			name:  "InvalidTimeZone",
+			query: fmt.Sprintf("?member_ids=%s&date=2023-08-07&time_zone=Local", tokyo.ID),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListMembersByIDs(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name:  "InvalidDate",
+			query: fmt.Sprintf("?member_ids=%s&date=2023-08-32", tokyo.ID),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListMembersByIDs(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+		{
+			name:  "MissingMemberIDs",
+			query: "?date=2023-08-07",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListMembersByIDs(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, response *http.Response) {
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			buildValidSessionStubs(store, session)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			url := "/api/v1/working-hours/overlap" + tc.query
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			addSessionTokenInCookie(request, session.SessionToken.String())
+			response, err := server.app.Test(request, int(time.Second.Milliseconds()))
+			require.NoError(t, err)
+
+			tc.checkResponse(t, response)
+		})
+	}
+}
+
+func requireBodyMemberWorkingHours(t *testing.T, body io.ReadCloser) memberWorkingHoursResponse {
+	data, err := io.ReadAll(body)
+	require.NoError(t, err)
+
+	var got memberWorkingHoursResponse
+	err = json.Unmarshal(data, &got)
+	require.NoError(t, err)
+
+	err = body.Close()
+	require.NoError(t, err)
+	return got
+}
+
+func requireBodyWorkingHoursOverlap(t *testing.T, body io.ReadCloser) workingHoursOverlapResponse {
+	data, err := io.ReadAll(body)
+	require.NoError(t, err)
+
+	var got workingHoursOverlapResponse
+	err = json.Unmarshal(data, &got)
+	require.NoError(t, err)
+
+	err = body.Close()
+	require.NoError(t, err)
+	return got
+}
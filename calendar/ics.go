@@ -0,0 +1,75 @@
+package calendar
+
+import (
+	"bufio"
+	"io"
+	"time"
+
+	"github.com/ot07/coworker-backend/util/contentline"
+)
+
+// ContentType is the media type of an iCalendar document.
+const ContentType = "text/calendar; charset=utf-8"
+
+// dateTimeLayout is the layout of the date-times of an iCalendar document, in UTC.
+const dateTimeLayout = "20060102T150405Z"
+
+// Event is a VEVENT of an iCalendar document, repeated by Rule unless it is empty.
+type Event struct {
+	UID         string
+	Summary     string
+	Location    string
+	Description string
+	Start       time.Time
+	End         time.Time
+	Rule        string
+	// ExDates lists the starts of the occurrences of a recurring event that are canceled.
+	ExDates []time.Time
+	// Stamp is when the event was last changed.
+	Stamp time.Time
+}
+
+// WriteCalendar writes an iCalendar document named name holding events, for calendar clients to subscribe to.
+func WriteCalendar(w io.Writer, name string, events []Event) error {
+	bw := bufio.NewWriter(w)
+	writeLine(bw, "BEGIN:VCALENDAR")
+	writeLine(bw, "VERSION:2.0")
+	writeLine(bw, "PRODID:-//coworker//coworker-backend//EN")
+	writeLine(bw, "CALSCALE:GREGORIAN")
+	writeLine(bw, "METHOD:PUBLISH")
+	writeLine(bw, "X-WR-CALNAME:"+contentline.EscapeText(name))
+
+	for _, event := range events {
+		writeLine(bw, "BEGIN:VEVENT")
+		writeLine(bw, "UID:"+event.UID)
+		writeLine(bw, "DTSTAMP:"+formatDateTime(event.Stamp))
+		writeLine(bw, "DTSTART:"+formatDateTime(event.Start))
+		writeLine(bw, "DTEND:"+formatDateTime(event.End))
+		if len(event.Rule) > 0 {
+			writeLine(bw, "RRULE:"+event.Rule)
+		}
+		for _, exDate := range event.ExDates {
+			writeLine(bw, "EXDATE:"+formatDateTime(exDate))
+		}
+		writeLine(bw, "SUMMARY:"+contentline.EscapeText(event.Summary))
+		if len(event.Location) > 0 {
+			writeLine(bw, "LOCATION:"+contentline.EscapeText(event.Location))
+		}
+		if len(event.Description) > 0 {
+			writeLine(bw, "DESCRIPTION:"+contentline.EscapeText(event.Description))
+		}
+		writeLine(bw, "END:VEVENT")
+	}
+
+	writeLine(bw, "END:VCALENDAR")
+	return bw.Flush()
+}
+
+func formatDateTime(t time.Time) string {
+	return t.UTC().Format(dateTimeLayout)
+}
+
+// writeLine writes a folded content line. Errors are reported by Flush.
+func writeLine(w *bufio.Writer, line string) {
+	w.WriteString(contentline.FoldLine(line))
+}
@@ -0,0 +1,87 @@
+package calendar
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ot07/coworker-backend/util/contentline"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteCalendar(t *testing.T) {
+	start := time.Date(2023, 4, 3, 9, 0, 0, 0, time.UTC)
+	events := []Event{
+		{
+			UID:      "1@coworker",
+			Summary:  "Standup; daily, short",
+			Location: "Room A",
+			Start:    start,
+			End:      start.Add(15 * time.Minute),
+			Rule:     "FREQ=DAILY;COUNT=3",
+			ExDates:  []time.Time{start.AddDate(0, 0, 1)},
+			Stamp:    start.Add(-time.Hour),
+		},
+		{
+			UID:         "2@coworker",
+			Summary:     "Review",
+			Description: "Line one\nLine two",
+			Start:       start.Add(time.Hour),
+			End:         start.Add(2 * time.Hour),
+			Stamp:       start.Add(-time.Hour),
+		},
+	}
+
+	var buf bytes.Buffer
+	err := WriteCalendar(&buf, "Room A", events)
+	require.NoError(t, err)
+
+	want := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//coworker//coworker-backend//EN",
+		"CALSCALE:GREGORIAN",
+		"METHOD:PUBLISH",
+		"X-WR-CALNAME:Room A",
+		"BEGIN:VEVENT",
+		"UID:1@coworker",
+		"DTSTAMP:20230403T080000Z",
+		"DTSTART:20230403T090000Z",
+		"DTEND:20230403T091500Z",
+		"RRULE:FREQ=DAILY;COUNT=3",
+		"EXDATE:20230404T090000Z",
+		`SUMMARY:Standup\; daily\, short`,
+		"LOCATION:Room A",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:2@coworker",
+		"DTSTAMP:20230403T080000Z",
+		"DTSTART:20230403T100000Z",
+		"DTEND:20230403T110000Z",
+		"SUMMARY:Review",
+		`DESCRIPTION:Line one\nLine two`,
+		"END:VEVENT",
+		"END:VCALENDAR",
+		"",
+	}, "\r\n")
+	require.Equal(t, want, buf.String())
+}
+
+func TestWriteLineFolding(t *testing.T) {
+	var buf bytes.Buffer
+	summary := strings.Repeat("会議", 30)
+	err := WriteCalendar(&buf, summary, nil)
+	require.NoError(t, err)
+
+	var unfolded []string
+	for _, line := range strings.Split(strings.TrimSuffix(buf.String(), "\r\n"), "\r\n") {
+		require.LessOrEqual(t, len(line), contentline.MaxLineLength)
+		if strings.HasPrefix(line, " ") {
+			unfolded[len(unfolded)-1] += line[1:]
+			continue
+		}
+		unfolded = append(unfolded, line)
+	}
+	require.Contains(t, unfolded, "X-WR-CALNAME:"+summary)
+}
@@ -0,0 +1,242 @@
+package calendar
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Frequencies a recurrence rule can repeat at.
+const (
+	FrequencyDaily   = "DAILY"
+	FrequencyWeekly  = "WEEKLY"
+	FrequencyMonthly = "MONTHLY"
+)
+
+const (
+	// MaxCount is the largest number of occurrences a recurrence rule can have.
+	MaxCount = 500
+	// MaxSpan is how long after its first occurrence a recurrence can last.
+	MaxSpan = 2 * 366 * 24 * time.Hour
+)
+
+// untilLayout is the layout of the UNTIL part of a recurrence rule, in UTC.
+const untilLayout = "20060102T150405Z"
+
+// ErrInvalidRule is returned for a recurrence rule that is malformed or not supported.
+var ErrInvalidRule = errors.New("invalid recurrence rule")
+
+var weekdays = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+var weekdayNames = [...]string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+// Rule is an RRULE of RFC 5545, limited to what reservations need: DAILY, WEEKLY and MONTHLY frequencies,
+// an INTERVAL, weekdays for a weekly rule, and an end given by either COUNT or UNTIL.
+// Weeks start on Monday. A rule without an end is not supported, so that every occurrence can be checked for conflicts.
+type Rule struct {
+	Frequency string
+	Interval  int
+	Count     int
+	Until     time.Time
+	ByDay     []time.Weekday
+}
+
+// ParseRule parses the value of an RRULE property, with or without its "RRULE:" name.
+func ParseRule(value string) (Rule, error) {
+	rule := Rule{Interval: 1}
+
+	value = strings.TrimPrefix(strings.TrimSpace(value), "RRULE:")
+	for _, part := range strings.Split(value, ";") {
+		name, v, ok := strings.Cut(part, "=")
+		if !ok {
+			return Rule{}, fmt.Errorf("%w: malformed part %q", ErrInvalidRule, part)
+		}
+
+		var err error
+		switch strings.ToUpper(name) {
+		case "FREQ":
+			rule.Frequency = strings.ToUpper(v)
+			switch rule.Frequency {
+			case FrequencyDaily, FrequencyWeekly, FrequencyMonthly:
+			default:
+				return Rule{}, fmt.Errorf("%w: unsupported frequency %q", ErrInvalidRule, v)
+			}
+		case "INTERVAL":
+			rule.Interval, err = strconv.Atoi(v)
+			if err != nil || rule.Interval < 1 {
+				return Rule{}, fmt.Errorf("%w: invalid interval %q", ErrInvalidRule, v)
+			}
+		case "COUNT":
+			rule.Count, err = strconv.Atoi(v)
+			if err != nil || rule.Count < 1 || rule.Count > MaxCount {
+				return Rule{}, fmt.Errorf("%w: count must be from 1 to %d", ErrInvalidRule, MaxCount)
+			}
+		case "UNTIL":
+			rule.Until, err = parseUntil(v)
+			if err != nil {
+				return Rule{}, fmt.Errorf("%w: invalid until %q", ErrInvalidRule, v)
+			}
+		case "BYDAY":
+			rule.ByDay = nil
+			for _, name := range strings.Split(v, ",") {
+				day, ok := weekdays[strings.ToUpper(name)]
+				if !ok {
+					return Rule{}, fmt.Errorf("%w: unsupported weekday %q", ErrInvalidRule, name)
+				}
+				rule.ByDay = append(rule.ByDay, day)
+			}
+		case "WKST":
+			if strings.ToUpper(v) != "MO" {
+				return Rule{}, fmt.Errorf("%w: weeks can only start on Monday", ErrInvalidRule)
+			}
+		default:
+			return Rule{}, fmt.Errorf("%w: unsupported part %q", ErrInvalidRule, name)
+		}
+	}
+
+	switch {
+	case len(rule.Frequency) == 0:
+		return Rule{}, fmt.Errorf("%w: the frequency is missing", ErrInvalidRule)
+	case rule.Count == 0 && rule.Until.IsZero():
+		return Rule{}, fmt.Errorf("%w: a recurrence must end with either a count or an until", ErrInvalidRule)
+	case rule.Count > 0 && !rule.Until.IsZero():
+		return Rule{}, fmt.Errorf("%w: count and until cannot be given together", ErrInvalidRule)
+	case len(rule.ByDay) > 0 && rule.Frequency != FrequencyWeekly:
+		return Rule{}, fmt.Errorf("%w: weekdays can only be given to a weekly recurrence", ErrInvalidRule)
+	}
+
+	sort.Slice(rule.ByDay, func(i, j int) bool {
+		return daysSinceMonday(rule.ByDay[i]) < daysSinceMonday(rule.ByDay[j])
+	})
+	return rule, nil
+}
+
+// parseUntil parses an UNTIL in UTC. A date alone lasts until the end of the day.
+func parseUntil(value string) (time.Time, error) {
+	if len(value) == len("20060102") {
+		date, err := time.Parse("20060102", value)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return date.Add(24*time.Hour - time.Second), nil
+	}
+	return time.Parse(untilLayout, value)
+}
+
+// String formats the rule as the value of an RRULE property.
+func (rule Rule) String() string {
+	parts := []string{"FREQ=" + rule.Frequency}
+	if rule.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(rule.Interval))
+	}
+	if len(rule.ByDay) > 0 {
+		names := make([]string, 0, len(rule.ByDay))
+		for _, day := range rule.ByDay {
+			names = append(names, weekdayNames[day])
+		}
+		parts = append(parts, "BYDAY="+strings.Join(names, ","))
+	}
+	if rule.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(rule.Count))
+	} else {
+		parts = append(parts, "UNTIL="+rule.Until.UTC().Format(untilLayout))
+	}
+	return strings.Join(parts, ";")
+}
+
+// Starts expands the rule from its first occurrence, start, into the start of every occurrence.
+// The first occurrence must match the rule, and the last one must begin within MaxSpan of it.
+func (rule Rule) Starts(start time.Time) ([]time.Time, error) {
+	start = start.UTC()
+	if !rule.Until.IsZero() && rule.Until.Before(start) {
+		return nil, fmt.Errorf("%w: until is before the first occurrence", ErrInvalidRule)
+	}
+
+	days := rule.ByDay
+	if len(days) == 0 {
+		days = []time.Weekday{start.Weekday()}
+	} else if rule.Frequency == FrequencyWeekly && !containsWeekday(days, start.Weekday()) {
+		return nil, fmt.Errorf("%w: the first occurrence is not on one of the weekdays", ErrInvalidRule)
+	}
+
+	limit := start.Add(MaxSpan)
+	var starts []time.Time
+	// add appends an occurrence, telling whether the rule goes on after it.
+	add := func(t time.Time) (bool, error) {
+		if !rule.Until.IsZero() && t.After(rule.Until) {
+			return false, nil
+		}
+		if t.After(limit) {
+			return false, fmt.Errorf("%w: a recurrence cannot last longer than %d days", ErrInvalidRule, int(MaxSpan.Hours()/24))
+		}
+		starts = append(starts, t)
+		return rule.Count == 0 || len(starts) < rule.Count, nil
+	}
+
+	switch rule.Frequency {
+	case FrequencyDaily:
+		for i := 0; ; i++ {
+			more, err := add(start.AddDate(0, 0, i*rule.Interval))
+			if err != nil || !more {
+				return starts, err
+			}
+		}
+	case FrequencyWeekly:
+		weekStart := start.AddDate(0, 0, -daysSinceMonday(start.Weekday()))
+		for i := 0; ; i++ {
+			for _, day := range days {
+				t := weekStart.AddDate(0, 0, i*7*rule.Interval+daysSinceMonday(day))
+				if t.Before(start) {
+					continue
+				}
+				more, err := add(t)
+				if err != nil || !more {
+					return starts, err
+				}
+			}
+		}
+	case FrequencyMonthly:
+		// Months without the day of the month of the first occurrence are skipped, as RFC 5545 asks.
+		for i := 0; ; i++ {
+			t := time.Date(start.Year(), start.Month()+time.Month(i*rule.Interval), start.Day(),
+				start.Hour(), start.Minute(), start.Second(), start.Nanosecond(), time.UTC)
+			if t.Day() != start.Day() {
+				if t.After(limit) {
+					_, err := add(t)
+					return starts, err
+				}
+				continue
+			}
+			more, err := add(t)
+			if err != nil || !more {
+				return starts, err
+			}
+		}
+	}
+	return nil, fmt.Errorf("%w: unsupported frequency %q", ErrInvalidRule, rule.Frequency)
+}
+
+// daysSinceMonday returns how many days a weekday comes after Monday.
+func daysSinceMonday(day time.Weekday) int {
+	return (int(day) + 6) % 7
+}
+
+func containsWeekday(days []time.Weekday, day time.Weekday) bool {
+	for _, d := range days {
+		if d == day {
+			return true
+		}
+	}
+	return false
+}
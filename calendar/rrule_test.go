@@ -0,0 +1,114 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRule(t *testing.T) {
+	rule, err := ParseRule("RRULE:FREQ=weekly;INTERVAL=2;BYDAY=FR,MO;UNTIL=20230630")
+	require.NoError(t, err)
+	require.Equal(t, FrequencyWeekly, rule.Frequency)
+	require.Equal(t, 2, rule.Interval)
+	require.Equal(t, []time.Weekday{time.Monday, time.Friday}, rule.ByDay)
+	require.Equal(t, time.Date(2023, 6, 30, 23, 59, 59, 0, time.UTC), rule.Until)
+	require.Equal(t, "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,FR;UNTIL=20230630T235959Z", rule.String())
+
+	rule, err = ParseRule("FREQ=DAILY;COUNT=3")
+	require.NoError(t, err)
+	require.Equal(t, 1, rule.Interval)
+	require.Equal(t, "FREQ=DAILY;COUNT=3", rule.String())
+
+	for _, value := range []string{
+		"",
+		"COUNT=3",
+		"FREQ=YEARLY;COUNT=3",
+		"FREQ=DAILY",
+		"FREQ=DAILY;COUNT=3;UNTIL=20230630",
+		"FREQ=DAILY;COUNT=0",
+		"FREQ=DAILY;COUNT=501",
+		"FREQ=DAILY;INTERVAL=0;COUNT=3",
+		"FREQ=DAILY;BYDAY=MO;COUNT=3",
+		"FREQ=WEEKLY;BYDAY=1MO;COUNT=3",
+		"FREQ=WEEKLY;WKST=SU;COUNT=3",
+		"FREQ=MONTHLY;BYMONTHDAY=1;COUNT=3",
+		"FREQ=DAILY;UNTIL=tomorrow",
+	} {
+		_, err := ParseRule(value)
+		require.ErrorIs(t, err, ErrInvalidRule, value)
+	}
+}
+
+func TestRuleStarts(t *testing.T) {
+	// A Monday.
+	start := time.Date(2023, 4, 3, 9, 0, 0, 0, time.UTC)
+	date := func(month time.Month, day int) time.Time {
+		return time.Date(2023, month, day, 9, 0, 0, 0, time.UTC)
+	}
+
+	testCases := []struct {
+		rule   string
+		start  time.Time
+		starts []time.Time
+	}{
+		{
+			rule:   "FREQ=DAILY;COUNT=3",
+			start:  start,
+			starts: []time.Time{date(4, 3), date(4, 4), date(4, 5)},
+		},
+		{
+			rule:   "FREQ=DAILY;INTERVAL=3;UNTIL=20230409T090000Z",
+			start:  start,
+			starts: []time.Time{date(4, 3), date(4, 6), date(4, 9)},
+		},
+		{
+			rule:   "FREQ=WEEKLY;COUNT=2",
+			start:  start,
+			starts: []time.Time{date(4, 3), date(4, 10)},
+		},
+		{
+			rule:   "FREQ=WEEKLY;BYDAY=MO,WE;COUNT=5",
+			start:  date(4, 5),
+			starts: []time.Time{date(4, 5), date(4, 10), date(4, 12), date(4, 17), date(4, 19)},
+		},
+		{
+			rule:   "FREQ=WEEKLY;INTERVAL=2;BYDAY=TU,SU;UNTIL=20230420",
+			start:  date(4, 4),
+			starts: []time.Time{date(4, 4), date(4, 9), date(4, 18)},
+		},
+		{
+			rule:   "FREQ=MONTHLY;COUNT=3",
+			start:  date(1, 31),
+			starts: []time.Time{date(1, 31), date(3, 31), date(5, 31)},
+		},
+	}
+
+	for _, tc := range testCases {
+		rule, err := ParseRule(tc.rule)
+		require.NoError(t, err, tc.rule)
+
+		starts, err := rule.Starts(tc.start)
+		require.NoError(t, err, tc.rule)
+		require.Equal(t, tc.starts, starts, tc.rule)
+	}
+}
+
+func TestRuleStartsErrors(t *testing.T) {
+	start := time.Date(2023, 4, 3, 9, 0, 0, 0, time.UTC)
+
+	for _, value := range []string{
+		// The first occurrence is on a Monday.
+		"FREQ=WEEKLY;BYDAY=TU;COUNT=3",
+		"FREQ=DAILY;UNTIL=20230402",
+		"FREQ=MONTHLY;INTERVAL=13;COUNT=3",
+		"FREQ=DAILY;UNTIL=20300101",
+	} {
+		rule, err := ParseRule(value)
+		require.NoError(t, err, value)
+
+		_, err = rule.Starts(start)
+		require.ErrorIs(t, err, ErrInvalidRule, value)
+	}
+}
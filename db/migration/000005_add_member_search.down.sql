@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS "members_search_text_trgm_idx";
+DROP INDEX IF EXISTS "members_search_vector_idx";
+ALTER TABLE "members" DROP COLUMN IF EXISTS "search_vector";
+DROP FUNCTION IF EXISTS normalize_search_text(text);
+DROP EXTENSION IF EXISTS "pg_trgm";
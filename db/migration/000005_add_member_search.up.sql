@@ -0,0 +1,20 @@
+CREATE EXTENSION IF NOT EXISTS "pg_trgm";
+
+-- normalize_search_text folds width and case (e.g. "Ｙａｍａｄａ" and "ﾔﾏﾀﾞ")
+-- so that Japanese and Latin names compare the same way when searching.
+CREATE FUNCTION normalize_search_text(text) RETURNS text
+    LANGUAGE sql IMMUTABLE STRICT PARALLEL SAFE
+AS $$ SELECT lower(normalize($1, NFKC)) $$;
+
+ALTER TABLE "members" ADD COLUMN "search_vector" tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('simple', normalize_search_text("first_name" || ' ' || "last_name")), 'A') ||
+    setweight(to_tsvector('simple', normalize_search_text(coalesce("email", ''))), 'B')
+) STORED;
+
+CREATE INDEX "members_search_vector_idx" ON "members" USING GIN ("search_vector");
+
+-- The parsers of text search do not split CJK text into words, so trigram
+-- similarity on the normalized text is used for partial and fuzzy matches.
+CREATE INDEX "members_search_text_trgm_idx" ON "members" USING GIN (
+    normalize_search_text("first_name" || ' ' || "last_name" || ' ' || coalesce("email", '')) gin_trgm_ops
+);
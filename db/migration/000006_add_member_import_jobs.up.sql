@@ -0,0 +1,12 @@
+CREATE TABLE "member_import_jobs"
+(
+    "id"            uuid PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    "status"        varchar          NOT NULL DEFAULT 'running',
+    "dry_run"       boolean          NOT NULL,
+    "total_rows"    integer          NOT NULL,
+    "imported_rows" integer          NOT NULL DEFAULT 0,
+    "errors"        jsonb            NOT NULL DEFAULT '[]',
+    "created_at"    timestamptz      NOT NULL DEFAULT (now()),
+    "finished_at"   timestamptz,
+    CHECK ("status" IN ('running', 'succeeded', 'failed'))
+);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS "members_deleted_at_idx";
+DELETE FROM "members" WHERE "deleted_at" IS NOT NULL;
+ALTER TABLE "members" DROP COLUMN IF EXISTS "deleted_at";
@@ -0,0 +1,5 @@
+ALTER TABLE "members" ADD COLUMN "deleted_at" timestamptz;
+
+-- Only deleted members are looked up by deleted_at (trash view and purge),
+-- so the index is kept small by leaving active members out of it.
+CREATE INDEX "members_deleted_at_idx" ON "members" ("deleted_at") WHERE "deleted_at" IS NOT NULL;
@@ -0,0 +1,3 @@
+-- version is incremented on every update and exposed as the ETag of a member,
+-- so that concurrent updates based on a stale representation can be rejected.
+ALTER TABLE "members" ADD COLUMN "version" integer NOT NULL DEFAULT 1;
@@ -0,0 +1,16 @@
+CREATE TABLE "audit_events"
+(
+    "id"          uuid PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    "actor_id"    uuid REFERENCES "users" ("id") ON DELETE SET NULL,
+    "action"      varchar          NOT NULL,
+    "entity_type" varchar          NOT NULL,
+    -- entity_id has no foreign key so that the history outlives purged entities.
+    "entity_id"   uuid             NOT NULL,
+    "changes"     jsonb            NOT NULL DEFAULT '{}',
+    "request_id"  varchar          NOT NULL,
+    "created_at"  timestamptz      NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX "audit_events_entity_idx" ON "audit_events" ("entity_type", "entity_id", "created_at" DESC);
+CREATE INDEX "audit_events_created_at_idx" ON "audit_events" ("created_at" DESC);
+CREATE INDEX "audit_events_actor_id_idx" ON "audit_events" ("actor_id");
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS "members_custom_fields_idx";
+
+ALTER TABLE "members" DROP COLUMN IF EXISTS "custom_fields";
+
+DROP TABLE IF EXISTS "custom_field_definitions";
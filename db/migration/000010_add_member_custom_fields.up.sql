@@ -0,0 +1,21 @@
+CREATE TABLE "custom_field_definitions"
+(
+    "id"         uuid PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    -- key is the name of the field within members.custom_fields.
+    "key"        varchar UNIQUE   NOT NULL CHECK ("key" ~ '^[a-z][a-z0-9_]*$'),
+    "label"      varchar          NOT NULL,
+    "type"       varchar          NOT NULL CHECK ("type" IN ('text', 'number', 'date', 'select', 'multi_select', 'url')),
+    "required"   boolean          NOT NULL DEFAULT false,
+    -- options lists the choices of select and multi_select fields.
+    "options"    varchar[]        NOT NULL DEFAULT '{}',
+    "min_length" integer,
+    "max_length" integer,
+    "pattern"    varchar,
+    "min_value"  double precision,
+    "max_value"  double precision,
+    "created_at" timestamptz      NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "members" ADD COLUMN "custom_fields" jsonb NOT NULL DEFAULT '{}';
+
+CREATE INDEX "members_custom_fields_idx" ON "members" USING GIN ("custom_fields" jsonb_path_ops);
@@ -0,0 +1,19 @@
+CREATE TABLE "tags"
+(
+    "id"         uuid PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    "name"       varchar          NOT NULL,
+    "created_at" timestamptz      NOT NULL DEFAULT (now())
+);
+
+-- Tag names are unique regardless of case, so that "Remote" and "remote" cannot coexist.
+CREATE UNIQUE INDEX "tags_name_key" ON "tags" (lower("name"));
+
+CREATE TABLE "member_tags"
+(
+    "member_id"  uuid        NOT NULL REFERENCES "members" ("id") ON DELETE CASCADE,
+    "tag_id"     uuid        NOT NULL REFERENCES "tags" ("id") ON DELETE CASCADE,
+    "created_at" timestamptz NOT NULL DEFAULT (now()),
+    PRIMARY KEY ("member_id", "tag_id")
+);
+
+CREATE INDEX "member_tags_tag_id_idx" ON "member_tags" ("tag_id");
@@ -0,0 +1,21 @@
+CREATE TABLE "teams"
+(
+    "id"         uuid PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    "name"       varchar          NOT NULL,
+    "parent_id"  uuid REFERENCES "teams" ("id"),
+    "created_at" timestamptz      NOT NULL DEFAULT (now()),
+    CHECK ("parent_id" <> "id")
+);
+
+CREATE INDEX "teams_parent_id_idx" ON "teams" ("parent_id");
+
+CREATE TABLE "team_members"
+(
+    "team_id"    uuid        NOT NULL REFERENCES "teams" ("id") ON DELETE CASCADE,
+    "member_id"  uuid        NOT NULL REFERENCES "members" ("id") ON DELETE CASCADE,
+    "role"       varchar     NOT NULL DEFAULT 'member' CHECK ("role" IN ('lead', 'member')),
+    "created_at" timestamptz NOT NULL DEFAULT (now()),
+    PRIMARY KEY ("team_id", "member_id")
+);
+
+CREATE INDEX "team_members_member_id_idx" ON "team_members" ("member_id");
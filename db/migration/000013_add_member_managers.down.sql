@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS "members_manager_id_idx";
+
+ALTER TABLE "members" DROP COLUMN IF EXISTS "manager_id";
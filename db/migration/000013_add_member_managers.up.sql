@@ -0,0 +1,5 @@
+ALTER TABLE "members" ADD COLUMN "manager_id" uuid REFERENCES "members" ("id") ON DELETE SET NULL;
+
+ALTER TABLE "members" ADD CONSTRAINT "members_manager_id_check" CHECK ("manager_id" <> "id");
+
+CREATE INDEX "members_manager_id_idx" ON "members" ("manager_id");
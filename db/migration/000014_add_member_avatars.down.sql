@@ -0,0 +1,3 @@
+ALTER TABLE "members" DROP COLUMN IF EXISTS "avatar_url";
+
+ALTER TABLE "members" DROP COLUMN IF EXISTS "avatar_key";
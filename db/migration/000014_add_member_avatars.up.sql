@@ -0,0 +1,4 @@
+-- avatar_key is the storage key prefix of the avatar thumbnails and avatar_url the public URL of that prefix.
+ALTER TABLE "members" ADD COLUMN "avatar_key" varchar;
+
+ALTER TABLE "members" ADD COLUMN "avatar_url" varchar;
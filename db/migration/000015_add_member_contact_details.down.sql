@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS "member_links";
+
+DROP TABLE IF EXISTS "member_addresses";
+
+DROP TABLE IF EXISTS "member_phones";
+
+DROP TABLE IF EXISTS "member_emails";
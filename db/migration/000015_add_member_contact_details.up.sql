@@ -0,0 +1,68 @@
+CREATE TABLE "member_emails"
+(
+    "id"         uuid PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    "member_id"  uuid             NOT NULL REFERENCES "members" ("id") ON DELETE CASCADE,
+    "label"      varchar          NOT NULL CHECK ("label" IN ('work', 'personal', 'other')),
+    "email"      varchar          NOT NULL,
+    "is_primary" boolean          NOT NULL DEFAULT false,
+    "position"   integer          NOT NULL DEFAULT 0,
+    "created_at" timestamptz      NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX "member_emails_member_id_idx" ON "member_emails" ("member_id");
+
+-- A member has at most one primary email, and no two members share the same primary email.
+CREATE UNIQUE INDEX "member_emails_member_id_primary_idx" ON "member_emails" ("member_id") WHERE "is_primary";
+CREATE UNIQUE INDEX "member_emails_primary_email_idx" ON "member_emails" (lower("email")) WHERE "is_primary";
+
+CREATE TABLE "member_phones"
+(
+    "id"         uuid PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    "member_id"  uuid             NOT NULL REFERENCES "members" ("id") ON DELETE CASCADE,
+    "label"      varchar          NOT NULL CHECK ("label" IN ('work', 'mobile', 'home', 'other')),
+    -- Numbers are stored in the E.164 format, such as +81312345678.
+    "number"     varchar          NOT NULL CHECK ("number" ~ '^\+[1-9][0-9]{1,14}$'),
+    "position"   integer          NOT NULL DEFAULT 0,
+    "created_at" timestamptz      NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX "member_phones_member_id_idx" ON "member_phones" ("member_id");
+
+CREATE TABLE "member_addresses"
+(
+    "id"          uuid PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    "member_id"   uuid             NOT NULL REFERENCES "members" ("id") ON DELETE CASCADE,
+    "label"       varchar          NOT NULL CHECK ("label" IN ('work', 'home', 'other')),
+    "street"      varchar          NOT NULL DEFAULT '',
+    "city"        varchar          NOT NULL DEFAULT '',
+    "region"      varchar          NOT NULL DEFAULT '',
+    "postal_code" varchar          NOT NULL DEFAULT '',
+    -- ISO 3166-1 alpha-2 country code.
+    "country"     varchar          NOT NULL CHECK ("country" ~ '^[A-Z]{2}$'),
+    "position"    integer          NOT NULL DEFAULT 0,
+    "created_at"  timestamptz      NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX "member_addresses_member_id_idx" ON "member_addresses" ("member_id");
+
+CREATE TABLE "member_links"
+(
+    "id"         uuid PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    "member_id"  uuid             NOT NULL REFERENCES "members" ("id") ON DELETE CASCADE,
+    "label"      varchar          NOT NULL,
+    "url"        varchar          NOT NULL,
+    "position"   integer          NOT NULL DEFAULT 0,
+    "created_at" timestamptz      NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX "member_links_member_id_idx" ON "member_links" ("member_id");
+
+-- The existing emails become the primary work emails of their members.
+-- When members share an email, only the earliest created one gets it as primary.
+INSERT INTO "member_emails" ("member_id", "label", "email", "is_primary")
+SELECT "id",
+       'work',
+       "email",
+       row_number() OVER (PARTITION BY lower("email") ORDER BY "created_at", "id") = 1
+FROM "members"
+WHERE "email" IS NOT NULL;
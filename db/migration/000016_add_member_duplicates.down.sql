@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS "members_name_trgm_idx";
+DROP INDEX IF EXISTS "member_emails_normalized_email_idx";
+DROP FUNCTION IF EXISTS normalize_email(text);
@@ -0,0 +1,12 @@
+-- normalize_email folds case and drops the "+tag" part of the local part,
+-- so that "Taro+work@Example.com" and "taro@example.com" are found to be the same address.
+CREATE FUNCTION normalize_email(text) RETURNS text
+    LANGUAGE sql IMMUTABLE STRICT PARALLEL SAFE
+AS $$ SELECT regexp_replace(lower(trim($1)), '\+[^@]*@', '@') $$;
+
+CREATE INDEX "member_emails_normalized_email_idx" ON "member_emails" (normalize_email("email"));
+
+-- Names alone are compared when looking for duplicates, which the search index over names and emails cannot serve.
+CREATE INDEX "members_name_trgm_idx" ON "members" USING GIN (
+    normalize_search_text("first_name" || ' ' || "last_name") gin_trgm_ops
+);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS "members_status_idx";
+ALTER TABLE "members"
+    DROP COLUMN IF EXISTS "end_date",
+    DROP COLUMN IF EXISTS "start_date",
+    DROP COLUMN IF EXISTS "status";
@@ -0,0 +1,9 @@
+-- Existing members are all working members, so they start out active.
+ALTER TABLE "members"
+    ADD COLUMN "status"     varchar NOT NULL DEFAULT 'active'
+        CHECK ("status" IN ('invited', 'onboarding', 'active', 'on_leave', 'offboarded')),
+    ADD COLUMN "start_date" date,
+    ADD COLUMN "end_date"   date,
+    ADD CONSTRAINT "members_end_date_check" CHECK ("end_date" >= "start_date");
+
+CREATE INDEX "members_status_idx" ON "members" ("status");
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS "member_checklist_tasks";
+DROP TABLE IF EXISTS "checklist_template_tasks";
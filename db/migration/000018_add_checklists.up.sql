@@ -0,0 +1,34 @@
+-- The tasks of the onboarding and offboarding templates of the workspace, from which the checklists of members are generated.
+CREATE TABLE "checklist_template_tasks"
+(
+    "id"              uuid PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    "kind"            varchar          NOT NULL CHECK ("kind" IN ('onboarding', 'offboarding')),
+    "title"           varchar          NOT NULL,
+    "description"     varchar          NOT NULL DEFAULT '',
+    "assignee_id"     uuid REFERENCES "users" ("id") ON DELETE SET NULL,
+    "due_offset_days" integer          NOT NULL DEFAULT 0,
+    "position"        integer          NOT NULL,
+    "created_at"      timestamptz      NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX "checklist_template_tasks_kind_idx" ON "checklist_template_tasks" ("kind", "position");
+
+CREATE TABLE "member_checklist_tasks"
+(
+    "id"           uuid PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    "member_id"    uuid             NOT NULL REFERENCES "members" ("id") ON DELETE CASCADE,
+    "kind"         varchar          NOT NULL CHECK ("kind" IN ('onboarding', 'offboarding')),
+    "title"        varchar          NOT NULL,
+    "description"  varchar          NOT NULL DEFAULT '',
+    "assignee_id"  uuid REFERENCES "users" ("id") ON DELETE SET NULL,
+    "due_date"     date             NOT NULL,
+    "position"     integer          NOT NULL,
+    "completed_at" timestamptz,
+    "completed_by" uuid REFERENCES "users" ("id") ON DELETE SET NULL,
+    "overdue_at"   timestamptz,
+    "created_at"   timestamptz      NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX "member_checklist_tasks_member_id_idx" ON "member_checklist_tasks" ("member_id");
+CREATE INDEX "member_checklist_tasks_open_idx" ON "member_checklist_tasks" ("assignee_id", "due_date")
+    WHERE "completed_at" IS NULL;
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS "desk_bookings";
+DROP FUNCTION IF EXISTS desk_booking_period(date, varchar);
+DROP TABLE IF EXISTS "desks";
+DROP TABLE IF EXISTS "floors";
+DROP TABLE IF EXISTS "locations";
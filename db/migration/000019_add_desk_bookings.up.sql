@@ -0,0 +1,61 @@
+CREATE EXTENSION IF NOT EXISTS "btree_gist";
+
+CREATE TABLE "locations"
+(
+    "id"                    uuid PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    "name"                  varchar          NOT NULL,
+    -- Booking rules: how many days ahead a desk can be booked, and how many bookings a member can hold within a week.
+    "max_advance_days"      integer          NOT NULL DEFAULT 14 CHECK ("max_advance_days" >= 0),
+    "max_bookings_per_week" integer          NOT NULL DEFAULT 5 CHECK ("max_bookings_per_week" > 0),
+    "created_at"            timestamptz      NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "floors"
+(
+    "id"          uuid PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    "location_id" uuid             NOT NULL REFERENCES "locations" ("id") ON DELETE CASCADE,
+    "name"        varchar          NOT NULL,
+    "level"       integer          NOT NULL DEFAULT 0,
+    "created_at"  timestamptz      NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX "floors_location_id_idx" ON "floors" ("location_id");
+
+CREATE TABLE "desks"
+(
+    "id"         uuid PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    "floor_id"   uuid             NOT NULL REFERENCES "floors" ("id") ON DELETE CASCADE,
+    "name"       varchar          NOT NULL,
+    "created_at" timestamptz      NOT NULL DEFAULT (now()),
+    UNIQUE ("floor_id", "name")
+);
+
+-- desk_booking_period returns the time range a slot of a day covers: the morning and the afternoon are the two halves of the day.
+CREATE FUNCTION desk_booking_period(date, varchar) RETURNS tsrange
+    LANGUAGE sql IMMUTABLE STRICT PARALLEL SAFE
+AS $$
+SELECT tsrange(
+    $1 + CASE $2 WHEN 'afternoon' THEN time '12:00' ELSE time '00:00' END,
+    $1 + CASE $2 WHEN 'morning' THEN time '12:00' ELSE time '24:00' END
+)
+$$;
+
+CREATE TABLE "desk_bookings"
+(
+    "id"          uuid PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    "desk_id"     uuid             NOT NULL REFERENCES "desks" ("id") ON DELETE CASCADE,
+    "member_id"   uuid             NOT NULL REFERENCES "members" ("id") ON DELETE CASCADE,
+    "date"        date             NOT NULL,
+    "slot"        varchar          NOT NULL CHECK ("slot" IN ('morning', 'afternoon', 'full_day')),
+    "canceled_at" timestamptz,
+    "created_at"  timestamptz      NOT NULL DEFAULT (now()),
+    -- Neither a desk nor a member can be booked twice at the same time.
+    CONSTRAINT "desk_bookings_desk_overlap_excl" EXCLUDE USING gist (
+        "desk_id" WITH =, desk_booking_period("date", "slot") WITH &&
+    ) WHERE ("canceled_at" IS NULL),
+    CONSTRAINT "desk_bookings_member_overlap_excl" EXCLUDE USING gist (
+        "member_id" WITH =, desk_booking_period("date", "slot") WITH &&
+    ) WHERE ("canceled_at" IS NULL)
+);
+
+CREATE INDEX "desk_bookings_date_idx" ON "desk_bookings" ("date");
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS "calendar_tokens";
+DROP TABLE IF EXISTS "room_reservation_exceptions";
+DROP TABLE IF EXISTS "room_reservations";
+DROP TABLE IF EXISTS "rooms";
@@ -0,0 +1,48 @@
+CREATE TABLE "rooms"
+(
+    "id"          uuid PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    "location_id" uuid             NOT NULL REFERENCES "locations" ("id") ON DELETE CASCADE,
+    "name"        varchar          NOT NULL,
+    "capacity"    integer          NOT NULL CHECK ("capacity" > 0),
+    "equipment"   varchar[]        NOT NULL DEFAULT '{}',
+    "created_at"  timestamptz      NOT NULL DEFAULT (now()),
+    UNIQUE ("location_id", "name")
+);
+
+CREATE TABLE "room_reservations"
+(
+    "id"             uuid PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    "room_id"        uuid             NOT NULL REFERENCES "rooms" ("id") ON DELETE CASCADE,
+    "member_id"      uuid             NOT NULL REFERENCES "members" ("id") ON DELETE CASCADE,
+    "title"          varchar          NOT NULL,
+    -- starts_at and ends_at are those of the first occurrence.
+    "starts_at"      timestamptz      NOT NULL,
+    "ends_at"        timestamptz      NOT NULL CHECK ("ends_at" > "starts_at"),
+    -- rrule repeats the reservation, as the RRULE of RFC 5545.
+    "rrule"          varchar,
+    -- series_ends_at is the end of the last occurrence, which bounds the reservations to expand.
+    "series_ends_at" timestamptz      NOT NULL CHECK ("series_ends_at" >= "ends_at"),
+    "created_at"     timestamptz      NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX "room_reservations_room_id_idx" ON "room_reservations" ("room_id", "series_ends_at");
+CREATE INDEX "room_reservations_member_id_idx" ON "room_reservations" ("member_id", "series_ends_at");
+
+-- room_reservation_exceptions lists the occurrences of recurring reservations that are canceled.
+CREATE TABLE "room_reservation_exceptions"
+(
+    "reservation_id"   uuid        NOT NULL REFERENCES "room_reservations" ("id") ON DELETE CASCADE,
+    "occurrence_start" timestamptz NOT NULL,
+    "created_at"       timestamptz NOT NULL DEFAULT (now()),
+    PRIMARY KEY ("reservation_id", "occurrence_start")
+);
+
+-- calendar_tokens authenticate the iCalendar feeds of a room or a member, since calendar clients cannot log in.
+CREATE TABLE "calendar_tokens"
+(
+    "token"      uuid PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    "room_id"    uuid UNIQUE REFERENCES "rooms" ("id") ON DELETE CASCADE,
+    "member_id"  uuid UNIQUE REFERENCES "members" ("id") ON DELETE CASCADE,
+    "created_at" timestamptz      NOT NULL DEFAULT (now()),
+    CHECK (num_nonnulls("room_id", "member_id") = 1)
+);
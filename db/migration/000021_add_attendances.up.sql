@@ -0,0 +1,19 @@
+CREATE TABLE "attendances"
+(
+    "id"               uuid PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    "member_id"        uuid             NOT NULL REFERENCES "members" ("id") ON DELETE CASCADE,
+    -- The attendance is kept when its location is deleted, with the location unknown.
+    "location_id"      uuid REFERENCES "locations" ("id") ON DELETE SET NULL,
+    "checked_in_at"    timestamptz      NOT NULL,
+    "checked_out_at"   timestamptz,
+    -- auto_checked_out tells that the member did not check out and was checked out at the cutoff instead.
+    "auto_checked_out" boolean          NOT NULL DEFAULT false,
+    CHECK ("checked_out_at" >= "checked_in_at")
+);
+
+-- A member can only be checked in once at a time.
+CREATE UNIQUE INDEX "attendances_member_id_open_idx" ON "attendances" ("member_id") WHERE "checked_out_at" IS NULL;
+
+CREATE INDEX "attendances_member_id_checked_in_at_idx" ON "attendances" ("member_id", "checked_in_at");
+
+CREATE INDEX "attendances_checked_in_at_idx" ON "attendances" ("checked_in_at");
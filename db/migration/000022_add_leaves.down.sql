@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS "leave_requests";
+DROP FUNCTION IF EXISTS leave_request_period(date, boolean, date, boolean);
+DROP TABLE IF EXISTS "leave_types";
+ALTER TABLE "users" DROP COLUMN IF EXISTS "is_admin";
@@ -0,0 +1,60 @@
+-- Admins can decide on any leave request. The first user registered becomes the admin, as the ones registering later do.
+ALTER TABLE "users" ADD COLUMN "is_admin" boolean NOT NULL DEFAULT false;
+
+UPDATE "users" SET "is_admin" = true
+WHERE "id" = (SELECT "id" FROM "users" ORDER BY "created_at", "id" LIMIT 1);
+
+CREATE TABLE "leave_types"
+(
+    "id"               uuid PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    "name"             varchar UNIQUE   NOT NULL,
+    -- annual_allowance is the number of days granted every year, null for leave that is not counted against a balance.
+    "annual_allowance" double precision CHECK ("annual_allowance" >= 0),
+    -- max_carry_over is how many of the days left at the end of a year are carried over to the next one.
+    "max_carry_over"   double precision NOT NULL DEFAULT 0 CHECK ("max_carry_over" >= 0),
+    "created_at"       timestamptz      NOT NULL DEFAULT (now())
+);
+
+INSERT INTO "leave_types" ("name", "annual_allowance", "max_carry_over")
+VALUES ('vacation', 20, 5),
+       ('sick', NULL, 0),
+       ('remote', NULL, 0);
+
+-- leave_request_period returns the time range a leave covers, from the start of its first day, or its noon for a leave
+-- starting with a half-day, to the end of its last day, or its noon for a leave ending with a half-day.
+CREATE FUNCTION leave_request_period(date, boolean, date, boolean) RETURNS tsrange
+    LANGUAGE sql IMMUTABLE STRICT PARALLEL SAFE
+AS $$
+SELECT tsrange(
+    $1 + CASE WHEN $2 THEN time '12:00' ELSE time '00:00' END,
+    $3 + CASE WHEN $4 THEN time '12:00' ELSE time '24:00' END
+)
+$$;
+
+CREATE TABLE "leave_requests"
+(
+    "id"             uuid PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    "member_id"      uuid             NOT NULL REFERENCES "members" ("id") ON DELETE CASCADE,
+    "leave_type_id"  uuid             NOT NULL REFERENCES "leave_types" ("id"),
+    "start_date"     date             NOT NULL,
+    -- start_half_day tells that the leave starts at noon of its first day.
+    "start_half_day" boolean          NOT NULL DEFAULT false,
+    "end_date"       date             NOT NULL,
+    -- end_half_day tells that the leave ends at noon of its last day.
+    "end_half_day"   boolean          NOT NULL DEFAULT false,
+    -- days is the number of working days the leave takes, from Monday to Friday.
+    "days"           double precision NOT NULL,
+    "status"         varchar          NOT NULL DEFAULT 'pending' CHECK ("status" IN ('pending', 'approved', 'rejected', 'canceled')),
+    "reason"         varchar          NOT NULL DEFAULT '',
+    "decided_by"     uuid REFERENCES "users" ("id") ON DELETE SET NULL,
+    "decided_at"     timestamptz,
+    "decision_note"  varchar          NOT NULL DEFAULT '',
+    "created_at"     timestamptz      NOT NULL DEFAULT (now()),
+    CHECK ("end_date" > "start_date" OR ("end_date" = "start_date" AND NOT ("start_half_day" AND "end_half_day"))),
+    -- A member cannot be away twice at the same time, unless one of the leaves was rejected or canceled.
+    CONSTRAINT "leave_requests_member_overlap_excl" EXCLUDE USING gist (
+        "member_id" WITH =, leave_request_period("start_date", "start_half_day", "end_date", "end_half_day") WITH &&
+    ) WHERE ("status" IN ('pending', 'approved'))
+);
+
+CREATE INDEX "leave_requests_start_date_idx" ON "leave_requests" ("start_date");
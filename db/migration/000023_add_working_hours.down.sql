@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS "member_working_hours";
+ALTER TABLE "members" DROP COLUMN IF EXISTS "time_zone";
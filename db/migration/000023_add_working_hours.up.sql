@@ -0,0 +1,17 @@
+-- time_zone is the IANA time zone the member works in, such as Asia/Tokyo.
+ALTER TABLE "members" ADD "time_zone" varchar;
+
+-- The weekly working hours of a member, in minutes from midnight of their local time.
+-- A weekday can have several windows, such as a morning and an afternoon.
+CREATE TABLE "member_working_hours"
+(
+    "id"           uuid PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    "member_id"    uuid             NOT NULL REFERENCES "members" ("id") ON DELETE CASCADE,
+    -- weekday counts from Sunday, 0, to Saturday, 6.
+    "weekday"      integer          NOT NULL CHECK ("weekday" BETWEEN 0 AND 6),
+    "start_minute" integer          NOT NULL,
+    "end_minute"   integer          NOT NULL,
+    CHECK (0 <= "start_minute" AND "start_minute" < "end_minute" AND "end_minute" <= 1440)
+);
+
+CREATE INDEX "member_working_hours_member_id_idx" ON "member_working_hours" ("member_id", "weekday", "start_minute");
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS "timesheets";
+DROP TABLE IF EXISTS "time_entries";
+DROP TABLE IF EXISTS "projects";
@@ -0,0 +1,50 @@
+CREATE TABLE "projects"
+(
+    "id"          uuid PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    "name"        varchar UNIQUE   NOT NULL,
+    "description" varchar          NOT NULL DEFAULT '',
+    -- billable is what the time entries of the project are by default.
+    "billable"    boolean          NOT NULL DEFAULT true,
+    -- An archived project is kept for the reports, but time can no longer be tracked against it.
+    "archived_at" timestamptz,
+    "created_at"  timestamptz      NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "time_entries"
+(
+    "id"         uuid PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    "member_id"  uuid             NOT NULL REFERENCES "members" ("id") ON DELETE CASCADE,
+    "project_id" uuid             NOT NULL REFERENCES "projects" ("id"),
+    -- date is the day the time is tracked on: the day in UTC an entry with a start and an end started on.
+    "date"       date             NOT NULL,
+    -- started_at and ended_at are left out for an entry given as a duration. A running timer has not ended yet.
+    "started_at" timestamptz,
+    "ended_at"   timestamptz CHECK ("ended_at" > "started_at"),
+    -- minutes is the duration of the entry, unknown until a running timer is stopped.
+    "minutes"    integer CHECK ("minutes" >= 0),
+    "note"       varchar          NOT NULL DEFAULT '',
+    "billable"   boolean          NOT NULL,
+    "created_at" timestamptz      NOT NULL DEFAULT (now()),
+    CHECK (("started_at" IS NULL AND "ended_at" IS NULL AND "minutes" IS NOT NULL)
+        OR ("started_at" IS NOT NULL AND ("ended_at" IS NULL) = ("minutes" IS NULL))),
+    -- A member cannot track two entries at the same time. A running timer runs until it is stopped.
+    CONSTRAINT "time_entries_member_overlap_excl" EXCLUDE USING gist (
+        "member_id" WITH =, tstzrange("started_at", coalesce("ended_at", 'infinity')) WITH &&
+    ) WHERE ("started_at" IS NOT NULL)
+);
+
+CREATE INDEX "time_entries_member_id_date_idx" ON "time_entries" ("member_id", "date");
+CREATE INDEX "time_entries_project_id_date_idx" ON "time_entries" ("project_id", "date");
+CREATE INDEX "time_entries_date_idx" ON "time_entries" ("date");
+
+-- A submitted timesheet locks the time entries of the member within its week.
+CREATE TABLE "timesheets"
+(
+    "id"           uuid PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    "member_id"    uuid             NOT NULL REFERENCES "members" ("id") ON DELETE CASCADE,
+    -- week_start is the Monday the week starts on.
+    "week_start"   date             NOT NULL CHECK (extract(isodow FROM "week_start") = 1),
+    "submitted_by" uuid REFERENCES "users" ("id") ON DELETE SET NULL,
+    "submitted_at" timestamptz      NOT NULL DEFAULT (now()),
+    UNIQUE ("member_id", "week_start")
+);
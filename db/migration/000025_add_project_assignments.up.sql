@@ -0,0 +1,16 @@
+CREATE TABLE "project_assignments"
+(
+    "id"         uuid PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    "project_id" uuid             NOT NULL REFERENCES "projects" ("id") ON DELETE CASCADE,
+    "member_id"  uuid             NOT NULL REFERENCES "members" ("id") ON DELETE CASCADE,
+    -- role is what the member does in the project.
+    "role"       varchar          NOT NULL DEFAULT '',
+    -- allocation is the share of the working time of the member given to the project on every day of the assignment, in percent.
+    "allocation" integer          NOT NULL CHECK ("allocation" > 0),
+    "start_date" date             NOT NULL,
+    "end_date"   date             NOT NULL CHECK ("end_date" >= "start_date"),
+    "created_at" timestamptz      NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX "project_assignments_member_id_start_date_idx" ON "project_assignments" ("member_id", "start_date");
+CREATE INDEX "project_assignments_project_id_idx" ON "project_assignments" ("project_id");
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS "skill_endorsements";
+DROP TABLE IF EXISTS "member_skills";
+DROP TABLE IF EXISTS "skills";
@@ -0,0 +1,34 @@
+CREATE TABLE "skills"
+(
+    "id"         uuid PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    "name"       varchar          NOT NULL,
+    "created_at" timestamptz      NOT NULL DEFAULT (now())
+);
+
+-- Skill names are unique regardless of case, as tag names are.
+CREATE UNIQUE INDEX "skills_name_key" ON "skills" (lower("name"));
+
+CREATE TABLE "member_skills"
+(
+    "member_id"    uuid        NOT NULL REFERENCES "members" ("id") ON DELETE CASCADE,
+    "skill_id"     uuid        NOT NULL REFERENCES "skills" ("id") ON DELETE CASCADE,
+    -- level is the proficiency of the member, from 1 (novice) to 5 (expert).
+    "level"        integer     NOT NULL CHECK ("level" BETWEEN 1 AND 5),
+    -- last_used_on is the last day the member put the skill to use, when known.
+    "last_used_on" date,
+    "created_at"   timestamptz NOT NULL DEFAULT (now()),
+    "updated_at"   timestamptz NOT NULL DEFAULT (now()),
+    PRIMARY KEY ("member_id", "skill_id")
+);
+
+CREATE INDEX "member_skills_skill_id_level_idx" ON "member_skills" ("skill_id", "level");
+
+CREATE TABLE "skill_endorsements"
+(
+    "member_id"  uuid        NOT NULL,
+    "skill_id"   uuid        NOT NULL,
+    "user_id"    uuid        NOT NULL REFERENCES "users" ("id") ON DELETE CASCADE,
+    "created_at" timestamptz NOT NULL DEFAULT (now()),
+    PRIMARY KEY ("member_id", "skill_id", "user_id"),
+    FOREIGN KEY ("member_id", "skill_id") REFERENCES "member_skills" ("member_id", "skill_id") ON DELETE CASCADE
+);
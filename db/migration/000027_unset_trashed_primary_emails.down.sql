@@ -0,0 +1,15 @@
+-- The emails of members in the trash become primary again, unless another member holds them.
+UPDATE "member_emails"
+SET "is_primary" = true
+WHERE "id" IN (
+    SELECT DISTINCT ON (lower("member_emails"."email")) "member_emails"."id"
+    FROM "member_emails"
+    JOIN "members" ON "members"."id" = "member_emails"."member_id"
+    WHERE "members"."deleted_at" IS NOT NULL
+      AND "member_emails"."email" = "members"."email"
+      AND NOT EXISTS (
+        SELECT 1 FROM "member_emails" AS "taken"
+        WHERE "taken"."is_primary" AND lower("taken"."email") = lower("member_emails"."email")
+      )
+    ORDER BY lower("member_emails"."email"), "members"."deleted_at" DESC, "member_emails"."position", "member_emails"."id"
+);
@@ -0,0 +1,8 @@
+-- Members in the trash no longer hold their primary email, so that live members can take it over.
+-- Their email becomes the primary one again when they are restored.
+UPDATE "member_emails"
+SET "is_primary" = false
+FROM "members"
+WHERE "members"."id" = "member_emails"."member_id"
+  AND "members"."deleted_at" IS NOT NULL
+  AND "member_emails"."is_primary";
@@ -6,7 +6,10 @@ package mockdb
 
 import (
 	context "context"
+	sql "database/sql"
+	jsontext "encoding/json/jsontext"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
@@ -36,181 +39,3596 @@ func (m *MockStore) EXPECT() *MockStoreMockRecorder {
 	return m.recorder
 }
 
+// AddMemberTags mocks base method.
+func (m *MockStore) AddMemberTags(arg0 context.Context, arg1 db.AddMemberTagsParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddMemberTags", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddMemberTags indicates an expected call of AddMemberTags.
+func (mr *MockStoreMockRecorder) AddMemberTags(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMemberTags", reflect.TypeOf((*MockStore)(nil).AddMemberTags), arg0, arg1)
+}
+
+// AddTeamMember mocks base method.
+func (m *MockStore) AddTeamMember(arg0 context.Context, arg1 db.AddTeamMemberParams) (db.TeamMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTeamMember", arg0, arg1)
+	ret0, _ := ret[0].(db.TeamMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddTeamMember indicates an expected call of AddTeamMember.
+func (mr *MockStoreMockRecorder) AddTeamMember(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTeamMember", reflect.TypeOf((*MockStore)(nil).AddTeamMember), arg0, arg1)
+}
+
+// AutoCheckOutAttendances mocks base method.
+func (m *MockStore) AutoCheckOutAttendances(arg0 context.Context, arg1 time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AutoCheckOutAttendances", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AutoCheckOutAttendances indicates an expected call of AutoCheckOutAttendances.
+func (mr *MockStoreMockRecorder) AutoCheckOutAttendances(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AutoCheckOutAttendances", reflect.TypeOf((*MockStore)(nil).AutoCheckOutAttendances), arg0, arg1)
+}
+
+// BookDeskTx mocks base method.
+func (m *MockStore) BookDeskTx(arg0 context.Context, arg1 db.BookDeskTxParams) (db.DeskBooking, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BookDeskTx", arg0, arg1)
+	ret0, _ := ret[0].(db.DeskBooking)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BookDeskTx indicates an expected call of BookDeskTx.
+func (mr *MockStoreMockRecorder) BookDeskTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BookDeskTx", reflect.TypeOf((*MockStore)(nil).BookDeskTx), arg0, arg1)
+}
+
+// CancelDeskBooking mocks base method.
+func (m *MockStore) CancelDeskBooking(arg0 context.Context, arg1 uuid.UUID) (db.DeskBooking, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelDeskBooking", arg0, arg1)
+	ret0, _ := ret[0].(db.DeskBooking)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelDeskBooking indicates an expected call of CancelDeskBooking.
+func (mr *MockStoreMockRecorder) CancelDeskBooking(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelDeskBooking", reflect.TypeOf((*MockStore)(nil).CancelDeskBooking), arg0, arg1)
+}
+
+// CancelLeaveRequest mocks base method.
+func (m *MockStore) CancelLeaveRequest(arg0 context.Context, arg1 uuid.UUID) (db.LeaveRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelLeaveRequest", arg0, arg1)
+	ret0, _ := ret[0].(db.LeaveRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelLeaveRequest indicates an expected call of CancelLeaveRequest.
+func (mr *MockStoreMockRecorder) CancelLeaveRequest(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelLeaveRequest", reflect.TypeOf((*MockStore)(nil).CancelLeaveRequest), arg0, arg1)
+}
+
+// CancelRoomReservationOccurrence mocks base method.
+func (m *MockStore) CancelRoomReservationOccurrence(arg0 context.Context, arg1 uuid.UUID, arg2 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelRoomReservationOccurrence", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelRoomReservationOccurrence indicates an expected call of CancelRoomReservationOccurrence.
+func (mr *MockStoreMockRecorder) CancelRoomReservationOccurrence(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelRoomReservationOccurrence", reflect.TypeOf((*MockStore)(nil).CancelRoomReservationOccurrence), arg0, arg1, arg2)
+}
+
+// CheckInMember mocks base method.
+func (m *MockStore) CheckInMember(arg0 context.Context, arg1 db.CheckInMemberParams) (db.Attendance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckInMember", arg0, arg1)
+	ret0, _ := ret[0].(db.Attendance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckInMember indicates an expected call of CheckInMember.
+func (mr *MockStoreMockRecorder) CheckInMember(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckInMember", reflect.TypeOf((*MockStore)(nil).CheckInMember), arg0, arg1)
+}
+
+// CheckOutMember mocks base method.
+func (m *MockStore) CheckOutMember(arg0 context.Context, arg1 db.CheckOutMemberParams) (db.Attendance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckOutMember", arg0, arg1)
+	ret0, _ := ret[0].(db.Attendance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckOutMember indicates an expected call of CheckOutMember.
+func (mr *MockStoreMockRecorder) CheckOutMember(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckOutMember", reflect.TypeOf((*MockStore)(nil).CheckOutMember), arg0, arg1)
+}
+
+// CompleteMemberChecklistTask mocks base method.
+func (m *MockStore) CompleteMemberChecklistTask(arg0 context.Context, arg1 db.CompleteMemberChecklistTaskParams) (db.MemberChecklistTask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteMemberChecklistTask", arg0, arg1)
+	ret0, _ := ret[0].(db.MemberChecklistTask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompleteMemberChecklistTask indicates an expected call of CompleteMemberChecklistTask.
+func (mr *MockStoreMockRecorder) CompleteMemberChecklistTask(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteMemberChecklistTask", reflect.TypeOf((*MockStore)(nil).CompleteMemberChecklistTask), arg0, arg1)
+}
+
+// CountAuditEvents mocks base method.
+func (m *MockStore) CountAuditEvents(arg0 context.Context, arg1 db.CountAuditEventsParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountAuditEvents", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountAuditEvents indicates an expected call of CountAuditEvents.
+func (mr *MockStoreMockRecorder) CountAuditEvents(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountAuditEvents", reflect.TypeOf((*MockStore)(nil).CountAuditEvents), arg0, arg1)
+}
+
+// CountDeletedMembers mocks base method.
+func (m *MockStore) CountDeletedMembers(arg0 context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountDeletedMembers", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountDeletedMembers indicates an expected call of CountDeletedMembers.
+func (mr *MockStoreMockRecorder) CountDeletedMembers(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountDeletedMembers", reflect.TypeOf((*MockStore)(nil).CountDeletedMembers), arg0)
+}
+
+// CountLeaveRequests mocks base method.
+func (m *MockStore) CountLeaveRequests(arg0 context.Context, arg1 db.CountLeaveRequestsParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountLeaveRequests", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountLeaveRequests indicates an expected call of CountLeaveRequests.
+func (mr *MockStoreMockRecorder) CountLeaveRequests(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountLeaveRequests", reflect.TypeOf((*MockStore)(nil).CountLeaveRequests), arg0, arg1)
+}
+
+// CountMemberReports mocks base method.
+func (m *MockStore) CountMemberReports(arg0 context.Context, arg1 db.CountMemberReportsParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountMemberReports", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountMemberReports indicates an expected call of CountMemberReports.
+func (mr *MockStoreMockRecorder) CountMemberReports(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountMemberReports", reflect.TypeOf((*MockStore)(nil).CountMemberReports), arg0, arg1)
+}
+
+// CountMemberWeekDeskBookings mocks base method.
+func (m *MockStore) CountMemberWeekDeskBookings(arg0 context.Context, arg1 db.CountMemberWeekDeskBookingsParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountMemberWeekDeskBookings", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountMemberWeekDeskBookings indicates an expected call of CountMemberWeekDeskBookings.
+func (mr *MockStoreMockRecorder) CountMemberWeekDeskBookings(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountMemberWeekDeskBookings", reflect.TypeOf((*MockStore)(nil).CountMemberWeekDeskBookings), arg0, arg1)
+}
+
 // CountMembers mocks base method.
 func (m *MockStore) CountMembers(arg0 context.Context) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CountMembers", arg0)
-	ret0, _ := ret[0].(int64)
+	ret := m.ctrl.Call(m, "CountMembers", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountMembers indicates an expected call of CountMembers.
+func (mr *MockStoreMockRecorder) CountMembers(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountMembers", reflect.TypeOf((*MockStore)(nil).CountMembers), arg0)
+}
+
+// CountMembersByFilter mocks base method.
+func (m *MockStore) CountMembersByFilter(arg0 context.Context, arg1 db.MemberFilter) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountMembersByFilter", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountMembersByFilter indicates an expected call of CountMembersByFilter.
+func (mr *MockStoreMockRecorder) CountMembersByFilter(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountMembersByFilter", reflect.TypeOf((*MockStore)(nil).CountMembersByFilter), arg0, arg1)
+}
+
+// CountTeamMembers mocks base method.
+func (m *MockStore) CountTeamMembers(arg0 context.Context, arg1 db.CountTeamMembersParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountTeamMembers", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountTeamMembers indicates an expected call of CountTeamMembers.
+func (mr *MockStoreMockRecorder) CountTeamMembers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountTeamMembers", reflect.TypeOf((*MockStore)(nil).CountTeamMembers), arg0, arg1)
+}
+
+// CountTimeEntries mocks base method.
+func (m *MockStore) CountTimeEntries(arg0 context.Context, arg1 db.CountTimeEntriesParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountTimeEntries", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountTimeEntries indicates an expected call of CountTimeEntries.
+func (mr *MockStoreMockRecorder) CountTimeEntries(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountTimeEntries", reflect.TypeOf((*MockStore)(nil).CountTimeEntries), arg0, arg1)
+}
+
+// CountUsers mocks base method.
+func (m *MockStore) CountUsers(arg0 context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountUsers", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountUsers indicates an expected call of CountUsers.
+func (mr *MockStoreMockRecorder) CountUsers(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUsers", reflect.TypeOf((*MockStore)(nil).CountUsers), arg0)
+}
+
+// CreateAuditEvent mocks base method.
+func (m *MockStore) CreateAuditEvent(arg0 context.Context, arg1 db.CreateAuditEventParams) (db.AuditEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAuditEvent", arg0, arg1)
+	ret0, _ := ret[0].(db.AuditEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAuditEvent indicates an expected call of CreateAuditEvent.
+func (mr *MockStoreMockRecorder) CreateAuditEvent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAuditEvent", reflect.TypeOf((*MockStore)(nil).CreateAuditEvent), arg0, arg1)
+}
+
+// CreateChecklistTemplateTask mocks base method.
+func (m *MockStore) CreateChecklistTemplateTask(arg0 context.Context, arg1 db.CreateChecklistTemplateTaskParams) (db.ChecklistTemplateTask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateChecklistTemplateTask", arg0, arg1)
+	ret0, _ := ret[0].(db.ChecklistTemplateTask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateChecklistTemplateTask indicates an expected call of CreateChecklistTemplateTask.
+func (mr *MockStoreMockRecorder) CreateChecklistTemplateTask(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChecklistTemplateTask", reflect.TypeOf((*MockStore)(nil).CreateChecklistTemplateTask), arg0, arg1)
+}
+
+// CreateCustomFieldDefinition mocks base method.
+func (m *MockStore) CreateCustomFieldDefinition(arg0 context.Context, arg1 db.CreateCustomFieldDefinitionParams) (db.CustomFieldDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCustomFieldDefinition", arg0, arg1)
+	ret0, _ := ret[0].(db.CustomFieldDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCustomFieldDefinition indicates an expected call of CreateCustomFieldDefinition.
+func (mr *MockStoreMockRecorder) CreateCustomFieldDefinition(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCustomFieldDefinition", reflect.TypeOf((*MockStore)(nil).CreateCustomFieldDefinition), arg0, arg1)
+}
+
+// CreateDesk mocks base method.
+func (m *MockStore) CreateDesk(arg0 context.Context, arg1 db.CreateDeskParams) (db.Desk, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDesk", arg0, arg1)
+	ret0, _ := ret[0].(db.Desk)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDesk indicates an expected call of CreateDesk.
+func (mr *MockStoreMockRecorder) CreateDesk(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDesk", reflect.TypeOf((*MockStore)(nil).CreateDesk), arg0, arg1)
+}
+
+// CreateDeskBooking mocks base method.
+func (m *MockStore) CreateDeskBooking(arg0 context.Context, arg1 db.CreateDeskBookingParams) (db.DeskBooking, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDeskBooking", arg0, arg1)
+	ret0, _ := ret[0].(db.DeskBooking)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDeskBooking indicates an expected call of CreateDeskBooking.
+func (mr *MockStoreMockRecorder) CreateDeskBooking(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDeskBooking", reflect.TypeOf((*MockStore)(nil).CreateDeskBooking), arg0, arg1)
+}
+
+// CreateFloor mocks base method.
+func (m *MockStore) CreateFloor(arg0 context.Context, arg1 db.CreateFloorParams) (db.Floor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFloor", arg0, arg1)
+	ret0, _ := ret[0].(db.Floor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFloor indicates an expected call of CreateFloor.
+func (mr *MockStoreMockRecorder) CreateFloor(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFloor", reflect.TypeOf((*MockStore)(nil).CreateFloor), arg0, arg1)
+}
+
+// CreateLeaveRequest mocks base method.
+func (m *MockStore) CreateLeaveRequest(arg0 context.Context, arg1 db.CreateLeaveRequestParams) (db.LeaveRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLeaveRequest", arg0, arg1)
+	ret0, _ := ret[0].(db.LeaveRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLeaveRequest indicates an expected call of CreateLeaveRequest.
+func (mr *MockStoreMockRecorder) CreateLeaveRequest(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLeaveRequest", reflect.TypeOf((*MockStore)(nil).CreateLeaveRequest), arg0, arg1)
+}
+
+// CreateLeaveRequestTx mocks base method.
+func (m *MockStore) CreateLeaveRequestTx(arg0 context.Context, arg1 db.CreateLeaveRequestParams) (db.LeaveRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLeaveRequestTx", arg0, arg1)
+	ret0, _ := ret[0].(db.LeaveRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLeaveRequestTx indicates an expected call of CreateLeaveRequestTx.
+func (mr *MockStoreMockRecorder) CreateLeaveRequestTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLeaveRequestTx", reflect.TypeOf((*MockStore)(nil).CreateLeaveRequestTx), arg0, arg1)
+}
+
+// CreateLeaveType mocks base method.
+func (m *MockStore) CreateLeaveType(arg0 context.Context, arg1 db.CreateLeaveTypeParams) (db.LeaveType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLeaveType", arg0, arg1)
+	ret0, _ := ret[0].(db.LeaveType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLeaveType indicates an expected call of CreateLeaveType.
+func (mr *MockStoreMockRecorder) CreateLeaveType(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLeaveType", reflect.TypeOf((*MockStore)(nil).CreateLeaveType), arg0, arg1)
+}
+
+// CreateLocation mocks base method.
+func (m *MockStore) CreateLocation(arg0 context.Context, arg1 db.CreateLocationParams) (db.Location, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLocation", arg0, arg1)
+	ret0, _ := ret[0].(db.Location)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLocation indicates an expected call of CreateLocation.
+func (mr *MockStoreMockRecorder) CreateLocation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLocation", reflect.TypeOf((*MockStore)(nil).CreateLocation), arg0, arg1)
+}
+
+// CreateMember mocks base method.
+func (m *MockStore) CreateMember(arg0 context.Context, arg1 db.CreateMemberParams) (db.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMember", arg0, arg1)
+	ret0, _ := ret[0].(db.Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMember indicates an expected call of CreateMember.
+func (mr *MockStoreMockRecorder) CreateMember(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMember", reflect.TypeOf((*MockStore)(nil).CreateMember), arg0, arg1)
+}
+
+// CreateMemberAddress mocks base method.
+func (m *MockStore) CreateMemberAddress(arg0 context.Context, arg1 db.CreateMemberAddressParams) (db.MemberAddress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMemberAddress", arg0, arg1)
+	ret0, _ := ret[0].(db.MemberAddress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMemberAddress indicates an expected call of CreateMemberAddress.
+func (mr *MockStoreMockRecorder) CreateMemberAddress(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMemberAddress", reflect.TypeOf((*MockStore)(nil).CreateMemberAddress), arg0, arg1)
+}
+
+// CreateMemberChecklist mocks base method.
+func (m *MockStore) CreateMemberChecklist(arg0 context.Context, arg1 db.CreateMemberChecklistParams) ([]db.MemberChecklistTask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMemberChecklist", arg0, arg1)
+	ret0, _ := ret[0].([]db.MemberChecklistTask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMemberChecklist indicates an expected call of CreateMemberChecklist.
+func (mr *MockStoreMockRecorder) CreateMemberChecklist(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMemberChecklist", reflect.TypeOf((*MockStore)(nil).CreateMemberChecklist), arg0, arg1)
+}
+
+// CreateMemberEmail mocks base method.
+func (m *MockStore) CreateMemberEmail(arg0 context.Context, arg1 db.CreateMemberEmailParams) (db.MemberEmail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMemberEmail", arg0, arg1)
+	ret0, _ := ret[0].(db.MemberEmail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMemberEmail indicates an expected call of CreateMemberEmail.
+func (mr *MockStoreMockRecorder) CreateMemberEmail(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMemberEmail", reflect.TypeOf((*MockStore)(nil).CreateMemberEmail), arg0, arg1)
+}
+
+// CreateMemberImportJob mocks base method.
+func (m *MockStore) CreateMemberImportJob(arg0 context.Context, arg1 db.CreateMemberImportJobParams) (db.MemberImportJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMemberImportJob", arg0, arg1)
+	ret0, _ := ret[0].(db.MemberImportJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMemberImportJob indicates an expected call of CreateMemberImportJob.
+func (mr *MockStoreMockRecorder) CreateMemberImportJob(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMemberImportJob", reflect.TypeOf((*MockStore)(nil).CreateMemberImportJob), arg0, arg1)
+}
+
+// CreateMemberLink mocks base method.
+func (m *MockStore) CreateMemberLink(arg0 context.Context, arg1 db.CreateMemberLinkParams) (db.MemberLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMemberLink", arg0, arg1)
+	ret0, _ := ret[0].(db.MemberLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMemberLink indicates an expected call of CreateMemberLink.
+func (mr *MockStoreMockRecorder) CreateMemberLink(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMemberLink", reflect.TypeOf((*MockStore)(nil).CreateMemberLink), arg0, arg1)
+}
+
+// CreateMemberPhone mocks base method.
+func (m *MockStore) CreateMemberPhone(arg0 context.Context, arg1 db.CreateMemberPhoneParams) (db.MemberPhone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMemberPhone", arg0, arg1)
+	ret0, _ := ret[0].(db.MemberPhone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMemberPhone indicates an expected call of CreateMemberPhone.
+func (mr *MockStoreMockRecorder) CreateMemberPhone(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMemberPhone", reflect.TypeOf((*MockStore)(nil).CreateMemberPhone), arg0, arg1)
+}
+
+// CreateMemberTx mocks base method.
+func (m *MockStore) CreateMemberTx(arg0 context.Context, arg1 db.CreateMemberTxParams, arg2 db.AuditMeta) (db.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMemberTx", arg0, arg1, arg2)
+	ret0, _ := ret[0].(db.Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMemberTx indicates an expected call of CreateMemberTx.
+func (mr *MockStoreMockRecorder) CreateMemberTx(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMemberTx", reflect.TypeOf((*MockStore)(nil).CreateMemberTx), arg0, arg1, arg2)
+}
+
+// CreateMemberWorkingHours mocks base method.
+func (m *MockStore) CreateMemberWorkingHours(arg0 context.Context, arg1 db.CreateMemberWorkingHoursParams) (db.MemberWorkingHour, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMemberWorkingHours", arg0, arg1)
+	ret0, _ := ret[0].(db.MemberWorkingHour)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMemberWorkingHours indicates an expected call of CreateMemberWorkingHours.
+func (mr *MockStoreMockRecorder) CreateMemberWorkingHours(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMemberWorkingHours", reflect.TypeOf((*MockStore)(nil).CreateMemberWorkingHours), arg0, arg1)
+}
+
+// CreateProject mocks base method.
+func (m *MockStore) CreateProject(arg0 context.Context, arg1 db.CreateProjectParams) (db.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProject", arg0, arg1)
+	ret0, _ := ret[0].(db.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProject indicates an expected call of CreateProject.
+func (mr *MockStoreMockRecorder) CreateProject(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProject", reflect.TypeOf((*MockStore)(nil).CreateProject), arg0, arg1)
+}
+
+// CreateProjectAssignment mocks base method.
+func (m *MockStore) CreateProjectAssignment(arg0 context.Context, arg1 db.CreateProjectAssignmentParams) (db.ProjectAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProjectAssignment", arg0, arg1)
+	ret0, _ := ret[0].(db.ProjectAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProjectAssignment indicates an expected call of CreateProjectAssignment.
+func (mr *MockStoreMockRecorder) CreateProjectAssignment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProjectAssignment", reflect.TypeOf((*MockStore)(nil).CreateProjectAssignment), arg0, arg1)
+}
+
+// CreateProjectAssignmentTx mocks base method.
+func (m *MockStore) CreateProjectAssignmentTx(arg0 context.Context, arg1 db.CreateProjectAssignmentParams, arg2 int32) (db.ProjectAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProjectAssignmentTx", arg0, arg1, arg2)
+	ret0, _ := ret[0].(db.ProjectAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProjectAssignmentTx indicates an expected call of CreateProjectAssignmentTx.
+func (mr *MockStoreMockRecorder) CreateProjectAssignmentTx(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProjectAssignmentTx", reflect.TypeOf((*MockStore)(nil).CreateProjectAssignmentTx), arg0, arg1, arg2)
+}
+
+// CreateRoom mocks base method.
+func (m *MockStore) CreateRoom(arg0 context.Context, arg1 db.CreateRoomParams) (db.Room, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRoom", arg0, arg1)
+	ret0, _ := ret[0].(db.Room)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRoom indicates an expected call of CreateRoom.
+func (mr *MockStoreMockRecorder) CreateRoom(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRoom", reflect.TypeOf((*MockStore)(nil).CreateRoom), arg0, arg1)
+}
+
+// CreateRoomReservation mocks base method.
+func (m *MockStore) CreateRoomReservation(arg0 context.Context, arg1 db.CreateRoomReservationParams) (db.RoomReservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRoomReservation", arg0, arg1)
+	ret0, _ := ret[0].(db.RoomReservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRoomReservation indicates an expected call of CreateRoomReservation.
+func (mr *MockStoreMockRecorder) CreateRoomReservation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRoomReservation", reflect.TypeOf((*MockStore)(nil).CreateRoomReservation), arg0, arg1)
+}
+
+// CreateRoomReservationException mocks base method.
+func (m *MockStore) CreateRoomReservationException(arg0 context.Context, arg1 db.CreateRoomReservationExceptionParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRoomReservationException", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRoomReservationException indicates an expected call of CreateRoomReservationException.
+func (mr *MockStoreMockRecorder) CreateRoomReservationException(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRoomReservationException", reflect.TypeOf((*MockStore)(nil).CreateRoomReservationException), arg0, arg1)
+}
+
+// CreateRoomReservationTx mocks base method.
+func (m *MockStore) CreateRoomReservationTx(arg0 context.Context, arg1 db.CreateRoomReservationTxParams) (db.RoomReservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRoomReservationTx", arg0, arg1)
+	ret0, _ := ret[0].(db.RoomReservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRoomReservationTx indicates an expected call of CreateRoomReservationTx.
+func (mr *MockStoreMockRecorder) CreateRoomReservationTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRoomReservationTx", reflect.TypeOf((*MockStore)(nil).CreateRoomReservationTx), arg0, arg1)
+}
+
+// CreateSession mocks base method.
+func (m *MockStore) CreateSession(arg0 context.Context, arg1 db.CreateSessionParams) (db.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSession", arg0, arg1)
+	ret0, _ := ret[0].(db.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSession indicates an expected call of CreateSession.
+func (mr *MockStoreMockRecorder) CreateSession(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockStore)(nil).CreateSession), arg0, arg1)
+}
+
+// CreateSkill mocks base method.
+func (m *MockStore) CreateSkill(arg0 context.Context, arg1 string) (db.Skill, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSkill", arg0, arg1)
+	ret0, _ := ret[0].(db.Skill)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSkill indicates an expected call of CreateSkill.
+func (mr *MockStoreMockRecorder) CreateSkill(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSkill", reflect.TypeOf((*MockStore)(nil).CreateSkill), arg0, arg1)
+}
+
+// CreateSkillEndorsement mocks base method.
+func (m *MockStore) CreateSkillEndorsement(arg0 context.Context, arg1 db.CreateSkillEndorsementParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSkillEndorsement", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSkillEndorsement indicates an expected call of CreateSkillEndorsement.
+func (mr *MockStoreMockRecorder) CreateSkillEndorsement(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSkillEndorsement", reflect.TypeOf((*MockStore)(nil).CreateSkillEndorsement), arg0, arg1)
+}
+
+// CreateTag mocks base method.
+func (m *MockStore) CreateTag(arg0 context.Context, arg1 string) (db.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTag", arg0, arg1)
+	ret0, _ := ret[0].(db.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTag indicates an expected call of CreateTag.
+func (mr *MockStoreMockRecorder) CreateTag(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTag", reflect.TypeOf((*MockStore)(nil).CreateTag), arg0, arg1)
+}
+
+// CreateTeam mocks base method.
+func (m *MockStore) CreateTeam(arg0 context.Context, arg1 db.CreateTeamParams) (db.Team, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTeam", arg0, arg1)
+	ret0, _ := ret[0].(db.Team)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTeam indicates an expected call of CreateTeam.
+func (mr *MockStoreMockRecorder) CreateTeam(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTeam", reflect.TypeOf((*MockStore)(nil).CreateTeam), arg0, arg1)
+}
+
+// CreateTimeEntry mocks base method.
+func (m *MockStore) CreateTimeEntry(arg0 context.Context, arg1 db.CreateTimeEntryParams) (db.TimeEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTimeEntry", arg0, arg1)
+	ret0, _ := ret[0].(db.TimeEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTimeEntry indicates an expected call of CreateTimeEntry.
+func (mr *MockStoreMockRecorder) CreateTimeEntry(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTimeEntry", reflect.TypeOf((*MockStore)(nil).CreateTimeEntry), arg0, arg1)
+}
+
+// CreateTimeEntryTx mocks base method.
+func (m *MockStore) CreateTimeEntryTx(arg0 context.Context, arg1 db.CreateTimeEntryParams) (db.TimeEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTimeEntryTx", arg0, arg1)
+	ret0, _ := ret[0].(db.TimeEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTimeEntryTx indicates an expected call of CreateTimeEntryTx.
+func (mr *MockStoreMockRecorder) CreateTimeEntryTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTimeEntryTx", reflect.TypeOf((*MockStore)(nil).CreateTimeEntryTx), arg0, arg1)
+}
+
+// CreateTimesheet mocks base method.
+func (m *MockStore) CreateTimesheet(arg0 context.Context, arg1 db.CreateTimesheetParams) (db.Timesheet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTimesheet", arg0, arg1)
+	ret0, _ := ret[0].(db.Timesheet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTimesheet indicates an expected call of CreateTimesheet.
+func (mr *MockStoreMockRecorder) CreateTimesheet(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTimesheet", reflect.TypeOf((*MockStore)(nil).CreateTimesheet), arg0, arg1)
+}
+
+// CreateUser mocks base method.
+func (m *MockStore) CreateUser(arg0 context.Context, arg1 db.CreateUserParams) (db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUser", arg0, arg1)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUser indicates an expected call of CreateUser.
+func (mr *MockStoreMockRecorder) CreateUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockStore)(nil).CreateUser), arg0, arg1)
+}
+
+// CreateUserTx mocks base method.
+func (m *MockStore) CreateUserTx(arg0 context.Context, arg1 db.CreateUserParams) (db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUserTx", arg0, arg1)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUserTx indicates an expected call of CreateUserTx.
+func (mr *MockStoreMockRecorder) CreateUserTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserTx", reflect.TypeOf((*MockStore)(nil).CreateUserTx), arg0, arg1)
+}
+
+// DecideLeaveRequest mocks base method.
+func (m *MockStore) DecideLeaveRequest(arg0 context.Context, arg1 db.DecideLeaveRequestParams) (db.LeaveRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DecideLeaveRequest", arg0, arg1)
+	ret0, _ := ret[0].(db.LeaveRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DecideLeaveRequest indicates an expected call of DecideLeaveRequest.
+func (mr *MockStoreMockRecorder) DecideLeaveRequest(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecideLeaveRequest", reflect.TypeOf((*MockStore)(nil).DecideLeaveRequest), arg0, arg1)
+}
+
+// DecideLeaveRequestTx mocks base method.
+func (m *MockStore) DecideLeaveRequestTx(arg0 context.Context, arg1 db.DecideLeaveRequestTxParams) (db.LeaveRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DecideLeaveRequestTx", arg0, arg1)
+	ret0, _ := ret[0].(db.LeaveRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DecideLeaveRequestTx indicates an expected call of DecideLeaveRequestTx.
+func (mr *MockStoreMockRecorder) DecideLeaveRequestTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecideLeaveRequestTx", reflect.TypeOf((*MockStore)(nil).DecideLeaveRequestTx), arg0, arg1)
+}
+
+// DeleteChecklistTemplateTasks mocks base method.
+func (m *MockStore) DeleteChecklistTemplateTasks(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteChecklistTemplateTasks", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteChecklistTemplateTasks indicates an expected call of DeleteChecklistTemplateTasks.
+func (mr *MockStoreMockRecorder) DeleteChecklistTemplateTasks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChecklistTemplateTasks", reflect.TypeOf((*MockStore)(nil).DeleteChecklistTemplateTasks), arg0, arg1)
+}
+
+// DeleteCustomFieldDefinition mocks base method.
+func (m *MockStore) DeleteCustomFieldDefinition(arg0 context.Context, arg1 uuid.UUID) (db.CustomFieldDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCustomFieldDefinition", arg0, arg1)
+	ret0, _ := ret[0].(db.CustomFieldDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCustomFieldDefinition indicates an expected call of DeleteCustomFieldDefinition.
+func (mr *MockStoreMockRecorder) DeleteCustomFieldDefinition(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCustomFieldDefinition", reflect.TypeOf((*MockStore)(nil).DeleteCustomFieldDefinition), arg0, arg1)
+}
+
+// DeleteCustomFieldDefinitionTx mocks base method.
+func (m *MockStore) DeleteCustomFieldDefinitionTx(arg0 context.Context, arg1 uuid.UUID) (db.CustomFieldDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCustomFieldDefinitionTx", arg0, arg1)
+	ret0, _ := ret[0].(db.CustomFieldDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCustomFieldDefinitionTx indicates an expected call of DeleteCustomFieldDefinitionTx.
+func (mr *MockStoreMockRecorder) DeleteCustomFieldDefinitionTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCustomFieldDefinitionTx", reflect.TypeOf((*MockStore)(nil).DeleteCustomFieldDefinitionTx), arg0, arg1)
+}
+
+// DeleteDesk mocks base method.
+func (m *MockStore) DeleteDesk(arg0 context.Context, arg1 uuid.UUID) (db.Desk, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDesk", arg0, arg1)
+	ret0, _ := ret[0].(db.Desk)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteDesk indicates an expected call of DeleteDesk.
+func (mr *MockStoreMockRecorder) DeleteDesk(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDesk", reflect.TypeOf((*MockStore)(nil).DeleteDesk), arg0, arg1)
+}
+
+// DeleteFloor mocks base method.
+func (m *MockStore) DeleteFloor(arg0 context.Context, arg1 uuid.UUID) (db.Floor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFloor", arg0, arg1)
+	ret0, _ := ret[0].(db.Floor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFloor indicates an expected call of DeleteFloor.
+func (mr *MockStoreMockRecorder) DeleteFloor(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFloor", reflect.TypeOf((*MockStore)(nil).DeleteFloor), arg0, arg1)
+}
+
+// DeleteLeaveType mocks base method.
+func (m *MockStore) DeleteLeaveType(arg0 context.Context, arg1 uuid.UUID) (db.LeaveType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLeaveType", arg0, arg1)
+	ret0, _ := ret[0].(db.LeaveType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteLeaveType indicates an expected call of DeleteLeaveType.
+func (mr *MockStoreMockRecorder) DeleteLeaveType(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLeaveType", reflect.TypeOf((*MockStore)(nil).DeleteLeaveType), arg0, arg1)
+}
+
+// DeleteLocation mocks base method.
+func (m *MockStore) DeleteLocation(arg0 context.Context, arg1 uuid.UUID) (db.Location, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLocation", arg0, arg1)
+	ret0, _ := ret[0].(db.Location)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteLocation indicates an expected call of DeleteLocation.
+func (mr *MockStoreMockRecorder) DeleteLocation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLocation", reflect.TypeOf((*MockStore)(nil).DeleteLocation), arg0, arg1)
+}
+
+// DeleteMember mocks base method.
+func (m *MockStore) DeleteMember(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMember", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMember indicates an expected call of DeleteMember.
+func (mr *MockStoreMockRecorder) DeleteMember(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMember", reflect.TypeOf((*MockStore)(nil).DeleteMember), arg0, arg1)
+}
+
+// DeleteMemberAddresses mocks base method.
+func (m *MockStore) DeleteMemberAddresses(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMemberAddresses", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMemberAddresses indicates an expected call of DeleteMemberAddresses.
+func (mr *MockStoreMockRecorder) DeleteMemberAddresses(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMemberAddresses", reflect.TypeOf((*MockStore)(nil).DeleteMemberAddresses), arg0, arg1)
+}
+
+// DeleteMemberEmails mocks base method.
+func (m *MockStore) DeleteMemberEmails(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMemberEmails", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMemberEmails indicates an expected call of DeleteMemberEmails.
+func (mr *MockStoreMockRecorder) DeleteMemberEmails(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMemberEmails", reflect.TypeOf((*MockStore)(nil).DeleteMemberEmails), arg0, arg1)
+}
+
+// DeleteMemberLinks mocks base method.
+func (m *MockStore) DeleteMemberLinks(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMemberLinks", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMemberLinks indicates an expected call of DeleteMemberLinks.
+func (mr *MockStoreMockRecorder) DeleteMemberLinks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMemberLinks", reflect.TypeOf((*MockStore)(nil).DeleteMemberLinks), arg0, arg1)
+}
+
+// DeleteMemberPhones mocks base method.
+func (m *MockStore) DeleteMemberPhones(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMemberPhones", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMemberPhones indicates an expected call of DeleteMemberPhones.
+func (mr *MockStoreMockRecorder) DeleteMemberPhones(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMemberPhones", reflect.TypeOf((*MockStore)(nil).DeleteMemberPhones), arg0, arg1)
+}
+
+// DeleteMemberSkill mocks base method.
+func (m *MockStore) DeleteMemberSkill(arg0 context.Context, arg1 db.DeleteMemberSkillParams) (db.MemberSkill, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMemberSkill", arg0, arg1)
+	ret0, _ := ret[0].(db.MemberSkill)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteMemberSkill indicates an expected call of DeleteMemberSkill.
+func (mr *MockStoreMockRecorder) DeleteMemberSkill(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMemberSkill", reflect.TypeOf((*MockStore)(nil).DeleteMemberSkill), arg0, arg1)
+}
+
+// DeleteMemberTimesheet mocks base method.
+func (m *MockStore) DeleteMemberTimesheet(arg0 context.Context, arg1 db.DeleteMemberTimesheetParams) (db.Timesheet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMemberTimesheet", arg0, arg1)
+	ret0, _ := ret[0].(db.Timesheet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteMemberTimesheet indicates an expected call of DeleteMemberTimesheet.
+func (mr *MockStoreMockRecorder) DeleteMemberTimesheet(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMemberTimesheet", reflect.TypeOf((*MockStore)(nil).DeleteMemberTimesheet), arg0, arg1)
+}
+
+// DeleteMemberWorkingHours mocks base method.
+func (m *MockStore) DeleteMemberWorkingHours(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMemberWorkingHours", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMemberWorkingHours indicates an expected call of DeleteMemberWorkingHours.
+func (mr *MockStoreMockRecorder) DeleteMemberWorkingHours(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMemberWorkingHours", reflect.TypeOf((*MockStore)(nil).DeleteMemberWorkingHours), arg0, arg1)
+}
+
+// DeleteMembers mocks base method.
+func (m *MockStore) DeleteMembers(arg0 context.Context, arg1 []uuid.UUID) ([]db.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMembers", arg0, arg1)
+	ret0, _ := ret[0].([]db.Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteMembers indicates an expected call of DeleteMembers.
+func (mr *MockStoreMockRecorder) DeleteMembers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMembers", reflect.TypeOf((*MockStore)(nil).DeleteMembers), arg0, arg1)
+}
+
+// DeleteMembersTx mocks base method.
+func (m *MockStore) DeleteMembersTx(arg0 context.Context, arg1 []uuid.UUID, arg2 db.AuditMeta) ([]db.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMembersTx", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]db.Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteMembersTx indicates an expected call of DeleteMembersTx.
+func (mr *MockStoreMockRecorder) DeleteMembersTx(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMembersTx", reflect.TypeOf((*MockStore)(nil).DeleteMembersTx), arg0, arg1, arg2)
+}
+
+// DeletePrimaryMemberEmail mocks base method.
+func (m *MockStore) DeletePrimaryMemberEmail(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePrimaryMemberEmail", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePrimaryMemberEmail indicates an expected call of DeletePrimaryMemberEmail.
+func (mr *MockStoreMockRecorder) DeletePrimaryMemberEmail(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePrimaryMemberEmail", reflect.TypeOf((*MockStore)(nil).DeletePrimaryMemberEmail), arg0, arg1)
+}
+
+// DeleteProject mocks base method.
+func (m *MockStore) DeleteProject(arg0 context.Context, arg1 uuid.UUID) (db.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProject", arg0, arg1)
+	ret0, _ := ret[0].(db.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteProject indicates an expected call of DeleteProject.
+func (mr *MockStoreMockRecorder) DeleteProject(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProject", reflect.TypeOf((*MockStore)(nil).DeleteProject), arg0, arg1)
+}
+
+// DeleteProjectAssignment mocks base method.
+func (m *MockStore) DeleteProjectAssignment(arg0 context.Context, arg1 uuid.UUID) (db.ProjectAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProjectAssignment", arg0, arg1)
+	ret0, _ := ret[0].(db.ProjectAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteProjectAssignment indicates an expected call of DeleteProjectAssignment.
+func (mr *MockStoreMockRecorder) DeleteProjectAssignment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProjectAssignment", reflect.TypeOf((*MockStore)(nil).DeleteProjectAssignment), arg0, arg1)
+}
+
+// DeleteRoom mocks base method.
+func (m *MockStore) DeleteRoom(arg0 context.Context, arg1 uuid.UUID) (db.Room, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRoom", arg0, arg1)
+	ret0, _ := ret[0].(db.Room)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRoom indicates an expected call of DeleteRoom.
+func (mr *MockStoreMockRecorder) DeleteRoom(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRoom", reflect.TypeOf((*MockStore)(nil).DeleteRoom), arg0, arg1)
+}
+
+// DeleteRoomReservation mocks base method.
+func (m *MockStore) DeleteRoomReservation(arg0 context.Context, arg1 uuid.UUID) (db.RoomReservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRoomReservation", arg0, arg1)
+	ret0, _ := ret[0].(db.RoomReservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRoomReservation indicates an expected call of DeleteRoomReservation.
+func (mr *MockStoreMockRecorder) DeleteRoomReservation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRoomReservation", reflect.TypeOf((*MockStore)(nil).DeleteRoomReservation), arg0, arg1)
+}
+
+// DeleteSelfSkillEndorsements mocks base method.
+func (m *MockStore) DeleteSelfSkillEndorsements(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSelfSkillEndorsements", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSelfSkillEndorsements indicates an expected call of DeleteSelfSkillEndorsements.
+func (mr *MockStoreMockRecorder) DeleteSelfSkillEndorsements(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSelfSkillEndorsements", reflect.TypeOf((*MockStore)(nil).DeleteSelfSkillEndorsements), arg0, arg1)
+}
+
+// DeleteSession mocks base method.
+func (m *MockStore) DeleteSession(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSession", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSession indicates an expected call of DeleteSession.
+func (mr *MockStoreMockRecorder) DeleteSession(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSession", reflect.TypeOf((*MockStore)(nil).DeleteSession), arg0, arg1)
+}
+
+// DeleteSkill mocks base method.
+func (m *MockStore) DeleteSkill(arg0 context.Context, arg1 uuid.UUID) (db.Skill, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSkill", arg0, arg1)
+	ret0, _ := ret[0].(db.Skill)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSkill indicates an expected call of DeleteSkill.
+func (mr *MockStoreMockRecorder) DeleteSkill(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSkill", reflect.TypeOf((*MockStore)(nil).DeleteSkill), arg0, arg1)
+}
+
+// DeleteSkillEndorsement mocks base method.
+func (m *MockStore) DeleteSkillEndorsement(arg0 context.Context, arg1 db.DeleteSkillEndorsementParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSkillEndorsement", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSkillEndorsement indicates an expected call of DeleteSkillEndorsement.
+func (mr *MockStoreMockRecorder) DeleteSkillEndorsement(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSkillEndorsement", reflect.TypeOf((*MockStore)(nil).DeleteSkillEndorsement), arg0, arg1)
+}
+
+// DeleteTag mocks base method.
+func (m *MockStore) DeleteTag(arg0 context.Context, arg1 uuid.UUID) (db.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTag", arg0, arg1)
+	ret0, _ := ret[0].(db.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTag indicates an expected call of DeleteTag.
+func (mr *MockStoreMockRecorder) DeleteTag(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTag", reflect.TypeOf((*MockStore)(nil).DeleteTag), arg0, arg1)
+}
+
+// DeleteTeam mocks base method.
+func (m *MockStore) DeleteTeam(arg0 context.Context, arg1 uuid.UUID) (db.Team, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTeam", arg0, arg1)
+	ret0, _ := ret[0].(db.Team)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTeam indicates an expected call of DeleteTeam.
+func (mr *MockStoreMockRecorder) DeleteTeam(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTeam", reflect.TypeOf((*MockStore)(nil).DeleteTeam), arg0, arg1)
+}
+
+// DeleteTeamTx mocks base method.
+func (m *MockStore) DeleteTeamTx(arg0 context.Context, arg1 uuid.UUID) (db.Team, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTeamTx", arg0, arg1)
+	ret0, _ := ret[0].(db.Team)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTeamTx indicates an expected call of DeleteTeamTx.
+func (mr *MockStoreMockRecorder) DeleteTeamTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTeamTx", reflect.TypeOf((*MockStore)(nil).DeleteTeamTx), arg0, arg1)
+}
+
+// DeleteTimeEntry mocks base method.
+func (m *MockStore) DeleteTimeEntry(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTimeEntry", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTimeEntry indicates an expected call of DeleteTimeEntry.
+func (mr *MockStoreMockRecorder) DeleteTimeEntry(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTimeEntry", reflect.TypeOf((*MockStore)(nil).DeleteTimeEntry), arg0, arg1)
+}
+
+// DeleteTimeEntryTx mocks base method.
+func (m *MockStore) DeleteTimeEntryTx(arg0 context.Context, arg1 uuid.UUID) (db.TimeEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTimeEntryTx", arg0, arg1)
+	ret0, _ := ret[0].(db.TimeEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTimeEntryTx indicates an expected call of DeleteTimeEntryTx.
+func (mr *MockStoreMockRecorder) DeleteTimeEntryTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTimeEntryTx", reflect.TypeOf((*MockStore)(nil).DeleteTimeEntryTx), arg0, arg1)
+}
+
+// EndorseMemberSkillTx mocks base method.
+func (m *MockStore) EndorseMemberSkillTx(arg0 context.Context, arg1 db.EndorseMemberSkillTxParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndorseMemberSkillTx", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EndorseMemberSkillTx indicates an expected call of EndorseMemberSkillTx.
+func (mr *MockStoreMockRecorder) EndorseMemberSkillTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndorseMemberSkillTx", reflect.TypeOf((*MockStore)(nil).EndorseMemberSkillTx), arg0, arg1)
+}
+
+// FailRunningMemberImportJobs mocks base method.
+func (m *MockStore) FailRunningMemberImportJobs(arg0 context.Context, arg1 jsontext.Value) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FailRunningMemberImportJobs", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FailRunningMemberImportJobs indicates an expected call of FailRunningMemberImportJobs.
+func (mr *MockStoreMockRecorder) FailRunningMemberImportJobs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailRunningMemberImportJobs", reflect.TypeOf((*MockStore)(nil).FailRunningMemberImportJobs), arg0, arg1)
+}
+
+// FinishMemberImportJob mocks base method.
+func (m *MockStore) FinishMemberImportJob(arg0 context.Context, arg1 db.FinishMemberImportJobParams) (db.MemberImportJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinishMemberImportJob", arg0, arg1)
+	ret0, _ := ret[0].(db.MemberImportJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FinishMemberImportJob indicates an expected call of FinishMemberImportJob.
+func (mr *MockStoreMockRecorder) FinishMemberImportJob(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishMemberImportJob", reflect.TypeOf((*MockStore)(nil).FinishMemberImportJob), arg0, arg1)
+}
+
+// ForEachMemberByFilter mocks base method.
+func (m *MockStore) ForEachMemberByFilter(arg0 context.Context, arg1 db.ForEachMemberByFilterParams, arg2 func(db.Member) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForEachMemberByFilter", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ForEachMemberByFilter indicates an expected call of ForEachMemberByFilter.
+func (mr *MockStoreMockRecorder) ForEachMemberByFilter(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForEachMemberByFilter", reflect.TypeOf((*MockStore)(nil).ForEachMemberByFilter), arg0, arg1, arg2)
+}
+
+// GetCalendarToken mocks base method.
+func (m *MockStore) GetCalendarToken(arg0 context.Context, arg1 uuid.UUID) (db.CalendarToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCalendarToken", arg0, arg1)
+	ret0, _ := ret[0].(db.CalendarToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCalendarToken indicates an expected call of GetCalendarToken.
+func (mr *MockStoreMockRecorder) GetCalendarToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCalendarToken", reflect.TypeOf((*MockStore)(nil).GetCalendarToken), arg0, arg1)
+}
+
+// GetCustomFieldDefinition mocks base method.
+func (m *MockStore) GetCustomFieldDefinition(arg0 context.Context, arg1 uuid.UUID) (db.CustomFieldDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCustomFieldDefinition", arg0, arg1)
+	ret0, _ := ret[0].(db.CustomFieldDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCustomFieldDefinition indicates an expected call of GetCustomFieldDefinition.
+func (mr *MockStoreMockRecorder) GetCustomFieldDefinition(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomFieldDefinition", reflect.TypeOf((*MockStore)(nil).GetCustomFieldDefinition), arg0, arg1)
+}
+
+// GetDeskLocationForUpdate mocks base method.
+func (m *MockStore) GetDeskLocationForUpdate(arg0 context.Context, arg1 uuid.UUID) (db.Location, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeskLocationForUpdate", arg0, arg1)
+	ret0, _ := ret[0].(db.Location)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeskLocationForUpdate indicates an expected call of GetDeskLocationForUpdate.
+func (mr *MockStoreMockRecorder) GetDeskLocationForUpdate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeskLocationForUpdate", reflect.TypeOf((*MockStore)(nil).GetDeskLocationForUpdate), arg0, arg1)
+}
+
+// GetLeaveRequest mocks base method.
+func (m *MockStore) GetLeaveRequest(arg0 context.Context, arg1 uuid.UUID) (db.LeaveRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLeaveRequest", arg0, arg1)
+	ret0, _ := ret[0].(db.LeaveRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLeaveRequest indicates an expected call of GetLeaveRequest.
+func (mr *MockStoreMockRecorder) GetLeaveRequest(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLeaveRequest", reflect.TypeOf((*MockStore)(nil).GetLeaveRequest), arg0, arg1)
+}
+
+// GetLeaveRequestForUpdate mocks base method.
+func (m *MockStore) GetLeaveRequestForUpdate(arg0 context.Context, arg1 uuid.UUID) (db.LeaveRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLeaveRequestForUpdate", arg0, arg1)
+	ret0, _ := ret[0].(db.LeaveRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLeaveRequestForUpdate indicates an expected call of GetLeaveRequestForUpdate.
+func (mr *MockStoreMockRecorder) GetLeaveRequestForUpdate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLeaveRequestForUpdate", reflect.TypeOf((*MockStore)(nil).GetLeaveRequestForUpdate), arg0, arg1)
+}
+
+// GetLeaveType mocks base method.
+func (m *MockStore) GetLeaveType(arg0 context.Context, arg1 uuid.UUID) (db.LeaveType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLeaveType", arg0, arg1)
+	ret0, _ := ret[0].(db.LeaveType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLeaveType indicates an expected call of GetLeaveType.
+func (mr *MockStoreMockRecorder) GetLeaveType(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLeaveType", reflect.TypeOf((*MockStore)(nil).GetLeaveType), arg0, arg1)
+}
+
+// GetLocation mocks base method.
+func (m *MockStore) GetLocation(arg0 context.Context, arg1 uuid.UUID) (db.Location, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLocation", arg0, arg1)
+	ret0, _ := ret[0].(db.Location)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLocation indicates an expected call of GetLocation.
+func (mr *MockStoreMockRecorder) GetLocation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLocation", reflect.TypeOf((*MockStore)(nil).GetLocation), arg0, arg1)
+}
+
+// GetMember mocks base method.
+func (m *MockStore) GetMember(arg0 context.Context, arg1 uuid.UUID) (db.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMember", arg0, arg1)
+	ret0, _ := ret[0].(db.Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMember indicates an expected call of GetMember.
+func (mr *MockStoreMockRecorder) GetMember(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMember", reflect.TypeOf((*MockStore)(nil).GetMember), arg0, arg1)
+}
+
+// GetMemberContactDetails mocks base method.
+func (m *MockStore) GetMemberContactDetails(arg0 context.Context, arg1 uuid.UUID) (db.MemberContactDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMemberContactDetails", arg0, arg1)
+	ret0, _ := ret[0].(db.MemberContactDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMemberContactDetails indicates an expected call of GetMemberContactDetails.
+func (mr *MockStoreMockRecorder) GetMemberContactDetails(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMemberContactDetails", reflect.TypeOf((*MockStore)(nil).GetMemberContactDetails), arg0, arg1)
+}
+
+// GetMemberForUpdate mocks base method.
+func (m *MockStore) GetMemberForUpdate(arg0 context.Context, arg1 uuid.UUID) (db.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMemberForUpdate", arg0, arg1)
+	ret0, _ := ret[0].(db.Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMemberForUpdate indicates an expected call of GetMemberForUpdate.
+func (mr *MockStoreMockRecorder) GetMemberForUpdate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMemberForUpdate", reflect.TypeOf((*MockStore)(nil).GetMemberForUpdate), arg0, arg1)
+}
+
+// GetMemberImportJob mocks base method.
+func (m *MockStore) GetMemberImportJob(arg0 context.Context, arg1 uuid.UUID) (db.MemberImportJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMemberImportJob", arg0, arg1)
+	ret0, _ := ret[0].(db.MemberImportJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMemberImportJob indicates an expected call of GetMemberImportJob.
+func (mr *MockStoreMockRecorder) GetMemberImportJob(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMemberImportJob", reflect.TypeOf((*MockStore)(nil).GetMemberImportJob), arg0, arg1)
+}
+
+// GetMemberSkill mocks base method.
+func (m *MockStore) GetMemberSkill(arg0 context.Context, arg1 db.GetMemberSkillParams) (db.MemberSkill, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMemberSkill", arg0, arg1)
+	ret0, _ := ret[0].(db.MemberSkill)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMemberSkill indicates an expected call of GetMemberSkill.
+func (mr *MockStoreMockRecorder) GetMemberSkill(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMemberSkill", reflect.TypeOf((*MockStore)(nil).GetMemberSkill), arg0, arg1)
+}
+
+// GetMemberTimesheet mocks base method.
+func (m *MockStore) GetMemberTimesheet(arg0 context.Context, arg1 db.GetMemberTimesheetParams) (db.Timesheet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMemberTimesheet", arg0, arg1)
+	ret0, _ := ret[0].(db.Timesheet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMemberTimesheet indicates an expected call of GetMemberTimesheet.
+func (mr *MockStoreMockRecorder) GetMemberTimesheet(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMemberTimesheet", reflect.TypeOf((*MockStore)(nil).GetMemberTimesheet), arg0, arg1)
+}
+
+// GetProject mocks base method.
+func (m *MockStore) GetProject(arg0 context.Context, arg1 uuid.UUID) (db.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProject", arg0, arg1)
+	ret0, _ := ret[0].(db.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProject indicates an expected call of GetProject.
+func (mr *MockStoreMockRecorder) GetProject(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProject", reflect.TypeOf((*MockStore)(nil).GetProject), arg0, arg1)
+}
+
+// GetProjectAssignment mocks base method.
+func (m *MockStore) GetProjectAssignment(arg0 context.Context, arg1 uuid.UUID) (db.ProjectAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProjectAssignment", arg0, arg1)
+	ret0, _ := ret[0].(db.ProjectAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProjectAssignment indicates an expected call of GetProjectAssignment.
+func (mr *MockStoreMockRecorder) GetProjectAssignment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectAssignment", reflect.TypeOf((*MockStore)(nil).GetProjectAssignment), arg0, arg1)
+}
+
+// GetProjectAssignmentForUpdate mocks base method.
+func (m *MockStore) GetProjectAssignmentForUpdate(arg0 context.Context, arg1 uuid.UUID) (db.ProjectAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProjectAssignmentForUpdate", arg0, arg1)
+	ret0, _ := ret[0].(db.ProjectAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProjectAssignmentForUpdate indicates an expected call of GetProjectAssignmentForUpdate.
+func (mr *MockStoreMockRecorder) GetProjectAssignmentForUpdate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectAssignmentForUpdate", reflect.TypeOf((*MockStore)(nil).GetProjectAssignmentForUpdate), arg0, arg1)
+}
+
+// GetRoom mocks base method.
+func (m *MockStore) GetRoom(arg0 context.Context, arg1 uuid.UUID) (db.Room, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRoom", arg0, arg1)
+	ret0, _ := ret[0].(db.Room)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRoom indicates an expected call of GetRoom.
+func (mr *MockStoreMockRecorder) GetRoom(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoom", reflect.TypeOf((*MockStore)(nil).GetRoom), arg0, arg1)
+}
+
+// GetRoomForUpdate mocks base method.
+func (m *MockStore) GetRoomForUpdate(arg0 context.Context, arg1 uuid.UUID) (db.Room, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRoomForUpdate", arg0, arg1)
+	ret0, _ := ret[0].(db.Room)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRoomForUpdate indicates an expected call of GetRoomForUpdate.
+func (mr *MockStoreMockRecorder) GetRoomForUpdate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoomForUpdate", reflect.TypeOf((*MockStore)(nil).GetRoomForUpdate), arg0, arg1)
+}
+
+// GetRoomReservation mocks base method.
+func (m *MockStore) GetRoomReservation(arg0 context.Context, arg1 uuid.UUID) (db.RoomReservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRoomReservation", arg0, arg1)
+	ret0, _ := ret[0].(db.RoomReservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRoomReservation indicates an expected call of GetRoomReservation.
+func (mr *MockStoreMockRecorder) GetRoomReservation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoomReservation", reflect.TypeOf((*MockStore)(nil).GetRoomReservation), arg0, arg1)
+}
+
+// GetRunningTimeEntry mocks base method.
+func (m *MockStore) GetRunningTimeEntry(arg0 context.Context, arg1 uuid.UUID) (db.TimeEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRunningTimeEntry", arg0, arg1)
+	ret0, _ := ret[0].(db.TimeEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRunningTimeEntry indicates an expected call of GetRunningTimeEntry.
+func (mr *MockStoreMockRecorder) GetRunningTimeEntry(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRunningTimeEntry", reflect.TypeOf((*MockStore)(nil).GetRunningTimeEntry), arg0, arg1)
+}
+
+// GetSession mocks base method.
+func (m *MockStore) GetSession(arg0 context.Context, arg1 uuid.UUID) (db.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSession", arg0, arg1)
+	ret0, _ := ret[0].(db.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSession indicates an expected call of GetSession.
+func (mr *MockStoreMockRecorder) GetSession(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSession", reflect.TypeOf((*MockStore)(nil).GetSession), arg0, arg1)
+}
+
+// GetSkill mocks base method.
+func (m *MockStore) GetSkill(arg0 context.Context, arg1 uuid.UUID) (db.Skill, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSkill", arg0, arg1)
+	ret0, _ := ret[0].(db.Skill)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSkill indicates an expected call of GetSkill.
+func (mr *MockStoreMockRecorder) GetSkill(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSkill", reflect.TypeOf((*MockStore)(nil).GetSkill), arg0, arg1)
+}
+
+// GetTag mocks base method.
+func (m *MockStore) GetTag(arg0 context.Context, arg1 uuid.UUID) (db.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTag", arg0, arg1)
+	ret0, _ := ret[0].(db.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTag indicates an expected call of GetTag.
+func (mr *MockStoreMockRecorder) GetTag(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTag", reflect.TypeOf((*MockStore)(nil).GetTag), arg0, arg1)
+}
+
+// GetTeam mocks base method.
+func (m *MockStore) GetTeam(arg0 context.Context, arg1 uuid.UUID) (db.Team, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTeam", arg0, arg1)
+	ret0, _ := ret[0].(db.Team)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTeam indicates an expected call of GetTeam.
+func (mr *MockStoreMockRecorder) GetTeam(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeam", reflect.TypeOf((*MockStore)(nil).GetTeam), arg0, arg1)
+}
+
+// GetTimeEntry mocks base method.
+func (m *MockStore) GetTimeEntry(arg0 context.Context, arg1 uuid.UUID) (db.TimeEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTimeEntry", arg0, arg1)
+	ret0, _ := ret[0].(db.TimeEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTimeEntry indicates an expected call of GetTimeEntry.
+func (mr *MockStoreMockRecorder) GetTimeEntry(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTimeEntry", reflect.TypeOf((*MockStore)(nil).GetTimeEntry), arg0, arg1)
+}
+
+// GetTimeEntryForUpdate mocks base method.
+func (m *MockStore) GetTimeEntryForUpdate(arg0 context.Context, arg1 uuid.UUID) (db.TimeEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTimeEntryForUpdate", arg0, arg1)
+	ret0, _ := ret[0].(db.TimeEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTimeEntryForUpdate indicates an expected call of GetTimeEntryForUpdate.
+func (mr *MockStoreMockRecorder) GetTimeEntryForUpdate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTimeEntryForUpdate", reflect.TypeOf((*MockStore)(nil).GetTimeEntryForUpdate), arg0, arg1)
+}
+
+// GetUser mocks base method.
+func (m *MockStore) GetUser(arg0 context.Context, arg1 uuid.UUID) (db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUser", arg0, arg1)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUser indicates an expected call of GetUser.
+func (mr *MockStoreMockRecorder) GetUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockStore)(nil).GetUser), arg0, arg1)
+}
+
+// GetUserByEmail mocks base method.
+func (m *MockStore) GetUserByEmail(arg0 context.Context, arg1 string) (db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByEmail", arg0, arg1)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByEmail indicates an expected call of GetUserByEmail.
+func (mr *MockStoreMockRecorder) GetUserByEmail(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByEmail", reflect.TypeOf((*MockStore)(nil).GetUserByEmail), arg0, arg1)
+}
+
+// ImportMembersTx mocks base method.
+func (m *MockStore) ImportMembersTx(arg0 context.Context, arg1 []db.CreateMemberParams, arg2 db.AuditMeta) ([]db.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportMembersTx", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]db.Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportMembersTx indicates an expected call of ImportMembersTx.
+func (mr *MockStoreMockRecorder) ImportMembersTx(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportMembersTx", reflect.TypeOf((*MockStore)(nil).ImportMembersTx), arg0, arg1, arg2)
+}
+
+// IsDescendantTeam mocks base method.
+func (m *MockStore) IsDescendantTeam(arg0 context.Context, arg1 db.IsDescendantTeamParams) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsDescendantTeam", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsDescendantTeam indicates an expected call of IsDescendantTeam.
+func (mr *MockStoreMockRecorder) IsDescendantTeam(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsDescendantTeam", reflect.TypeOf((*MockStore)(nil).IsDescendantTeam), arg0, arg1)
+}
+
+// IsMemberReport mocks base method.
+func (m *MockStore) IsMemberReport(arg0 context.Context, arg1 db.IsMemberReportParams) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsMemberReport", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsMemberReport indicates an expected call of IsMemberReport.
+func (mr *MockStoreMockRecorder) IsMemberReport(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsMemberReport", reflect.TypeOf((*MockStore)(nil).IsMemberReport), arg0, arg1)
+}
+
+// ListAttendanceSummaries mocks base method.
+func (m *MockStore) ListAttendanceSummaries(arg0 context.Context, arg1 db.ListAttendanceSummariesParams) ([]db.ListAttendanceSummariesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAttendanceSummaries", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListAttendanceSummariesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAttendanceSummaries indicates an expected call of ListAttendanceSummaries.
+func (mr *MockStoreMockRecorder) ListAttendanceSummaries(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAttendanceSummaries", reflect.TypeOf((*MockStore)(nil).ListAttendanceSummaries), arg0, arg1)
+}
+
+// ListAuditEvents mocks base method.
+func (m *MockStore) ListAuditEvents(arg0 context.Context, arg1 db.ListAuditEventsParams) ([]db.AuditEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAuditEvents", arg0, arg1)
+	ret0, _ := ret[0].([]db.AuditEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAuditEvents indicates an expected call of ListAuditEvents.
+func (mr *MockStoreMockRecorder) ListAuditEvents(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuditEvents", reflect.TypeOf((*MockStore)(nil).ListAuditEvents), arg0, arg1)
+}
+
+// ListCalendarFeedReservations mocks base method.
+func (m *MockStore) ListCalendarFeedReservations(arg0 context.Context, arg1 db.ListCalendarFeedReservationsParams) ([]db.ListCalendarFeedReservationsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCalendarFeedReservations", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListCalendarFeedReservationsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCalendarFeedReservations indicates an expected call of ListCalendarFeedReservations.
+func (mr *MockStoreMockRecorder) ListCalendarFeedReservations(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCalendarFeedReservations", reflect.TypeOf((*MockStore)(nil).ListCalendarFeedReservations), arg0, arg1)
+}
+
+// ListCapacityAssignments mocks base method.
+func (m *MockStore) ListCapacityAssignments(arg0 context.Context, arg1 db.ListCapacityAssignmentsParams) ([]db.ListCapacityAssignmentsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCapacityAssignments", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListCapacityAssignmentsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCapacityAssignments indicates an expected call of ListCapacityAssignments.
+func (mr *MockStoreMockRecorder) ListCapacityAssignments(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCapacityAssignments", reflect.TypeOf((*MockStore)(nil).ListCapacityAssignments), arg0, arg1)
+}
+
+// ListCapacityLeaves mocks base method.
+func (m *MockStore) ListCapacityLeaves(arg0 context.Context, arg1 db.ListCapacityLeavesParams) ([]db.ListCapacityLeavesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCapacityLeaves", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListCapacityLeavesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCapacityLeaves indicates an expected call of ListCapacityLeaves.
+func (mr *MockStoreMockRecorder) ListCapacityLeaves(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCapacityLeaves", reflect.TypeOf((*MockStore)(nil).ListCapacityLeaves), arg0, arg1)
+}
+
+// ListCapacityMembers mocks base method.
+func (m *MockStore) ListCapacityMembers(arg0 context.Context, arg1 db.ListCapacityMembersParams) ([]db.ListCapacityMembersRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCapacityMembers", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListCapacityMembersRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCapacityMembers indicates an expected call of ListCapacityMembers.
+func (mr *MockStoreMockRecorder) ListCapacityMembers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCapacityMembers", reflect.TypeOf((*MockStore)(nil).ListCapacityMembers), arg0, arg1)
+}
+
+// ListChecklistTemplateTasks mocks base method.
+func (m *MockStore) ListChecklistTemplateTasks(arg0 context.Context, arg1 string) ([]db.ChecklistTemplateTask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListChecklistTemplateTasks", arg0, arg1)
+	ret0, _ := ret[0].([]db.ChecklistTemplateTask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListChecklistTemplateTasks indicates an expected call of ListChecklistTemplateTasks.
+func (mr *MockStoreMockRecorder) ListChecklistTemplateTasks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListChecklistTemplateTasks", reflect.TypeOf((*MockStore)(nil).ListChecklistTemplateTasks), arg0, arg1)
+}
+
+// ListCustomFieldDefinitions mocks base method.
+func (m *MockStore) ListCustomFieldDefinitions(arg0 context.Context) ([]db.CustomFieldDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCustomFieldDefinitions", arg0)
+	ret0, _ := ret[0].([]db.CustomFieldDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCustomFieldDefinitions indicates an expected call of ListCustomFieldDefinitions.
+func (mr *MockStoreMockRecorder) ListCustomFieldDefinitions(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCustomFieldDefinitions", reflect.TypeOf((*MockStore)(nil).ListCustomFieldDefinitions), arg0)
+}
+
+// ListDeletedMembers mocks base method.
+func (m *MockStore) ListDeletedMembers(arg0 context.Context, arg1 db.ListDeletedMembersParams) ([]db.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeletedMembers", arg0, arg1)
+	ret0, _ := ret[0].([]db.Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeletedMembers indicates an expected call of ListDeletedMembers.
+func (mr *MockStoreMockRecorder) ListDeletedMembers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeletedMembers", reflect.TypeOf((*MockStore)(nil).ListDeletedMembers), arg0, arg1)
+}
+
+// ListDesks mocks base method.
+func (m *MockStore) ListDesks(arg0 context.Context, arg1 uuid.UUID) ([]db.Desk, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDesks", arg0, arg1)
+	ret0, _ := ret[0].([]db.Desk)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDesks indicates an expected call of ListDesks.
+func (mr *MockStoreMockRecorder) ListDesks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDesks", reflect.TypeOf((*MockStore)(nil).ListDesks), arg0, arg1)
+}
+
+// ListDirectReportsForUpdate mocks base method.
+func (m *MockStore) ListDirectReportsForUpdate(arg0 context.Context, arg1 db.ListDirectReportsForUpdateParams) ([]db.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDirectReportsForUpdate", arg0, arg1)
+	ret0, _ := ret[0].([]db.Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDirectReportsForUpdate indicates an expected call of ListDirectReportsForUpdate.
+func (mr *MockStoreMockRecorder) ListDirectReportsForUpdate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDirectReportsForUpdate", reflect.TypeOf((*MockStore)(nil).ListDirectReportsForUpdate), arg0, arg1)
+}
+
+// ListFloors mocks base method.
+func (m *MockStore) ListFloors(arg0 context.Context, arg1 uuid.UUID) ([]db.Floor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFloors", arg0, arg1)
+	ret0, _ := ret[0].([]db.Floor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFloors indicates an expected call of ListFloors.
+func (mr *MockStoreMockRecorder) ListFloors(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFloors", reflect.TypeOf((*MockStore)(nil).ListFloors), arg0, arg1)
+}
+
+// ListLeaveBalances mocks base method.
+func (m *MockStore) ListLeaveBalances(arg0 context.Context, arg1 uuid.UUID, arg2 int) ([]db.LeaveBalance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLeaveBalances", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]db.LeaveBalance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLeaveBalances indicates an expected call of ListLeaveBalances.
+func (mr *MockStoreMockRecorder) ListLeaveBalances(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLeaveBalances", reflect.TypeOf((*MockStore)(nil).ListLeaveBalances), arg0, arg1, arg2)
+}
+
+// ListLeaveRequests mocks base method.
+func (m *MockStore) ListLeaveRequests(arg0 context.Context, arg1 db.ListLeaveRequestsParams) ([]db.LeaveRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLeaveRequests", arg0, arg1)
+	ret0, _ := ret[0].([]db.LeaveRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLeaveRequests indicates an expected call of ListLeaveRequests.
+func (mr *MockStoreMockRecorder) ListLeaveRequests(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLeaveRequests", reflect.TypeOf((*MockStore)(nil).ListLeaveRequests), arg0, arg1)
+}
+
+// ListLeaveTypes mocks base method.
+func (m *MockStore) ListLeaveTypes(arg0 context.Context) ([]db.LeaveType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLeaveTypes", arg0)
+	ret0, _ := ret[0].([]db.LeaveType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLeaveTypes indicates an expected call of ListLeaveTypes.
+func (mr *MockStoreMockRecorder) ListLeaveTypes(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLeaveTypes", reflect.TypeOf((*MockStore)(nil).ListLeaveTypes), arg0)
+}
+
+// ListLocationDeskBookings mocks base method.
+func (m *MockStore) ListLocationDeskBookings(arg0 context.Context, arg1 db.ListLocationDeskBookingsParams) ([]db.DeskBooking, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLocationDeskBookings", arg0, arg1)
+	ret0, _ := ret[0].([]db.DeskBooking)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLocationDeskBookings indicates an expected call of ListLocationDeskBookings.
+func (mr *MockStoreMockRecorder) ListLocationDeskBookings(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLocationDeskBookings", reflect.TypeOf((*MockStore)(nil).ListLocationDeskBookings), arg0, arg1)
+}
+
+// ListLocationDesks mocks base method.
+func (m *MockStore) ListLocationDesks(arg0 context.Context, arg1 uuid.UUID) ([]db.ListLocationDesksRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLocationDesks", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListLocationDesksRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLocationDesks indicates an expected call of ListLocationDesks.
+func (mr *MockStoreMockRecorder) ListLocationDesks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLocationDesks", reflect.TypeOf((*MockStore)(nil).ListLocationDesks), arg0, arg1)
+}
+
+// ListLocations mocks base method.
+func (m *MockStore) ListLocations(arg0 context.Context) ([]db.Location, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLocations", arg0)
+	ret0, _ := ret[0].([]db.Location)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLocations indicates an expected call of ListLocations.
+func (mr *MockStoreMockRecorder) ListLocations(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLocations", reflect.TypeOf((*MockStore)(nil).ListLocations), arg0)
+}
+
+// ListMemberActiveLeaveRequests mocks base method.
+func (m *MockStore) ListMemberActiveLeaveRequests(arg0 context.Context, arg1 db.ListMemberActiveLeaveRequestsParams) ([]db.LeaveRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMemberActiveLeaveRequests", arg0, arg1)
+	ret0, _ := ret[0].([]db.LeaveRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMemberActiveLeaveRequests indicates an expected call of ListMemberActiveLeaveRequests.
+func (mr *MockStoreMockRecorder) ListMemberActiveLeaveRequests(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMemberActiveLeaveRequests", reflect.TypeOf((*MockStore)(nil).ListMemberActiveLeaveRequests), arg0, arg1)
+}
+
+// ListMemberAddresses mocks base method.
+func (m *MockStore) ListMemberAddresses(arg0 context.Context, arg1 uuid.UUID) ([]db.MemberAddress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMemberAddresses", arg0, arg1)
+	ret0, _ := ret[0].([]db.MemberAddress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMemberAddresses indicates an expected call of ListMemberAddresses.
+func (mr *MockStoreMockRecorder) ListMemberAddresses(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMemberAddresses", reflect.TypeOf((*MockStore)(nil).ListMemberAddresses), arg0, arg1)
+}
+
+// ListMemberAttendances mocks base method.
+func (m *MockStore) ListMemberAttendances(arg0 context.Context, arg1 db.ListMemberAttendancesParams) ([]db.Attendance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMemberAttendances", arg0, arg1)
+	ret0, _ := ret[0].([]db.Attendance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMemberAttendances indicates an expected call of ListMemberAttendances.
+func (mr *MockStoreMockRecorder) ListMemberAttendances(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMemberAttendances", reflect.TypeOf((*MockStore)(nil).ListMemberAttendances), arg0, arg1)
+}
+
+// ListMemberCapacities mocks base method.
+func (m *MockStore) ListMemberCapacities(arg0 context.Context, arg1 db.ListMemberCapacitiesParams) ([]db.MemberCapacity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMemberCapacities", arg0, arg1)
+	ret0, _ := ret[0].([]db.MemberCapacity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMemberCapacities indicates an expected call of ListMemberCapacities.
+func (mr *MockStoreMockRecorder) ListMemberCapacities(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMemberCapacities", reflect.TypeOf((*MockStore)(nil).ListMemberCapacities), arg0, arg1)
+}
+
+// ListMemberChain mocks base method.
+func (m *MockStore) ListMemberChain(arg0 context.Context, arg1 uuid.UUID) ([]db.ListMemberChainRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMemberChain", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListMemberChainRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMemberChain indicates an expected call of ListMemberChain.
+func (mr *MockStoreMockRecorder) ListMemberChain(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMemberChain", reflect.TypeOf((*MockStore)(nil).ListMemberChain), arg0, arg1)
+}
+
+// ListMemberChecklistTasks mocks base method.
+func (m *MockStore) ListMemberChecklistTasks(arg0 context.Context, arg1 db.ListMemberChecklistTasksParams) ([]db.MemberChecklistTask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMemberChecklistTasks", arg0, arg1)
+	ret0, _ := ret[0].([]db.MemberChecklistTask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMemberChecklistTasks indicates an expected call of ListMemberChecklistTasks.
+func (mr *MockStoreMockRecorder) ListMemberChecklistTasks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMemberChecklistTasks", reflect.TypeOf((*MockStore)(nil).ListMemberChecklistTasks), arg0, arg1)
+}
+
+// ListMemberDeskBookings mocks base method.
+func (m *MockStore) ListMemberDeskBookings(arg0 context.Context, arg1 db.ListMemberDeskBookingsParams) ([]db.DeskBooking, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMemberDeskBookings", arg0, arg1)
+	ret0, _ := ret[0].([]db.DeskBooking)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMemberDeskBookings indicates an expected call of ListMemberDeskBookings.
+func (mr *MockStoreMockRecorder) ListMemberDeskBookings(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMemberDeskBookings", reflect.TypeOf((*MockStore)(nil).ListMemberDeskBookings), arg0, arg1)
+}
+
+// ListMemberDuplicatePairs mocks base method.
+func (m *MockStore) ListMemberDuplicatePairs(arg0 context.Context, arg1 db.ListMemberDuplicatePairsParams) ([]db.ListMemberDuplicatePairsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMemberDuplicatePairs", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListMemberDuplicatePairsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMemberDuplicatePairs indicates an expected call of ListMemberDuplicatePairs.
+func (mr *MockStoreMockRecorder) ListMemberDuplicatePairs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMemberDuplicatePairs", reflect.TypeOf((*MockStore)(nil).ListMemberDuplicatePairs), arg0, arg1)
+}
+
+// ListMemberEmails mocks base method.
+func (m *MockStore) ListMemberEmails(arg0 context.Context, arg1 uuid.UUID) ([]db.MemberEmail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMemberEmails", arg0, arg1)
+	ret0, _ := ret[0].([]db.MemberEmail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMemberEmails indicates an expected call of ListMemberEmails.
+func (mr *MockStoreMockRecorder) ListMemberEmails(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMemberEmails", reflect.TypeOf((*MockStore)(nil).ListMemberEmails), arg0, arg1)
+}
+
+// ListMemberLinks mocks base method.
+func (m *MockStore) ListMemberLinks(arg0 context.Context, arg1 uuid.UUID) ([]db.MemberLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMemberLinks", arg0, arg1)
+	ret0, _ := ret[0].([]db.MemberLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMemberLinks indicates an expected call of ListMemberLinks.
+func (mr *MockStoreMockRecorder) ListMemberLinks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMemberLinks", reflect.TypeOf((*MockStore)(nil).ListMemberLinks), arg0, arg1)
+}
+
+// ListMemberPhones mocks base method.
+func (m *MockStore) ListMemberPhones(arg0 context.Context, arg1 uuid.UUID) ([]db.MemberPhone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMemberPhones", arg0, arg1)
+	ret0, _ := ret[0].([]db.MemberPhone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMemberPhones indicates an expected call of ListMemberPhones.
+func (mr *MockStoreMockRecorder) ListMemberPhones(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMemberPhones", reflect.TypeOf((*MockStore)(nil).ListMemberPhones), arg0, arg1)
+}
+
+// ListMemberProjectAssignments mocks base method.
+func (m *MockStore) ListMemberProjectAssignments(arg0 context.Context, arg1 db.ListMemberProjectAssignmentsParams) ([]db.ProjectAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMemberProjectAssignments", arg0, arg1)
+	ret0, _ := ret[0].([]db.ProjectAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMemberProjectAssignments indicates an expected call of ListMemberProjectAssignments.
+func (mr *MockStoreMockRecorder) ListMemberProjectAssignments(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMemberProjectAssignments", reflect.TypeOf((*MockStore)(nil).ListMemberProjectAssignments), arg0, arg1)
+}
+
+// ListMemberReports mocks base method.
+func (m *MockStore) ListMemberReports(arg0 context.Context, arg1 db.ListMemberReportsParams) ([]db.ListMemberReportsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMemberReports", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListMemberReportsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMemberReports indicates an expected call of ListMemberReports.
+func (mr *MockStoreMockRecorder) ListMemberReports(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMemberReports", reflect.TypeOf((*MockStore)(nil).ListMemberReports), arg0, arg1)
+}
+
+// ListMemberSkills mocks base method.
+func (m *MockStore) ListMemberSkills(arg0 context.Context, arg1 db.ListMemberSkillsParams) ([]db.ListMemberSkillsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMemberSkills", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListMemberSkillsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMemberSkills indicates an expected call of ListMemberSkills.
+func (mr *MockStoreMockRecorder) ListMemberSkills(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMemberSkills", reflect.TypeOf((*MockStore)(nil).ListMemberSkills), arg0, arg1)
+}
+
+// ListMemberTimeEntries mocks base method.
+func (m *MockStore) ListMemberTimeEntries(arg0 context.Context, arg1 db.ListMemberTimeEntriesParams) ([]db.TimeEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMemberTimeEntries", arg0, arg1)
+	ret0, _ := ret[0].([]db.TimeEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMemberTimeEntries indicates an expected call of ListMemberTimeEntries.
+func (mr *MockStoreMockRecorder) ListMemberTimeEntries(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMemberTimeEntries", reflect.TypeOf((*MockStore)(nil).ListMemberTimeEntries), arg0, arg1)
+}
+
+// ListMemberWorkingHours mocks base method.
+func (m *MockStore) ListMemberWorkingHours(arg0 context.Context, arg1 []uuid.UUID) ([]db.MemberWorkingHour, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMemberWorkingHours", arg0, arg1)
+	ret0, _ := ret[0].([]db.MemberWorkingHour)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMemberWorkingHours indicates an expected call of ListMemberWorkingHours.
+func (mr *MockStoreMockRecorder) ListMemberWorkingHours(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMemberWorkingHours", reflect.TypeOf((*MockStore)(nil).ListMemberWorkingHours), arg0, arg1)
+}
+
+// ListMembers mocks base method.
+func (m *MockStore) ListMembers(arg0 context.Context, arg1 db.ListMembersParams) ([]db.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMembers", arg0, arg1)
+	ret0, _ := ret[0].([]db.Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMembers indicates an expected call of ListMembers.
+func (mr *MockStoreMockRecorder) ListMembers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMembers", reflect.TypeOf((*MockStore)(nil).ListMembers), arg0, arg1)
+}
+
+// ListMembersByFilter mocks base method.
+func (m *MockStore) ListMembersByFilter(arg0 context.Context, arg1 db.ListMembersByFilterParams) ([]db.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMembersByFilter", arg0, arg1)
+	ret0, _ := ret[0].([]db.Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMembersByFilter indicates an expected call of ListMembersByFilter.
+func (mr *MockStoreMockRecorder) ListMembersByFilter(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMembersByFilter", reflect.TypeOf((*MockStore)(nil).ListMembersByFilter), arg0, arg1)
+}
+
+// ListMembersByIDs mocks base method.
+func (m *MockStore) ListMembersByIDs(arg0 context.Context, arg1 []uuid.UUID) ([]db.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMembersByIDs", arg0, arg1)
+	ret0, _ := ret[0].([]db.Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMembersByIDs indicates an expected call of ListMembersByIDs.
+func (mr *MockStoreMockRecorder) ListMembersByIDs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMembersByIDs", reflect.TypeOf((*MockStore)(nil).ListMembersByIDs), arg0, arg1)
+}
+
+// ListMembersForUpdate mocks base method.
+func (m *MockStore) ListMembersForUpdate(arg0 context.Context, arg1 []uuid.UUID) ([]db.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMembersForUpdate", arg0, arg1)
+	ret0, _ := ret[0].([]db.Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMembersForUpdate indicates an expected call of ListMembersForUpdate.
+func (mr *MockStoreMockRecorder) ListMembersForUpdate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMembersForUpdate", reflect.TypeOf((*MockStore)(nil).ListMembersForUpdate), arg0, arg1)
+}
+
+// ListOpenChecklistTasksByAssignee mocks base method.
+func (m *MockStore) ListOpenChecklistTasksByAssignee(arg0 context.Context, arg1 uuid.NullUUID) ([]db.MemberChecklistTask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOpenChecklistTasksByAssignee", arg0, arg1)
+	ret0, _ := ret[0].([]db.MemberChecklistTask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOpenChecklistTasksByAssignee indicates an expected call of ListOpenChecklistTasksByAssignee.
+func (mr *MockStoreMockRecorder) ListOpenChecklistTasksByAssignee(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOpenChecklistTasksByAssignee", reflect.TypeOf((*MockStore)(nil).ListOpenChecklistTasksByAssignee), arg0, arg1)
+}
+
+// ListOrgChartMembers mocks base method.
+func (m *MockStore) ListOrgChartMembers(arg0 context.Context) ([]db.ListOrgChartMembersRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrgChartMembers", arg0)
+	ret0, _ := ret[0].([]db.ListOrgChartMembersRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrgChartMembers indicates an expected call of ListOrgChartMembers.
+func (mr *MockStoreMockRecorder) ListOrgChartMembers(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrgChartMembers", reflect.TypeOf((*MockStore)(nil).ListOrgChartMembers), arg0)
+}
+
+// ListPresenceAttendances mocks base method.
+func (m *MockStore) ListPresenceAttendances(arg0 context.Context, arg1 db.ListPresenceAttendancesParams) ([]db.ListPresenceAttendancesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPresenceAttendances", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListPresenceAttendancesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPresenceAttendances indicates an expected call of ListPresenceAttendances.
+func (mr *MockStoreMockRecorder) ListPresenceAttendances(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPresenceAttendances", reflect.TypeOf((*MockStore)(nil).ListPresenceAttendances), arg0, arg1)
+}
+
+// ListPrimaryMemberEmails mocks base method.
+func (m *MockStore) ListPrimaryMemberEmails(arg0 context.Context, arg1 []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPrimaryMemberEmails", arg0, arg1)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPrimaryMemberEmails indicates an expected call of ListPrimaryMemberEmails.
+func (mr *MockStoreMockRecorder) ListPrimaryMemberEmails(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPrimaryMemberEmails", reflect.TypeOf((*MockStore)(nil).ListPrimaryMemberEmails), arg0, arg1)
+}
+
+// ListProjectAssignments mocks base method.
+func (m *MockStore) ListProjectAssignments(arg0 context.Context, arg1 db.ListProjectAssignmentsParams) ([]db.ProjectAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProjectAssignments", arg0, arg1)
+	ret0, _ := ret[0].([]db.ProjectAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProjectAssignments indicates an expected call of ListProjectAssignments.
+func (mr *MockStoreMockRecorder) ListProjectAssignments(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectAssignments", reflect.TypeOf((*MockStore)(nil).ListProjectAssignments), arg0, arg1)
+}
+
+// ListProjects mocks base method.
+func (m *MockStore) ListProjects(arg0 context.Context, arg1 bool) ([]db.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProjects", arg0, arg1)
+	ret0, _ := ret[0].([]db.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProjects indicates an expected call of ListProjects.
+func (mr *MockStoreMockRecorder) ListProjects(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjects", reflect.TypeOf((*MockStore)(nil).ListProjects), arg0, arg1)
+}
+
+// ListRoomReservationExceptions mocks base method.
+func (m *MockStore) ListRoomReservationExceptions(arg0 context.Context, arg1 []uuid.UUID) ([]db.RoomReservationException, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRoomReservationExceptions", arg0, arg1)
+	ret0, _ := ret[0].([]db.RoomReservationException)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRoomReservationExceptions indicates an expected call of ListRoomReservationExceptions.
+func (mr *MockStoreMockRecorder) ListRoomReservationExceptions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoomReservationExceptions", reflect.TypeOf((*MockStore)(nil).ListRoomReservationExceptions), arg0, arg1)
+}
+
+// ListRoomReservationOccurrences mocks base method.
+func (m *MockStore) ListRoomReservationOccurrences(arg0 context.Context, arg1 db.ListRoomReservationOccurrencesParams) ([]db.RoomReservationOccurrence, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRoomReservationOccurrences", arg0, arg1)
+	ret0, _ := ret[0].([]db.RoomReservationOccurrence)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRoomReservationOccurrences indicates an expected call of ListRoomReservationOccurrences.
+func (mr *MockStoreMockRecorder) ListRoomReservationOccurrences(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoomReservationOccurrences", reflect.TypeOf((*MockStore)(nil).ListRoomReservationOccurrences), arg0, arg1)
+}
+
+// ListRoomReservationsInRange mocks base method.
+func (m *MockStore) ListRoomReservationsInRange(arg0 context.Context, arg1 db.ListRoomReservationsInRangeParams) ([]db.RoomReservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRoomReservationsInRange", arg0, arg1)
+	ret0, _ := ret[0].([]db.RoomReservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRoomReservationsInRange indicates an expected call of ListRoomReservationsInRange.
+func (mr *MockStoreMockRecorder) ListRoomReservationsInRange(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoomReservationsInRange", reflect.TypeOf((*MockStore)(nil).ListRoomReservationsInRange), arg0, arg1)
+}
+
+// ListRooms mocks base method.
+func (m *MockStore) ListRooms(arg0 context.Context, arg1 db.ListRoomsParams) ([]db.Room, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRooms", arg0, arg1)
+	ret0, _ := ret[0].([]db.Room)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRooms indicates an expected call of ListRooms.
+func (mr *MockStoreMockRecorder) ListRooms(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRooms", reflect.TypeOf((*MockStore)(nil).ListRooms), arg0, arg1)
+}
+
+// ListSkillSearchRows mocks base method.
+func (m *MockStore) ListSkillSearchRows(arg0 context.Context, arg1 []uuid.UUID) ([]db.ListSkillSearchRowsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSkillSearchRows", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListSkillSearchRowsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSkillSearchRows indicates an expected call of ListSkillSearchRows.
+func (mr *MockStoreMockRecorder) ListSkillSearchRows(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSkillSearchRows", reflect.TypeOf((*MockStore)(nil).ListSkillSearchRows), arg0, arg1)
+}
+
+// ListSkills mocks base method.
+func (m *MockStore) ListSkills(arg0 context.Context) ([]db.Skill, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSkills", arg0)
+	ret0, _ := ret[0].([]db.Skill)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSkills indicates an expected call of ListSkills.
+func (mr *MockStoreMockRecorder) ListSkills(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSkills", reflect.TypeOf((*MockStore)(nil).ListSkills), arg0)
+}
+
+// ListTags mocks base method.
+func (m *MockStore) ListTags(arg0 context.Context) ([]db.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTags", arg0)
+	ret0, _ := ret[0].([]db.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTags indicates an expected call of ListTags.
+func (mr *MockStoreMockRecorder) ListTags(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTags", reflect.TypeOf((*MockStore)(nil).ListTags), arg0)
+}
+
+// ListTagsByMemberIDs mocks base method.
+func (m *MockStore) ListTagsByMemberIDs(arg0 context.Context, arg1 []uuid.UUID) ([]db.ListTagsByMemberIDsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTagsByMemberIDs", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListTagsByMemberIDsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTagsByMemberIDs indicates an expected call of ListTagsByMemberIDs.
+func (mr *MockStoreMockRecorder) ListTagsByMemberIDs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTagsByMemberIDs", reflect.TypeOf((*MockStore)(nil).ListTagsByMemberIDs), arg0, arg1)
+}
+
+// ListTeamLeaveRequests mocks base method.
+func (m *MockStore) ListTeamLeaveRequests(arg0 context.Context, arg1 db.ListTeamLeaveRequestsParams) ([]db.ListTeamLeaveRequestsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTeamLeaveRequests", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListTeamLeaveRequestsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTeamLeaveRequests indicates an expected call of ListTeamLeaveRequests.
+func (mr *MockStoreMockRecorder) ListTeamLeaveRequests(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTeamLeaveRequests", reflect.TypeOf((*MockStore)(nil).ListTeamLeaveRequests), arg0, arg1)
+}
+
+// ListTeamMembers mocks base method.
+func (m *MockStore) ListTeamMembers(arg0 context.Context, arg1 db.ListTeamMembersParams) ([]db.ListTeamMembersRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTeamMembers", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListTeamMembersRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTeamMembers indicates an expected call of ListTeamMembers.
+func (mr *MockStoreMockRecorder) ListTeamMembers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTeamMembers", reflect.TypeOf((*MockStore)(nil).ListTeamMembers), arg0, arg1)
+}
+
+// ListTeams mocks base method.
+func (m *MockStore) ListTeams(arg0 context.Context) ([]db.Team, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTeams", arg0)
+	ret0, _ := ret[0].([]db.Team)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTeams indicates an expected call of ListTeams.
+func (mr *MockStoreMockRecorder) ListTeams(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTeams", reflect.TypeOf((*MockStore)(nil).ListTeams), arg0)
+}
+
+// ListTimeEntries mocks base method.
+func (m *MockStore) ListTimeEntries(arg0 context.Context, arg1 db.ListTimeEntriesParams) ([]db.TimeEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTimeEntries", arg0, arg1)
+	ret0, _ := ret[0].([]db.TimeEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTimeEntries indicates an expected call of ListTimeEntries.
+func (mr *MockStoreMockRecorder) ListTimeEntries(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTimeEntries", reflect.TypeOf((*MockStore)(nil).ListTimeEntries), arg0, arg1)
+}
+
+// ListTimeReportRows mocks base method.
+func (m *MockStore) ListTimeReportRows(arg0 context.Context, arg1 db.ListTimeReportRowsParams) ([]db.ListTimeReportRowsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTimeReportRows", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListTimeReportRowsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTimeReportRows indicates an expected call of ListTimeReportRows.
+func (mr *MockStoreMockRecorder) ListTimeReportRows(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTimeReportRows", reflect.TypeOf((*MockStore)(nil).ListTimeReportRows), arg0, arg1)
+}
+
+// LockReportingLines mocks base method.
+func (m *MockStore) LockReportingLines(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockReportingLines", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockReportingLines indicates an expected call of LockReportingLines.
+func (mr *MockStoreMockRecorder) LockReportingLines(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockReportingLines", reflect.TypeOf((*MockStore)(nil).LockReportingLines), arg0)
+}
+
+// LockUserRegistration mocks base method.
+func (m *MockStore) LockUserRegistration(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockUserRegistration", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockUserRegistration indicates an expected call of LockUserRegistration.
+func (mr *MockStoreMockRecorder) LockUserRegistration(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockUserRegistration", reflect.TypeOf((*MockStore)(nil).LockUserRegistration), arg0)
+}
+
+// MarkOverdueChecklistTasks mocks base method.
+func (m *MockStore) MarkOverdueChecklistTasks(arg0 context.Context, arg1 time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkOverdueChecklistTasks", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkOverdueChecklistTasks indicates an expected call of MarkOverdueChecklistTasks.
+func (mr *MockStoreMockRecorder) MarkOverdueChecklistTasks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkOverdueChecklistTasks", reflect.TypeOf((*MockStore)(nil).MarkOverdueChecklistTasks), arg0, arg1)
+}
+
+// MergeMember mocks base method.
+func (m *MockStore) MergeMember(arg0 context.Context, arg1 db.MergeMemberParams) (db.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeMember", arg0, arg1)
+	ret0, _ := ret[0].(db.Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergeMember indicates an expected call of MergeMember.
+func (mr *MockStoreMockRecorder) MergeMember(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeMember", reflect.TypeOf((*MockStore)(nil).MergeMember), arg0, arg1)
+}
+
+// MergeMembersTx mocks base method.
+func (m *MockStore) MergeMembersTx(arg0 context.Context, arg1 db.MergeMembersTxParams, arg2 db.AuditMeta) (db.MergeMembersTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeMembersTx", arg0, arg1, arg2)
+	ret0, _ := ret[0].(db.MergeMembersTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergeMembersTx indicates an expected call of MergeMembersTx.
+func (mr *MockStoreMockRecorder) MergeMembersTx(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeMembersTx", reflect.TypeOf((*MockStore)(nil).MergeMembersTx), arg0, arg1, arg2)
+}
+
+// MoveMemberAddresses mocks base method.
+func (m *MockStore) MoveMemberAddresses(arg0 context.Context, arg1 db.MoveMemberAddressesParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveMemberAddresses", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveMemberAddresses indicates an expected call of MoveMemberAddresses.
+func (mr *MockStoreMockRecorder) MoveMemberAddresses(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveMemberAddresses", reflect.TypeOf((*MockStore)(nil).MoveMemberAddresses), arg0, arg1)
+}
+
+// MoveMemberAttendances mocks base method.
+func (m *MockStore) MoveMemberAttendances(arg0 context.Context, arg1 db.MoveMemberAttendancesParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveMemberAttendances", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveMemberAttendances indicates an expected call of MoveMemberAttendances.
+func (mr *MockStoreMockRecorder) MoveMemberAttendances(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveMemberAttendances", reflect.TypeOf((*MockStore)(nil).MoveMemberAttendances), arg0, arg1)
+}
+
+// MoveMemberAuditEvents mocks base method.
+func (m *MockStore) MoveMemberAuditEvents(arg0 context.Context, arg1 db.MoveMemberAuditEventsParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveMemberAuditEvents", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveMemberAuditEvents indicates an expected call of MoveMemberAuditEvents.
+func (mr *MockStoreMockRecorder) MoveMemberAuditEvents(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveMemberAuditEvents", reflect.TypeOf((*MockStore)(nil).MoveMemberAuditEvents), arg0, arg1)
+}
+
+// MoveMemberCalendarToken mocks base method.
+func (m *MockStore) MoveMemberCalendarToken(arg0 context.Context, arg1 db.MoveMemberCalendarTokenParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveMemberCalendarToken", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveMemberCalendarToken indicates an expected call of MoveMemberCalendarToken.
+func (mr *MockStoreMockRecorder) MoveMemberCalendarToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveMemberCalendarToken", reflect.TypeOf((*MockStore)(nil).MoveMemberCalendarToken), arg0, arg1)
+}
+
+// MoveMemberDeskBookings mocks base method.
+func (m *MockStore) MoveMemberDeskBookings(arg0 context.Context, arg1 db.MoveMemberDeskBookingsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveMemberDeskBookings", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveMemberDeskBookings indicates an expected call of MoveMemberDeskBookings.
+func (mr *MockStoreMockRecorder) MoveMemberDeskBookings(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveMemberDeskBookings", reflect.TypeOf((*MockStore)(nil).MoveMemberDeskBookings), arg0, arg1)
+}
+
+// MoveMemberEmails mocks base method.
+func (m *MockStore) MoveMemberEmails(arg0 context.Context, arg1 db.MoveMemberEmailsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveMemberEmails", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveMemberEmails indicates an expected call of MoveMemberEmails.
+func (mr *MockStoreMockRecorder) MoveMemberEmails(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveMemberEmails", reflect.TypeOf((*MockStore)(nil).MoveMemberEmails), arg0, arg1)
+}
+
+// MoveMemberLeaveRequests mocks base method.
+func (m *MockStore) MoveMemberLeaveRequests(arg0 context.Context, arg1 db.MoveMemberLeaveRequestsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveMemberLeaveRequests", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveMemberLeaveRequests indicates an expected call of MoveMemberLeaveRequests.
+func (mr *MockStoreMockRecorder) MoveMemberLeaveRequests(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveMemberLeaveRequests", reflect.TypeOf((*MockStore)(nil).MoveMemberLeaveRequests), arg0, arg1)
+}
+
+// MoveMemberLinks mocks base method.
+func (m *MockStore) MoveMemberLinks(arg0 context.Context, arg1 db.MoveMemberLinksParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveMemberLinks", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveMemberLinks indicates an expected call of MoveMemberLinks.
+func (mr *MockStoreMockRecorder) MoveMemberLinks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveMemberLinks", reflect.TypeOf((*MockStore)(nil).MoveMemberLinks), arg0, arg1)
+}
+
+// MoveMemberPhones mocks base method.
+func (m *MockStore) MoveMemberPhones(arg0 context.Context, arg1 db.MoveMemberPhonesParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveMemberPhones", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveMemberPhones indicates an expected call of MoveMemberPhones.
+func (mr *MockStoreMockRecorder) MoveMemberPhones(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveMemberPhones", reflect.TypeOf((*MockStore)(nil).MoveMemberPhones), arg0, arg1)
+}
+
+// MoveMemberProjectAssignments mocks base method.
+func (m *MockStore) MoveMemberProjectAssignments(arg0 context.Context, arg1 db.MoveMemberProjectAssignmentsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveMemberProjectAssignments", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveMemberProjectAssignments indicates an expected call of MoveMemberProjectAssignments.
+func (mr *MockStoreMockRecorder) MoveMemberProjectAssignments(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveMemberProjectAssignments", reflect.TypeOf((*MockStore)(nil).MoveMemberProjectAssignments), arg0, arg1)
+}
+
+// MoveMemberRoomReservations mocks base method.
+func (m *MockStore) MoveMemberRoomReservations(arg0 context.Context, arg1 db.MoveMemberRoomReservationsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveMemberRoomReservations", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveMemberRoomReservations indicates an expected call of MoveMemberRoomReservations.
+func (mr *MockStoreMockRecorder) MoveMemberRoomReservations(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveMemberRoomReservations", reflect.TypeOf((*MockStore)(nil).MoveMemberRoomReservations), arg0, arg1)
+}
+
+// MoveMemberSkills mocks base method.
+func (m *MockStore) MoveMemberSkills(arg0 context.Context, arg1 db.MoveMemberSkillsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveMemberSkills", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveMemberSkills indicates an expected call of MoveMemberSkills.
+func (mr *MockStoreMockRecorder) MoveMemberSkills(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveMemberSkills", reflect.TypeOf((*MockStore)(nil).MoveMemberSkills), arg0, arg1)
+}
+
+// MoveMemberTags mocks base method.
+func (m *MockStore) MoveMemberTags(arg0 context.Context, arg1 db.MoveMemberTagsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveMemberTags", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveMemberTags indicates an expected call of MoveMemberTags.
+func (mr *MockStoreMockRecorder) MoveMemberTags(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveMemberTags", reflect.TypeOf((*MockStore)(nil).MoveMemberTags), arg0, arg1)
+}
+
+// MoveMemberTimeEntries mocks base method.
+func (m *MockStore) MoveMemberTimeEntries(arg0 context.Context, arg1 db.MoveMemberTimeEntriesParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveMemberTimeEntries", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveMemberTimeEntries indicates an expected call of MoveMemberTimeEntries.
+func (mr *MockStoreMockRecorder) MoveMemberTimeEntries(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveMemberTimeEntries", reflect.TypeOf((*MockStore)(nil).MoveMemberTimeEntries), arg0, arg1)
+}
+
+// MoveMemberTimesheets mocks base method.
+func (m *MockStore) MoveMemberTimesheets(arg0 context.Context, arg1 db.MoveMemberTimesheetsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveMemberTimesheets", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveMemberTimesheets indicates an expected call of MoveMemberTimesheets.
+func (mr *MockStoreMockRecorder) MoveMemberTimesheets(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveMemberTimesheets", reflect.TypeOf((*MockStore)(nil).MoveMemberTimesheets), arg0, arg1)
+}
+
+// MoveMemberWorkingHours mocks base method.
+func (m *MockStore) MoveMemberWorkingHours(arg0 context.Context, arg1 db.MoveMemberWorkingHoursParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveMemberWorkingHours", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveMemberWorkingHours indicates an expected call of MoveMemberWorkingHours.
+func (mr *MockStoreMockRecorder) MoveMemberWorkingHours(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveMemberWorkingHours", reflect.TypeOf((*MockStore)(nil).MoveMemberWorkingHours), arg0, arg1)
+}
+
+// MoveTeamMemberTx mocks base method.
+func (m *MockStore) MoveTeamMemberTx(arg0 context.Context, arg1 db.MoveTeamMemberTxParams) (db.TeamMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveTeamMemberTx", arg0, arg1)
+	ret0, _ := ret[0].(db.TeamMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveTeamMemberTx indicates an expected call of MoveTeamMemberTx.
+func (mr *MockStoreMockRecorder) MoveTeamMemberTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveTeamMemberTx", reflect.TypeOf((*MockStore)(nil).MoveTeamMemberTx), arg0, arg1)
+}
+
+// MoveTeamMembers mocks base method.
+func (m *MockStore) MoveTeamMembers(arg0 context.Context, arg1 db.MoveTeamMembersParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveTeamMembers", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveTeamMembers indicates an expected call of MoveTeamMembers.
+func (mr *MockStoreMockRecorder) MoveTeamMembers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveTeamMembers", reflect.TypeOf((*MockStore)(nil).MoveTeamMembers), arg0, arg1)
+}
+
+// PatchMember mocks base method.
+func (m *MockStore) PatchMember(arg0 context.Context, arg1 db.PatchMemberParams) (db.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PatchMember", arg0, arg1)
+	ret0, _ := ret[0].(db.Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PatchMember indicates an expected call of PatchMember.
+func (mr *MockStoreMockRecorder) PatchMember(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchMember", reflect.TypeOf((*MockStore)(nil).PatchMember), arg0, arg1)
+}
+
+// PatchMemberTx mocks base method.
+func (m *MockStore) PatchMemberTx(arg0 context.Context, arg1 db.PatchMemberTxParams, arg2 db.AuditMeta) (db.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PatchMemberTx", arg0, arg1, arg2)
+	ret0, _ := ret[0].(db.Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PatchMemberTx indicates an expected call of PatchMemberTx.
+func (mr *MockStoreMockRecorder) PatchMemberTx(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchMemberTx", reflect.TypeOf((*MockStore)(nil).PatchMemberTx), arg0, arg1, arg2)
+}
+
+// PromoteMemberEmail mocks base method.
+func (m *MockStore) PromoteMemberEmail(arg0 context.Context, arg1 db.PromoteMemberEmailParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PromoteMemberEmail", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PromoteMemberEmail indicates an expected call of PromoteMemberEmail.
+func (mr *MockStoreMockRecorder) PromoteMemberEmail(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PromoteMemberEmail", reflect.TypeOf((*MockStore)(nil).PromoteMemberEmail), arg0, arg1)
+}
+
+// PurgeDeletedMembers mocks base method.
+func (m *MockStore) PurgeDeletedMembers(arg0 context.Context, arg1 time.Time) ([]sql.NullString, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeletedMembers", arg0, arg1)
+	ret0, _ := ret[0].([]sql.NullString)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeletedMembers indicates an expected call of PurgeDeletedMembers.
+func (mr *MockStoreMockRecorder) PurgeDeletedMembers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeletedMembers", reflect.TypeOf((*MockStore)(nil).PurgeDeletedMembers), arg0, arg1)
+}
+
+// ReassignMemberChecklistTask mocks base method.
+func (m *MockStore) ReassignMemberChecklistTask(arg0 context.Context, arg1 db.ReassignMemberChecklistTaskParams) (db.MemberChecklistTask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReassignMemberChecklistTask", arg0, arg1)
+	ret0, _ := ret[0].(db.MemberChecklistTask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReassignMemberChecklistTask indicates an expected call of ReassignMemberChecklistTask.
+func (mr *MockStoreMockRecorder) ReassignMemberChecklistTask(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReassignMemberChecklistTask", reflect.TypeOf((*MockStore)(nil).ReassignMemberChecklistTask), arg0, arg1)
+}
+
+// RemoveMemberTags mocks base method.
+func (m *MockStore) RemoveMemberTags(arg0 context.Context, arg1 db.RemoveMemberTagsParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveMemberTags", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveMemberTags indicates an expected call of RemoveMemberTags.
+func (mr *MockStoreMockRecorder) RemoveMemberTags(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMemberTags", reflect.TypeOf((*MockStore)(nil).RemoveMemberTags), arg0, arg1)
+}
+
+// RemoveMembersCustomField mocks base method.
+func (m *MockStore) RemoveMembersCustomField(arg0 context.Context, arg1 string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveMembersCustomField", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveMembersCustomField indicates an expected call of RemoveMembersCustomField.
+func (mr *MockStoreMockRecorder) RemoveMembersCustomField(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMembersCustomField", reflect.TypeOf((*MockStore)(nil).RemoveMembersCustomField), arg0, arg1)
+}
+
+// RemoveTeamMember mocks base method.
+func (m *MockStore) RemoveTeamMember(arg0 context.Context, arg1 db.RemoveTeamMemberParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveTeamMember", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveTeamMember indicates an expected call of RemoveTeamMember.
+func (mr *MockStoreMockRecorder) RemoveTeamMember(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTeamMember", reflect.TypeOf((*MockStore)(nil).RemoveTeamMember), arg0, arg1)
+}
+
+// RenameSkill mocks base method.
+func (m *MockStore) RenameSkill(arg0 context.Context, arg1 db.RenameSkillParams) (db.Skill, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameSkill", arg0, arg1)
+	ret0, _ := ret[0].(db.Skill)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenameSkill indicates an expected call of RenameSkill.
+func (mr *MockStoreMockRecorder) RenameSkill(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameSkill", reflect.TypeOf((*MockStore)(nil).RenameSkill), arg0, arg1)
+}
+
+// RenameTag mocks base method.
+func (m *MockStore) RenameTag(arg0 context.Context, arg1 db.RenameTagParams) (db.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameTag", arg0, arg1)
+	ret0, _ := ret[0].(db.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenameTag indicates an expected call of RenameTag.
+func (mr *MockStoreMockRecorder) RenameTag(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameTag", reflect.TypeOf((*MockStore)(nil).RenameTag), arg0, arg1)
+}
+
+// ReopenTimesheetTx mocks base method.
+func (m *MockStore) ReopenTimesheetTx(arg0 context.Context, arg1 db.ReopenTimesheetTxParams) (db.Timesheet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReopenTimesheetTx", arg0, arg1)
+	ret0, _ := ret[0].(db.Timesheet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReopenTimesheetTx indicates an expected call of ReopenTimesheetTx.
+func (mr *MockStoreMockRecorder) ReopenTimesheetTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReopenTimesheetTx", reflect.TypeOf((*MockStore)(nil).ReopenTimesheetTx), arg0, arg1)
+}
+
+// ReparentChildTeams mocks base method.
+func (m *MockStore) ReparentChildTeams(arg0 context.Context, arg1 db.ReparentChildTeamsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReparentChildTeams", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReparentChildTeams indicates an expected call of ReparentChildTeams.
+func (mr *MockStoreMockRecorder) ReparentChildTeams(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReparentChildTeams", reflect.TypeOf((*MockStore)(nil).ReparentChildTeams), arg0, arg1)
+}
+
+// ReplaceChecklistTemplateTx mocks base method.
+func (m *MockStore) ReplaceChecklistTemplateTx(arg0 context.Context, arg1 string, arg2 []db.CreateChecklistTemplateTaskParams) ([]db.ChecklistTemplateTask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceChecklistTemplateTx", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]db.ChecklistTemplateTask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplaceChecklistTemplateTx indicates an expected call of ReplaceChecklistTemplateTx.
+func (mr *MockStoreMockRecorder) ReplaceChecklistTemplateTx(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceChecklistTemplateTx", reflect.TypeOf((*MockStore)(nil).ReplaceChecklistTemplateTx), arg0, arg1, arg2)
+}
+
+// RestoreMember mocks base method.
+func (m *MockStore) RestoreMember(arg0 context.Context, arg1 uuid.UUID) (db.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreMember", arg0, arg1)
+	ret0, _ := ret[0].(db.Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreMember indicates an expected call of RestoreMember.
+func (mr *MockStoreMockRecorder) RestoreMember(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreMember", reflect.TypeOf((*MockStore)(nil).RestoreMember), arg0, arg1)
+}
+
+// RestoreMemberTx mocks base method.
+func (m *MockStore) RestoreMemberTx(arg0 context.Context, arg1 uuid.UUID, arg2 db.AuditMeta) (db.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreMemberTx", arg0, arg1, arg2)
+	ret0, _ := ret[0].(db.Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreMemberTx indicates an expected call of RestoreMemberTx.
+func (mr *MockStoreMockRecorder) RestoreMemberTx(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreMemberTx", reflect.TypeOf((*MockStore)(nil).RestoreMemberTx), arg0, arg1, arg2)
+}
+
+// RestoreMembers mocks base method.
+func (m *MockStore) RestoreMembers(arg0 context.Context, arg1 []uuid.UUID) ([]db.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreMembers", arg0, arg1)
+	ret0, _ := ret[0].([]db.Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreMembers indicates an expected call of RestoreMembers.
+func (mr *MockStoreMockRecorder) RestoreMembers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreMembers", reflect.TypeOf((*MockStore)(nil).RestoreMembers), arg0, arg1)
+}
+
+// RestoreMembersTx mocks base method.
+func (m *MockStore) RestoreMembersTx(arg0 context.Context, arg1 []uuid.UUID, arg2 db.AuditMeta) ([]db.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreMembersTx", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]db.Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreMembersTx indicates an expected call of RestoreMembersTx.
+func (mr *MockStoreMockRecorder) RestoreMembersTx(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreMembersTx", reflect.TypeOf((*MockStore)(nil).RestoreMembersTx), arg0, arg1, arg2)
+}
+
+// RestorePrimaryMemberEmails mocks base method.
+func (m *MockStore) RestorePrimaryMemberEmails(arg0 context.Context, arg1 []uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestorePrimaryMemberEmails", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestorePrimaryMemberEmails indicates an expected call of RestorePrimaryMemberEmails.
+func (mr *MockStoreMockRecorder) RestorePrimaryMemberEmails(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestorePrimaryMemberEmails", reflect.TypeOf((*MockStore)(nil).RestorePrimaryMemberEmails), arg0, arg1)
+}
+
+// RotateMemberCalendarToken mocks base method.
+func (m *MockStore) RotateMemberCalendarToken(arg0 context.Context, arg1 uuid.NullUUID) (db.CalendarToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateMemberCalendarToken", arg0, arg1)
+	ret0, _ := ret[0].(db.CalendarToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateMemberCalendarToken indicates an expected call of RotateMemberCalendarToken.
+func (mr *MockStoreMockRecorder) RotateMemberCalendarToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateMemberCalendarToken", reflect.TypeOf((*MockStore)(nil).RotateMemberCalendarToken), arg0, arg1)
+}
+
+// RotateRoomCalendarToken mocks base method.
+func (m *MockStore) RotateRoomCalendarToken(arg0 context.Context, arg1 uuid.NullUUID) (db.CalendarToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateRoomCalendarToken", arg0, arg1)
+	ret0, _ := ret[0].(db.CalendarToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateRoomCalendarToken indicates an expected call of RotateRoomCalendarToken.
+func (mr *MockStoreMockRecorder) RotateRoomCalendarToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateRoomCalendarToken", reflect.TypeOf((*MockStore)(nil).RotateRoomCalendarToken), arg0, arg1)
+}
+
+// SearchMembers mocks base method.
+func (m *MockStore) SearchMembers(arg0 context.Context, arg1 db.SearchMembersParams) ([]db.SearchMembersRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchMembers", arg0, arg1)
+	ret0, _ := ret[0].([]db.SearchMembersRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchMembers indicates an expected call of SearchMembers.
+func (mr *MockStoreMockRecorder) SearchMembers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchMembers", reflect.TypeOf((*MockStore)(nil).SearchMembers), arg0, arg1)
+}
+
+// SearchMembersBySkills mocks base method.
+func (m *MockStore) SearchMembersBySkills(arg0 context.Context, arg1 db.SearchMembersBySkillsParams) ([]db.SkillMatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchMembersBySkills", arg0, arg1)
+	ret0, _ := ret[0].([]db.SkillMatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchMembersBySkills indicates an expected call of SearchMembersBySkills.
+func (mr *MockStoreMockRecorder) SearchMembersBySkills(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchMembersBySkills", reflect.TypeOf((*MockStore)(nil).SearchMembersBySkills), arg0, arg1)
+}
+
+// SetMemberAvatar mocks base method.
+func (m *MockStore) SetMemberAvatar(arg0 context.Context, arg1 db.SetMemberAvatarParams) (db.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMemberAvatar", arg0, arg1)
+	ret0, _ := ret[0].(db.Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetMemberAvatar indicates an expected call of SetMemberAvatar.
+func (mr *MockStoreMockRecorder) SetMemberAvatar(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMemberAvatar", reflect.TypeOf((*MockStore)(nil).SetMemberAvatar), arg0, arg1)
+}
+
+// SetMemberAvatarTx mocks base method.
+func (m *MockStore) SetMemberAvatarTx(arg0 context.Context, arg1 db.SetMemberAvatarParams, arg2 db.AuditMeta) (db.SetMemberAvatarTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMemberAvatarTx", arg0, arg1, arg2)
+	ret0, _ := ret[0].(db.SetMemberAvatarTxResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CountMembers indicates an expected call of CountMembers.
-func (mr *MockStoreMockRecorder) CountMembers(arg0 interface{}) *gomock.Call {
+// SetMemberAvatarTx indicates an expected call of SetMemberAvatarTx.
+func (mr *MockStoreMockRecorder) SetMemberAvatarTx(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountMembers", reflect.TypeOf((*MockStore)(nil).CountMembers), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMemberAvatarTx", reflect.TypeOf((*MockStore)(nil).SetMemberAvatarTx), arg0, arg1, arg2)
 }
 
-// CreateMember mocks base method.
-func (m *MockStore) CreateMember(arg0 context.Context, arg1 db.CreateMemberParams) (db.Member, error) {
+// SetMemberManager mocks base method.
+func (m *MockStore) SetMemberManager(arg0 context.Context, arg1 db.SetMemberManagerParams) (db.Member, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateMember", arg0, arg1)
+	ret := m.ctrl.Call(m, "SetMemberManager", arg0, arg1)
 	ret0, _ := ret[0].(db.Member)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateMember indicates an expected call of CreateMember.
-func (mr *MockStoreMockRecorder) CreateMember(arg0, arg1 interface{}) *gomock.Call {
+// SetMemberManager indicates an expected call of SetMemberManager.
+func (mr *MockStoreMockRecorder) SetMemberManager(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMember", reflect.TypeOf((*MockStore)(nil).CreateMember), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMemberManager", reflect.TypeOf((*MockStore)(nil).SetMemberManager), arg0, arg1)
 }
 
-// CreateSession mocks base method.
-func (m *MockStore) CreateSession(arg0 context.Context, arg1 db.CreateSessionParams) (db.Session, error) {
+// SetMemberManagerTx mocks base method.
+func (m *MockStore) SetMemberManagerTx(arg0 context.Context, arg1 db.SetMemberManagerParams, arg2 db.AuditMeta) (db.Member, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateSession", arg0, arg1)
-	ret0, _ := ret[0].(db.Session)
+	ret := m.ctrl.Call(m, "SetMemberManagerTx", arg0, arg1, arg2)
+	ret0, _ := ret[0].(db.Member)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateSession indicates an expected call of CreateSession.
-func (mr *MockStoreMockRecorder) CreateSession(arg0, arg1 interface{}) *gomock.Call {
+// SetMemberManagerTx indicates an expected call of SetMemberManagerTx.
+func (mr *MockStoreMockRecorder) SetMemberManagerTx(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockStore)(nil).CreateSession), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMemberManagerTx", reflect.TypeOf((*MockStore)(nil).SetMemberManagerTx), arg0, arg1, arg2)
 }
 
-// CreateUser mocks base method.
-func (m *MockStore) CreateUser(arg0 context.Context, arg1 db.CreateUserParams) (db.User, error) {
+// SetMemberTimeZone mocks base method.
+func (m *MockStore) SetMemberTimeZone(arg0 context.Context, arg1 db.SetMemberTimeZoneParams) (db.Member, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateUser", arg0, arg1)
-	ret0, _ := ret[0].(db.User)
+	ret := m.ctrl.Call(m, "SetMemberTimeZone", arg0, arg1)
+	ret0, _ := ret[0].(db.Member)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateUser indicates an expected call of CreateUser.
-func (mr *MockStoreMockRecorder) CreateUser(arg0, arg1 interface{}) *gomock.Call {
+// SetMemberTimeZone indicates an expected call of SetMemberTimeZone.
+func (mr *MockStoreMockRecorder) SetMemberTimeZone(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockStore)(nil).CreateUser), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMemberTimeZone", reflect.TypeOf((*MockStore)(nil).SetMemberTimeZone), arg0, arg1)
 }
 
-// DeleteMember mocks base method.
-func (m *MockStore) DeleteMember(arg0 context.Context, arg1 uuid.UUID) error {
+// SetMemberWorkingHoursTx mocks base method.
+func (m *MockStore) SetMemberWorkingHoursTx(arg0 context.Context, arg1 db.SetMemberWorkingHoursTxParams, arg2 db.AuditMeta) (db.SetMemberWorkingHoursTxResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteMember", arg0, arg1)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "SetMemberWorkingHoursTx", arg0, arg1, arg2)
+	ret0, _ := ret[0].(db.SetMemberWorkingHoursTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteMember indicates an expected call of DeleteMember.
-func (mr *MockStoreMockRecorder) DeleteMember(arg0, arg1 interface{}) *gomock.Call {
+// SetMemberWorkingHoursTx indicates an expected call of SetMemberWorkingHoursTx.
+func (mr *MockStoreMockRecorder) SetMemberWorkingHoursTx(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMember", reflect.TypeOf((*MockStore)(nil).DeleteMember), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMemberWorkingHoursTx", reflect.TypeOf((*MockStore)(nil).SetMemberWorkingHoursTx), arg0, arg1, arg2)
 }
 
-// DeleteMembers mocks base method.
-func (m *MockStore) DeleteMembers(arg0 context.Context, arg1 []uuid.UUID) error {
+// SetPrimaryMemberEmail mocks base method.
+func (m *MockStore) SetPrimaryMemberEmail(arg0 context.Context, arg1 db.SetPrimaryMemberEmailParams) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteMembers", arg0, arg1)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "SetPrimaryMemberEmail", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteMembers indicates an expected call of DeleteMembers.
-func (mr *MockStoreMockRecorder) DeleteMembers(arg0, arg1 interface{}) *gomock.Call {
+// SetPrimaryMemberEmail indicates an expected call of SetPrimaryMemberEmail.
+func (mr *MockStoreMockRecorder) SetPrimaryMemberEmail(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMembers", reflect.TypeOf((*MockStore)(nil).DeleteMembers), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPrimaryMemberEmail", reflect.TypeOf((*MockStore)(nil).SetPrimaryMemberEmail), arg0, arg1)
 }
 
-// DeleteSession mocks base method.
-func (m *MockStore) DeleteSession(arg0 context.Context, arg1 uuid.UUID) error {
+// StopTimerTx mocks base method.
+func (m *MockStore) StopTimerTx(arg0 context.Context, arg1 uuid.UUID, arg2 time.Time) (db.TimeEntry, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteSession", arg0, arg1)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "StopTimerTx", arg0, arg1, arg2)
+	ret0, _ := ret[0].(db.TimeEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteSession indicates an expected call of DeleteSession.
-func (mr *MockStoreMockRecorder) DeleteSession(arg0, arg1 interface{}) *gomock.Call {
+// StopTimerTx indicates an expected call of StopTimerTx.
+func (mr *MockStoreMockRecorder) StopTimerTx(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSession", reflect.TypeOf((*MockStore)(nil).DeleteSession), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopTimerTx", reflect.TypeOf((*MockStore)(nil).StopTimerTx), arg0, arg1, arg2)
 }
 
-// GetMember mocks base method.
-func (m *MockStore) GetMember(arg0 context.Context, arg1 uuid.UUID) (db.Member, error) {
+// SubmitTimesheetTx mocks base method.
+func (m *MockStore) SubmitTimesheetTx(arg0 context.Context, arg1 db.SubmitTimesheetTxParams) (db.Timesheet, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMember", arg0, arg1)
-	ret0, _ := ret[0].(db.Member)
+	ret := m.ctrl.Call(m, "SubmitTimesheetTx", arg0, arg1)
+	ret0, _ := ret[0].(db.Timesheet)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetMember indicates an expected call of GetMember.
-func (mr *MockStoreMockRecorder) GetMember(arg0, arg1 interface{}) *gomock.Call {
+// SubmitTimesheetTx indicates an expected call of SubmitTimesheetTx.
+func (mr *MockStoreMockRecorder) SubmitTimesheetTx(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMember", reflect.TypeOf((*MockStore)(nil).GetMember), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitTimesheetTx", reflect.TypeOf((*MockStore)(nil).SubmitTimesheetTx), arg0, arg1)
 }
 
-// GetSession mocks base method.
-func (m *MockStore) GetSession(arg0 context.Context, arg1 uuid.UUID) (db.Session, error) {
+// SyncMemberEmail mocks base method.
+func (m *MockStore) SyncMemberEmail(arg0 context.Context, arg1 uuid.UUID) (db.Member, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSession", arg0, arg1)
-	ret0, _ := ret[0].(db.Session)
+	ret := m.ctrl.Call(m, "SyncMemberEmail", arg0, arg1)
+	ret0, _ := ret[0].(db.Member)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetSession indicates an expected call of GetSession.
-func (mr *MockStoreMockRecorder) GetSession(arg0, arg1 interface{}) *gomock.Call {
+// SyncMemberEmail indicates an expected call of SyncMemberEmail.
+func (mr *MockStoreMockRecorder) SyncMemberEmail(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSession", reflect.TypeOf((*MockStore)(nil).GetSession), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncMemberEmail", reflect.TypeOf((*MockStore)(nil).SyncMemberEmail), arg0, arg1)
 }
 
-// GetUser mocks base method.
-func (m *MockStore) GetUser(arg0 context.Context, arg1 uuid.UUID) (db.User, error) {
+// TransitionMember mocks base method.
+func (m *MockStore) TransitionMember(arg0 context.Context, arg1 db.TransitionMemberParams) (db.Member, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUser", arg0, arg1)
-	ret0, _ := ret[0].(db.User)
+	ret := m.ctrl.Call(m, "TransitionMember", arg0, arg1)
+	ret0, _ := ret[0].(db.Member)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetUser indicates an expected call of GetUser.
-func (mr *MockStoreMockRecorder) GetUser(arg0, arg1 interface{}) *gomock.Call {
+// TransitionMember indicates an expected call of TransitionMember.
+func (mr *MockStoreMockRecorder) TransitionMember(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockStore)(nil).GetUser), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransitionMember", reflect.TypeOf((*MockStore)(nil).TransitionMember), arg0, arg1)
 }
 
-// GetUserByEmail mocks base method.
-func (m *MockStore) GetUserByEmail(arg0 context.Context, arg1 string) (db.User, error) {
+// TransitionMemberTx mocks base method.
+func (m *MockStore) TransitionMemberTx(arg0 context.Context, arg1 db.TransitionMemberTxParams, arg2 db.AuditMeta) (db.Member, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserByEmail", arg0, arg1)
-	ret0, _ := ret[0].(db.User)
+	ret := m.ctrl.Call(m, "TransitionMemberTx", arg0, arg1, arg2)
+	ret0, _ := ret[0].(db.Member)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetUserByEmail indicates an expected call of GetUserByEmail.
-func (mr *MockStoreMockRecorder) GetUserByEmail(arg0, arg1 interface{}) *gomock.Call {
+// TransitionMemberTx indicates an expected call of TransitionMemberTx.
+func (mr *MockStoreMockRecorder) TransitionMemberTx(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByEmail", reflect.TypeOf((*MockStore)(nil).GetUserByEmail), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransitionMemberTx", reflect.TypeOf((*MockStore)(nil).TransitionMemberTx), arg0, arg1, arg2)
 }
 
-// ListMembers mocks base method.
-func (m *MockStore) ListMembers(arg0 context.Context, arg1 db.ListMembersParams) ([]db.Member, error) {
+// TrashMergedMembers mocks base method.
+func (m *MockStore) TrashMergedMembers(arg0 context.Context, arg1 []uuid.UUID) ([]db.Member, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListMembers", arg0, arg1)
+	ret := m.ctrl.Call(m, "TrashMergedMembers", arg0, arg1)
 	ret0, _ := ret[0].([]db.Member)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListMembers indicates an expected call of ListMembers.
-func (mr *MockStoreMockRecorder) ListMembers(arg0, arg1 interface{}) *gomock.Call {
+// TrashMergedMembers indicates an expected call of TrashMergedMembers.
+func (mr *MockStoreMockRecorder) TrashMergedMembers(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMembers", reflect.TypeOf((*MockStore)(nil).ListMembers), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrashMergedMembers", reflect.TypeOf((*MockStore)(nil).TrashMergedMembers), arg0, arg1)
+}
+
+// TruncateAuditEventsTable mocks base method.
+func (m *MockStore) TruncateAuditEventsTable(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TruncateAuditEventsTable", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TruncateAuditEventsTable indicates an expected call of TruncateAuditEventsTable.
+func (mr *MockStoreMockRecorder) TruncateAuditEventsTable(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TruncateAuditEventsTable", reflect.TypeOf((*MockStore)(nil).TruncateAuditEventsTable), arg0)
+}
+
+// TruncateCustomFieldDefinitionsTable mocks base method.
+func (m *MockStore) TruncateCustomFieldDefinitionsTable(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TruncateCustomFieldDefinitionsTable", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TruncateCustomFieldDefinitionsTable indicates an expected call of TruncateCustomFieldDefinitionsTable.
+func (mr *MockStoreMockRecorder) TruncateCustomFieldDefinitionsTable(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TruncateCustomFieldDefinitionsTable", reflect.TypeOf((*MockStore)(nil).TruncateCustomFieldDefinitionsTable), arg0)
+}
+
+// TruncateMemberImportJobsTable mocks base method.
+func (m *MockStore) TruncateMemberImportJobsTable(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TruncateMemberImportJobsTable", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TruncateMemberImportJobsTable indicates an expected call of TruncateMemberImportJobsTable.
+func (mr *MockStoreMockRecorder) TruncateMemberImportJobsTable(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TruncateMemberImportJobsTable", reflect.TypeOf((*MockStore)(nil).TruncateMemberImportJobsTable), arg0)
 }
 
 // TruncateMembersTable mocks base method.
@@ -241,6 +3659,34 @@ func (mr *MockStoreMockRecorder) TruncateSessionsTable(arg0 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TruncateSessionsTable", reflect.TypeOf((*MockStore)(nil).TruncateSessionsTable), arg0)
 }
 
+// TruncateTagsTable mocks base method.
+func (m *MockStore) TruncateTagsTable(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TruncateTagsTable", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TruncateTagsTable indicates an expected call of TruncateTagsTable.
+func (mr *MockStoreMockRecorder) TruncateTagsTable(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TruncateTagsTable", reflect.TypeOf((*MockStore)(nil).TruncateTagsTable), arg0)
+}
+
+// TruncateTeamsTable mocks base method.
+func (m *MockStore) TruncateTeamsTable(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TruncateTeamsTable", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TruncateTeamsTable indicates an expected call of TruncateTeamsTable.
+func (mr *MockStoreMockRecorder) TruncateTeamsTable(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TruncateTeamsTable", reflect.TypeOf((*MockStore)(nil).TruncateTeamsTable), arg0)
+}
+
 // TruncateUsersTable mocks base method.
 func (m *MockStore) TruncateUsersTable(arg0 context.Context) error {
 	m.ctrl.T.Helper()
@@ -255,6 +3701,79 @@ func (mr *MockStoreMockRecorder) TruncateUsersTable(arg0 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TruncateUsersTable", reflect.TypeOf((*MockStore)(nil).TruncateUsersTable), arg0)
 }
 
+// UnsetPrimaryMemberEmail mocks base method.
+func (m *MockStore) UnsetPrimaryMemberEmail(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnsetPrimaryMemberEmail", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnsetPrimaryMemberEmail indicates an expected call of UnsetPrimaryMemberEmail.
+func (mr *MockStoreMockRecorder) UnsetPrimaryMemberEmail(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsetPrimaryMemberEmail", reflect.TypeOf((*MockStore)(nil).UnsetPrimaryMemberEmail), arg0, arg1)
+}
+
+// UnsetPrimaryMemberEmails mocks base method.
+func (m *MockStore) UnsetPrimaryMemberEmails(arg0 context.Context, arg1 []uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnsetPrimaryMemberEmails", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnsetPrimaryMemberEmails indicates an expected call of UnsetPrimaryMemberEmails.
+func (mr *MockStoreMockRecorder) UnsetPrimaryMemberEmails(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsetPrimaryMemberEmails", reflect.TypeOf((*MockStore)(nil).UnsetPrimaryMemberEmails), arg0, arg1)
+}
+
+// UpdateCustomFieldDefinition mocks base method.
+func (m *MockStore) UpdateCustomFieldDefinition(arg0 context.Context, arg1 db.UpdateCustomFieldDefinitionParams) (db.CustomFieldDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCustomFieldDefinition", arg0, arg1)
+	ret0, _ := ret[0].(db.CustomFieldDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCustomFieldDefinition indicates an expected call of UpdateCustomFieldDefinition.
+func (mr *MockStoreMockRecorder) UpdateCustomFieldDefinition(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCustomFieldDefinition", reflect.TypeOf((*MockStore)(nil).UpdateCustomFieldDefinition), arg0, arg1)
+}
+
+// UpdateLeaveType mocks base method.
+func (m *MockStore) UpdateLeaveType(arg0 context.Context, arg1 db.UpdateLeaveTypeParams) (db.LeaveType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLeaveType", arg0, arg1)
+	ret0, _ := ret[0].(db.LeaveType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateLeaveType indicates an expected call of UpdateLeaveType.
+func (mr *MockStoreMockRecorder) UpdateLeaveType(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLeaveType", reflect.TypeOf((*MockStore)(nil).UpdateLeaveType), arg0, arg1)
+}
+
+// UpdateLocation mocks base method.
+func (m *MockStore) UpdateLocation(arg0 context.Context, arg1 db.UpdateLocationParams) (db.Location, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLocation", arg0, arg1)
+	ret0, _ := ret[0].(db.Location)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateLocation indicates an expected call of UpdateLocation.
+func (mr *MockStoreMockRecorder) UpdateLocation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLocation", reflect.TypeOf((*MockStore)(nil).UpdateLocation), arg0, arg1)
+}
+
 // UpdateMember mocks base method.
 func (m *MockStore) UpdateMember(arg0 context.Context, arg1 db.UpdateMemberParams) (db.Member, error) {
 	m.ctrl.T.Helper()
@@ -269,3 +3788,153 @@ func (mr *MockStoreMockRecorder) UpdateMember(arg0, arg1 interface{}) *gomock.Ca
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMember", reflect.TypeOf((*MockStore)(nil).UpdateMember), arg0, arg1)
 }
+
+// UpdateMemberTx mocks base method.
+func (m *MockStore) UpdateMemberTx(arg0 context.Context, arg1 db.UpdateMemberTxParams, arg2 db.AuditMeta) (db.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMemberTx", arg0, arg1, arg2)
+	ret0, _ := ret[0].(db.Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateMemberTx indicates an expected call of UpdateMemberTx.
+func (mr *MockStoreMockRecorder) UpdateMemberTx(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMemberTx", reflect.TypeOf((*MockStore)(nil).UpdateMemberTx), arg0, arg1, arg2)
+}
+
+// UpdateProject mocks base method.
+func (m *MockStore) UpdateProject(arg0 context.Context, arg1 db.UpdateProjectParams) (db.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProject", arg0, arg1)
+	ret0, _ := ret[0].(db.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProject indicates an expected call of UpdateProject.
+func (mr *MockStoreMockRecorder) UpdateProject(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProject", reflect.TypeOf((*MockStore)(nil).UpdateProject), arg0, arg1)
+}
+
+// UpdateProjectAssignment mocks base method.
+func (m *MockStore) UpdateProjectAssignment(arg0 context.Context, arg1 db.UpdateProjectAssignmentParams) (db.ProjectAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProjectAssignment", arg0, arg1)
+	ret0, _ := ret[0].(db.ProjectAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProjectAssignment indicates an expected call of UpdateProjectAssignment.
+func (mr *MockStoreMockRecorder) UpdateProjectAssignment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProjectAssignment", reflect.TypeOf((*MockStore)(nil).UpdateProjectAssignment), arg0, arg1)
+}
+
+// UpdateProjectAssignmentTx mocks base method.
+func (m *MockStore) UpdateProjectAssignmentTx(arg0 context.Context, arg1 db.UpdateProjectAssignmentParams, arg2 int32) (db.ProjectAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProjectAssignmentTx", arg0, arg1, arg2)
+	ret0, _ := ret[0].(db.ProjectAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProjectAssignmentTx indicates an expected call of UpdateProjectAssignmentTx.
+func (mr *MockStoreMockRecorder) UpdateProjectAssignmentTx(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProjectAssignmentTx", reflect.TypeOf((*MockStore)(nil).UpdateProjectAssignmentTx), arg0, arg1, arg2)
+}
+
+// UpdateRoom mocks base method.
+func (m *MockStore) UpdateRoom(arg0 context.Context, arg1 db.UpdateRoomParams) (db.Room, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRoom", arg0, arg1)
+	ret0, _ := ret[0].(db.Room)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRoom indicates an expected call of UpdateRoom.
+func (mr *MockStoreMockRecorder) UpdateRoom(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRoom", reflect.TypeOf((*MockStore)(nil).UpdateRoom), arg0, arg1)
+}
+
+// UpdateTeam mocks base method.
+func (m *MockStore) UpdateTeam(arg0 context.Context, arg1 db.UpdateTeamParams) (db.Team, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTeam", arg0, arg1)
+	ret0, _ := ret[0].(db.Team)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTeam indicates an expected call of UpdateTeam.
+func (mr *MockStoreMockRecorder) UpdateTeam(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTeam", reflect.TypeOf((*MockStore)(nil).UpdateTeam), arg0, arg1)
+}
+
+// UpdateTeamTx mocks base method.
+func (m *MockStore) UpdateTeamTx(arg0 context.Context, arg1 db.UpdateTeamParams) (db.Team, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTeamTx", arg0, arg1)
+	ret0, _ := ret[0].(db.Team)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTeamTx indicates an expected call of UpdateTeamTx.
+func (mr *MockStoreMockRecorder) UpdateTeamTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTeamTx", reflect.TypeOf((*MockStore)(nil).UpdateTeamTx), arg0, arg1)
+}
+
+// UpdateTimeEntry mocks base method.
+func (m *MockStore) UpdateTimeEntry(arg0 context.Context, arg1 db.UpdateTimeEntryParams) (db.TimeEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTimeEntry", arg0, arg1)
+	ret0, _ := ret[0].(db.TimeEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTimeEntry indicates an expected call of UpdateTimeEntry.
+func (mr *MockStoreMockRecorder) UpdateTimeEntry(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTimeEntry", reflect.TypeOf((*MockStore)(nil).UpdateTimeEntry), arg0, arg1)
+}
+
+// UpdateTimeEntryTx mocks base method.
+func (m *MockStore) UpdateTimeEntryTx(arg0 context.Context, arg1 db.UpdateTimeEntryParams) (db.TimeEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTimeEntryTx", arg0, arg1)
+	ret0, _ := ret[0].(db.TimeEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTimeEntryTx indicates an expected call of UpdateTimeEntryTx.
+func (mr *MockStoreMockRecorder) UpdateTimeEntryTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTimeEntryTx", reflect.TypeOf((*MockStore)(nil).UpdateTimeEntryTx), arg0, arg1)
+}
+
+// UpsertMemberSkill mocks base method.
+func (m *MockStore) UpsertMemberSkill(arg0 context.Context, arg1 db.UpsertMemberSkillParams) (db.MemberSkill, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertMemberSkill", arg0, arg1)
+	ret0, _ := ret[0].(db.MemberSkill)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertMemberSkill indicates an expected call of UpsertMemberSkill.
+func (mr *MockStoreMockRecorder) UpsertMemberSkill(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertMemberSkill", reflect.TypeOf((*MockStore)(nil).UpsertMemberSkill), arg0, arg1)
+}
@@ -0,0 +1,55 @@
+-- name: CheckInMember :one
+INSERT INTO attendances (
+  member_id, location_id, checked_in_at
+) VALUES (
+  $1, $2, $3
+)
+RETURNING *;
+
+-- name: CheckOutMember :one
+UPDATE attendances
+SET checked_out_at = sqlc.arg(checked_out_at)
+WHERE member_id = sqlc.arg(member_id)
+  AND checked_out_at IS NULL
+RETURNING *;
+
+-- name: AutoCheckOutAttendances :execrows
+UPDATE attendances
+SET checked_out_at = sqlc.arg(cutoff_at)::timestamptz
+      - floor(extract(epoch FROM sqlc.arg(cutoff_at)::timestamptz - checked_in_at) / 86400) * interval '1 day',
+    auto_checked_out = true
+WHERE checked_out_at IS NULL
+  AND checked_in_at < sqlc.arg(cutoff_at)::timestamptz;
+
+-- name: ListMemberAttendances :many
+SELECT * FROM attendances
+WHERE member_id = sqlc.arg(member_id)
+  AND checked_in_at >= sqlc.arg(from_time)
+  AND checked_in_at < sqlc.arg(to_time)
+ORDER BY checked_in_at;
+
+-- name: ListPresenceAttendances :many
+SELECT attendances.id, attendances.member_id, attendances.location_id, attendances.checked_in_at,
+  attendances.checked_out_at, attendances.auto_checked_out, members.first_name, members.last_name,
+  locations.name AS location_name
+FROM attendances
+JOIN members ON members.id = attendances.member_id
+LEFT JOIN locations ON locations.id = attendances.location_id
+WHERE members.deleted_at IS NULL
+  AND (attendances.checked_out_at IS NULL OR attendances.checked_in_at >= sqlc.arg(since))
+  AND (sqlc.narg(location_id)::uuid IS NULL OR attendances.location_id = sqlc.narg(location_id))
+ORDER BY attendances.member_id, attendances.checked_in_at;
+
+-- name: ListAttendanceSummaries :many
+SELECT members.id AS member_id, members.first_name, members.last_name,
+  count(DISTINCT (attendances.checked_in_at AT TIME ZONE 'UTC')::date)::integer AS days_present,
+  coalesce(sum(extract(epoch FROM attendances.checked_out_at - attendances.checked_in_at)), 0)::bigint AS seconds_present,
+  count(attendances.id) FILTER (WHERE attendances.auto_checked_out)::integer AS auto_checkouts
+FROM members
+LEFT JOIN attendances ON attendances.member_id = members.id
+  AND attendances.checked_in_at >= sqlc.arg(from_time)
+  AND attendances.checked_in_at < sqlc.arg(to_time)
+WHERE members.deleted_at IS NULL
+  AND (sqlc.narg(member_id)::uuid IS NULL OR members.id = sqlc.narg(member_id))
+GROUP BY members.id
+ORDER BY lower(members.last_name), lower(members.first_name), members.id;
@@ -0,0 +1,63 @@
+-- name: ListChecklistTemplateTasks :many
+SELECT * FROM checklist_template_tasks
+WHERE kind = $1
+ORDER BY position, id;
+
+-- name: DeleteChecklistTemplateTasks :exec
+DELETE FROM checklist_template_tasks
+WHERE kind = $1;
+
+-- name: CreateChecklistTemplateTask :one
+INSERT INTO checklist_template_tasks (
+  kind, title, description, assignee_id, due_offset_days, position
+) VALUES (
+  $1, $2, $3, $4, $5, $6
+)
+RETURNING *;
+
+-- name: CreateMemberChecklist :many
+INSERT INTO member_checklist_tasks (
+  member_id, kind, title, description, assignee_id, due_date, position
+)
+SELECT sqlc.arg(member_id), kind, title, description, assignee_id,
+       sqlc.arg(effective_date)::date + due_offset_days, position
+FROM checklist_template_tasks
+WHERE kind = sqlc.arg(kind)
+ORDER BY position, id
+RETURNING *;
+
+-- name: ListMemberChecklistTasks :many
+SELECT * FROM member_checklist_tasks
+WHERE member_id = sqlc.arg(member_id)
+  AND (sqlc.narg(kind)::varchar IS NULL OR kind = sqlc.narg(kind))
+ORDER BY created_at, position, id;
+
+-- name: ListOpenChecklistTasksByAssignee :many
+SELECT * FROM member_checklist_tasks
+WHERE assignee_id = $1
+  AND completed_at IS NULL
+  AND EXISTS (
+    SELECT 1 FROM members
+    WHERE members.id = member_checklist_tasks.member_id AND members.deleted_at IS NULL
+  )
+ORDER BY due_date, position, id;
+
+-- name: CompleteMemberChecklistTask :one
+UPDATE member_checklist_tasks
+SET completed_at = COALESCE(completed_at, now()),
+    completed_by = CASE WHEN completed_at IS NULL THEN sqlc.narg(completed_by) ELSE completed_by END
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: ReassignMemberChecklistTask :one
+UPDATE member_checklist_tasks
+SET assignee_id = sqlc.narg(assignee_id)
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: MarkOverdueChecklistTasks :execrows
+UPDATE member_checklist_tasks
+SET overdue_at = now()
+WHERE completed_at IS NULL
+  AND overdue_at IS NULL
+  AND due_date < sqlc.arg(today)::date;
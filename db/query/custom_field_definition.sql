@@ -0,0 +1,37 @@
+-- name: CreateCustomFieldDefinition :one
+INSERT INTO custom_field_definitions (
+  key, label, type, required, options, min_length, max_length, pattern, min_value, max_value
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+)
+RETURNING *;
+
+-- name: GetCustomFieldDefinition :one
+SELECT * FROM custom_field_definitions
+WHERE id = $1 LIMIT 1;
+
+-- name: ListCustomFieldDefinitions :many
+SELECT * FROM custom_field_definitions
+ORDER BY created_at, id;
+
+-- name: UpdateCustomFieldDefinition :one
+UPDATE custom_field_definitions
+SET
+  label = $2,
+  required = $3,
+  options = $4,
+  min_length = $5,
+  max_length = $6,
+  pattern = $7,
+  min_value = $8,
+  max_value = $9
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteCustomFieldDefinition :one
+DELETE FROM custom_field_definitions
+WHERE id = $1
+RETURNING *;
+
+-- name: TruncateCustomFieldDefinitionsTable :exec
+TRUNCATE TABLE custom_field_definitions CASCADE;
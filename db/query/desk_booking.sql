@@ -0,0 +1,50 @@
+-- name: GetDeskLocationForUpdate :one
+SELECT * FROM locations
+WHERE id = (
+  SELECT floors.location_id FROM desks
+  JOIN floors ON floors.id = desks.floor_id
+  WHERE desks.id = $1
+)
+FOR UPDATE;
+
+-- name: CountMemberWeekDeskBookings :one
+SELECT count(*) FROM desk_bookings
+JOIN desks ON desks.id = desk_bookings.desk_id
+JOIN floors ON floors.id = desks.floor_id
+WHERE desk_bookings.member_id = sqlc.arg(member_id)
+  AND floors.location_id = sqlc.arg(location_id)
+  AND date_trunc('week', desk_bookings.date) = date_trunc('week', sqlc.arg(date)::date)
+  AND desk_bookings.canceled_at IS NULL;
+
+-- name: CreateDeskBooking :one
+INSERT INTO desk_bookings (
+  desk_id, member_id, date, slot
+) VALUES (
+  $1, $2, $3, $4
+)
+RETURNING *;
+
+-- name: CancelDeskBooking :one
+UPDATE desk_bookings
+SET canceled_at = now()
+WHERE id = $1 AND canceled_at IS NULL
+RETURNING *;
+
+-- name: ListLocationDeskBookings :many
+SELECT * FROM desk_bookings
+WHERE desk_id IN (
+  SELECT desks.id FROM desks
+  JOIN floors ON floors.id = desks.floor_id
+  WHERE floors.location_id = sqlc.arg(location_id)
+)
+  AND date = sqlc.arg(date)
+  AND canceled_at IS NULL
+ORDER BY created_at, id;
+
+-- name: ListMemberDeskBookings :many
+SELECT * FROM desk_bookings
+WHERE member_id = sqlc.arg(member_id)
+  AND date >= sqlc.arg(from_date)
+  AND date < sqlc.arg(to_date)
+  AND canceled_at IS NULL
+ORDER BY date, slot = 'afternoon', id;
@@ -0,0 +1,106 @@
+-- name: CreateLeaveType :one
+INSERT INTO leave_types (
+  name, annual_allowance, max_carry_over
+) VALUES (
+  $1, $2, $3
+)
+RETURNING *;
+
+-- name: GetLeaveType :one
+SELECT * FROM leave_types
+WHERE id = $1 LIMIT 1;
+
+-- name: ListLeaveTypes :many
+SELECT * FROM leave_types
+ORDER BY lower(name), id;
+
+-- name: UpdateLeaveType :one
+UPDATE leave_types
+SET name = $2,
+    annual_allowance = $3,
+    max_carry_over = $4
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteLeaveType :one
+DELETE FROM leave_types
+WHERE id = $1
+RETURNING *;
+
+-- name: CreateLeaveRequest :one
+INSERT INTO leave_requests (
+  member_id, leave_type_id, start_date, start_half_day, end_date, end_half_day, days, reason
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8
+)
+RETURNING *;
+
+-- name: GetLeaveRequest :one
+SELECT * FROM leave_requests
+WHERE id = $1 LIMIT 1;
+
+-- name: GetLeaveRequestForUpdate :one
+SELECT * FROM leave_requests
+WHERE id = $1 LIMIT 1
+FOR UPDATE;
+
+-- name: ListLeaveRequests :many
+SELECT * FROM leave_requests
+WHERE (sqlc.narg(member_id)::uuid IS NULL OR member_id = sqlc.narg(member_id))
+  AND (sqlc.narg(status)::varchar IS NULL OR status = sqlc.narg(status))
+ORDER BY start_date DESC, id
+LIMIT $1
+OFFSET $2;
+
+-- name: CountLeaveRequests :one
+SELECT count(*) FROM leave_requests
+WHERE (sqlc.narg(member_id)::uuid IS NULL OR member_id = sqlc.narg(member_id))
+  AND (sqlc.narg(status)::varchar IS NULL OR status = sqlc.narg(status));
+
+-- name: ListMemberActiveLeaveRequests :many
+SELECT * FROM leave_requests
+WHERE member_id = sqlc.arg(member_id)
+  AND leave_type_id = sqlc.arg(leave_type_id)
+  AND status IN ('pending', 'approved')
+ORDER BY start_date, id;
+
+-- name: DecideLeaveRequest :one
+UPDATE leave_requests
+SET status = sqlc.arg(status),
+    decided_by = sqlc.arg(decided_by),
+    decided_at = now(),
+    decision_note = sqlc.arg(decision_note)
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: CancelLeaveRequest :one
+UPDATE leave_requests
+SET status = 'canceled'
+WHERE id = $1
+  AND status IN ('pending', 'approved')
+RETURNING *;
+
+-- name: ListTeamLeaveRequests :many
+WITH RECURSIVE subteams AS (
+  SELECT teams.id FROM teams
+  WHERE teams.id = sqlc.arg(team_id)::uuid
+  UNION
+  SELECT teams.id FROM teams
+  JOIN subteams ON teams.parent_id = subteams.id
+  WHERE sqlc.arg(recursive)::boolean
+)
+SELECT leave_requests.id, leave_requests.member_id, members.first_name, members.last_name,
+       leave_requests.leave_type_id, leave_types.name AS leave_type_name, leave_requests.start_date,
+       leave_requests.start_half_day, leave_requests.end_date, leave_requests.end_half_day, leave_requests.status
+FROM leave_requests
+JOIN members ON members.id = leave_requests.member_id
+JOIN leave_types ON leave_types.id = leave_requests.leave_type_id
+WHERE leave_requests.member_id IN (
+    SELECT team_members.member_id FROM team_members
+    WHERE team_members.team_id IN (SELECT subteams.id FROM subteams)
+  )
+  AND members.deleted_at IS NULL
+  AND leave_requests.start_date <= sqlc.arg(to_date)
+  AND leave_requests.end_date >= sqlc.arg(from_date)
+  AND (leave_requests.status = 'approved' OR (sqlc.arg(include_pending)::boolean AND leave_requests.status = 'pending'))
+ORDER BY leave_requests.start_date, members.last_name, members.first_name, leave_requests.id;
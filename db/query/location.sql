@@ -0,0 +1,71 @@
+-- name: CreateLocation :one
+INSERT INTO locations (
+  name, max_advance_days, max_bookings_per_week
+) VALUES (
+  $1, $2, $3
+)
+RETURNING *;
+
+-- name: GetLocation :one
+SELECT * FROM locations
+WHERE id = $1 LIMIT 1;
+
+-- name: ListLocations :many
+SELECT * FROM locations
+ORDER BY lower(name), id;
+
+-- name: UpdateLocation :one
+UPDATE locations
+SET name = $2,
+    max_advance_days = $3,
+    max_bookings_per_week = $4
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteLocation :one
+DELETE FROM locations
+WHERE id = $1
+RETURNING *;
+
+-- name: CreateFloor :one
+INSERT INTO floors (
+  location_id, name, level
+) VALUES (
+  $1, $2, $3
+)
+RETURNING *;
+
+-- name: ListFloors :many
+SELECT * FROM floors
+WHERE location_id = $1
+ORDER BY level, lower(name), id;
+
+-- name: DeleteFloor :one
+DELETE FROM floors
+WHERE id = $1
+RETURNING *;
+
+-- name: CreateDesk :one
+INSERT INTO desks (
+  floor_id, name
+) VALUES (
+  $1, $2
+)
+RETURNING *;
+
+-- name: ListDesks :many
+SELECT * FROM desks
+WHERE floor_id = $1
+ORDER BY lower(name), id;
+
+-- name: DeleteDesk :one
+DELETE FROM desks
+WHERE id = $1
+RETURNING *;
+
+-- name: ListLocationDesks :many
+SELECT desks.id, desks.name, desks.floor_id, floors.name AS floor_name, floors.level AS floor_level
+FROM desks
+JOIN floors ON floors.id = desks.floor_id
+WHERE floors.location_id = $1
+ORDER BY floors.level, lower(floors.name), floors.id, lower(desks.name), desks.id;
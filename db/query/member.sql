@@ -1,17 +1,35 @@
 -- name: CreateMember :one
 INSERT INTO members (
-  first_name, last_name, email
+  first_name, last_name, email, custom_fields, status, start_date
 ) VALUES (
-  $1, $2, $3
+  $1, $2, $3, COALESCE(NULLIF(sqlc.arg(custom_fields)::text, ''), '{}')::jsonb,
+  COALESCE(NULLIF(sqlc.arg(status)::varchar, ''), 'active'), sqlc.narg(start_date)
 )
 RETURNING *;
 
 -- name: GetMember :one
 SELECT * FROM members
-WHERE id = $1 LIMIT 1;
+WHERE id = $1 AND deleted_at IS NULL LIMIT 1;
+
+-- name: GetMemberForUpdate :one
+SELECT * FROM members
+WHERE id = $1 LIMIT 1
+FOR UPDATE;
+
+-- name: ListMembersForUpdate :many
+SELECT * FROM members
+WHERE id = ANY($1::uuid[])
+ORDER BY id
+FOR UPDATE;
+
+-- name: ListMembersByIDs :many
+SELECT * FROM members
+WHERE id = ANY($1::uuid[]) AND deleted_at IS NULL
+ORDER BY created_at, id;
 
 -- name: ListMembers :many
 SELECT * FROM members
+WHERE deleted_at IS NULL
 ORDER BY id
 LIMIT $1
 OFFSET $2;
@@ -21,20 +39,98 @@ UPDATE members
 SET
   first_name = COALESCE(sqlc.narg(first_name), first_name),
   last_name = COALESCE(sqlc.narg(last_name), last_name),
-  email = COALESCE(sqlc.narg(email), email)
-WHERE id = $1
+  email = COALESCE(sqlc.narg(email), email),
+  custom_fields = jsonb_strip_nulls(custom_fields || COALESCE(NULLIF(sqlc.arg(custom_fields)::text, ''), '{}')::jsonb),
+  version = version + 1
+WHERE id = $1 AND deleted_at IS NULL
+  AND (sqlc.narg(expected_versions)::integer[] IS NULL OR version = ANY(sqlc.narg(expected_versions)::integer[]))
+RETURNING *;
+
+-- name: PatchMember :one
+UPDATE members
+SET
+  first_name = COALESCE(sqlc.narg(first_name), first_name),
+  last_name = COALESCE(sqlc.narg(last_name), last_name),
+  email = CASE WHEN sqlc.arg(set_email)::boolean THEN sqlc.narg(email) ELSE email END,
+  custom_fields = jsonb_strip_nulls(custom_fields || COALESCE(NULLIF(sqlc.arg(custom_fields)::text, ''), '{}')::jsonb),
+  version = version + 1
+WHERE id = $1 AND deleted_at IS NULL
+  AND (sqlc.narg(expected_versions)::integer[] IS NULL OR version = ANY(sqlc.narg(expected_versions)::integer[]))
+RETURNING *;
+
+-- name: SetMemberAvatar :one
+UPDATE members
+SET avatar_key = sqlc.narg(avatar_key),
+    avatar_url = sqlc.narg(avatar_url),
+    version = version + 1
+WHERE id = sqlc.arg(id) AND deleted_at IS NULL
 RETURNING *;
 
 -- name: DeleteMember :exec
-DELETE FROM members
-WHERE id = $1;
+UPDATE members
+SET deleted_at = now()
+WHERE id = $1 AND deleted_at IS NULL;
 
--- name: DeleteMembers :exec
-DELETE FROM members
-WHERE id = ANY($1::uuid[]);
+-- name: DeleteMembers :many
+UPDATE members
+SET deleted_at = now()
+WHERE id = ANY($1::uuid[]) AND deleted_at IS NULL
+RETURNING *;
 
 -- name: CountMembers :one
-SELECT count(*) FROM members;
+SELECT count(*) FROM members
+WHERE deleted_at IS NULL;
+
+-- name: ListDeletedMembers :many
+SELECT * FROM members
+WHERE deleted_at IS NOT NULL
+ORDER BY deleted_at DESC, id
+LIMIT $1
+OFFSET $2;
+
+-- name: CountDeletedMembers :one
+SELECT count(*) FROM members
+WHERE deleted_at IS NOT NULL;
+
+-- name: RestoreMember :one
+UPDATE members
+SET deleted_at = NULL
+WHERE id = $1 AND deleted_at IS NOT NULL
+RETURNING *;
+
+-- name: RestoreMembers :many
+UPDATE members
+SET deleted_at = NULL
+WHERE id = ANY($1::uuid[]) AND deleted_at IS NOT NULL
+RETURNING *;
+
+-- name: PurgeDeletedMembers :many
+DELETE FROM members
+WHERE deleted_at < sqlc.arg(deleted_before)
+RETURNING avatar_key;
 
 -- name: TruncateMembersTable :exec
 TRUNCATE TABLE members CASCADE;
+
+-- name: SearchMembers :many
+SELECT
+  id, first_name, last_name, email, created_at, custom_fields, manager_id, avatar_url, status, start_date, end_date, time_zone,
+  (
+    ts_rank(search_vector, websearch_to_tsquery('simple', normalize_search_text(sqlc.arg(query)::text))) +
+    word_similarity(normalize_search_text(sqlc.arg(query)::text), normalize_search_text(first_name || ' ' || last_name || ' ' || coalesce(email, '')))
+  )::real AS rank
+FROM members
+WHERE deleted_at IS NULL
+  AND (
+    search_vector @@ websearch_to_tsquery('simple', normalize_search_text(sqlc.arg(query)::text))
+    OR normalize_search_text(sqlc.arg(query)::text) <% normalize_search_text(first_name || ' ' || last_name || ' ' || coalesce(email, ''))
+  )
+ORDER BY rank DESC, id
+LIMIT sqlc.arg(result_limit);
+
+-- name: RemoveMembersCustomField :execrows
+UPDATE members
+SET
+  custom_fields = custom_fields - sqlc.arg(key)::varchar,
+  version = version + 1
+WHERE custom_fields ? sqlc.arg(key)::varchar;
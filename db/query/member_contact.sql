@@ -0,0 +1,106 @@
+-- name: ListMemberEmails :many
+SELECT * FROM member_emails
+WHERE member_id = $1
+ORDER BY is_primary DESC, position, id;
+
+-- name: ListPrimaryMemberEmails :many
+SELECT email FROM member_emails
+WHERE is_primary AND lower(email) = ANY(sqlc.arg(emails)::varchar[]);
+
+-- name: CreateMemberEmail :one
+INSERT INTO member_emails (
+  member_id, label, email, is_primary, position
+) VALUES (
+  $1, $2, $3, $4, $5
+)
+RETURNING *;
+
+-- name: DeleteMemberEmails :exec
+DELETE FROM member_emails
+WHERE member_id = $1;
+
+-- name: SetPrimaryMemberEmail :execrows
+UPDATE member_emails
+SET email = sqlc.arg(email)
+WHERE member_id = sqlc.arg(member_id) AND is_primary;
+
+-- name: DeletePrimaryMemberEmail :exec
+DELETE FROM member_emails
+WHERE member_id = $1 AND is_primary;
+
+-- name: SyncMemberEmail :one
+UPDATE members
+SET email = (
+  SELECT member_emails.email FROM member_emails
+  WHERE member_emails.member_id = members.id AND member_emails.is_primary
+)
+WHERE id = $1
+RETURNING *;
+
+-- name: UnsetPrimaryMemberEmails :exec
+UPDATE member_emails
+SET is_primary = false
+WHERE member_id = ANY(sqlc.arg(member_ids)::uuid[]) AND is_primary;
+
+-- name: RestorePrimaryMemberEmails :exec
+UPDATE member_emails
+SET is_primary = true
+WHERE id IN (
+  SELECT DISTINCT ON (member_emails.member_id) member_emails.id FROM member_emails
+  JOIN members ON members.id = member_emails.member_id
+  WHERE member_emails.member_id = ANY(sqlc.arg(member_ids)::uuid[])
+    AND members.deleted_at IS NOT NULL
+    AND member_emails.email = members.email
+  ORDER BY member_emails.member_id, member_emails.position, member_emails.id
+);
+
+-- name: ListMemberPhones :many
+SELECT * FROM member_phones
+WHERE member_id = $1
+ORDER BY position, id;
+
+-- name: CreateMemberPhone :one
+INSERT INTO member_phones (
+  member_id, label, number, position
+) VALUES (
+  $1, $2, $3, $4
+)
+RETURNING *;
+
+-- name: DeleteMemberPhones :exec
+DELETE FROM member_phones
+WHERE member_id = $1;
+
+-- name: ListMemberAddresses :many
+SELECT * FROM member_addresses
+WHERE member_id = $1
+ORDER BY position, id;
+
+-- name: CreateMemberAddress :one
+INSERT INTO member_addresses (
+  member_id, label, street, city, region, postal_code, country, position
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8
+)
+RETURNING *;
+
+-- name: DeleteMemberAddresses :exec
+DELETE FROM member_addresses
+WHERE member_id = $1;
+
+-- name: ListMemberLinks :many
+SELECT * FROM member_links
+WHERE member_id = $1
+ORDER BY position, id;
+
+-- name: CreateMemberLink :one
+INSERT INTO member_links (
+  member_id, label, url, position
+) VALUES (
+  $1, $2, $3, $4
+)
+RETURNING *;
+
+-- name: DeleteMemberLinks :exec
+DELETE FROM member_links
+WHERE member_id = $1;
@@ -0,0 +1,372 @@
+-- name: ListMemberDuplicatePairs :many
+WITH candidates AS (
+  SELECT a.member_id, b.member_id AS duplicate_id
+  FROM member_emails a
+  JOIN member_emails b ON normalize_email(b.email) = normalize_email(a.email)
+    AND b.member_id > a.member_id
+  UNION
+  SELECT a.id, b.id
+  FROM members a
+  JOIN members b ON normalize_search_text(b.first_name || ' ' || b.last_name) % normalize_search_text(a.first_name || ' ' || a.last_name)
+    AND b.id > a.id
+  WHERE a.deleted_at IS NULL AND b.deleted_at IS NULL
+), pairs AS (
+  SELECT candidates.member_id, candidates.duplicate_id,
+    EXISTS (
+      SELECT 1 FROM member_emails a
+      JOIN member_emails b ON normalize_email(b.email) = normalize_email(a.email)
+      WHERE a.member_id = candidates.member_id AND b.member_id = candidates.duplicate_id
+    ) AS same_email,
+    similarity(
+      normalize_search_text(a.first_name || ' ' || a.last_name),
+      normalize_search_text(b.first_name || ' ' || b.last_name)
+    ) AS name_similarity
+  FROM candidates
+  JOIN members a ON a.id = candidates.member_id
+  JOIN members b ON b.id = candidates.duplicate_id
+  WHERE a.deleted_at IS NULL AND b.deleted_at IS NULL
+)
+SELECT member_id, duplicate_id, same_email::boolean AS same_email, name_similarity::real AS name_similarity
+FROM pairs
+WHERE same_email OR name_similarity >= sqlc.arg(min_name_similarity)::real
+ORDER BY member_id, duplicate_id
+LIMIT sqlc.arg(pair_limit);
+
+-- name: MergeMember :one
+UPDATE members
+SET first_name = $2,
+    last_name = $3,
+    manager_id = $4,
+    avatar_key = $5,
+    avatar_url = $6,
+    custom_fields = $7::jsonb,
+    version = version + 1
+WHERE id = $1 AND deleted_at IS NULL
+RETURNING *;
+
+-- name: TrashMergedMembers :many
+UPDATE members
+SET email = NULL,
+    avatar_key = NULL,
+    avatar_url = NULL,
+    deleted_at = now(),
+    version = version + 1
+WHERE id = ANY($1::uuid[]) AND deleted_at IS NULL
+RETURNING *;
+
+-- name: ListDirectReportsForUpdate :many
+SELECT * FROM members
+WHERE manager_id = ANY(sqlc.arg(manager_ids)::uuid[])
+  AND id <> sqlc.arg(exclude_id)
+  AND deleted_at IS NULL
+ORDER BY id
+FOR UPDATE;
+
+-- name: MoveMemberAuditEvents :execrows
+UPDATE audit_events
+SET entity_id = sqlc.arg(survivor_id)
+WHERE entity_type = 'member' AND entity_id = ANY(sqlc.arg(member_ids)::uuid[]);
+
+-- name: MoveMemberTags :exec
+WITH moved AS (
+  DELETE FROM member_tags
+  WHERE member_tags.member_id = ANY(sqlc.arg(member_ids)::uuid[])
+  RETURNING member_tags.tag_id
+)
+INSERT INTO member_tags (member_id, tag_id)
+SELECT DISTINCT sqlc.arg(survivor_id)::uuid, moved.tag_id FROM moved
+ON CONFLICT DO NOTHING;
+
+-- name: MoveTeamMembers :exec
+WITH moved AS (
+  DELETE FROM team_members
+  WHERE team_members.member_id = ANY(sqlc.arg(member_ids)::uuid[])
+  RETURNING team_members.team_id, team_members.role
+)
+INSERT INTO team_members (team_id, member_id, role)
+SELECT DISTINCT ON (moved.team_id) moved.team_id, sqlc.arg(survivor_id)::uuid, moved.role
+FROM moved
+ORDER BY moved.team_id, moved.role = 'lead' DESC
+ON CONFLICT (team_id, member_id) DO UPDATE
+SET role = CASE WHEN EXCLUDED.role = 'lead' THEN EXCLUDED.role ELSE team_members.role END;
+
+-- name: MoveMemberEmails :exec
+WITH moved AS (
+  DELETE FROM member_emails
+  WHERE member_emails.member_id = ANY(sqlc.arg(member_ids)::uuid[])
+  RETURNING member_emails.member_id, member_emails.label, member_emails.email, member_emails.is_primary, member_emails.position
+), kept AS (
+  SELECT DISTINCT ON (lower(moved.email)) moved.*
+  FROM moved
+  WHERE NOT EXISTS (
+    SELECT 1 FROM member_emails
+    WHERE member_emails.member_id = sqlc.arg(survivor_id)::uuid AND lower(member_emails.email) = lower(moved.email)
+  )
+  ORDER BY lower(moved.email), moved.is_primary DESC, array_position(sqlc.arg(member_ids)::uuid[], moved.member_id)
+)
+INSERT INTO member_emails (member_id, label, email, is_primary, position)
+SELECT sqlc.arg(survivor_id)::uuid, kept.label, kept.email, false,
+  (SELECT count(*) FROM member_emails WHERE member_emails.member_id = sqlc.arg(survivor_id)::uuid) +
+  row_number() OVER (ORDER BY array_position(sqlc.arg(member_ids)::uuid[], kept.member_id), kept.is_primary DESC, kept.position)
+FROM kept;
+
+-- name: MoveMemberPhones :exec
+WITH moved AS (
+  DELETE FROM member_phones
+  WHERE member_phones.member_id = ANY(sqlc.arg(member_ids)::uuid[])
+  RETURNING member_phones.member_id, member_phones.label, member_phones.number, member_phones.position
+), kept AS (
+  SELECT DISTINCT ON (moved.number) moved.*
+  FROM moved
+  WHERE NOT EXISTS (
+    SELECT 1 FROM member_phones
+    WHERE member_phones.member_id = sqlc.arg(survivor_id)::uuid AND member_phones.number = moved.number
+  )
+  ORDER BY moved.number, array_position(sqlc.arg(member_ids)::uuid[], moved.member_id)
+)
+INSERT INTO member_phones (member_id, label, number, position)
+SELECT sqlc.arg(survivor_id)::uuid, kept.label, kept.number,
+  (SELECT count(*) FROM member_phones WHERE member_phones.member_id = sqlc.arg(survivor_id)::uuid) +
+  row_number() OVER (ORDER BY array_position(sqlc.arg(member_ids)::uuid[], kept.member_id), kept.position)
+FROM kept;
+
+-- name: MoveMemberAddresses :exec
+WITH moved AS (
+  DELETE FROM member_addresses
+  WHERE member_addresses.member_id = ANY(sqlc.arg(member_ids)::uuid[])
+  RETURNING member_addresses.member_id, member_addresses.label, member_addresses.street, member_addresses.city,
+            member_addresses.region, member_addresses.postal_code, member_addresses.country, member_addresses.position
+), kept AS (
+  SELECT DISTINCT ON (moved.street, moved.city, moved.region, moved.postal_code, moved.country) moved.*
+  FROM moved
+  WHERE NOT EXISTS (
+    SELECT 1 FROM member_addresses
+    WHERE member_addresses.member_id = sqlc.arg(survivor_id)::uuid
+      AND (member_addresses.street, member_addresses.city, member_addresses.region, member_addresses.postal_code, member_addresses.country)
+        = (moved.street, moved.city, moved.region, moved.postal_code, moved.country)
+  )
+  ORDER BY moved.street, moved.city, moved.region, moved.postal_code, moved.country,
+           array_position(sqlc.arg(member_ids)::uuid[], moved.member_id)
+)
+INSERT INTO member_addresses (member_id, label, street, city, region, postal_code, country, position)
+SELECT sqlc.arg(survivor_id)::uuid, kept.label, kept.street, kept.city, kept.region, kept.postal_code, kept.country,
+  (SELECT count(*) FROM member_addresses WHERE member_addresses.member_id = sqlc.arg(survivor_id)::uuid) +
+  row_number() OVER (ORDER BY array_position(sqlc.arg(member_ids)::uuid[], kept.member_id), kept.position)
+FROM kept;
+
+-- name: MoveMemberLinks :exec
+WITH moved AS (
+  DELETE FROM member_links
+  WHERE member_links.member_id = ANY(sqlc.arg(member_ids)::uuid[])
+  RETURNING member_links.member_id, member_links.label, member_links.url, member_links.position
+), kept AS (
+  SELECT DISTINCT ON (moved.url) moved.*
+  FROM moved
+  WHERE NOT EXISTS (
+    SELECT 1 FROM member_links
+    WHERE member_links.member_id = sqlc.arg(survivor_id)::uuid AND member_links.url = moved.url
+  )
+  ORDER BY moved.url, array_position(sqlc.arg(member_ids)::uuid[], moved.member_id)
+)
+INSERT INTO member_links (member_id, label, url, position)
+SELECT sqlc.arg(survivor_id)::uuid, kept.label, kept.url,
+  (SELECT count(*) FROM member_links WHERE member_links.member_id = sqlc.arg(survivor_id)::uuid) +
+  row_number() OVER (ORDER BY array_position(sqlc.arg(member_ids)::uuid[], kept.member_id), kept.position)
+FROM kept;
+
+-- name: MoveMemberDeskBookings :exec
+WITH canceled AS (
+  SELECT desk_bookings.id FROM desk_bookings
+  WHERE desk_bookings.member_id = ANY(sqlc.arg(member_ids)::uuid[]) AND desk_bookings.canceled_at IS NULL
+    AND EXISTS (
+      SELECT 1 FROM desk_bookings AS kept
+      WHERE kept.canceled_at IS NULL
+        AND (
+          kept.member_id = sqlc.arg(survivor_id)::uuid
+          OR array_position(sqlc.arg(member_ids)::uuid[], kept.member_id) < array_position(sqlc.arg(member_ids)::uuid[], desk_bookings.member_id)
+        )
+        AND desk_booking_period(kept.date, kept.slot) && desk_booking_period(desk_bookings.date, desk_bookings.slot)
+    )
+)
+UPDATE desk_bookings
+SET member_id = sqlc.arg(survivor_id)::uuid,
+    canceled_at = CASE WHEN desk_bookings.id IN (SELECT canceled.id FROM canceled) THEN now() ELSE desk_bookings.canceled_at END
+WHERE desk_bookings.member_id = ANY(sqlc.arg(member_ids)::uuid[]);
+
+-- name: MoveMemberRoomReservations :exec
+UPDATE room_reservations
+SET member_id = sqlc.arg(survivor_id)::uuid
+WHERE room_reservations.member_id = ANY(sqlc.arg(member_ids)::uuid[]);
+
+-- name: MoveMemberCalendarToken :exec
+UPDATE calendar_tokens
+SET member_id = sqlc.arg(survivor_id)::uuid
+WHERE calendar_tokens.token = (
+  SELECT moved.token FROM calendar_tokens AS moved
+  WHERE moved.member_id = ANY(sqlc.arg(member_ids)::uuid[])
+    AND NOT EXISTS (
+      SELECT 1 FROM calendar_tokens AS kept
+      WHERE kept.member_id = sqlc.arg(survivor_id)::uuid
+    )
+  ORDER BY array_position(sqlc.arg(member_ids)::uuid[], moved.member_id)
+  LIMIT 1
+);
+
+-- name: MoveMemberAttendances :exec
+WITH closed AS (
+  SELECT attendances.id FROM attendances
+  WHERE attendances.member_id = ANY(sqlc.arg(member_ids)::uuid[]) AND attendances.checked_out_at IS NULL
+    AND EXISTS (
+      SELECT 1 FROM attendances AS kept
+      WHERE kept.checked_out_at IS NULL
+        AND (
+          kept.member_id = sqlc.arg(survivor_id)::uuid
+          OR array_position(sqlc.arg(member_ids)::uuid[], kept.member_id) < array_position(sqlc.arg(member_ids)::uuid[], attendances.member_id)
+        )
+    )
+)
+UPDATE attendances
+SET member_id = sqlc.arg(survivor_id)::uuid,
+    checked_out_at = CASE WHEN attendances.id IN (SELECT closed.id FROM closed) THEN greatest(now(), attendances.checked_in_at) ELSE attendances.checked_out_at END,
+    auto_checked_out = attendances.auto_checked_out OR attendances.id IN (SELECT closed.id FROM closed)
+WHERE attendances.member_id = ANY(sqlc.arg(member_ids)::uuid[]);
+
+-- name: MoveMemberLeaveRequests :exec
+WITH canceled AS (
+  SELECT leave_requests.id FROM leave_requests
+  WHERE leave_requests.member_id = ANY(sqlc.arg(member_ids)::uuid[]) AND leave_requests.status IN ('pending', 'approved')
+    AND EXISTS (
+      SELECT 1 FROM leave_requests AS kept
+      WHERE kept.status IN ('pending', 'approved')
+        AND (
+          kept.member_id = sqlc.arg(survivor_id)::uuid
+          OR array_position(sqlc.arg(member_ids)::uuid[], kept.member_id) < array_position(sqlc.arg(member_ids)::uuid[], leave_requests.member_id)
+        )
+        AND leave_request_period(kept.start_date, kept.start_half_day, kept.end_date, kept.end_half_day)
+          && leave_request_period(leave_requests.start_date, leave_requests.start_half_day, leave_requests.end_date, leave_requests.end_half_day)
+    )
+)
+UPDATE leave_requests
+SET member_id = sqlc.arg(survivor_id)::uuid,
+    status = CASE WHEN leave_requests.id IN (SELECT canceled.id FROM canceled) THEN 'canceled' ELSE leave_requests.status END
+WHERE leave_requests.member_id = ANY(sqlc.arg(member_ids)::uuid[]);
+
+-- name: MoveMemberWorkingHours :exec
+WITH source AS (
+  SELECT members.id, members.time_zone FROM members
+  WHERE members.id = ANY(sqlc.arg(member_ids)::uuid[])
+    AND EXISTS (
+      SELECT 1 FROM member_working_hours
+      WHERE member_working_hours.member_id = members.id
+    )
+    AND NOT EXISTS (
+      SELECT 1 FROM member_working_hours
+      WHERE member_working_hours.member_id = sqlc.arg(survivor_id)::uuid
+    )
+  ORDER BY array_position(sqlc.arg(member_ids)::uuid[], members.id)
+  LIMIT 1
+), moved AS (
+  UPDATE member_working_hours
+  SET member_id = sqlc.arg(survivor_id)::uuid
+  WHERE member_working_hours.member_id IN (SELECT source.id FROM source)
+)
+UPDATE members
+SET time_zone = source.time_zone
+FROM source
+WHERE members.id = sqlc.arg(survivor_id)::uuid;
+
+-- name: MoveMemberTimeEntries :exec
+WITH untimed AS (
+  SELECT time_entries.id FROM time_entries
+  WHERE time_entries.member_id = ANY(sqlc.arg(member_ids)::uuid[]) AND time_entries.started_at IS NOT NULL
+    AND EXISTS (
+      SELECT 1 FROM time_entries AS kept
+      WHERE kept.started_at IS NOT NULL
+        AND (
+          kept.member_id = sqlc.arg(survivor_id)::uuid
+          OR array_position(sqlc.arg(member_ids)::uuid[], kept.member_id) < array_position(sqlc.arg(member_ids)::uuid[], time_entries.member_id)
+        )
+        AND tstzrange(kept.started_at, coalesce(kept.ended_at, 'infinity'))
+          && tstzrange(time_entries.started_at, coalesce(time_entries.ended_at, 'infinity'))
+    )
+)
+UPDATE time_entries
+SET member_id = sqlc.arg(survivor_id)::uuid,
+    minutes = CASE WHEN time_entries.id IN (SELECT untimed.id FROM untimed)
+      THEN coalesce(time_entries.minutes, floor(extract(epoch FROM greatest(now(), time_entries.started_at) - time_entries.started_at) / 60)::integer)
+      ELSE time_entries.minutes END,
+    started_at = CASE WHEN time_entries.id IN (SELECT untimed.id FROM untimed) THEN NULL ELSE time_entries.started_at END,
+    ended_at = CASE WHEN time_entries.id IN (SELECT untimed.id FROM untimed) THEN NULL ELSE time_entries.ended_at END
+WHERE time_entries.member_id = ANY(sqlc.arg(member_ids)::uuid[]);
+
+-- name: MoveMemberTimesheets :exec
+UPDATE timesheets
+SET member_id = sqlc.arg(survivor_id)::uuid
+WHERE timesheets.id IN (
+  SELECT DISTINCT ON (moved.week_start) moved.id FROM timesheets AS moved
+  WHERE moved.member_id = ANY(sqlc.arg(member_ids)::uuid[])
+    AND NOT EXISTS (
+      SELECT 1 FROM timesheets AS kept
+      WHERE kept.member_id = sqlc.arg(survivor_id)::uuid AND kept.week_start = moved.week_start
+    )
+  ORDER BY moved.week_start, array_position(sqlc.arg(member_ids)::uuid[], moved.member_id)
+);
+
+-- name: MoveMemberProjectAssignments :exec
+UPDATE project_assignments
+SET member_id = sqlc.arg(survivor_id)::uuid
+WHERE project_assignments.member_id = ANY(sqlc.arg(member_ids)::uuid[])
+  AND NOT EXISTS (
+    SELECT 1 FROM project_assignments AS kept
+    WHERE kept.project_id = project_assignments.project_id
+      AND (
+        kept.member_id = sqlc.arg(survivor_id)::uuid
+        OR array_position(sqlc.arg(member_ids)::uuid[], kept.member_id) < array_position(sqlc.arg(member_ids)::uuid[], project_assignments.member_id)
+      )
+      AND daterange(kept.start_date, kept.end_date, '[]') && daterange(project_assignments.start_date, project_assignments.end_date, '[]')
+  );
+
+-- name: MoveMemberSkills :exec
+WITH moved AS (
+  DELETE FROM member_skills
+  WHERE member_skills.member_id = ANY(sqlc.arg(member_ids)::uuid[])
+  RETURNING member_skills.skill_id, member_skills.level, member_skills.last_used_on, member_skills.created_at, member_skills.updated_at
+), merged AS (
+  INSERT INTO member_skills (member_id, skill_id, level, last_used_on, created_at, updated_at)
+  SELECT sqlc.arg(survivor_id)::uuid, moved.skill_id, max(moved.level), max(moved.last_used_on), min(moved.created_at), max(moved.updated_at)
+  FROM moved
+  GROUP BY moved.skill_id
+  ON CONFLICT (member_id, skill_id) DO UPDATE
+  SET level = greatest(member_skills.level, EXCLUDED.level),
+      last_used_on = greatest(member_skills.last_used_on, EXCLUDED.last_used_on),
+      updated_at = greatest(member_skills.updated_at, EXCLUDED.updated_at)
+)
+INSERT INTO skill_endorsements (member_id, skill_id, user_id, created_at)
+SELECT sqlc.arg(survivor_id)::uuid, skill_endorsements.skill_id, skill_endorsements.user_id, min(skill_endorsements.created_at)
+FROM skill_endorsements
+WHERE skill_endorsements.member_id = ANY(sqlc.arg(member_ids)::uuid[])
+GROUP BY skill_endorsements.skill_id, skill_endorsements.user_id
+ON CONFLICT DO NOTHING;
+
+-- name: DeleteSelfSkillEndorsements :exec
+DELETE FROM skill_endorsements
+USING users, members
+WHERE skill_endorsements.member_id = $1
+  AND users.id = skill_endorsements.user_id
+  AND members.id = skill_endorsements.member_id
+  AND lower(users.email) = lower(members.email);
+
+-- name: UnsetPrimaryMemberEmail :exec
+UPDATE member_emails
+SET is_primary = false
+WHERE member_id = $1 AND is_primary;
+
+-- name: PromoteMemberEmail :execrows
+UPDATE member_emails
+SET is_primary = true
+WHERE id = (
+  SELECT member_emails.id FROM member_emails
+  WHERE member_emails.member_id = sqlc.arg(member_id) AND lower(member_emails.email) = lower(sqlc.arg(email))
+  ORDER BY member_emails.position, member_emails.id
+  LIMIT 1
+);
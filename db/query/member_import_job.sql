@@ -0,0 +1,32 @@
+-- name: CreateMemberImportJob :one
+INSERT INTO member_import_jobs (
+  dry_run, total_rows
+) VALUES (
+  $1, $2
+)
+RETURNING *;
+
+-- name: GetMemberImportJob :one
+SELECT * FROM member_import_jobs
+WHERE id = $1 LIMIT 1;
+
+-- name: FinishMemberImportJob :one
+UPDATE member_import_jobs
+SET
+  status = $2,
+  imported_rows = $3,
+  errors = $4,
+  finished_at = now()
+WHERE id = $1
+RETURNING *;
+
+-- name: FailRunningMemberImportJobs :execrows
+UPDATE member_import_jobs
+SET
+  status = 'failed',
+  errors = $1,
+  finished_at = now()
+WHERE status = 'running';
+
+-- name: TruncateMemberImportJobsTable :exec
+TRUNCATE TABLE member_import_jobs CASCADE;
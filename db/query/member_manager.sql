@@ -0,0 +1,79 @@
+-- name: LockReportingLines :exec
+SELECT pg_advisory_xact_lock(hashtext('members.manager_id'));
+
+-- name: IsMemberReport :one
+WITH RECURSIVE reports AS (
+  SELECT members.id FROM members
+  WHERE members.manager_id = sqlc.arg(manager_id)::uuid
+  UNION
+  SELECT members.id FROM members
+  JOIN reports ON members.manager_id = reports.id
+)
+SELECT EXISTS (
+  SELECT 1 FROM reports
+  WHERE reports.id = sqlc.arg(member_id)::uuid
+);
+
+-- name: SetMemberManager :one
+UPDATE members
+SET manager_id = sqlc.narg(manager_id),
+    version = version + 1
+WHERE id = sqlc.arg(id) AND deleted_at IS NULL
+RETURNING *;
+
+-- name: ListMemberReports :many
+WITH RECURSIVE reports AS (
+  SELECT members.id, 1 AS depth FROM members
+  WHERE members.manager_id = sqlc.arg(manager_id)::uuid
+    AND members.deleted_at IS NULL
+  UNION ALL
+  SELECT members.id, reports.depth + 1 FROM members
+  JOIN reports ON members.manager_id = reports.id
+  WHERE members.deleted_at IS NULL
+    AND sqlc.arg(recursive)::boolean
+)
+SELECT members.id, members.first_name, members.last_name, members.email, members.created_at, members.custom_fields,
+       members.manager_id, members.avatar_url, members.status, members.start_date, members.end_date, members.time_zone,
+       reports.depth::integer AS depth
+FROM reports
+JOIN members ON members.id = reports.id
+ORDER BY reports.depth, members.last_name, members.first_name, members.id
+LIMIT $1
+OFFSET $2;
+
+-- name: CountMemberReports :one
+WITH RECURSIVE reports AS (
+  SELECT members.id FROM members
+  WHERE members.manager_id = sqlc.arg(manager_id)::uuid
+    AND members.deleted_at IS NULL
+  UNION ALL
+  SELECT members.id FROM members
+  JOIN reports ON members.manager_id = reports.id
+  WHERE members.deleted_at IS NULL
+    AND sqlc.arg(recursive)::boolean
+)
+SELECT count(*) FROM reports;
+
+-- name: ListMemberChain :many
+WITH RECURSIVE chain AS (
+  SELECT members.manager_id AS id, 1 AS depth FROM members
+  WHERE members.id = sqlc.arg(id)::uuid
+    AND members.manager_id IS NOT NULL
+  UNION ALL
+  SELECT members.manager_id, chain.depth + 1 FROM members
+  JOIN chain ON members.id = chain.id
+  WHERE members.manager_id IS NOT NULL
+    AND members.deleted_at IS NULL
+)
+SELECT members.id, members.first_name, members.last_name, members.email, members.created_at, members.custom_fields,
+       members.manager_id, members.avatar_url, members.status, members.start_date, members.end_date, members.time_zone,
+       chain.depth::integer AS depth
+FROM chain
+JOIN members ON members.id = chain.id
+WHERE members.deleted_at IS NULL
+ORDER BY chain.depth;
+
+-- name: ListOrgChartMembers :many
+SELECT id, first_name, last_name, email, manager_id FROM members
+WHERE deleted_at IS NULL
+ORDER BY last_name, first_name, id;
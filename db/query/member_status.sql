@@ -0,0 +1,8 @@
+-- name: TransitionMember :one
+UPDATE members
+SET status = sqlc.arg(status),
+    start_date = sqlc.narg(start_date),
+    end_date = sqlc.narg(end_date),
+    version = version + 1
+WHERE id = sqlc.arg(id) AND deleted_at IS NULL
+RETURNING *;
@@ -0,0 +1,30 @@
+-- name: CreateProject :one
+INSERT INTO projects (
+  name, description, billable
+) VALUES (
+  $1, $2, $3
+)
+RETURNING *;
+
+-- name: GetProject :one
+SELECT * FROM projects
+WHERE id = $1 LIMIT 1;
+
+-- name: ListProjects :many
+SELECT * FROM projects
+WHERE sqlc.arg(include_archived)::boolean OR archived_at IS NULL
+ORDER BY lower(name), id;
+
+-- name: UpdateProject :one
+UPDATE projects
+SET name = sqlc.arg(name),
+    description = sqlc.arg(description),
+    billable = sqlc.arg(billable),
+    archived_at = CASE WHEN sqlc.arg(archived)::boolean THEN coalesce(archived_at, now()) END
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: DeleteProject :one
+DELETE FROM projects
+WHERE id = $1
+RETURNING *;
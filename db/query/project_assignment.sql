@@ -0,0 +1,74 @@
+-- name: CreateProjectAssignment :one
+INSERT INTO project_assignments (
+  project_id, member_id, role, allocation, start_date, end_date
+) VALUES (
+  $1, $2, $3, $4, $5, $6
+)
+RETURNING *;
+
+-- name: GetProjectAssignment :one
+SELECT * FROM project_assignments
+WHERE id = $1 LIMIT 1;
+
+-- name: GetProjectAssignmentForUpdate :one
+SELECT * FROM project_assignments
+WHERE id = $1 LIMIT 1
+FOR UPDATE;
+
+-- name: UpdateProjectAssignment :one
+UPDATE project_assignments
+SET role = sqlc.arg(role),
+    allocation = sqlc.arg(allocation),
+    start_date = sqlc.arg(start_date),
+    end_date = sqlc.arg(end_date)
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: DeleteProjectAssignment :one
+DELETE FROM project_assignments
+WHERE id = $1
+RETURNING *;
+
+-- name: ListProjectAssignments :many
+SELECT * FROM project_assignments
+WHERE (sqlc.narg(project_id)::uuid IS NULL OR project_id = sqlc.narg(project_id))
+  AND (sqlc.narg(member_id)::uuid IS NULL OR member_id = sqlc.narg(member_id))
+  AND (sqlc.narg(from_date)::date IS NULL OR end_date >= sqlc.narg(from_date))
+  AND (sqlc.narg(to_date)::date IS NULL OR start_date <= sqlc.narg(to_date))
+ORDER BY start_date, created_at, id;
+
+-- name: ListMemberProjectAssignments :many
+SELECT * FROM project_assignments
+WHERE member_id = sqlc.arg(member_id)
+  AND end_date >= sqlc.arg(from_date)
+  AND start_date <= sqlc.arg(to_date)
+ORDER BY start_date, id;
+
+-- name: ListCapacityMembers :many
+SELECT id, first_name, last_name FROM members
+WHERE deleted_at IS NULL
+  AND status <> 'offboarded'
+  AND (sqlc.narg(member_id)::uuid IS NULL OR id = sqlc.narg(member_id))
+  AND (sqlc.narg(team_id)::uuid IS NULL OR id IN (
+    SELECT team_members.member_id FROM team_members
+    WHERE team_members.team_id = sqlc.narg(team_id)
+  ))
+ORDER BY lower(last_name), lower(first_name), id;
+
+-- name: ListCapacityAssignments :many
+SELECT project_assignments.member_id, project_assignments.project_id, projects.name AS project_name,
+  project_assignments.allocation, project_assignments.start_date, project_assignments.end_date
+FROM project_assignments
+JOIN projects ON projects.id = project_assignments.project_id
+WHERE project_assignments.member_id = ANY(sqlc.arg(member_ids)::uuid[])
+  AND project_assignments.end_date >= sqlc.arg(from_date)
+  AND project_assignments.start_date <= sqlc.arg(to_date)
+ORDER BY lower(projects.name), project_assignments.project_id, project_assignments.start_date;
+
+-- name: ListCapacityLeaves :many
+SELECT member_id, start_date, start_half_day, end_date, end_half_day FROM leave_requests
+WHERE member_id = ANY(sqlc.arg(member_ids)::uuid[])
+  AND status = 'approved'
+  AND end_date >= sqlc.arg(from_date)
+  AND start_date <= sqlc.arg(to_date)
+ORDER BY member_id, start_date;
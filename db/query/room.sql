@@ -0,0 +1,36 @@
+-- name: CreateRoom :one
+INSERT INTO rooms (
+  location_id, name, capacity, equipment
+) VALUES (
+  $1, $2, $3, $4
+)
+RETURNING *;
+
+-- name: GetRoom :one
+SELECT * FROM rooms
+WHERE id = $1 LIMIT 1;
+
+-- name: GetRoomForUpdate :one
+SELECT * FROM rooms
+WHERE id = $1 LIMIT 1
+FOR UPDATE;
+
+-- name: ListRooms :many
+SELECT * FROM rooms
+WHERE (sqlc.narg(location_id)::uuid IS NULL OR location_id = sqlc.narg(location_id))
+  AND capacity >= sqlc.arg(min_capacity)
+  AND equipment @> sqlc.arg(equipment)::varchar[]
+ORDER BY lower(name), id;
+
+-- name: UpdateRoom :one
+UPDATE rooms
+SET name = $2,
+    capacity = $3,
+    equipment = $4
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteRoom :one
+DELETE FROM rooms
+WHERE id = $1
+RETURNING *;
@@ -0,0 +1,72 @@
+-- name: CreateRoomReservation :one
+INSERT INTO room_reservations (
+  room_id, member_id, title, starts_at, ends_at, rrule, series_ends_at
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7
+)
+RETURNING *;
+
+-- name: GetRoomReservation :one
+SELECT * FROM room_reservations
+WHERE id = $1 LIMIT 1;
+
+-- name: DeleteRoomReservation :one
+DELETE FROM room_reservations
+WHERE id = $1
+RETURNING *;
+
+-- name: ListRoomReservationsInRange :many
+SELECT * FROM room_reservations
+WHERE (sqlc.narg(room_id)::uuid IS NULL OR room_id = sqlc.narg(room_id))
+  AND (sqlc.narg(member_id)::uuid IS NULL OR member_id = sqlc.narg(member_id))
+  AND starts_at < sqlc.arg(to_time)
+  AND series_ends_at > sqlc.arg(from_time)
+ORDER BY starts_at, id;
+
+-- name: CreateRoomReservationException :exec
+INSERT INTO room_reservation_exceptions (
+  reservation_id, occurrence_start
+) VALUES (
+  $1, $2
+)
+ON CONFLICT DO NOTHING;
+
+-- name: ListRoomReservationExceptions :many
+SELECT * FROM room_reservation_exceptions
+WHERE reservation_id = ANY($1::uuid[])
+ORDER BY reservation_id, occurrence_start;
+
+-- name: ListCalendarFeedReservations :many
+SELECT room_reservations.id, room_reservations.room_id, room_reservations.member_id, room_reservations.title,
+  room_reservations.starts_at, room_reservations.ends_at, room_reservations.rrule, room_reservations.series_ends_at,
+  room_reservations.created_at, rooms.name AS room_name
+FROM room_reservations
+JOIN rooms ON rooms.id = room_reservations.room_id
+WHERE (sqlc.narg(room_id)::uuid IS NULL OR room_reservations.room_id = sqlc.narg(room_id))
+  AND (sqlc.narg(member_id)::uuid IS NULL OR room_reservations.member_id = sqlc.narg(member_id))
+  AND room_reservations.series_ends_at > sqlc.arg(since)
+ORDER BY room_reservations.starts_at, room_reservations.id;
+
+-- name: RotateRoomCalendarToken :one
+INSERT INTO calendar_tokens (
+  room_id
+) VALUES (
+  $1
+)
+ON CONFLICT (room_id) DO UPDATE
+SET token = gen_random_uuid(), created_at = now()
+RETURNING *;
+
+-- name: RotateMemberCalendarToken :one
+INSERT INTO calendar_tokens (
+  member_id
+) VALUES (
+  $1
+)
+ON CONFLICT (member_id) DO UPDATE
+SET token = gen_random_uuid(), created_at = now()
+RETURNING *;
+
+-- name: GetCalendarToken :one
+SELECT * FROM calendar_tokens
+WHERE token = $1 LIMIT 1;
@@ -0,0 +1,87 @@
+-- name: CreateSkill :one
+INSERT INTO skills (
+  name
+) VALUES (
+  $1
+)
+RETURNING *;
+
+-- name: GetSkill :one
+SELECT * FROM skills
+WHERE id = $1 LIMIT 1;
+
+-- name: ListSkills :many
+SELECT * FROM skills
+ORDER BY lower(name), id;
+
+-- name: RenameSkill :one
+UPDATE skills
+SET name = $2
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteSkill :one
+DELETE FROM skills
+WHERE id = $1
+RETURNING *;
+
+-- name: UpsertMemberSkill :one
+INSERT INTO member_skills (
+  member_id, skill_id, level, last_used_on
+) VALUES (
+  $1, $2, $3, $4
+)
+ON CONFLICT (member_id, skill_id) DO UPDATE
+SET level = EXCLUDED.level,
+    last_used_on = EXCLUDED.last_used_on,
+    updated_at = now()
+RETURNING *;
+
+-- name: GetMemberSkill :one
+SELECT * FROM member_skills
+WHERE member_id = $1 AND skill_id = $2 LIMIT 1;
+
+-- name: DeleteMemberSkill :one
+DELETE FROM member_skills
+WHERE member_id = $1 AND skill_id = $2
+RETURNING *;
+
+-- name: ListMemberSkills :many
+SELECT member_skills.skill_id, skills.name AS skill_name, member_skills.level, member_skills.last_used_on,
+  count(skill_endorsements.user_id)::integer AS endorsements,
+  coalesce(bool_or(skill_endorsements.user_id = sqlc.arg(user_id)), false)::boolean AS endorsed
+FROM member_skills
+JOIN skills ON skills.id = member_skills.skill_id
+LEFT JOIN skill_endorsements ON skill_endorsements.member_id = member_skills.member_id
+  AND skill_endorsements.skill_id = member_skills.skill_id
+WHERE member_skills.member_id = sqlc.arg(member_id)
+GROUP BY member_skills.skill_id, skills.name, member_skills.level, member_skills.last_used_on
+ORDER BY member_skills.level DESC, lower(skills.name), member_skills.skill_id;
+
+-- name: CreateSkillEndorsement :execrows
+INSERT INTO skill_endorsements (
+  member_id, skill_id, user_id
+) VALUES (
+  $1, $2, $3
+)
+ON CONFLICT DO NOTHING;
+
+-- name: DeleteSkillEndorsement :execrows
+DELETE FROM skill_endorsements
+WHERE member_id = $1 AND skill_id = $2 AND user_id = $3;
+
+-- name: ListSkillSearchRows :many
+SELECT member_skills.member_id, members.first_name, members.last_name,
+  member_skills.skill_id, skills.name AS skill_name, member_skills.level, member_skills.last_used_on,
+  count(skill_endorsements.user_id)::integer AS endorsements
+FROM member_skills
+JOIN members ON members.id = member_skills.member_id
+JOIN skills ON skills.id = member_skills.skill_id
+LEFT JOIN skill_endorsements ON skill_endorsements.member_id = member_skills.member_id
+  AND skill_endorsements.skill_id = member_skills.skill_id
+WHERE member_skills.skill_id = ANY(sqlc.arg(skill_ids)::uuid[])
+  AND members.deleted_at IS NULL
+  AND members.status <> 'offboarded'
+GROUP BY member_skills.member_id, members.first_name, members.last_name,
+  member_skills.skill_id, skills.name, member_skills.level, member_skills.last_used_on
+ORDER BY member_skills.member_id, lower(skills.name), member_skills.skill_id;
@@ -0,0 +1,50 @@
+-- name: CreateTag :one
+INSERT INTO tags (
+  name
+) VALUES (
+  $1
+)
+RETURNING *;
+
+-- name: GetTag :one
+SELECT * FROM tags
+WHERE id = $1 LIMIT 1;
+
+-- name: ListTags :many
+SELECT * FROM tags
+ORDER BY lower(name), id;
+
+-- name: RenameTag :one
+UPDATE tags
+SET name = $2
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteTag :one
+DELETE FROM tags
+WHERE id = $1
+RETURNING *;
+
+-- name: ListTagsByMemberIDs :many
+SELECT member_tags.member_id, tags.id, tags.name, tags.created_at
+FROM member_tags
+JOIN tags ON tags.id = member_tags.tag_id
+WHERE member_tags.member_id = ANY(sqlc.arg(member_ids)::uuid[])
+ORDER BY member_tags.member_id, lower(tags.name), tags.id;
+
+-- name: AddMemberTags :execrows
+INSERT INTO member_tags (member_id, tag_id)
+SELECT members.id, tags.id
+FROM members
+CROSS JOIN tags
+WHERE members.id = ANY(sqlc.arg(member_ids)::uuid[]) AND members.deleted_at IS NULL
+  AND tags.id = ANY(sqlc.arg(tag_ids)::uuid[])
+ON CONFLICT DO NOTHING;
+
+-- name: RemoveMemberTags :execrows
+DELETE FROM member_tags
+WHERE member_id = ANY(sqlc.arg(member_ids)::uuid[])
+  AND tag_id = ANY(sqlc.arg(tag_ids)::uuid[]);
+
+-- name: TruncateTagsTable :exec
+TRUNCATE TABLE tags CASCADE;
@@ -0,0 +1,97 @@
+-- name: CreateTeam :one
+INSERT INTO teams (
+  name, parent_id
+) VALUES (
+  $1, $2
+)
+RETURNING *;
+
+-- name: GetTeam :one
+SELECT * FROM teams
+WHERE id = $1 LIMIT 1;
+
+-- name: ListTeams :many
+SELECT * FROM teams
+ORDER BY lower(name), id;
+
+-- name: UpdateTeam :one
+UPDATE teams
+SET name = $2,
+    parent_id = $3
+WHERE id = $1
+RETURNING *;
+
+-- name: IsDescendantTeam :one
+WITH RECURSIVE descendants AS (
+  SELECT teams.id FROM teams
+  WHERE teams.parent_id = sqlc.arg(ancestor_id)::uuid
+  UNION
+  SELECT teams.id FROM teams
+  JOIN descendants ON teams.parent_id = descendants.id
+)
+SELECT EXISTS (
+  SELECT 1 FROM descendants
+  WHERE descendants.id = sqlc.arg(team_id)::uuid
+);
+
+-- name: ReparentChildTeams :exec
+UPDATE teams
+SET parent_id = sqlc.narg(parent_id)
+WHERE parent_id = sqlc.arg(id);
+
+-- name: DeleteTeam :one
+DELETE FROM teams
+WHERE id = $1
+RETURNING *;
+
+-- name: AddTeamMember :one
+INSERT INTO team_members (
+  team_id, member_id, role
+) VALUES (
+  $1, $2, $3
+)
+ON CONFLICT (team_id, member_id) DO UPDATE
+SET role = EXCLUDED.role
+RETURNING *;
+
+-- name: RemoveTeamMember :execrows
+DELETE FROM team_members
+WHERE team_id = $1 AND member_id = $2;
+
+-- name: ListTeamMembers :many
+WITH RECURSIVE subteams AS (
+  SELECT teams.id FROM teams
+  WHERE teams.id = sqlc.arg(team_id)::uuid
+  UNION
+  SELECT teams.id FROM teams
+  JOIN subteams ON teams.parent_id = subteams.id
+  WHERE sqlc.arg(recursive)::boolean
+)
+SELECT members.id, members.first_name, members.last_name, members.email, members.created_at, members.custom_fields,
+       members.manager_id, members.avatar_url, members.status, members.start_date, members.end_date, members.time_zone,
+       team_members.team_id, team_members.role
+FROM team_members
+JOIN members ON members.id = team_members.member_id
+WHERE team_members.team_id IN (SELECT subteams.id FROM subteams)
+  AND members.deleted_at IS NULL
+ORDER BY members.last_name, members.first_name, members.id, team_members.team_id
+LIMIT $1
+OFFSET $2;
+
+-- name: CountTeamMembers :one
+WITH RECURSIVE subteams AS (
+  SELECT teams.id FROM teams
+  WHERE teams.id = sqlc.arg(team_id)::uuid
+  UNION
+  SELECT teams.id FROM teams
+  JOIN subteams ON teams.parent_id = subteams.id
+  WHERE sqlc.arg(recursive)::boolean
+)
+SELECT count(*)
+FROM team_members
+JOIN members ON members.id = team_members.member_id
+WHERE team_members.team_id IN (SELECT subteams.id FROM subteams)
+  AND members.deleted_at IS NULL;
+
+-- name: TruncateTeamsTable :exec
+TRUNCATE TABLE teams CASCADE;
@@ -0,0 +1,81 @@
+-- name: CreateTimeEntry :one
+INSERT INTO time_entries (
+  member_id, project_id, date, started_at, ended_at, minutes, note, billable
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8
+)
+RETURNING *;
+
+-- name: GetTimeEntry :one
+SELECT * FROM time_entries
+WHERE id = $1 LIMIT 1;
+
+-- name: GetTimeEntryForUpdate :one
+SELECT * FROM time_entries
+WHERE id = $1 LIMIT 1
+FOR UPDATE;
+
+-- name: GetRunningTimeEntry :one
+SELECT * FROM time_entries
+WHERE member_id = $1
+  AND started_at IS NOT NULL
+  AND ended_at IS NULL
+LIMIT 1;
+
+-- name: UpdateTimeEntry :one
+UPDATE time_entries
+SET project_id = sqlc.arg(project_id),
+    date = sqlc.arg(date),
+    started_at = sqlc.narg(started_at),
+    ended_at = sqlc.narg(ended_at),
+    minutes = sqlc.narg(minutes),
+    note = sqlc.arg(note),
+    billable = sqlc.arg(billable)
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: DeleteTimeEntry :exec
+DELETE FROM time_entries
+WHERE id = $1;
+
+-- name: ListTimeEntries :many
+SELECT * FROM time_entries
+WHERE (sqlc.narg(member_id)::uuid IS NULL OR member_id = sqlc.narg(member_id))
+  AND (sqlc.narg(project_id)::uuid IS NULL OR project_id = sqlc.narg(project_id))
+  AND (sqlc.narg(from_date)::date IS NULL OR date >= sqlc.narg(from_date))
+  AND (sqlc.narg(to_date)::date IS NULL OR date <= sqlc.narg(to_date))
+ORDER BY date DESC, started_at DESC NULLS LAST, id
+LIMIT $1
+OFFSET $2;
+
+-- name: CountTimeEntries :one
+SELECT count(*) FROM time_entries
+WHERE (sqlc.narg(member_id)::uuid IS NULL OR member_id = sqlc.narg(member_id))
+  AND (sqlc.narg(project_id)::uuid IS NULL OR project_id = sqlc.narg(project_id))
+  AND (sqlc.narg(from_date)::date IS NULL OR date >= sqlc.narg(from_date))
+  AND (sqlc.narg(to_date)::date IS NULL OR date <= sqlc.narg(to_date));
+
+-- name: ListMemberTimeEntries :many
+SELECT * FROM time_entries
+WHERE member_id = sqlc.arg(member_id)
+  AND date >= sqlc.arg(from_date)
+  AND date <= sqlc.arg(to_date)
+ORDER BY date, started_at NULLS FIRST, created_at, id;
+
+-- name: ListTimeReportRows :many
+SELECT time_entries.project_id, projects.name AS project_name, time_entries.member_id, members.first_name,
+  members.last_name, date_trunc('week', time_entries.date)::date AS week_start,
+  count(*)::integer AS entries,
+  sum(time_entries.minutes)::bigint AS minutes,
+  coalesce(sum(time_entries.minutes) FILTER (WHERE time_entries.billable), 0)::bigint AS billable_minutes
+FROM time_entries
+JOIN projects ON projects.id = time_entries.project_id
+JOIN members ON members.id = time_entries.member_id
+WHERE time_entries.date >= sqlc.arg(from_date)
+  AND time_entries.date <= sqlc.arg(to_date)
+  AND time_entries.minutes IS NOT NULL
+  AND (sqlc.narg(member_id)::uuid IS NULL OR time_entries.member_id = sqlc.narg(member_id))
+  AND (sqlc.narg(project_id)::uuid IS NULL OR time_entries.project_id = sqlc.narg(project_id))
+GROUP BY time_entries.project_id, projects.name, time_entries.member_id, members.first_name, members.last_name, week_start
+ORDER BY week_start, lower(projects.name), time_entries.project_id, lower(members.last_name), lower(members.first_name),
+  time_entries.member_id;
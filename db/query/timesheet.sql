@@ -0,0 +1,16 @@
+-- name: CreateTimesheet :one
+INSERT INTO timesheets (
+  member_id, week_start, submitted_by
+) VALUES (
+  $1, $2, $3
+)
+RETURNING *;
+
+-- name: GetMemberTimesheet :one
+SELECT * FROM timesheets
+WHERE member_id = $1 AND week_start = $2 LIMIT 1;
+
+-- name: DeleteMemberTimesheet :one
+DELETE FROM timesheets
+WHERE member_id = $1 AND week_start = $2
+RETURNING *;
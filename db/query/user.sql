@@ -3,11 +3,18 @@ INSERT INTO users (
   first_name,
   last_name,
   email,
-  hashed_password
+  hashed_password,
+  is_admin
 ) VALUES (
-  $1, $2, $3, $4
+  $1, $2, $3, $4, $5
 ) RETURNING *;
 
+-- name: LockUserRegistration :exec
+SELECT pg_advisory_xact_lock(hashtext('users.is_admin'));
+
+-- name: CountUsers :one
+SELECT count(*) FROM users;
+
 -- name: GetUser :one
 SELECT * FROM users
 WHERE id = $1 LIMIT 1;
@@ -0,0 +1,23 @@
+-- name: SetMemberTimeZone :one
+UPDATE members
+SET time_zone = sqlc.narg(time_zone),
+    version = version + 1
+WHERE id = sqlc.arg(id) AND deleted_at IS NULL
+RETURNING *;
+
+-- name: CreateMemberWorkingHours :one
+INSERT INTO member_working_hours (
+  member_id, weekday, start_minute, end_minute
+) VALUES (
+  $1, $2, $3, $4
+)
+RETURNING *;
+
+-- name: DeleteMemberWorkingHours :exec
+DELETE FROM member_working_hours
+WHERE member_id = $1;
+
+-- name: ListMemberWorkingHours :many
+SELECT * FROM member_working_hours
+WHERE member_id = ANY(sqlc.arg(member_ids)::uuid[])
+ORDER BY member_id, weekday, start_minute;
@@ -0,0 +1,186 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+)
+
+func requireUniqueViolation(t *testing.T, err error) {
+	pqErr, ok := err.(*pq.Error)
+	require.True(t, ok, err)
+	require.Equal(t, "unique_violation", pqErr.Code.Name())
+}
+
+func TestCheckInAndOutMember(t *testing.T) {
+	t.Parallel()
+
+	tx := beginTransaction(t)
+	defer rollbackTransaction(t, tx)
+
+	testQueries := New(tx)
+	ctx := context.Background()
+
+	location := createRandomLocation(t, testQueries, 14, 5)
+	member := createRandomMember(t, testQueries)
+	checkedInAt := time.Date(2030, 1, 7, 9, 0, 0, 0, time.UTC)
+
+	attendance, err := testQueries.CheckInMember(ctx, CheckInMemberParams{
+		MemberID:    member.ID,
+		LocationID:  uuid.NullUUID{UUID: location.ID, Valid: true},
+		CheckedInAt: checkedInAt,
+	})
+	require.NoError(t, err)
+	require.Equal(t, member.ID, attendance.MemberID)
+	require.False(t, attendance.CheckedOutAt.Valid)
+	require.False(t, attendance.AutoCheckedOut)
+
+	// A member can only be checked in once at a time.
+	_, err = testQueries.CheckInMember(ctx, CheckInMemberParams{
+		MemberID:    member.ID,
+		LocationID:  uuid.NullUUID{UUID: location.ID, Valid: true},
+		CheckedInAt: checkedInAt.Add(time.Hour),
+	})
+	requireUniqueViolation(t, err)
+}
+
+func TestCheckOutMember(t *testing.T) {
+	t.Parallel()
+
+	tx := beginTransaction(t)
+	defer rollbackTransaction(t, tx)
+
+	testQueries := New(tx)
+	ctx := context.Background()
+
+	member := createRandomMember(t, testQueries)
+	checkedInAt := time.Date(2030, 1, 7, 9, 0, 0, 0, time.UTC)
+
+	_, err := testQueries.CheckOutMember(ctx, CheckOutMemberParams{
+		CheckedOutAt: sql.NullTime{Time: checkedInAt, Valid: true},
+		MemberID:     member.ID,
+	})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	_, err = testQueries.CheckInMember(ctx, CheckInMemberParams{MemberID: member.ID, CheckedInAt: checkedInAt})
+	require.NoError(t, err)
+
+	attendance, err := testQueries.CheckOutMember(ctx, CheckOutMemberParams{
+		CheckedOutAt: sql.NullTime{Time: checkedInAt.Add(8 * time.Hour), Valid: true},
+		MemberID:     member.ID,
+	})
+	require.NoError(t, err)
+	require.True(t, checkedInAt.Add(8*time.Hour).Equal(attendance.CheckedOutAt.Time))
+
+	// Once checked out, the member can check in again.
+	_, err = testQueries.CheckInMember(ctx, CheckInMemberParams{MemberID: member.ID, CheckedInAt: checkedInAt.Add(9 * time.Hour)})
+	require.NoError(t, err)
+
+	attendances, err := testQueries.ListMemberAttendances(ctx, ListMemberAttendancesParams{
+		MemberID: member.ID,
+		FromTime: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+		ToTime:   time.Date(2030, 2, 1, 0, 0, 0, 0, time.UTC),
+	})
+	require.NoError(t, err)
+	require.Len(t, attendances, 2)
+	require.Equal(t, attendance.ID, attendances[0].ID)
+	require.False(t, attendances[1].CheckedOutAt.Valid)
+}
+
+func TestAutoCheckOutAttendances(t *testing.T) {
+	t.Parallel()
+
+	tx := beginTransaction(t)
+	defer rollbackTransaction(t, tx)
+
+	testQueries := New(tx)
+	ctx := context.Background()
+
+	early := createRandomMember(t, testQueries)
+	late := createRandomMember(t, testQueries)
+	recent := createRandomMember(t, testQueries)
+
+	// Cutoffs come at 23:00 UTC, and the job last ran on the 9th.
+	cutoffAt := time.Date(2030, 1, 9, 23, 0, 0, 0, time.UTC)
+	for member, checkedInAt := range map[uuid.UUID]time.Time{
+		early.ID:  time.Date(2030, 1, 7, 9, 0, 0, 0, time.UTC),
+		late.ID:   time.Date(2030, 1, 7, 23, 30, 0, 0, time.UTC),
+		recent.ID: cutoffAt.Add(time.Minute),
+	} {
+		_, err := testQueries.CheckInMember(ctx, CheckInMemberParams{MemberID: member, CheckedInAt: checkedInAt})
+		require.NoError(t, err)
+	}
+
+	checkedOut, err := testQueries.AutoCheckOutAttendances(ctx, cutoffAt)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), checkedOut)
+
+	// Every member is checked out at the first cutoff after their check-in.
+	for member, checkedOutAt := range map[uuid.UUID]time.Time{
+		early.ID: time.Date(2030, 1, 7, 23, 0, 0, 0, time.UTC),
+		late.ID:  time.Date(2030, 1, 8, 23, 0, 0, 0, time.UTC),
+	} {
+		attendances, err := testQueries.ListMemberAttendances(ctx, ListMemberAttendancesParams{
+			MemberID: member,
+			FromTime: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+			ToTime:   time.Date(2030, 2, 1, 0, 0, 0, 0, time.UTC),
+		})
+		require.NoError(t, err)
+		require.Len(t, attendances, 1)
+		require.True(t, attendances[0].AutoCheckedOut)
+		require.True(t, checkedOutAt.Equal(attendances[0].CheckedOutAt.Time), attendances[0].CheckedOutAt.Time)
+	}
+
+	presence, err := testQueries.ListPresenceAttendances(ctx, ListPresenceAttendancesParams{
+		Since: time.Date(2030, 1, 10, 0, 0, 0, 0, time.UTC),
+	})
+	require.NoError(t, err)
+	require.Len(t, presence, 1)
+	require.Equal(t, recent.ID, presence[0].MemberID)
+	require.False(t, presence[0].LocationName.Valid)
+}
+
+func TestListAttendanceSummaries(t *testing.T) {
+	t.Parallel()
+
+	tx := beginTransaction(t)
+	defer rollbackTransaction(t, tx)
+
+	testQueries := New(tx)
+	ctx := context.Background()
+
+	member := createRandomMember(t, testQueries)
+	absent := createRandomMember(t, testQueries)
+
+	// Two check-ins on the 7th and one on the 8th, the last one still open.
+	for _, hours := range [][2]int{{9, 12}, {13, 18}, {33, 0}} {
+		checkedInAt := time.Date(2030, 1, 7, hours[0], 0, 0, 0, time.UTC)
+		_, err := testQueries.CheckInMember(ctx, CheckInMemberParams{MemberID: member.ID, CheckedInAt: checkedInAt})
+		require.NoError(t, err)
+		if hours[1] > 0 {
+			_, err = testQueries.CheckOutMember(ctx, CheckOutMemberParams{
+				CheckedOutAt: sql.NullTime{Time: time.Date(2030, 1, 7, hours[1], 0, 0, 0, time.UTC), Valid: true},
+				MemberID:     member.ID,
+			})
+			require.NoError(t, err)
+		}
+	}
+
+	for id, want := range map[uuid.UUID][2]int64{member.ID: {2, 8 * 60 * 60}, absent.ID: {0, 0}} {
+		summaries, err := testQueries.ListAttendanceSummaries(ctx, ListAttendanceSummariesParams{
+			FromTime: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+			ToTime:   time.Date(2030, 2, 1, 0, 0, 0, 0, time.UTC),
+			MemberID: uuid.NullUUID{UUID: id, Valid: true},
+		})
+		require.NoError(t, err)
+		require.Len(t, summaries, 1)
+		require.Equal(t, int32(want[0]), summaries[0].DaysPresent)
+		require.Equal(t, want[1], summaries[0].SecondsPresent)
+		require.Zero(t, summaries[0].AutoCheckouts)
+	}
+}
@@ -0,0 +1,130 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+
+	"github.com/google/uuid"
+)
+
+// Actions recorded in the audit trail.
+const (
+	AuditActionCreate     = "create"
+	AuditActionUpdate     = "update"
+	AuditActionDelete     = "delete"
+	AuditActionRestore    = "restore"
+	AuditActionMerge      = "merge"
+	AuditActionTransition = "transition"
+)
+
+// AuditEntityMember is the entity type of the audit events about members.
+const AuditEntityMember = "member"
+
+// AuditMeta identifies who makes a change and within which request, for the audit trail.
+type AuditMeta struct {
+	ActorID   uuid.UUID
+	RequestID string
+}
+
+// AuditFieldChange holds the values of a field before and after a change.
+// A nil value means that the field was null or that the entity did not exist.
+type AuditFieldChange struct {
+	Before interface{} `json:"before"`
+	After  interface{} `json:"after"`
+}
+
+// memberAuditFields returns the fields of a member tracked by the audit trail.
+func memberAuditFields(member *Member) map[string]interface{} {
+	if member == nil {
+		return nil
+	}
+
+	fields := map[string]interface{}{
+		"first_name": member.FirstName,
+		"last_name":  member.LastName,
+		"email":      nil,
+		"deleted_at": nil,
+		"manager_id": nil,
+		"avatar_url": nil,
+		"status":     member.Status,
+		"start_date": nil,
+		"end_date":   nil,
+		"time_zone":  nil,
+	}
+	if member.Email.Valid {
+		fields["email"] = member.Email.String
+	}
+	if member.DeletedAt.Valid {
+		fields["deleted_at"] = member.DeletedAt.Time
+	}
+	if member.AvatarUrl.Valid {
+		fields["avatar_url"] = member.AvatarUrl.String
+	}
+	if member.ManagerID.Valid {
+		fields["manager_id"] = member.ManagerID.UUID
+	}
+	if member.StartDate.Valid {
+		fields["start_date"] = member.StartDate.Time.Format(DateLayout)
+	}
+	if member.EndDate.Valid {
+		fields["end_date"] = member.EndDate.Time.Format(DateLayout)
+	}
+	if member.TimeZone.Valid {
+		fields["time_zone"] = member.TimeZone.String
+	}
+
+	// Each custom field is tracked on its own, so that the changes only list the fields actually changed.
+	var customFields map[string]json.RawMessage
+	if err := json.Unmarshal(member.CustomFields, &customFields); err == nil {
+		for key, value := range customFields {
+			fields["custom_fields."+key] = value
+		}
+	}
+	return fields
+}
+
+// diffAuditFields returns the fields whose values differ between before and after.
+func diffAuditFields(before, after map[string]interface{}) map[string]AuditFieldChange {
+	changes := map[string]AuditFieldChange{}
+	for key, value := range before {
+		if !reflect.DeepEqual(value, after[key]) {
+			changes[key] = AuditFieldChange{Before: value, After: after[key]}
+		}
+	}
+	for key, value := range after {
+		if _, ok := before[key]; !ok && value != nil {
+			changes[key] = AuditFieldChange{Before: nil, After: value}
+		}
+	}
+	return changes
+}
+
+// createMemberAuditEvent records a change of a member; before is nil for a creation.
+func (q *Queries) createMemberAuditEvent(ctx context.Context, meta AuditMeta, action string, before, after *Member) error {
+	return q.createMemberAuditEventWithChanges(ctx, meta, action, before, after, nil)
+}
+
+// createMemberAuditEventWithChanges records a change of a member together with changes
+// that are not fields of the member, such as the members merged into it.
+func (q *Queries) createMemberAuditEventWithChanges(ctx context.Context, meta AuditMeta, action string, before, after *Member, extra map[string]AuditFieldChange) error {
+	fieldChanges := diffAuditFields(memberAuditFields(before), memberAuditFields(after))
+	for key, change := range extra {
+		fieldChanges[key] = change
+	}
+
+	changes, err := json.Marshal(fieldChanges)
+	if err != nil {
+		return err
+	}
+
+	_, err = q.CreateAuditEvent(ctx, CreateAuditEventParams{
+		ActorID:    uuid.NullUUID{UUID: meta.ActorID, Valid: meta.ActorID != uuid.Nil},
+		Action:     action,
+		EntityType: AuditEntityMember,
+		EntityID:   after.ID,
+		Changes:    changes,
+		RequestID:  meta.RequestID,
+	})
+	return err
+}
@@ -0,0 +1,134 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/ot07/coworker-backend/util"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomAuditEvent(t *testing.T, testQueries *Queries, actorID uuid.UUID, entityID uuid.UUID) AuditEvent {
+	arg := CreateAuditEventParams{
+		ActorID:    uuid.NullUUID{UUID: actorID, Valid: true},
+		Action:     AuditActionUpdate,
+		EntityType: AuditEntityMember,
+		EntityID:   entityID,
+		Changes:    json.RawMessage(`{"first_name": {"before": "Taro", "after": "Jiro"}}`),
+		RequestID:  util.RandomString(16),
+	}
+
+	event, err := testQueries.CreateAuditEvent(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, event)
+
+	require.Equal(t, arg.ActorID, event.ActorID)
+	require.Equal(t, arg.Action, event.Action)
+	require.Equal(t, arg.EntityType, event.EntityType)
+	require.Equal(t, arg.EntityID, event.EntityID)
+	require.JSONEq(t, string(arg.Changes), string(event.Changes))
+	require.Equal(t, arg.RequestID, event.RequestID)
+
+	require.NotEmpty(t, event.ID)
+	require.NotZero(t, event.CreatedAt)
+
+	return event
+}
+
+func TestCreateAuditEvent(t *testing.T) {
+	t.Parallel()
+
+	tx := beginTransaction(t)
+	defer rollbackTransaction(t, tx)
+
+	testQueries := New(tx)
+
+	user := createRandomUser(t, testQueries)
+	createRandomAuditEvent(t, testQueries, user.ID, util.RandomUUID())
+}
+
+func TestListAuditEvents(t *testing.T) {
+	t.Parallel()
+
+	tx := beginTransaction(t)
+	defer rollbackTransaction(t, tx)
+
+	testQueries := New(tx)
+
+	user1 := createRandomUser(t, testQueries)
+	user2 := createRandomUser(t, testQueries)
+	entityID := util.RandomUUID()
+
+	for i := 0; i < 3; i++ {
+		createRandomAuditEvent(t, testQueries, user1.ID, entityID)
+	}
+	createRandomAuditEvent(t, testQueries, user2.ID, entityID)
+	createRandomAuditEvent(t, testQueries, user1.ID, util.RandomUUID())
+
+	arg := ListAuditEventsParams{
+		Limit:    10,
+		Offset:   0,
+		ActorID:  uuid.NullUUID{UUID: user1.ID, Valid: true},
+		EntityID: uuid.NullUUID{UUID: entityID, Valid: true},
+	}
+
+	events, err := testQueries.ListAuditEvents(context.Background(), arg)
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+
+	for _, event := range events {
+		require.Equal(t, user1.ID, event.ActorID.UUID)
+		require.Equal(t, entityID, event.EntityID)
+	}
+
+	count, err := testQueries.CountAuditEvents(context.Background(), CountAuditEventsParams{
+		ActorID:  arg.ActorID,
+		EntityID: arg.EntityID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(3), count)
+
+	count, err = testQueries.CountAuditEvents(context.Background(), CountAuditEventsParams{
+		EntityID:     arg.EntityID,
+		CreatedAfter: sql.NullTime{Time: events[0].CreatedAt.AddDate(0, 0, 1), Valid: true},
+	})
+	require.NoError(t, err)
+	require.Zero(t, count)
+}
+
+func TestCreateMemberAuditEvent(t *testing.T) {
+	t.Parallel()
+
+	tx := beginTransaction(t)
+	defer rollbackTransaction(t, tx)
+
+	testQueries := New(tx)
+
+	user := createRandomUser(t, testQueries)
+	before := createRandomMember(t, testQueries)
+	after := before
+	after.FirstName = util.RandomName()
+
+	meta := AuditMeta{ActorID: user.ID, RequestID: util.RandomString(16)}
+	err := testQueries.createMemberAuditEvent(context.Background(), meta, AuditActionUpdate, &before, &after)
+	require.NoError(t, err)
+
+	events, err := testQueries.ListAuditEvents(context.Background(), ListAuditEventsParams{
+		Limit:    10,
+		Offset:   0,
+		EntityID: uuid.NullUUID{UUID: before.ID, Valid: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+
+	var changes map[string]AuditFieldChange
+	err = json.Unmarshal(events[0].Changes, &changes)
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	require.Equal(t, before.FirstName, changes["first_name"].Before)
+	require.Equal(t, after.FirstName, changes["first_name"].After)
+	require.Equal(t, meta.RequestID, events[0].RequestID)
+}
@@ -0,0 +1,67 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Kinds of checklists generated for members.
+const (
+	ChecklistKindOnboarding  = "onboarding"
+	ChecklistKindOffboarding = "offboarding"
+)
+
+// checklistKindForStatus returns the kind of checklist generated for a member moving to a lifecycle status, if any.
+func checklistKindForStatus(status string) (string, bool) {
+	switch status {
+	case MemberStatusOnboarding:
+		return ChecklistKindOnboarding, true
+	case MemberStatusOffboarded:
+		return ChecklistKindOffboarding, true
+	}
+	return "", false
+}
+
+// createMemberChecklist generates the checklist of a member moving to a lifecycle status from the template of its kind.
+// The tasks are due their offset in days after the effective date of the move.
+func (q *Queries) createMemberChecklist(ctx context.Context, memberID uuid.UUID, status string, effectiveDate time.Time) error {
+	kind, ok := checklistKindForStatus(status)
+	if !ok {
+		return nil
+	}
+	_, err := q.CreateMemberChecklist(ctx, CreateMemberChecklistParams{
+		MemberID:      memberID,
+		EffectiveDate: effectiveDate,
+		Kind:          kind,
+	})
+	return err
+}
+
+// ReplaceChecklistTemplateTx replaces the tasks of the checklist template of a kind within a single database transaction.
+// The kind and the position of every task are filled in from the order they are given.
+func (store *SQLStore) ReplaceChecklistTemplateTx(ctx context.Context, kind string, tasks []CreateChecklistTemplateTaskParams) ([]ChecklistTemplateTask, error) {
+	created := make([]ChecklistTemplateTask, 0, len(tasks))
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		if err := q.DeleteChecklistTemplateTasks(ctx, kind); err != nil {
+			return err
+		}
+		for i, task := range tasks {
+			task.Kind = kind
+			task.Position = int32(i)
+			templateTask, err := q.CreateChecklistTemplateTask(ctx, task)
+			if err != nil {
+				return err
+			}
+			created = append(created, templateTask)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
@@ -0,0 +1,163 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ot07/coworker-backend/util"
+	"github.com/stretchr/testify/require"
+)
+
+// TestMemberChecklists is not run in parallel, since the checklist templates are shared by every member.
+func TestMemberChecklists(t *testing.T) {
+	store := NewStore(testDB)
+	ctx := context.Background()
+
+	hr := createRandomUser(t, store.Queries)
+	it := createRandomUser(t, store.Queries)
+
+	onboarding, err := store.ReplaceChecklistTemplateTx(ctx, ChecklistKindOnboarding, []CreateChecklistTemplateTaskParams{
+		{Title: "Sign the contract", AssigneeID: uuid.NullUUID{UUID: hr.ID, Valid: true}, DueOffsetDays: -3},
+		{Title: "Set up a laptop", AssigneeID: uuid.NullUUID{UUID: it.ID, Valid: true}, DueOffsetDays: 0},
+	})
+	require.NoError(t, err)
+	require.Len(t, onboarding, 2)
+	require.Equal(t, int32(1), onboarding[1].Position)
+	_, err = store.ReplaceChecklistTemplateTx(ctx, ChecklistKindOffboarding, []CreateChecklistTemplateTaskParams{
+		{Title: "Return the laptop", AssigneeID: uuid.NullUUID{UUID: it.ID, Valid: true}, DueOffsetDays: 1},
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		for _, kind := range []string{ChecklistKindOnboarding, ChecklistKindOffboarding} {
+			_, err := store.ReplaceChecklistTemplateTx(ctx, kind, nil)
+			require.NoError(t, err)
+		}
+	})
+
+	// Replacing a template drops its previous tasks.
+	onboarding, err = store.ReplaceChecklistTemplateTx(ctx, ChecklistKindOnboarding, []CreateChecklistTemplateTaskParams{
+		{Title: "Sign the contract", AssigneeID: uuid.NullUUID{UUID: hr.ID, Valid: true}, DueOffsetDays: -3},
+		{Title: "Set up a laptop", AssigneeID: uuid.NullUUID{UUID: it.ID, Valid: true}},
+		{Title: "Meet the team"},
+	})
+	require.NoError(t, err)
+	templateTasks, err := store.ListChecklistTemplateTasks(ctx, ChecklistKindOnboarding)
+	require.NoError(t, err)
+	require.Equal(t, onboarding, templateTasks)
+
+	startDate := time.Date(2023, 4, 3, 0, 0, 0, 0, time.UTC)
+	member, err := store.CreateMemberTx(ctx, CreateMemberTxParams{
+		CreateMemberParams: CreateMemberParams{
+			FirstName: util.RandomName(),
+			LastName:  util.RandomName(),
+			Status:    MemberStatusOnboarding,
+			StartDate: sql.NullTime{Time: startDate, Valid: true},
+		},
+	}, AuditMeta{})
+	require.NoError(t, err)
+
+	tasks, err := store.ListMemberChecklistTasks(ctx, ListMemberChecklistTasksParams{MemberID: member.ID})
+	require.NoError(t, err)
+	require.Len(t, tasks, 3)
+	require.Equal(t, "Sign the contract", tasks[0].Title)
+	require.Equal(t, ChecklistKindOnboarding, tasks[0].Kind)
+	require.True(t, startDate.AddDate(0, 0, -3).Equal(tasks[0].DueDate))
+	require.Equal(t, uuid.NullUUID{UUID: hr.ID, Valid: true}, tasks[0].AssigneeID)
+	require.True(t, startDate.Equal(tasks[1].DueDate))
+	require.False(t, tasks[2].AssigneeID.Valid)
+
+	// The tasks open past their due date are flagged once.
+	marked, err := store.MarkOverdueChecklistTasks(ctx, startDate.AddDate(0, 0, 1))
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, marked, int64(3))
+	marked, err = store.MarkOverdueChecklistTasks(ctx, startDate.AddDate(0, 0, 1))
+	require.NoError(t, err)
+	require.Zero(t, marked)
+
+	completed, err := store.CompleteMemberChecklistTask(ctx, CompleteMemberChecklistTaskParams{
+		ID:          tasks[0].ID,
+		CompletedBy: uuid.NullUUID{UUID: hr.ID, Valid: true},
+	})
+	require.NoError(t, err)
+	require.True(t, completed.CompletedAt.Valid)
+	require.Equal(t, uuid.NullUUID{UUID: hr.ID, Valid: true}, completed.CompletedBy)
+	require.True(t, completed.OverdueAt.Valid)
+
+	// Completing a completed task keeps who completed it first.
+	again, err := store.CompleteMemberChecklistTask(ctx, CompleteMemberChecklistTaskParams{
+		ID:          tasks[0].ID,
+		CompletedBy: uuid.NullUUID{UUID: it.ID, Valid: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, completed.CompletedBy, again.CompletedBy)
+	require.True(t, completed.CompletedAt.Time.Equal(again.CompletedAt.Time))
+
+	reassigned, err := store.ReassignMemberChecklistTask(ctx, ReassignMemberChecklistTaskParams{
+		ID:         tasks[2].ID,
+		AssigneeID: uuid.NullUUID{UUID: hr.ID, Valid: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, uuid.NullUUID{UUID: hr.ID, Valid: true}, reassigned.AssigneeID)
+
+	_, err = store.ReassignMemberChecklistTask(ctx, ReassignMemberChecklistTaskParams{
+		ID:         tasks[2].ID,
+		AssigneeID: uuid.NullUUID{UUID: util.RandomUUID(), Valid: true},
+	})
+	require.Error(t, err)
+
+	open, err := store.ListOpenChecklistTasksByAssignee(ctx, uuid.NullUUID{UUID: hr.ID, Valid: true})
+	require.NoError(t, err)
+	require.Len(t, open, 1)
+	require.Equal(t, tasks[2].ID, open[0].ID)
+
+	// Offboarding generates the offboarding checklist, due from the end date.
+	endDate := time.Date(2024, 3, 29, 0, 0, 0, 0, time.UTC)
+	_, err = store.TransitionMemberTx(ctx, TransitionMemberTxParams{
+		ID:            member.ID,
+		Status:        MemberStatusOffboarded,
+		EffectiveDate: endDate,
+	}, AuditMeta{})
+	require.NoError(t, err)
+
+	tasks, err = store.ListMemberChecklistTasks(ctx, ListMemberChecklistTasksParams{
+		MemberID: member.ID,
+		Kind:     sql.NullString{String: ChecklistKindOffboarding, Valid: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+	require.Equal(t, "Return the laptop", tasks[0].Title)
+	require.True(t, endDate.AddDate(0, 0, 1).Equal(tasks[0].DueDate))
+
+	open, err = store.ListOpenChecklistTasksByAssignee(ctx, uuid.NullUUID{UUID: it.ID, Valid: true})
+	require.NoError(t, err)
+	require.Len(t, open, 2)
+
+	// The tasks of members in the trash are left out.
+	_, err = store.DeleteMembers(ctx, []uuid.UUID{member.ID})
+	require.NoError(t, err)
+	open, err = store.ListOpenChecklistTasksByAssignee(ctx, uuid.NullUUID{UUID: it.ID, Valid: true})
+	require.NoError(t, err)
+	require.Empty(t, open)
+}
+
+func TestCreateMemberWithoutChecklist(t *testing.T) {
+	t.Parallel()
+
+	store := NewStore(testDB)
+	ctx := context.Background()
+
+	member, err := store.CreateMemberTx(ctx, CreateMemberTxParams{
+		CreateMemberParams: CreateMemberParams{
+			FirstName: util.RandomName(),
+			LastName:  util.RandomName(),
+		},
+	}, AuditMeta{})
+	require.NoError(t, err)
+
+	tasks, err := store.ListMemberChecklistTasks(ctx, ListMemberChecklistTasksParams{MemberID: member.ID})
+	require.NoError(t, err)
+	require.Empty(t, tasks)
+}
@@ -0,0 +1,144 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/ot07/coworker-backend/util"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomCustomFieldDefinition(t *testing.T, testQueries *Queries) CustomFieldDefinition {
+	arg := CreateCustomFieldDefinitionParams{
+		Key:       "field_" + util.RandomString(8),
+		Label:     util.RandomName(),
+		Type:      "text",
+		Required:  false,
+		Options:   []string{},
+		MaxLength: sql.NullInt32{Int32: 20, Valid: true},
+	}
+
+	definition, err := testQueries.CreateCustomFieldDefinition(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, definition)
+
+	require.Equal(t, arg.Key, definition.Key)
+	require.Equal(t, arg.Label, definition.Label)
+	require.Equal(t, arg.Type, definition.Type)
+	require.Equal(t, arg.Required, definition.Required)
+	require.Empty(t, definition.Options)
+	require.Equal(t, arg.MaxLength, definition.MaxLength)
+	require.False(t, definition.MinLength.Valid)
+
+	require.NotEmpty(t, definition.ID)
+	require.NotZero(t, definition.CreatedAt)
+
+	return definition
+}
+
+func TestCreateCustomFieldDefinition(t *testing.T) {
+	t.Parallel()
+
+	tx := beginTransaction(t)
+	defer rollbackTransaction(t, tx)
+
+	testQueries := New(tx)
+
+	createRandomCustomFieldDefinition(t, testQueries)
+}
+
+func TestCreateCustomFieldDefinitionInvalidKey(t *testing.T) {
+	t.Parallel()
+
+	tx := beginTransaction(t)
+	defer rollbackTransaction(t, tx)
+
+	testQueries := New(tx)
+
+	_, err := testQueries.CreateCustomFieldDefinition(context.Background(), CreateCustomFieldDefinitionParams{
+		Key:     "Invalid Key",
+		Label:   util.RandomName(),
+		Type:    "text",
+		Options: []string{},
+	})
+	require.Error(t, err)
+}
+
+func TestListCustomFieldDefinitions(t *testing.T) {
+	t.Parallel()
+
+	tx := beginTransaction(t)
+	defer rollbackTransaction(t, tx)
+
+	testQueries := New(tx)
+
+	definition1 := createRandomCustomFieldDefinition(t, testQueries)
+	definition2 := createRandomCustomFieldDefinition(t, testQueries)
+
+	definitions, err := testQueries.ListCustomFieldDefinitions(context.Background())
+	require.NoError(t, err)
+	require.Len(t, definitions, 2)
+	require.Equal(t, definition1.ID, definitions[0].ID)
+	require.Equal(t, definition2.ID, definitions[1].ID)
+}
+
+func TestUpdateCustomFieldDefinition(t *testing.T) {
+	t.Parallel()
+
+	tx := beginTransaction(t)
+	defer rollbackTransaction(t, tx)
+
+	testQueries := New(tx)
+
+	definition1 := createRandomCustomFieldDefinition(t, testQueries)
+
+	arg := UpdateCustomFieldDefinitionParams{
+		ID:       definition1.ID,
+		Label:    util.RandomName(),
+		Required: true,
+		Options:  []string{},
+		Pattern:  sql.NullString{String: `^E-\d+$`, Valid: true},
+	}
+
+	definition2, err := testQueries.UpdateCustomFieldDefinition(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, definition1.Key, definition2.Key)
+	require.Equal(t, definition1.Type, definition2.Type)
+	require.Equal(t, arg.Label, definition2.Label)
+	require.True(t, definition2.Required)
+	require.Equal(t, arg.Pattern, definition2.Pattern)
+	require.False(t, definition2.MaxLength.Valid)
+}
+
+func TestDeleteCustomFieldDefinitionRemovesMemberValues(t *testing.T) {
+	t.Parallel()
+
+	tx := beginTransaction(t)
+	defer rollbackTransaction(t, tx)
+
+	testQueries := New(tx)
+
+	definition := createRandomCustomFieldDefinition(t, testQueries)
+	member1, err := testQueries.CreateMember(context.Background(), CreateMemberParams{
+		FirstName:    util.RandomName(),
+		LastName:     util.RandomName(),
+		CustomFields: `{"` + definition.Key + `": "E-1", "other": "kept"}`,
+	})
+	require.NoError(t, err)
+
+	_, err = testQueries.DeleteCustomFieldDefinition(context.Background(), definition.ID)
+	require.NoError(t, err)
+
+	_, err = testQueries.GetCustomFieldDefinition(context.Background(), definition.ID)
+	require.EqualError(t, err, sql.ErrNoRows.Error())
+
+	rows, err := testQueries.RemoveMembersCustomField(context.Background(), definition.Key)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), rows)
+
+	member2, err := testQueries.GetMember(context.Background(), member1.ID)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"other": "kept"}`, string(member2.CustomFields))
+	require.Equal(t, member1.Version+1, member2.Version)
+}
@@ -0,0 +1,94 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Slots of a day a desk can be booked for.
+const (
+	DeskSlotMorning   = "morning"
+	DeskSlotAfternoon = "afternoon"
+	DeskSlotFullDay   = "full_day"
+)
+
+// DeskSlots lists the slots of a day a desk can be booked for.
+var DeskSlots = []string{DeskSlotMorning, DeskSlotAfternoon, DeskSlotFullDay}
+
+// DeskSlotsOverlap tells whether two slots of the same day overlap.
+func DeskSlotsOverlap(a, b string) bool {
+	return a == b || a == DeskSlotFullDay || b == DeskSlotFullDay
+}
+
+// ErrBookingInPast is returned when a desk is booked for a day that has gone by.
+var ErrBookingInPast = errors.New("a desk cannot be booked for a past day")
+
+// ErrBookingTooFarAhead is returned when a desk is booked further ahead than its location allows.
+var ErrBookingTooFarAhead = errors.New("a desk cannot be booked that far ahead")
+
+// ErrBookingWeeklyLimit is returned when a member already holds as many bookings in the week as their location allows.
+var ErrBookingWeeklyLimit = errors.New("the weekly limit of desk bookings is reached")
+
+// BookDeskTxParams contains the input parameters of BookDeskTx.
+type BookDeskTxParams struct {
+	DeskID   uuid.UUID
+	MemberID uuid.UUID
+	Date     time.Time
+	Slot     string
+	// Today is the day the booking is made on, from which the rules of the location are applied.
+	Today time.Time
+}
+
+// BookDeskTx books a desk for a member within a single database transaction, applying the booking rules of the location of the desk.
+// The location is locked, so that bookings made at the same time cannot exceed the weekly limit together.
+// sql.ErrNoRows is returned when the desk does not exist, or when the member does not exist or is in the trash.
+// A booking overlapping another one of the desk or of the member violates an exclusion constraint.
+func (store *SQLStore) BookDeskTx(ctx context.Context, arg BookDeskTxParams) (DeskBooking, error) {
+	var booking DeskBooking
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		location, err := q.GetDeskLocationForUpdate(ctx, arg.DeskID)
+		if err != nil {
+			return err
+		}
+		if _, err := q.GetMember(ctx, arg.MemberID); err != nil {
+			return err
+		}
+
+		if arg.Date.Before(arg.Today) {
+			return ErrBookingInPast
+		}
+		if arg.Date.After(arg.Today.AddDate(0, 0, int(location.MaxAdvanceDays))) {
+			return fmt.Errorf("%w: at most %d days ahead", ErrBookingTooFarAhead, location.MaxAdvanceDays)
+		}
+
+		count, err := q.CountMemberWeekDeskBookings(ctx, CountMemberWeekDeskBookingsParams{
+			MemberID:   arg.MemberID,
+			LocationID: location.ID,
+			Date:       arg.Date,
+		})
+		if err != nil {
+			return err
+		}
+		if count >= int64(location.MaxBookingsPerWeek) {
+			return fmt.Errorf("%w: at most %d bookings a week", ErrBookingWeeklyLimit, location.MaxBookingsPerWeek)
+		}
+
+		booking, err = q.CreateDeskBooking(ctx, CreateDeskBookingParams{
+			DeskID:   arg.DeskID,
+			MemberID: arg.MemberID,
+			Date:     arg.Date,
+			Slot:     arg.Slot,
+		})
+		return err
+	})
+	if err != nil {
+		return DeskBooking{}, err
+	}
+
+	return booking, nil
+}
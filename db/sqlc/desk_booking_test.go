@@ -0,0 +1,115 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/ot07/coworker-backend/util"
+	"github.com/stretchr/testify/require"
+)
+
+func requireExclusionViolation(t *testing.T, err error) {
+	pqErr, ok := err.(*pq.Error)
+	require.True(t, ok, err)
+	require.Equal(t, "exclusion_violation", pqErr.Code.Name())
+}
+
+func TestBookDeskTx(t *testing.T) {
+	t.Parallel()
+
+	store := NewStore(testDB)
+	ctx := context.Background()
+
+	location := createRandomLocation(t, store.Queries, 14, 5)
+	floor := createRandomFloor(t, store.Queries, location, 0)
+	desk := createRandomDesk(t, store.Queries, floor)
+	member := createRandomMember(t, store.Queries)
+	other := createRandomMember(t, store.Queries)
+
+	// A Monday, so that the days booked below fall within the same week.
+	today := time.Date(2030, 1, 7, 0, 0, 0, 0, time.UTC)
+
+	morning, err := store.BookDeskTx(ctx, BookDeskTxParams{DeskID: desk.ID, MemberID: member.ID, Date: today, Slot: DeskSlotMorning, Today: today})
+	require.NoError(t, err)
+	require.Equal(t, desk.ID, morning.DeskID)
+	require.Equal(t, member.ID, morning.MemberID)
+	require.Equal(t, today.Format(DateLayout), morning.Date.Format(DateLayout))
+	require.Equal(t, DeskSlotMorning, morning.Slot)
+	require.False(t, morning.CanceledAt.Valid)
+
+	// The afternoon of the desk is still free, but not the full day.
+	_, err = store.BookDeskTx(ctx, BookDeskTxParams{DeskID: desk.ID, MemberID: other.ID, Date: today, Slot: DeskSlotFullDay, Today: today})
+	requireExclusionViolation(t, err)
+	afternoon, err := store.BookDeskTx(ctx, BookDeskTxParams{DeskID: desk.ID, MemberID: other.ID, Date: today, Slot: DeskSlotAfternoon, Today: today})
+	require.NoError(t, err)
+
+	// A member cannot hold two desks at the same time.
+	otherDesk := createRandomDesk(t, store.Queries, floor)
+	_, err = store.BookDeskTx(ctx, BookDeskTxParams{DeskID: otherDesk.ID, MemberID: member.ID, Date: today, Slot: DeskSlotFullDay, Today: today})
+	requireExclusionViolation(t, err)
+
+	bookings, err := store.ListLocationDeskBookings(ctx, ListLocationDeskBookingsParams{LocationID: location.ID, Date: today})
+	require.NoError(t, err)
+	require.Len(t, bookings, 2)
+	require.Equal(t, morning.ID, bookings[0].ID)
+	require.Equal(t, afternoon.ID, bookings[1].ID)
+
+	// Canceling a booking frees the desk.
+	canceled, err := store.CancelDeskBooking(ctx, afternoon.ID)
+	require.NoError(t, err)
+	require.True(t, canceled.CanceledAt.Valid)
+	_, err = store.CancelDeskBooking(ctx, afternoon.ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	_, err = store.BookDeskTx(ctx, BookDeskTxParams{DeskID: desk.ID, MemberID: member.ID, Date: today, Slot: DeskSlotAfternoon, Today: today})
+	require.NoError(t, err)
+
+	bookings, err = store.ListMemberDeskBookings(ctx, ListMemberDeskBookingsParams{
+		MemberID: member.ID,
+		FromDate: today,
+		ToDate:   today.AddDate(0, 0, 1),
+	})
+	require.NoError(t, err)
+	require.Len(t, bookings, 2)
+	require.Equal(t, DeskSlotMorning, bookings[0].Slot)
+	require.Equal(t, DeskSlotAfternoon, bookings[1].Slot)
+}
+
+func TestBookDeskTxRules(t *testing.T) {
+	t.Parallel()
+
+	store := NewStore(testDB)
+	ctx := context.Background()
+
+	location := createRandomLocation(t, store.Queries, 7, 2)
+	floor := createRandomFloor(t, store.Queries, location, 0)
+	desk := createRandomDesk(t, store.Queries, floor)
+	member := createRandomMember(t, store.Queries)
+
+	today := time.Date(2030, 1, 7, 0, 0, 0, 0, time.UTC)
+	book := func(date time.Time) error {
+		_, err := store.BookDeskTx(ctx, BookDeskTxParams{DeskID: desk.ID, MemberID: member.ID, Date: date, Slot: DeskSlotFullDay, Today: today})
+		return err
+	}
+
+	require.ErrorIs(t, book(today.AddDate(0, 0, -1)), ErrBookingInPast)
+	require.ErrorIs(t, book(today.AddDate(0, 0, 8)), ErrBookingTooFarAhead)
+
+	require.NoError(t, book(today))
+	require.NoError(t, book(today.AddDate(0, 0, 1)))
+	require.ErrorIs(t, book(today.AddDate(0, 0, 2)), ErrBookingWeeklyLimit)
+
+	// The limit applies to every week on its own, and the last day ahead allowed can be booked.
+	require.NoError(t, book(today.AddDate(0, 0, 7)))
+
+	_, err := store.BookDeskTx(ctx, BookDeskTxParams{DeskID: util.RandomUUID(), MemberID: member.ID, Date: today, Slot: DeskSlotFullDay, Today: today})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	_, err = store.DeleteMembers(ctx, []uuid.UUID{member.ID})
+	require.NoError(t, err)
+	require.ErrorIs(t, book(today.AddDate(0, 0, 8)), sql.ErrNoRows)
+}
@@ -0,0 +1,331 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Statuses of a leave request.
+const (
+	LeaveStatusPending  = "pending"
+	LeaveStatusApproved = "approved"
+	LeaveStatusRejected = "rejected"
+	LeaveStatusCanceled = "canceled"
+)
+
+// ErrNoLeaveDays is returned for a leave that does not take any working day.
+var ErrNoLeaveDays = errors.New("the leave does not take any working day")
+
+// ErrInsufficientLeaveBalance is returned when the balance of a leave type cannot take the days of a leave.
+var ErrInsufficientLeaveBalance = errors.New("the leave balance is insufficient")
+
+// ErrLeaveRequestDecided is returned when deciding on a leave request that is no longer pending.
+var ErrLeaveRequestDecided = errors.New("the leave request has already been decided")
+
+// ErrNotLeaveApprover is returned when a user who is neither an admin nor the manager of the member decides on a leave request.
+var ErrNotLeaveApprover = errors.New("only an admin or the manager of the member can decide on the leave request")
+
+// leaveDaysByYear counts the working days a leave takes in every year it spans. Working days run from Monday to Friday,
+// and a half day at either end of the leave counts as half a day.
+func leaveDaysByYear(startDate time.Time, startHalfDay bool, endDate time.Time, endHalfDay bool) map[int]float64 {
+	days := make(map[int]float64)
+	for date := startDate; !date.After(endDate); date = date.AddDate(0, 0, 1) {
+		if date.Weekday() == time.Saturday || date.Weekday() == time.Sunday {
+			continue
+		}
+		day := 1.0
+		if startHalfDay && date.Equal(startDate) {
+			day -= 0.5
+		}
+		if endHalfDay && date.Equal(endDate) {
+			day -= 0.5
+		}
+		days[date.Year()] += day
+	}
+	return days
+}
+
+// LeaveDays counts the working days, from Monday to Friday, a leave takes.
+func LeaveDays(startDate time.Time, startHalfDay bool, endDate time.Time, endHalfDay bool) float64 {
+	var total float64
+	for _, days := range leaveDaysByYear(startDate, startHalfDay, endDate, endHalfDay) {
+		total += days
+	}
+	return total
+}
+
+// LeaveBalance is the balance of a leave type counted against an annual allowance, for a member in a year.
+type LeaveBalance struct {
+	LeaveType LeaveType
+	Year      int
+	Allowance float64
+	// CarriedOver is what was left of the year before, up to the maximum carry-over of the leave type.
+	CarriedOver float64
+	// Used counts the days of the approved leaves in the year, and Pending those of the leaves waiting for a decision.
+	Used    float64
+	Pending float64
+	// Remaining is what is left once the approved leaves are taken, Allowance + CarriedOver - Used.
+	Remaining float64
+}
+
+// leaveBalanceSince returns the first year a member has an allowance of a leave type:
+// the year the member started, or joined the directory, unless the leave type was created later.
+func leaveBalanceSince(member Member, leaveType LeaveType) int {
+	since := member.CreatedAt.Year()
+	if member.StartDate.Valid {
+		since = member.StartDate.Time.Year()
+	}
+	if year := leaveType.CreatedAt.Year(); year > since {
+		since = year
+	}
+	return since
+}
+
+// computeLeaveBalance computes the balance of a leave type in a year from the active leave requests of a member.
+// The allowance starts in the year since, and every year from then on carries what is left over to the next one.
+// Leaves spanning several years are counted in each year for the days they take in it.
+func computeLeaveBalance(leaveType LeaveType, since, year int, requests []LeaveRequest) LeaveBalance {
+	used := make(map[int]float64)
+	pending := make(map[int]float64)
+	for _, request := range requests {
+		for y, days := range leaveDaysByYear(request.StartDate, request.StartHalfDay, request.EndDate, request.EndHalfDay) {
+			switch request.Status {
+			case LeaveStatusApproved:
+				used[y] += days
+				if y < since {
+					since = y
+				}
+			case LeaveStatusPending:
+				pending[y] += days
+			}
+		}
+	}
+
+	allowance := leaveType.AnnualAllowance.Float64
+	var carriedOver float64
+	for y := since; y < year; y++ {
+		carriedOver = math.Min(leaveType.MaxCarryOver, math.Max(allowance+carriedOver-used[y], 0))
+	}
+
+	return LeaveBalance{
+		LeaveType:   leaveType,
+		Year:        year,
+		Allowance:   allowance,
+		CarriedOver: carriedOver,
+		Used:        used[year],
+		Pending:     pending[year],
+		Remaining:   allowance + carriedOver - used[year],
+	}
+}
+
+// ListLeaveBalances lists the balances of a member in a year, for every leave type counted against an annual allowance.
+// sql.ErrNoRows is returned when the member does not exist.
+func (store *SQLStore) ListLeaveBalances(ctx context.Context, memberID uuid.UUID, year int) ([]LeaveBalance, error) {
+	member, err := store.GetMember(ctx, memberID)
+	if err != nil {
+		return nil, err
+	}
+	leaveTypes, err := store.ListLeaveTypes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	balances := []LeaveBalance{}
+	for _, leaveType := range leaveTypes {
+		if !leaveType.AnnualAllowance.Valid {
+			continue
+		}
+		requests, err := store.ListMemberActiveLeaveRequests(ctx, ListMemberActiveLeaveRequestsParams{
+			MemberID:    memberID,
+			LeaveTypeID: leaveType.ID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		balances = append(balances, computeLeaveBalance(leaveType, leaveBalanceSince(member, leaveType), year, requests))
+	}
+	return balances, nil
+}
+
+// checkLeaveBalance checks that the balance of every year a leave spans can take the days the leave takes in it.
+// The days of the pending leaves of the member are held back as well when countPending is set.
+// Leave types without an annual allowance are not counted, so any leave fits them.
+func (q *Queries) checkLeaveBalance(ctx context.Context, member Member, leaveType LeaveType, leave LeaveRequest, countPending bool) error {
+	if !leaveType.AnnualAllowance.Valid {
+		return nil
+	}
+
+	requests, err := q.ListMemberActiveLeaveRequests(ctx, ListMemberActiveLeaveRequestsParams{
+		MemberID:    member.ID,
+		LeaveTypeID: leaveType.ID,
+	})
+	if err != nil {
+		return err
+	}
+	// The leave itself is left out of the balance it is checked against.
+	others := make([]LeaveRequest, 0, len(requests))
+	for _, request := range requests {
+		if request.ID != leave.ID {
+			others = append(others, request)
+		}
+	}
+
+	daysByYear := leaveDaysByYear(leave.StartDate, leave.StartHalfDay, leave.EndDate, leave.EndHalfDay)
+	years := make([]int, 0, len(daysByYear))
+	for year := range daysByYear {
+		years = append(years, year)
+	}
+	sort.Ints(years)
+
+	since := leaveBalanceSince(member, leaveType)
+	for _, year := range years {
+		balance := computeLeaveBalance(leaveType, since, year, others)
+		available := balance.Remaining
+		if countPending {
+			available -= balance.Pending
+		}
+		if daysByYear[year] > available {
+			return fmt.Errorf("%w: %g %s days left in %d", ErrInsufficientLeaveBalance, math.Max(available, 0), leaveType.Name, year)
+		}
+	}
+	return nil
+}
+
+// CreateLeaveRequestTx requests a leave for a member within a single database transaction, after checking that the balance
+// of the leave type can take it along with the other pending leaves of the member. The member is locked, so that leaves
+// requested at the same time are checked one after the other.
+// sql.ErrNoRows is returned when the member does not exist or is in the trash, or when the leave type does not exist.
+// ErrNoLeaveDays is returned for a leave that does not take any working day.
+func (store *SQLStore) CreateLeaveRequestTx(ctx context.Context, arg CreateLeaveRequestParams) (LeaveRequest, error) {
+	var request LeaveRequest
+
+	arg.Days = LeaveDays(arg.StartDate, arg.StartHalfDay, arg.EndDate, arg.EndHalfDay)
+	if arg.Days <= 0 {
+		return LeaveRequest{}, ErrNoLeaveDays
+	}
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		member, err := q.GetMemberForUpdate(ctx, arg.MemberID)
+		if err != nil {
+			return err
+		}
+		if member.DeletedAt.Valid {
+			return sql.ErrNoRows
+		}
+		leaveType, err := q.GetLeaveType(ctx, arg.LeaveTypeID)
+		if err != nil {
+			return err
+		}
+
+		err = q.checkLeaveBalance(ctx, member, leaveType, LeaveRequest{
+			StartDate:    arg.StartDate,
+			StartHalfDay: arg.StartHalfDay,
+			EndDate:      arg.EndDate,
+			EndHalfDay:   arg.EndHalfDay,
+		}, true)
+		if err != nil {
+			return err
+		}
+
+		request, err = q.CreateLeaveRequest(ctx, arg)
+		return err
+	})
+	if err != nil {
+		return LeaveRequest{}, err
+	}
+
+	return request, nil
+}
+
+// DecideLeaveRequestTxParams contains the input parameters of DecideLeaveRequestTx.
+type DecideLeaveRequestTxParams struct {
+	ID uuid.UUID
+	// UserID is the user deciding, who must be an admin or the manager of the member.
+	UserID uuid.UUID
+	// Status is either LeaveStatusApproved or LeaveStatusRejected.
+	Status       string
+	DecisionNote string
+}
+
+// DecideLeaveRequestTx approves or rejects a pending leave request within a single database transaction.
+// A user decides as the manager of the member when their email is the email of the manager.
+// A leave is approved only if the balance of its leave type can still take it.
+// sql.ErrNoRows is returned when the leave request or the user does not exist.
+func (store *SQLStore) DecideLeaveRequestTx(ctx context.Context, arg DecideLeaveRequestTxParams) (LeaveRequest, error) {
+	var request LeaveRequest
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+		request, err = q.GetLeaveRequestForUpdate(ctx, arg.ID)
+		if err != nil {
+			return err
+		}
+		member, err := q.GetMemberForUpdate(ctx, request.MemberID)
+		if err != nil {
+			return err
+		}
+		if request.Status != LeaveStatusPending {
+			return fmt.Errorf("%w: it is %s", ErrLeaveRequestDecided, request.Status)
+		}
+
+		user, err := q.GetUser(ctx, arg.UserID)
+		if err != nil {
+			return err
+		}
+		if !user.IsAdmin {
+			isManager, err := q.isMemberManager(ctx, member, user)
+			if err != nil {
+				return err
+			}
+			if !isManager {
+				return ErrNotLeaveApprover
+			}
+		}
+
+		if arg.Status == LeaveStatusApproved {
+			leaveType, err := q.GetLeaveType(ctx, request.LeaveTypeID)
+			if err != nil {
+				return err
+			}
+			if err := q.checkLeaveBalance(ctx, member, leaveType, request, false); err != nil {
+				return err
+			}
+		}
+
+		request, err = q.DecideLeaveRequest(ctx, DecideLeaveRequestParams{
+			Status:       arg.Status,
+			DecidedBy:    uuid.NullUUID{UUID: user.ID, Valid: true},
+			DecisionNote: arg.DecisionNote,
+			ID:           request.ID,
+		})
+		return err
+	})
+	if err != nil {
+		return LeaveRequest{}, err
+	}
+
+	return request, nil
+}
+
+// isMemberManager tells whether a user is the manager of a member: whether the email of the user is the email of the manager.
+func (q *Queries) isMemberManager(ctx context.Context, member Member, user User) (bool, error) {
+	if !member.ManagerID.Valid {
+		return false, nil
+	}
+	manager, err := q.GetMember(ctx, member.ManagerID.UUID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return manager.Email.Valid && strings.EqualFold(manager.Email.String, user.Email), nil
+}
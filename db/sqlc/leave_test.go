@@ -0,0 +1,351 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ot07/coworker-backend/util"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomLeaveType(t *testing.T, testQueries *Queries, annualAllowance sql.NullFloat64) LeaveType {
+	leaveType, err := testQueries.CreateLeaveType(context.Background(), CreateLeaveTypeParams{
+		Name:            util.RandomName(),
+		AnnualAllowance: annualAllowance,
+		MaxCarryOver:    5,
+	})
+	require.NoError(t, err)
+	return leaveType
+}
+
+// leaveTestMonday returns the first Monday of June of the current year, so that balances start that year.
+func leaveTestMonday() time.Time {
+	date := time.Date(time.Now().Year(), time.June, 1, 0, 0, 0, 0, time.UTC)
+	for date.Weekday() != time.Monday {
+		date = date.AddDate(0, 0, 1)
+	}
+	return date
+}
+
+func TestLeaveDays(t *testing.T) {
+	t.Parallel()
+
+	monday := time.Date(2023, 8, 7, 0, 0, 0, 0, time.UTC)
+	friday := monday.AddDate(0, 0, 4)
+
+	require.Equal(t, 5.0, LeaveDays(monday, false, friday, false))
+	require.Equal(t, 4.0, LeaveDays(monday, true, friday, true))
+	require.Equal(t, 0.5, LeaveDays(monday, true, monday, false))
+	require.Equal(t, 0.0, LeaveDays(monday, true, monday, true))
+	require.Equal(t, 0.0, LeaveDays(friday.AddDate(0, 0, 1), false, friday.AddDate(0, 0, 2), false))
+	// A half day on a weekend takes nothing off.
+	require.Equal(t, 5.0, LeaveDays(monday.AddDate(0, 0, -1), true, friday, false))
+
+	// A leave spanning the new year is split between both years.
+	days := leaveDaysByYear(time.Date(2023, 12, 29, 0, 0, 0, 0, time.UTC), false, time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), true)
+	require.Equal(t, map[int]float64{2023: 1, 2024: 1.5}, days)
+}
+
+func TestComputeLeaveBalance(t *testing.T) {
+	t.Parallel()
+
+	leaveType := LeaveType{
+		ID:              util.RandomUUID(),
+		AnnualAllowance: sql.NullFloat64{Float64: 20, Valid: true},
+		MaxCarryOver:    5,
+	}
+	leave := func(start, end time.Time, status string) LeaveRequest {
+		return LeaveRequest{StartDate: start, EndDate: end, Status: status}
+	}
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	requests := []LeaveRequest{
+		// 10 days in 2021, so 5 of the 10 left are carried over.
+		leave(date(2021, 3, 1), date(2021, 3, 12), LeaveStatusApproved),
+		// 22 days in 2022, which with the day below leaves 2 to carry over.
+		leave(date(2022, 3, 1), date(2022, 3, 30), LeaveStatusApproved),
+		// 1 day in 2022 and 2 days in 2023.
+		leave(date(2022, 12, 30), date(2023, 1, 3), LeaveStatusApproved),
+		leave(date(2023, 2, 6), date(2023, 2, 7), LeaveStatusPending),
+	}
+
+	balance := computeLeaveBalance(leaveType, 2021, 2021, requests)
+	require.Equal(t, 0.0, balance.CarriedOver)
+	require.Equal(t, 10.0, balance.Used)
+	require.Equal(t, 10.0, balance.Remaining)
+
+	balance = computeLeaveBalance(leaveType, 2021, 2022, requests)
+	require.Equal(t, 5.0, balance.CarriedOver)
+	require.Equal(t, 23.0, balance.Used)
+	require.Equal(t, 2.0, balance.Remaining)
+
+	balance = computeLeaveBalance(leaveType, 2021, 2023, requests)
+	require.Equal(t, leaveType, balance.LeaveType)
+	require.Equal(t, 2023, balance.Year)
+	require.Equal(t, 20.0, balance.Allowance)
+	require.Equal(t, 2.0, balance.CarriedOver)
+	require.Equal(t, 2.0, balance.Used)
+	require.Equal(t, 2.0, balance.Pending)
+	require.Equal(t, 20.0, balance.Remaining)
+
+	// Leaves taken before the year the allowance starts are counted from then.
+	balance = computeLeaveBalance(leaveType, 2023, 2023, requests)
+	require.Equal(t, 2.0, balance.CarriedOver)
+
+	// Nothing is carried over from a year overdrawn.
+	balance = computeLeaveBalance(leaveType, 2022, 2023, requests[1:2])
+	require.Equal(t, 0.0, balance.CarriedOver)
+}
+
+func TestCreateLeaveRequestTx(t *testing.T) {
+	t.Parallel()
+
+	store := NewStore(testDB)
+	ctx := context.Background()
+
+	member := createRandomMember(t, store.Queries)
+	vacation := createRandomLeaveType(t, store.Queries, sql.NullFloat64{Float64: 4, Valid: true})
+	remote := createRandomLeaveType(t, store.Queries, sql.NullFloat64{})
+	monday := leaveTestMonday()
+
+	request, err := store.CreateLeaveRequestTx(ctx, CreateLeaveRequestParams{
+		MemberID:    member.ID,
+		LeaveTypeID: vacation.ID,
+		StartDate:   monday,
+		EndDate:     monday.AddDate(0, 0, 2),
+		EndHalfDay:  true,
+		Reason:      "holiday",
+	})
+	require.NoError(t, err)
+	require.Equal(t, member.ID, request.MemberID)
+	require.Equal(t, 2.5, request.Days)
+	require.Equal(t, LeaveStatusPending, request.Status)
+	require.Equal(t, "holiday", request.Reason)
+
+	// The pending leave holds back its days, leaving 1.5.
+	_, err = store.CreateLeaveRequestTx(ctx, CreateLeaveRequestParams{
+		MemberID:    member.ID,
+		LeaveTypeID: vacation.ID,
+		StartDate:   monday.AddDate(0, 0, 7),
+		EndDate:     monday.AddDate(0, 0, 8),
+	})
+	require.ErrorIs(t, err, ErrInsufficientLeaveBalance)
+
+	// The afternoon of the last day is still free, whatever the leave type.
+	_, err = store.CreateLeaveRequestTx(ctx, CreateLeaveRequestParams{
+		MemberID:     member.ID,
+		LeaveTypeID:  remote.ID,
+		StartDate:    monday.AddDate(0, 0, 2),
+		StartHalfDay: true,
+		EndDate:      monday.AddDate(0, 0, 3),
+	})
+	require.NoError(t, err)
+
+	_, err = store.CreateLeaveRequestTx(ctx, CreateLeaveRequestParams{
+		MemberID:    member.ID,
+		LeaveTypeID: remote.ID,
+		StartDate:   monday.AddDate(0, 0, 1),
+		EndDate:     monday.AddDate(0, 0, 1),
+	})
+	requireExclusionViolation(t, err)
+
+	_, err = store.CreateLeaveRequestTx(ctx, CreateLeaveRequestParams{
+		MemberID:    member.ID,
+		LeaveTypeID: remote.ID,
+		StartDate:   monday.AddDate(0, 0, 5),
+		EndDate:     monday.AddDate(0, 0, 6),
+	})
+	require.Equal(t, ErrNoLeaveDays, err)
+
+	_, err = store.CreateLeaveRequestTx(ctx, CreateLeaveRequestParams{
+		MemberID:    util.RandomUUID(),
+		LeaveTypeID: remote.ID,
+		StartDate:   monday,
+		EndDate:     monday,
+	})
+	require.Equal(t, sql.ErrNoRows, err)
+
+	// Canceling the leave gives its days back.
+	_, err = store.CancelLeaveRequest(ctx, request.ID)
+	require.NoError(t, err)
+
+	_, err = store.CreateLeaveRequestTx(ctx, CreateLeaveRequestParams{
+		MemberID:    member.ID,
+		LeaveTypeID: vacation.ID,
+		StartDate:   monday.AddDate(0, 0, 7),
+		EndDate:     monday.AddDate(0, 0, 8),
+	})
+	require.NoError(t, err)
+}
+
+func TestDecideLeaveRequestTx(t *testing.T) {
+	t.Parallel()
+
+	store := NewStore(testDB)
+	ctx := context.Background()
+
+	managerUser := createRandomUser(t, store.Queries)
+	otherUser := createRandomUser(t, store.Queries)
+	admin := createRandomUser(t, store.Queries)
+	_, err := testDB.ExecContext(ctx, "UPDATE users SET is_admin = true WHERE id = $1", admin.ID)
+	require.NoError(t, err)
+
+	manager := createRandomMemberWithEmail(t, store.Queries, util.RandomName(), util.RandomName(), managerUser.Email)
+	member := createRandomMember(t, store.Queries)
+	setRandomMemberManager(t, store.Queries, member, manager)
+
+	vacation := createRandomLeaveType(t, store.Queries, sql.NullFloat64{Float64: 5, Valid: true})
+	monday := leaveTestMonday()
+
+	request, err := store.CreateLeaveRequestTx(ctx, CreateLeaveRequestParams{
+		MemberID:    member.ID,
+		LeaveTypeID: vacation.ID,
+		StartDate:   monday,
+		EndDate:     monday.AddDate(0, 0, 2),
+	})
+	require.NoError(t, err)
+
+	_, err = store.DecideLeaveRequestTx(ctx, DecideLeaveRequestTxParams{
+		ID:     request.ID,
+		UserID: otherUser.ID,
+		Status: LeaveStatusApproved,
+	})
+	require.Equal(t, ErrNotLeaveApprover, err)
+
+	approved, err := store.DecideLeaveRequestTx(ctx, DecideLeaveRequestTxParams{
+		ID:           request.ID,
+		UserID:       managerUser.ID,
+		Status:       LeaveStatusApproved,
+		DecisionNote: "enjoy",
+	})
+	require.NoError(t, err)
+	require.Equal(t, LeaveStatusApproved, approved.Status)
+	require.Equal(t, uuid.NullUUID{UUID: managerUser.ID, Valid: true}, approved.DecidedBy)
+	require.True(t, approved.DecidedAt.Valid)
+	require.Equal(t, "enjoy", approved.DecisionNote)
+
+	_, err = store.DecideLeaveRequestTx(ctx, DecideLeaveRequestTxParams{
+		ID:     request.ID,
+		UserID: managerUser.ID,
+		Status: LeaveStatusRejected,
+	})
+	require.ErrorIs(t, err, ErrLeaveRequestDecided)
+
+	// An admin decides on the leaves of any member.
+	request, err = store.CreateLeaveRequestTx(ctx, CreateLeaveRequestParams{
+		MemberID:    member.ID,
+		LeaveTypeID: vacation.ID,
+		StartDate:   monday.AddDate(0, 0, 7),
+		EndDate:     monday.AddDate(0, 0, 8),
+	})
+	require.NoError(t, err)
+
+	rejected, err := store.DecideLeaveRequestTx(ctx, DecideLeaveRequestTxParams{
+		ID:     request.ID,
+		UserID: admin.ID,
+		Status: LeaveStatusRejected,
+	})
+	require.NoError(t, err)
+	require.Equal(t, LeaveStatusRejected, rejected.Status)
+
+	_, err = store.DecideLeaveRequestTx(ctx, DecideLeaveRequestTxParams{
+		ID:     util.RandomUUID(),
+		UserID: admin.ID,
+		Status: LeaveStatusApproved,
+	})
+	require.Equal(t, sql.ErrNoRows, err)
+
+	balances, err := store.ListLeaveBalances(ctx, member.ID, monday.Year())
+	require.NoError(t, err)
+
+	var balance LeaveBalance
+	for _, b := range balances {
+		if b.LeaveType.ID == vacation.ID {
+			balance = b
+		}
+	}
+	require.Equal(t, vacation.ID, balance.LeaveType.ID)
+	require.Equal(t, 3.0, balance.Used)
+	require.Equal(t, 0.0, balance.Pending)
+	require.Equal(t, 2.0, balance.Remaining)
+}
+
+func TestListTeamLeaveRequests(t *testing.T) {
+	t.Parallel()
+
+	tx := beginTransaction(t)
+	defer rollbackTransaction(t, tx)
+
+	testQueries := New(tx)
+	ctx := context.Background()
+
+	parent := createRandomTeam(t, testQueries, uuid.NullUUID{})
+	child := createRandomTeam(t, testQueries, uuid.NullUUID{UUID: parent.ID, Valid: true})
+	member1 := createRandomMember(t, testQueries)
+	member2 := createRandomMember(t, testQueries)
+	for team, member := range map[uuid.UUID]Member{parent.ID: member1, child.ID: member2} {
+		_, err := testQueries.AddTeamMember(ctx, AddTeamMemberParams{TeamID: team, MemberID: member.ID, Role: "member"})
+		require.NoError(t, err)
+	}
+
+	leaveType := createRandomLeaveType(t, testQueries, sql.NullFloat64{})
+	monday := time.Date(2030, 1, 7, 0, 0, 0, 0, time.UTC)
+
+	approved, err := testQueries.CreateLeaveRequest(ctx, CreateLeaveRequestParams{
+		MemberID:    member1.ID,
+		LeaveTypeID: leaveType.ID,
+		StartDate:   monday,
+		EndDate:     monday.AddDate(0, 0, 4),
+		Days:        5,
+	})
+	require.NoError(t, err)
+	_, err = testQueries.DecideLeaveRequest(ctx, DecideLeaveRequestParams{ID: approved.ID, Status: LeaveStatusApproved})
+	require.NoError(t, err)
+
+	pending, err := testQueries.CreateLeaveRequest(ctx, CreateLeaveRequestParams{
+		MemberID:    member2.ID,
+		LeaveTypeID: leaveType.ID,
+		StartDate:   monday.AddDate(0, 0, 2),
+		EndDate:     monday.AddDate(0, 0, 2),
+		Days:        1,
+	})
+	require.NoError(t, err)
+
+	rows, err := testQueries.ListTeamLeaveRequests(ctx, ListTeamLeaveRequestsParams{
+		TeamID:   parent.ID,
+		FromDate: monday.AddDate(0, 0, 1),
+		ToDate:   monday.AddDate(0, 0, 3),
+	})
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	require.Equal(t, approved.ID, rows[0].ID)
+	require.Equal(t, member1.FirstName, rows[0].FirstName)
+	require.Equal(t, leaveType.Name, rows[0].LeaveTypeName)
+
+	rows, err = testQueries.ListTeamLeaveRequests(ctx, ListTeamLeaveRequestsParams{
+		TeamID:         parent.ID,
+		Recursive:      true,
+		FromDate:       monday.AddDate(0, 0, 1),
+		ToDate:         monday.AddDate(0, 0, 3),
+		IncludePending: true,
+	})
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	require.Equal(t, approved.ID, rows[0].ID)
+	require.Equal(t, pending.ID, rows[1].ID)
+
+	rows, err = testQueries.ListTeamLeaveRequests(ctx, ListTeamLeaveRequestsParams{
+		TeamID:         parent.ID,
+		Recursive:      true,
+		FromDate:       monday.AddDate(0, 0, 7),
+		ToDate:         monday.AddDate(0, 0, 14),
+		IncludePending: true,
+	})
+	require.NoError(t, err)
+	require.Empty(t, rows)
+}
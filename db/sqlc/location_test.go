@@ -0,0 +1,137 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/ot07/coworker-backend/util"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomLocation(t *testing.T, testQueries *Queries, maxAdvanceDays, maxBookingsPerWeek int32) Location {
+	arg := CreateLocationParams{
+		Name:               util.RandomName(),
+		MaxAdvanceDays:     maxAdvanceDays,
+		MaxBookingsPerWeek: maxBookingsPerWeek,
+	}
+
+	location, err := testQueries.CreateLocation(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, location)
+
+	require.Equal(t, arg.Name, location.Name)
+	require.Equal(t, arg.MaxAdvanceDays, location.MaxAdvanceDays)
+	require.Equal(t, arg.MaxBookingsPerWeek, location.MaxBookingsPerWeek)
+
+	require.NotEmpty(t, location.ID)
+	require.NotZero(t, location.CreatedAt)
+
+	return location
+}
+
+func createRandomFloor(t *testing.T, testQueries *Queries, location Location, level int32) Floor {
+	floor, err := testQueries.CreateFloor(context.Background(), CreateFloorParams{
+		LocationID: location.ID,
+		Name:       util.RandomName(),
+		Level:      level,
+	})
+	require.NoError(t, err)
+	require.Equal(t, location.ID, floor.LocationID)
+	require.Equal(t, level, floor.Level)
+
+	return floor
+}
+
+func createRandomDesk(t *testing.T, testQueries *Queries, floor Floor) Desk {
+	desk, err := testQueries.CreateDesk(context.Background(), CreateDeskParams{
+		FloorID: floor.ID,
+		Name:    util.RandomName(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, floor.ID, desk.FloorID)
+
+	return desk
+}
+
+func TestUpdateLocation(t *testing.T) {
+	t.Parallel()
+
+	tx := beginTransaction(t)
+	defer rollbackTransaction(t, tx)
+
+	testQueries := New(tx)
+
+	location := createRandomLocation(t, testQueries, 14, 5)
+
+	arg := UpdateLocationParams{
+		ID:                 location.ID,
+		Name:               util.RandomName(),
+		MaxAdvanceDays:     7,
+		MaxBookingsPerWeek: 2,
+	}
+	updated, err := testQueries.UpdateLocation(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.Name, updated.Name)
+	require.Equal(t, arg.MaxAdvanceDays, updated.MaxAdvanceDays)
+	require.Equal(t, arg.MaxBookingsPerWeek, updated.MaxBookingsPerWeek)
+
+	arg.MaxBookingsPerWeek = 0
+	_, err = testQueries.UpdateLocation(context.Background(), arg)
+	require.Error(t, err)
+}
+
+func TestListLocationDesks(t *testing.T) {
+	t.Parallel()
+
+	tx := beginTransaction(t)
+	defer rollbackTransaction(t, tx)
+
+	testQueries := New(tx)
+
+	location := createRandomLocation(t, testQueries, 14, 5)
+	upper := createRandomFloor(t, testQueries, location, 2)
+	lower := createRandomFloor(t, testQueries, location, 1)
+	upperDesk := createRandomDesk(t, testQueries, upper)
+	lowerDesk := createRandomDesk(t, testQueries, lower)
+
+	floors, err := testQueries.ListFloors(context.Background(), location.ID)
+	require.NoError(t, err)
+	require.Len(t, floors, 2)
+	require.Equal(t, lower.ID, floors[0].ID)
+	require.Equal(t, upper.ID, floors[1].ID)
+
+	desks, err := testQueries.ListLocationDesks(context.Background(), location.ID)
+	require.NoError(t, err)
+	require.Len(t, desks, 2)
+	require.Equal(t, lowerDesk.ID, desks[0].ID)
+	require.Equal(t, lower.Name, desks[0].FloorName)
+	require.Equal(t, lower.Level, desks[0].FloorLevel)
+	require.Equal(t, upperDesk.ID, desks[1].ID)
+
+	_, err = testQueries.CreateDesk(context.Background(), CreateDeskParams{FloorID: upper.ID, Name: upperDesk.Name})
+	require.Error(t, err)
+}
+
+func TestDeleteLocation(t *testing.T) {
+	t.Parallel()
+
+	tx := beginTransaction(t)
+	defer rollbackTransaction(t, tx)
+
+	testQueries := New(tx)
+
+	location := createRandomLocation(t, testQueries, 14, 5)
+	floor := createRandomFloor(t, testQueries, location, 0)
+	createRandomDesk(t, testQueries, floor)
+
+	_, err := testQueries.DeleteLocation(context.Background(), location.ID)
+	require.NoError(t, err)
+
+	_, err = testQueries.GetLocation(context.Background(), location.ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	desks, err := testQueries.ListDesks(context.Background(), floor.ID)
+	require.NoError(t, err)
+	require.Empty(t, desks)
+}
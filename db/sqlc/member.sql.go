@@ -8,13 +8,28 @@ package db
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/lib/pq"
 )
 
+const countDeletedMembers = `-- name: CountDeletedMembers :one
+SELECT count(*) FROM members
+WHERE deleted_at IS NOT NULL
+`
+
+func (q *Queries) CountDeletedMembers(ctx context.Context) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countDeletedMembers)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const countMembers = `-- name: CountMembers :one
 SELECT count(*) FROM members
+WHERE deleted_at IS NULL
 `
 
 func (q *Queries) CountMembers(ctx context.Context) (int64, error) {
@@ -26,21 +41,32 @@ func (q *Queries) CountMembers(ctx context.Context) (int64, error) {
 
 const createMember = `-- name: CreateMember :one
 INSERT INTO members (
-  first_name, last_name, email
+  first_name, last_name, email, custom_fields, status, start_date
 ) VALUES (
-  $1, $2, $3
+  $1, $2, $3, COALESCE(NULLIF($4::text, ''), '{}')::jsonb,
+  COALESCE(NULLIF($5::varchar, ''), 'active'), $6
 )
-RETURNING id, first_name, last_name, email, created_at
+RETURNING id, first_name, last_name, email, created_at, search_vector, deleted_at, version, custom_fields, manager_id, avatar_key, avatar_url, status, start_date, end_date, time_zone
 `
 
 type CreateMemberParams struct {
-	FirstName string         `json:"first_name"`
-	LastName  string         `json:"last_name"`
-	Email     sql.NullString `json:"email"`
+	FirstName    string         `json:"first_name"`
+	LastName     string         `json:"last_name"`
+	Email        sql.NullString `json:"email"`
+	CustomFields string         `json:"custom_fields"`
+	Status       string         `json:"status"`
+	StartDate    sql.NullTime   `json:"start_date"`
 }
 
 func (q *Queries) CreateMember(ctx context.Context, arg CreateMemberParams) (Member, error) {
-	row := q.db.QueryRowContext(ctx, createMember, arg.FirstName, arg.LastName, arg.Email)
+	row := q.db.QueryRowContext(ctx, createMember,
+		arg.FirstName,
+		arg.LastName,
+		arg.Email,
+		arg.CustomFields,
+		arg.Status,
+		arg.StartDate,
+	)
 	var i Member
 	err := row.Scan(
 		&i.ID,
@@ -48,13 +74,25 @@ func (q *Queries) CreateMember(ctx context.Context, arg CreateMemberParams) (Mem
 		&i.LastName,
 		&i.Email,
 		&i.CreatedAt,
+		&i.SearchVector,
+		&i.DeletedAt,
+		&i.Version,
+		&i.CustomFields,
+		&i.ManagerID,
+		&i.AvatarKey,
+		&i.AvatarUrl,
+		&i.Status,
+		&i.StartDate,
+		&i.EndDate,
+		&i.TimeZone,
 	)
 	return i, err
 }
 
 const deleteMember = `-- name: DeleteMember :exec
-DELETE FROM members
-WHERE id = $1
+UPDATE members
+SET deleted_at = now()
+WHERE id = $1 AND deleted_at IS NULL
 `
 
 func (q *Queries) DeleteMember(ctx context.Context, id uuid.UUID) error {
@@ -62,19 +100,56 @@ func (q *Queries) DeleteMember(ctx context.Context, id uuid.UUID) error {
 	return err
 }
 
-const deleteMembers = `-- name: DeleteMembers :exec
-DELETE FROM members
-WHERE id = ANY($1::uuid[])
+const deleteMembers = `-- name: DeleteMembers :many
+UPDATE members
+SET deleted_at = now()
+WHERE id = ANY($1::uuid[]) AND deleted_at IS NULL
+RETURNING id, first_name, last_name, email, created_at, search_vector, deleted_at, version, custom_fields, manager_id, avatar_key, avatar_url, status, start_date, end_date, time_zone
 `
 
-func (q *Queries) DeleteMembers(ctx context.Context, dollar_1 []uuid.UUID) error {
-	_, err := q.db.ExecContext(ctx, deleteMembers, pq.Array(dollar_1))
-	return err
+func (q *Queries) DeleteMembers(ctx context.Context, dollar_1 []uuid.UUID) ([]Member, error) {
+	rows, err := q.db.QueryContext(ctx, deleteMembers, pq.Array(dollar_1))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Member{}
+	for rows.Next() {
+		var i Member
+		if err := rows.Scan(
+			&i.ID,
+			&i.FirstName,
+			&i.LastName,
+			&i.Email,
+			&i.CreatedAt,
+			&i.SearchVector,
+			&i.DeletedAt,
+			&i.Version,
+			&i.CustomFields,
+			&i.ManagerID,
+			&i.AvatarKey,
+			&i.AvatarUrl,
+			&i.Status,
+			&i.StartDate,
+			&i.EndDate,
+			&i.TimeZone,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
 const getMember = `-- name: GetMember :one
-SELECT id, first_name, last_name, email, created_at FROM members
-WHERE id = $1 LIMIT 1
+SELECT id, first_name, last_name, email, created_at, search_vector, deleted_at, version, custom_fields, manager_id, avatar_key, avatar_url, status, start_date, end_date, time_zone FROM members
+WHERE id = $1 AND deleted_at IS NULL LIMIT 1
 `
 
 func (q *Queries) GetMember(ctx context.Context, id uuid.UUID) (Member, error) {
@@ -86,12 +161,107 @@ func (q *Queries) GetMember(ctx context.Context, id uuid.UUID) (Member, error) {
 		&i.LastName,
 		&i.Email,
 		&i.CreatedAt,
+		&i.SearchVector,
+		&i.DeletedAt,
+		&i.Version,
+		&i.CustomFields,
+		&i.ManagerID,
+		&i.AvatarKey,
+		&i.AvatarUrl,
+		&i.Status,
+		&i.StartDate,
+		&i.EndDate,
+		&i.TimeZone,
 	)
 	return i, err
 }
 
+const getMemberForUpdate = `-- name: GetMemberForUpdate :one
+SELECT id, first_name, last_name, email, created_at, search_vector, deleted_at, version, custom_fields, manager_id, avatar_key, avatar_url, status, start_date, end_date, time_zone FROM members
+WHERE id = $1 LIMIT 1
+FOR UPDATE
+`
+
+func (q *Queries) GetMemberForUpdate(ctx context.Context, id uuid.UUID) (Member, error) {
+	row := q.db.QueryRowContext(ctx, getMemberForUpdate, id)
+	var i Member
+	err := row.Scan(
+		&i.ID,
+		&i.FirstName,
+		&i.LastName,
+		&i.Email,
+		&i.CreatedAt,
+		&i.SearchVector,
+		&i.DeletedAt,
+		&i.Version,
+		&i.CustomFields,
+		&i.ManagerID,
+		&i.AvatarKey,
+		&i.AvatarUrl,
+		&i.Status,
+		&i.StartDate,
+		&i.EndDate,
+		&i.TimeZone,
+	)
+	return i, err
+}
+
+const listDeletedMembers = `-- name: ListDeletedMembers :many
+SELECT id, first_name, last_name, email, created_at, search_vector, deleted_at, version, custom_fields, manager_id, avatar_key, avatar_url, status, start_date, end_date, time_zone FROM members
+WHERE deleted_at IS NOT NULL
+ORDER BY deleted_at DESC, id
+LIMIT $1
+OFFSET $2
+`
+
+type ListDeletedMembersParams struct {
+	Limit  int32 `json:"limit"`
+	Offset int32 `json:"offset"`
+}
+
+func (q *Queries) ListDeletedMembers(ctx context.Context, arg ListDeletedMembersParams) ([]Member, error) {
+	rows, err := q.db.QueryContext(ctx, listDeletedMembers, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Member{}
+	for rows.Next() {
+		var i Member
+		if err := rows.Scan(
+			&i.ID,
+			&i.FirstName,
+			&i.LastName,
+			&i.Email,
+			&i.CreatedAt,
+			&i.SearchVector,
+			&i.DeletedAt,
+			&i.Version,
+			&i.CustomFields,
+			&i.ManagerID,
+			&i.AvatarKey,
+			&i.AvatarUrl,
+			&i.Status,
+			&i.StartDate,
+			&i.EndDate,
+			&i.TimeZone,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listMembers = `-- name: ListMembers :many
-SELECT id, first_name, last_name, email, created_at FROM members
+SELECT id, first_name, last_name, email, created_at, search_vector, deleted_at, version, custom_fields, manager_id, avatar_key, avatar_url, status, start_date, end_date, time_zone FROM members
+WHERE deleted_at IS NULL
 ORDER BY id
 LIMIT $1
 OFFSET $2
@@ -117,6 +287,110 @@ func (q *Queries) ListMembers(ctx context.Context, arg ListMembersParams) ([]Mem
 			&i.LastName,
 			&i.Email,
 			&i.CreatedAt,
+			&i.SearchVector,
+			&i.DeletedAt,
+			&i.Version,
+			&i.CustomFields,
+			&i.ManagerID,
+			&i.AvatarKey,
+			&i.AvatarUrl,
+			&i.Status,
+			&i.StartDate,
+			&i.EndDate,
+			&i.TimeZone,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMembersByIDs = `-- name: ListMembersByIDs :many
+SELECT id, first_name, last_name, email, created_at, search_vector, deleted_at, version, custom_fields, manager_id, avatar_key, avatar_url, status, start_date, end_date, time_zone FROM members
+WHERE id = ANY($1::uuid[]) AND deleted_at IS NULL
+ORDER BY created_at, id
+`
+
+func (q *Queries) ListMembersByIDs(ctx context.Context, dollar_1 []uuid.UUID) ([]Member, error) {
+	rows, err := q.db.QueryContext(ctx, listMembersByIDs, pq.Array(dollar_1))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Member{}
+	for rows.Next() {
+		var i Member
+		if err := rows.Scan(
+			&i.ID,
+			&i.FirstName,
+			&i.LastName,
+			&i.Email,
+			&i.CreatedAt,
+			&i.SearchVector,
+			&i.DeletedAt,
+			&i.Version,
+			&i.CustomFields,
+			&i.ManagerID,
+			&i.AvatarKey,
+			&i.AvatarUrl,
+			&i.Status,
+			&i.StartDate,
+			&i.EndDate,
+			&i.TimeZone,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMembersForUpdate = `-- name: ListMembersForUpdate :many
+SELECT id, first_name, last_name, email, created_at, search_vector, deleted_at, version, custom_fields, manager_id, avatar_key, avatar_url, status, start_date, end_date, time_zone FROM members
+WHERE id = ANY($1::uuid[])
+ORDER BY id
+FOR UPDATE
+`
+
+func (q *Queries) ListMembersForUpdate(ctx context.Context, dollar_1 []uuid.UUID) ([]Member, error) {
+	rows, err := q.db.QueryContext(ctx, listMembersForUpdate, pq.Array(dollar_1))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Member{}
+	for rows.Next() {
+		var i Member
+		if err := rows.Scan(
+			&i.ID,
+			&i.FirstName,
+			&i.LastName,
+			&i.Email,
+			&i.CreatedAt,
+			&i.SearchVector,
+			&i.DeletedAt,
+			&i.Version,
+			&i.CustomFields,
+			&i.ManagerID,
+			&i.AvatarKey,
+			&i.AvatarUrl,
+			&i.Status,
+			&i.StartDate,
+			&i.EndDate,
+			&i.TimeZone,
 		); err != nil {
 			return nil, err
 		}
@@ -131,6 +405,298 @@ func (q *Queries) ListMembers(ctx context.Context, arg ListMembersParams) ([]Mem
 	return items, nil
 }
 
+const patchMember = `-- name: PatchMember :one
+UPDATE members
+SET
+  first_name = COALESCE($2, first_name),
+  last_name = COALESCE($3, last_name),
+  email = CASE WHEN $4::boolean THEN $5 ELSE email END,
+  custom_fields = jsonb_strip_nulls(custom_fields || COALESCE(NULLIF($6::text, ''), '{}')::jsonb),
+  version = version + 1
+WHERE id = $1 AND deleted_at IS NULL
+  AND ($7::integer[] IS NULL OR version = ANY($7::integer[]))
+RETURNING id, first_name, last_name, email, created_at, search_vector, deleted_at, version, custom_fields, manager_id, avatar_key, avatar_url, status, start_date, end_date, time_zone
+`
+
+type PatchMemberParams struct {
+	ID               uuid.UUID      `json:"id"`
+	FirstName        sql.NullString `json:"first_name"`
+	LastName         sql.NullString `json:"last_name"`
+	SetEmail         bool           `json:"set_email"`
+	Email            sql.NullString `json:"email"`
+	CustomFields     string         `json:"custom_fields"`
+	ExpectedVersions []int32        `json:"expected_versions"`
+}
+
+func (q *Queries) PatchMember(ctx context.Context, arg PatchMemberParams) (Member, error) {
+	row := q.db.QueryRowContext(ctx, patchMember,
+		arg.ID,
+		arg.FirstName,
+		arg.LastName,
+		arg.SetEmail,
+		arg.Email,
+		arg.CustomFields,
+		pq.Array(arg.ExpectedVersions),
+	)
+	var i Member
+	err := row.Scan(
+		&i.ID,
+		&i.FirstName,
+		&i.LastName,
+		&i.Email,
+		&i.CreatedAt,
+		&i.SearchVector,
+		&i.DeletedAt,
+		&i.Version,
+		&i.CustomFields,
+		&i.ManagerID,
+		&i.AvatarKey,
+		&i.AvatarUrl,
+		&i.Status,
+		&i.StartDate,
+		&i.EndDate,
+		&i.TimeZone,
+	)
+	return i, err
+}
+
+const purgeDeletedMembers = `-- name: PurgeDeletedMembers :many
+DELETE FROM members
+WHERE deleted_at < $1
+RETURNING avatar_key
+`
+
+func (q *Queries) PurgeDeletedMembers(ctx context.Context, deletedBefore time.Time) ([]sql.NullString, error) {
+	rows, err := q.db.QueryContext(ctx, purgeDeletedMembers, deletedBefore)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []sql.NullString{}
+	for rows.Next() {
+		var avatar_key sql.NullString
+		if err := rows.Scan(&avatar_key); err != nil {
+			return nil, err
+		}
+		items = append(items, avatar_key)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const removeMembersCustomField = `-- name: RemoveMembersCustomField :execrows
+UPDATE members
+SET
+  custom_fields = custom_fields - $1::varchar,
+  version = version + 1
+WHERE custom_fields ? $1::varchar
+`
+
+func (q *Queries) RemoveMembersCustomField(ctx context.Context, key string) (int64, error) {
+	result, err := q.db.ExecContext(ctx, removeMembersCustomField, key)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const restoreMember = `-- name: RestoreMember :one
+UPDATE members
+SET deleted_at = NULL
+WHERE id = $1 AND deleted_at IS NOT NULL
+RETURNING id, first_name, last_name, email, created_at, search_vector, deleted_at, version, custom_fields, manager_id, avatar_key, avatar_url, status, start_date, end_date, time_zone
+`
+
+func (q *Queries) RestoreMember(ctx context.Context, id uuid.UUID) (Member, error) {
+	row := q.db.QueryRowContext(ctx, restoreMember, id)
+	var i Member
+	err := row.Scan(
+		&i.ID,
+		&i.FirstName,
+		&i.LastName,
+		&i.Email,
+		&i.CreatedAt,
+		&i.SearchVector,
+		&i.DeletedAt,
+		&i.Version,
+		&i.CustomFields,
+		&i.ManagerID,
+		&i.AvatarKey,
+		&i.AvatarUrl,
+		&i.Status,
+		&i.StartDate,
+		&i.EndDate,
+		&i.TimeZone,
+	)
+	return i, err
+}
+
+const restoreMembers = `-- name: RestoreMembers :many
+UPDATE members
+SET deleted_at = NULL
+WHERE id = ANY($1::uuid[]) AND deleted_at IS NOT NULL
+RETURNING id, first_name, last_name, email, created_at, search_vector, deleted_at, version, custom_fields, manager_id, avatar_key, avatar_url, status, start_date, end_date, time_zone
+`
+
+func (q *Queries) RestoreMembers(ctx context.Context, dollar_1 []uuid.UUID) ([]Member, error) {
+	rows, err := q.db.QueryContext(ctx, restoreMembers, pq.Array(dollar_1))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Member{}
+	for rows.Next() {
+		var i Member
+		if err := rows.Scan(
+			&i.ID,
+			&i.FirstName,
+			&i.LastName,
+			&i.Email,
+			&i.CreatedAt,
+			&i.SearchVector,
+			&i.DeletedAt,
+			&i.Version,
+			&i.CustomFields,
+			&i.ManagerID,
+			&i.AvatarKey,
+			&i.AvatarUrl,
+			&i.Status,
+			&i.StartDate,
+			&i.EndDate,
+			&i.TimeZone,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchMembers = `-- name: SearchMembers :many
+SELECT
+  id, first_name, last_name, email, created_at, custom_fields, manager_id, avatar_url, status, start_date, end_date, time_zone,
+  (
+    ts_rank(search_vector, websearch_to_tsquery('simple', normalize_search_text($1::text))) +
+    word_similarity(normalize_search_text($1::text), normalize_search_text(first_name || ' ' || last_name || ' ' || coalesce(email, '')))
+  )::real AS rank
+FROM members
+WHERE deleted_at IS NULL
+  AND (
+    search_vector @@ websearch_to_tsquery('simple', normalize_search_text($1::text))
+    OR normalize_search_text($1::text) <% normalize_search_text(first_name || ' ' || last_name || ' ' || coalesce(email, ''))
+  )
+ORDER BY rank DESC, id
+LIMIT $2
+`
+
+type SearchMembersParams struct {
+	Query       string `json:"query"`
+	ResultLimit int32  `json:"result_limit"`
+}
+
+type SearchMembersRow struct {
+	ID           uuid.UUID       `json:"id"`
+	FirstName    string          `json:"first_name"`
+	LastName     string          `json:"last_name"`
+	Email        sql.NullString  `json:"email"`
+	CreatedAt    time.Time       `json:"created_at"`
+	CustomFields json.RawMessage `json:"custom_fields"`
+	ManagerID    uuid.NullUUID   `json:"manager_id"`
+	AvatarUrl    sql.NullString  `json:"avatar_url"`
+	Status       string          `json:"status"`
+	StartDate    sql.NullTime    `json:"start_date"`
+	EndDate      sql.NullTime    `json:"end_date"`
+	TimeZone     sql.NullString  `json:"time_zone"`
+	Rank         float32         `json:"rank"`
+}
+
+func (q *Queries) SearchMembers(ctx context.Context, arg SearchMembersParams) ([]SearchMembersRow, error) {
+	rows, err := q.db.QueryContext(ctx, searchMembers, arg.Query, arg.ResultLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []SearchMembersRow{}
+	for rows.Next() {
+		var i SearchMembersRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.FirstName,
+			&i.LastName,
+			&i.Email,
+			&i.CreatedAt,
+			&i.CustomFields,
+			&i.ManagerID,
+			&i.AvatarUrl,
+			&i.Status,
+			&i.StartDate,
+			&i.EndDate,
+			&i.TimeZone,
+			&i.Rank,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setMemberAvatar = `-- name: SetMemberAvatar :one
+UPDATE members
+SET avatar_key = $1,
+    avatar_url = $2,
+    version = version + 1
+WHERE id = $3 AND deleted_at IS NULL
+RETURNING id, first_name, last_name, email, created_at, search_vector, deleted_at, version, custom_fields, manager_id, avatar_key, avatar_url, status, start_date, end_date, time_zone
+`
+
+type SetMemberAvatarParams struct {
+	AvatarKey sql.NullString `json:"avatar_key"`
+	AvatarUrl sql.NullString `json:"avatar_url"`
+	ID        uuid.UUID      `json:"id"`
+}
+
+func (q *Queries) SetMemberAvatar(ctx context.Context, arg SetMemberAvatarParams) (Member, error) {
+	row := q.db.QueryRowContext(ctx, setMemberAvatar, arg.AvatarKey, arg.AvatarUrl, arg.ID)
+	var i Member
+	err := row.Scan(
+		&i.ID,
+		&i.FirstName,
+		&i.LastName,
+		&i.Email,
+		&i.CreatedAt,
+		&i.SearchVector,
+		&i.DeletedAt,
+		&i.Version,
+		&i.CustomFields,
+		&i.ManagerID,
+		&i.AvatarKey,
+		&i.AvatarUrl,
+		&i.Status,
+		&i.StartDate,
+		&i.EndDate,
+		&i.TimeZone,
+	)
+	return i, err
+}
+
 const truncateMembersTable = `-- name: TruncateMembersTable :exec
 TRUNCATE TABLE members CASCADE
 `
@@ -145,16 +711,21 @@ UPDATE members
 SET
   first_name = COALESCE($2, first_name),
   last_name = COALESCE($3, last_name),
-  email = COALESCE($4, email)
-WHERE id = $1
-RETURNING id, first_name, last_name, email, created_at
+  email = COALESCE($4, email),
+  custom_fields = jsonb_strip_nulls(custom_fields || COALESCE(NULLIF($5::text, ''), '{}')::jsonb),
+  version = version + 1
+WHERE id = $1 AND deleted_at IS NULL
+  AND ($6::integer[] IS NULL OR version = ANY($6::integer[]))
+RETURNING id, first_name, last_name, email, created_at, search_vector, deleted_at, version, custom_fields, manager_id, avatar_key, avatar_url, status, start_date, end_date, time_zone
 `
 
 type UpdateMemberParams struct {
-	ID        uuid.UUID      `json:"id"`
-	FirstName sql.NullString `json:"first_name"`
-	LastName  sql.NullString `json:"last_name"`
-	Email     sql.NullString `json:"email"`
+	ID               uuid.UUID      `json:"id"`
+	FirstName        sql.NullString `json:"first_name"`
+	LastName         sql.NullString `json:"last_name"`
+	Email            sql.NullString `json:"email"`
+	CustomFields     string         `json:"custom_fields"`
+	ExpectedVersions []int32        `json:"expected_versions"`
 }
 
 func (q *Queries) UpdateMember(ctx context.Context, arg UpdateMemberParams) (Member, error) {
@@ -163,6 +734,8 @@ func (q *Queries) UpdateMember(ctx context.Context, arg UpdateMemberParams) (Mem
 		arg.FirstName,
 		arg.LastName,
 		arg.Email,
+		arg.CustomFields,
+		pq.Array(arg.ExpectedVersions),
 	)
 	var i Member
 	err := row.Scan(
@@ -171,6 +744,17 @@ func (q *Queries) UpdateMember(ctx context.Context, arg UpdateMemberParams) (Mem
 		&i.LastName,
 		&i.Email,
 		&i.CreatedAt,
+		&i.SearchVector,
+		&i.DeletedAt,
+		&i.Version,
+		&i.CustomFields,
+		&i.ManagerID,
+		&i.AvatarKey,
+		&i.AvatarUrl,
+		&i.Status,
+		&i.StartDate,
+		&i.EndDate,
+		&i.TimeZone,
 	)
 	return i, err
 }
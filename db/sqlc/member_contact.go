@@ -0,0 +1,139 @@
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// DefaultMemberEmailLabel is the label of a primary email created through the email of a member.
+const DefaultMemberEmailLabel = "work"
+
+// MemberContactDetails holds the contact details of a member, in the order they are listed.
+type MemberContactDetails struct {
+	Emails    []MemberEmail
+	Phones    []MemberPhone
+	Addresses []MemberAddress
+	Links     []MemberLink
+}
+
+// MemberContactDetailsParams lists the contact details replacing those of a member.
+// A nil list leaves the details of its kind untouched, while an empty one removes them all.
+// The member and the position of every detail are filled in when the details are stored.
+type MemberContactDetailsParams struct {
+	Emails    []CreateMemberEmailParams
+	Phones    []CreateMemberPhoneParams
+	Addresses []CreateMemberAddressParams
+	Links     []CreateMemberLinkParams
+}
+
+// GetMemberContactDetails returns all the contact details of a member.
+func (store *SQLStore) GetMemberContactDetails(ctx context.Context, memberID uuid.UUID) (MemberContactDetails, error) {
+	var details MemberContactDetails
+	var err error
+
+	if details.Emails, err = store.ListMemberEmails(ctx, memberID); err != nil {
+		return MemberContactDetails{}, err
+	}
+	if details.Phones, err = store.ListMemberPhones(ctx, memberID); err != nil {
+		return MemberContactDetails{}, err
+	}
+	if details.Addresses, err = store.ListMemberAddresses(ctx, memberID); err != nil {
+		return MemberContactDetails{}, err
+	}
+	if details.Links, err = store.ListMemberLinks(ctx, memberID); err != nil {
+		return MemberContactDetails{}, err
+	}
+	return details, nil
+}
+
+// setMemberContactDetails replaces the contact details of a member with the lists given.
+// When the emails are replaced, the email of the member becomes the new primary email.
+func (q *Queries) setMemberContactDetails(ctx context.Context, member Member, arg MemberContactDetailsParams) (Member, error) {
+	if arg.Emails != nil {
+		if err := q.DeleteMemberEmails(ctx, member.ID); err != nil {
+			return Member{}, err
+		}
+		for i, email := range arg.Emails {
+			email.MemberID = member.ID
+			email.Position = int32(i)
+			if _, err := q.CreateMemberEmail(ctx, email); err != nil {
+				return Member{}, err
+			}
+		}
+
+		var err error
+		member, err = q.SyncMemberEmail(ctx, member.ID)
+		if err != nil {
+			return Member{}, err
+		}
+	}
+
+	if arg.Phones != nil {
+		if err := q.DeleteMemberPhones(ctx, member.ID); err != nil {
+			return Member{}, err
+		}
+		for i, phone := range arg.Phones {
+			phone.MemberID = member.ID
+			phone.Position = int32(i)
+			if _, err := q.CreateMemberPhone(ctx, phone); err != nil {
+				return Member{}, err
+			}
+		}
+	}
+
+	if arg.Addresses != nil {
+		if err := q.DeleteMemberAddresses(ctx, member.ID); err != nil {
+			return Member{}, err
+		}
+		for i, address := range arg.Addresses {
+			address.MemberID = member.ID
+			address.Position = int32(i)
+			if _, err := q.CreateMemberAddress(ctx, address); err != nil {
+				return Member{}, err
+			}
+		}
+	}
+
+	if arg.Links != nil {
+		if err := q.DeleteMemberLinks(ctx, member.ID); err != nil {
+			return Member{}, err
+		}
+		for i, link := range arg.Links {
+			link.MemberID = member.ID
+			link.Position = int32(i)
+			if _, err := q.CreateMemberLink(ctx, link); err != nil {
+				return Member{}, err
+			}
+		}
+	}
+
+	return member, nil
+}
+
+// syncPrimaryMemberEmail makes the primary email of a member follow a change of the email of the member,
+// creating a primary email when the member had none and removing it when the email is cleared.
+func (q *Queries) syncPrimaryMemberEmail(ctx context.Context, before, after Member) error {
+	if before.Email == after.Email {
+		return nil
+	}
+	if !after.Email.Valid {
+		return q.DeletePrimaryMemberEmail(ctx, after.ID)
+	}
+
+	updated, err := q.SetPrimaryMemberEmail(ctx, SetPrimaryMemberEmailParams{
+		Email:    after.Email.String,
+		MemberID: after.ID,
+	})
+	if err != nil || updated > 0 {
+		return err
+	}
+
+	_, err = q.CreateMemberEmail(ctx, CreateMemberEmailParams{
+		MemberID:  after.ID,
+		Label:     DefaultMemberEmailLabel,
+		Email:     after.Email.String,
+		IsPrimary: true,
+	})
+	return err
+}
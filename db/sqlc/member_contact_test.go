@@ -0,0 +1,299 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/ot07/coworker-backend/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetMemberContactDetails(t *testing.T) {
+	t.Parallel()
+
+	tx := beginTransaction(t)
+	defer rollbackTransaction(t, tx)
+
+	testQueries := New(tx)
+
+	member := createRandomMember(t, testQueries)
+	primaryEmail := util.RandomEmail()
+
+	arg := MemberContactDetailsParams{
+		Emails: []CreateMemberEmailParams{
+			{Label: "personal", Email: util.RandomEmail()},
+			{Label: "work", Email: primaryEmail, IsPrimary: true},
+		},
+		Phones: []CreateMemberPhoneParams{
+			{Label: "mobile", Number: "+819012345678"},
+		},
+		Addresses: []CreateMemberAddressParams{
+			{Label: "work", City: "Tokyo", Country: "JP"},
+		},
+		Links: []CreateMemberLinkParams{
+			{Label: "github", Url: "https://github.com/" + util.RandomName()},
+		},
+	}
+
+	updated, err := testQueries.setMemberContactDetails(context.Background(), member, arg)
+	require.NoError(t, err)
+	require.Equal(t, primaryEmail, updated.Email.String)
+
+	emails, err := testQueries.ListMemberEmails(context.Background(), member.ID)
+	require.NoError(t, err)
+	require.Len(t, emails, 2)
+	require.True(t, emails[0].IsPrimary)
+	require.Equal(t, primaryEmail, emails[0].Email)
+	require.Equal(t, arg.Emails[0].Email, emails[1].Email)
+
+	phones, err := testQueries.ListMemberPhones(context.Background(), member.ID)
+	require.NoError(t, err)
+	require.Len(t, phones, 1)
+	require.Equal(t, "+819012345678", phones[0].Number)
+
+	addresses, err := testQueries.ListMemberAddresses(context.Background(), member.ID)
+	require.NoError(t, err)
+	require.Len(t, addresses, 1)
+	require.Equal(t, "JP", addresses[0].Country)
+
+	links, err := testQueries.ListMemberLinks(context.Background(), member.ID)
+	require.NoError(t, err)
+	require.Len(t, links, 1)
+	require.Equal(t, arg.Links[0].Url, links[0].Url)
+
+	// Nil lists are left untouched, while empty ones remove every detail of their kind.
+	updated, err = testQueries.setMemberContactDetails(context.Background(), updated, MemberContactDetailsParams{
+		Emails: []CreateMemberEmailParams{},
+	})
+	require.NoError(t, err)
+	require.False(t, updated.Email.Valid)
+
+	emails, err = testQueries.ListMemberEmails(context.Background(), member.ID)
+	require.NoError(t, err)
+	require.Empty(t, emails)
+
+	phones, err = testQueries.ListMemberPhones(context.Background(), member.ID)
+	require.NoError(t, err)
+	require.Len(t, phones, 1)
+}
+
+func TestMemberContactDetailsConstraints(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+		arg  MemberContactDetailsParams
+	}{
+		{
+			name: "TwoPrimaryEmails",
+			arg: MemberContactDetailsParams{Emails: []CreateMemberEmailParams{
+				{Label: "work", Email: util.RandomEmail(), IsPrimary: true},
+				{Label: "personal", Email: util.RandomEmail(), IsPrimary: true},
+			}},
+		},
+		{
+			name: "InvalidEmailLabel",
+			arg: MemberContactDetailsParams{Emails: []CreateMemberEmailParams{
+				{Label: "school", Email: util.RandomEmail()},
+			}},
+		},
+		{
+			name: "NationalPhoneNumber",
+			arg: MemberContactDetailsParams{Phones: []CreateMemberPhoneParams{
+				{Label: "work", Number: "0312345678"},
+			}},
+		},
+		{
+			name: "InvalidCountry",
+			arg: MemberContactDetailsParams{Addresses: []CreateMemberAddressParams{
+				{Label: "home", Country: "jp"},
+			}},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			tx := beginTransaction(t)
+			defer rollbackTransaction(t, tx)
+
+			testQueries := New(tx)
+
+			member := createRandomMember(t, testQueries)
+			_, err := testQueries.setMemberContactDetails(context.Background(), member, tc.arg)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestPrimaryMemberEmailIsUnique(t *testing.T) {
+	t.Parallel()
+
+	tx := beginTransaction(t)
+	defer rollbackTransaction(t, tx)
+
+	testQueries := New(tx)
+
+	member1 := createRandomMember(t, testQueries)
+	member2 := createRandomMember(t, testQueries)
+	email := util.RandomEmail()
+
+	_, err := testQueries.CreateMemberEmail(context.Background(), CreateMemberEmailParams{
+		MemberID:  member1.ID,
+		Label:     "work",
+		Email:     email,
+		IsPrimary: true,
+	})
+	require.NoError(t, err)
+
+	// Another member may list the email, but not as its primary email.
+	_, err = testQueries.CreateMemberEmail(context.Background(), CreateMemberEmailParams{
+		MemberID: member2.ID,
+		Label:    "other",
+		Email:    email,
+		Position: 1,
+	})
+	require.NoError(t, err)
+
+	_, err = testQueries.CreateMemberEmail(context.Background(), CreateMemberEmailParams{
+		MemberID:  member2.ID,
+		Label:     "work",
+		Email:     util.RandomEmail(),
+		IsPrimary: true,
+	})
+	require.NoError(t, err)
+
+	_, err = testQueries.SetPrimaryMemberEmail(context.Background(), SetPrimaryMemberEmailParams{
+		Email:    email,
+		MemberID: member2.ID,
+	})
+	require.Error(t, err)
+}
+
+func TestSyncPrimaryMemberEmail(t *testing.T) {
+	t.Parallel()
+
+	tx := beginTransaction(t)
+	defer rollbackTransaction(t, tx)
+
+	testQueries := New(tx)
+
+	member := createRandomMember(t, testQueries)
+
+	// A member without a primary email gets one for its email.
+	err := testQueries.syncPrimaryMemberEmail(context.Background(), Member{}, member)
+	require.NoError(t, err)
+
+	emails, err := testQueries.ListMemberEmails(context.Background(), member.ID)
+	require.NoError(t, err)
+	require.Len(t, emails, 1)
+	require.True(t, emails[0].IsPrimary)
+	require.Equal(t, DefaultMemberEmailLabel, emails[0].Label)
+	require.Equal(t, member.Email.String, emails[0].Email)
+
+	// A changed email replaces the primary email in place.
+	changed := member
+	changed.Email = sql.NullString{String: util.RandomEmail(), Valid: true}
+	err = testQueries.syncPrimaryMemberEmail(context.Background(), member, changed)
+	require.NoError(t, err)
+
+	emails, err = testQueries.ListMemberEmails(context.Background(), member.ID)
+	require.NoError(t, err)
+	require.Len(t, emails, 1)
+	require.Equal(t, changed.Email.String, emails[0].Email)
+
+	// A cleared email removes the primary email.
+	cleared := changed
+	cleared.Email = sql.NullString{}
+	err = testQueries.syncPrimaryMemberEmail(context.Background(), changed, cleared)
+	require.NoError(t, err)
+
+	emails, err = testQueries.ListMemberEmails(context.Background(), member.ID)
+	require.NoError(t, err)
+	require.Empty(t, emails)
+}
+
+func TestTrashedMemberPrimaryEmail(t *testing.T) {
+	t.Parallel()
+
+	store := NewStore(testDB)
+	ctx := context.Background()
+	email := util.RandomEmail()
+
+	create := func() Member {
+		member, err := store.CreateMemberTx(ctx, CreateMemberTxParams{
+			CreateMemberParams: CreateMemberParams{
+				FirstName: util.RandomName(),
+				LastName:  util.RandomName(),
+				Email:     sql.NullString{String: email, Valid: true},
+			},
+		}, AuditMeta{})
+		require.NoError(t, err)
+		return member
+	}
+
+	trashed := create()
+	_, err := store.DeleteMembersTx(ctx, []uuid.UUID{trashed.ID}, AuditMeta{})
+	require.NoError(t, err)
+
+	// The member in the trash keeps its email, but no longer as its primary email.
+	emails, err := store.ListMemberEmails(ctx, trashed.ID)
+	require.NoError(t, err)
+	require.Len(t, emails, 1)
+	require.Equal(t, email, emails[0].Email)
+	require.False(t, emails[0].IsPrimary)
+
+	// A live member can take the email over, which keeps the trashed member from being restored.
+	live := create()
+	_, err = store.RestoreMemberTx(ctx, trashed.ID, AuditMeta{})
+	require.Error(t, err)
+	_, err = store.RestoreMembersTx(ctx, []uuid.UUID{trashed.ID}, AuditMeta{})
+	require.Error(t, err)
+
+	_, err = store.DeleteMembersTx(ctx, []uuid.UUID{live.ID}, AuditMeta{})
+	require.NoError(t, err)
+
+	restored, err := store.RestoreMemberTx(ctx, trashed.ID, AuditMeta{})
+	require.NoError(t, err)
+	require.Equal(t, email, restored.Email.String)
+
+	emails, err = store.ListMemberEmails(ctx, trashed.ID)
+	require.NoError(t, err)
+	require.Len(t, emails, 1)
+	require.True(t, emails[0].IsPrimary)
+}
+
+func TestListPrimaryMemberEmails(t *testing.T) {
+	t.Parallel()
+
+	tx := beginTransaction(t)
+	defer rollbackTransaction(t, tx)
+
+	testQueries := New(tx)
+	ctx := context.Background()
+
+	member := createRandomMember(t, testQueries)
+	primary := util.RandomEmail()
+	other := util.RandomEmail()
+	for i, email := range []string{primary, other} {
+		_, err := testQueries.CreateMemberEmail(ctx, CreateMemberEmailParams{
+			MemberID:  member.ID,
+			Label:     "work",
+			Email:     email,
+			IsPrimary: i == 0,
+			Position:  int32(i),
+		})
+		require.NoError(t, err)
+	}
+
+	// Emails are matched regardless of case, and only primary emails are listed.
+	emails, err := testQueries.ListPrimaryMemberEmails(ctx, []string{strings.ToLower(primary), strings.ToLower(other), util.RandomEmail()})
+	require.NoError(t, err)
+	require.Equal(t, []string{primary}, emails)
+}
@@ -0,0 +1,277 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// memberSortableColumns whitelists the columns members can be sorted by.
+// Sort keys are interpolated into the ORDER BY clause, so only keys found here may be used.
+var memberSortableColumns = map[string]string{
+	"first_name": "first_name",
+	"last_name":  "last_name",
+	"email":      "email",
+	"created_at": "created_at",
+}
+
+// MemberFilter holds the conditions used to narrow down members.
+// Zero values mean that the condition is not applied.
+type MemberFilter struct {
+	Query         string
+	HasEmail      sql.NullBool
+	CreatedAfter  sql.NullTime
+	CreatedBefore sql.NullTime
+	// CustomFields is a JSON object the custom fields of members must contain, as with the jsonb @> operator.
+	CustomFields json.RawMessage
+	// TagIDs narrows down members to those with any of the tags, or all of them when TagsMatchAll is set.
+	TagIDs       []uuid.UUID
+	TagsMatchAll bool
+	// Statuses narrows down members to those in any of the lifecycle statuses.
+	Statuses []string
+}
+
+// MemberSortKey is a single key of the ORDER BY clause for members.
+type MemberSortKey struct {
+	Column string
+	Desc   bool
+}
+
+// ParseMemberSort parses a comma separated sort expression such as "last_name,-created_at".
+// A leading "-" sorts the column in descending order.
+func ParseMemberSort(sort string) ([]MemberSortKey, error) {
+	if len(sort) == 0 {
+		return nil, nil
+	}
+
+	var keys []MemberSortKey
+	for _, field := range strings.Split(sort, ",") {
+		key := MemberSortKey{Column: strings.TrimSpace(field)}
+		if strings.HasPrefix(key.Column, "-") {
+			key.Column = key.Column[1:]
+			key.Desc = true
+		}
+		if _, ok := memberSortableColumns[key.Column]; !ok {
+			return nil, fmt.Errorf("cannot sort members by %q", key.Column)
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// queryBuilder accumulates WHERE conditions together with their bind parameters.
+type queryBuilder struct {
+	conditions []string
+	args       []interface{}
+}
+
+// bind registers a bind parameter and returns its placeholder.
+func (b *queryBuilder) bind(value interface{}) string {
+	b.args = append(b.args, value)
+	return fmt.Sprintf("$%d", len(b.args))
+}
+
+func (b *queryBuilder) where(condition string) {
+	b.conditions = append(b.conditions, condition)
+}
+
+func (b *queryBuilder) whereClause() string {
+	if len(b.conditions) == 0 {
+		return ""
+	}
+	return "WHERE " + strings.Join(b.conditions, " AND ") + "\n"
+}
+
+// escapeLike escapes the wildcard characters of a LIKE pattern.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
+func (f MemberFilter) apply(b *queryBuilder) {
+	// Deleted members stay in the trash until they are restored or purged.
+	b.where("deleted_at IS NULL")
+
+	// Every whitespace separated term must match at least one of the searchable columns,
+	// so that "taro yamada" finds the member whose first and last names are split.
+	for _, term := range strings.Fields(f.Query) {
+		p := b.bind("%" + escapeLike(term) + "%")
+		b.where(fmt.Sprintf("(first_name ILIKE %[1]s OR last_name ILIKE %[1]s OR email ILIKE %[1]s)", p))
+	}
+	if f.HasEmail.Valid {
+		if f.HasEmail.Bool {
+			b.where("email IS NOT NULL")
+		} else {
+			b.where("email IS NULL")
+		}
+	}
+	if f.CreatedAfter.Valid {
+		b.where("created_at >= " + b.bind(f.CreatedAfter.Time))
+	}
+	if f.CreatedBefore.Valid {
+		b.where("created_at < " + b.bind(f.CreatedBefore.Time))
+	}
+	if len(f.CustomFields) > 0 {
+		b.where("custom_fields @> " + b.bind(string(f.CustomFields)) + "::jsonb")
+	}
+	if len(f.TagIDs) > 0 {
+		p := b.bind(pq.Array(f.TagIDs))
+		if f.TagsMatchAll {
+			distinct := make(map[uuid.UUID]bool, len(f.TagIDs))
+			for _, id := range f.TagIDs {
+				distinct[id] = true
+			}
+			b.where(fmt.Sprintf("(SELECT count(*) FROM member_tags WHERE member_id = members.id AND tag_id = ANY(%s::uuid[])) = %s",
+				p, b.bind(len(distinct))))
+		} else {
+			b.where(fmt.Sprintf("EXISTS (SELECT 1 FROM member_tags WHERE member_id = members.id AND tag_id = ANY(%s::uuid[]))", p))
+		}
+	}
+	if len(f.Statuses) > 0 {
+		b.where("status = ANY(" + b.bind(pq.Array(f.Statuses)) + "::varchar[])")
+	}
+}
+
+func orderByClause(keys []MemberSortKey) string {
+	terms := make([]string, 0, len(keys)+1)
+	for _, key := range keys {
+		term := memberSortableColumns[key.Column]
+		if key.Desc {
+			term += " DESC"
+		}
+		terms = append(terms, term)
+	}
+	// id breaks ties so that pages stay stable.
+	terms = append(terms, "id")
+	return "ORDER BY " + strings.Join(terms, ", ") + "\n"
+}
+
+// selectMembersByFilter builds the SELECT statement for the members matching the filter in the requested order.
+// search_vector is only used by the search and is left out, as it is not needed to list members.
+func selectMembersByFilter(b *queryBuilder, filter MemberFilter, sort []MemberSortKey) (string, error) {
+	for _, key := range sort {
+		if _, ok := memberSortableColumns[key.Column]; !ok {
+			return "", fmt.Errorf("cannot sort members by %q", key.Column)
+		}
+	}
+
+	filter.apply(b)
+
+	return "SELECT id, first_name, last_name, email, created_at, deleted_at, version, custom_fields, manager_id, avatar_key, avatar_url, status, start_date, end_date, time_zone FROM members\n" +
+		b.whereClause() +
+		orderByClause(sort), nil
+}
+
+// scanMember scans a row selected by selectMembersByFilter, leaving SearchVector unset.
+func scanMember(rows *sql.Rows) (Member, error) {
+	var i Member
+	err := rows.Scan(
+		&i.ID,
+		&i.FirstName,
+		&i.LastName,
+		&i.Email,
+		&i.CreatedAt,
+		&i.DeletedAt,
+		&i.Version,
+		&i.CustomFields,
+		&i.ManagerID,
+		&i.AvatarKey,
+		&i.AvatarUrl,
+		&i.Status,
+		&i.StartDate,
+		&i.EndDate,
+		&i.TimeZone,
+	)
+	return i, err
+}
+
+type ListMembersByFilterParams struct {
+	Filter MemberFilter    `json:"filter"`
+	Sort   []MemberSortKey `json:"sort"`
+	Limit  int32           `json:"limit"`
+	Offset int32           `json:"offset"`
+}
+
+// ListMembersByFilter lists the members matching the filter in the requested order.
+func (q *Queries) ListMembersByFilter(ctx context.Context, arg ListMembersByFilterParams) ([]Member, error) {
+	b := new(queryBuilder)
+	query, err := selectMembersByFilter(b, arg.Filter, arg.Sort)
+	if err != nil {
+		return nil, err
+	}
+	query += "LIMIT " + b.bind(arg.Limit) + "\n" +
+		"OFFSET " + b.bind(arg.Offset)
+
+	rows, err := q.db.QueryContext(ctx, query, b.args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Member{}
+	for rows.Next() {
+		i, err := scanMember(rows)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+type ForEachMemberByFilterParams struct {
+	Filter MemberFilter    `json:"filter"`
+	Sort   []MemberSortKey `json:"sort"`
+}
+
+// ForEachMemberByFilter calls fn for every member matching the filter in the requested order.
+// The members are read row by row from the result cursor instead of being loaded into memory at once,
+// so that all members can be streamed. Iteration stops at the first error returned by fn.
+func (q *Queries) ForEachMemberByFilter(ctx context.Context, arg ForEachMemberByFilterParams, fn func(Member) error) error {
+	b := new(queryBuilder)
+	query, err := selectMembersByFilter(b, arg.Filter, arg.Sort)
+	if err != nil {
+		return err
+	}
+
+	rows, err := q.db.QueryContext(ctx, query, b.args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		i, err := scanMember(rows)
+		if err != nil {
+			return err
+		}
+		if err := fn(i); err != nil {
+			return err
+		}
+	}
+	if err := rows.Close(); err != nil {
+		return err
+	}
+	return rows.Err()
+}
+
+// CountMembersByFilter counts the members matching the filter.
+func (q *Queries) CountMembersByFilter(ctx context.Context, filter MemberFilter) (int64, error) {
+	b := new(queryBuilder)
+	filter.apply(b)
+
+	query := "SELECT count(*) FROM members\n" + b.whereClause()
+
+	row := q.db.QueryRowContext(ctx, query, b.args...)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
@@ -0,0 +1,310 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ot07/coworker-backend/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMemberSort(t *testing.T) {
+	t.Parallel()
+
+	keys, err := ParseMemberSort("last_name,-created_at")
+	require.NoError(t, err)
+	require.Equal(t, []MemberSortKey{
+		{Column: "last_name"},
+		{Column: "created_at", Desc: true},
+	}, keys)
+
+	keys, err = ParseMemberSort("")
+	require.NoError(t, err)
+	require.Empty(t, keys)
+
+	_, err = ParseMemberSort("first_name; DROP TABLE members")
+	require.Error(t, err)
+}
+
+func TestListMembersByFilterQuery(t *testing.T) {
+	t.Parallel()
+
+	tx := beginTransaction(t)
+	defer rollbackTransaction(t, tx)
+
+	testQueries := New(tx)
+
+	for i := 0; i < 5; i++ {
+		createRandomMember(t, testQueries)
+	}
+
+	target, err := testQueries.CreateMember(context.Background(), CreateMemberParams{
+		FirstName: "Taro",
+		LastName:  "Yamada_" + util.RandomString(6),
+		Email:     sql.NullString{String: util.RandomEmail(), Valid: true},
+	})
+	require.NoError(t, err)
+
+	filter := MemberFilter{Query: "taro " + target.LastName}
+	members, err := testQueries.ListMembersByFilter(context.Background(), ListMembersByFilterParams{
+		Filter: filter,
+		Limit:  10,
+		Offset: 0,
+	})
+	require.NoError(t, err)
+	require.Len(t, members, 1)
+	require.Equal(t, target.ID, members[0].ID)
+	require.Nil(t, members[0].SearchVector)
+
+	count, err := testQueries.CountMembersByFilter(context.Background(), filter)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), count)
+
+	// Wildcards in the query are matched literally.
+	count, err = testQueries.CountMembersByFilter(context.Background(), MemberFilter{Query: "%"})
+	require.NoError(t, err)
+	require.Zero(t, count)
+}
+
+func TestListMembersByFilterHasEmail(t *testing.T) {
+	t.Parallel()
+
+	tx := beginTransaction(t)
+	defer rollbackTransaction(t, tx)
+
+	testQueries := New(tx)
+
+	createRandomMember(t, testQueries)
+	withoutEmail, err := testQueries.CreateMember(context.Background(), CreateMemberParams{
+		FirstName: util.RandomName(),
+		LastName:  util.RandomName(),
+	})
+	require.NoError(t, err)
+
+	members, err := testQueries.ListMembersByFilter(context.Background(), ListMembersByFilterParams{
+		Filter: MemberFilter{HasEmail: sql.NullBool{Bool: false, Valid: true}},
+		Limit:  10,
+		Offset: 0,
+	})
+	require.NoError(t, err)
+	require.Len(t, members, 1)
+	require.Equal(t, withoutEmail.ID, members[0].ID)
+
+	count, err := testQueries.CountMembersByFilter(context.Background(), MemberFilter{
+		HasEmail: sql.NullBool{Bool: true, Valid: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), count)
+}
+
+func TestListMembersByFilterCreatedAt(t *testing.T) {
+	t.Parallel()
+
+	tx := beginTransaction(t)
+	defer rollbackTransaction(t, tx)
+
+	testQueries := New(tx)
+
+	createRandomMember(t, testQueries)
+
+	count, err := testQueries.CountMembersByFilter(context.Background(), MemberFilter{
+		CreatedAfter:  sql.NullTime{Time: time.Now().Add(-time.Hour), Valid: true},
+		CreatedBefore: sql.NullTime{Time: time.Now().Add(time.Hour), Valid: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), count)
+
+	count, err = testQueries.CountMembersByFilter(context.Background(), MemberFilter{
+		CreatedAfter: sql.NullTime{Time: time.Now().Add(time.Hour), Valid: true},
+	})
+	require.NoError(t, err)
+	require.Zero(t, count)
+}
+
+func TestListMembersByFilterSort(t *testing.T) {
+	t.Parallel()
+
+	tx := beginTransaction(t)
+	defer rollbackTransaction(t, tx)
+
+	testQueries := New(tx)
+
+	for i := 0; i < 10; i++ {
+		createRandomMember(t, testQueries)
+	}
+
+	members, err := testQueries.ListMembersByFilter(context.Background(), ListMembersByFilterParams{
+		Sort:   []MemberSortKey{{Column: "last_name", Desc: true}},
+		Limit:  10,
+		Offset: 0,
+	})
+	require.NoError(t, err)
+	require.Len(t, members, 10)
+
+	for i := 1; i < len(members); i++ {
+		require.GreaterOrEqual(t, members[i-1].LastName, members[i].LastName)
+	}
+
+	_, err = testQueries.ListMembersByFilter(context.Background(), ListMembersByFilterParams{
+		Sort:  []MemberSortKey{{Column: "id; DROP TABLE members"}},
+		Limit: 10,
+	})
+	require.Error(t, err)
+}
+
+func TestForEachMemberByFilter(t *testing.T) {
+	t.Parallel()
+
+	tx := beginTransaction(t)
+	defer rollbackTransaction(t, tx)
+
+	testQueries := New(tx)
+
+	n := 10
+	for i := 0; i < n; i++ {
+		createRandomMember(t, testQueries)
+	}
+
+	var members []Member
+	err := testQueries.ForEachMemberByFilter(context.Background(), ForEachMemberByFilterParams{
+		Sort: []MemberSortKey{{Column: "first_name"}},
+	}, func(member Member) error {
+		members = append(members, member)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, members, n)
+
+	for i := 1; i < len(members); i++ {
+		require.LessOrEqual(t, members[i-1].FirstName, members[i].FirstName)
+	}
+
+	// Iteration stops at the first error returned by the callback.
+	errStop := errors.New("stop")
+	calls := 0
+	err = testQueries.ForEachMemberByFilter(context.Background(), ForEachMemberByFilterParams{}, func(member Member) error {
+		calls++
+		return errStop
+	})
+	require.ErrorIs(t, err, errStop)
+	require.Equal(t, 1, calls)
+}
+
+func TestListMembersByFilterCustomFields(t *testing.T) {
+	t.Parallel()
+
+	tx := beginTransaction(t)
+	defer rollbackTransaction(t, tx)
+
+	testQueries := New(tx)
+
+	createRandomMember(t, testQueries)
+	member, err := testQueries.CreateMember(context.Background(), CreateMemberParams{
+		FirstName:    util.RandomName(),
+		LastName:     util.RandomName(),
+		CustomFields: `{"department": "Sales", "skills": ["Go", "SQL"]}`,
+	})
+	require.NoError(t, err)
+
+	filter := MemberFilter{CustomFields: json.RawMessage(`{"department": "Sales", "skills": ["Go"]}`)}
+
+	members, err := testQueries.ListMembersByFilter(context.Background(), ListMembersByFilterParams{
+		Filter: filter,
+		Limit:  10,
+		Offset: 0,
+	})
+	require.NoError(t, err)
+	require.Len(t, members, 1)
+	require.Equal(t, member.ID, members[0].ID)
+
+	count, err := testQueries.CountMembersByFilter(context.Background(), MemberFilter{
+		CustomFields: json.RawMessage(`{"department": "Engineering"}`),
+	})
+	require.NoError(t, err)
+	require.Zero(t, count)
+}
+
+func TestListMembersByFilterTags(t *testing.T) {
+	t.Parallel()
+
+	tx := beginTransaction(t)
+	defer rollbackTransaction(t, tx)
+
+	testQueries := New(tx)
+
+	tag1 := createRandomTag(t, testQueries)
+	tag2 := createRandomTag(t, testQueries)
+
+	createRandomMember(t, testQueries)
+	member1 := createRandomMember(t, testQueries)
+	member2 := createRandomMember(t, testQueries)
+
+	_, err := testQueries.AddMemberTags(context.Background(), AddMemberTagsParams{
+		MemberIds: []uuid.UUID{member1.ID, member2.ID},
+		TagIds:    []uuid.UUID{tag1.ID},
+	})
+	require.NoError(t, err)
+	_, err = testQueries.AddMemberTags(context.Background(), AddMemberTagsParams{
+		MemberIds: []uuid.UUID{member2.ID},
+		TagIds:    []uuid.UUID{tag2.ID},
+	})
+	require.NoError(t, err)
+
+	count, err := testQueries.CountMembersByFilter(context.Background(), MemberFilter{
+		TagIDs: []uuid.UUID{tag1.ID, tag2.ID},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(2), count)
+
+	members, err := testQueries.ListMembersByFilter(context.Background(), ListMembersByFilterParams{
+		Filter: MemberFilter{TagIDs: []uuid.UUID{tag1.ID, tag2.ID, tag2.ID}, TagsMatchAll: true},
+		Limit:  10,
+		Offset: 0,
+	})
+	require.NoError(t, err)
+	require.Len(t, members, 1)
+	require.Equal(t, member2.ID, members[0].ID)
+}
+
+func TestListMembersByFilterStatuses(t *testing.T) {
+	t.Parallel()
+
+	tx := beginTransaction(t)
+	defer rollbackTransaction(t, tx)
+
+	testQueries := New(tx)
+
+	query := util.RandomName()
+	active, err := testQueries.CreateMember(context.Background(), CreateMemberParams{
+		FirstName: query,
+		LastName:  util.RandomName(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, MemberStatusActive, active.Status)
+
+	invited, err := testQueries.CreateMember(context.Background(), CreateMemberParams{
+		FirstName: query,
+		LastName:  util.RandomName(),
+		Status:    MemberStatusInvited,
+	})
+	require.NoError(t, err)
+
+	members, err := testQueries.ListMembersByFilter(context.Background(), ListMembersByFilterParams{
+		Filter: MemberFilter{Query: query, Statuses: []string{MemberStatusInvited, MemberStatusOnboarding}},
+		Limit:  10,
+		Offset: 0,
+	})
+	require.NoError(t, err)
+	require.Len(t, members, 1)
+	require.Equal(t, invited.ID, members[0].ID)
+	require.Equal(t, MemberStatusInvited, members[0].Status)
+
+	count, err := testQueries.CountMembersByFilter(context.Background(), MemberFilter{Query: query})
+	require.NoError(t, err)
+	require.Equal(t, int64(2), count)
+}
@@ -0,0 +1,143 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomMemberImportJob(t *testing.T, testQueries *Queries) MemberImportJob {
+	arg := CreateMemberImportJobParams{
+		DryRun:    true,
+		TotalRows: 100,
+	}
+
+	job, err := testQueries.CreateMemberImportJob(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, job)
+
+	require.Equal(t, arg.DryRun, job.DryRun)
+	require.Equal(t, arg.TotalRows, job.TotalRows)
+	require.Equal(t, "running", job.Status)
+	require.Zero(t, job.ImportedRows)
+	require.JSONEq(t, "[]", string(job.Errors))
+	require.False(t, job.FinishedAt.Valid)
+
+	require.NotEmpty(t, job.ID)
+	require.NotZero(t, job.CreatedAt)
+
+	return job
+}
+
+func TestCreateMemberImportJob(t *testing.T) {
+	t.Parallel()
+
+	tx := beginTransaction(t)
+	defer rollbackTransaction(t, tx)
+
+	testQueries := New(tx)
+
+	createRandomMemberImportJob(t, testQueries)
+}
+
+func TestGetMemberImportJob(t *testing.T) {
+	t.Parallel()
+
+	tx := beginTransaction(t)
+	defer rollbackTransaction(t, tx)
+
+	testQueries := New(tx)
+
+	job1 := createRandomMemberImportJob(t, testQueries)
+	job2, err := testQueries.GetMemberImportJob(context.Background(), job1.ID)
+	require.NoError(t, err)
+	require.NotEmpty(t, job2)
+
+	require.Equal(t, job1.ID, job2.ID)
+	require.Equal(t, job1.Status, job2.Status)
+	require.Equal(t, job1.TotalRows, job2.TotalRows)
+	require.WithinDuration(t, job1.CreatedAt, job2.CreatedAt, time.Second)
+}
+
+func TestFinishMemberImportJob(t *testing.T) {
+	t.Parallel()
+
+	tx := beginTransaction(t)
+	defer rollbackTransaction(t, tx)
+
+	testQueries := New(tx)
+
+	job := createRandomMemberImportJob(t, testQueries)
+	errors := json.RawMessage(`[{"row": 2, "field": "email", "message": "failed on the 'email' tag"}]`)
+
+	arg := FinishMemberImportJobParams{
+		ID:           job.ID,
+		Status:       "failed",
+		ImportedRows: 0,
+		Errors:       errors,
+	}
+
+	finishedJob, err := testQueries.FinishMemberImportJob(context.Background(), arg)
+	require.NoError(t, err)
+
+	require.Equal(t, job.ID, finishedJob.ID)
+	require.Equal(t, arg.Status, finishedJob.Status)
+	require.JSONEq(t, string(errors), string(finishedJob.Errors))
+	require.True(t, finishedJob.FinishedAt.Valid)
+	require.WithinDuration(t, time.Now(), finishedJob.FinishedAt.Time, time.Minute)
+}
+
+func TestFinishMemberImportJobInvalidStatus(t *testing.T) {
+	t.Parallel()
+
+	tx := beginTransaction(t)
+	defer rollbackTransaction(t, tx)
+
+	testQueries := New(tx)
+
+	job := createRandomMemberImportJob(t, testQueries)
+
+	_, err := testQueries.FinishMemberImportJob(context.Background(), FinishMemberImportJobParams{
+		ID:     job.ID,
+		Status: "unknown",
+		Errors: json.RawMessage("[]"),
+	})
+	require.Error(t, err)
+}
+
+func TestFailRunningMemberImportJobs(t *testing.T) {
+	t.Parallel()
+
+	tx := beginTransaction(t)
+	defer rollbackTransaction(t, tx)
+
+	testQueries := New(tx)
+	ctx := context.Background()
+
+	running := createRandomMemberImportJob(t, testQueries)
+	finished := createRandomMemberImportJob(t, testQueries)
+	finished, err := testQueries.FinishMemberImportJob(ctx, FinishMemberImportJobParams{
+		ID:     finished.ID,
+		Status: "succeeded",
+		Errors: json.RawMessage(`[]`),
+	})
+	require.NoError(t, err)
+
+	errors := json.RawMessage(`[{"row": 0, "message": "interrupted"}]`)
+	failed, err := testQueries.FailRunningMemberImportJobs(ctx, errors)
+	require.NoError(t, err)
+	require.NotZero(t, failed)
+
+	job, err := testQueries.GetMemberImportJob(ctx, running.ID)
+	require.NoError(t, err)
+	require.Equal(t, "failed", job.Status)
+	require.JSONEq(t, string(errors), string(job.Errors))
+	require.True(t, job.FinishedAt.Valid)
+
+	job, err = testQueries.GetMemberImportJob(ctx, finished.ID)
+	require.NoError(t, err)
+	require.Equal(t, "succeeded", job.Status)
+}
@@ -0,0 +1,221 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func setRandomMemberManager(t *testing.T, testQueries *Queries, member, manager Member) Member {
+	arg := SetMemberManagerParams{
+		ID:        member.ID,
+		ManagerID: uuid.NullUUID{UUID: manager.ID, Valid: true},
+	}
+
+	updated, err := testQueries.SetMemberManager(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.ManagerID, updated.ManagerID)
+	require.Equal(t, member.Version+1, updated.Version)
+
+	return updated
+}
+
+func TestSetMemberManager(t *testing.T) {
+	t.Parallel()
+
+	tx := beginTransaction(t)
+	defer rollbackTransaction(t, tx)
+
+	testQueries := New(tx)
+
+	manager := createRandomMember(t, testQueries)
+	member := createRandomMember(t, testQueries)
+	require.False(t, member.ManagerID.Valid)
+
+	member = setRandomMemberManager(t, testQueries, member, manager)
+
+	cleared, err := testQueries.SetMemberManager(context.Background(), SetMemberManagerParams{ID: member.ID})
+	require.NoError(t, err)
+	require.False(t, cleared.ManagerID.Valid)
+
+	// A member cannot be their own manager.
+	_, err = testQueries.SetMemberManager(context.Background(), SetMemberManagerParams{
+		ID:        member.ID,
+		ManagerID: uuid.NullUUID{UUID: member.ID, Valid: true},
+	})
+	require.Error(t, err)
+}
+
+func TestSetMemberManagerDeleted(t *testing.T) {
+	t.Parallel()
+
+	tx := beginTransaction(t)
+	defer rollbackTransaction(t, tx)
+
+	testQueries := New(tx)
+
+	manager := createRandomMember(t, testQueries)
+	member := createRandomMember(t, testQueries)
+
+	_, err := testQueries.DeleteMembers(context.Background(), []uuid.UUID{member.ID})
+	require.NoError(t, err)
+
+	_, err = testQueries.SetMemberManager(context.Background(), SetMemberManagerParams{
+		ID:        member.ID,
+		ManagerID: uuid.NullUUID{UUID: manager.ID, Valid: true},
+	})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestIsMemberReport(t *testing.T) {
+	t.Parallel()
+
+	tx := beginTransaction(t)
+	defer rollbackTransaction(t, tx)
+
+	testQueries := New(tx)
+
+	root := createRandomMember(t, testQueries)
+	manager := setRandomMemberManager(t, testQueries, createRandomMember(t, testQueries), root)
+	report := setRandomMemberManager(t, testQueries, createRandomMember(t, testQueries), manager)
+	other := createRandomMember(t, testQueries)
+
+	ok, err := testQueries.IsMemberReport(context.Background(), IsMemberReportParams{
+		ManagerID: root.ID,
+		MemberID:  report.ID,
+	})
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	ok, err = testQueries.IsMemberReport(context.Background(), IsMemberReportParams{
+		ManagerID: report.ID,
+		MemberID:  root.ID,
+	})
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	ok, err = testQueries.IsMemberReport(context.Background(), IsMemberReportParams{
+		ManagerID: root.ID,
+		MemberID:  other.ID,
+	})
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+func TestListMemberReports(t *testing.T) {
+	t.Parallel()
+
+	tx := beginTransaction(t)
+	defer rollbackTransaction(t, tx)
+
+	testQueries := New(tx)
+
+	root := createRandomMember(t, testQueries)
+	manager := setRandomMemberManager(t, testQueries, createRandomMember(t, testQueries), root)
+	report := setRandomMemberManager(t, testQueries, createRandomMember(t, testQueries), manager)
+	deleted := setRandomMemberManager(t, testQueries, createRandomMember(t, testQueries), root)
+
+	_, err := testQueries.DeleteMembers(context.Background(), []uuid.UUID{deleted.ID})
+	require.NoError(t, err)
+
+	rows, err := testQueries.ListMemberReports(context.Background(), ListMemberReportsParams{
+		Limit:     10,
+		Offset:    0,
+		ManagerID: root.ID,
+	})
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	require.Equal(t, manager.ID, rows[0].ID)
+	require.Equal(t, int32(1), rows[0].Depth)
+
+	count, err := testQueries.CountMemberReports(context.Background(), CountMemberReportsParams{
+		ManagerID: root.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), count)
+
+	rows, err = testQueries.ListMemberReports(context.Background(), ListMemberReportsParams{
+		Limit:     10,
+		Offset:    0,
+		ManagerID: root.ID,
+		Recursive: true,
+	})
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	require.Equal(t, manager.ID, rows[0].ID)
+	require.Equal(t, int32(1), rows[0].Depth)
+	require.Equal(t, report.ID, rows[1].ID)
+	require.Equal(t, int32(2), rows[1].Depth)
+	require.Equal(t, uuid.NullUUID{UUID: manager.ID, Valid: true}, rows[1].ManagerID)
+
+	count, err = testQueries.CountMemberReports(context.Background(), CountMemberReportsParams{
+		ManagerID: root.ID,
+		Recursive: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(2), count)
+}
+
+func TestListMemberChain(t *testing.T) {
+	t.Parallel()
+
+	tx := beginTransaction(t)
+	defer rollbackTransaction(t, tx)
+
+	testQueries := New(tx)
+
+	root := createRandomMember(t, testQueries)
+	manager := setRandomMemberManager(t, testQueries, createRandomMember(t, testQueries), root)
+	report := setRandomMemberManager(t, testQueries, createRandomMember(t, testQueries), manager)
+
+	rows, err := testQueries.ListMemberChain(context.Background(), report.ID)
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	require.Equal(t, manager.ID, rows[0].ID)
+	require.Equal(t, int32(1), rows[0].Depth)
+	require.Equal(t, root.ID, rows[1].ID)
+	require.Equal(t, int32(2), rows[1].Depth)
+
+	rows, err = testQueries.ListMemberChain(context.Background(), root.ID)
+	require.NoError(t, err)
+	require.Empty(t, rows)
+
+	// The chain stops at a manager in the trash.
+	_, err = testQueries.DeleteMembers(context.Background(), []uuid.UUID{manager.ID})
+	require.NoError(t, err)
+
+	rows, err = testQueries.ListMemberChain(context.Background(), report.ID)
+	require.NoError(t, err)
+	require.Empty(t, rows)
+}
+
+func TestListOrgChartMembers(t *testing.T) {
+	t.Parallel()
+
+	tx := beginTransaction(t)
+	defer rollbackTransaction(t, tx)
+
+	testQueries := New(tx)
+
+	manager := createRandomMember(t, testQueries)
+	report := setRandomMemberManager(t, testQueries, createRandomMember(t, testQueries), manager)
+	deleted := createRandomMember(t, testQueries)
+
+	_, err := testQueries.DeleteMembers(context.Background(), []uuid.UUID{deleted.ID})
+	require.NoError(t, err)
+
+	rows, err := testQueries.ListOrgChartMembers(context.Background())
+	require.NoError(t, err)
+
+	managerIDs := make(map[uuid.UUID]uuid.NullUUID)
+	for _, row := range rows {
+		managerIDs[row.ID] = row.ManagerID
+	}
+	require.Contains(t, managerIDs, manager.ID)
+	require.False(t, managerIDs[manager.ID].Valid)
+	require.Equal(t, uuid.NullUUID{UUID: manager.ID, Valid: true}, managerIDs[report.ID])
+	require.NotContains(t, managerIDs, deleted.ID)
+}
@@ -0,0 +1,368 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// Fields of a member whose value MergeMembersTx can take from any of the members merged.
+const (
+	MergeFieldFirstName = "first_name"
+	MergeFieldLastName  = "last_name"
+	MergeFieldEmail     = "email"
+	MergeFieldManagerID = "manager_id"
+	MergeFieldAvatar    = "avatar"
+	// MergeFieldCustomFieldPrefix prefixes the key of a custom field, as in "custom_fields.department".
+	MergeFieldCustomFieldPrefix = "custom_fields."
+)
+
+// ErrInvalidMergeField is returned when a merge names an unknown field or takes a field from a member not merged.
+var ErrInvalidMergeField = errors.New("invalid merge field")
+
+// ErrMergeSurvivor is returned when the survivor of a merge is also listed among the members merged into it.
+var ErrMergeSurvivor = errors.New("a member cannot be merged into itself")
+
+// MergeMembersTxParams contains the input parameters of MergeMembersTx.
+type MergeMembersTxParams struct {
+	SurvivorID uuid.UUID
+	// MemberIDs lists the members merged into the survivor, which are moved to the trash.
+	MemberIDs []uuid.UUID
+	// Fields maps a field of the survivor to the member, the survivor or a merged one, whose value it takes.
+	// A field left out keeps the value of the survivor or, when the survivor has none, takes that of the first merged member with one.
+	Fields map[string]uuid.UUID
+}
+
+// MergeMembersTxResult is the result of MergeMembersTx.
+type MergeMembersTxResult struct {
+	Member Member
+	// Merged lists the merged members as they are left in the trash.
+	Merged []Member
+	// UnusedAvatarKeys lists the avatars no member refers to after the merge, whose files can be removed.
+	UnusedAvatarKeys []string
+}
+
+// MergeMembersTx merges members into a survivor within a single database transaction.
+// Their tags, teams, contact details, desk bookings, room reservations, attendances, leaves, working hours, time entries,
+// timesheets, project assignments, skills, reports and history move to the survivor, settling what clashes with the
+// survivor, and they are moved to the trash, which is recorded in the audit trail of the survivor and of every merged member.
+// sql.ErrNoRows is returned when any of the members does not exist or is in the trash.
+func (store *SQLStore) MergeMembersTx(ctx context.Context, arg MergeMembersTxParams, meta AuditMeta) (MergeMembersTxResult, error) {
+	var result MergeMembersTxResult
+
+	memberIDs := make([]uuid.UUID, 0, len(arg.MemberIDs))
+	seen := make(map[uuid.UUID]bool, len(arg.MemberIDs))
+	for _, id := range arg.MemberIDs {
+		if id == arg.SurvivorID {
+			return MergeMembersTxResult{}, ErrMergeSurvivor
+		}
+		if !seen[id] {
+			seen[id] = true
+			memberIDs = append(memberIDs, id)
+		}
+	}
+	arg.MemberIDs = memberIDs
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		// Reports of the merged members move to the survivor, so reporting lines are changed one at a time as in SetMemberManagerTx.
+		if err := q.LockReportingLines(ctx); err != nil {
+			return err
+		}
+
+		ids := append([]uuid.UUID{arg.SurvivorID}, arg.MemberIDs...)
+		locked, err := q.ListMembersForUpdate(ctx, ids)
+		if err != nil {
+			return err
+		}
+		byID := make(map[uuid.UUID]Member, len(locked))
+		for _, member := range locked {
+			if !member.DeletedAt.Valid {
+				byID[member.ID] = member
+			}
+		}
+
+		sources := make([]Member, 0, len(ids))
+		for _, id := range ids {
+			member, ok := byID[id]
+			if !ok {
+				return sql.ErrNoRows
+			}
+			sources = append(sources, member)
+		}
+		survivor := sources[0]
+
+		values, err := mergeMemberValues(sources, arg.Fields)
+		if err != nil {
+			return err
+		}
+
+		if _, err := q.MoveMemberAuditEvents(ctx, MoveMemberAuditEventsParams{SurvivorID: survivor.ID, MemberIds: arg.MemberIDs}); err != nil {
+			return err
+		}
+		if err := q.MoveMemberTags(ctx, MoveMemberTagsParams{MemberIds: arg.MemberIDs, SurvivorID: survivor.ID}); err != nil {
+			return err
+		}
+		if err := q.MoveTeamMembers(ctx, MoveTeamMembersParams{MemberIds: arg.MemberIDs, SurvivorID: survivor.ID}); err != nil {
+			return err
+		}
+		if err := q.MoveMemberEmails(ctx, MoveMemberEmailsParams{MemberIds: arg.MemberIDs, SurvivorID: survivor.ID}); err != nil {
+			return err
+		}
+		if err := q.MoveMemberPhones(ctx, MoveMemberPhonesParams{MemberIds: arg.MemberIDs, SurvivorID: survivor.ID}); err != nil {
+			return err
+		}
+		if err := q.MoveMemberAddresses(ctx, MoveMemberAddressesParams{MemberIds: arg.MemberIDs, SurvivorID: survivor.ID}); err != nil {
+			return err
+		}
+		if err := q.MoveMemberLinks(ctx, MoveMemberLinksParams{MemberIds: arg.MemberIDs, SurvivorID: survivor.ID}); err != nil {
+			return err
+		}
+		// A booking clashing with one of the survivor or of a member listed before is canceled, as the survivor cannot sit at two desks.
+		if err := q.MoveMemberDeskBookings(ctx, MoveMemberDeskBookingsParams{MemberIds: arg.MemberIDs, SurvivorID: survivor.ID}); err != nil {
+			return err
+		}
+		if err := q.MoveMemberRoomReservations(ctx, MoveMemberRoomReservationsParams{SurvivorID: survivor.ID, MemberIds: arg.MemberIDs}); err != nil {
+			return err
+		}
+		// A member has a single calendar feed, so the survivor only takes one over when it has none, keeping its subscribers.
+		if err := q.MoveMemberCalendarToken(ctx, MoveMemberCalendarTokenParams{SurvivorID: survivor.ID, MemberIds: arg.MemberIDs}); err != nil {
+			return err
+		}
+		// The survivor can only be checked in once, so the other members still checked in are checked out as if they had not done so.
+		if err := q.MoveMemberAttendances(ctx, MoveMemberAttendancesParams{MemberIds: arg.MemberIDs, SurvivorID: survivor.ID}); err != nil {
+			return err
+		}
+		// A leave clashing with one of the survivor or of a member listed before is canceled, as the survivor cannot be away twice.
+		if err := q.MoveMemberLeaveRequests(ctx, MoveMemberLeaveRequestsParams{MemberIds: arg.MemberIDs, SurvivorID: survivor.ID}); err != nil {
+			return err
+		}
+		// Working hours only make sense as a whole and in their own time zone, so the survivor only takes over those of
+		// the first merged member with any, along with its time zone, when it has none.
+		if err := q.MoveMemberWorkingHours(ctx, MoveMemberWorkingHoursParams{MemberIds: arg.MemberIDs, SurvivorID: survivor.ID}); err != nil {
+			return err
+		}
+		// An entry tracked at the same time as one of the survivor or of a member listed before keeps its duration only,
+		// as the survivor cannot track two entries at once.
+		if err := q.MoveMemberTimeEntries(ctx, MoveMemberTimeEntriesParams{MemberIds: arg.MemberIDs, SurvivorID: survivor.ID}); err != nil {
+			return err
+		}
+		if err := q.MoveMemberTimesheets(ctx, MoveMemberTimesheetsParams{SurvivorID: survivor.ID, MemberIds: arg.MemberIDs}); err != nil {
+			return err
+		}
+		// An assignment to a project the survivor, or a member listed before, is assigned to over the same days is the
+		// same assignment twice, so it stays in the trash. The allocation limit is left to be checked on the next change.
+		if err := q.MoveMemberProjectAssignments(ctx, MoveMemberProjectAssignmentsParams{SurvivorID: survivor.ID, MemberIds: arg.MemberIDs}); err != nil {
+			return err
+		}
+
+		trashed, err := q.TrashMergedMembers(ctx, arg.MemberIDs)
+		if err != nil {
+			return err
+		}
+
+		values.params.ID = survivor.ID
+		member, err := q.MergeMember(ctx, values.params)
+		if err != nil {
+			return err
+		}
+
+		// The email moved over to the survivor along with the other emails, so it only has to become the primary one.
+		if values.email != survivor.Email {
+			if err := q.UnsetPrimaryMemberEmail(ctx, survivor.ID); err != nil {
+				return err
+			}
+			if values.email.Valid {
+				promoted, err := q.PromoteMemberEmail(ctx, PromoteMemberEmailParams{MemberID: survivor.ID, Email: values.email.String})
+				if err != nil {
+					return err
+				}
+				if promoted == 0 {
+					_, err = q.CreateMemberEmail(ctx, CreateMemberEmailParams{
+						MemberID:  survivor.ID,
+						Label:     DefaultMemberEmailLabel,
+						Email:     values.email.String,
+						IsPrimary: true,
+					})
+					if err != nil {
+						return err
+					}
+				}
+			}
+			if member, err = q.SyncMemberEmail(ctx, survivor.ID); err != nil {
+				return err
+			}
+		}
+
+		// A skill both members have keeps the highest level. An endorsement by the user the survivor now is would be
+		// one of its own, which is not allowed, so it is dropped, as the email of the survivor may have changed above.
+		if err := q.MoveMemberSkills(ctx, MoveMemberSkillsParams{MemberIds: arg.MemberIDs, SurvivorID: survivor.ID}); err != nil {
+			return err
+		}
+		if err := q.DeleteSelfSkillEndorsements(ctx, survivor.ID); err != nil {
+			return err
+		}
+
+		reports, err := q.ListDirectReportsForUpdate(ctx, ListDirectReportsForUpdateParams{
+			ManagerIds: arg.MemberIDs,
+			ExcludeID:  survivor.ID,
+		})
+		if err != nil {
+			return err
+		}
+		for i := range reports {
+			report, err := q.SetMemberManager(ctx, SetMemberManagerParams{
+				ManagerID: uuid.NullUUID{UUID: survivor.ID, Valid: true},
+				ID:        reports[i].ID,
+			})
+			if err != nil {
+				return err
+			}
+			if err := q.createMemberAuditEvent(ctx, meta, AuditActionUpdate, &reports[i], &report); err != nil {
+				return err
+			}
+		}
+
+		// The survivor can end up below one of the reports it took over from a merged member.
+		if member.ManagerID.Valid {
+			report, err := q.IsMemberReport(ctx, IsMemberReportParams{ManagerID: survivor.ID, MemberID: member.ManagerID.UUID})
+			if err != nil {
+				return err
+			}
+			if report {
+				return ErrManagerCycle
+			}
+		}
+
+		err = q.createMemberAuditEventWithChanges(ctx, meta, AuditActionMerge, &survivor, &member, map[string]AuditFieldChange{
+			"merged_ids": {Before: nil, After: arg.MemberIDs},
+		})
+		if err != nil {
+			return err
+		}
+
+		trashedByID := make(map[uuid.UUID]Member, len(trashed))
+		for _, member := range trashed {
+			trashedByID[member.ID] = member
+		}
+		result.Merged = make([]Member, 0, len(trashed))
+		for _, before := range sources[1:] {
+			after := trashedByID[before.ID]
+			err := q.createMemberAuditEventWithChanges(ctx, meta, AuditActionMerge, &before, &after, map[string]AuditFieldChange{
+				"merged_into": {Before: nil, After: survivor.ID},
+			})
+			if err != nil {
+				return err
+			}
+			result.Merged = append(result.Merged, after)
+
+			if before.AvatarKey.Valid && before.AvatarKey != member.AvatarKey {
+				result.UnusedAvatarKeys = append(result.UnusedAvatarKeys, before.AvatarKey.String)
+			}
+		}
+		if survivor.AvatarKey.Valid && survivor.AvatarKey != member.AvatarKey {
+			result.UnusedAvatarKeys = append(result.UnusedAvatarKeys, survivor.AvatarKey.String)
+		}
+
+		result.Member = member
+		return nil
+	})
+	if err != nil {
+		return MergeMembersTxResult{}, err
+	}
+
+	return result, nil
+}
+
+// mergedMemberValues holds the values a merge leaves the survivor with.
+type mergedMemberValues struct {
+	params MergeMemberParams
+	email  sql.NullString
+}
+
+// mergeMemberValues picks the values of the survivor, sources[0], among sources as fields selects them.
+func mergeMemberValues(sources []Member, fields map[string]uuid.UUID) (mergedMemberValues, error) {
+	byID := make(map[uuid.UUID]Member, len(sources))
+	for _, member := range sources {
+		byID[member.ID] = member
+	}
+
+	for field, id := range fields {
+		switch field {
+		case MergeFieldFirstName, MergeFieldLastName, MergeFieldEmail, MergeFieldManagerID, MergeFieldAvatar:
+		default:
+			if !strings.HasPrefix(field, MergeFieldCustomFieldPrefix) || len(field) == len(MergeFieldCustomFieldPrefix) {
+				return mergedMemberValues{}, fmt.Errorf("%w: unknown field %q", ErrInvalidMergeField, field)
+			}
+		}
+		if _, ok := byID[id]; !ok {
+			return mergedMemberValues{}, fmt.Errorf("%w: %s is taken from %s, which is not merged", ErrInvalidMergeField, field, id)
+		}
+	}
+
+	pick := func(field string, has func(Member) bool) Member {
+		if id, ok := fields[field]; ok {
+			return byID[id]
+		}
+		for _, member := range sources {
+			if has(member) {
+				return member
+			}
+		}
+		return sources[0]
+	}
+	always := func(Member) bool { return true }
+
+	var values mergedMemberValues
+	values.params.FirstName = pick(MergeFieldFirstName, always).FirstName
+	values.params.LastName = pick(MergeFieldLastName, always).LastName
+	values.email = pick(MergeFieldEmail, func(member Member) bool { return member.Email.Valid }).Email
+
+	// None of the members merged is left to report to, the survivor included.
+	values.params.ManagerID = pick(MergeFieldManagerID, func(member Member) bool { return member.ManagerID.Valid }).ManagerID
+	if _, ok := byID[values.params.ManagerID.UUID]; ok {
+		values.params.ManagerID = uuid.NullUUID{}
+	}
+
+	avatar := pick(MergeFieldAvatar, func(member Member) bool { return member.AvatarKey.Valid })
+	values.params.AvatarKey = avatar.AvatarKey
+	values.params.AvatarUrl = avatar.AvatarUrl
+
+	customFields := make(map[string]json.RawMessage)
+	sourceCustomFields := make(map[uuid.UUID]map[string]json.RawMessage, len(sources))
+	for _, member := range sources {
+		var memberFields map[string]json.RawMessage
+		if err := json.Unmarshal(member.CustomFields, &memberFields); err != nil {
+			return mergedMemberValues{}, err
+		}
+		sourceCustomFields[member.ID] = memberFields
+		for key, value := range memberFields {
+			if _, ok := customFields[key]; !ok {
+				customFields[key] = value
+			}
+		}
+	}
+	for field, id := range fields {
+		if !strings.HasPrefix(field, MergeFieldCustomFieldPrefix) {
+			continue
+		}
+		key := strings.TrimPrefix(field, MergeFieldCustomFieldPrefix)
+		if value, ok := sourceCustomFields[id][key]; ok {
+			customFields[key] = value
+		} else {
+			delete(customFields, key)
+		}
+	}
+
+	data, err := json.Marshal(customFields)
+	if err != nil {
+		return mergedMemberValues{}, err
+	}
+	values.params.CustomFields = string(data)
+
+	return values, nil
+}
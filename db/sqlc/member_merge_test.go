@@ -0,0 +1,693 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ot07/coworker-backend/util"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomMemberWithEmail(t *testing.T, testQueries *Queries, firstName, lastName, email string) Member {
+	member, err := testQueries.CreateMember(context.Background(), CreateMemberParams{
+		FirstName: firstName,
+		LastName:  lastName,
+		Email:     sql.NullString{String: email, Valid: len(email) > 0},
+	})
+	require.NoError(t, err)
+
+	err = testQueries.syncPrimaryMemberEmail(context.Background(), Member{}, member)
+	require.NoError(t, err)
+
+	return member
+}
+
+func TestListMemberDuplicatePairs(t *testing.T) {
+	t.Parallel()
+
+	tx := beginTransaction(t)
+	defer rollbackTransaction(t, tx)
+
+	testQueries := New(tx)
+
+	local := util.RandomName()
+	byEmail1 := createRandomMemberWithEmail(t, testQueries, util.RandomName(), util.RandomName(), local+"@example.com")
+	byEmail2 := createRandomMemberWithEmail(t, testQueries, util.RandomName(), util.RandomName(), local+"+work@Example.com")
+
+	lastName := util.RandomName()
+	byName1 := createRandomMemberWithEmail(t, testQueries, "Katherine", lastName, "")
+	byName2 := createRandomMemberWithEmail(t, testQueries, "Katharine", lastName, "")
+
+	pairs, err := testQueries.ListMemberDuplicatePairs(context.Background(), ListMemberDuplicatePairsParams{
+		MinNameSimilarity: 0.6,
+		PairLimit:         1000,
+	})
+	require.NoError(t, err)
+
+	find := func(a, b uuid.UUID) (ListMemberDuplicatePairsRow, bool) {
+		for _, pair := range pairs {
+			if (pair.MemberID == a && pair.DuplicateID == b) || (pair.MemberID == b && pair.DuplicateID == a) {
+				return pair, true
+			}
+		}
+		return ListMemberDuplicatePairsRow{}, false
+	}
+
+	pair, ok := find(byEmail1.ID, byEmail2.ID)
+	require.True(t, ok)
+	require.True(t, pair.SameEmail)
+
+	pair, ok = find(byName1.ID, byName2.ID)
+	require.True(t, ok)
+	require.False(t, pair.SameEmail)
+	require.GreaterOrEqual(t, pair.NameSimilarity, float32(0.6))
+
+	_, err = testQueries.DeleteMembers(context.Background(), []uuid.UUID{byName2.ID})
+	require.NoError(t, err)
+
+	pairs, err = testQueries.ListMemberDuplicatePairs(context.Background(), ListMemberDuplicatePairsParams{
+		MinNameSimilarity: 0.6,
+		PairLimit:         1000,
+	})
+	require.NoError(t, err)
+
+	_, ok = find(byName1.ID, byName2.ID)
+	require.False(t, ok)
+}
+
+func TestMergeMembersTx(t *testing.T) {
+	t.Parallel()
+
+	store := NewStore(testDB)
+	ctx := context.Background()
+
+	survivor := createRandomMemberWithEmail(t, store.Queries, util.RandomName(), util.RandomName(), util.RandomEmail())
+	member := createRandomMemberWithEmail(t, store.Queries, util.RandomName(), util.RandomName(), util.RandomEmail())
+	report := createRandomMember(t, store.Queries)
+	setRandomMemberManager(t, store.Queries, report, member)
+
+	tag, err := store.CreateTag(ctx, util.RandomName())
+	require.NoError(t, err)
+	_, err = store.AddMemberTags(ctx, AddMemberTagsParams{MemberIds: []uuid.UUID{member.ID}, TagIds: []uuid.UUID{tag.ID}})
+	require.NoError(t, err)
+
+	team, err := store.CreateTeam(ctx, CreateTeamParams{Name: util.RandomName()})
+	require.NoError(t, err)
+	_, err = store.AddTeamMember(ctx, AddTeamMemberParams{TeamID: team.ID, MemberID: member.ID, Role: "lead"})
+	require.NoError(t, err)
+
+	_, err = store.CreateMemberPhone(ctx, CreateMemberPhoneParams{MemberID: member.ID, Label: "mobile", Number: "+819012345678"})
+	require.NoError(t, err)
+
+	meta := AuditMeta{RequestID: util.RandomName()}
+	result, err := store.MergeMembersTx(ctx, MergeMembersTxParams{
+		SurvivorID: survivor.ID,
+		MemberIDs:  []uuid.UUID{member.ID},
+		Fields: map[string]uuid.UUID{
+			MergeFieldFirstName: member.ID,
+			MergeFieldEmail:     member.ID,
+		},
+	}, meta)
+	require.NoError(t, err)
+
+	merged := result.Member
+	require.Equal(t, survivor.ID, merged.ID)
+	require.Equal(t, member.FirstName, merged.FirstName)
+	require.Equal(t, survivor.LastName, merged.LastName)
+	require.Equal(t, member.Email, merged.Email)
+	require.Equal(t, survivor.Version+1, merged.Version)
+
+	require.Len(t, result.Merged, 1)
+	require.Equal(t, member.ID, result.Merged[0].ID)
+	require.True(t, result.Merged[0].DeletedAt.Valid)
+	require.False(t, result.Merged[0].Email.Valid)
+
+	emails, err := store.ListMemberEmails(ctx, survivor.ID)
+	require.NoError(t, err)
+	require.Len(t, emails, 2)
+	require.True(t, emails[0].IsPrimary)
+	require.Equal(t, member.Email.String, emails[0].Email)
+	require.Equal(t, survivor.Email.String, emails[1].Email)
+
+	phones, err := store.ListMemberPhones(ctx, survivor.ID)
+	require.NoError(t, err)
+	require.Len(t, phones, 1)
+
+	tags, err := store.ListTagsByMemberIDs(ctx, []uuid.UUID{survivor.ID, member.ID})
+	require.NoError(t, err)
+	require.Len(t, tags, 1)
+	require.Equal(t, survivor.ID, tags[0].MemberID)
+
+	teamMembers, err := store.ListTeamMembers(ctx, ListTeamMembersParams{Limit: 10, TeamID: team.ID})
+	require.NoError(t, err)
+	require.Len(t, teamMembers, 1)
+	require.Equal(t, survivor.ID, teamMembers[0].ID)
+	require.Equal(t, "lead", teamMembers[0].Role)
+
+	movedReport, err := store.GetMember(ctx, report.ID)
+	require.NoError(t, err)
+	require.Equal(t, uuid.NullUUID{UUID: survivor.ID, Valid: true}, movedReport.ManagerID)
+
+	events, err := store.ListAuditEvents(ctx, ListAuditEventsParams{
+		Limit:      10,
+		EntityType: sql.NullString{String: AuditEntityMember, Valid: true},
+		EntityID:   uuid.NullUUID{UUID: member.ID, Valid: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, AuditActionMerge, events[0].Action)
+
+	events, err = store.ListAuditEvents(ctx, ListAuditEventsParams{
+		Limit:      10,
+		EntityType: sql.NullString{String: AuditEntityMember, Valid: true},
+		EntityID:   uuid.NullUUID{UUID: survivor.ID, Valid: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, AuditActionMerge, events[0].Action)
+
+	var changes map[string]AuditFieldChange
+	err = json.Unmarshal(events[0].Changes, &changes)
+	require.NoError(t, err)
+	require.Contains(t, changes, "merged_ids")
+	require.Contains(t, changes, "first_name")
+}
+
+func TestMergeMembersTxErrors(t *testing.T) {
+	t.Parallel()
+
+	store := NewStore(testDB)
+	ctx := context.Background()
+
+	survivor := createRandomMember(t, store.Queries)
+	member := createRandomMember(t, store.Queries)
+
+	_, err := store.MergeMembersTx(ctx, MergeMembersTxParams{
+		SurvivorID: survivor.ID,
+		MemberIDs:  []uuid.UUID{survivor.ID},
+	}, AuditMeta{})
+	require.ErrorIs(t, err, ErrMergeSurvivor)
+
+	_, err = store.MergeMembersTx(ctx, MergeMembersTxParams{
+		SurvivorID: survivor.ID,
+		MemberIDs:  []uuid.UUID{util.RandomUUID()},
+	}, AuditMeta{})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	_, err = store.MergeMembersTx(ctx, MergeMembersTxParams{
+		SurvivorID: survivor.ID,
+		MemberIDs:  []uuid.UUID{member.ID},
+		Fields:     map[string]uuid.UUID{MergeFieldFirstName: util.RandomUUID()},
+	}, AuditMeta{})
+	require.ErrorIs(t, err, ErrInvalidMergeField)
+
+	// Nothing is merged when the merge fails.
+	_, err = store.GetMember(ctx, member.ID)
+	require.NoError(t, err)
+}
+
+func TestMergeMembersTxDeskBookings(t *testing.T) {
+	t.Parallel()
+
+	store := NewStore(testDB)
+	ctx := context.Background()
+
+	location := createRandomLocation(t, store.Queries, 14, 5)
+	floor := createRandomFloor(t, store.Queries, location, 0)
+	survivor := createRandomMember(t, store.Queries)
+	member1 := createRandomMember(t, store.Queries)
+	member2 := createRandomMember(t, store.Queries)
+
+	today := time.Date(2030, 1, 7, 0, 0, 0, 0, time.UTC)
+	tomorrow := today.AddDate(0, 0, 1)
+	book := func(member Member, date time.Time, slot string) DeskBooking {
+		booking, err := store.BookDeskTx(ctx, BookDeskTxParams{
+			DeskID:   createRandomDesk(t, store.Queries, floor).ID,
+			MemberID: member.ID,
+			Date:     date,
+			Slot:     slot,
+			Today:    today,
+		})
+		require.NoError(t, err)
+		return booking
+	}
+
+	book(survivor, today, DeskSlotMorning)
+	clashing := book(member1, today, DeskSlotFullDay)
+	moved := book(member1, tomorrow, DeskSlotMorning)
+	// Both merged members hold a desk tomorrow morning, so only the booking of the one listed first is kept.
+	book(member2, tomorrow, DeskSlotFullDay)
+
+	_, err := store.MergeMembersTx(ctx, MergeMembersTxParams{
+		SurvivorID: survivor.ID,
+		MemberIDs:  []uuid.UUID{member1.ID, member2.ID},
+	}, AuditMeta{})
+	require.NoError(t, err)
+
+	bookings, err := store.ListMemberDeskBookings(ctx, ListMemberDeskBookingsParams{
+		MemberID: survivor.ID,
+		FromDate: today,
+		ToDate:   tomorrow.AddDate(0, 0, 1),
+	})
+	require.NoError(t, err)
+	require.Len(t, bookings, 2)
+	require.Equal(t, DeskSlotMorning, bookings[0].Slot)
+	require.Equal(t, moved.ID, bookings[1].ID)
+
+	_, err = store.CancelDeskBooking(ctx, clashing.ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestMergeMembersTxRoomReservations(t *testing.T) {
+	t.Parallel()
+
+	store := NewStore(testDB)
+	ctx := context.Background()
+
+	location := createRandomLocation(t, store.Queries, 14, 5)
+	room := createRandomRoom(t, store.Queries, location, 8)
+	survivor := createRandomMember(t, store.Queries)
+	member1 := createRandomMember(t, store.Queries)
+	member2 := createRandomMember(t, store.Queries)
+
+	reservation, err := store.CreateRoomReservation(ctx, CreateRoomReservationParams{
+		RoomID:       room.ID,
+		MemberID:     member2.ID,
+		Title:        util.RandomName(),
+		StartsAt:     time.Date(2030, 1, 7, 10, 0, 0, 0, time.UTC),
+		EndsAt:       time.Date(2030, 1, 7, 11, 0, 0, 0, time.UTC),
+		SeriesEndsAt: time.Date(2030, 1, 7, 11, 0, 0, 0, time.UTC),
+	})
+	require.NoError(t, err)
+
+	token1, err := store.RotateMemberCalendarToken(ctx, uuid.NullUUID{UUID: member1.ID, Valid: true})
+	require.NoError(t, err)
+	token2, err := store.RotateMemberCalendarToken(ctx, uuid.NullUUID{UUID: member2.ID, Valid: true})
+	require.NoError(t, err)
+
+	_, err = store.MergeMembersTx(ctx, MergeMembersTxParams{
+		SurvivorID: survivor.ID,
+		MemberIDs:  []uuid.UUID{member1.ID, member2.ID},
+	}, AuditMeta{})
+	require.NoError(t, err)
+
+	moved, err := store.GetRoomReservation(ctx, reservation.ID)
+	require.NoError(t, err)
+	require.Equal(t, survivor.ID, moved.MemberID)
+
+	// The survivor had no feed, so it takes over that of the first merged member, while the other one stays in the trash.
+	token, err := store.GetCalendarToken(ctx, token1.Token)
+	require.NoError(t, err)
+	require.Equal(t, uuid.NullUUID{UUID: survivor.ID, Valid: true}, token.MemberID)
+	token, err = store.GetCalendarToken(ctx, token2.Token)
+	require.NoError(t, err)
+	require.Equal(t, uuid.NullUUID{UUID: member2.ID, Valid: true}, token.MemberID)
+}
+
+func TestMergeMembersTxAttendances(t *testing.T) {
+	t.Parallel()
+
+	store := NewStore(testDB)
+	ctx := context.Background()
+
+	survivor := createRandomMember(t, store.Queries)
+	member := createRandomMember(t, store.Queries)
+	checkedInAt := time.Date(2030, 1, 7, 9, 0, 0, 0, time.UTC)
+
+	_, err := store.CheckInMember(ctx, CheckInMemberParams{MemberID: survivor.ID, CheckedInAt: checkedInAt})
+	require.NoError(t, err)
+	_, err = store.CheckInMember(ctx, CheckInMemberParams{MemberID: member.ID, CheckedInAt: checkedInAt.AddDate(0, 0, -1)})
+	require.NoError(t, err)
+	_, err = store.CheckOutMember(ctx, CheckOutMemberParams{MemberID: member.ID, CheckedOutAt: sql.NullTime{Time: checkedInAt.AddDate(0, 0, -1).Add(8 * time.Hour), Valid: true}})
+	require.NoError(t, err)
+	checkedIn, err := store.CheckInMember(ctx, CheckInMemberParams{MemberID: member.ID, CheckedInAt: checkedInAt.Add(time.Hour)})
+	require.NoError(t, err)
+
+	_, err = store.MergeMembersTx(ctx, MergeMembersTxParams{
+		SurvivorID: survivor.ID,
+		MemberIDs:  []uuid.UUID{member.ID},
+	}, AuditMeta{})
+	require.NoError(t, err)
+
+	attendances, err := store.ListMemberAttendances(ctx, ListMemberAttendancesParams{
+		MemberID: survivor.ID,
+		FromTime: checkedInAt.AddDate(0, 0, -1),
+		ToTime:   checkedInAt.AddDate(0, 0, 1),
+	})
+	require.NoError(t, err)
+	require.Len(t, attendances, 3)
+
+	// The survivor stays checked in, while the merged member is checked out.
+	require.False(t, attendances[1].CheckedOutAt.Valid)
+	require.Equal(t, checkedIn.ID, attendances[2].ID)
+	require.True(t, attendances[2].CheckedOutAt.Valid)
+	require.True(t, attendances[2].AutoCheckedOut)
+}
+
+func TestMergeMembersTxLeaveRequests(t *testing.T) {
+	t.Parallel()
+
+	store := NewStore(testDB)
+	ctx := context.Background()
+
+	survivor := createRandomMember(t, store.Queries)
+	member := createRandomMember(t, store.Queries)
+	leaveType := createRandomLeaveType(t, store.Queries, sql.NullFloat64{})
+	monday := leaveTestMonday()
+
+	request := func(member Member, startDate, endDate time.Time) LeaveRequest {
+		request, err := store.CreateLeaveRequestTx(ctx, CreateLeaveRequestParams{
+			MemberID:    member.ID,
+			LeaveTypeID: leaveType.ID,
+			StartDate:   startDate,
+			EndDate:     endDate,
+		})
+		require.NoError(t, err)
+		return request
+	}
+
+	request(survivor, monday, monday.AddDate(0, 0, 1))
+	clashing := request(member, monday.AddDate(0, 0, 1), monday.AddDate(0, 0, 2))
+	moved := request(member, monday.AddDate(0, 0, 7), monday.AddDate(0, 0, 7))
+
+	_, err := store.MergeMembersTx(ctx, MergeMembersTxParams{
+		SurvivorID: survivor.ID,
+		MemberIDs:  []uuid.UUID{member.ID},
+	}, AuditMeta{})
+	require.NoError(t, err)
+
+	canceled, err := store.GetLeaveRequest(ctx, clashing.ID)
+	require.NoError(t, err)
+	require.Equal(t, survivor.ID, canceled.MemberID)
+	require.Equal(t, LeaveStatusCanceled, canceled.Status)
+
+	pending, err := store.GetLeaveRequest(ctx, moved.ID)
+	require.NoError(t, err)
+	require.Equal(t, survivor.ID, pending.MemberID)
+	require.Equal(t, LeaveStatusPending, pending.Status)
+}
+
+func TestMergeMembersTxWorkingHours(t *testing.T) {
+	t.Parallel()
+
+	store := NewStore(testDB)
+	ctx := context.Background()
+
+	survivor := createRandomMember(t, store.Queries)
+	member1 := createRandomMember(t, store.Queries)
+	member2 := createRandomMember(t, store.Queries)
+
+	_, err := store.SetMemberTimeZone(ctx, SetMemberTimeZoneParams{TimeZone: sql.NullString{String: "Asia/Tokyo", Valid: true}, ID: member1.ID})
+	require.NoError(t, err)
+	for _, member := range []Member{member1, member2} {
+		_, err = store.CreateMemberWorkingHours(ctx, CreateMemberWorkingHoursParams{MemberID: member.ID, Weekday: 1, StartMinute: 540, EndMinute: 1080})
+		require.NoError(t, err)
+	}
+
+	result, err := store.MergeMembersTx(ctx, MergeMembersTxParams{
+		SurvivorID: survivor.ID,
+		MemberIDs:  []uuid.UUID{member1.ID, member2.ID},
+	}, AuditMeta{})
+	require.NoError(t, err)
+	require.Equal(t, sql.NullString{String: "Asia/Tokyo", Valid: true}, result.Member.TimeZone)
+
+	// The survivor had no working hours, so it takes those of the first merged member, while the others stay in the trash.
+	hours, err := store.ListMemberWorkingHours(ctx, []uuid.UUID{survivor.ID, member1.ID, member2.ID})
+	require.NoError(t, err)
+	require.Len(t, hours, 2)
+	byMember := make(map[uuid.UUID]int)
+	for _, hour := range hours {
+		byMember[hour.MemberID]++
+	}
+	require.Equal(t, map[uuid.UUID]int{survivor.ID: 1, member2.ID: 1}, byMember)
+}
+
+func TestMergeMembersTxTimeEntries(t *testing.T) {
+	t.Parallel()
+
+	store := NewStore(testDB)
+	ctx := context.Background()
+
+	survivor := createRandomMember(t, store.Queries)
+	member := createRandomMember(t, store.Queries)
+	project := createRandomProject(t, store.Queries)
+	monday := time.Date(2030, 1, 7, 0, 0, 0, 0, time.UTC)
+	start := monday.Add(9 * time.Hour)
+
+	track := func(member Member, startedAt time.Time, minutes int32) TimeEntry {
+		entry, err := store.CreateTimeEntry(ctx, CreateTimeEntryParams{
+			MemberID:  member.ID,
+			ProjectID: project.ID,
+			Date:      monday,
+			StartedAt: nullTime(startedAt),
+			EndedAt:   nullTime(startedAt.Add(time.Duration(minutes) * time.Minute)),
+			Minutes:   sql.NullInt32{Int32: minutes, Valid: true},
+			Billable:  true,
+		})
+		require.NoError(t, err)
+		return entry
+	}
+
+	track(survivor, start, 60)
+	clashing := track(member, start.Add(30*time.Minute), 60)
+	timed := track(member, start.Add(2*time.Hour), 60)
+
+	for _, week := range []time.Time{monday, monday.AddDate(0, 0, 7)} {
+		_, err := store.CreateTimesheet(ctx, CreateTimesheetParams{MemberID: member.ID, WeekStart: week})
+		require.NoError(t, err)
+	}
+	kept, err := store.CreateTimesheet(ctx, CreateTimesheetParams{MemberID: survivor.ID, WeekStart: monday})
+	require.NoError(t, err)
+
+	_, err = store.MergeMembersTx(ctx, MergeMembersTxParams{
+		SurvivorID: survivor.ID,
+		MemberIDs:  []uuid.UUID{member.ID},
+	}, AuditMeta{})
+	require.NoError(t, err)
+
+	// The entry tracked at the same time as one of the survivor is left with its duration only.
+	entry, err := store.GetTimeEntry(ctx, clashing.ID)
+	require.NoError(t, err)
+	require.Equal(t, survivor.ID, entry.MemberID)
+	require.False(t, entry.StartedAt.Valid)
+	require.False(t, entry.EndedAt.Valid)
+	require.Equal(t, sql.NullInt32{Int32: 60, Valid: true}, entry.Minutes)
+
+	entry, err = store.GetTimeEntry(ctx, timed.ID)
+	require.NoError(t, err)
+	require.Equal(t, survivor.ID, entry.MemberID)
+	require.True(t, entry.StartedAt.Valid)
+
+	// The survivor keeps its own timesheet of a week both submitted.
+	timesheet, err := store.GetMemberTimesheet(ctx, GetMemberTimesheetParams{MemberID: survivor.ID, WeekStart: monday})
+	require.NoError(t, err)
+	require.Equal(t, kept.ID, timesheet.ID)
+	_, err = store.GetMemberTimesheet(ctx, GetMemberTimesheetParams{MemberID: survivor.ID, WeekStart: monday.AddDate(0, 0, 7)})
+	require.NoError(t, err)
+}
+
+func TestMergeMembersTxProjectAssignments(t *testing.T) {
+	t.Parallel()
+
+	store := NewStore(testDB)
+	ctx := context.Background()
+
+	survivor := createRandomMember(t, store.Queries)
+	member := createRandomMember(t, store.Queries)
+	shared := createRandomProject(t, store.Queries)
+	other := createRandomProject(t, store.Queries)
+	monday := time.Date(2030, 1, 7, 0, 0, 0, 0, time.UTC)
+
+	assign := func(member Member, project Project, startDate, endDate time.Time) ProjectAssignment {
+		assignment, err := store.CreateProjectAssignment(ctx, CreateProjectAssignmentParams{
+			ProjectID:  project.ID,
+			MemberID:   member.ID,
+			Allocation: 50,
+			StartDate:  startDate,
+			EndDate:    endDate,
+		})
+		require.NoError(t, err)
+		return assignment
+	}
+
+	assign(survivor, shared, monday, monday.AddDate(0, 0, 20))
+	duplicate := assign(member, shared, monday.AddDate(0, 0, 14), monday.AddDate(0, 0, 34))
+	moved := assign(member, other, monday, monday.AddDate(0, 0, 20))
+
+	_, err := store.MergeMembersTx(ctx, MergeMembersTxParams{
+		SurvivorID: survivor.ID,
+		MemberIDs:  []uuid.UUID{member.ID},
+	}, AuditMeta{})
+	require.NoError(t, err)
+
+	assignment, err := store.GetProjectAssignment(ctx, moved.ID)
+	require.NoError(t, err)
+	require.Equal(t, survivor.ID, assignment.MemberID)
+
+	// The survivor is already assigned to the shared project over those days.
+	assignment, err = store.GetProjectAssignment(ctx, duplicate.ID)
+	require.NoError(t, err)
+	require.Equal(t, member.ID, assignment.MemberID)
+}
+
+func TestMergeMembersTxSkills(t *testing.T) {
+	t.Parallel()
+
+	store := NewStore(testDB)
+	ctx := context.Background()
+
+	user := createRandomUser(t, store.Queries)
+	other := createRandomUser(t, store.Queries)
+	survivor := createRandomMemberWithEmail(t, store.Queries, util.RandomName(), util.RandomName(), util.RandomEmail())
+	member := createRandomMemberWithEmail(t, store.Queries, util.RandomName(), util.RandomName(), user.Email)
+	shared := createRandomSkill(t, store.Queries)
+	moved := createRandomSkill(t, store.Queries)
+
+	usedOn := func(year int) sql.NullTime {
+		return sql.NullTime{Time: time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC), Valid: true}
+	}
+	skills := []UpsertMemberSkillParams{
+		{MemberID: survivor.ID, SkillID: shared.ID, Level: 2, LastUsedOn: usedOn(2029)},
+		{MemberID: member.ID, SkillID: shared.ID, Level: 4, LastUsedOn: usedOn(2028)},
+		{MemberID: member.ID, SkillID: moved.ID, Level: 3},
+	}
+	for _, skill := range skills {
+		_, err := store.UpsertMemberSkill(ctx, skill)
+		require.NoError(t, err)
+	}
+
+	endorsements := []EndorseMemberSkillTxParams{
+		{MemberID: survivor.ID, SkillID: shared.ID, UserID: user.ID},
+		{MemberID: survivor.ID, SkillID: shared.ID, UserID: other.ID},
+		{MemberID: member.ID, SkillID: shared.ID, UserID: other.ID},
+		{MemberID: member.ID, SkillID: moved.ID, UserID: other.ID},
+	}
+	for _, endorsement := range endorsements {
+		err := store.EndorseMemberSkillTx(ctx, endorsement)
+		require.NoError(t, err)
+	}
+
+	_, err := store.MergeMembersTx(ctx, MergeMembersTxParams{
+		SurvivorID: survivor.ID,
+		MemberIDs:  []uuid.UUID{member.ID},
+		Fields:     map[string]uuid.UUID{MergeFieldEmail: member.ID},
+	}, AuditMeta{})
+	require.NoError(t, err)
+
+	rows, err := store.ListMemberSkills(ctx, ListMemberSkillsParams{MemberID: survivor.ID, UserID: other.ID})
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+
+	// The shared skill takes the highest level and the latest use, and is endorsed once by each user. The survivor now
+	// has the email of user, whose endorsement would be one of its own.
+	require.Equal(t, shared.ID, rows[0].SkillID)
+	require.Equal(t, int32(4), rows[0].Level)
+	require.Equal(t, usedOn(2029).Time, rows[0].LastUsedOn.Time)
+	require.Equal(t, int32(1), rows[0].Endorsements)
+	require.True(t, rows[0].Endorsed)
+
+	require.Equal(t, moved.ID, rows[1].SkillID)
+	require.Equal(t, int32(3), rows[1].Level)
+	require.Equal(t, int32(1), rows[1].Endorsements)
+
+	_, err = store.GetMemberSkill(ctx, GetMemberSkillParams{MemberID: member.ID, SkillID: shared.ID})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestMergeMemberValues(t *testing.T) {
+	t.Parallel()
+
+	manager := Member{ID: util.RandomUUID()}
+	survivor := Member{
+		ID:           util.RandomUUID(),
+		FirstName:    "Taro",
+		LastName:     "Yamada",
+		CustomFields: json.RawMessage(`{"department": "Sales"}`),
+	}
+	member := Member{
+		ID:           util.RandomUUID(),
+		FirstName:    "Tarou",
+		LastName:     "Yamada",
+		Email:        sql.NullString{String: "taro@example.com", Valid: true},
+		ManagerID:    uuid.NullUUID{UUID: manager.ID, Valid: true},
+		AvatarKey:    sql.NullString{String: "avatars/1", Valid: true},
+		AvatarUrl:    sql.NullString{String: "https://example.com/avatars/1", Valid: true},
+		CustomFields: json.RawMessage(`{"department": "Marketing", "location": "Tokyo"}`),
+	}
+
+	testCases := []struct {
+		name   string
+		fields map[string]uuid.UUID
+		check  func(t *testing.T, values mergedMemberValues, err error)
+	}{
+		{
+			name: "SurvivorFirst",
+			check: func(t *testing.T, values mergedMemberValues, err error) {
+				require.NoError(t, err)
+				require.Equal(t, survivor.FirstName, values.params.FirstName)
+				require.Equal(t, member.Email, values.email)
+				require.Equal(t, member.ManagerID, values.params.ManagerID)
+				require.Equal(t, member.AvatarKey, values.params.AvatarKey)
+				require.JSONEq(t, `{"department": "Sales", "location": "Tokyo"}`, values.params.CustomFields)
+			},
+		},
+		{
+			name: "SelectedFields",
+			fields: map[string]uuid.UUID{
+				MergeFieldFirstName: member.ID,
+				MergeFieldEmail:     survivor.ID,
+				MergeFieldCustomFieldPrefix + "department": member.ID,
+				MergeFieldCustomFieldPrefix + "location":   survivor.ID,
+			},
+			check: func(t *testing.T, values mergedMemberValues, err error) {
+				require.NoError(t, err)
+				require.Equal(t, member.FirstName, values.params.FirstName)
+				require.False(t, values.email.Valid)
+				require.JSONEq(t, `{"department": "Marketing"}`, values.params.CustomFields)
+			},
+		},
+		{
+			name:   "UnknownField",
+			fields: map[string]uuid.UUID{"created_at": member.ID},
+			check: func(t *testing.T, values mergedMemberValues, err error) {
+				require.True(t, errors.Is(err, ErrInvalidMergeField))
+			},
+		},
+		{
+			name:   "EmptyCustomField",
+			fields: map[string]uuid.UUID{MergeFieldCustomFieldPrefix: member.ID},
+			check: func(t *testing.T, values mergedMemberValues, err error) {
+				require.True(t, errors.Is(err, ErrInvalidMergeField))
+			},
+		},
+		{
+			name:   "MemberNotMerged",
+			fields: map[string]uuid.UUID{MergeFieldLastName: manager.ID},
+			check: func(t *testing.T, values mergedMemberValues, err error) {
+				require.True(t, errors.Is(err, ErrInvalidMergeField))
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			values, err := mergeMemberValues([]Member{survivor, member}, tc.fields)
+			tc.check(t, values, err)
+		})
+	}
+
+	// A merged member is never left as the manager of the survivor.
+	survivor.ManagerID = uuid.NullUUID{UUID: member.ID, Valid: true}
+	values, err := mergeMemberValues([]Member{survivor, member}, nil)
+	require.NoError(t, err)
+	require.False(t, values.params.ManagerID.Valid)
+}
@@ -0,0 +1,122 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Lifecycle statuses of a member.
+const (
+	MemberStatusInvited    = "invited"
+	MemberStatusOnboarding = "onboarding"
+	MemberStatusActive     = "active"
+	MemberStatusOnLeave    = "on_leave"
+	MemberStatusOffboarded = "offboarded"
+)
+
+// DateLayout is the layout of the calendar dates of members, such as their start and end dates.
+const DateLayout = "2006-01-02"
+
+// memberTransitions maps a lifecycle status to the statuses a member can move to from it.
+// An offboarded member can come back, which is a rehire.
+var memberTransitions = map[string][]string{
+	MemberStatusInvited:    {MemberStatusOnboarding, MemberStatusActive, MemberStatusOffboarded},
+	MemberStatusOnboarding: {MemberStatusActive, MemberStatusOffboarded},
+	MemberStatusActive:     {MemberStatusOnLeave, MemberStatusOffboarded},
+	MemberStatusOnLeave:    {MemberStatusActive, MemberStatusOffboarded},
+	MemberStatusOffboarded: {MemberStatusOnboarding, MemberStatusActive},
+}
+
+// ErrInvalidMemberTransition is returned when a member cannot move from their status to the one requested.
+var ErrInvalidMemberTransition = errors.New("invalid member status transition")
+
+// ErrMemberEndBeforeStart is returned when a member would be offboarded before their start date.
+var ErrMemberEndBeforeStart = errors.New("the end date of a member cannot be before their start date")
+
+// CanTransitionMember tells whether a member can move from one lifecycle status to another.
+func CanTransitionMember(from, to string) bool {
+	for _, status := range memberTransitions[from] {
+		if status == to {
+			return true
+		}
+	}
+	return false
+}
+
+// TransitionMemberTxParams contains the input parameters of TransitionMemberTx.
+type TransitionMemberTxParams struct {
+	ID     uuid.UUID
+	Status string
+	// EffectiveDate is the date the change takes effect, which becomes the start or end date of the member.
+	EffectiveDate time.Time
+	// Reason explains the change in the audit trail.
+	Reason string
+}
+
+// TransitionMemberTx moves a member to another lifecycle status within a single database transaction,
+// and records it in the audit trail together with its effective date and reason.
+// A member starting work, for the first time or again, takes the effective date as start date,
+// and an offboarded member takes it as end date. A member moving to onboarding or offboarded gets the checklist of the template.
+// sql.ErrNoRows is returned when the member does not exist or is in the trash.
+func (store *SQLStore) TransitionMemberTx(ctx context.Context, arg TransitionMemberTxParams, meta AuditMeta) (Member, error) {
+	var member Member
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		before, err := q.GetMemberForUpdate(ctx, arg.ID)
+		if err != nil {
+			return err
+		}
+		if before.DeletedAt.Valid {
+			return sql.ErrNoRows
+		}
+		if !CanTransitionMember(before.Status, arg.Status) {
+			return fmt.Errorf("%w: from %s to %s", ErrInvalidMemberTransition, before.Status, arg.Status)
+		}
+
+		effective := sql.NullTime{Time: arg.EffectiveDate, Valid: true}
+		params := TransitionMemberParams{
+			ID:        before.ID,
+			Status:    arg.Status,
+			StartDate: before.StartDate,
+			EndDate:   before.EndDate,
+		}
+		switch {
+		case before.Status == MemberStatusOffboarded:
+			params.StartDate = effective
+			params.EndDate = sql.NullTime{}
+		case arg.Status == MemberStatusOffboarded:
+			if before.StartDate.Valid && arg.EffectiveDate.Before(before.StartDate.Time) {
+				return ErrMemberEndBeforeStart
+			}
+			params.EndDate = effective
+		case !before.StartDate.Valid && arg.Status != MemberStatusOnLeave:
+			params.StartDate = effective
+		}
+
+		member, err = q.TransitionMember(ctx, params)
+		if err != nil {
+			return err
+		}
+		if err := q.createMemberChecklist(ctx, member.ID, member.Status, arg.EffectiveDate); err != nil {
+			return err
+		}
+
+		extra := map[string]AuditFieldChange{
+			"effective_date": {Before: nil, After: arg.EffectiveDate.Format(DateLayout)},
+		}
+		if len(arg.Reason) > 0 {
+			extra["reason"] = AuditFieldChange{Before: nil, After: arg.Reason}
+		}
+		return q.createMemberAuditEventWithChanges(ctx, meta, AuditActionTransition, &before, &member, extra)
+	})
+	if err != nil {
+		return Member{}, err
+	}
+
+	return member, nil
+}
@@ -0,0 +1,158 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ot07/coworker-backend/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanTransitionMember(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		from, to string
+		ok       bool
+	}{
+		{MemberStatusInvited, MemberStatusOnboarding, true},
+		{MemberStatusInvited, MemberStatusOnLeave, false},
+		{MemberStatusOnboarding, MemberStatusActive, true},
+		{MemberStatusOnboarding, MemberStatusInvited, false},
+		{MemberStatusActive, MemberStatusOnLeave, true},
+		{MemberStatusActive, MemberStatusActive, false},
+		{MemberStatusOnLeave, MemberStatusActive, true},
+		{MemberStatusOnLeave, MemberStatusOnboarding, false},
+		{MemberStatusOffboarded, MemberStatusActive, true},
+		{MemberStatusOffboarded, MemberStatusOnLeave, false},
+		{"unknown", MemberStatusActive, false},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.ok, CanTransitionMember(tc.from, tc.to), "%s to %s", tc.from, tc.to)
+	}
+}
+
+func TestTransitionMemberTx(t *testing.T) {
+	t.Parallel()
+
+	store := NewStore(testDB)
+	ctx := context.Background()
+
+	member, err := store.CreateMember(ctx, CreateMemberParams{
+		FirstName: util.RandomName(),
+		LastName:  util.RandomName(),
+		Status:    MemberStatusInvited,
+	})
+	require.NoError(t, err)
+	require.False(t, member.StartDate.Valid)
+
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+	transition := func(status string, effectiveDate time.Time) Member {
+		member, err := store.TransitionMemberTx(ctx, TransitionMemberTxParams{
+			ID:            member.ID,
+			Status:        status,
+			EffectiveDate: effectiveDate,
+			Reason:        "Planned",
+		}, AuditMeta{RequestID: util.RandomName()})
+		require.NoError(t, err)
+		require.Equal(t, status, member.Status)
+		return member
+	}
+
+	onboarding := transition(MemberStatusOnboarding, date(2023, 4, 1))
+	require.Equal(t, member.Version+1, onboarding.Version)
+	require.True(t, onboarding.StartDate.Valid)
+	require.True(t, date(2023, 4, 1).Equal(onboarding.StartDate.Time))
+	require.False(t, onboarding.EndDate.Valid)
+
+	// The start date is only set when the member starts work.
+	active := transition(MemberStatusActive, date(2023, 4, 10))
+	require.True(t, date(2023, 4, 1).Equal(active.StartDate.Time))
+
+	onLeave := transition(MemberStatusOnLeave, date(2023, 8, 1))
+	require.Equal(t, active.StartDate, onLeave.StartDate)
+	transition(MemberStatusActive, date(2023, 9, 1))
+
+	offboarded := transition(MemberStatusOffboarded, date(2024, 3, 31))
+	require.True(t, date(2023, 4, 1).Equal(offboarded.StartDate.Time))
+	require.True(t, offboarded.EndDate.Valid)
+	require.True(t, date(2024, 3, 31).Equal(offboarded.EndDate.Time))
+
+	rehired := transition(MemberStatusActive, date(2025, 1, 6))
+	require.True(t, date(2025, 1, 6).Equal(rehired.StartDate.Time))
+	require.False(t, rehired.EndDate.Valid)
+
+	events, err := store.ListAuditEvents(ctx, ListAuditEventsParams{
+		Limit:      10,
+		EntityType: sql.NullString{String: AuditEntityMember, Valid: true},
+		EntityID:   uuid.NullUUID{UUID: member.ID, Valid: true},
+		Action:     sql.NullString{String: AuditActionTransition, Valid: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, events, 6)
+
+	var changes map[string]AuditFieldChange
+	err = json.Unmarshal(events[0].Changes, &changes)
+	require.NoError(t, err)
+	require.Equal(t, AuditFieldChange{Before: MemberStatusOffboarded, After: MemberStatusActive}, changes["status"])
+	require.Equal(t, AuditFieldChange{Before: "2024-03-31", After: nil}, changes["end_date"])
+	require.Equal(t, AuditFieldChange{Before: nil, After: "2025-01-06"}, changes["effective_date"])
+	require.Equal(t, AuditFieldChange{Before: nil, After: "Planned"}, changes["reason"])
+}
+
+func TestTransitionMemberTxErrors(t *testing.T) {
+	t.Parallel()
+
+	store := NewStore(testDB)
+	ctx := context.Background()
+
+	member, err := store.CreateMember(ctx, CreateMemberParams{
+		FirstName: util.RandomName(),
+		LastName:  util.RandomName(),
+		StartDate: sql.NullTime{Time: time.Date(2023, 4, 1, 0, 0, 0, 0, time.UTC), Valid: true},
+	})
+	require.NoError(t, err)
+
+	_, err = store.TransitionMemberTx(ctx, TransitionMemberTxParams{
+		ID:            member.ID,
+		Status:        MemberStatusInvited,
+		EffectiveDate: time.Now(),
+	}, AuditMeta{})
+	require.True(t, errors.Is(err, ErrInvalidMemberTransition))
+
+	_, err = store.TransitionMemberTx(ctx, TransitionMemberTxParams{
+		ID:            member.ID,
+		Status:        MemberStatusOffboarded,
+		EffectiveDate: time.Date(2023, 3, 31, 0, 0, 0, 0, time.UTC),
+	}, AuditMeta{})
+	require.ErrorIs(t, err, ErrMemberEndBeforeStart)
+
+	_, err = store.TransitionMemberTx(ctx, TransitionMemberTxParams{
+		ID:            util.RandomUUID(),
+		Status:        MemberStatusOnLeave,
+		EffectiveDate: time.Now(),
+	}, AuditMeta{})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	_, err = store.DeleteMembers(ctx, []uuid.UUID{member.ID})
+	require.NoError(t, err)
+	_, err = store.TransitionMemberTx(ctx, TransitionMemberTxParams{
+		ID:            member.ID,
+		Status:        MemberStatusOnLeave,
+		EffectiveDate: time.Now(),
+	}, AuditMeta{})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	// Nothing is changed when a transition fails.
+	got, err := store.GetMemberForUpdate(ctx, member.ID)
+	require.NoError(t, err)
+	require.Equal(t, MemberStatusActive, got.Status)
+}
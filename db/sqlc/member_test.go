@@ -28,6 +28,8 @@ func createRandomMember(t *testing.T, testQueries *Queries) Member {
 
 	require.NotEmpty(t, member.ID)
 	require.NotZero(t, member.CreatedAt)
+	require.JSONEq(t, "{}", string(member.CustomFields))
+	require.Equal(t, MemberStatusActive, member.Status)
 
 	return member
 }
@@ -238,8 +240,9 @@ func TestDeleteMembers(t *testing.T) {
 
 	member1 := createRandomMember(t, testQueries)
 	member2 := createRandomMember(t, testQueries)
-	err := testQueries.DeleteMembers(context.Background(), []uuid.UUID{member1.ID, member2.ID})
+	deleted, err := testQueries.DeleteMembers(context.Background(), []uuid.UUID{member1.ID, member2.ID})
 	require.NoError(t, err)
+	require.Len(t, deleted, 2)
 
 	member3, err := testQueries.GetMember(context.Background(), member1.ID)
 	require.Error(t, err)
@@ -269,3 +272,355 @@ func TestCountMembers(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, count, int64(n))
 }
+
+func TestSearchMembers(t *testing.T) {
+	t.Parallel()
+
+	tx := beginTransaction(t)
+	defer rollbackTransaction(t, tx)
+
+	testQueries := New(tx)
+
+	for i := 0; i < 5; i++ {
+		createRandomMember(t, testQueries)
+	}
+
+	latin, err := testQueries.CreateMember(context.Background(), CreateMemberParams{
+		FirstName: "Taro",
+		LastName:  "Yamada",
+	})
+	require.NoError(t, err)
+
+	japanese, err := testQueries.CreateMember(context.Background(), CreateMemberParams{
+		FirstName: "花子",
+		LastName:  "佐藤",
+	})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name  string
+		query string
+		want  Member
+	}{
+		{name: "Exact", query: "yamada", want: latin},
+		{name: "Typo", query: "Yamda", want: latin},
+		{name: "FullWidth", query: "ＹＡＭＡＤＡ", want: latin},
+		{name: "Japanese", query: "佐藤", want: japanese},
+	}
+
+	for _, tc := range testCases {
+		rows, err := testQueries.SearchMembers(context.Background(), SearchMembersParams{
+			Query:       tc.query,
+			ResultLimit: 10,
+		})
+		require.NoError(t, err, tc.name)
+		require.NotEmpty(t, rows, tc.name)
+		require.Equal(t, tc.want.ID, rows[0].ID, tc.name)
+		require.Positive(t, rows[0].Rank, tc.name)
+	}
+}
+
+func TestDeleteMemberKeepsMemberInTrash(t *testing.T) {
+	t.Parallel()
+
+	tx := beginTransaction(t)
+	defer rollbackTransaction(t, tx)
+
+	testQueries := New(tx)
+
+	createRandomMember(t, testQueries)
+	member := createRandomMember(t, testQueries)
+	err := testQueries.DeleteMember(context.Background(), member.ID)
+	require.NoError(t, err)
+
+	count, err := testQueries.CountMembers(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, int64(1), count)
+
+	deletedMembers, err := testQueries.ListDeletedMembers(context.Background(), ListDeletedMembersParams{
+		Limit:  10,
+		Offset: 0,
+	})
+	require.NoError(t, err)
+	require.Len(t, deletedMembers, 1)
+	require.Equal(t, member.ID, deletedMembers[0].ID)
+	require.True(t, deletedMembers[0].DeletedAt.Valid)
+
+	count, err = testQueries.CountDeletedMembers(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, int64(1), count)
+
+	// A deleted member can no longer be updated.
+	_, err = testQueries.UpdateMember(context.Background(), UpdateMemberParams{
+		ID:        member.ID,
+		FirstName: sql.NullString{String: util.RandomName(), Valid: true},
+	})
+	require.EqualError(t, err, sql.ErrNoRows.Error())
+}
+
+func TestRestoreMember(t *testing.T) {
+	t.Parallel()
+
+	tx := beginTransaction(t)
+	defer rollbackTransaction(t, tx)
+
+	testQueries := New(tx)
+
+	member1 := createRandomMember(t, testQueries)
+
+	// Members not in the trash cannot be restored.
+	_, err := testQueries.RestoreMember(context.Background(), member1.ID)
+	require.EqualError(t, err, sql.ErrNoRows.Error())
+
+	err = testQueries.DeleteMember(context.Background(), member1.ID)
+	require.NoError(t, err)
+
+	member2, err := testQueries.RestoreMember(context.Background(), member1.ID)
+	require.NoError(t, err)
+	require.Equal(t, member1.ID, member2.ID)
+	require.False(t, member2.DeletedAt.Valid)
+
+	_, err = testQueries.GetMember(context.Background(), member1.ID)
+	require.NoError(t, err)
+}
+
+func TestRestoreMembers(t *testing.T) {
+	t.Parallel()
+
+	tx := beginTransaction(t)
+	defer rollbackTransaction(t, tx)
+
+	testQueries := New(tx)
+
+	member1 := createRandomMember(t, testQueries)
+	member2 := createRandomMember(t, testQueries)
+	IDs := []uuid.UUID{member1.ID, member2.ID}
+
+	_, err := testQueries.DeleteMembers(context.Background(), IDs)
+	require.NoError(t, err)
+
+	restored, err := testQueries.RestoreMembers(context.Background(), IDs)
+	require.NoError(t, err)
+	require.Len(t, restored, 2)
+	for _, member := range restored {
+		require.False(t, member.DeletedAt.Valid)
+	}
+
+	count, err := testQueries.CountMembers(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, int64(2), count)
+
+	count, err = testQueries.CountDeletedMembers(context.Background())
+	require.NoError(t, err)
+	require.Zero(t, count)
+}
+
+func TestPurgeDeletedMembers(t *testing.T) {
+	t.Parallel()
+
+	tx := beginTransaction(t)
+	defer rollbackTransaction(t, tx)
+
+	testQueries := New(tx)
+
+	active := createRandomMember(t, testQueries)
+	deleted := createRandomMember(t, testQueries)
+	avatarKey := sql.NullString{String: "avatars/" + deleted.ID.String() + "/1", Valid: true}
+	_, err := testQueries.SetMemberAvatar(context.Background(), SetMemberAvatarParams{ID: deleted.ID, AvatarKey: avatarKey})
+	require.NoError(t, err)
+	err = testQueries.DeleteMember(context.Background(), deleted.ID)
+	require.NoError(t, err)
+
+	// Members deleted after the cutoff are kept.
+	purged, err := testQueries.PurgeDeletedMembers(context.Background(), time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	require.Empty(t, purged)
+
+	purged, err = testQueries.PurgeDeletedMembers(context.Background(), time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	// The avatar of a purged member is returned, so that its files can be removed.
+	require.Equal(t, []sql.NullString{avatarKey}, purged)
+
+	_, err = testQueries.RestoreMember(context.Background(), deleted.ID)
+	require.EqualError(t, err, sql.ErrNoRows.Error())
+
+	_, err = testQueries.GetMember(context.Background(), active.ID)
+	require.NoError(t, err)
+}
+
+func TestUpdateMemberVersion(t *testing.T) {
+	t.Parallel()
+
+	tx := beginTransaction(t)
+	defer rollbackTransaction(t, tx)
+
+	testQueries := New(tx)
+
+	member1 := createRandomMember(t, testQueries)
+	require.Equal(t, int32(1), member1.Version)
+
+	member2, err := testQueries.UpdateMember(context.Background(), UpdateMemberParams{
+		ID:               member1.ID,
+		FirstName:        sql.NullString{String: util.RandomName(), Valid: true},
+		ExpectedVersions: []int32{member1.Version},
+	})
+	require.NoError(t, err)
+	require.Equal(t, member1.Version+1, member2.Version)
+
+	// An update based on the stale version is rejected and leaves the member untouched.
+	_, err = testQueries.UpdateMember(context.Background(), UpdateMemberParams{
+		ID:               member1.ID,
+		FirstName:        sql.NullString{String: util.RandomName(), Valid: true},
+		ExpectedVersions: []int32{member1.Version},
+	})
+	require.EqualError(t, err, sql.ErrNoRows.Error())
+
+	member3, err := testQueries.GetMember(context.Background(), member1.ID)
+	require.NoError(t, err)
+	require.Equal(t, member2.FirstName, member3.FirstName)
+	require.Equal(t, member2.Version, member3.Version)
+
+	// Without expected versions the update is unconditional.
+	member4, err := testQueries.UpdateMember(context.Background(), UpdateMemberParams{
+		ID:       member1.ID,
+		LastName: sql.NullString{String: util.RandomName(), Valid: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, member3.Version+1, member4.Version)
+}
+
+func TestPatchMember(t *testing.T) {
+	t.Parallel()
+
+	tx := beginTransaction(t)
+	defer rollbackTransaction(t, tx)
+
+	testQueries := New(tx)
+
+	member1 := createRandomMember(t, testQueries)
+
+	// Fields that are not set are left untouched.
+	newFirstName := util.RandomName()
+	member2, err := testQueries.PatchMember(context.Background(), PatchMemberParams{
+		ID:        member1.ID,
+		FirstName: sql.NullString{String: newFirstName, Valid: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, newFirstName, member2.FirstName)
+	require.Equal(t, member1.LastName, member2.LastName)
+	require.Equal(t, member1.Email, member2.Email)
+	require.Equal(t, member1.Version+1, member2.Version)
+
+	// Setting email to null clears it.
+	member3, err := testQueries.PatchMember(context.Background(), PatchMemberParams{
+		ID:               member1.ID,
+		SetEmail:         true,
+		ExpectedVersions: []int32{member2.Version},
+	})
+	require.NoError(t, err)
+	require.False(t, member3.Email.Valid)
+
+	_, err = testQueries.PatchMember(context.Background(), PatchMemberParams{
+		ID: util.RandomUUID(),
+	})
+	require.EqualError(t, err, sql.ErrNoRows.Error())
+}
+
+func TestUpdateMemberCustomFields(t *testing.T) {
+	t.Parallel()
+
+	tx := beginTransaction(t)
+	defer rollbackTransaction(t, tx)
+
+	testQueries := New(tx)
+
+	member1, err := testQueries.CreateMember(context.Background(), CreateMemberParams{
+		FirstName:    util.RandomName(),
+		LastName:     util.RandomName(),
+		CustomFields: `{"department": "Sales", "grade": 3}`,
+	})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"department": "Sales", "grade": 3}`, string(member1.CustomFields))
+
+	// The given fields are merged into the existing ones and null removes a field.
+	member2, err := testQueries.UpdateMember(context.Background(), UpdateMemberParams{
+		ID:           member1.ID,
+		CustomFields: `{"department": "Engineering", "grade": null, "skills": ["Go"]}`,
+	})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"department": "Engineering", "skills": ["Go"]}`, string(member2.CustomFields))
+
+	// No custom fields leave them as they are.
+	member3, err := testQueries.PatchMember(context.Background(), PatchMemberParams{
+		ID:        member1.ID,
+		FirstName: sql.NullString{String: util.RandomName(), Valid: true},
+	})
+	require.NoError(t, err)
+	require.JSONEq(t, string(member2.CustomFields), string(member3.CustomFields))
+}
+
+func TestSetMemberAvatar(t *testing.T) {
+	t.Parallel()
+
+	tx := beginTransaction(t)
+	defer rollbackTransaction(t, tx)
+
+	testQueries := New(tx)
+
+	member1 := createRandomMember(t, testQueries)
+	require.False(t, member1.AvatarKey.Valid)
+	require.False(t, member1.AvatarUrl.Valid)
+
+	key := "avatars/" + member1.ID.String() + "/" + util.RandomUUID().String()
+	arg := SetMemberAvatarParams{
+		ID:        member1.ID,
+		AvatarKey: sql.NullString{String: key, Valid: true},
+		AvatarUrl: sql.NullString{String: "http://localhost:8080/uploads/" + key, Valid: true},
+	}
+
+	member2, err := testQueries.SetMemberAvatar(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.AvatarKey, member2.AvatarKey)
+	require.Equal(t, arg.AvatarUrl, member2.AvatarUrl)
+	require.Equal(t, member1.Version+1, member2.Version)
+
+	member3, err := testQueries.GetMember(context.Background(), member1.ID)
+	require.NoError(t, err)
+	require.Equal(t, arg.AvatarUrl, member3.AvatarUrl)
+
+	// Members in the trash keep their avatar.
+	_, err = testQueries.DeleteMembers(context.Background(), []uuid.UUID{member1.ID})
+	require.NoError(t, err)
+
+	_, err = testQueries.SetMemberAvatar(context.Background(), SetMemberAvatarParams{ID: member1.ID})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestSetMemberAvatarTx(t *testing.T) {
+	t.Parallel()
+
+	store := NewStore(testDB)
+	ctx := context.Background()
+
+	member := createRandomMember(t, store.Queries)
+
+	var keys []sql.NullString
+	for i := 0; i < 2; i++ {
+		key := "avatars/" + member.ID.String() + "/" + util.RandomUUID().String()
+		keys = append(keys, sql.NullString{String: key, Valid: true})
+	}
+
+	result, err := store.SetMemberAvatarTx(ctx, SetMemberAvatarParams{ID: member.ID, AvatarKey: keys[0]}, AuditMeta{})
+	require.NoError(t, err)
+	require.Equal(t, keys[0], result.Member.AvatarKey)
+	require.False(t, result.PreviousAvatarKey.Valid)
+
+	// The avatar replaced is returned, so that its files can be removed.
+	result, err = store.SetMemberAvatarTx(ctx, SetMemberAvatarParams{ID: member.ID, AvatarKey: keys[1]}, AuditMeta{})
+	require.NoError(t, err)
+	require.Equal(t, keys[1], result.Member.AvatarKey)
+	require.Equal(t, keys[0], result.PreviousAvatarKey)
+
+	_, err = store.SetMemberAvatarTx(ctx, SetMemberAvatarParams{ID: util.RandomUUID()}, AuditMeta{})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
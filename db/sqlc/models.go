@@ -6,17 +6,277 @@ package db
 
 import (
 	"database/sql"
+	"encoding/json"
 	"time"
 
 	"github.com/google/uuid"
 )
 
+type Attendance struct {
+	ID             uuid.UUID     `json:"id"`
+	MemberID       uuid.UUID     `json:"member_id"`
+	LocationID     uuid.NullUUID `json:"location_id"`
+	CheckedInAt    time.Time     `json:"checked_in_at"`
+	CheckedOutAt   sql.NullTime  `json:"checked_out_at"`
+	AutoCheckedOut bool          `json:"auto_checked_out"`
+}
+
+type AuditEvent struct {
+	ID         uuid.UUID       `json:"id"`
+	ActorID    uuid.NullUUID   `json:"actor_id"`
+	Action     string          `json:"action"`
+	EntityType string          `json:"entity_type"`
+	EntityID   uuid.UUID       `json:"entity_id"`
+	Changes    json.RawMessage `json:"changes"`
+	RequestID  string          `json:"request_id"`
+	CreatedAt  time.Time       `json:"created_at"`
+}
+
+type CalendarToken struct {
+	Token     uuid.UUID     `json:"token"`
+	RoomID    uuid.NullUUID `json:"room_id"`
+	MemberID  uuid.NullUUID `json:"member_id"`
+	CreatedAt time.Time     `json:"created_at"`
+}
+
+type ChecklistTemplateTask struct {
+	ID            uuid.UUID     `json:"id"`
+	Kind          string        `json:"kind"`
+	Title         string        `json:"title"`
+	Description   string        `json:"description"`
+	AssigneeID    uuid.NullUUID `json:"assignee_id"`
+	DueOffsetDays int32         `json:"due_offset_days"`
+	Position      int32         `json:"position"`
+	CreatedAt     time.Time     `json:"created_at"`
+}
+
+type CustomFieldDefinition struct {
+	ID        uuid.UUID       `json:"id"`
+	Key       string          `json:"key"`
+	Label     string          `json:"label"`
+	Type      string          `json:"type"`
+	Required  bool            `json:"required"`
+	Options   []string        `json:"options"`
+	MinLength sql.NullInt32   `json:"min_length"`
+	MaxLength sql.NullInt32   `json:"max_length"`
+	Pattern   sql.NullString  `json:"pattern"`
+	MinValue  sql.NullFloat64 `json:"min_value"`
+	MaxValue  sql.NullFloat64 `json:"max_value"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+type Desk struct {
+	ID        uuid.UUID `json:"id"`
+	FloorID   uuid.UUID `json:"floor_id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type DeskBooking struct {
+	ID         uuid.UUID    `json:"id"`
+	DeskID     uuid.UUID    `json:"desk_id"`
+	MemberID   uuid.UUID    `json:"member_id"`
+	Date       time.Time    `json:"date"`
+	Slot       string       `json:"slot"`
+	CanceledAt sql.NullTime `json:"canceled_at"`
+	CreatedAt  time.Time    `json:"created_at"`
+}
+
+type Floor struct {
+	ID         uuid.UUID `json:"id"`
+	LocationID uuid.UUID `json:"location_id"`
+	Name       string    `json:"name"`
+	Level      int32     `json:"level"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type LeaveRequest struct {
+	ID           uuid.UUID     `json:"id"`
+	MemberID     uuid.UUID     `json:"member_id"`
+	LeaveTypeID  uuid.UUID     `json:"leave_type_id"`
+	StartDate    time.Time     `json:"start_date"`
+	StartHalfDay bool          `json:"start_half_day"`
+	EndDate      time.Time     `json:"end_date"`
+	EndHalfDay   bool          `json:"end_half_day"`
+	Days         float64       `json:"days"`
+	Status       string        `json:"status"`
+	Reason       string        `json:"reason"`
+	DecidedBy    uuid.NullUUID `json:"decided_by"`
+	DecidedAt    sql.NullTime  `json:"decided_at"`
+	DecisionNote string        `json:"decision_note"`
+	CreatedAt    time.Time     `json:"created_at"`
+}
+
+type LeaveType struct {
+	ID              uuid.UUID       `json:"id"`
+	Name            string          `json:"name"`
+	AnnualAllowance sql.NullFloat64 `json:"annual_allowance"`
+	MaxCarryOver    float64         `json:"max_carry_over"`
+	CreatedAt       time.Time       `json:"created_at"`
+}
+
+type Location struct {
+	ID                 uuid.UUID `json:"id"`
+	Name               string    `json:"name"`
+	MaxAdvanceDays     int32     `json:"max_advance_days"`
+	MaxBookingsPerWeek int32     `json:"max_bookings_per_week"`
+	CreatedAt          time.Time `json:"created_at"`
+}
+
 type Member struct {
-	ID        uuid.UUID      `json:"id"`
-	FirstName string         `json:"first_name"`
-	LastName  string         `json:"last_name"`
-	Email     sql.NullString `json:"email"`
-	CreatedAt time.Time      `json:"created_at"`
+	ID           uuid.UUID       `json:"id"`
+	FirstName    string          `json:"first_name"`
+	LastName     string          `json:"last_name"`
+	Email        sql.NullString  `json:"email"`
+	CreatedAt    time.Time       `json:"created_at"`
+	SearchVector interface{}     `json:"search_vector"`
+	DeletedAt    sql.NullTime    `json:"deleted_at"`
+	Version      int32           `json:"version"`
+	CustomFields json.RawMessage `json:"custom_fields"`
+	ManagerID    uuid.NullUUID   `json:"manager_id"`
+	AvatarKey    sql.NullString  `json:"avatar_key"`
+	AvatarUrl    sql.NullString  `json:"avatar_url"`
+	Status       string          `json:"status"`
+	StartDate    sql.NullTime    `json:"start_date"`
+	EndDate      sql.NullTime    `json:"end_date"`
+	TimeZone     sql.NullString  `json:"time_zone"`
+}
+
+type MemberAddress struct {
+	ID         uuid.UUID `json:"id"`
+	MemberID   uuid.UUID `json:"member_id"`
+	Label      string    `json:"label"`
+	Street     string    `json:"street"`
+	City       string    `json:"city"`
+	Region     string    `json:"region"`
+	PostalCode string    `json:"postal_code"`
+	Country    string    `json:"country"`
+	Position   int32     `json:"position"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type MemberChecklistTask struct {
+	ID          uuid.UUID     `json:"id"`
+	MemberID    uuid.UUID     `json:"member_id"`
+	Kind        string        `json:"kind"`
+	Title       string        `json:"title"`
+	Description string        `json:"description"`
+	AssigneeID  uuid.NullUUID `json:"assignee_id"`
+	DueDate     time.Time     `json:"due_date"`
+	Position    int32         `json:"position"`
+	CompletedAt sql.NullTime  `json:"completed_at"`
+	CompletedBy uuid.NullUUID `json:"completed_by"`
+	OverdueAt   sql.NullTime  `json:"overdue_at"`
+	CreatedAt   time.Time     `json:"created_at"`
+}
+
+type MemberEmail struct {
+	ID        uuid.UUID `json:"id"`
+	MemberID  uuid.UUID `json:"member_id"`
+	Label     string    `json:"label"`
+	Email     string    `json:"email"`
+	IsPrimary bool      `json:"is_primary"`
+	Position  int32     `json:"position"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type MemberImportJob struct {
+	ID           uuid.UUID       `json:"id"`
+	Status       string          `json:"status"`
+	DryRun       bool            `json:"dry_run"`
+	TotalRows    int32           `json:"total_rows"`
+	ImportedRows int32           `json:"imported_rows"`
+	Errors       json.RawMessage `json:"errors"`
+	CreatedAt    time.Time       `json:"created_at"`
+	FinishedAt   sql.NullTime    `json:"finished_at"`
+}
+
+type MemberLink struct {
+	ID        uuid.UUID `json:"id"`
+	MemberID  uuid.UUID `json:"member_id"`
+	Label     string    `json:"label"`
+	Url       string    `json:"url"`
+	Position  int32     `json:"position"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type MemberPhone struct {
+	ID        uuid.UUID `json:"id"`
+	MemberID  uuid.UUID `json:"member_id"`
+	Label     string    `json:"label"`
+	Number    string    `json:"number"`
+	Position  int32     `json:"position"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type MemberSkill struct {
+	MemberID   uuid.UUID    `json:"member_id"`
+	SkillID    uuid.UUID    `json:"skill_id"`
+	Level      int32        `json:"level"`
+	LastUsedOn sql.NullTime `json:"last_used_on"`
+	CreatedAt  time.Time    `json:"created_at"`
+	UpdatedAt  time.Time    `json:"updated_at"`
+}
+
+type MemberTag struct {
+	MemberID  uuid.UUID `json:"member_id"`
+	TagID     uuid.UUID `json:"tag_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type MemberWorkingHour struct {
+	ID          uuid.UUID `json:"id"`
+	MemberID    uuid.UUID `json:"member_id"`
+	Weekday     int32     `json:"weekday"`
+	StartMinute int32     `json:"start_minute"`
+	EndMinute   int32     `json:"end_minute"`
+}
+
+type Project struct {
+	ID          uuid.UUID    `json:"id"`
+	Name        string       `json:"name"`
+	Description string       `json:"description"`
+	Billable    bool         `json:"billable"`
+	ArchivedAt  sql.NullTime `json:"archived_at"`
+	CreatedAt   time.Time    `json:"created_at"`
+}
+
+type ProjectAssignment struct {
+	ID         uuid.UUID `json:"id"`
+	ProjectID  uuid.UUID `json:"project_id"`
+	MemberID   uuid.UUID `json:"member_id"`
+	Role       string    `json:"role"`
+	Allocation int32     `json:"allocation"`
+	StartDate  time.Time `json:"start_date"`
+	EndDate    time.Time `json:"end_date"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type Room struct {
+	ID         uuid.UUID `json:"id"`
+	LocationID uuid.UUID `json:"location_id"`
+	Name       string    `json:"name"`
+	Capacity   int32     `json:"capacity"`
+	Equipment  []string  `json:"equipment"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type RoomReservation struct {
+	ID           uuid.UUID      `json:"id"`
+	RoomID       uuid.UUID      `json:"room_id"`
+	MemberID     uuid.UUID      `json:"member_id"`
+	Title        string         `json:"title"`
+	StartsAt     time.Time      `json:"starts_at"`
+	EndsAt       time.Time      `json:"ends_at"`
+	Rrule        sql.NullString `json:"rrule"`
+	SeriesEndsAt time.Time      `json:"series_ends_at"`
+	CreatedAt    time.Time      `json:"created_at"`
+}
+
+type RoomReservationException struct {
+	ReservationID   uuid.UUID `json:"reservation_id"`
+	OccurrenceStart time.Time `json:"occurrence_start"`
+	CreatedAt       time.Time `json:"created_at"`
 }
 
 type Session struct {
@@ -26,6 +286,60 @@ type Session struct {
 	ExpiredAt    time.Time `json:"expired_at"`
 }
 
+type Skill struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type SkillEndorsement struct {
+	MemberID  uuid.UUID `json:"member_id"`
+	SkillID   uuid.UUID `json:"skill_id"`
+	UserID    uuid.UUID `json:"user_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type Tag struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type Team struct {
+	ID        uuid.UUID     `json:"id"`
+	Name      string        `json:"name"`
+	ParentID  uuid.NullUUID `json:"parent_id"`
+	CreatedAt time.Time     `json:"created_at"`
+}
+
+type TeamMember struct {
+	TeamID    uuid.UUID `json:"team_id"`
+	MemberID  uuid.UUID `json:"member_id"`
+	Role      string    `json:"role"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type TimeEntry struct {
+	ID        uuid.UUID     `json:"id"`
+	MemberID  uuid.UUID     `json:"member_id"`
+	ProjectID uuid.UUID     `json:"project_id"`
+	Date      time.Time     `json:"date"`
+	StartedAt sql.NullTime  `json:"started_at"`
+	EndedAt   sql.NullTime  `json:"ended_at"`
+	Minutes   sql.NullInt32 `json:"minutes"`
+	Note      string        `json:"note"`
+	Billable  bool          `json:"billable"`
+	CreatedAt time.Time     `json:"created_at"`
+}
+
+type Timesheet struct {
+	ID          uuid.UUID     `json:"id"`
+	MemberID    uuid.UUID     `json:"member_id"`
+	WeekStart   time.Time     `json:"week_start"`
+	SubmittedBy uuid.NullUUID `json:"submitted_by"`
+	SubmittedAt time.Time     `json:"submitted_at"`
+}
+
 type User struct {
 	ID                uuid.UUID `json:"id"`
 	FirstName         string    `json:"first_name"`
@@ -34,4 +348,5 @@ type User struct {
 	HashedPassword    string    `json:"hashed_password"`
 	PasswordChangedAt time.Time `json:"password_changed_at"`
 	CreatedAt         time.Time `json:"created_at"`
+	IsAdmin           bool      `json:"is_admin"`
 }
@@ -58,6 +58,32 @@ func (nf *NullFloat64) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// NullInt32 that overrides sql.NullInt32
+type NullInt32 struct {
+	sql.NullInt32
+}
+
+func (ni NullInt32) MarshalJSON() ([]byte, error) {
+	if ni.Valid {
+		return json.Marshal(ni.Int32)
+	}
+	return json.Marshal(nil)
+}
+
+func (ni *NullInt32) UnmarshalJSON(data []byte) error {
+	var i *int32
+	if err := json.Unmarshal(data, &i); err != nil {
+		return err
+	}
+	if i != nil {
+		ni.Valid = true
+		ni.Int32 = *i
+	} else {
+		ni.Valid = false
+	}
+	return nil
+}
+
 // NullInt64 that overrides sql.NullInt64
 type NullInt64 struct {
 	sql.NullInt64
@@ -135,3 +161,34 @@ func (ns *NullTime) UnmarshalJSON(data []byte) error {
 	}
 	return nil
 }
+
+// NullDate is a NullTime holding a calendar date, marshaled as "2006-01-02".
+type NullDate struct {
+	sql.NullTime
+}
+
+func (nd NullDate) MarshalJSON() ([]byte, error) {
+	if nd.Valid {
+		return json.Marshal(nd.Time.Format(DateLayout))
+	}
+	return json.Marshal(nil)
+}
+
+func (nd *NullDate) UnmarshalJSON(data []byte) error {
+	var s *string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	if s != nil {
+		t, err := time.Parse(DateLayout, *s)
+		if err != nil {
+			return err
+		}
+		nd.Valid = true
+		nd.Time = t
+	} else {
+		nd.Valid = false
+		nd.Time = time.Time{}
+	}
+	return nil
+}
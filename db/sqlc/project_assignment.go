@@ -0,0 +1,292 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrAllocationExceeded is returned when the assignments of a member would allocate them beyond the limit on a day.
+var ErrAllocationExceeded = errors.New("the allocation of the member exceeds the limit")
+
+// peakAllocation returns the highest total allocation of assignments on a day from startDate to endDate,
+// along with the first day it is reached on. The total only rises on the days assignments start,
+// so only those days and startDate are looked at.
+func peakAllocation(assignments []ProjectAssignment, startDate, endDate time.Time) (int32, time.Time) {
+	days := []time.Time{startDate}
+	for _, assignment := range assignments {
+		if assignment.StartDate.After(startDate) && !assignment.StartDate.After(endDate) {
+			days = append(days, assignment.StartDate)
+		}
+	}
+
+	var peak int32
+	peakDay := startDate
+	for _, day := range days {
+		var total int32
+		for _, assignment := range assignments {
+			if !assignment.StartDate.After(day) && !assignment.EndDate.Before(day) {
+				total += assignment.Allocation
+			}
+		}
+		if total > peak || (total == peak && day.Before(peakDay)) {
+			peak, peakDay = total, day
+		}
+	}
+	return peak, peakDay
+}
+
+// checkAllocationLimit checks that the assignments of a member, along with an assignment being made, do not allocate
+// the member beyond limit on any day, returning ErrAllocationExceeded otherwise. When the assignment is being changed,
+// it replaces what was stored for it.
+func (q *Queries) checkAllocationLimit(ctx context.Context, assignment ProjectAssignment, limit int32) error {
+	assignments, err := q.ListMemberProjectAssignments(ctx, ListMemberProjectAssignmentsParams{
+		MemberID: assignment.MemberID,
+		FromDate: assignment.StartDate,
+		ToDate:   assignment.EndDate,
+	})
+	if err != nil {
+		return err
+	}
+
+	others := []ProjectAssignment{assignment}
+	for _, other := range assignments {
+		if other.ID != assignment.ID {
+			others = append(others, other)
+		}
+	}
+
+	peak, day := peakAllocation(others, assignment.StartDate, assignment.EndDate)
+	if peak > limit {
+		return fmt.Errorf("%w: %d%% on %s, at most %d%%", ErrAllocationExceeded, peak, day.Format(DateLayout), limit)
+	}
+	return nil
+}
+
+// lockAssignedMember locks a member, so that their assignments are checked against the allocation limit one after the other.
+// sql.ErrNoRows is returned when the member does not exist or is in the trash.
+func (q *Queries) lockAssignedMember(ctx context.Context, memberID uuid.UUID) error {
+	member, err := q.GetMemberForUpdate(ctx, memberID)
+	if err != nil {
+		return err
+	}
+	if member.DeletedAt.Valid {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// CreateProjectAssignmentTx assigns a member to a project within a single database transaction, after checking that
+// the member is not allocated beyond allocationLimit, in percent, on any day of the assignment.
+// sql.ErrNoRows is returned when the member does not exist or is in the trash, or when the project does not exist.
+// ErrProjectArchived and ErrAllocationExceeded are returned when the member cannot be assigned.
+func (store *SQLStore) CreateProjectAssignmentTx(ctx context.Context, arg CreateProjectAssignmentParams, allocationLimit int32) (ProjectAssignment, error) {
+	var assignment ProjectAssignment
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		if err := q.lockAssignedMember(ctx, arg.MemberID); err != nil {
+			return err
+		}
+		if err := q.checkProjectOpen(ctx, arg.ProjectID); err != nil {
+			return err
+		}
+
+		err := q.checkAllocationLimit(ctx, ProjectAssignment{
+			MemberID:   arg.MemberID,
+			Allocation: arg.Allocation,
+			StartDate:  arg.StartDate,
+			EndDate:    arg.EndDate,
+		}, allocationLimit)
+		if err != nil {
+			return err
+		}
+
+		assignment, err = q.CreateProjectAssignment(ctx, arg)
+		return err
+	})
+	if err != nil {
+		return ProjectAssignment{}, err
+	}
+
+	return assignment, nil
+}
+
+// UpdateProjectAssignmentTx changes the allocation, the role or the days of an assignment within a single database
+// transaction, checking the allocation limit as CreateProjectAssignmentTx does.
+// sql.ErrNoRows is returned when the assignment does not exist.
+func (store *SQLStore) UpdateProjectAssignmentTx(ctx context.Context, arg UpdateProjectAssignmentParams, allocationLimit int32) (ProjectAssignment, error) {
+	var assignment ProjectAssignment
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		// The member is locked before the assignment, as when assignments are created.
+		current, err := q.GetProjectAssignment(ctx, arg.ID)
+		if err != nil {
+			return err
+		}
+		if err := q.lockAssignedMember(ctx, current.MemberID); err != nil {
+			return err
+		}
+		if current, err = q.GetProjectAssignmentForUpdate(ctx, arg.ID); err != nil {
+			return err
+		}
+
+		err = q.checkAllocationLimit(ctx, ProjectAssignment{
+			ID:         current.ID,
+			MemberID:   current.MemberID,
+			Allocation: arg.Allocation,
+			StartDate:  arg.StartDate,
+			EndDate:    arg.EndDate,
+		}, allocationLimit)
+		if err != nil {
+			return err
+		}
+
+		assignment, err = q.UpdateProjectAssignment(ctx, arg)
+		return err
+	})
+	if err != nil {
+		return ProjectAssignment{}, err
+	}
+
+	return assignment, nil
+}
+
+// ProjectCapacity is the time of a member allocated to a project over a range of days, in days.
+type ProjectCapacity struct {
+	ProjectID     uuid.UUID
+	ProjectName   string
+	AllocatedDays float64
+}
+
+// MemberCapacity is the capacity of a member over a range of days, in days.
+// Working days run from Monday to Friday, as they do for leaves.
+type MemberCapacity struct {
+	MemberID  uuid.UUID
+	FirstName string
+	LastName  string
+	// WorkingDays counts the working days of the range.
+	WorkingDays float64
+	// LeaveDays counts the working days taken by the approved leaves of the member.
+	LeaveDays float64
+	// AvailableDays is what is left of the working days besides the leaves.
+	AvailableDays float64
+	// AllocatedDays sums up the allocations of the assignments of the member over the days they are available,
+	// so that a half day of leave halves the allocations of the day.
+	AllocatedDays float64
+	// Projects splits the allocated days by project, ordered by project name.
+	Projects []ProjectCapacity
+}
+
+// computeMemberCapacity computes the capacity of a member from fromDate to toDate from their assignments and approved leaves.
+func computeMemberCapacity(member ListCapacityMembersRow, fromDate, toDate time.Time, assignments []ListCapacityAssignmentsRow, leaves []ListCapacityLeavesRow) MemberCapacity {
+	capacity := MemberCapacity{
+		MemberID:  member.ID,
+		FirstName: member.FirstName,
+		LastName:  member.LastName,
+		Projects:  []ProjectCapacity{},
+	}
+	projects := make(map[uuid.UUID]int)
+	for _, assignment := range assignments {
+		if _, ok := projects[assignment.ProjectID]; !ok {
+			projects[assignment.ProjectID] = len(capacity.Projects)
+			capacity.Projects = append(capacity.Projects, ProjectCapacity{
+				ProjectID:   assignment.ProjectID,
+				ProjectName: assignment.ProjectName,
+			})
+		}
+	}
+
+	for date := fromDate; !date.After(toDate); date = date.AddDate(0, 0, 1) {
+		if date.Weekday() == time.Saturday || date.Weekday() == time.Sunday {
+			continue
+		}
+		capacity.WorkingDays++
+
+		var leave float64
+		for _, l := range leaves {
+			if date.Before(l.StartDate) || date.After(l.EndDate) {
+				continue
+			}
+			leave += LeaveDays(date, l.StartHalfDay && date.Equal(l.StartDate), date, l.EndHalfDay && date.Equal(l.EndDate))
+		}
+		if leave > 1 {
+			leave = 1
+		}
+		capacity.LeaveDays += leave
+		available := 1 - leave
+		capacity.AvailableDays += available
+
+		for _, assignment := range assignments {
+			if date.Before(assignment.StartDate) || date.After(assignment.EndDate) {
+				continue
+			}
+			allocated := available * float64(assignment.Allocation) / 100
+			capacity.AllocatedDays += allocated
+			capacity.Projects[projects[assignment.ProjectID]].AllocatedDays += allocated
+		}
+	}
+	return capacity
+}
+
+// ListMemberCapacitiesParams contains the input parameters of ListMemberCapacities.
+type ListMemberCapacitiesParams struct {
+	FromDate time.Time
+	ToDate   time.Time
+	// MemberID and TeamID optionally narrow the capacities down to a member or to the members of a team.
+	MemberID uuid.NullUUID
+	TeamID   uuid.NullUUID
+}
+
+// ListMemberCapacities lists the capacities of the members from FromDate to ToDate, ordered by name.
+// Members in the trash or offboarded are left out.
+func (store *SQLStore) ListMemberCapacities(ctx context.Context, arg ListMemberCapacitiesParams) ([]MemberCapacity, error) {
+	members, err := store.ListCapacityMembers(ctx, ListCapacityMembersParams{
+		MemberID: arg.MemberID,
+		TeamID:   arg.TeamID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	memberIDs := make([]uuid.UUID, 0, len(members))
+	for _, member := range members {
+		memberIDs = append(memberIDs, member.ID)
+	}
+
+	assignments, err := store.ListCapacityAssignments(ctx, ListCapacityAssignmentsParams{
+		MemberIds: memberIDs,
+		FromDate:  arg.FromDate,
+		ToDate:    arg.ToDate,
+	})
+	if err != nil {
+		return nil, err
+	}
+	assignmentsByMember := make(map[uuid.UUID][]ListCapacityAssignmentsRow)
+	for _, assignment := range assignments {
+		assignmentsByMember[assignment.MemberID] = append(assignmentsByMember[assignment.MemberID], assignment)
+	}
+
+	leaves, err := store.ListCapacityLeaves(ctx, ListCapacityLeavesParams{
+		MemberIds: memberIDs,
+		FromDate:  arg.FromDate,
+		ToDate:    arg.ToDate,
+	})
+	if err != nil {
+		return nil, err
+	}
+	leavesByMember := make(map[uuid.UUID][]ListCapacityLeavesRow)
+	for _, leave := range leaves {
+		leavesByMember[leave.MemberID] = append(leavesByMember[leave.MemberID], leave)
+	}
+
+	capacities := make([]MemberCapacity, 0, len(members))
+	for _, member := range members {
+		capacities = append(capacities, computeMemberCapacity(member, arg.FromDate, arg.ToDate,
+			assignmentsByMember[member.ID], leavesByMember[member.ID]))
+	}
+	return capacities, nil
+}
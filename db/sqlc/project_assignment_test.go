@@ -0,0 +1,216 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ot07/coworker-backend/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPeakAllocation(t *testing.T) {
+	t.Parallel()
+
+	day := func(d int) time.Time {
+		return time.Date(2023, 8, d, 0, 0, 0, 0, time.UTC)
+	}
+	assignment := func(allocation int32, start, end int) ProjectAssignment {
+		return ProjectAssignment{Allocation: allocation, StartDate: day(start), EndDate: day(end)}
+	}
+
+	assignments := []ProjectAssignment{
+		assignment(50, 7, 18),
+		assignment(30, 1, 9),
+		assignment(40, 10, 31),
+		assignment(20, 14, 14),
+	}
+
+	peak, peakDay := peakAllocation(assignments, day(7), day(18))
+	require.Equal(t, int32(110), peak)
+	require.Equal(t, day(14), peakDay)
+
+	peak, peakDay = peakAllocation(assignments, day(7), day(13))
+	require.Equal(t, int32(90), peak)
+	require.Equal(t, day(10), peakDay)
+
+	peak, peakDay = peakAllocation(assignments[:2], day(7), day(9))
+	require.Equal(t, int32(80), peak)
+	require.Equal(t, day(7), peakDay)
+}
+
+func TestComputeMemberCapacity(t *testing.T) {
+	t.Parallel()
+
+	member := ListCapacityMembersRow{ID: util.RandomUUID(), FirstName: util.RandomName(), LastName: util.RandomName()}
+	alpha := util.RandomUUID()
+	beta := util.RandomUUID()
+	// From Monday to Sunday of the week after: 10 working days.
+	monday := time.Date(2023, 8, 7, 0, 0, 0, 0, time.UTC)
+
+	assignments := []ListCapacityAssignmentsRow{
+		{MemberID: member.ID, ProjectID: alpha, ProjectName: "Alpha", Allocation: 50, StartDate: monday.AddDate(0, 0, -7), EndDate: monday.AddDate(0, 0, 30)},
+		{MemberID: member.ID, ProjectID: beta, ProjectName: "Beta", Allocation: 20, StartDate: monday.AddDate(0, 0, 7), EndDate: monday.AddDate(0, 0, 8)},
+		{MemberID: member.ID, ProjectID: alpha, ProjectName: "Alpha", Allocation: 10, StartDate: monday.AddDate(0, 0, 9), EndDate: monday.AddDate(0, 0, 9)},
+	}
+	leaves := []ListCapacityLeavesRow{
+		// Wednesday afternoon to Thursday: a day and a half.
+		{MemberID: member.ID, StartDate: monday.AddDate(0, 0, 2), StartHalfDay: true, EndDate: monday.AddDate(0, 0, 3)},
+		// Tuesday of the week after, half of which is taken by each leave.
+		{MemberID: member.ID, StartDate: monday.AddDate(0, 0, 8), EndDate: monday.AddDate(0, 0, 8), EndHalfDay: true},
+		{MemberID: member.ID, StartDate: monday.AddDate(0, 0, 8), StartHalfDay: true, EndDate: monday.AddDate(0, 0, 9), EndHalfDay: true},
+	}
+
+	capacity := computeMemberCapacity(member, monday, monday.AddDate(0, 0, 13), assignments, leaves)
+	require.Equal(t, member.ID, capacity.MemberID)
+	require.Equal(t, 10.0, capacity.WorkingDays)
+	require.Equal(t, 3.0, capacity.LeaveDays)
+	require.Equal(t, 7.0, capacity.AvailableDays)
+	// Alpha takes half of the 7 available days and a tenth of the half day left on the second Wednesday;
+	// Beta a fifth of the second Monday, the Tuesday after being taken by leaves.
+	require.InDelta(t, 3.75, capacity.AllocatedDays, 1e-9)
+	require.Len(t, capacity.Projects, 2)
+	require.Equal(t, alpha, capacity.Projects[0].ProjectID)
+	require.InDelta(t, 3.55, capacity.Projects[0].AllocatedDays, 1e-9)
+	require.Equal(t, beta, capacity.Projects[1].ProjectID)
+	require.InDelta(t, 0.2, capacity.Projects[1].AllocatedDays, 1e-9)
+
+	empty := computeMemberCapacity(member, monday.AddDate(0, 0, 5), monday.AddDate(0, 0, 6), nil, nil)
+	require.Zero(t, empty.WorkingDays)
+	require.Empty(t, empty.Projects)
+}
+
+func TestCreateProjectAssignmentTx(t *testing.T) {
+	t.Parallel()
+
+	store := NewStore(testDB)
+	ctx := context.Background()
+
+	member := createRandomMember(t, store.Queries)
+	project := createRandomProject(t, store.Queries)
+	other := createRandomProject(t, store.Queries)
+	monday := time.Date(2023, 8, 7, 0, 0, 0, 0, time.UTC)
+
+	assignment, err := store.CreateProjectAssignmentTx(ctx, CreateProjectAssignmentParams{
+		ProjectID:  project.ID,
+		MemberID:   member.ID,
+		Role:       "Backend developer",
+		Allocation: 60,
+		StartDate:  monday,
+		EndDate:    monday.AddDate(0, 0, 13),
+	}, 100)
+	require.NoError(t, err)
+	require.Equal(t, project.ID, assignment.ProjectID)
+	require.Equal(t, member.ID, assignment.MemberID)
+	require.Equal(t, "Backend developer", assignment.Role)
+	require.Equal(t, int32(60), assignment.Allocation)
+
+	// 60% and 50% on the second week exceed 100%, but not a higher limit.
+	arg := CreateProjectAssignmentParams{
+		ProjectID:  other.ID,
+		MemberID:   member.ID,
+		Allocation: 50,
+		StartDate:  monday.AddDate(0, 0, 7),
+		EndDate:    monday.AddDate(0, 0, 20),
+	}
+	_, err = store.CreateProjectAssignmentTx(ctx, arg, 100)
+	require.ErrorIs(t, err, ErrAllocationExceeded)
+
+	arg.StartDate = monday.AddDate(0, 0, 14)
+	second, err := store.CreateProjectAssignmentTx(ctx, arg, 100)
+	require.NoError(t, err)
+
+	// Moving the first assignment over the second one exceeds the limit again.
+	_, err = store.UpdateProjectAssignmentTx(ctx, UpdateProjectAssignmentParams{
+		ID:         assignment.ID,
+		Allocation: 60,
+		StartDate:  monday,
+		EndDate:    monday.AddDate(0, 0, 14),
+	}, 100)
+	require.ErrorIs(t, err, ErrAllocationExceeded)
+
+	updated, err := store.UpdateProjectAssignmentTx(ctx, UpdateProjectAssignmentParams{
+		ID:         assignment.ID,
+		Allocation: 50,
+		StartDate:  monday,
+		EndDate:    monday.AddDate(0, 0, 14),
+	}, 100)
+	require.NoError(t, err)
+	require.Equal(t, int32(50), updated.Allocation)
+
+	assignments, err := store.ListProjectAssignments(ctx, ListProjectAssignmentsParams{
+		MemberID: uuid.NullUUID{UUID: member.ID, Valid: true},
+		FromDate: sql.NullTime{Time: monday.AddDate(0, 0, 14), Valid: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, assignments, 2)
+	require.Equal(t, assignment.ID, assignments[0].ID)
+	require.Equal(t, second.ID, assignments[1].ID)
+
+	_, err = store.CreateProjectAssignmentTx(ctx, CreateProjectAssignmentParams{
+		ProjectID:  util.RandomUUID(),
+		MemberID:   member.ID,
+		Allocation: 10,
+		StartDate:  monday,
+		EndDate:    monday,
+	}, 100)
+	require.Equal(t, sql.ErrNoRows, err)
+}
+
+func TestListMemberCapacities(t *testing.T) {
+	t.Parallel()
+
+	store := NewStore(testDB)
+	ctx := context.Background()
+
+	member := createRandomMember(t, store.Queries)
+	project := createRandomProject(t, store.Queries)
+	vacation := createRandomLeaveType(t, store.Queries, sql.NullFloat64{})
+	monday := time.Date(2023, 8, 7, 0, 0, 0, 0, time.UTC)
+
+	_, err := store.CreateProjectAssignmentTx(ctx, CreateProjectAssignmentParams{
+		ProjectID:  project.ID,
+		MemberID:   member.ID,
+		Allocation: 50,
+		StartDate:  monday,
+		EndDate:    monday.AddDate(0, 0, 4),
+	}, 100)
+	require.NoError(t, err)
+
+	leave, err := store.CreateLeaveRequestTx(ctx, CreateLeaveRequestParams{
+		MemberID:    member.ID,
+		LeaveTypeID: vacation.ID,
+		StartDate:   monday,
+		EndDate:     monday,
+	})
+	require.NoError(t, err)
+
+	capacities, err := store.ListMemberCapacities(ctx, ListMemberCapacitiesParams{
+		FromDate: monday,
+		ToDate:   monday.AddDate(0, 0, 6),
+		MemberID: uuid.NullUUID{UUID: member.ID, Valid: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, capacities, 1)
+	require.Equal(t, 5.0, capacities[0].AvailableDays)
+	require.Equal(t, 2.5, capacities[0].AllocatedDays)
+
+	// Only approved leaves take capacity away.
+	_, err = store.DecideLeaveRequest(ctx, DecideLeaveRequestParams{ID: leave.ID, Status: LeaveStatusApproved})
+	require.NoError(t, err)
+
+	capacities, err = store.ListMemberCapacities(ctx, ListMemberCapacitiesParams{
+		FromDate: monday,
+		ToDate:   monday.AddDate(0, 0, 6),
+		MemberID: uuid.NullUUID{UUID: member.ID, Valid: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, capacities, 1)
+	require.Equal(t, 1.0, capacities[0].LeaveDays)
+	require.Equal(t, 4.0, capacities[0].AvailableDays)
+	require.Equal(t, 2.0, capacities[0].AllocatedDays)
+	require.Len(t, capacities[0].Projects, 1)
+	require.Equal(t, project.Name, capacities[0].Projects[0].ProjectName)
+}
@@ -6,27 +6,237 @@ package db
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 type Querier interface {
+	AddMemberTags(ctx context.Context, arg AddMemberTagsParams) (int64, error)
+	AddTeamMember(ctx context.Context, arg AddTeamMemberParams) (TeamMember, error)
+	AutoCheckOutAttendances(ctx context.Context, cutoffAt time.Time) (int64, error)
+	CancelDeskBooking(ctx context.Context, id uuid.UUID) (DeskBooking, error)
+	CancelLeaveRequest(ctx context.Context, id uuid.UUID) (LeaveRequest, error)
+	CheckInMember(ctx context.Context, arg CheckInMemberParams) (Attendance, error)
+	CheckOutMember(ctx context.Context, arg CheckOutMemberParams) (Attendance, error)
+	CompleteMemberChecklistTask(ctx context.Context, arg CompleteMemberChecklistTaskParams) (MemberChecklistTask, error)
+	CountAuditEvents(ctx context.Context, arg CountAuditEventsParams) (int64, error)
+	CountDeletedMembers(ctx context.Context) (int64, error)
+	CountLeaveRequests(ctx context.Context, arg CountLeaveRequestsParams) (int64, error)
+	CountMemberReports(ctx context.Context, arg CountMemberReportsParams) (int64, error)
+	CountMemberWeekDeskBookings(ctx context.Context, arg CountMemberWeekDeskBookingsParams) (int64, error)
 	CountMembers(ctx context.Context) (int64, error)
+	CountTeamMembers(ctx context.Context, arg CountTeamMembersParams) (int64, error)
+	CountTimeEntries(ctx context.Context, arg CountTimeEntriesParams) (int64, error)
+	CountUsers(ctx context.Context) (int64, error)
+	CreateAuditEvent(ctx context.Context, arg CreateAuditEventParams) (AuditEvent, error)
+	CreateChecklistTemplateTask(ctx context.Context, arg CreateChecklistTemplateTaskParams) (ChecklistTemplateTask, error)
+	CreateCustomFieldDefinition(ctx context.Context, arg CreateCustomFieldDefinitionParams) (CustomFieldDefinition, error)
+	CreateDesk(ctx context.Context, arg CreateDeskParams) (Desk, error)
+	CreateDeskBooking(ctx context.Context, arg CreateDeskBookingParams) (DeskBooking, error)
+	CreateFloor(ctx context.Context, arg CreateFloorParams) (Floor, error)
+	CreateLeaveRequest(ctx context.Context, arg CreateLeaveRequestParams) (LeaveRequest, error)
+	CreateLeaveType(ctx context.Context, arg CreateLeaveTypeParams) (LeaveType, error)
+	CreateLocation(ctx context.Context, arg CreateLocationParams) (Location, error)
 	CreateMember(ctx context.Context, arg CreateMemberParams) (Member, error)
+	CreateMemberAddress(ctx context.Context, arg CreateMemberAddressParams) (MemberAddress, error)
+	CreateMemberChecklist(ctx context.Context, arg CreateMemberChecklistParams) ([]MemberChecklistTask, error)
+	CreateMemberEmail(ctx context.Context, arg CreateMemberEmailParams) (MemberEmail, error)
+	CreateMemberImportJob(ctx context.Context, arg CreateMemberImportJobParams) (MemberImportJob, error)
+	CreateMemberLink(ctx context.Context, arg CreateMemberLinkParams) (MemberLink, error)
+	CreateMemberPhone(ctx context.Context, arg CreateMemberPhoneParams) (MemberPhone, error)
+	CreateMemberWorkingHours(ctx context.Context, arg CreateMemberWorkingHoursParams) (MemberWorkingHour, error)
+	CreateProject(ctx context.Context, arg CreateProjectParams) (Project, error)
+	CreateProjectAssignment(ctx context.Context, arg CreateProjectAssignmentParams) (ProjectAssignment, error)
+	CreateRoom(ctx context.Context, arg CreateRoomParams) (Room, error)
+	CreateRoomReservation(ctx context.Context, arg CreateRoomReservationParams) (RoomReservation, error)
+	CreateRoomReservationException(ctx context.Context, arg CreateRoomReservationExceptionParams) error
 	CreateSession(ctx context.Context, arg CreateSessionParams) (Session, error)
+	CreateSkill(ctx context.Context, name string) (Skill, error)
+	CreateSkillEndorsement(ctx context.Context, arg CreateSkillEndorsementParams) (int64, error)
+	CreateTag(ctx context.Context, name string) (Tag, error)
+	CreateTeam(ctx context.Context, arg CreateTeamParams) (Team, error)
+	CreateTimeEntry(ctx context.Context, arg CreateTimeEntryParams) (TimeEntry, error)
+	CreateTimesheet(ctx context.Context, arg CreateTimesheetParams) (Timesheet, error)
 	CreateUser(ctx context.Context, arg CreateUserParams) (User, error)
+	DecideLeaveRequest(ctx context.Context, arg DecideLeaveRequestParams) (LeaveRequest, error)
+	DeleteChecklistTemplateTasks(ctx context.Context, kind string) error
+	DeleteCustomFieldDefinition(ctx context.Context, id uuid.UUID) (CustomFieldDefinition, error)
+	DeleteDesk(ctx context.Context, id uuid.UUID) (Desk, error)
+	DeleteFloor(ctx context.Context, id uuid.UUID) (Floor, error)
+	DeleteLeaveType(ctx context.Context, id uuid.UUID) (LeaveType, error)
+	DeleteLocation(ctx context.Context, id uuid.UUID) (Location, error)
 	DeleteMember(ctx context.Context, id uuid.UUID) error
-	DeleteMembers(ctx context.Context, dollar_1 []uuid.UUID) error
+	DeleteMemberAddresses(ctx context.Context, memberID uuid.UUID) error
+	DeleteMemberEmails(ctx context.Context, memberID uuid.UUID) error
+	DeleteMemberLinks(ctx context.Context, memberID uuid.UUID) error
+	DeleteMemberPhones(ctx context.Context, memberID uuid.UUID) error
+	DeleteMemberSkill(ctx context.Context, arg DeleteMemberSkillParams) (MemberSkill, error)
+	DeleteMemberTimesheet(ctx context.Context, arg DeleteMemberTimesheetParams) (Timesheet, error)
+	DeleteMemberWorkingHours(ctx context.Context, memberID uuid.UUID) error
+	DeleteMembers(ctx context.Context, dollar_1 []uuid.UUID) ([]Member, error)
+	DeletePrimaryMemberEmail(ctx context.Context, memberID uuid.UUID) error
+	DeleteProject(ctx context.Context, id uuid.UUID) (Project, error)
+	DeleteProjectAssignment(ctx context.Context, id uuid.UUID) (ProjectAssignment, error)
+	DeleteRoom(ctx context.Context, id uuid.UUID) (Room, error)
+	DeleteRoomReservation(ctx context.Context, id uuid.UUID) (RoomReservation, error)
+	DeleteSelfSkillEndorsements(ctx context.Context, memberID uuid.UUID) error
 	DeleteSession(ctx context.Context, sessionToken uuid.UUID) error
+	DeleteSkill(ctx context.Context, id uuid.UUID) (Skill, error)
+	DeleteSkillEndorsement(ctx context.Context, arg DeleteSkillEndorsementParams) (int64, error)
+	DeleteTag(ctx context.Context, id uuid.UUID) (Tag, error)
+	DeleteTeam(ctx context.Context, id uuid.UUID) (Team, error)
+	DeleteTimeEntry(ctx context.Context, id uuid.UUID) error
+	FailRunningMemberImportJobs(ctx context.Context, errors json.RawMessage) (int64, error)
+	FinishMemberImportJob(ctx context.Context, arg FinishMemberImportJobParams) (MemberImportJob, error)
+	GetCalendarToken(ctx context.Context, token uuid.UUID) (CalendarToken, error)
+	GetCustomFieldDefinition(ctx context.Context, id uuid.UUID) (CustomFieldDefinition, error)
+	GetDeskLocationForUpdate(ctx context.Context, id uuid.UUID) (Location, error)
+	GetLeaveRequest(ctx context.Context, id uuid.UUID) (LeaveRequest, error)
+	GetLeaveRequestForUpdate(ctx context.Context, id uuid.UUID) (LeaveRequest, error)
+	GetLeaveType(ctx context.Context, id uuid.UUID) (LeaveType, error)
+	GetLocation(ctx context.Context, id uuid.UUID) (Location, error)
 	GetMember(ctx context.Context, id uuid.UUID) (Member, error)
+	GetMemberForUpdate(ctx context.Context, id uuid.UUID) (Member, error)
+	GetMemberImportJob(ctx context.Context, id uuid.UUID) (MemberImportJob, error)
+	GetMemberSkill(ctx context.Context, arg GetMemberSkillParams) (MemberSkill, error)
+	GetMemberTimesheet(ctx context.Context, arg GetMemberTimesheetParams) (Timesheet, error)
+	GetProject(ctx context.Context, id uuid.UUID) (Project, error)
+	GetProjectAssignment(ctx context.Context, id uuid.UUID) (ProjectAssignment, error)
+	GetProjectAssignmentForUpdate(ctx context.Context, id uuid.UUID) (ProjectAssignment, error)
+	GetRoom(ctx context.Context, id uuid.UUID) (Room, error)
+	GetRoomForUpdate(ctx context.Context, id uuid.UUID) (Room, error)
+	GetRoomReservation(ctx context.Context, id uuid.UUID) (RoomReservation, error)
+	GetRunningTimeEntry(ctx context.Context, memberID uuid.UUID) (TimeEntry, error)
 	GetSession(ctx context.Context, sessionToken uuid.UUID) (Session, error)
+	GetSkill(ctx context.Context, id uuid.UUID) (Skill, error)
+	GetTag(ctx context.Context, id uuid.UUID) (Tag, error)
+	GetTeam(ctx context.Context, id uuid.UUID) (Team, error)
+	GetTimeEntry(ctx context.Context, id uuid.UUID) (TimeEntry, error)
+	GetTimeEntryForUpdate(ctx context.Context, id uuid.UUID) (TimeEntry, error)
 	GetUser(ctx context.Context, id uuid.UUID) (User, error)
 	GetUserByEmail(ctx context.Context, email string) (User, error)
+	IsDescendantTeam(ctx context.Context, arg IsDescendantTeamParams) (bool, error)
+	IsMemberReport(ctx context.Context, arg IsMemberReportParams) (bool, error)
+	ListAttendanceSummaries(ctx context.Context, arg ListAttendanceSummariesParams) ([]ListAttendanceSummariesRow, error)
+	ListAuditEvents(ctx context.Context, arg ListAuditEventsParams) ([]AuditEvent, error)
+	ListCalendarFeedReservations(ctx context.Context, arg ListCalendarFeedReservationsParams) ([]ListCalendarFeedReservationsRow, error)
+	ListCapacityAssignments(ctx context.Context, arg ListCapacityAssignmentsParams) ([]ListCapacityAssignmentsRow, error)
+	ListCapacityLeaves(ctx context.Context, arg ListCapacityLeavesParams) ([]ListCapacityLeavesRow, error)
+	ListCapacityMembers(ctx context.Context, arg ListCapacityMembersParams) ([]ListCapacityMembersRow, error)
+	ListChecklistTemplateTasks(ctx context.Context, kind string) ([]ChecklistTemplateTask, error)
+	ListCustomFieldDefinitions(ctx context.Context) ([]CustomFieldDefinition, error)
+	ListDeletedMembers(ctx context.Context, arg ListDeletedMembersParams) ([]Member, error)
+	ListDesks(ctx context.Context, floorID uuid.UUID) ([]Desk, error)
+	ListDirectReportsForUpdate(ctx context.Context, arg ListDirectReportsForUpdateParams) ([]Member, error)
+	ListFloors(ctx context.Context, locationID uuid.UUID) ([]Floor, error)
+	ListLeaveRequests(ctx context.Context, arg ListLeaveRequestsParams) ([]LeaveRequest, error)
+	ListLeaveTypes(ctx context.Context) ([]LeaveType, error)
+	ListLocationDeskBookings(ctx context.Context, arg ListLocationDeskBookingsParams) ([]DeskBooking, error)
+	ListLocationDesks(ctx context.Context, locationID uuid.UUID) ([]ListLocationDesksRow, error)
+	ListLocations(ctx context.Context) ([]Location, error)
+	ListMemberActiveLeaveRequests(ctx context.Context, arg ListMemberActiveLeaveRequestsParams) ([]LeaveRequest, error)
+	ListMemberAddresses(ctx context.Context, memberID uuid.UUID) ([]MemberAddress, error)
+	ListMemberAttendances(ctx context.Context, arg ListMemberAttendancesParams) ([]Attendance, error)
+	ListMemberChain(ctx context.Context, id uuid.UUID) ([]ListMemberChainRow, error)
+	ListMemberChecklistTasks(ctx context.Context, arg ListMemberChecklistTasksParams) ([]MemberChecklistTask, error)
+	ListMemberDeskBookings(ctx context.Context, arg ListMemberDeskBookingsParams) ([]DeskBooking, error)
+	ListMemberDuplicatePairs(ctx context.Context, arg ListMemberDuplicatePairsParams) ([]ListMemberDuplicatePairsRow, error)
+	ListMemberEmails(ctx context.Context, memberID uuid.UUID) ([]MemberEmail, error)
+	ListMemberLinks(ctx context.Context, memberID uuid.UUID) ([]MemberLink, error)
+	ListMemberPhones(ctx context.Context, memberID uuid.UUID) ([]MemberPhone, error)
+	ListMemberProjectAssignments(ctx context.Context, arg ListMemberProjectAssignmentsParams) ([]ProjectAssignment, error)
+	ListMemberReports(ctx context.Context, arg ListMemberReportsParams) ([]ListMemberReportsRow, error)
+	ListMemberSkills(ctx context.Context, arg ListMemberSkillsParams) ([]ListMemberSkillsRow, error)
+	ListMemberTimeEntries(ctx context.Context, arg ListMemberTimeEntriesParams) ([]TimeEntry, error)
+	ListMemberWorkingHours(ctx context.Context, memberIds []uuid.UUID) ([]MemberWorkingHour, error)
 	ListMembers(ctx context.Context, arg ListMembersParams) ([]Member, error)
+	ListMembersByIDs(ctx context.Context, dollar_1 []uuid.UUID) ([]Member, error)
+	ListMembersForUpdate(ctx context.Context, dollar_1 []uuid.UUID) ([]Member, error)
+	ListOpenChecklistTasksByAssignee(ctx context.Context, assigneeID uuid.NullUUID) ([]MemberChecklistTask, error)
+	ListOrgChartMembers(ctx context.Context) ([]ListOrgChartMembersRow, error)
+	ListPresenceAttendances(ctx context.Context, arg ListPresenceAttendancesParams) ([]ListPresenceAttendancesRow, error)
+	ListPrimaryMemberEmails(ctx context.Context, emails []string) ([]string, error)
+	ListProjectAssignments(ctx context.Context, arg ListProjectAssignmentsParams) ([]ProjectAssignment, error)
+	ListProjects(ctx context.Context, includeArchived bool) ([]Project, error)
+	ListRoomReservationExceptions(ctx context.Context, dollar_1 []uuid.UUID) ([]RoomReservationException, error)
+	ListRoomReservationsInRange(ctx context.Context, arg ListRoomReservationsInRangeParams) ([]RoomReservation, error)
+	ListRooms(ctx context.Context, arg ListRoomsParams) ([]Room, error)
+	ListSkillSearchRows(ctx context.Context, skillIds []uuid.UUID) ([]ListSkillSearchRowsRow, error)
+	ListSkills(ctx context.Context) ([]Skill, error)
+	ListTags(ctx context.Context) ([]Tag, error)
+	ListTagsByMemberIDs(ctx context.Context, memberIds []uuid.UUID) ([]ListTagsByMemberIDsRow, error)
+	ListTeamLeaveRequests(ctx context.Context, arg ListTeamLeaveRequestsParams) ([]ListTeamLeaveRequestsRow, error)
+	ListTeamMembers(ctx context.Context, arg ListTeamMembersParams) ([]ListTeamMembersRow, error)
+	ListTeams(ctx context.Context) ([]Team, error)
+	ListTimeEntries(ctx context.Context, arg ListTimeEntriesParams) ([]TimeEntry, error)
+	ListTimeReportRows(ctx context.Context, arg ListTimeReportRowsParams) ([]ListTimeReportRowsRow, error)
+	LockReportingLines(ctx context.Context) error
+	LockUserRegistration(ctx context.Context) error
+	MarkOverdueChecklistTasks(ctx context.Context, today time.Time) (int64, error)
+	MergeMember(ctx context.Context, arg MergeMemberParams) (Member, error)
+	MoveMemberAddresses(ctx context.Context, arg MoveMemberAddressesParams) error
+	MoveMemberAttendances(ctx context.Context, arg MoveMemberAttendancesParams) error
+	MoveMemberAuditEvents(ctx context.Context, arg MoveMemberAuditEventsParams) (int64, error)
+	MoveMemberCalendarToken(ctx context.Context, arg MoveMemberCalendarTokenParams) error
+	MoveMemberDeskBookings(ctx context.Context, arg MoveMemberDeskBookingsParams) error
+	MoveMemberEmails(ctx context.Context, arg MoveMemberEmailsParams) error
+	MoveMemberLeaveRequests(ctx context.Context, arg MoveMemberLeaveRequestsParams) error
+	MoveMemberLinks(ctx context.Context, arg MoveMemberLinksParams) error
+	MoveMemberPhones(ctx context.Context, arg MoveMemberPhonesParams) error
+	MoveMemberProjectAssignments(ctx context.Context, arg MoveMemberProjectAssignmentsParams) error
+	MoveMemberRoomReservations(ctx context.Context, arg MoveMemberRoomReservationsParams) error
+	MoveMemberSkills(ctx context.Context, arg MoveMemberSkillsParams) error
+	MoveMemberTags(ctx context.Context, arg MoveMemberTagsParams) error
+	MoveMemberTimeEntries(ctx context.Context, arg MoveMemberTimeEntriesParams) error
+	MoveMemberTimesheets(ctx context.Context, arg MoveMemberTimesheetsParams) error
+	MoveMemberWorkingHours(ctx context.Context, arg MoveMemberWorkingHoursParams) error
+	MoveTeamMembers(ctx context.Context, arg MoveTeamMembersParams) error
+	PatchMember(ctx context.Context, arg PatchMemberParams) (Member, error)
+	PromoteMemberEmail(ctx context.Context, arg PromoteMemberEmailParams) (int64, error)
+	PurgeDeletedMembers(ctx context.Context, deletedBefore time.Time) ([]sql.NullString, error)
+	ReassignMemberChecklistTask(ctx context.Context, arg ReassignMemberChecklistTaskParams) (MemberChecklistTask, error)
+	RemoveMemberTags(ctx context.Context, arg RemoveMemberTagsParams) (int64, error)
+	RemoveMembersCustomField(ctx context.Context, key string) (int64, error)
+	RemoveTeamMember(ctx context.Context, arg RemoveTeamMemberParams) (int64, error)
+	RenameSkill(ctx context.Context, arg RenameSkillParams) (Skill, error)
+	RenameTag(ctx context.Context, arg RenameTagParams) (Tag, error)
+	ReparentChildTeams(ctx context.Context, arg ReparentChildTeamsParams) error
+	RestoreMember(ctx context.Context, id uuid.UUID) (Member, error)
+	RestoreMembers(ctx context.Context, dollar_1 []uuid.UUID) ([]Member, error)
+	RestorePrimaryMemberEmails(ctx context.Context, memberIds []uuid.UUID) error
+	RotateMemberCalendarToken(ctx context.Context, memberID uuid.NullUUID) (CalendarToken, error)
+	RotateRoomCalendarToken(ctx context.Context, roomID uuid.NullUUID) (CalendarToken, error)
+	SearchMembers(ctx context.Context, arg SearchMembersParams) ([]SearchMembersRow, error)
+	SetMemberAvatar(ctx context.Context, arg SetMemberAvatarParams) (Member, error)
+	SetMemberManager(ctx context.Context, arg SetMemberManagerParams) (Member, error)
+	SetMemberTimeZone(ctx context.Context, arg SetMemberTimeZoneParams) (Member, error)
+	SetPrimaryMemberEmail(ctx context.Context, arg SetPrimaryMemberEmailParams) (int64, error)
+	SyncMemberEmail(ctx context.Context, id uuid.UUID) (Member, error)
+	TransitionMember(ctx context.Context, arg TransitionMemberParams) (Member, error)
+	TrashMergedMembers(ctx context.Context, dollar_1 []uuid.UUID) ([]Member, error)
+	TruncateAuditEventsTable(ctx context.Context) error
+	TruncateCustomFieldDefinitionsTable(ctx context.Context) error
+	TruncateMemberImportJobsTable(ctx context.Context) error
 	TruncateMembersTable(ctx context.Context) error
 	TruncateSessionsTable(ctx context.Context) error
+	TruncateTagsTable(ctx context.Context) error
+	TruncateTeamsTable(ctx context.Context) error
 	TruncateUsersTable(ctx context.Context) error
+	UnsetPrimaryMemberEmail(ctx context.Context, memberID uuid.UUID) error
+	UnsetPrimaryMemberEmails(ctx context.Context, memberIds []uuid.UUID) error
+	UpdateCustomFieldDefinition(ctx context.Context, arg UpdateCustomFieldDefinitionParams) (CustomFieldDefinition, error)
+	UpdateLeaveType(ctx context.Context, arg UpdateLeaveTypeParams) (LeaveType, error)
+	UpdateLocation(ctx context.Context, arg UpdateLocationParams) (Location, error)
 	UpdateMember(ctx context.Context, arg UpdateMemberParams) (Member, error)
+	UpdateProject(ctx context.Context, arg UpdateProjectParams) (Project, error)
+	UpdateProjectAssignment(ctx context.Context, arg UpdateProjectAssignmentParams) (ProjectAssignment, error)
+	UpdateRoom(ctx context.Context, arg UpdateRoomParams) (Room, error)
+	UpdateTeam(ctx context.Context, arg UpdateTeamParams) (Team, error)
+	UpdateTimeEntry(ctx context.Context, arg UpdateTimeEntryParams) (TimeEntry, error)
+	UpsertMemberSkill(ctx context.Context, arg UpsertMemberSkillParams) (MemberSkill, error)
 }
 
 var _ Querier = (*Queries)(nil)
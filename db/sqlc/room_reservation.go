@@ -0,0 +1,225 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ot07/coworker-backend/calendar"
+)
+
+// ErrRoomReservationConflict is returned when a reservation overlaps another reservation of the room.
+var ErrRoomReservationConflict = errors.New("the room is already reserved at that time")
+
+// ErrNotAnOccurrence is returned when no occurrence of a reservation starts at the time given.
+var ErrNotAnOccurrence = errors.New("no occurrence of the reservation starts at that time")
+
+// RoomReservationOccurrence is a single occurrence of a reservation, which is the reservation itself unless it recurs.
+type RoomReservationOccurrence struct {
+	Reservation RoomReservation
+	StartsAt    time.Time
+	EndsAt      time.Time
+}
+
+// roomReservationStarts returns the start of every occurrence of a reservation starting at startsAt and repeated by rrule.
+// Errors wrap calendar.ErrInvalidRule.
+func roomReservationStarts(startsAt time.Time, rrule sql.NullString) ([]time.Time, error) {
+	if !rrule.Valid {
+		return []time.Time{startsAt}, nil
+	}
+	rule, err := calendar.ParseRule(rrule.String)
+	if err != nil {
+		return nil, err
+	}
+	return rule.Starts(startsAt)
+}
+
+// ExpandRoomReservation returns the occurrences of a reservation in order, leaving out the ones canceled by exceptions.
+func ExpandRoomReservation(reservation RoomReservation, exceptions []time.Time) ([]RoomReservationOccurrence, error) {
+	starts, err := roomReservationStarts(reservation.StartsAt, reservation.Rrule)
+	if err != nil {
+		return nil, err
+	}
+
+	canceled := make(map[time.Time]bool, len(exceptions))
+	for _, exception := range exceptions {
+		canceled[exception.UTC()] = true
+	}
+
+	duration := reservation.EndsAt.Sub(reservation.StartsAt)
+	occurrences := make([]RoomReservationOccurrence, 0, len(starts))
+	for _, start := range starts {
+		if canceled[start.UTC()] {
+			continue
+		}
+		occurrences = append(occurrences, RoomReservationOccurrence{
+			Reservation: reservation,
+			StartsAt:    start,
+			EndsAt:      start.Add(duration),
+		})
+	}
+	return occurrences, nil
+}
+
+// ListRoomReservationOccurrencesParams contains the input parameters of ListRoomReservationOccurrences.
+type ListRoomReservationOccurrencesParams struct {
+	// RoomID and MemberID restrict the reservations to those of a room or a member, when they are set.
+	RoomID   uuid.NullUUID
+	MemberID uuid.NullUUID
+	From     time.Time
+	To       time.Time
+}
+
+// ListRoomReservationOccurrences lists the occurrences of reservations overlapping the range from From to To, ordered by start.
+func (store *SQLStore) ListRoomReservationOccurrences(ctx context.Context, arg ListRoomReservationOccurrencesParams) ([]RoomReservationOccurrence, error) {
+	return store.listRoomReservationOccurrences(ctx, arg)
+}
+
+func (q *Queries) listRoomReservationOccurrences(ctx context.Context, arg ListRoomReservationOccurrencesParams) ([]RoomReservationOccurrence, error) {
+	reservations, err := q.ListRoomReservationsInRange(ctx, ListRoomReservationsInRangeParams{
+		RoomID:   arg.RoomID,
+		MemberID: arg.MemberID,
+		ToTime:   arg.To,
+		FromTime: arg.From,
+	})
+	if err != nil || len(reservations) == 0 {
+		return []RoomReservationOccurrence{}, err
+	}
+
+	ids := make([]uuid.UUID, 0, len(reservations))
+	for _, reservation := range reservations {
+		ids = append(ids, reservation.ID)
+	}
+	exceptions, err := q.ListRoomReservationExceptions(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	exceptionsByID := make(map[uuid.UUID][]time.Time)
+	for _, exception := range exceptions {
+		exceptionsByID[exception.ReservationID] = append(exceptionsByID[exception.ReservationID], exception.OccurrenceStart)
+	}
+
+	occurrences := []RoomReservationOccurrence{}
+	for _, reservation := range reservations {
+		expanded, err := ExpandRoomReservation(reservation, exceptionsByID[reservation.ID])
+		if err != nil {
+			return nil, err
+		}
+		for _, occurrence := range expanded {
+			if occurrence.StartsAt.Before(arg.To) && occurrence.EndsAt.After(arg.From) {
+				occurrences = append(occurrences, occurrence)
+			}
+		}
+	}
+
+	sort.SliceStable(occurrences, func(i, j int) bool {
+		return occurrences[i].StartsAt.Before(occurrences[j].StartsAt)
+	})
+	return occurrences, nil
+}
+
+// CreateRoomReservationTxParams contains the input parameters of CreateRoomReservationTx.
+type CreateRoomReservationTxParams struct {
+	RoomID   uuid.UUID
+	MemberID uuid.UUID
+	Title    string
+	// StartsAt and EndsAt are those of the first occurrence.
+	StartsAt time.Time
+	EndsAt   time.Time
+	// RRule repeats the reservation, as the RRULE of RFC 5545 that calendar.ParseRule supports.
+	RRule sql.NullString
+}
+
+// CreateRoomReservationTx reserves a room within a single database transaction, after checking that none of the occurrences
+// of the reservation overlaps an occurrence of another reservation of the room. The room is locked, so that reservations
+// made at the same time are checked one after the other.
+// sql.ErrNoRows is returned when the room does not exist, or when the member does not exist or is in the trash.
+// An invalid recurrence rule is reported with an error wrapping calendar.ErrInvalidRule.
+func (store *SQLStore) CreateRoomReservationTx(ctx context.Context, arg CreateRoomReservationTxParams) (RoomReservation, error) {
+	var reservation RoomReservation
+
+	if arg.RRule.Valid {
+		rule, err := calendar.ParseRule(arg.RRule.String)
+		if err != nil {
+			return RoomReservation{}, err
+		}
+		arg.RRule.String = rule.String()
+	}
+
+	starts, err := roomReservationStarts(arg.StartsAt, arg.RRule)
+	if err != nil {
+		return RoomReservation{}, err
+	}
+	duration := arg.EndsAt.Sub(arg.StartsAt)
+	seriesEndsAt := starts[len(starts)-1].Add(duration)
+
+	err = store.execTx(ctx, func(q *Queries) error {
+		if _, err := q.GetRoomForUpdate(ctx, arg.RoomID); err != nil {
+			return err
+		}
+		if _, err := q.GetMember(ctx, arg.MemberID); err != nil {
+			return err
+		}
+
+		existing, err := q.listRoomReservationOccurrences(ctx, ListRoomReservationOccurrencesParams{
+			RoomID: uuid.NullUUID{UUID: arg.RoomID, Valid: true},
+			From:   arg.StartsAt,
+			To:     seriesEndsAt,
+		})
+		if err != nil {
+			return err
+		}
+		// The occurrences of the room never overlap one another, so they end in the order they start.
+		for _, start := range starts {
+			end := start.Add(duration)
+			i := sort.Search(len(existing), func(i int) bool { return existing[i].EndsAt.After(start) })
+			if i < len(existing) && existing[i].StartsAt.Before(end) {
+				return fmt.Errorf("%w: %q from %s to %s", ErrRoomReservationConflict, existing[i].Reservation.Title,
+					existing[i].StartsAt.UTC().Format(time.RFC3339), existing[i].EndsAt.UTC().Format(time.RFC3339))
+			}
+		}
+
+		reservation, err = q.CreateRoomReservation(ctx, CreateRoomReservationParams{
+			RoomID:       arg.RoomID,
+			MemberID:     arg.MemberID,
+			Title:        arg.Title,
+			StartsAt:     arg.StartsAt,
+			EndsAt:       arg.EndsAt,
+			Rrule:        arg.RRule,
+			SeriesEndsAt: seriesEndsAt,
+		})
+		return err
+	})
+	if err != nil {
+		return RoomReservation{}, err
+	}
+
+	return reservation, nil
+}
+
+// CancelRoomReservationOccurrence cancels a single occurrence of a reservation, the one starting at occurrenceStart.
+// sql.ErrNoRows is returned when the reservation does not exist, and ErrNotAnOccurrence when no occurrence starts then.
+func (store *SQLStore) CancelRoomReservationOccurrence(ctx context.Context, id uuid.UUID, occurrenceStart time.Time) error {
+	reservation, err := store.GetRoomReservation(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	starts, err := roomReservationStarts(reservation.StartsAt, reservation.Rrule)
+	if err != nil {
+		return err
+	}
+	for _, start := range starts {
+		if start.Equal(occurrenceStart) {
+			return store.CreateRoomReservationException(ctx, CreateRoomReservationExceptionParams{
+				ReservationID:   id,
+				OccurrenceStart: start,
+			})
+		}
+	}
+	return ErrNotAnOccurrence
+}
@@ -0,0 +1,181 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ot07/coworker-backend/calendar"
+	"github.com/ot07/coworker-backend/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateRoomReservationTx(t *testing.T) {
+	t.Parallel()
+
+	store := NewStore(testDB)
+	ctx := context.Background()
+
+	location := createRandomLocation(t, store.Queries, 14, 5)
+	room := createRandomRoom(t, store.Queries, location, 8)
+	member := createRandomMember(t, store.Queries)
+
+	// A Monday.
+	monday := time.Date(2030, 1, 7, 10, 0, 0, 0, time.UTC)
+	reserve := func(startsAt time.Time, duration time.Duration, rrule string) (RoomReservation, error) {
+		return store.CreateRoomReservationTx(ctx, CreateRoomReservationTxParams{
+			RoomID:   room.ID,
+			MemberID: member.ID,
+			Title:    util.RandomName(),
+			StartsAt: startsAt,
+			EndsAt:   startsAt.Add(duration),
+			RRule:    sql.NullString{String: rrule, Valid: len(rrule) > 0},
+		})
+	}
+
+	weekly, err := reserve(monday, time.Hour, "freq=weekly;byday=we,mo;count=4")
+	require.NoError(t, err)
+	require.Equal(t, "FREQ=WEEKLY;BYDAY=MO,WE;COUNT=4", weekly.Rrule.String)
+	// The fourth occurrence is on the second Wednesday.
+	require.True(t, monday.AddDate(0, 0, 9).Add(time.Hour).Equal(weekly.SeriesEndsAt))
+
+	// Overlapping any occurrence is refused, while the time between them is free.
+	_, err = reserve(monday.AddDate(0, 0, 9).Add(30*time.Minute), time.Hour, "")
+	require.ErrorIs(t, err, ErrRoomReservationConflict)
+	_, err = reserve(monday.AddDate(0, 0, -7), time.Hour, "FREQ=WEEKLY;COUNT=2")
+	require.ErrorIs(t, err, ErrRoomReservationConflict)
+	single, err := reserve(monday.AddDate(0, 0, 1), time.Hour, "")
+	require.NoError(t, err)
+	_, err = reserve(monday.Add(time.Hour), time.Hour, "")
+	require.NoError(t, err)
+
+	_, err = reserve(monday.AddDate(0, 0, 1), time.Hour, "FREQ=DAILY")
+	require.ErrorIs(t, err, calendar.ErrInvalidRule)
+
+	occurrences, err := store.ListRoomReservationOccurrences(ctx, ListRoomReservationOccurrencesParams{
+		RoomID: uuid.NullUUID{UUID: room.ID, Valid: true},
+		From:   monday,
+		To:     monday.AddDate(0, 0, 7),
+	})
+	require.NoError(t, err)
+	require.Len(t, occurrences, 4)
+	require.Equal(t, weekly.ID, occurrences[0].Reservation.ID)
+	require.Equal(t, single.ID, occurrences[2].Reservation.ID)
+	require.True(t, monday.AddDate(0, 0, 2).Equal(occurrences[3].StartsAt))
+
+	// A canceled occurrence leaves the others and frees its time.
+	err = store.CancelRoomReservationOccurrence(ctx, weekly.ID, monday.AddDate(0, 0, 2))
+	require.NoError(t, err)
+	err = store.CancelRoomReservationOccurrence(ctx, weekly.ID, monday.AddDate(0, 0, 2).Add(time.Minute))
+	require.ErrorIs(t, err, ErrNotAnOccurrence)
+	err = store.CancelRoomReservationOccurrence(ctx, util.RandomUUID(), monday)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	_, err = reserve(monday.AddDate(0, 0, 2), time.Hour, "")
+	require.NoError(t, err)
+
+	occurrences, err = store.ListRoomReservationOccurrences(ctx, ListRoomReservationOccurrencesParams{
+		MemberID: uuid.NullUUID{UUID: member.ID, Valid: true},
+		From:     monday.AddDate(0, 0, 2),
+		To:       monday.AddDate(0, 0, 3),
+	})
+	require.NoError(t, err)
+	require.Len(t, occurrences, 1)
+	require.NotEqual(t, weekly.ID, occurrences[0].Reservation.ID)
+
+	_, err = store.CreateRoomReservationTx(ctx, CreateRoomReservationTxParams{
+		RoomID:   util.RandomUUID(),
+		MemberID: member.ID,
+		Title:    util.RandomName(),
+		StartsAt: monday,
+		EndsAt:   monday.Add(time.Hour),
+	})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestExpandRoomReservation(t *testing.T) {
+	t.Parallel()
+
+	startsAt := time.Date(2030, 1, 31, 9, 0, 0, 0, time.UTC)
+	reservation := RoomReservation{
+		StartsAt: startsAt,
+		EndsAt:   startsAt.Add(90 * time.Minute),
+		Rrule:    sql.NullString{String: "FREQ=MONTHLY;COUNT=3", Valid: true},
+	}
+
+	// Months without a 31st are skipped.
+	occurrences, err := ExpandRoomReservation(reservation, []time.Time{time.Date(2030, 3, 31, 9, 0, 0, 0, time.UTC)})
+	require.NoError(t, err)
+	require.Len(t, occurrences, 2)
+	require.True(t, startsAt.Equal(occurrences[0].StartsAt))
+	require.True(t, time.Date(2030, 5, 31, 9, 0, 0, 0, time.UTC).Equal(occurrences[1].StartsAt))
+	require.Equal(t, 90*time.Minute, occurrences[1].EndsAt.Sub(occurrences[1].StartsAt))
+
+	reservation.Rrule = sql.NullString{}
+	occurrences, err = ExpandRoomReservation(reservation, nil)
+	require.NoError(t, err)
+	require.Len(t, occurrences, 1)
+}
+
+func TestCalendarTokens(t *testing.T) {
+	t.Parallel()
+
+	tx := beginTransaction(t)
+	defer rollbackTransaction(t, tx)
+
+	testQueries := New(tx)
+	ctx := context.Background()
+
+	location := createRandomLocation(t, testQueries, 14, 5)
+	room := createRandomRoom(t, testQueries, location, 8)
+	member := createRandomMember(t, testQueries)
+
+	roomID := uuid.NullUUID{UUID: room.ID, Valid: true}
+	first, err := testQueries.RotateRoomCalendarToken(ctx, roomID)
+	require.NoError(t, err)
+	require.Equal(t, roomID, first.RoomID)
+
+	// Rotating replaces the token, so that the previous URL stops working.
+	second, err := testQueries.RotateRoomCalendarToken(ctx, roomID)
+	require.NoError(t, err)
+	require.NotEqual(t, first.Token, second.Token)
+	_, err = testQueries.GetCalendarToken(ctx, first.Token)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	token, err := testQueries.GetCalendarToken(ctx, second.Token)
+	require.NoError(t, err)
+	require.Equal(t, roomID, token.RoomID)
+	require.False(t, token.MemberID.Valid)
+
+	memberToken, err := testQueries.RotateMemberCalendarToken(ctx, uuid.NullUUID{UUID: member.ID, Valid: true})
+	require.NoError(t, err)
+	require.Equal(t, member.ID, memberToken.MemberID.UUID)
+	require.False(t, memberToken.RoomID.Valid)
+
+	reservation, err := testQueries.CreateRoomReservation(ctx, CreateRoomReservationParams{
+		RoomID:       room.ID,
+		MemberID:     member.ID,
+		Title:        util.RandomName(),
+		StartsAt:     time.Date(2030, 1, 7, 10, 0, 0, 0, time.UTC),
+		EndsAt:       time.Date(2030, 1, 7, 11, 0, 0, 0, time.UTC),
+		SeriesEndsAt: time.Date(2030, 1, 7, 11, 0, 0, 0, time.UTC),
+	})
+	require.NoError(t, err)
+
+	rows, err := testQueries.ListCalendarFeedReservations(ctx, ListCalendarFeedReservationsParams{
+		MemberID: uuid.NullUUID{UUID: member.ID, Valid: true},
+		Since:    time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+	})
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	require.Equal(t, reservation.ID, rows[0].ID)
+	require.Equal(t, room.Name, rows[0].RoomName)
+
+	// Deleting the room deletes its feed as well.
+	_, err = testQueries.DeleteRoom(ctx, room.ID)
+	require.NoError(t, err)
+	_, err = testQueries.GetCalendarToken(ctx, second.Token)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
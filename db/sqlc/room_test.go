@@ -0,0 +1,79 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/ot07/coworker-backend/util"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomRoom(t *testing.T, testQueries *Queries, location Location, capacity int32, equipment ...string) Room {
+	if equipment == nil {
+		equipment = []string{}
+	}
+
+	room, err := testQueries.CreateRoom(context.Background(), CreateRoomParams{
+		LocationID: location.ID,
+		Name:       util.RandomName(),
+		Capacity:   capacity,
+		Equipment:  equipment,
+	})
+	require.NoError(t, err)
+	require.Equal(t, location.ID, room.LocationID)
+	require.Equal(t, capacity, room.Capacity)
+	require.Equal(t, equipment, room.Equipment)
+
+	return room
+}
+
+func TestListRooms(t *testing.T) {
+	t.Parallel()
+
+	tx := beginTransaction(t)
+	defer rollbackTransaction(t, tx)
+
+	testQueries := New(tx)
+	ctx := context.Background()
+
+	location := createRandomLocation(t, testQueries, 14, 5)
+	small := createRandomRoom(t, testQueries, location, 4, "whiteboard")
+	large := createRandomRoom(t, testQueries, location, 12, "projector", "whiteboard")
+	createRandomRoom(t, testQueries, createRandomLocation(t, testQueries, 14, 5), 12, "projector")
+
+	locationID := uuid.NullUUID{UUID: location.ID, Valid: true}
+
+	rooms, err := testQueries.ListRooms(ctx, ListRoomsParams{LocationID: locationID, Equipment: []string{}})
+	require.NoError(t, err)
+	require.Len(t, rooms, 2)
+
+	rooms, err = testQueries.ListRooms(ctx, ListRoomsParams{LocationID: locationID, MinCapacity: 5, Equipment: []string{}})
+	require.NoError(t, err)
+	require.Len(t, rooms, 1)
+	require.Equal(t, large.ID, rooms[0].ID)
+
+	rooms, err = testQueries.ListRooms(ctx, ListRoomsParams{LocationID: locationID, Equipment: []string{"whiteboard"}})
+	require.NoError(t, err)
+	require.Len(t, rooms, 2)
+
+	rooms, err = testQueries.ListRooms(ctx, ListRoomsParams{LocationID: locationID, Equipment: []string{"projector", "whiteboard"}})
+	require.NoError(t, err)
+	require.Len(t, rooms, 1)
+	require.Equal(t, large.ID, rooms[0].ID)
+
+	// Rooms of every location are listed when no location is given.
+	rooms, err = testQueries.ListRooms(ctx, ListRoomsParams{MinCapacity: 12, Equipment: []string{"projector"}})
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, len(rooms), 2)
+
+	updated, err := testQueries.UpdateRoom(ctx, UpdateRoomParams{
+		ID:        small.ID,
+		Name:      small.Name,
+		Capacity:  6,
+		Equipment: []string{},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(6), updated.Capacity)
+	require.Empty(t, updated.Equipment)
+}
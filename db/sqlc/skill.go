@@ -0,0 +1,166 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// ErrSelfEndorsement is returned when a user endorses a skill of the member they are.
+var ErrSelfEndorsement = errors.New("members cannot endorse their own skills")
+
+// EndorseMemberSkillTxParams contains the input parameters of EndorseMemberSkillTx.
+type EndorseMemberSkillTxParams struct {
+	MemberID uuid.UUID
+	SkillID  uuid.UUID
+	// UserID is the user endorsing, who must not be the member.
+	UserID uuid.UUID
+}
+
+// EndorseMemberSkillTx records that a user vouches for a skill of a member within a single database transaction.
+// A user is the member when their email is the email of the member. Endorsing a skill twice is a no-op.
+// sql.ErrNoRows is returned when the member does not exist or is in the trash, when the member does not have the skill,
+// or when the user does not exist.
+func (store *SQLStore) EndorseMemberSkillTx(ctx context.Context, arg EndorseMemberSkillTxParams) error {
+	return store.execTx(ctx, func(q *Queries) error {
+		member, err := q.GetMember(ctx, arg.MemberID)
+		if err != nil {
+			return err
+		}
+		if member.DeletedAt.Valid {
+			return sql.ErrNoRows
+		}
+		if _, err := q.GetMemberSkill(ctx, GetMemberSkillParams{MemberID: arg.MemberID, SkillID: arg.SkillID}); err != nil {
+			return err
+		}
+
+		user, err := q.GetUser(ctx, arg.UserID)
+		if err != nil {
+			return err
+		}
+		if member.Email.Valid && strings.EqualFold(member.Email.String, user.Email) {
+			return ErrSelfEndorsement
+		}
+
+		_, err = q.CreateSkillEndorsement(ctx, CreateSkillEndorsementParams{
+			MemberID: arg.MemberID,
+			SkillID:  arg.SkillID,
+			UserID:   arg.UserID,
+		})
+		return err
+	})
+}
+
+// SkillRequirement asks for a skill at a proficiency level of at least MinLevel.
+type SkillRequirement struct {
+	SkillID  uuid.UUID
+	MinLevel int32
+}
+
+// SkillMatch is how well a member matches a set of skill requirements.
+type SkillMatch struct {
+	MemberID  uuid.UUID
+	FirstName string
+	LastName  string
+	// Matched counts the requirements the member meets.
+	Matched int32
+	// Score averages, over the requirements, the level of the member divided by the level asked for,
+	// so that members above the bar score higher than those just meeting it. A missing skill scores 0.
+	Score float64
+	// Endorsements sums up the endorsements of the required skills of the member.
+	Endorsements int32
+	// Skills lists the required skills the member has, in the order of the requirements.
+	Skills []ListSkillSearchRowsRow
+}
+
+// rankSkillMatches scores the members having any of the required skills, given the rows of their required skills,
+// and ranks them by the requirements they meet, then by score, then by endorsements, then by name.
+func rankSkillMatches(requirements []SkillRequirement, rows []ListSkillSearchRowsRow) []SkillMatch {
+	order := make(map[uuid.UUID]int, len(requirements))
+	for i, requirement := range requirements {
+		order[requirement.SkillID] = i
+	}
+
+	matches := []SkillMatch{}
+	byMember := make(map[uuid.UUID]int)
+	for _, row := range rows {
+		i, ok := order[row.SkillID]
+		if !ok {
+			continue
+		}
+		m, ok := byMember[row.MemberID]
+		if !ok {
+			m = len(matches)
+			byMember[row.MemberID] = m
+			matches = append(matches, SkillMatch{
+				MemberID:  row.MemberID,
+				FirstName: row.FirstName,
+				LastName:  row.LastName,
+				Skills:    []ListSkillSearchRowsRow{},
+			})
+		}
+
+		match := &matches[m]
+		requirement := requirements[i]
+		if row.Level >= requirement.MinLevel {
+			match.Matched++
+		}
+		match.Score += float64(row.Level) / float64(requirement.MinLevel) / float64(len(requirements))
+		match.Endorsements += row.Endorsements
+		match.Skills = append(match.Skills, row)
+	}
+
+	for _, match := range matches {
+		sort.SliceStable(match.Skills, func(i, j int) bool {
+			return order[match.Skills[i].SkillID] < order[match.Skills[j].SkillID]
+		})
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		a, b := matches[i], matches[j]
+		switch {
+		case a.Matched != b.Matched:
+			return a.Matched > b.Matched
+		case a.Score != b.Score:
+			return a.Score > b.Score
+		case a.Endorsements != b.Endorsements:
+			return a.Endorsements > b.Endorsements
+		case !strings.EqualFold(a.LastName, b.LastName):
+			return strings.ToLower(a.LastName) < strings.ToLower(b.LastName)
+		case !strings.EqualFold(a.FirstName, b.FirstName):
+			return strings.ToLower(a.FirstName) < strings.ToLower(b.FirstName)
+		}
+		return a.MemberID.String() < b.MemberID.String()
+	})
+	return matches
+}
+
+// SearchMembersBySkillsParams contains the input parameters of SearchMembersBySkills.
+type SearchMembersBySkillsParams struct {
+	Requirements []SkillRequirement
+	// Limit caps the number of members returned.
+	Limit int
+}
+
+// SearchMembersBySkills finds the members having any of the required skills, best matches first, as rankSkillMatches ranks them.
+// Members in the trash or offboarded are left out.
+func (store *SQLStore) SearchMembersBySkills(ctx context.Context, arg SearchMembersBySkillsParams) ([]SkillMatch, error) {
+	skillIDs := make([]uuid.UUID, 0, len(arg.Requirements))
+	for _, requirement := range arg.Requirements {
+		skillIDs = append(skillIDs, requirement.SkillID)
+	}
+
+	rows, err := store.ListSkillSearchRows(ctx, skillIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	matches := rankSkillMatches(arg.Requirements, rows)
+	if len(matches) > arg.Limit {
+		matches = matches[:arg.Limit]
+	}
+	return matches, nil
+}
@@ -0,0 +1,186 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ot07/coworker-backend/util"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomSkill(t *testing.T, testQueries *Queries) Skill {
+	name := util.RandomName()
+
+	skill, err := testQueries.CreateSkill(context.Background(), name)
+	require.NoError(t, err)
+	require.NotEmpty(t, skill)
+
+	require.Equal(t, name, skill.Name)
+
+	require.NotEmpty(t, skill.ID)
+	require.NotZero(t, skill.CreatedAt)
+
+	return skill
+}
+
+func TestCreateSkillDuplicateName(t *testing.T) {
+	t.Parallel()
+
+	tx := beginTransaction(t)
+	defer rollbackTransaction(t, tx)
+
+	testQueries := New(tx)
+
+	skill := createRandomSkill(t, testQueries)
+
+	_, err := testQueries.CreateSkill(context.Background(), strings.ToUpper(skill.Name))
+	require.Error(t, err)
+}
+
+func TestUpsertMemberSkill(t *testing.T) {
+	t.Parallel()
+
+	tx := beginTransaction(t)
+	defer rollbackTransaction(t, tx)
+
+	testQueries := New(tx)
+	ctx := context.Background()
+
+	member := createRandomMember(t, testQueries)
+	skill := createRandomSkill(t, testQueries)
+
+	memberSkill, err := testQueries.UpsertMemberSkill(ctx, UpsertMemberSkillParams{
+		MemberID: member.ID,
+		SkillID:  skill.ID,
+		Level:    3,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(3), memberSkill.Level)
+	require.False(t, memberSkill.LastUsedOn.Valid)
+
+	lastUsedOn := time.Date(2023, 8, 7, 0, 0, 0, 0, time.UTC)
+	memberSkill, err = testQueries.UpsertMemberSkill(ctx, UpsertMemberSkillParams{
+		MemberID:   member.ID,
+		SkillID:    skill.ID,
+		Level:      5,
+		LastUsedOn: sql.NullTime{Time: lastUsedOn, Valid: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(5), memberSkill.Level)
+	require.True(t, lastUsedOn.Equal(memberSkill.LastUsedOn.Time))
+
+	_, err = testQueries.UpsertMemberSkill(ctx, UpsertMemberSkillParams{
+		MemberID: member.ID,
+		SkillID:  skill.ID,
+		Level:    6,
+	})
+	require.Error(t, err)
+}
+
+func TestEndorseMemberSkillTx(t *testing.T) {
+	t.Parallel()
+
+	store := NewStore(testDB)
+	ctx := context.Background()
+
+	user := createRandomUser(t, store.Queries)
+	other := createRandomUser(t, store.Queries)
+	member := createRandomMemberWithEmail(t, store.Queries, util.RandomName(), util.RandomName(), strings.ToUpper(user.Email))
+	skill := createRandomSkill(t, store.Queries)
+	missing := createRandomSkill(t, store.Queries)
+
+	_, err := store.UpsertMemberSkill(ctx, UpsertMemberSkillParams{MemberID: member.ID, SkillID: skill.ID, Level: 4})
+	require.NoError(t, err)
+
+	err = store.EndorseMemberSkillTx(ctx, EndorseMemberSkillTxParams{MemberID: member.ID, SkillID: skill.ID, UserID: user.ID})
+	require.ErrorIs(t, err, ErrSelfEndorsement)
+
+	err = store.EndorseMemberSkillTx(ctx, EndorseMemberSkillTxParams{MemberID: member.ID, SkillID: missing.ID, UserID: other.ID})
+	require.Equal(t, sql.ErrNoRows, err)
+
+	// Endorsing twice is a no-op.
+	for i := 0; i < 2; i++ {
+		err = store.EndorseMemberSkillTx(ctx, EndorseMemberSkillTxParams{MemberID: member.ID, SkillID: skill.ID, UserID: other.ID})
+		require.NoError(t, err)
+	}
+
+	skills, err := store.ListMemberSkills(ctx, ListMemberSkillsParams{UserID: other.ID, MemberID: member.ID})
+	require.NoError(t, err)
+	require.Len(t, skills, 1)
+	require.Equal(t, skill.Name, skills[0].SkillName)
+	require.Equal(t, int32(1), skills[0].Endorsements)
+	require.True(t, skills[0].Endorsed)
+
+	skills, err = store.ListMemberSkills(ctx, ListMemberSkillsParams{UserID: user.ID, MemberID: member.ID})
+	require.NoError(t, err)
+	require.Equal(t, int32(1), skills[0].Endorsements)
+	require.False(t, skills[0].Endorsed)
+
+	// Removing the skill removes its endorsements.
+	_, err = store.DeleteMemberSkill(ctx, DeleteMemberSkillParams{MemberID: member.ID, SkillID: skill.ID})
+	require.NoError(t, err)
+
+	removed, err := store.DeleteSkillEndorsement(ctx, DeleteSkillEndorsementParams{MemberID: member.ID, SkillID: skill.ID, UserID: other.ID})
+	require.NoError(t, err)
+	require.Zero(t, removed)
+}
+
+func TestRankSkillMatches(t *testing.T) {
+	t.Parallel()
+
+	golang := util.RandomUUID()
+	postgres := util.RandomUUID()
+	requirements := []SkillRequirement{
+		{SkillID: golang, MinLevel: 4},
+		{SkillID: postgres, MinLevel: 3},
+	}
+
+	ada := ListSkillSearchRowsRow{MemberID: util.RandomUUID(), FirstName: "Ada", LastName: "Lovelace"}
+	alan := ListSkillSearchRowsRow{MemberID: util.RandomUUID(), FirstName: "Alan", LastName: "Turing"}
+	grace := ListSkillSearchRowsRow{MemberID: util.RandomUUID(), FirstName: "Grace", LastName: "Hopper"}
+	linus := ListSkillSearchRowsRow{MemberID: util.RandomUUID(), FirstName: "Linus", LastName: "Torvalds"}
+	row := func(member ListSkillSearchRowsRow, skillID uuid.UUID, level, endorsements int32) ListSkillSearchRowsRow {
+		member.SkillID = skillID
+		member.Level = level
+		member.Endorsements = endorsements
+		return member
+	}
+
+	matches := rankSkillMatches(requirements, []ListSkillSearchRowsRow{
+		// Ada meets both requirements just so, Grace both of them above the bar.
+		row(ada, postgres, 3, 2),
+		row(ada, golang, 4, 0),
+		row(grace, golang, 5, 0),
+		row(grace, postgres, 3, 0),
+		// Alan and Linus meet one requirement each, Linus with more endorsements.
+		row(alan, golang, 4, 0),
+		row(alan, postgres, 2, 0),
+		row(linus, golang, 4, 3),
+		row(linus, postgres, 2, 0),
+		// Skills that were not asked for are ignored.
+		row(linus, util.RandomUUID(), 5, 10),
+	})
+	require.Len(t, matches, 4)
+
+	require.Equal(t, grace.MemberID, matches[0].MemberID)
+	require.Equal(t, int32(2), matches[0].Matched)
+	require.InDelta(t, 1.125, matches[0].Score, 1e-9)
+
+	require.Equal(t, ada.MemberID, matches[1].MemberID)
+	require.Equal(t, 1.0, matches[1].Score)
+	require.Equal(t, int32(2), matches[1].Endorsements)
+	require.Len(t, matches[1].Skills, 2)
+	require.Equal(t, golang, matches[1].Skills[0].SkillID)
+
+	require.Equal(t, linus.MemberID, matches[2].MemberID)
+	require.Equal(t, int32(1), matches[2].Matched)
+	require.Equal(t, int32(3), matches[2].Endorsements)
+	require.Len(t, matches[2].Skills, 2)
+	require.Equal(t, alan.MemberID, matches[3].MemberID)
+
+	require.Empty(t, rankSkillMatches(requirements, nil))
+}
@@ -1,10 +1,58 @@
 package db
 
-import "database/sql"
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
 
 // Store provides all functions to execute db queries and transactions
 type Store interface {
 	Querier
+	CreateUserTx(ctx context.Context, arg CreateUserParams) (User, error)
+	ListMembersByFilter(ctx context.Context, arg ListMembersByFilterParams) ([]Member, error)
+	CountMembersByFilter(ctx context.Context, filter MemberFilter) (int64, error)
+	ForEachMemberByFilter(ctx context.Context, arg ForEachMemberByFilterParams, fn func(Member) error) error
+	ImportMembersTx(ctx context.Context, args []CreateMemberParams, meta AuditMeta) ([]Member, error)
+	CreateMemberTx(ctx context.Context, arg CreateMemberTxParams, meta AuditMeta) (Member, error)
+	UpdateMemberTx(ctx context.Context, arg UpdateMemberTxParams, meta AuditMeta) (Member, error)
+	PatchMemberTx(ctx context.Context, arg PatchMemberTxParams, meta AuditMeta) (Member, error)
+	GetMemberContactDetails(ctx context.Context, memberID uuid.UUID) (MemberContactDetails, error)
+	SetMemberManagerTx(ctx context.Context, arg SetMemberManagerParams, meta AuditMeta) (Member, error)
+	SetMemberAvatarTx(ctx context.Context, arg SetMemberAvatarParams, meta AuditMeta) (SetMemberAvatarTxResult, error)
+	SetMemberWorkingHoursTx(ctx context.Context, arg SetMemberWorkingHoursTxParams, meta AuditMeta) (SetMemberWorkingHoursTxResult, error)
+	DeleteMembersTx(ctx context.Context, ids []uuid.UUID, meta AuditMeta) ([]Member, error)
+	RestoreMemberTx(ctx context.Context, id uuid.UUID, meta AuditMeta) (Member, error)
+	RestoreMembersTx(ctx context.Context, ids []uuid.UUID, meta AuditMeta) ([]Member, error)
+	MergeMembersTx(ctx context.Context, arg MergeMembersTxParams, meta AuditMeta) (MergeMembersTxResult, error)
+	TransitionMemberTx(ctx context.Context, arg TransitionMemberTxParams, meta AuditMeta) (Member, error)
+	ReplaceChecklistTemplateTx(ctx context.Context, kind string, tasks []CreateChecklistTemplateTaskParams) ([]ChecklistTemplateTask, error)
+	BookDeskTx(ctx context.Context, arg BookDeskTxParams) (DeskBooking, error)
+	CreateRoomReservationTx(ctx context.Context, arg CreateRoomReservationTxParams) (RoomReservation, error)
+	ListRoomReservationOccurrences(ctx context.Context, arg ListRoomReservationOccurrencesParams) ([]RoomReservationOccurrence, error)
+	CancelRoomReservationOccurrence(ctx context.Context, id uuid.UUID, occurrenceStart time.Time) error
+	ListLeaveBalances(ctx context.Context, memberID uuid.UUID, year int) ([]LeaveBalance, error)
+	CreateLeaveRequestTx(ctx context.Context, arg CreateLeaveRequestParams) (LeaveRequest, error)
+	DecideLeaveRequestTx(ctx context.Context, arg DecideLeaveRequestTxParams) (LeaveRequest, error)
+	CreateTimeEntryTx(ctx context.Context, arg CreateTimeEntryParams) (TimeEntry, error)
+	UpdateTimeEntryTx(ctx context.Context, arg UpdateTimeEntryParams) (TimeEntry, error)
+	DeleteTimeEntryTx(ctx context.Context, id uuid.UUID) (TimeEntry, error)
+	StopTimerTx(ctx context.Context, memberID uuid.UUID, endedAt time.Time) (TimeEntry, error)
+	SubmitTimesheetTx(ctx context.Context, arg SubmitTimesheetTxParams) (Timesheet, error)
+	ReopenTimesheetTx(ctx context.Context, arg ReopenTimesheetTxParams) (Timesheet, error)
+	CreateProjectAssignmentTx(ctx context.Context, arg CreateProjectAssignmentParams, allocationLimit int32) (ProjectAssignment, error)
+	UpdateProjectAssignmentTx(ctx context.Context, arg UpdateProjectAssignmentParams, allocationLimit int32) (ProjectAssignment, error)
+	ListMemberCapacities(ctx context.Context, arg ListMemberCapacitiesParams) ([]MemberCapacity, error)
+	EndorseMemberSkillTx(ctx context.Context, arg EndorseMemberSkillTxParams) error
+	SearchMembersBySkills(ctx context.Context, arg SearchMembersBySkillsParams) ([]SkillMatch, error)
+	DeleteCustomFieldDefinitionTx(ctx context.Context, id uuid.UUID) (CustomFieldDefinition, error)
+	UpdateTeamTx(ctx context.Context, arg UpdateTeamParams) (Team, error)
+	DeleteTeamTx(ctx context.Context, id uuid.UUID) (Team, error)
+	MoveTeamMemberTx(ctx context.Context, arg MoveTeamMemberTxParams) (TeamMember, error)
 }
 
 // SQLStore provides all functions to execute SQL queries and transactions
@@ -20,3 +68,407 @@ func NewStore(db *sql.DB) *SQLStore {
 		Queries: New(db),
 	}
 }
+
+// execTx executes a function within a database transaction
+func (store *SQLStore) execTx(ctx context.Context, fn func(*Queries) error) error {
+	tx, err := store.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	q := New(tx)
+	err = fn(q)
+	if err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("tx err: %v, rb err: %v", err, rbErr)
+		}
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// ImportMembersTx creates all the given members within a single database transaction.
+// Either every member is created or none of them are.
+func (store *SQLStore) ImportMembersTx(ctx context.Context, args []CreateMemberParams, meta AuditMeta) ([]Member, error) {
+	members := make([]Member, 0, len(args))
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		for _, arg := range args {
+			member, err := q.CreateMember(ctx, arg)
+			if err != nil {
+				return err
+			}
+			if err := q.syncPrimaryMemberEmail(ctx, Member{}, member); err != nil {
+				return err
+			}
+			if err := q.createMemberAuditEvent(ctx, meta, AuditActionCreate, nil, &member); err != nil {
+				return err
+			}
+			members = append(members, member)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return members, nil
+}
+
+// CreateMemberTxParams contains the input parameters of CreateMemberTx.
+type CreateMemberTxParams struct {
+	CreateMemberParams
+	ContactDetails MemberContactDetailsParams
+}
+
+// CreateMemberTx creates a member with its contact details and records it in the audit trail within a single database transaction.
+func (store *SQLStore) CreateMemberTx(ctx context.Context, arg CreateMemberTxParams, meta AuditMeta) (Member, error) {
+	var member Member
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		created, err := q.CreateMember(ctx, arg.CreateMemberParams)
+		if err != nil {
+			return err
+		}
+		// A member created while onboarding gets the onboarding checklist, due from their start date or else from today.
+		effectiveDate := created.StartDate.Time
+		if !created.StartDate.Valid {
+			effectiveDate = time.Now()
+		}
+		if err := q.createMemberChecklist(ctx, created.ID, created.Status, effectiveDate); err != nil {
+			return err
+		}
+		member, err = q.setMemberContactDetails(ctx, created, arg.ContactDetails)
+		if err != nil {
+			return err
+		}
+		if err := q.syncPrimaryMemberEmail(ctx, Member{}, member); err != nil {
+			return err
+		}
+		return q.createMemberAuditEvent(ctx, meta, AuditActionCreate, nil, &member)
+	})
+
+	return member, err
+}
+
+// UpdateMemberTxParams contains the input parameters of UpdateMemberTx.
+type UpdateMemberTxParams struct {
+	UpdateMemberParams
+	ContactDetails MemberContactDetailsParams
+}
+
+// UpdateMemberTx updates a member with its contact details and records the changed fields in the audit trail
+// within a single database transaction.
+func (store *SQLStore) UpdateMemberTx(ctx context.Context, arg UpdateMemberTxParams, meta AuditMeta) (Member, error) {
+	return store.updateMemberTx(ctx, arg.ID, meta, func(q *Queries) (Member, error) {
+		member, err := q.UpdateMember(ctx, arg.UpdateMemberParams)
+		if err != nil {
+			return Member{}, err
+		}
+		return q.setMemberContactDetails(ctx, member, arg.ContactDetails)
+	})
+}
+
+// PatchMemberTxParams contains the input parameters of PatchMemberTx.
+type PatchMemberTxParams struct {
+	PatchMemberParams
+	ContactDetails MemberContactDetailsParams
+}
+
+// PatchMemberTx patches a member with its contact details and records the changed fields in the audit trail
+// within a single database transaction.
+func (store *SQLStore) PatchMemberTx(ctx context.Context, arg PatchMemberTxParams, meta AuditMeta) (Member, error) {
+	return store.updateMemberTx(ctx, arg.ID, meta, func(q *Queries) (Member, error) {
+		member, err := q.PatchMember(ctx, arg.PatchMemberParams)
+		if err != nil {
+			return Member{}, err
+		}
+		return q.setMemberContactDetails(ctx, member, arg.ContactDetails)
+	})
+}
+
+// SetMemberAvatarTxResult is the result of SetMemberAvatarTx.
+type SetMemberAvatarTxResult struct {
+	Member Member
+	// PreviousAvatarKey is the avatar replaced, whose files can be removed once the transaction is committed.
+	PreviousAvatarKey sql.NullString
+}
+
+// SetMemberAvatarTx sets or clears the avatar of a member and records it in the audit trail within a single database transaction.
+// The row is locked, so that of concurrent changes each gets back the avatar it actually replaced.
+func (store *SQLStore) SetMemberAvatarTx(ctx context.Context, arg SetMemberAvatarParams, meta AuditMeta) (SetMemberAvatarTxResult, error) {
+	var result SetMemberAvatarTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		before, err := q.GetMemberForUpdate(ctx, arg.ID)
+		if err != nil {
+			return err
+		}
+
+		member, err := q.SetMemberAvatar(ctx, arg)
+		if err != nil {
+			return err
+		}
+		if err := q.createMemberAuditEvent(ctx, meta, AuditActionUpdate, &before, &member); err != nil {
+			return err
+		}
+
+		result.Member = member
+		result.PreviousAvatarKey = before.AvatarKey
+		return nil
+	})
+	if err != nil {
+		return SetMemberAvatarTxResult{}, err
+	}
+
+	return result, nil
+}
+
+// ErrManagerCycle is returned when a member would end up reporting to themselves.
+var ErrManagerCycle = errors.New("a member cannot report to themselves or to one of their reports")
+
+// ErrManagerNotFound is returned when the manager assigned to a member does not exist or is in the trash.
+var ErrManagerNotFound = errors.New("manager not found")
+
+// SetMemberManagerTx sets or clears the manager of a member and records it in the audit trail within a single database transaction,
+// refusing with ErrManagerCycle to make a member report to themselves or to one of their reports.
+func (store *SQLStore) SetMemberManagerTx(ctx context.Context, arg SetMemberManagerParams, meta AuditMeta) (Member, error) {
+	return store.updateMemberTx(ctx, arg.ID, meta, func(q *Queries) (Member, error) {
+		if arg.ManagerID.Valid {
+			// Reporting lines are changed one at a time, so that concurrent changes cannot form a cycle together.
+			if err := q.LockReportingLines(ctx); err != nil {
+				return Member{}, err
+			}
+			if arg.ManagerID.UUID == arg.ID {
+				return Member{}, ErrManagerCycle
+			}
+			if _, err := q.GetMember(ctx, arg.ManagerID.UUID); err != nil {
+				if err == sql.ErrNoRows {
+					return Member{}, ErrManagerNotFound
+				}
+				return Member{}, err
+			}
+			report, err := q.IsMemberReport(ctx, IsMemberReportParams{ManagerID: arg.ID, MemberID: arg.ManagerID.UUID})
+			if err != nil {
+				return Member{}, err
+			}
+			if report {
+				return Member{}, ErrManagerCycle
+			}
+		}
+
+		return q.SetMemberManager(ctx, arg)
+	})
+}
+
+func (store *SQLStore) updateMemberTx(ctx context.Context, id uuid.UUID, meta AuditMeta, update func(q *Queries) (Member, error)) (Member, error) {
+	var member Member
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		// The row is locked so that the recorded state before the update is the one actually updated.
+		before, err := q.GetMemberForUpdate(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		member, err = update(q)
+		if err != nil {
+			return err
+		}
+		if err := q.syncPrimaryMemberEmail(ctx, before, member); err != nil {
+			return err
+		}
+		return q.createMemberAuditEvent(ctx, meta, AuditActionUpdate, &before, &member)
+	})
+
+	return member, err
+}
+
+// DeleteMembersTx moves members to the trash and records it in the audit trail within a single database transaction.
+// Members that do not exist or are already in the trash are skipped.
+// The primary emails of the members are unset, so that live members can take them over.
+func (store *SQLStore) DeleteMembersTx(ctx context.Context, ids []uuid.UUID, meta AuditMeta) ([]Member, error) {
+	return store.changeMembersTx(ctx, ids, meta, AuditActionDelete, func(q *Queries) ([]Member, error) {
+		members, err := q.DeleteMembers(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+		return members, q.UnsetPrimaryMemberEmails(ctx, ids)
+	})
+}
+
+// RestoreMembersTx moves members out of the trash and records it in the audit trail within a single database transaction.
+// Members that are not in the trash are skipped. The email of every member becomes its primary email again,
+// which fails with a unique violation when a live member has taken it over in the meantime.
+func (store *SQLStore) RestoreMembersTx(ctx context.Context, ids []uuid.UUID, meta AuditMeta) ([]Member, error) {
+	return store.changeMembersTx(ctx, ids, meta, AuditActionRestore, func(q *Queries) ([]Member, error) {
+		if err := q.RestorePrimaryMemberEmails(ctx, ids); err != nil {
+			return nil, err
+		}
+		return q.RestoreMembers(ctx, ids)
+	})
+}
+
+func (store *SQLStore) changeMembersTx(ctx context.Context, ids []uuid.UUID, meta AuditMeta, action string, change func(q *Queries) ([]Member, error)) ([]Member, error) {
+	var members []Member
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		locked, err := q.ListMembersForUpdate(ctx, ids)
+		if err != nil {
+			return err
+		}
+		before := make(map[uuid.UUID]Member, len(locked))
+		for _, member := range locked {
+			before[member.ID] = member
+		}
+
+		members, err = change(q)
+		if err != nil {
+			return err
+		}
+		for i := range members {
+			old := before[members[i].ID]
+			if err := q.createMemberAuditEvent(ctx, meta, action, &old, &members[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return members, nil
+}
+
+// RestoreMemberTx moves a member out of the trash and records it in the audit trail within a single database transaction.
+// sql.ErrNoRows is returned when the member is not in the trash. As in RestoreMembersTx, the email of the member
+// becomes its primary email again.
+func (store *SQLStore) RestoreMemberTx(ctx context.Context, id uuid.UUID, meta AuditMeta) (Member, error) {
+	var member Member
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		before, err := q.GetMemberForUpdate(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if err := q.RestorePrimaryMemberEmails(ctx, []uuid.UUID{id}); err != nil {
+			return err
+		}
+
+		member, err = q.RestoreMember(ctx, id)
+		if err != nil {
+			return err
+		}
+		return q.createMemberAuditEvent(ctx, meta, AuditActionRestore, &before, &member)
+	})
+
+	return member, err
+}
+
+// DeleteCustomFieldDefinitionTx deletes a custom field definition together with the values of the field
+// held by members within a single database transaction.
+func (store *SQLStore) DeleteCustomFieldDefinitionTx(ctx context.Context, id uuid.UUID) (CustomFieldDefinition, error) {
+	var definition CustomFieldDefinition
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+		definition, err = q.DeleteCustomFieldDefinition(ctx, id)
+		if err != nil {
+			return err
+		}
+		_, err = q.RemoveMembersCustomField(ctx, definition.Key)
+		return err
+	})
+
+	return definition, err
+}
+
+// ErrTeamCycle is returned when a team would become its own ancestor.
+var ErrTeamCycle = errors.New("a team cannot be placed under itself or one of its subteams")
+
+// UpdateTeamTx updates a team within a single database transaction,
+// refusing with ErrTeamCycle to place the team under itself or one of its subteams.
+func (store *SQLStore) UpdateTeamTx(ctx context.Context, arg UpdateTeamParams) (Team, error) {
+	var team Team
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		if arg.ParentID.Valid {
+			if arg.ParentID.UUID == arg.ID {
+				return ErrTeamCycle
+			}
+			descendant, err := q.IsDescendantTeam(ctx, IsDescendantTeamParams{AncestorID: arg.ID, TeamID: arg.ParentID.UUID})
+			if err != nil {
+				return err
+			}
+			if descendant {
+				return ErrTeamCycle
+			}
+		}
+
+		var err error
+		team, err = q.UpdateTeam(ctx, arg)
+		return err
+	})
+
+	return team, err
+}
+
+// DeleteTeamTx deletes a team within a single database transaction.
+// Its subteams are moved up to its parent, and its members are only removed from the team.
+func (store *SQLStore) DeleteTeamTx(ctx context.Context, id uuid.UUID) (Team, error) {
+	var team Team
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+		team, err = q.GetTeam(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		err = q.ReparentChildTeams(ctx, ReparentChildTeamsParams{ParentID: team.ParentID, ID: team.ID})
+		if err != nil {
+			return err
+		}
+
+		_, err = q.DeleteTeam(ctx, id)
+		return err
+	})
+
+	return team, err
+}
+
+// MoveTeamMemberTxParams contains the input parameters of MoveTeamMemberTx.
+type MoveTeamMemberTxParams struct {
+	FromTeamID uuid.UUID
+	ToTeamID   uuid.UUID
+	MemberID   uuid.UUID
+	Role       string
+}
+
+// MoveTeamMemberTx moves a member from one team to another within a single database transaction.
+// sql.ErrNoRows is returned when the member does not belong to the team it is moved from.
+func (store *SQLStore) MoveTeamMemberTx(ctx context.Context, arg MoveTeamMemberTxParams) (TeamMember, error) {
+	var teamMember TeamMember
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		removed, err := q.RemoveTeamMember(ctx, RemoveTeamMemberParams{TeamID: arg.FromTeamID, MemberID: arg.MemberID})
+		if err != nil {
+			return err
+		}
+		if removed == 0 {
+			return sql.ErrNoRows
+		}
+
+		teamMember, err = q.AddTeamMember(ctx, AddTeamMemberParams{
+			TeamID:   arg.ToTeamID,
+			MemberID: arg.MemberID,
+			Role:     arg.Role,
+		})
+		return err
+	})
+
+	return teamMember, err
+}
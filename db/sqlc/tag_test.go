@@ -0,0 +1,174 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/ot07/coworker-backend/util"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomTag(t *testing.T, testQueries *Queries) Tag {
+	name := util.RandomName()
+
+	tag, err := testQueries.CreateTag(context.Background(), name)
+	require.NoError(t, err)
+	require.NotEmpty(t, tag)
+
+	require.Equal(t, name, tag.Name)
+
+	require.NotEmpty(t, tag.ID)
+	require.NotZero(t, tag.CreatedAt)
+
+	return tag
+}
+
+func TestCreateTag(t *testing.T) {
+	t.Parallel()
+
+	tx := beginTransaction(t)
+	defer rollbackTransaction(t, tx)
+
+	testQueries := New(tx)
+
+	createRandomTag(t, testQueries)
+}
+
+func TestCreateTagDuplicateName(t *testing.T) {
+	t.Parallel()
+
+	tx := beginTransaction(t)
+	defer rollbackTransaction(t, tx)
+
+	testQueries := New(tx)
+
+	tag := createRandomTag(t, testQueries)
+
+	_, err := testQueries.CreateTag(context.Background(), strings.ToUpper(tag.Name))
+	require.Error(t, err)
+}
+
+func TestListTags(t *testing.T) {
+	t.Parallel()
+
+	tx := beginTransaction(t)
+	defer rollbackTransaction(t, tx)
+
+	testQueries := New(tx)
+
+	tag1, err := testQueries.CreateTag(context.Background(), "beta")
+	require.NoError(t, err)
+	tag2, err := testQueries.CreateTag(context.Background(), "Alpha")
+	require.NoError(t, err)
+
+	tags, err := testQueries.ListTags(context.Background())
+	require.NoError(t, err)
+	require.Len(t, tags, 2)
+	require.Equal(t, tag2.ID, tags[0].ID)
+	require.Equal(t, tag1.ID, tags[1].ID)
+}
+
+func TestRenameTag(t *testing.T) {
+	t.Parallel()
+
+	tx := beginTransaction(t)
+	defer rollbackTransaction(t, tx)
+
+	testQueries := New(tx)
+
+	tag1 := createRandomTag(t, testQueries)
+
+	arg := RenameTagParams{
+		ID:   tag1.ID,
+		Name: util.RandomName(),
+	}
+
+	tag2, err := testQueries.RenameTag(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, tag1.ID, tag2.ID)
+	require.Equal(t, arg.Name, tag2.Name)
+	require.Equal(t, tag1.CreatedAt, tag2.CreatedAt)
+}
+
+func TestDeleteTag(t *testing.T) {
+	t.Parallel()
+
+	tx := beginTransaction(t)
+	defer rollbackTransaction(t, tx)
+
+	testQueries := New(tx)
+
+	tag := createRandomTag(t, testQueries)
+	member := createRandomMember(t, testQueries)
+
+	_, err := testQueries.AddMemberTags(context.Background(), AddMemberTagsParams{
+		MemberIds: []uuid.UUID{member.ID},
+		TagIds:    []uuid.UUID{tag.ID},
+	})
+	require.NoError(t, err)
+
+	_, err = testQueries.DeleteTag(context.Background(), tag.ID)
+	require.NoError(t, err)
+
+	_, err = testQueries.GetTag(context.Background(), tag.ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	rows, err := testQueries.ListTagsByMemberIDs(context.Background(), []uuid.UUID{member.ID})
+	require.NoError(t, err)
+	require.Empty(t, rows)
+}
+
+func TestAddAndRemoveMemberTags(t *testing.T) {
+	t.Parallel()
+
+	tx := beginTransaction(t)
+	defer rollbackTransaction(t, tx)
+
+	testQueries := New(tx)
+
+	member1 := createRandomMember(t, testQueries)
+	member2 := createRandomMember(t, testQueries)
+	deleted := createRandomMember(t, testQueries)
+	tag1 := createRandomTag(t, testQueries)
+	tag2 := createRandomTag(t, testQueries)
+
+	_, err := testQueries.DeleteMembers(context.Background(), []uuid.UUID{deleted.ID})
+	require.NoError(t, err)
+
+	arg := AddMemberTagsParams{
+		MemberIds: []uuid.UUID{member1.ID, member2.ID, deleted.ID},
+		TagIds:    []uuid.UUID{tag1.ID, tag2.ID},
+	}
+
+	// Members in the trash are not tagged.
+	added, err := testQueries.AddMemberTags(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, int64(4), added)
+
+	// Tags the members already have are ignored.
+	added, err = testQueries.AddMemberTags(context.Background(), arg)
+	require.NoError(t, err)
+	require.Zero(t, added)
+
+	removed, err := testQueries.RemoveMemberTags(context.Background(), RemoveMemberTagsParams{
+		MemberIds: []uuid.UUID{member1.ID},
+		TagIds:    []uuid.UUID{tag1.ID},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), removed)
+
+	rows, err := testQueries.ListTagsByMemberIDs(context.Background(), []uuid.UUID{member1.ID, member2.ID, deleted.ID})
+	require.NoError(t, err)
+	require.Len(t, rows, 3)
+
+	tagIDs := make(map[uuid.UUID][]uuid.UUID)
+	for _, row := range rows {
+		tagIDs[row.MemberID] = append(tagIDs[row.MemberID], row.ID)
+	}
+	require.Equal(t, []uuid.UUID{tag2.ID}, tagIDs[member1.ID])
+	require.ElementsMatch(t, []uuid.UUID{tag1.ID, tag2.ID}, tagIDs[member2.ID])
+	require.Empty(t, tagIDs[deleted.ID])
+}
@@ -0,0 +1,275 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/ot07/coworker-backend/util"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomTeam(t *testing.T, testQueries *Queries, parentID uuid.NullUUID) Team {
+	arg := CreateTeamParams{
+		Name:     util.RandomName(),
+		ParentID: parentID,
+	}
+
+	team, err := testQueries.CreateTeam(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, team)
+
+	require.Equal(t, arg.Name, team.Name)
+	require.Equal(t, arg.ParentID, team.ParentID)
+
+	require.NotEmpty(t, team.ID)
+	require.NotZero(t, team.CreatedAt)
+
+	return team
+}
+
+func TestCreateTeam(t *testing.T) {
+	t.Parallel()
+
+	tx := beginTransaction(t)
+	defer rollbackTransaction(t, tx)
+
+	testQueries := New(tx)
+
+	parent := createRandomTeam(t, testQueries, uuid.NullUUID{})
+	createRandomTeam(t, testQueries, uuid.NullUUID{UUID: parent.ID, Valid: true})
+}
+
+func TestCreateTeamParentNotFound(t *testing.T) {
+	t.Parallel()
+
+	tx := beginTransaction(t)
+	defer rollbackTransaction(t, tx)
+
+	testQueries := New(tx)
+
+	_, err := testQueries.CreateTeam(context.Background(), CreateTeamParams{
+		Name:     util.RandomName(),
+		ParentID: uuid.NullUUID{UUID: util.RandomUUID(), Valid: true},
+	})
+	require.Error(t, err)
+}
+
+func TestUpdateTeam(t *testing.T) {
+	t.Parallel()
+
+	tx := beginTransaction(t)
+	defer rollbackTransaction(t, tx)
+
+	testQueries := New(tx)
+
+	parent := createRandomTeam(t, testQueries, uuid.NullUUID{})
+	team1 := createRandomTeam(t, testQueries, uuid.NullUUID{})
+
+	arg := UpdateTeamParams{
+		ID:       team1.ID,
+		Name:     util.RandomName(),
+		ParentID: uuid.NullUUID{UUID: parent.ID, Valid: true},
+	}
+
+	team2, err := testQueries.UpdateTeam(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, team1.ID, team2.ID)
+	require.Equal(t, arg.Name, team2.Name)
+	require.Equal(t, arg.ParentID, team2.ParentID)
+	require.Equal(t, team1.CreatedAt, team2.CreatedAt)
+}
+
+func TestIsDescendantTeam(t *testing.T) {
+	t.Parallel()
+
+	tx := beginTransaction(t)
+	defer rollbackTransaction(t, tx)
+
+	testQueries := New(tx)
+
+	root := createRandomTeam(t, testQueries, uuid.NullUUID{})
+	child := createRandomTeam(t, testQueries, uuid.NullUUID{UUID: root.ID, Valid: true})
+	grandchild := createRandomTeam(t, testQueries, uuid.NullUUID{UUID: child.ID, Valid: true})
+	other := createRandomTeam(t, testQueries, uuid.NullUUID{})
+
+	ok, err := testQueries.IsDescendantTeam(context.Background(), IsDescendantTeamParams{
+		AncestorID: root.ID,
+		TeamID:     grandchild.ID,
+	})
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	ok, err = testQueries.IsDescendantTeam(context.Background(), IsDescendantTeamParams{
+		AncestorID: grandchild.ID,
+		TeamID:     root.ID,
+	})
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	ok, err = testQueries.IsDescendantTeam(context.Background(), IsDescendantTeamParams{
+		AncestorID: root.ID,
+		TeamID:     other.ID,
+	})
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+func TestDeleteTeam(t *testing.T) {
+	t.Parallel()
+
+	tx := beginTransaction(t)
+	defer rollbackTransaction(t, tx)
+
+	testQueries := New(tx)
+
+	root := createRandomTeam(t, testQueries, uuid.NullUUID{})
+	team := createRandomTeam(t, testQueries, uuid.NullUUID{UUID: root.ID, Valid: true})
+	child := createRandomTeam(t, testQueries, uuid.NullUUID{UUID: team.ID, Valid: true})
+	member := createRandomMember(t, testQueries)
+
+	_, err := testQueries.AddTeamMember(context.Background(), AddTeamMemberParams{
+		TeamID:   team.ID,
+		MemberID: member.ID,
+		Role:     "member",
+	})
+	require.NoError(t, err)
+
+	err = testQueries.ReparentChildTeams(context.Background(), ReparentChildTeamsParams{
+		ParentID: team.ParentID,
+		ID:       team.ID,
+	})
+	require.NoError(t, err)
+
+	_, err = testQueries.DeleteTeam(context.Background(), team.ID)
+	require.NoError(t, err)
+
+	_, err = testQueries.GetTeam(context.Background(), team.ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	got, err := testQueries.GetTeam(context.Background(), child.ID)
+	require.NoError(t, err)
+	require.Equal(t, uuid.NullUUID{UUID: root.ID, Valid: true}, got.ParentID)
+
+	_, err = testQueries.GetMember(context.Background(), member.ID)
+	require.NoError(t, err)
+}
+
+func TestAddAndRemoveTeamMember(t *testing.T) {
+	t.Parallel()
+
+	tx := beginTransaction(t)
+	defer rollbackTransaction(t, tx)
+
+	testQueries := New(tx)
+
+	team := createRandomTeam(t, testQueries, uuid.NullUUID{})
+	member := createRandomMember(t, testQueries)
+
+	arg := AddTeamMemberParams{
+		TeamID:   team.ID,
+		MemberID: member.ID,
+		Role:     "member",
+	}
+
+	teamMember, err := testQueries.AddTeamMember(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, team.ID, teamMember.TeamID)
+	require.Equal(t, member.ID, teamMember.MemberID)
+	require.Equal(t, "member", teamMember.Role)
+
+	// Adding an existing member again updates the role.
+	arg.Role = "lead"
+	teamMember, err = testQueries.AddTeamMember(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, "lead", teamMember.Role)
+
+	_, err = testQueries.AddTeamMember(context.Background(), AddTeamMemberParams{
+		TeamID:   team.ID,
+		MemberID: util.RandomUUID(),
+		Role:     "member",
+	})
+	require.Error(t, err)
+
+	removed, err := testQueries.RemoveTeamMember(context.Background(), RemoveTeamMemberParams{
+		TeamID:   team.ID,
+		MemberID: member.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), removed)
+
+	removed, err = testQueries.RemoveTeamMember(context.Background(), RemoveTeamMemberParams{
+		TeamID:   team.ID,
+		MemberID: member.ID,
+	})
+	require.NoError(t, err)
+	require.Zero(t, removed)
+}
+
+func TestListTeamMembers(t *testing.T) {
+	t.Parallel()
+
+	tx := beginTransaction(t)
+	defer rollbackTransaction(t, tx)
+
+	testQueries := New(tx)
+
+	team := createRandomTeam(t, testQueries, uuid.NullUUID{})
+	subteam := createRandomTeam(t, testQueries, uuid.NullUUID{UUID: team.ID, Valid: true})
+	member1 := createRandomMember(t, testQueries)
+	member2 := createRandomMember(t, testQueries)
+	deleted := createRandomMember(t, testQueries)
+
+	for _, arg := range []AddTeamMemberParams{
+		{TeamID: team.ID, MemberID: member1.ID, Role: "lead"},
+		{TeamID: subteam.ID, MemberID: member2.ID, Role: "member"},
+		{TeamID: team.ID, MemberID: deleted.ID, Role: "member"},
+	} {
+		_, err := testQueries.AddTeamMember(context.Background(), arg)
+		require.NoError(t, err)
+	}
+
+	_, err := testQueries.DeleteMembers(context.Background(), []uuid.UUID{deleted.ID})
+	require.NoError(t, err)
+
+	rows, err := testQueries.ListTeamMembers(context.Background(), ListTeamMembersParams{
+		Limit:  10,
+		Offset: 0,
+		TeamID: team.ID,
+	})
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	require.Equal(t, member1.ID, rows[0].ID)
+	require.Equal(t, team.ID, rows[0].TeamID)
+	require.Equal(t, "lead", rows[0].Role)
+
+	count, err := testQueries.CountTeamMembers(context.Background(), CountTeamMembersParams{
+		TeamID: team.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), count)
+
+	rows, err = testQueries.ListTeamMembers(context.Background(), ListTeamMembersParams{
+		Limit:     10,
+		Offset:    0,
+		TeamID:    team.ID,
+		Recursive: true,
+	})
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+
+	teamIDs := make(map[uuid.UUID]uuid.UUID)
+	for _, row := range rows {
+		teamIDs[row.ID] = row.TeamID
+	}
+	require.Equal(t, team.ID, teamIDs[member1.ID])
+	require.Equal(t, subteam.ID, teamIDs[member2.ID])
+
+	count, err = testQueries.CountTeamMembers(context.Background(), CountTeamMembersParams{
+		TeamID:    team.ID,
+		Recursive: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(2), count)
+}
@@ -0,0 +1,382 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrProjectArchived is returned when tracking time against an archived project.
+var ErrProjectArchived = errors.New("the project is archived")
+
+// ErrTimeEntryEndBeforeStart is returned for a time entry that does not end after it starts.
+var ErrTimeEntryEndBeforeStart = errors.New("the time entry must end after it starts")
+
+// ErrTimesheetLocked is returned when the time entries of a week are changed after its timesheet was submitted,
+// or when the timesheet is submitted twice.
+var ErrTimesheetLocked = errors.New("the timesheet of the week is submitted")
+
+// ErrTimesheetNotSubmitted is returned when reopening a timesheet that was not submitted.
+var ErrTimesheetNotSubmitted = errors.New("the timesheet of the week is not submitted")
+
+// ErrTimerRunning is returned when starting a timer while another one is running,
+// or when submitting a timesheet while a timer of the week is running.
+var ErrTimerRunning = errors.New("a timer of the member is running")
+
+// ErrNoRunningTimer is returned when stopping the timer of a member who has none running.
+var ErrNoRunningTimer = errors.New("no timer of the member is running")
+
+// ErrNotTimesheetApprover is returned when a user who is neither an admin nor the manager of the member reopens a timesheet.
+var ErrNotTimesheetApprover = errors.New("only an admin or the manager of the member can reopen the timesheet")
+
+// WeekStart returns the Monday starting the week of a date.
+func WeekStart(date time.Time) time.Time {
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
+	return day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+}
+
+// TimeEntryMinutes returns the duration from startedAt to endedAt, rounded to the minute.
+func TimeEntryMinutes(startedAt, endedAt time.Time) int32 {
+	return int32(endedAt.Sub(startedAt).Round(time.Minute) / time.Minute)
+}
+
+// timeEntryTimes works out the date and the minutes of a time entry with a start: the day in UTC it starts on,
+// and its duration once it has ended. An entry given as a duration is left as it is.
+func timeEntryTimes(date time.Time, startedAt, endedAt sql.NullTime, minutes sql.NullInt32) (time.Time, sql.NullInt32, error) {
+	if !startedAt.Valid {
+		return date, minutes, nil
+	}
+	start := startedAt.Time.UTC()
+	date = time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+	if !endedAt.Valid {
+		return date, sql.NullInt32{}, nil
+	}
+	if !endedAt.Time.After(startedAt.Time) {
+		return date, sql.NullInt32{}, ErrTimeEntryEndBeforeStart
+	}
+	return date, sql.NullInt32{Int32: TimeEntryMinutes(startedAt.Time, endedAt.Time), Valid: true}, nil
+}
+
+// lockTimeTrackingMember locks a member, so that the changes to their time entries and timesheets are made one after the other.
+// sql.ErrNoRows is returned when the member does not exist or is in the trash.
+func (q *Queries) lockTimeTrackingMember(ctx context.Context, memberID uuid.UUID) (Member, error) {
+	member, err := q.GetMemberForUpdate(ctx, memberID)
+	if err != nil {
+		return Member{}, err
+	}
+	if member.DeletedAt.Valid {
+		return Member{}, sql.ErrNoRows
+	}
+	return member, nil
+}
+
+// checkTimesheetOpen checks that the timesheet of the member for the week of a date was not submitted,
+// returning ErrTimesheetLocked otherwise.
+func (q *Queries) checkTimesheetOpen(ctx context.Context, memberID uuid.UUID, date time.Time) error {
+	weekStart := WeekStart(date)
+	_, err := q.GetMemberTimesheet(ctx, GetMemberTimesheetParams{
+		MemberID:  memberID,
+		WeekStart: weekStart,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return fmt.Errorf("%w: the week of %s", ErrTimesheetLocked, weekStart.Format(DateLayout))
+}
+
+// checkProjectOpen checks that time can be tracked against a project, returning ErrProjectArchived otherwise.
+func (q *Queries) checkProjectOpen(ctx context.Context, projectID uuid.UUID) error {
+	project, err := q.GetProject(ctx, projectID)
+	if err != nil {
+		return err
+	}
+	if project.ArchivedAt.Valid {
+		return fmt.Errorf("%w: %s", ErrProjectArchived, project.Name)
+	}
+	return nil
+}
+
+// checkNoOtherTimerRunning checks that no timer of the member other than the entry with the given ID is running,
+// returning ErrTimerRunning otherwise.
+func (q *Queries) checkNoOtherTimerRunning(ctx context.Context, memberID, entryID uuid.UUID) error {
+	running, err := q.GetRunningTimeEntry(ctx, memberID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if running.ID != entryID {
+		return ErrTimerRunning
+	}
+	return nil
+}
+
+// CreateTimeEntryTx tracks time for a member within a single database transaction. An entry is given either as a start
+// and an end, its date and minutes worked out from them, or as a date and minutes. An entry with a start but no end
+// is a running timer, which a member can only have one of.
+// sql.ErrNoRows is returned when the member does not exist or is in the trash, or when the project does not exist.
+// ErrProjectArchived, ErrTimesheetLocked, ErrTimerRunning and ErrTimeEntryEndBeforeStart are returned when the entry
+// cannot be tracked.
+func (store *SQLStore) CreateTimeEntryTx(ctx context.Context, arg CreateTimeEntryParams) (TimeEntry, error) {
+	var entry TimeEntry
+
+	var err error
+	arg.Date, arg.Minutes, err = timeEntryTimes(arg.Date, arg.StartedAt, arg.EndedAt, arg.Minutes)
+	if err != nil {
+		return TimeEntry{}, err
+	}
+
+	err = store.execTx(ctx, func(q *Queries) error {
+		if _, err := q.lockTimeTrackingMember(ctx, arg.MemberID); err != nil {
+			return err
+		}
+		if err := q.checkProjectOpen(ctx, arg.ProjectID); err != nil {
+			return err
+		}
+		if err := q.checkTimesheetOpen(ctx, arg.MemberID, arg.Date); err != nil {
+			return err
+		}
+		if arg.StartedAt.Valid && !arg.EndedAt.Valid {
+			if err := q.checkNoOtherTimerRunning(ctx, arg.MemberID, uuid.Nil); err != nil {
+				return err
+			}
+		}
+
+		var err error
+		entry, err = q.CreateTimeEntry(ctx, arg)
+		return err
+	})
+	if err != nil {
+		return TimeEntry{}, err
+	}
+
+	return entry, nil
+}
+
+// UpdateTimeEntryTx changes a time entry within a single database transaction, as CreateTimeEntryTx creates one.
+// The timesheets of both the week the entry was in and the week it moves to must be open. An entry can be moved
+// to an archived project only if it already was in it.
+// sql.ErrNoRows is returned when the time entry or the project does not exist.
+func (store *SQLStore) UpdateTimeEntryTx(ctx context.Context, arg UpdateTimeEntryParams) (TimeEntry, error) {
+	var entry TimeEntry
+
+	var err error
+	arg.Date, arg.Minutes, err = timeEntryTimes(arg.Date, arg.StartedAt, arg.EndedAt, arg.Minutes)
+	if err != nil {
+		return TimeEntry{}, err
+	}
+
+	err = store.execTx(ctx, func(q *Queries) error {
+		// The member is locked before the entry, as when entries are created.
+		current, err := q.GetTimeEntry(ctx, arg.ID)
+		if err != nil {
+			return err
+		}
+		if _, err := q.lockTimeTrackingMember(ctx, current.MemberID); err != nil {
+			return err
+		}
+		if current, err = q.GetTimeEntryForUpdate(ctx, arg.ID); err != nil {
+			return err
+		}
+
+		if arg.ProjectID != current.ProjectID {
+			if err := q.checkProjectOpen(ctx, arg.ProjectID); err != nil {
+				return err
+			}
+		}
+		if err := q.checkTimesheetOpen(ctx, current.MemberID, current.Date); err != nil {
+			return err
+		}
+		if err := q.checkTimesheetOpen(ctx, current.MemberID, arg.Date); err != nil {
+			return err
+		}
+		if arg.StartedAt.Valid && !arg.EndedAt.Valid {
+			if err := q.checkNoOtherTimerRunning(ctx, current.MemberID, current.ID); err != nil {
+				return err
+			}
+		}
+
+		entry, err = q.UpdateTimeEntry(ctx, arg)
+		return err
+	})
+	if err != nil {
+		return TimeEntry{}, err
+	}
+
+	return entry, nil
+}
+
+// DeleteTimeEntryTx deletes a time entry within a single database transaction, unless the timesheet of its week was submitted.
+// sql.ErrNoRows is returned when the time entry does not exist.
+func (store *SQLStore) DeleteTimeEntryTx(ctx context.Context, id uuid.UUID) (TimeEntry, error) {
+	var entry TimeEntry
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+		entry, err = q.GetTimeEntry(ctx, id)
+		if err != nil {
+			return err
+		}
+		if _, err := q.lockTimeTrackingMember(ctx, entry.MemberID); err != nil {
+			return err
+		}
+		if entry, err = q.GetTimeEntryForUpdate(ctx, id); err != nil {
+			return err
+		}
+		if err := q.checkTimesheetOpen(ctx, entry.MemberID, entry.Date); err != nil {
+			return err
+		}
+		return q.DeleteTimeEntry(ctx, id)
+	})
+	if err != nil {
+		return TimeEntry{}, err
+	}
+
+	return entry, nil
+}
+
+// StopTimerTx stops the running timer of a member at endedAt within a single database transaction.
+// sql.ErrNoRows is returned when the member does not exist or is in the trash, and ErrNoRunningTimer when they have no timer running.
+func (store *SQLStore) StopTimerTx(ctx context.Context, memberID uuid.UUID, endedAt time.Time) (TimeEntry, error) {
+	var entry TimeEntry
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		if _, err := q.lockTimeTrackingMember(ctx, memberID); err != nil {
+			return err
+		}
+		running, err := q.GetRunningTimeEntry(ctx, memberID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrNoRunningTimer
+		}
+		if err != nil {
+			return err
+		}
+		if !endedAt.After(running.StartedAt.Time) {
+			return ErrTimeEntryEndBeforeStart
+		}
+
+		entry, err = q.UpdateTimeEntry(ctx, UpdateTimeEntryParams{
+			ProjectID: running.ProjectID,
+			Date:      running.Date,
+			StartedAt: running.StartedAt,
+			EndedAt:   sql.NullTime{Time: endedAt, Valid: true},
+			Minutes:   sql.NullInt32{Int32: TimeEntryMinutes(running.StartedAt.Time, endedAt), Valid: true},
+			Note:      running.Note,
+			Billable:  running.Billable,
+			ID:        running.ID,
+		})
+		return err
+	})
+	if err != nil {
+		return TimeEntry{}, err
+	}
+
+	return entry, nil
+}
+
+// SubmitTimesheetTxParams contains the input parameters of SubmitTimesheetTx.
+type SubmitTimesheetTxParams struct {
+	MemberID uuid.UUID
+	// WeekStart is any day of the week submitted.
+	WeekStart time.Time
+	// UserID is the user submitting.
+	UserID uuid.UUID
+}
+
+// SubmitTimesheetTx submits the timesheet of a member for a week within a single database transaction, locking the
+// time entries of the week. A week cannot be submitted while a timer started within it is running.
+// sql.ErrNoRows is returned when the member does not exist or is in the trash, ErrTimesheetLocked when the timesheet
+// was already submitted, and ErrTimerRunning when a timer of the week is running.
+func (store *SQLStore) SubmitTimesheetTx(ctx context.Context, arg SubmitTimesheetTxParams) (Timesheet, error) {
+	var timesheet Timesheet
+
+	weekStart := WeekStart(arg.WeekStart)
+	err := store.execTx(ctx, func(q *Queries) error {
+		if _, err := q.lockTimeTrackingMember(ctx, arg.MemberID); err != nil {
+			return err
+		}
+		if err := q.checkTimesheetOpen(ctx, arg.MemberID, weekStart); err != nil {
+			return err
+		}
+
+		running, err := q.GetRunningTimeEntry(ctx, arg.MemberID)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+		if err == nil && WeekStart(running.Date).Equal(weekStart) {
+			return ErrTimerRunning
+		}
+
+		timesheet, err = q.CreateTimesheet(ctx, CreateTimesheetParams{
+			MemberID:    arg.MemberID,
+			WeekStart:   weekStart,
+			SubmittedBy: uuid.NullUUID{UUID: arg.UserID, Valid: true},
+		})
+		return err
+	})
+	if err != nil {
+		return Timesheet{}, err
+	}
+
+	return timesheet, nil
+}
+
+// ReopenTimesheetTxParams contains the input parameters of ReopenTimesheetTx.
+type ReopenTimesheetTxParams struct {
+	MemberID uuid.UUID
+	// WeekStart is any day of the week reopened.
+	WeekStart time.Time
+	// UserID is the user reopening, who must be an admin or the manager of the member.
+	UserID uuid.UUID
+}
+
+// ReopenTimesheetTx reopens the submitted timesheet of a member for a week within a single database transaction,
+// unlocking the time entries of the week.
+// sql.ErrNoRows is returned when the member or the user does not exist, and ErrTimesheetNotSubmitted when the
+// timesheet was not submitted.
+func (store *SQLStore) ReopenTimesheetTx(ctx context.Context, arg ReopenTimesheetTxParams) (Timesheet, error) {
+	var timesheet Timesheet
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		member, err := q.lockTimeTrackingMember(ctx, arg.MemberID)
+		if err != nil {
+			return err
+		}
+
+		user, err := q.GetUser(ctx, arg.UserID)
+		if err != nil {
+			return err
+		}
+		if !user.IsAdmin {
+			isManager, err := q.isMemberManager(ctx, member, user)
+			if err != nil {
+				return err
+			}
+			if !isManager {
+				return ErrNotTimesheetApprover
+			}
+		}
+
+		timesheet, err = q.DeleteMemberTimesheet(ctx, DeleteMemberTimesheetParams{
+			MemberID:  arg.MemberID,
+			WeekStart: WeekStart(arg.WeekStart),
+		})
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrTimesheetNotSubmitted
+		}
+		return err
+	})
+	if err != nil {
+		return Timesheet{}, err
+	}
+
+	return timesheet, nil
+}
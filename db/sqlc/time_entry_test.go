@@ -0,0 +1,312 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ot07/coworker-backend/util"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomProject(t *testing.T, testQueries *Queries) Project {
+	project, err := testQueries.CreateProject(context.Background(), CreateProjectParams{
+		Name:        util.RandomName(),
+		Description: util.RandomString(20),
+		Billable:    true,
+	})
+	require.NoError(t, err)
+	return project
+}
+
+func nullTime(t time.Time) sql.NullTime {
+	return sql.NullTime{Time: t, Valid: true}
+}
+
+func TestWeekStart(t *testing.T) {
+	t.Parallel()
+
+	monday := time.Date(2023, 8, 7, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 7; i++ {
+		require.Equal(t, monday, WeekStart(monday.AddDate(0, 0, i).Add(15*time.Hour)))
+	}
+	require.Equal(t, monday.AddDate(0, 0, 7), WeekStart(monday.AddDate(0, 0, 7)))
+}
+
+func TestTimeEntryTimes(t *testing.T) {
+	t.Parallel()
+
+	// An entry starting late on Sunday in Tokyo is tracked on the Sunday in UTC.
+	tokyo := time.FixedZone("JST", 9*60*60)
+	start := time.Date(2023, 8, 7, 8, 0, 0, 0, tokyo)
+	date, minutes, err := timeEntryTimes(time.Time{}, nullTime(start), nullTime(start.Add(90*time.Minute+20*time.Second)), sql.NullInt32{})
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2023, 8, 6, 0, 0, 0, 0, time.UTC), date)
+	require.Equal(t, sql.NullInt32{Int32: 90, Valid: true}, minutes)
+
+	// A running timer has no minutes yet.
+	_, minutes, err = timeEntryTimes(time.Time{}, nullTime(start), sql.NullTime{}, sql.NullInt32{})
+	require.NoError(t, err)
+	require.False(t, minutes.Valid)
+
+	_, _, err = timeEntryTimes(time.Time{}, nullTime(start), nullTime(start), sql.NullInt32{})
+	require.Equal(t, ErrTimeEntryEndBeforeStart, err)
+
+	// An entry given as a duration is left as it is.
+	day := time.Date(2023, 8, 9, 0, 0, 0, 0, time.UTC)
+	date, minutes, err = timeEntryTimes(day, sql.NullTime{}, sql.NullTime{}, sql.NullInt32{Int32: 45, Valid: true})
+	require.NoError(t, err)
+	require.Equal(t, day, date)
+	require.Equal(t, sql.NullInt32{Int32: 45, Valid: true}, minutes)
+}
+
+func TestCreateTimeEntryTx(t *testing.T) {
+	t.Parallel()
+
+	store := NewStore(testDB)
+	ctx := context.Background()
+
+	member := createRandomMember(t, store.Queries)
+	project := createRandomProject(t, store.Queries)
+	start := time.Date(2023, 8, 7, 9, 0, 0, 0, time.UTC)
+
+	entry, err := store.CreateTimeEntryTx(ctx, CreateTimeEntryParams{
+		MemberID:  member.ID,
+		ProjectID: project.ID,
+		StartedAt: nullTime(start),
+		EndedAt:   nullTime(start.Add(2 * time.Hour)),
+		Note:      "planning",
+		Billable:  true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, member.ID, entry.MemberID)
+	require.Equal(t, project.ID, entry.ProjectID)
+	require.Equal(t, "2023-08-07", entry.Date.Format(DateLayout))
+	require.Equal(t, sql.NullInt32{Int32: 120, Valid: true}, entry.Minutes)
+	require.Equal(t, "planning", entry.Note)
+
+	// A member cannot track two entries at the same time.
+	_, err = store.CreateTimeEntryTx(ctx, CreateTimeEntryParams{
+		MemberID:  member.ID,
+		ProjectID: project.ID,
+		StartedAt: nullTime(start.Add(time.Hour)),
+		EndedAt:   nullTime(start.Add(3 * time.Hour)),
+	})
+	requireExclusionViolation(t, err)
+
+	// An entry given as a duration does not overlap anything.
+	duration, err := store.CreateTimeEntryTx(ctx, CreateTimeEntryParams{
+		MemberID:  member.ID,
+		ProjectID: project.ID,
+		Date:      start,
+		Minutes:   sql.NullInt32{Int32: 30, Valid: true},
+	})
+	require.NoError(t, err)
+	require.False(t, duration.StartedAt.Valid)
+
+	// An entry cannot be moved onto another one either.
+	_, err = store.UpdateTimeEntryTx(ctx, UpdateTimeEntryParams{
+		ID:        duration.ID,
+		ProjectID: project.ID,
+		StartedAt: nullTime(start.Add(-time.Hour)),
+		EndedAt:   nullTime(start.Add(time.Hour)),
+	})
+	requireExclusionViolation(t, err)
+
+	archived, err := store.UpdateProject(ctx, UpdateProjectParams{
+		ID:       createRandomProject(t, store.Queries).ID,
+		Name:     util.RandomName(),
+		Archived: true,
+	})
+	require.NoError(t, err)
+	_, err = store.CreateTimeEntryTx(ctx, CreateTimeEntryParams{
+		MemberID:  member.ID,
+		ProjectID: archived.ID,
+		Date:      start,
+		Minutes:   sql.NullInt32{Int32: 30, Valid: true},
+	})
+	require.ErrorIs(t, err, ErrProjectArchived)
+
+	_, err = store.CreateTimeEntryTx(ctx, CreateTimeEntryParams{
+		MemberID:  util.RandomUUID(),
+		ProjectID: project.ID,
+		Date:      start,
+		Minutes:   sql.NullInt32{Int32: 30, Valid: true},
+	})
+	require.Equal(t, sql.ErrNoRows, err)
+}
+
+func TestTimerTx(t *testing.T) {
+	t.Parallel()
+
+	store := NewStore(testDB)
+	ctx := context.Background()
+
+	member := createRandomMember(t, store.Queries)
+	project := createRandomProject(t, store.Queries)
+	start := time.Now().UTC().Add(-time.Hour).Truncate(time.Second)
+
+	_, err := store.StopTimerTx(ctx, member.ID, start)
+	require.Equal(t, ErrNoRunningTimer, err)
+
+	timer, err := store.CreateTimeEntryTx(ctx, CreateTimeEntryParams{
+		MemberID:  member.ID,
+		ProjectID: project.ID,
+		StartedAt: nullTime(start),
+		Billable:  true,
+	})
+	require.NoError(t, err)
+	require.False(t, timer.EndedAt.Valid)
+	require.False(t, timer.Minutes.Valid)
+
+	running, err := store.GetRunningTimeEntry(ctx, member.ID)
+	require.NoError(t, err)
+	require.Equal(t, timer.ID, running.ID)
+
+	// A member can only have one timer running.
+	_, err = store.CreateTimeEntryTx(ctx, CreateTimeEntryParams{
+		MemberID:  member.ID,
+		ProjectID: project.ID,
+		StartedAt: nullTime(start.Add(-24 * time.Hour)),
+	})
+	require.Equal(t, ErrTimerRunning, err)
+
+	// Nothing can be tracked after the start of a running timer.
+	_, err = store.CreateTimeEntryTx(ctx, CreateTimeEntryParams{
+		MemberID:  member.ID,
+		ProjectID: project.ID,
+		StartedAt: nullTime(start.Add(10 * time.Minute)),
+		EndedAt:   nullTime(start.Add(20 * time.Minute)),
+	})
+	requireExclusionViolation(t, err)
+
+	_, err = store.StopTimerTx(ctx, member.ID, start)
+	require.Equal(t, ErrTimeEntryEndBeforeStart, err)
+
+	stopped, err := store.StopTimerTx(ctx, member.ID, start.Add(45*time.Minute))
+	require.NoError(t, err)
+	require.Equal(t, timer.ID, stopped.ID)
+	require.True(t, stopped.EndedAt.Valid)
+	require.Equal(t, sql.NullInt32{Int32: 45, Valid: true}, stopped.Minutes)
+
+	_, err = store.GetRunningTimeEntry(ctx, member.ID)
+	require.Equal(t, sql.ErrNoRows, err)
+}
+
+func TestTimesheetTx(t *testing.T) {
+	t.Parallel()
+
+	store := NewStore(testDB)
+	ctx := context.Background()
+
+	user := createRandomUser(t, store.Queries)
+	managerUser := createRandomUser(t, store.Queries)
+	manager := createRandomMemberWithEmail(t, store.Queries, util.RandomName(), util.RandomName(), managerUser.Email)
+	member := createRandomMember(t, store.Queries)
+	setRandomMemberManager(t, store.Queries, member, manager)
+	project := createRandomProject(t, store.Queries)
+
+	monday := time.Date(2023, 8, 7, 0, 0, 0, 0, time.UTC)
+	entry, err := store.CreateTimeEntryTx(ctx, CreateTimeEntryParams{
+		MemberID:  member.ID,
+		ProjectID: project.ID,
+		Date:      monday.AddDate(0, 0, 2),
+		Minutes:   sql.NullInt32{Int32: 60, Valid: true},
+	})
+	require.NoError(t, err)
+
+	timesheet, err := store.SubmitTimesheetTx(ctx, SubmitTimesheetTxParams{
+		MemberID:  member.ID,
+		WeekStart: monday.AddDate(0, 0, 4),
+		UserID:    user.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, member.ID, timesheet.MemberID)
+	require.Equal(t, monday.Format(DateLayout), timesheet.WeekStart.Format(DateLayout))
+	require.Equal(t, uuid.NullUUID{UUID: user.ID, Valid: true}, timesheet.SubmittedBy)
+
+	_, err = store.SubmitTimesheetTx(ctx, SubmitTimesheetTxParams{MemberID: member.ID, WeekStart: monday, UserID: user.ID})
+	require.ErrorIs(t, err, ErrTimesheetLocked)
+
+	// The entries of the week are locked, and no entry can be moved into it.
+	_, err = store.CreateTimeEntryTx(ctx, CreateTimeEntryParams{
+		MemberID:  member.ID,
+		ProjectID: project.ID,
+		Date:      monday.AddDate(0, 0, 6),
+		Minutes:   sql.NullInt32{Int32: 60, Valid: true},
+	})
+	require.ErrorIs(t, err, ErrTimesheetLocked)
+	_, err = store.DeleteTimeEntryTx(ctx, entry.ID)
+	require.ErrorIs(t, err, ErrTimesheetLocked)
+
+	nextWeek, err := store.CreateTimeEntryTx(ctx, CreateTimeEntryParams{
+		MemberID:  member.ID,
+		ProjectID: project.ID,
+		Date:      monday.AddDate(0, 0, 7),
+		Minutes:   sql.NullInt32{Int32: 60, Valid: true},
+	})
+	require.NoError(t, err)
+	_, err = store.UpdateTimeEntryTx(ctx, UpdateTimeEntryParams{
+		ID:        nextWeek.ID,
+		ProjectID: project.ID,
+		Date:      monday,
+		Minutes:   sql.NullInt32{Int32: 60, Valid: true},
+	})
+	require.ErrorIs(t, err, ErrTimesheetLocked)
+
+	// Only an admin or the manager of the member reopens a timesheet.
+	_, err = store.ReopenTimesheetTx(ctx, ReopenTimesheetTxParams{MemberID: member.ID, WeekStart: monday, UserID: user.ID})
+	require.Equal(t, ErrNotTimesheetApprover, err)
+
+	reopened, err := store.ReopenTimesheetTx(ctx, ReopenTimesheetTxParams{MemberID: member.ID, WeekStart: monday, UserID: managerUser.ID})
+	require.NoError(t, err)
+	require.Equal(t, timesheet.ID, reopened.ID)
+
+	_, err = store.ReopenTimesheetTx(ctx, ReopenTimesheetTxParams{MemberID: member.ID, WeekStart: monday, UserID: managerUser.ID})
+	require.Equal(t, ErrTimesheetNotSubmitted, err)
+
+	_, err = store.DeleteTimeEntryTx(ctx, entry.ID)
+	require.NoError(t, err)
+}
+
+func TestListTimeReportRows(t *testing.T) {
+	t.Parallel()
+
+	store := NewStore(testDB)
+	ctx := context.Background()
+
+	member := createRandomMember(t, store.Queries)
+	project := createRandomProject(t, store.Queries)
+	monday := time.Date(2023, 8, 7, 0, 0, 0, 0, time.UTC)
+
+	for i, minutes := range []int32{30, 60, 90} {
+		_, err := store.CreateTimeEntryTx(ctx, CreateTimeEntryParams{
+			MemberID:  member.ID,
+			ProjectID: project.ID,
+			Date:      monday.AddDate(0, 0, 3*i),
+			Minutes:   sql.NullInt32{Int32: minutes, Valid: true},
+			Billable:  i != 1,
+		})
+		require.NoError(t, err)
+	}
+
+	rows, err := store.ListTimeReportRows(ctx, ListTimeReportRowsParams{
+		FromDate: monday,
+		ToDate:   monday.AddDate(0, 0, 13),
+		MemberID: uuid.NullUUID{UUID: member.ID, Valid: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+
+	require.Equal(t, monday.Format(DateLayout), rows[0].WeekStart.Format(DateLayout))
+	require.Equal(t, project.Name, rows[0].ProjectName)
+	require.Equal(t, int32(2), rows[0].Entries)
+	require.Equal(t, int64(90), rows[0].Minutes)
+	require.Equal(t, int64(30), rows[0].BillableMinutes)
+
+	require.Equal(t, monday.AddDate(0, 0, 7).Format(DateLayout), rows[1].WeekStart.Format(DateLayout))
+	require.Equal(t, int32(1), rows[1].Entries)
+	require.Equal(t, int64(90), rows[1].BillableMinutes)
+}
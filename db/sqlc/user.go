@@ -0,0 +1,27 @@
+package db
+
+import "context"
+
+// CreateUserTx registers a user within a single database transaction.
+// The first user registered becomes an admin, who can decide on leave requests and reopen timesheets of every member;
+// registrations are serialized so that no two users can both be the first one.
+func (store *SQLStore) CreateUserTx(ctx context.Context, arg CreateUserParams) (User, error) {
+	var user User
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		if err := q.LockUserRegistration(ctx); err != nil {
+			return err
+		}
+
+		count, err := q.CountUsers(ctx)
+		if err != nil {
+			return err
+		}
+
+		arg.IsAdmin = count == 0
+		user, err = q.CreateUser(ctx, arg)
+		return err
+	})
+
+	return user, err
+}
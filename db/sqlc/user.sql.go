@@ -11,15 +11,27 @@ import (
 	"github.com/google/uuid"
 )
 
+const countUsers = `-- name: CountUsers :one
+SELECT count(*) FROM users
+`
+
+func (q *Queries) CountUsers(ctx context.Context) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countUsers)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createUser = `-- name: CreateUser :one
 INSERT INTO users (
   first_name,
   last_name,
   email,
-  hashed_password
+  hashed_password,
+  is_admin
 ) VALUES (
-  $1, $2, $3, $4
-) RETURNING id, first_name, last_name, email, hashed_password, password_changed_at, created_at
+  $1, $2, $3, $4, $5
+) RETURNING id, first_name, last_name, email, hashed_password, password_changed_at, created_at, is_admin
 `
 
 type CreateUserParams struct {
@@ -27,6 +39,7 @@ type CreateUserParams struct {
 	LastName       string `json:"last_name"`
 	Email          string `json:"email"`
 	HashedPassword string `json:"hashed_password"`
+	IsAdmin        bool   `json:"is_admin"`
 }
 
 func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, error) {
@@ -35,6 +48,7 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, e
 		arg.LastName,
 		arg.Email,
 		arg.HashedPassword,
+		arg.IsAdmin,
 	)
 	var i User
 	err := row.Scan(
@@ -45,12 +59,13 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, e
 		&i.HashedPassword,
 		&i.PasswordChangedAt,
 		&i.CreatedAt,
+		&i.IsAdmin,
 	)
 	return i, err
 }
 
 const getUser = `-- name: GetUser :one
-SELECT id, first_name, last_name, email, hashed_password, password_changed_at, created_at FROM users
+SELECT id, first_name, last_name, email, hashed_password, password_changed_at, created_at, is_admin FROM users
 WHERE id = $1 LIMIT 1
 `
 
@@ -65,12 +80,13 @@ func (q *Queries) GetUser(ctx context.Context, id uuid.UUID) (User, error) {
 		&i.HashedPassword,
 		&i.PasswordChangedAt,
 		&i.CreatedAt,
+		&i.IsAdmin,
 	)
 	return i, err
 }
 
 const getUserByEmail = `-- name: GetUserByEmail :one
-SELECT id, first_name, last_name, email, hashed_password, password_changed_at, created_at FROM users
+SELECT id, first_name, last_name, email, hashed_password, password_changed_at, created_at, is_admin FROM users
 WHERE email = $1 LIMIT 1
 `
 
@@ -85,10 +101,20 @@ func (q *Queries) GetUserByEmail(ctx context.Context, email string) (User, error
 		&i.HashedPassword,
 		&i.PasswordChangedAt,
 		&i.CreatedAt,
+		&i.IsAdmin,
 	)
 	return i, err
 }
 
+const lockUserRegistration = `-- name: LockUserRegistration :exec
+SELECT pg_advisory_xact_lock(hashtext('users.is_admin'))
+`
+
+func (q *Queries) LockUserRegistration(ctx context.Context) error {
+	_, err := q.db.ExecContext(ctx, lockUserRegistration)
+	return err
+}
+
 const truncateUsersTable = `-- name: TruncateUsersTable :exec
 TRUNCATE TABLE users CASCADE
 `
@@ -19,7 +19,7 @@ func CreateUserTestData(ctx context.Context, store *SQLStore) error {
 		HashedPassword: hashedPassword,
 	}
 
-	_, err = store.CreateUser(ctx, arg)
+	_, err = store.CreateUserTx(ctx, arg)
 	if err != nil {
 		return err
 	}
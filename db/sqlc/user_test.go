@@ -90,3 +90,27 @@ func TestGetUserByEmail(t *testing.T) {
 	require.WithinDuration(t, user1.PasswordChangedAt, user2.PasswordChangedAt, time.Second)
 	require.WithinDuration(t, user1.CreatedAt, user2.CreatedAt, time.Second)
 }
+
+func TestCreateUserTx(t *testing.T) {
+	t.Parallel()
+
+	store := NewStore(testDB)
+	ctx := context.Background()
+
+	createRandomUser(t, store.Queries)
+
+	// Only the first user registered becomes an admin.
+	user, err := store.CreateUserTx(ctx, CreateUserParams{
+		FirstName:      util.RandomName(),
+		LastName:       util.RandomName(),
+		Email:          util.RandomEmail(),
+		HashedPassword: util.RandomString(6),
+		IsAdmin:        true,
+	})
+	require.NoError(t, err)
+	require.False(t, user.IsAdmin)
+
+	count, err := store.CountUsers(ctx)
+	require.NoError(t, err)
+	require.NotZero(t, count)
+}
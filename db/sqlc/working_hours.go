@@ -0,0 +1,210 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MinutesPerDay is the end of the last working window a day can have.
+const MinutesPerDay = 24 * 60
+
+// ErrWorkingHoursOverlap is returned when two working windows of a weekday overlap.
+var ErrWorkingHoursOverlap = errors.New("working hours overlap")
+
+// ErrInvalidTimeZone is returned for a time zone missing from the tz database.
+var ErrInvalidTimeZone = errors.New("invalid time zone")
+
+// LoadTimeZone loads an IANA time zone, such as Asia/Tokyo, from the tz database.
+// The local time zone of the server is refused, as it means nothing to members elsewhere.
+func LoadTimeZone(name string) (*time.Location, error) {
+	if len(name) == 0 || name == "Local" {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidTimeZone, name)
+	}
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidTimeZone, name)
+	}
+	return location, nil
+}
+
+// TimeWindow is the range of time from Start to End.
+type TimeWindow struct {
+	Start time.Time
+	End   time.Time
+}
+
+// WorkingWindows returns the windows a member works in between from and to, in order, from their weekly working hours
+// in their time zone. Windows are cut at from and to, and windows that follow each other, such as those running
+// past midnight, are joined.
+func WorkingWindows(hours []MemberWorkingHour, location *time.Location, from, to time.Time) []TimeWindow {
+	byWeekday := make(map[time.Weekday][]MemberWorkingHour)
+	for _, hour := range hours {
+		weekday := time.Weekday(hour.Weekday)
+		byWeekday[weekday] = append(byWeekday[weekday], hour)
+	}
+
+	var windows []TimeWindow
+	// Local days are walked from the day before from, as a window of the day before can run past midnight in UTC.
+	start := from.In(location)
+	day := time.Date(start.Year(), start.Month(), start.Day()-1, 0, 0, 0, 0, location)
+	for ; day.Before(to); day = time.Date(day.Year(), day.Month(), day.Day()+1, 0, 0, 0, 0, location) {
+		for _, hour := range byWeekday[day.Weekday()] {
+			window := TimeWindow{
+				Start: minuteOfDay(day, hour.StartMinute),
+				End:   minuteOfDay(day, hour.EndMinute),
+			}
+			if window.Start.Before(from) {
+				window.Start = from
+			}
+			if window.End.After(to) {
+				window.End = to
+			}
+			if window.Start.Before(window.End) {
+				windows = append(windows, window)
+			}
+		}
+	}
+	return joinTimeWindows(windows)
+}
+
+// minuteOfDay returns the time a number of minutes after the midnight of day, in the time zone of day.
+// The wall clock is followed, so that 9:00 stays 9:00 on the days daylight saving time starts or ends.
+func minuteOfDay(day time.Time, minute int32) time.Time {
+	return time.Date(day.Year(), day.Month(), day.Day(), 0, int(minute), 0, 0, day.Location())
+}
+
+// joinTimeWindows sorts windows and joins those that overlap or follow each other.
+func joinTimeWindows(windows []TimeWindow) []TimeWindow {
+	sort.Slice(windows, func(i, j int) bool {
+		return windows[i].Start.Before(windows[j].Start)
+	})
+
+	joined := []TimeWindow{}
+	for _, window := range windows {
+		if last := len(joined) - 1; last >= 0 && !window.Start.After(joined[last].End) {
+			if window.End.After(joined[last].End) {
+				joined[last].End = window.End
+			}
+			continue
+		}
+		joined = append(joined, window)
+	}
+	return joined
+}
+
+// IntersectTimeWindows returns the windows within both a and b, which must be in order and not overlap.
+func IntersectTimeWindows(a, b []TimeWindow) []TimeWindow {
+	windows := []TimeWindow{}
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		start, end := a[i].Start, a[i].End
+		if b[j].Start.After(start) {
+			start = b[j].Start
+		}
+		if b[j].End.Before(end) {
+			end = b[j].End
+		}
+		if start.Before(end) {
+			windows = append(windows, TimeWindow{Start: start, End: end})
+		}
+		// The window ending first cannot overlap anything else.
+		if a[i].End.Before(b[j].End) {
+			i++
+		} else {
+			j++
+		}
+	}
+	return windows
+}
+
+// checkWorkingHours checks that no two working windows of a weekday overlap.
+func checkWorkingHours(hours []CreateMemberWorkingHoursParams) error {
+	sorted := make([]CreateMemberWorkingHoursParams, len(hours))
+	copy(sorted, hours)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Weekday != sorted[j].Weekday {
+			return sorted[i].Weekday < sorted[j].Weekday
+		}
+		return sorted[i].StartMinute < sorted[j].StartMinute
+	})
+
+	for i := 1; i < len(sorted); i++ {
+		if sorted[i].Weekday == sorted[i-1].Weekday && sorted[i].StartMinute < sorted[i-1].EndMinute {
+			return fmt.Errorf("%w on %s", ErrWorkingHoursOverlap, time.Weekday(sorted[i].Weekday))
+		}
+	}
+	return nil
+}
+
+// SetMemberWorkingHoursTxParams contains the input parameters of SetMemberWorkingHoursTx.
+type SetMemberWorkingHoursTxParams struct {
+	MemberID uuid.UUID
+	TimeZone sql.NullString
+	// Hours replace the working hours of the member. Their MemberID is ignored.
+	Hours []CreateMemberWorkingHoursParams
+}
+
+// SetMemberWorkingHoursTxResult is the result of SetMemberWorkingHoursTx.
+type SetMemberWorkingHoursTxResult struct {
+	Member       Member
+	WorkingHours []MemberWorkingHour
+}
+
+// SetMemberWorkingHoursTx sets the time zone of a member and replaces their weekly working hours within a single
+// database transaction, recording the time zone in the audit trail.
+// sql.ErrNoRows is returned when the member does not exist or is in the trash, ErrInvalidTimeZone for a time zone
+// missing from the tz database, and ErrWorkingHoursOverlap when two windows of a weekday overlap.
+func (store *SQLStore) SetMemberWorkingHoursTx(ctx context.Context, arg SetMemberWorkingHoursTxParams, meta AuditMeta) (SetMemberWorkingHoursTxResult, error) {
+	var result SetMemberWorkingHoursTxResult
+
+	if arg.TimeZone.Valid {
+		if _, err := LoadTimeZone(arg.TimeZone.String); err != nil {
+			return result, err
+		}
+	}
+	if err := checkWorkingHours(arg.Hours); err != nil {
+		return result, err
+	}
+
+	member, err := store.updateMemberTx(ctx, arg.MemberID, meta, func(q *Queries) (Member, error) {
+		member, err := q.SetMemberTimeZone(ctx, SetMemberTimeZoneParams{
+			TimeZone: arg.TimeZone,
+			ID:       arg.MemberID,
+		})
+		if err != nil {
+			return Member{}, err
+		}
+
+		if err := q.DeleteMemberWorkingHours(ctx, member.ID); err != nil {
+			return Member{}, err
+		}
+		result.WorkingHours = make([]MemberWorkingHour, 0, len(arg.Hours))
+		for _, hour := range arg.Hours {
+			hour.MemberID = member.ID
+			created, err := q.CreateMemberWorkingHours(ctx, hour)
+			if err != nil {
+				return Member{}, err
+			}
+			result.WorkingHours = append(result.WorkingHours, created)
+		}
+		return member, nil
+	})
+	if err != nil {
+		return SetMemberWorkingHoursTxResult{}, err
+	}
+
+	sort.Slice(result.WorkingHours, func(i, j int) bool {
+		a, b := result.WorkingHours[i], result.WorkingHours[j]
+		if a.Weekday != b.Weekday {
+			return a.Weekday < b.Weekday
+		}
+		return a.StartMinute < b.StartMinute
+	})
+	result.Member = member
+	return result, nil
+}
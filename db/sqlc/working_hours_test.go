@@ -0,0 +1,214 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ot07/coworker-backend/util"
+	"github.com/stretchr/testify/require"
+)
+
+func workingHour(weekday time.Weekday, startMinute, endMinute int32) MemberWorkingHour {
+	return MemberWorkingHour{Weekday: int32(weekday), StartMinute: startMinute, EndMinute: endMinute}
+}
+
+func utcTime(year int, month time.Month, day, hour int) time.Time {
+	return time.Date(year, month, day, hour, 0, 0, 0, time.UTC)
+}
+
+func TestLoadTimeZone(t *testing.T) {
+	location, err := LoadTimeZone("Asia/Tokyo")
+	require.NoError(t, err)
+	require.Equal(t, "Asia/Tokyo", location.String())
+
+	for _, name := range []string{"", "Local", "Mars/Olympus_Mons"} {
+		_, err := LoadTimeZone(name)
+		require.ErrorIs(t, err, ErrInvalidTimeZone, name)
+	}
+}
+
+func TestWorkingWindows(t *testing.T) {
+	newYork, err := LoadTimeZone("America/New_York")
+	require.NoError(t, err)
+	losAngeles, err := LoadTimeZone("America/Los_Angeles")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		hours    []MemberWorkingHour
+		location *time.Location
+		from, to time.Time
+		windows  []TimeWindow
+	}{
+		{
+			// Daylight saving time starts in New York on 2023-03-12, so 09:00 is an hour earlier in UTC.
+			name:     "DaylightSavingTime",
+			hours:    []MemberWorkingHour{workingHour(time.Sunday, 9*60, 17*60)},
+			location: newYork,
+			from:     utcTime(2023, 3, 5, 0),
+			to:       utcTime(2023, 3, 13, 0),
+			windows: []TimeWindow{
+				{Start: utcTime(2023, 3, 5, 14), End: utcTime(2023, 3, 5, 22)},
+				{Start: utcTime(2023, 3, 12, 13), End: utcTime(2023, 3, 12, 21)},
+			},
+		},
+		{
+			name: "PastMidnight",
+			hours: []MemberWorkingHour{
+				workingHour(time.Monday, 22*60, MinutesPerDay),
+				workingHour(time.Tuesday, 0, 2*60),
+			},
+			location: time.UTC,
+			from:     utcTime(2023, 8, 7, 0),
+			to:       utcTime(2023, 8, 9, 0),
+			windows: []TimeWindow{
+				{Start: utcTime(2023, 8, 7, 22), End: utcTime(2023, 8, 8, 2)},
+			},
+		},
+		{
+			// 20:00 to 24:00 on Sunday in Los Angeles is 03:00 to 07:00 on Monday in UTC.
+			name:     "DayBefore",
+			hours:    []MemberWorkingHour{workingHour(time.Sunday, 20*60, MinutesPerDay)},
+			location: losAngeles,
+			from:     utcTime(2023, 8, 7, 0),
+			to:       utcTime(2023, 8, 8, 0),
+			windows: []TimeWindow{
+				{Start: utcTime(2023, 8, 7, 3), End: utcTime(2023, 8, 7, 7)},
+			},
+		},
+		{
+			name:     "Cut",
+			hours:    []MemberWorkingHour{workingHour(time.Monday, 9*60, 17*60)},
+			location: time.UTC,
+			from:     utcTime(2023, 8, 7, 12),
+			to:       utcTime(2023, 8, 7, 15),
+			windows: []TimeWindow{
+				{Start: utcTime(2023, 8, 7, 12), End: utcTime(2023, 8, 7, 15)},
+			},
+		},
+		{
+			name:     "None",
+			location: time.UTC,
+			from:     utcTime(2023, 8, 7, 0),
+			to:       utcTime(2023, 8, 8, 0),
+			windows:  []TimeWindow{},
+		},
+	}
+
+	for _, tc := range testCases {
+		windows := WorkingWindows(tc.hours, tc.location, tc.from, tc.to)
+		require.Len(t, windows, len(tc.windows), tc.name)
+		for i := range windows {
+			require.True(t, tc.windows[i].Start.Equal(windows[i].Start), tc.name)
+			require.True(t, tc.windows[i].End.Equal(windows[i].End), tc.name)
+		}
+	}
+}
+
+func TestIntersectTimeWindows(t *testing.T) {
+	a := []TimeWindow{
+		{Start: utcTime(2023, 8, 7, 0), End: utcTime(2023, 8, 7, 9)},
+		{Start: utcTime(2023, 8, 7, 12), End: utcTime(2023, 8, 7, 20)},
+	}
+	b := []TimeWindow{
+		{Start: utcTime(2023, 8, 7, 7), End: utcTime(2023, 8, 7, 13)},
+		{Start: utcTime(2023, 8, 7, 15), End: utcTime(2023, 8, 7, 16)},
+		{Start: utcTime(2023, 8, 7, 20), End: utcTime(2023, 8, 7, 22)},
+	}
+
+	require.Equal(t, []TimeWindow{
+		{Start: utcTime(2023, 8, 7, 7), End: utcTime(2023, 8, 7, 9)},
+		{Start: utcTime(2023, 8, 7, 12), End: utcTime(2023, 8, 7, 13)},
+		{Start: utcTime(2023, 8, 7, 15), End: utcTime(2023, 8, 7, 16)},
+	}, IntersectTimeWindows(a, b))
+	require.Equal(t, IntersectTimeWindows(a, b), IntersectTimeWindows(b, a))
+	require.Empty(t, IntersectTimeWindows(a, nil))
+}
+
+func TestCheckWorkingHours(t *testing.T) {
+	hours := []CreateMemberWorkingHoursParams{
+		{Weekday: int32(time.Monday), StartMinute: 13 * 60, EndMinute: 17 * 60},
+		{Weekday: int32(time.Monday), StartMinute: 9 * 60, EndMinute: 12 * 60},
+		{Weekday: int32(time.Tuesday), StartMinute: 11 * 60, EndMinute: 14 * 60},
+	}
+	require.NoError(t, checkWorkingHours(hours))
+
+	hours = append(hours, CreateMemberWorkingHoursParams{Weekday: int32(time.Monday), StartMinute: 11 * 60, EndMinute: 13 * 60})
+	require.ErrorIs(t, checkWorkingHours(hours), ErrWorkingHoursOverlap)
+}
+
+func TestSetMemberWorkingHoursTx(t *testing.T) {
+	t.Parallel()
+
+	store := NewStore(testDB)
+	testQueries := New(testDB)
+
+	member := createRandomMember(t, testQueries)
+	meta := AuditMeta{RequestID: util.RandomString(16)}
+
+	arg := SetMemberWorkingHoursTxParams{
+		MemberID: member.ID,
+		TimeZone: sql.NullString{String: "Asia/Tokyo", Valid: true},
+		Hours: []CreateMemberWorkingHoursParams{
+			{Weekday: int32(time.Tuesday), StartMinute: 9 * 60, EndMinute: 18 * 60},
+			{Weekday: int32(time.Monday), StartMinute: 13 * 60, EndMinute: MinutesPerDay},
+			{Weekday: int32(time.Monday), StartMinute: 9 * 60, EndMinute: 12 * 60},
+		},
+	}
+	result, err := store.SetMemberWorkingHoursTx(context.Background(), arg, meta)
+	require.NoError(t, err)
+	require.Equal(t, arg.TimeZone, result.Member.TimeZone)
+	require.Equal(t, member.Version+1, result.Member.Version)
+	require.Len(t, result.WorkingHours, 3)
+	require.Equal(t, int32(time.Monday), result.WorkingHours[0].Weekday)
+	require.Equal(t, int32(9*60), result.WorkingHours[0].StartMinute)
+	require.Equal(t, int32(time.Tuesday), result.WorkingHours[2].Weekday)
+
+	hours, err := testQueries.ListMemberWorkingHours(context.Background(), []uuid.UUID{member.ID})
+	require.NoError(t, err)
+	require.Equal(t, result.WorkingHours, hours)
+
+	events, err := testQueries.ListAuditEvents(context.Background(), ListAuditEventsParams{
+		Limit:    10,
+		Offset:   0,
+		EntityID: uuid.NullUUID{UUID: member.ID, Valid: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+
+	var changes map[string]AuditFieldChange
+	err = json.Unmarshal(events[0].Changes, &changes)
+	require.NoError(t, err)
+	require.Equal(t, "Asia/Tokyo", changes["time_zone"].After)
+
+	// Setting working hours again replaces them.
+	arg.Hours = arg.Hours[:1]
+	result, err = store.SetMemberWorkingHoursTx(context.Background(), arg, meta)
+	require.NoError(t, err)
+
+	hours, err = testQueries.ListMemberWorkingHours(context.Background(), []uuid.UUID{member.ID})
+	require.NoError(t, err)
+	require.Equal(t, result.WorkingHours, hours)
+	require.Len(t, hours, 1)
+
+	overlapping := arg
+	overlapping.Hours = append(overlapping.Hours, CreateMemberWorkingHoursParams{
+		Weekday: int32(time.Tuesday), StartMinute: 17 * 60, EndMinute: 20 * 60,
+	})
+	_, err = store.SetMemberWorkingHoursTx(context.Background(), overlapping, meta)
+	require.ErrorIs(t, err, ErrWorkingHoursOverlap)
+
+	invalid := arg
+	invalid.TimeZone = sql.NullString{String: "Mars/Olympus_Mons", Valid: true}
+	_, err = store.SetMemberWorkingHoursTx(context.Background(), invalid, meta)
+	require.ErrorIs(t, err, ErrInvalidTimeZone)
+
+	missing := arg
+	missing.MemberID = util.RandomUUID()
+	_, err = store.SetMemberWorkingHoursTx(context.Background(), missing, meta)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
@@ -15,34 +15,48 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/members": {
+        "/attendance-summaries": {
             "get": {
+                "description": "Sums up the attendance of every member, or of a single one, within a month: the days they came in,\nthe hours they were checked in for and how many times they were checked out automatically.\nWith format=csv, the summaries are downloaded as CSV with a UTF-8 BOM for Excel.",
+                "produces": [
+                    "application/json",
+                    "text/csv"
+                ],
                 "tags": [
-                    "members"
+                    "attendance"
                 ],
-                "summary": "List members",
+                "summary": "List attendance summaries",
                 "parameters": [
                     {
-                        "minimum": 1,
-                        "type": "integer",
-                        "name": "page_id",
-                        "in": "query",
-                        "required": true
+                        "enum": [
+                            "json",
+                            "csv"
+                        ],
+                        "type": "string",
+                        "name": "format",
+                        "in": "query"
                     },
                     {
-                        "maximum": 10,
-                        "minimum": 5,
-                        "type": "integer",
-                        "name": "page_size",
-                        "in": "query",
-                        "required": true
+                        "type": "string",
+                        "name": "member_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "2023-05",
+                        "description": "Month is the month summarized, as YYYY-MM in UTC. The current month by default.",
+                        "name": "month",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api.listMembersResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.attendanceSummaryResponse"
+                            }
                         }
                     },
                     "400": {
@@ -58,28 +72,71 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/audit-events": {
+            "get": {
+                "description": "Lists the changes made in the workspace, most recent first.",
                 "tags": [
-                    "members"
+                    "audit-events"
                 ],
-                "summary": "Create member",
+                "summary": "List audit events",
                 "parameters": [
                     {
-                        "description": "Member object",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/api.createMemberRequest"
-                        }
+                        "type": "string",
+                        "example": "update",
+                        "name": "action",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "actor_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "date-time",
+                        "name": "created_after",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "date-time",
+                        "name": "created_before",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "entity_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "member",
+                        "name": "entity_type",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "page_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "maximum": 50,
+                        "minimum": 5,
+                        "type": "integer",
+                        "name": "page_size",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api.memberResponse"
+                            "$ref": "#/definitions/api.listAuditEventsResponse"
                         }
                     },
                     "400": {
@@ -95,26 +152,36 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/calendars/{token}.ics": {
+            "get": {
+                "description": "Serves the reservations of a room, or those a member made, as an iCalendar feed for calendar clients to subscribe to.\nThe token in the URL authenticates the request in place of the session cookie, which calendar clients cannot send.\nReservations that ended more than 90 days ago are left out.",
+                "produces": [
+                    "text/calendar"
+                ],
                 "tags": [
-                    "members"
+                    "rooms"
                 ],
-                "summary": "Delete members",
+                "summary": "Get calendar feed",
                 "parameters": [
                     {
                         "type": "string",
-                        "name": "ids",
-                        "in": "query",
+                        "description": "Calendar token",
+                        "name": "token",
+                        "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/api.errorResponse"
                         }
@@ -128,18 +195,38 @@ const docTemplate = `{
                 }
             }
         },
-        "/members/{id}": {
+        "/capacity": {
             "get": {
+                "description": "Returns, for every member, how many days of a range they are available, from Monday to Friday besides their\napproved leaves, and how many of them are allocated to projects by their assignments. Members in the trash or\noffboarded are left out. Days are rounded to the hundredth.",
                 "tags": [
-                    "members"
+                    "capacity"
                 ],
-                "summary": "Get member",
+                "summary": "Get capacity",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Member ID",
-                        "name": "id",
-                        "in": "path",
+                        "format": "date",
+                        "description": "From and To are the first and the last day planned.",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "MemberID and TeamID narrow the capacities down to a member or to the direct members of a team.",
+                        "name": "member_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "team_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "date",
+                        "name": "to",
+                        "in": "query",
                         "required": true
                     }
                 ],
@@ -147,7 +234,10 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api.memberResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.memberCapacityResponse"
+                            }
                         }
                     },
                     "400": {
@@ -163,27 +253,29 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/checklist-tasks/{id}/assignee": {
             "put": {
                 "tags": [
-                    "members"
+                    "checklists"
                 ],
-                "summary": "Update member",
+                "summary": "Reassign checklist task",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Member ID",
+                        "description": "Task ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Member object",
+                        "description": "Assignee",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/api.updateMemberRequestBody"
+                            "$ref": "#/definitions/api.reassignChecklistTaskRequestBody"
                         }
                     }
                 ],
@@ -191,7 +283,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api.memberResponse"
+                            "$ref": "#/definitions/api.checklistTaskResponse"
                         }
                     },
                     "400": {
@@ -200,6 +292,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.errorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -207,24 +305,30 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/checklist-tasks/{id}/complete": {
+            "post": {
+                "description": "Marks a task as completed by the logged-in user. Completing a completed task leaves it as it was.",
                 "tags": [
-                    "members"
+                    "checklists"
                 ],
-                "summary": "Delete member",
+                "summary": "Complete checklist task",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Member ID",
+                        "description": "Task ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.checklistTaskResponse"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -232,6 +336,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.errorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -241,28 +351,30 @@ const docTemplate = `{
                 }
             }
         },
-        "/users": {
-            "post": {
+        "/checklist-templates/{kind}": {
+            "get": {
                 "tags": [
-                    "users"
+                    "checklists"
                 ],
-                "summary": "Create user",
+                "summary": "Get checklist template",
                 "parameters": [
                     {
-                        "description": "User object",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/api.createUserRequest"
-                        }
+                        "enum": [
+                            "onboarding",
+                            "offboarding"
+                        ],
+                        "type": "string",
+                        "description": "Kind of checklist",
+                        "name": "kind",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api.userResponse"
+                            "$ref": "#/definitions/api.checklistTemplateResponse"
                         }
                     },
                     "400": {
@@ -271,12 +383,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.errorResponse"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
-                        "schema": {
-                            "$ref": "#/definitions/api.errorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -284,22 +390,32 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/users/login": {
-            "post": {
+            },
+            "put": {
+                "description": "Replaces the tasks of the template from which the checklists of members moving to onboarding, or to offboarded, are generated.\nEach task is due its offset in days after the effective date of the move. Checklists already generated are left untouched.",
                 "tags": [
-                    "users"
+                    "checklists"
                 ],
-                "summary": "Login user",
+                "summary": "Replace checklist template",
                 "parameters": [
                     {
-                        "description": "User object",
+                        "enum": [
+                            "onboarding",
+                            "offboarding"
+                        ],
+                        "type": "string",
+                        "description": "Kind of checklist",
+                        "name": "kind",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Template",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/api.loginUserRequest"
+                            "$ref": "#/definitions/api.replaceChecklistTemplateRequestBody"
                         }
                     }
                 ],
@@ -307,7 +423,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api.loginUserResponse"
+                            "$ref": "#/definitions/api.checklistTemplateResponse"
                         }
                     },
                     "400": {
@@ -316,16 +432,29 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.errorResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/api.errorResponse"
                         }
-                    },
-                    "404": {
-                        "description": "Not Found",
+                    }
+                }
+            }
+        },
+        "/custom-fields": {
+            "get": {
+                "tags": [
+                    "custom-fields"
+                ],
+                "summary": "List custom fields",
+                "responses": {
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api.errorResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.customFieldDefinitionResponse"
+                            }
                         }
                     },
                     "500": {
@@ -335,23 +464,39 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/users/logout": {
+            },
             "post": {
+                "description": "Defines a custom field that members can hold in their custom_fields.\nThe key and the type of a field cannot be changed afterwards.",
                 "tags": [
-                    "users"
+                    "custom-fields"
+                ],
+                "summary": "Create custom field",
+                "parameters": [
+                    {
+                        "description": "Custom field definition",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.createCustomFieldDefinitionRequest"
+                        }
+                    }
                 ],
-                "summary": "Logout user",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api.loginUserResponse"
+                            "$ref": "#/definitions/api.customFieldDefinitionResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/api.errorResponse"
                         }
@@ -365,21 +510,40 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/me": {
-            "get": {
+        "/custom-fields/{id}": {
+            "put": {
+                "description": "Replaces the label and the rules of a custom field.\nThe new rules apply to the values set from then on; values members already hold are kept as they are.",
                 "tags": [
-                    "users"
+                    "custom-fields"
+                ],
+                "summary": "Update custom field",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Custom field ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Custom field definition",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.updateCustomFieldDefinitionRequestBody"
+                        }
+                    }
                 ],
-                "summary": "Get logged in user",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api.userResponse"
+                            "$ref": "#/definitions/api.customFieldDefinitionResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/api.errorResponse"
                         }
@@ -397,148 +561,9181 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        }
-    },
-    "definitions": {
-        "api.createMemberRequest": {
-            "type": "object",
-            "required": [
-                "first_name",
+            },
+            "delete": {
+                "description": "Deletes a custom field together with the values members hold for it.",
+                "tags": [
+                    "custom-fields"
+                ],
+                "summary": "Delete custom field",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Custom field ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/desk-bookings": {
+            "post": {
+                "description": "Books a desk for a member for the morning, the afternoon or the full day.\nA desk cannot be booked twice at the same time, and neither can a member, which is refused with 403.\nThe location of the desk limits how many days ahead it can be booked and how many bookings a member can hold within a week.",
+                "tags": [
+                    "desk-bookings"
+                ],
+                "summary": "Book desk",
+                "parameters": [
+                    {
+                        "description": "Booking",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.bookDeskRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.deskBookingResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/desk-bookings/{id}": {
+            "delete": {
+                "description": "Cancels the booking, which frees the desk for others. A booking already canceled is not found.",
+                "tags": [
+                    "desk-bookings"
+                ],
+                "summary": "Cancel desk booking",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Desk booking ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.deskBookingResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/desks/{id}": {
+            "delete": {
+                "description": "Deletes the desk together with its bookings.",
+                "tags": [
+                    "locations"
+                ],
+                "summary": "Delete desk",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Desk ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/floors/{id}": {
+            "delete": {
+                "description": "Deletes the floor together with its desks and desk bookings.",
+                "tags": [
+                    "locations"
+                ],
+                "summary": "Delete floor",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Floor ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/floors/{id}/desks": {
+            "get": {
+                "tags": [
+                    "locations"
+                ],
+                "summary": "List desks",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Floor ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.deskResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "tags": [
+                    "locations"
+                ],
+                "summary": "Create desk",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Floor ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Desk object",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.createDeskRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.deskResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/leave-requests": {
+            "get": {
+                "description": "Lists the leave requests, latest start first, optionally those of a member or with a status.",
+                "tags": [
+                    "leaves"
+                ],
+                "summary": "List leave requests",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "name": "member_id",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "page_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "maximum": 50,
+                        "minimum": 5,
+                        "type": "integer",
+                        "name": "page_size",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "pending",
+                            "approved",
+                            "rejected",
+                            "canceled"
+                        ],
+                        "type": "string",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.listLeaveRequestsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Requests a leave for the member, pending until the manager of the member or an admin decides on it.\nOnly working days, from Monday to Friday, are counted. A leave counted against an allowance is refused\nwhen the balance of its year, less the pending leaves, cannot take it.",
+                "tags": [
+                    "leaves"
+                ],
+                "summary": "Create leave request",
+                "parameters": [
+                    {
+                        "description": "Leave request object",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.createLeaveRequestRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.leaveRequestResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/leave-requests/{id}": {
+            "get": {
+                "tags": [
+                    "leaves"
+                ],
+                "summary": "Get leave request",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Leave request ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.leaveRequestResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/leave-requests/{id}/approve": {
+            "post": {
+                "description": "Approves a pending leave request. Only the manager of the member, the user with the email of the manager,\nor an admin can approve it, and only while the balance of its leave type can take it.",
+                "tags": [
+                    "leaves"
+                ],
+                "summary": "Approve leave request",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Leave request ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Decision object",
+                        "name": "body",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/api.decideLeaveRequestRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.leaveRequestResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/leave-requests/{id}/cancel": {
+            "post": {
+                "description": "Cancels a pending or approved leave request, giving its days back to the balance.",
+                "tags": [
+                    "leaves"
+                ],
+                "summary": "Cancel leave request",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Leave request ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.leaveRequestResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/leave-requests/{id}/reject": {
+            "post": {
+                "description": "Rejects a pending leave request. Only the manager of the member, the user with the email of the manager,\nor an admin can reject it.",
+                "tags": [
+                    "leaves"
+                ],
+                "summary": "Reject leave request",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Leave request ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Decision object",
+                        "name": "body",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/api.decideLeaveRequestRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.leaveRequestResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/leave-types": {
+            "get": {
+                "tags": [
+                    "leaves"
+                ],
+                "summary": "List leave types",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.leaveTypeResponse"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "A leave type, such as vacation, sick or remote, is counted against an annual allowance when it has one.",
+                "tags": [
+                    "leaves"
+                ],
+                "summary": "Create leave type",
+                "parameters": [
+                    {
+                        "description": "Leave type object",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.leaveTypeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.leaveTypeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/leave-types/{id}": {
+            "put": {
+                "description": "Replaces the name and the allowance of the leave type. Balances are computed again with the new allowance.",
+                "tags": [
+                    "leaves"
+                ],
+                "summary": "Update leave type",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Leave type ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Leave type object",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.leaveTypeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.leaveTypeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "A leave type cannot be deleted while leave requests use it.",
+                "tags": [
+                    "leaves"
+                ],
+                "summary": "Delete leave type",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Leave type ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/locations": {
+            "get": {
+                "tags": [
+                    "locations"
+                ],
+                "summary": "List locations",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.locationResponse"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "A location is a coworking space, made of floors of desks. It sets the rules desks are booked by.",
+                "tags": [
+                    "locations"
+                ],
+                "summary": "Create location",
+                "parameters": [
+                    {
+                        "description": "Location object",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.locationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.locationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/locations/{id}": {
+            "get": {
+                "tags": [
+                    "locations"
+                ],
+                "summary": "Get location",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Location ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.locationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Replaces the name and booking rules of the location. Rules left out are reset to their defaults.\nBookings already made are kept when the rules become stricter.",
+                "tags": [
+                    "locations"
+                ],
+                "summary": "Update location",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Location ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Location object",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.locationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.locationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes the location together with its floors, desks and desk bookings.",
+                "tags": [
+                    "locations"
+                ],
+                "summary": "Delete location",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Location ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/locations/{id}/availability": {
+            "get": {
+                "description": "Lists the desks of the location floor by floor for a day, with their bookings and the slots they can still be booked for.",
+                "tags": [
+                    "desk-bookings"
+                ],
+                "summary": "Get location availability",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Location ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "date",
+                        "description": "Date is the day availability is listed for, today by default.",
+                        "name": "date",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.locationAvailabilityResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/locations/{id}/floors": {
+            "get": {
+                "description": "Lists the floors of the location from the lowest level.",
+                "tags": [
+                    "locations"
+                ],
+                "summary": "List floors",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Location ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.floorResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "tags": [
+                    "locations"
+                ],
+                "summary": "Create floor",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Location ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Floor object",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.createFloorRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.floorResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/members": {
+            "get": {
+                "description": "Members can be filtered by custom fields with custom_fields[\u003ckey\u003e]=\u003cvalue\u003e.\nA multi_select field matches when it contains every given value; other fields must equal the value.\ntags narrows members down to those having any (default) or, with tags_match=all, all of the given tags.",
+                "tags": [
+                    "members"
+                ],
+                "summary": "List members",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "date-time",
+                        "name": "created_after",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "date-time",
+                        "name": "created_before",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "name": "has_email",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "page_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "maximum": 10,
+                        "minimum": 5,
+                        "type": "integer",
+                        "name": "page_size",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "maxLength": 100,
+                        "type": "string",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "last_name,-created_at",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "active,on_leave",
+                        "description": "Status is a comma separated list of lifecycle statuses, or \"all\" for members in any status. Only active members are listed by default.",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "tags",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "any",
+                            "all"
+                        ],
+                        "type": "string",
+                        "name": "tags_match",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.listMembersResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "custom_fields holds the values of the custom fields by key; every required custom field must be given.\nContact details are given either as a single email or as lists of emails, phones, addresses and links.\nNo two members can have the same primary email; members in the trash do not hold one.",
+                "tags": [
+                    "members"
+                ],
+                "summary": "Create member",
+                "parameters": [
+                    {
+                        "description": "Member object",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.createMemberRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.memberDetailResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Moves the members to the trash, from where they can be restored until the retention period has passed.",
+                "tags": [
+                    "members"
+                ],
+                "summary": "Delete members",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "name": "ids",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/members/duplicates": {
+            "get": {
+                "description": "Finds groups of members that are likely duplicates: members sharing an email once case and any \"+tag\" are ignored,\nor members with similar names. Members in the trash are left out.",
+                "tags": [
+                    "members"
+                ],
+                "summary": "List member duplicates",
+                "parameters": [
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 1,
+                        "minimum": 0.3,
+                        "type": "number",
+                        "example": 0.6,
+                        "description": "MinSimilarity is the similarity, from 0.3 to 1, from which names are considered alike.",
+                        "name": "min_similarity",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.listMemberDuplicatesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/members/export": {
+            "get": {
+                "description": "Streams all members matching the same filters as \"List members\" as CSV (with a UTF-8 BOM for Excel),\nJSON Lines or vCard 4.0 with one card per member.",
+                "produces": [
+                    "text/csv",
+                    "application/jsonl",
+                    "text/vcard"
+                ],
+                "tags": [
+                    "members"
+                ],
+                "summary": "Export members",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "date-time",
+                        "name": "created_after",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "date-time",
+                        "name": "created_before",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "csv",
+                            "jsonl",
+                            "vcf"
+                        ],
+                        "type": "string",
+                        "name": "format",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "name": "has_email",
+                        "in": "query"
+                    },
+                    {
+                        "maxLength": 100,
+                        "type": "string",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "last_name,-created_at",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "active,on_leave",
+                        "description": "Status is a comma separated list of lifecycle statuses, or \"all\" for members in any status. Only active members are listed by default.",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "tags",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "any",
+                            "all"
+                        ],
+                        "type": "string",
+                        "name": "tags_match",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/members/import": {
+            "post": {
+                "description": "Imports members from a CSV or XLSX file whose first row is the header.\nEvery row is validated like \"Create member\", including its required custom fields, which are read from\ncolumns named or mapped to custom_fields.\u003ckey\u003e; the options of a multi_select field are separated by commas.\nA primary email can neither appear twice in the file nor belong to an existing member.\nWith dry_run only the validation report is returned.\nOtherwise the members are created all-or-nothing: a single invalid row aborts the whole import.\nFiles with more rows than the configured threshold are imported by a background job (202).",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "tags": [
+                    "members"
+                ],
+                "summary": "Import members",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "CSV or XLSX file",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Validate the rows without creating members",
+                        "name": "dry_run",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "JSON object mapping headers to member fields, e.g. {\\",
+                        "name": "mapping",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.memberImportReport"
+                        }
+                    },
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/api.memberImportJobResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/api.memberImportReport"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/members/import/{id}": {
+            "get": {
+                "tags": [
+                    "members"
+                ],
+                "summary": "Get member import job",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Member import job ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.memberImportJobResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/members/merge": {
+            "post": {
+                "description": "Merges members into a survivor in a single transaction. Their tags, teams, contact details, reports and history\nmove to the survivor, and they are moved to the trash. The merge is recorded in the audit log.",
+                "tags": [
+                    "members"
+                ],
+                "summary": "Merge members",
+                "parameters": [
+                    {
+                        "description": "Merge",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.mergeMembersRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.memberDetailResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/members/restore": {
+            "post": {
+                "description": "Moves deleted members back out of the trash. IDs of members that are not in the trash are ignored.\nNone of the members is restored when the email of one of them is now the primary email of another member.",
+                "tags": [
+                    "members"
+                ],
+                "summary": "Restore members",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "name": "ids",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/members/search": {
+            "get": {
+                "description": "Full-text and fuzzy search across names and email. Results are ranked by relevance and\nthe snippet is HTML-escaped with the matched parts wrapped in \u003cmark\u003e tags.",
+                "tags": [
+                    "members"
+                ],
+                "summary": "Search members",
+                "parameters": [
+                    {
+                        "maximum": 50,
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "maxLength": 100,
+                        "type": "string",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.searchMembersResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/members/tags": {
+            "post": {
+                "description": "Attaches every tag to every member. IDs of members in the trash or that do not exist are ignored,\nas are IDs of tags that do not exist.",
+                "tags": [
+                    "members"
+                ],
+                "summary": "Tag members",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "name": "ids",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "name": "tag_ids",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Detaches every tag from every member.",
+                "tags": [
+                    "members"
+                ],
+                "summary": "Untag members",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "name": "ids",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "name": "tag_ids",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/members/trash": {
+            "get": {
+                "description": "Lists the members in the trash, most recently deleted first.\nThey are permanently deleted once the retention period has passed.",
+                "tags": [
+                    "members"
+                ],
+                "summary": "List deleted members",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "page_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "maximum": 10,
+                        "minimum": 5,
+                        "type": "integer",
+                        "name": "page_size",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.listDeletedMembersResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/members/{id}": {
+            "get": {
+                "tags": [
+                    "members"
+                ],
+                "summary": "Get member",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Member ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.memberDetailResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the member"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "The update only applies when If-Match holds the current ETag of the member.\nOtherwise 412 is returned together with the current member.\nEach list of contact details given replaces all the details of its kind.",
+                "tags": [
+                    "members"
+                ],
+                "summary": "Update member",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Member ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the member being updated",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Member object",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.updateMemberRequestBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.memberDetailResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the member"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/api.memberDetailResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Moves the member to the trash, from where it can be restored until the retention period has passed.",
+                "tags": [
+                    "members"
+                ],
+                "summary": "Delete member",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Member ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Applies a JSON Merge Patch (RFC 7396) to the member: absent fields are left untouched\nand null clears a nullable field such as email.\nA list of contact details replaces all the details of its kind, and null removes them.\nThe same If-Match rules as \"Update member\" apply.",
+                "consumes": [
+                    "application/merge-patch+json"
+                ],
+                "tags": [
+                    "members"
+                ],
+                "summary": "Patch member",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Member ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the member being updated",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Merge patch",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.patchMemberRequestBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.memberDetailResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the member"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/api.memberDetailResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/members/{id}/attendances": {
+            "get": {
+                "description": "Lists the check-ins of the member within a month, in order.",
+                "tags": [
+                    "attendance"
+                ],
+                "summary": "List member attendances",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Member ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "2023-05",
+                        "description": "Month is the month listed, as YYYY-MM in UTC. The current month by default.",
+                        "name": "month",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.attendanceResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/members/{id}/avatar": {
+            "put": {
+                "description": "Accepts a JPEG, PNG or GIF image of up to 4 MiB, which is turned into square thumbnails\nof 64, 128 and 256 pixels with any EXIF data stripped. The previous avatar is removed.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "tags": [
+                    "members"
+                ],
+                "summary": "Upload member avatar",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Member ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Image",
+                        "name": "avatar",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.memberResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported Media Type",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/members/{id}/calendar-token": {
+            "post": {
+                "description": "Creates the token of the iCalendar feed of the room reservations the member made, replacing the previous one,\nwhose URL stops working.",
+                "tags": [
+                    "members"
+                ],
+                "summary": "Rotate member calendar token",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Member ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.calendarTokenResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/members/{id}/chain": {
+            "get": {
+                "description": "Lists the managers above the member, from the direct manager up to the top of the organization.\nThe chain stops at a manager who is in the trash.",
+                "tags": [
+                    "members"
+                ],
+                "summary": "Get member management chain",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Member ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.memberResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/members/{id}/check-in": {
+            "post": {
+                "description": "Records that the member arrived at a location. A member can only be checked in once at a time.",
+                "tags": [
+                    "attendance"
+                ],
+                "summary": "Check in member",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Member ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Check-in",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.checkInMemberRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.attendanceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/members/{id}/check-out": {
+            "post": {
+                "description": "Records that the member left, closing their current check-in. The body can be left out.",
+                "tags": [
+                    "attendance"
+                ],
+                "summary": "Check out member",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Member ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Check-out",
+                        "name": "body",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/api.checkOutMemberRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.attendanceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/members/{id}/checklist": {
+            "get": {
+                "description": "Lists the checklist tasks of a member, the oldest checklist first.",
+                "tags": [
+                    "checklists"
+                ],
+                "summary": "List member checklist",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Member ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "onboarding",
+                            "offboarding"
+                        ],
+                        "type": "string",
+                        "name": "kind",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.checklistTaskResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/members/{id}/history": {
+            "get": {
+                "description": "Lists the changes made to the member, most recent first.",
+                "tags": [
+                    "members"
+                ],
+                "summary": "Get member history",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Member ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "page_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "maximum": 50,
+                        "minimum": 5,
+                        "type": "integer",
+                        "name": "page_size",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.listAuditEventsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/members/{id}/leave-balances": {
+            "get": {
+                "description": "Lists the balances of the member in a year, for every leave type counted against an annual allowance.",
+                "tags": [
+                    "leaves"
+                ],
+                "summary": "List member leave balances",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Member ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "maximum": 2100,
+                        "minimum": 2000,
+                        "type": "integer",
+                        "example": 2023,
+                        "description": "Year is the year of the balances, the current year in UTC by default.",
+                        "name": "year",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.leaveBalanceResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/members/{id}/manager": {
+            "put": {
+                "description": "A member cannot report to themselves or to one of their direct or indirect reports.",
+                "tags": [
+                    "members"
+                ],
+                "summary": "Set member manager",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Member ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Manager",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.setMemberManagerRequestBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.memberResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "tags": [
+                    "members"
+                ],
+                "summary": "Clear member manager",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Member ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.memberResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/members/{id}/reports": {
+            "get": {
+                "description": "Lists the direct reports of the member, or with recursive=true all the members\nreporting to the member directly or indirectly, nearest first.",
+                "tags": [
+                    "members"
+                ],
+                "summary": "List member reports",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Member ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "page_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "maximum": 10,
+                        "minimum": 5,
+                        "type": "integer",
+                        "name": "page_size",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "name": "recursive",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.listMemberReportsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/members/{id}/restore": {
+            "post": {
+                "description": "Moves a deleted member back out of the trash, where its email becomes its primary email again.\nA member whose email another member has taken over as primary email cannot be restored.",
+                "tags": [
+                    "members"
+                ],
+                "summary": "Restore member",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Member ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.memberResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/members/{id}/skills": {
+            "get": {
+                "description": "Lists the skills of the member, the most proficient first.",
+                "tags": [
+                    "skills"
+                ],
+                "summary": "List member skills",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Member ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.memberSkillResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/members/{id}/skills/{skill_id}": {
+            "put": {
+                "description": "Gives the member the skill at a level, or changes the level and the last day they used it.\nMembers in the trash cannot be given skills.",
+                "tags": [
+                    "skills"
+                ],
+                "summary": "Set member skill",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Member ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Skill ID",
+                        "name": "skill_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Member skill object",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.setMemberSkillRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.memberSkillResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Removes the skill from the member, along with its endorsements.",
+                "tags": [
+                    "skills"
+                ],
+                "summary": "Remove member skill",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Member ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Skill ID",
+                        "name": "skill_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/members/{id}/skills/{skill_id}/endorsement": {
+            "put": {
+                "description": "Vouches for the skill of the member as the signed-in user. Members cannot endorse their own skills,\na user being a member when they share an email. Endorsing a skill twice is a no-op.",
+                "tags": [
+                    "skills"
+                ],
+                "summary": "Endorse member skill",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Member ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Skill ID",
+                        "name": "skill_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.memberSkillResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Withdraws the endorsement of the signed-in user. Withdrawing an endorsement that was not made is a no-op.",
+                "tags": [
+                    "skills"
+                ],
+                "summary": "Withdraw member skill endorsement",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Member ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Skill ID",
+                        "name": "skill_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.memberSkillResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/members/{id}/tags/{tag_id}": {
+            "put": {
+                "description": "Attaching a tag the member already has is a no-op.",
+                "tags": [
+                    "members"
+                ],
+                "summary": "Attach tag to member",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Member ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tag ID",
+                        "name": "tag_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.memberResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Detaching a tag the member does not have is a no-op.",
+                "tags": [
+                    "members"
+                ],
+                "summary": "Detach tag from member",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Member ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tag ID",
+                        "name": "tag_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.memberResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/members/{id}/timer": {
+            "get": {
+                "description": "Returns the time entry of the running timer of the member.",
+                "tags": [
+                    "time-tracking"
+                ],
+                "summary": "Get member timer",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Member ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.timeEntryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/members/{id}/timer/start": {
+            "post": {
+                "description": "Starts tracking time of the member against a project now, until the timer is stopped.\nA member can only have one timer running.",
+                "tags": [
+                    "time-tracking"
+                ],
+                "summary": "Start member timer",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Member ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Timer",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.startMemberTimerRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.timeEntryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/members/{id}/timer/stop": {
+            "post": {
+                "description": "Stops the running timer of the member now.",
+                "tags": [
+                    "time-tracking"
+                ],
+                "summary": "Stop member timer",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Member ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.timeEntryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/members/{id}/timesheets/{week}": {
+            "get": {
+                "description": "Returns the timesheet of the member for the week of a day, from Monday to Sunday: its time entries and their totals.",
+                "tags": [
+                    "time-tracking"
+                ],
+                "summary": "Get member timesheet",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Member ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Any day of the week, as YYYY-MM-DD",
+                        "name": "week",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.timesheetResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/members/{id}/timesheets/{week}/reopen": {
+            "post": {
+                "description": "Reopens the submitted timesheet of the member for the week of a day, unlocking its time entries.\nOnly an admin or the manager of the member can reopen a timesheet.",
+                "tags": [
+                    "time-tracking"
+                ],
+                "summary": "Reopen member timesheet",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Member ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Any day of the week, as YYYY-MM-DD",
+                        "name": "week",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.timesheetResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/members/{id}/timesheets/{week}/submit": {
+            "post": {
+                "description": "Submits the timesheet of the member for the week of a day, locking its time entries until it is reopened.\nA week cannot be submitted while a timer started within it is running.",
+                "tags": [
+                    "time-tracking"
+                ],
+                "summary": "Submit member timesheet",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Member ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Any day of the week, as YYYY-MM-DD",
+                        "name": "week",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.timesheetResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/members/{id}/transitions": {
+            "post": {
+                "description": "Moves a member to another lifecycle status, which is recorded in the history of the member.\ninvited moves to onboarding, active or offboarded; onboarding to active or offboarded; active to on_leave or offboarded;\non_leave to active or offboarded; and offboarded back to onboarding or active for a rehire.\nThe effective date becomes the start date of a member starting work, and the end date of an offboarded member.",
+                "tags": [
+                    "members"
+                ],
+                "summary": "Transition member",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Member ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Transition",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.transitionMemberRequestBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.memberDetailResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the member"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/members/{id}/working-hours": {
+            "get": {
+                "description": "Returns the time zone of the member and their weekly working hours in local time.",
+                "tags": [
+                    "members"
+                ],
+                "summary": "Get member working hours",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Member ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.memberWorkingHoursResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Sets the time zone of the member and replaces their weekly working hours, given in local time.",
+                "tags": [
+                    "members"
+                ],
+                "summary": "Set member working hours",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Member ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Working hours object",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.setMemberWorkingHoursRequestBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.memberWorkingHoursResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/org-chart": {
+            "get": {
+                "description": "Returns the whole organization as a tree of reporting lines, with the members sorted by name on each level.",
+                "tags": [
+                    "members"
+                ],
+                "summary": "Get org chart",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.orgChartNode"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/presence": {
+            "get": {
+                "description": "Lists who is in today: the members checked in right now, and then those who came in today and already left.\nDays start at midnight UTC.",
+                "tags": [
+                    "attendance"
+                ],
+                "summary": "Get presence board",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "name": "location_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.presenceResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/project-assignments": {
+            "get": {
+                "description": "Lists the assignments, by the day they start, optionally those of a project, of a member or running within a range of days.",
+                "tags": [
+                    "capacity"
+                ],
+                "summary": "List project assignments",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "date",
+                        "description": "From and To list the assignments running on any day between them.",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "member_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "project_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "date",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.projectAssignmentResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Assigns a member to a project for a range of days, with a share of their working time.\nThe assignments of a member cannot allocate them beyond the configured limit on any day,\nand no member can be assigned to an archived project.",
+                "tags": [
+                    "capacity"
+                ],
+                "summary": "Create project assignment",
+                "parameters": [
+                    {
+                        "description": "Project assignment object",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.createProjectAssignmentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.projectAssignmentResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/project-assignments/{id}": {
+            "get": {
+                "tags": [
+                    "capacity"
+                ],
+                "summary": "Get project assignment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project assignment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.projectAssignmentResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Changes the role, the allocation or the days of the assignment, checking the allocation limit as it is created.",
+                "tags": [
+                    "capacity"
+                ],
+                "summary": "Update project assignment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project assignment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Project assignment object",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.projectAssignmentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.projectAssignmentResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "tags": [
+                    "capacity"
+                ],
+                "summary": "Delete project assignment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project assignment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects": {
+            "get": {
+                "description": "Lists the projects by name, leaving out the archived ones unless asked for.",
+                "tags": [
+                    "time-tracking"
+                ],
+                "summary": "List projects",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "IncludeArchived lists the archived projects too.",
+                        "name": "include_archived",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.projectResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Time is tracked against projects.",
+                "tags": [
+                    "time-tracking"
+                ],
+                "summary": "Create project",
+                "parameters": [
+                    {
+                        "description": "Project object",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.createProjectRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.projectResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/{id}": {
+            "get": {
+                "tags": [
+                    "time-tracking"
+                ],
+                "summary": "Get project",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.projectResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Replaces the project. An archived project is kept for the reports, but no time can be tracked against it.",
+                "tags": [
+                    "time-tracking"
+                ],
+                "summary": "Update project",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Project object",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.updateProjectRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.projectResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "A project cannot be deleted once time is tracked against it. It can be archived instead.",
+                "tags": [
+                    "time-tracking"
+                ],
+                "summary": "Delete project",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/room-reservations": {
+            "post": {
+                "description": "Reserves a room, once or repeatedly following an RRULE. Every occurrence is checked against the other reservations\nof the room, and a reservation overlapping any of them is refused with 403.\nA recurrence must end within two years and 500 occurrences.",
+                "tags": [
+                    "rooms"
+                ],
+                "summary": "Create room reservation",
+                "parameters": [
+                    {
+                        "description": "Reservation",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.createRoomReservationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.roomReservationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/room-reservations/{id}": {
+            "get": {
+                "tags": [
+                    "rooms"
+                ],
+                "summary": "Get room reservation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Reservation ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.roomReservationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes the reservation with all its occurrences.",
+                "tags": [
+                    "rooms"
+                ],
+                "summary": "Delete room reservation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Reservation ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/room-reservations/{id}/exceptions": {
+            "post": {
+                "description": "Cancels the single occurrence of the reservation starting at occurrence_start, keeping the others.",
+                "tags": [
+                    "rooms"
+                ],
+                "summary": "Cancel room reservation occurrence",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Reservation ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Occurrence",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.cancelRoomReservationOccurrenceRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/rooms": {
+            "get": {
+                "description": "Lists the rooms, optionally only those of a location, seating at least min_capacity and having all the equipment listed.",
+                "tags": [
+                    "rooms"
+                ],
+                "summary": "List rooms",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "projector,whiteboard",
+                        "description": "Equipment is a comma separated list of the equipment the rooms must all have.",
+                        "name": "equipment",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "location_id",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "min_capacity",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.roomResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "A room of a location that members can reserve. Its name is unique within the location.",
+                "tags": [
+                    "rooms"
+                ],
+                "summary": "Create room",
+                "parameters": [
+                    {
+                        "description": "Room object",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.createRoomRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.roomResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/rooms/{id}": {
+            "get": {
+                "tags": [
+                    "rooms"
+                ],
+                "summary": "Get room",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Room ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.roomResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Replaces the name, capacity and equipment of the room. Its reservations are kept.",
+                "tags": [
+                    "rooms"
+                ],
+                "summary": "Update room",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Room ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Room object",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.roomRequestBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.roomResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes the room together with its reservations and calendar feed.",
+                "tags": [
+                    "rooms"
+                ],
+                "summary": "Delete room",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Room ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/rooms/{id}/calendar-token": {
+            "post": {
+                "description": "Creates the token of the iCalendar feed of the room's reservations, replacing the previous one, whose URL stops working.",
+                "tags": [
+                    "rooms"
+                ],
+                "summary": "Rotate room calendar token",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Room ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.calendarTokenResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/rooms/{id}/reservations": {
+            "get": {
+                "description": "Lists the occurrences of the reservations of the room overlapping a range, with recurring reservations expanded.",
+                "tags": [
+                    "rooms"
+                ],
+                "summary": "List room reservations",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Room ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "date-time",
+                        "description": "From is the start of the range listed, now by default.",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "date-time",
+                        "description": "To is the end of the range listed, a week after from by default. The range can span up to 92 days.",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.roomReservationOccurrenceResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/skills": {
+            "get": {
+                "tags": [
+                    "skills"
+                ],
+                "summary": "List skills",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.skillResponse"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Adds a skill to the catalog of the workspace. Skill names are unique regardless of case.",
+                "tags": [
+                    "skills"
+                ],
+                "summary": "Create skill",
+                "parameters": [
+                    {
+                        "description": "Skill object",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.skillRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.skillResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/skills/search": {
+            "get": {
+                "description": "Finds the members having any of the required skills, such as Go at level 4 or more and Postgres at level 3 or more.\nMembers meeting the most requirements come first, then those scoring the highest levels over the levels asked for,\nthen the most endorsed. Members in the trash or offboarded are left out.",
+                "tags": [
+                    "skills"
+                ],
+                "summary": "Search members by skills",
+                "parameters": [
+                    {
+                        "maximum": 50,
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "3f0e0f0c-8d4a-4a4e-9d44-8d7b0c6f5e21:4",
+                        "description": "Requirements are comma separated skill_id:level pairs, the level being the lowest one accepted.",
+                        "name": "requirements",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.skillMatchResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/skills/{id}": {
+            "put": {
+                "tags": [
+                    "skills"
+                ],
+                "summary": "Rename skill",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Skill ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Skill object",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.skillRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.skillResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes the skill, along with the levels members have in it and their endorsements.",
+                "tags": [
+                    "skills"
+                ],
+                "summary": "Delete skill",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Skill ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tags": {
+            "get": {
+                "tags": [
+                    "tags"
+                ],
+                "summary": "List tags",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.tagResponse"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Tag names are unique regardless of case.",
+                "tags": [
+                    "tags"
+                ],
+                "summary": "Create tag",
+                "parameters": [
+                    {
+                        "description": "Tag object",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.createTagRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.tagResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tags/{id}": {
+            "put": {
+                "tags": [
+                    "tags"
+                ],
+                "summary": "Rename tag",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tag ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Tag object",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.renameTagRequestBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.tagResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes the tag and detaches it from every member.",
+                "tags": [
+                    "tags"
+                ],
+                "summary": "Delete tag",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tag ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/teams": {
+            "get": {
+                "tags": [
+                    "teams"
+                ],
+                "summary": "List teams",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.teamResponse"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Teams form a tree through parent_id; a team without a parent is at the top level.",
+                "tags": [
+                    "teams"
+                ],
+                "summary": "Create team",
+                "parameters": [
+                    {
+                        "description": "Team object",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.createTeamRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.teamResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/teams/{id}": {
+            "get": {
+                "tags": [
+                    "teams"
+                ],
+                "summary": "Get team",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Team ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.teamResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Replaces the name and parent of the team. Moving a team under itself or one of its subteams is refused.",
+                "tags": [
+                    "teams"
+                ],
+                "summary": "Update team",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Team ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Team object",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.updateTeamRequestBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.teamResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes the team without deleting its members. Its subteams are moved up to its parent.",
+                "tags": [
+                    "teams"
+                ],
+                "summary": "Delete team",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Team ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/teams/{id}/leave-calendar": {
+            "get": {
+                "description": "Lists the leaves of the members of the team overlapping a range of days, to tell who is away.",
+                "tags": [
+                    "teams"
+                ],
+                "summary": "Get team leave calendar",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Team ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "date",
+                        "description": "From is the first day of the calendar, today by default.",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "IncludePending includes the leaves waiting for a decision along with the approved ones.",
+                        "name": "include_pending",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Recursive includes the members of the subteams.",
+                        "name": "recursive",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "date",
+                        "description": "To is the last day of the calendar, a month after from by default. The calendar can span up to 366 days.",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.teamLeaveResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/teams/{id}/members": {
+            "get": {
+                "description": "With recursive=true the members of all the subteams are listed as well,\nonce for every team they belong to. team_id tells which team that is.",
+                "tags": [
+                    "teams"
+                ],
+                "summary": "List team members",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Team ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "page_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "maximum": 10,
+                        "minimum": 5,
+                        "type": "integer",
+                        "name": "page_size",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "name": "recursive",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.listTeamMembersResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/teams/{id}/members/{member_id}": {
+            "put": {
+                "description": "Adds the member to the team, or changes its role when it already belongs to the team.",
+                "tags": [
+                    "teams"
+                ],
+                "summary": "Add team member",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Team ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Member ID",
+                        "name": "member_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Membership object",
+                        "name": "body",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/api.addTeamMemberRequestBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.teamMembershipResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Removes the member from the team without deleting the member.",
+                "tags": [
+                    "teams"
+                ],
+                "summary": "Remove team member",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Team ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Member ID",
+                        "name": "member_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/teams/{id}/members/{member_id}/move": {
+            "post": {
+                "description": "Moves the member from the team to the team given in the body.",
+                "tags": [
+                    "teams"
+                ],
+                "summary": "Move team member",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Team ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Member ID",
+                        "name": "member_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Target team",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.moveTeamMemberRequestBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.teamMembershipResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/time-entries": {
+            "get": {
+                "description": "Lists the time entries, latest first, optionally those of a member, of a project or within a range of days.",
+                "tags": [
+                    "time-tracking"
+                ],
+                "summary": "List time entries",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "date",
+                        "description": "From and To are the first and the last day listed.",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "member_id",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "page_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "maximum": 50,
+                        "minimum": 5,
+                        "type": "integer",
+                        "name": "page_size",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "name": "project_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "date",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.listTimeEntriesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Tracks time of the member against a project, given either as started_at and ended_at, or as date and minutes.\nAn entry without an end is a running timer. Entries cannot overlap, nor be added to the week of a submitted\ntimesheet or to an archived project.",
+                "tags": [
+                    "time-tracking"
+                ],
+                "summary": "Create time entry",
+                "parameters": [
+                    {
+                        "description": "Time entry object",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.createTimeEntryRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.timeEntryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/time-entries/{id}": {
+            "get": {
+                "tags": [
+                    "time-tracking"
+                ],
+                "summary": "Get time entry",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Time entry ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.timeEntryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Replaces the time entry, as it is created. Neither the week it was in nor the week it moves to can be submitted.",
+                "tags": [
+                    "time-tracking"
+                ],
+                "summary": "Update time entry",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Time entry ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Time entry object",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.timeEntryRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.timeEntryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "A time entry cannot be deleted once the timesheet of its week is submitted.",
+                "tags": [
+                    "time-tracking"
+                ],
+                "summary": "Delete time entry",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Time entry ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/time-reports": {
+            "get": {
+                "description": "Sums up the time tracked within a range of days, per project, member or week, or any of them together.\nWeeks start on Monday. Running timers are not counted.\nWith format=csv, the report is downloaded as CSV with a UTF-8 BOM for Excel.",
+                "produces": [
+                    "application/json",
+                    "text/csv"
+                ],
+                "tags": [
+                    "time-tracking"
+                ],
+                "summary": "Get time report",
+                "parameters": [
+                    {
+                        "enum": [
+                            "json",
+                            "csv"
+                        ],
+                        "type": "string",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "date",
+                        "description": "From and To are the first and the last day reported.",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "project,week",
+                        "description": "GroupBy is a comma-separated list of project, member and week, all of them by default.",
+                        "name": "group_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "member_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "project_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "date",
+                        "name": "to",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.timeReportRowResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users": {
+            "post": {
+                "description": "The first user registered becomes an admin.",
+                "tags": [
+                    "users"
+                ],
+                "summary": "Create user",
+                "parameters": [
+                    {
+                        "description": "User object",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.createUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.userResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/login": {
+            "post": {
+                "tags": [
+                    "users"
+                ],
+                "summary": "Login user",
+                "parameters": [
+                    {
+                        "description": "User object",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.loginUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.loginUserResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/logout": {
+            "post": {
+                "tags": [
+                    "users"
+                ],
+                "summary": "Logout user",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.loginUserResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me": {
+            "get": {
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get logged in user",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.userResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me/checklist-tasks": {
+            "get": {
+                "description": "Lists the open checklist tasks assigned to the logged-in user, the soonest due first.",
+                "tags": [
+                    "checklists"
+                ],
+                "summary": "List my checklist tasks",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.checklistTaskResponse"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/working-hours/overlap": {
+            "get": {
+                "description": "Returns the windows of a day in which all the members work, from their weekly working hours and time zones.\nWindows are given in UTC and in the local time of every member. Every member must have a time zone.",
+                "tags": [
+                    "members"
+                ],
+                "summary": "Get working hours overlap",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "date",
+                        "name": "date",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "MemberIDs is a comma-separated list of the IDs of the members.",
+                        "name": "member_ids",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "maxLength": 64,
+                        "type": "string",
+                        "example": "Europe/Paris",
+                        "description": "TimeZone is the IANA time zone the date is in, UTC by default.",
+                        "name": "time_zone",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.workingHoursOverlapResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.errorResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "api.addTeamMemberRequestBody": {
+            "type": "object",
+            "properties": {
+                "role": {
+                    "type": "string",
+                    "default": "member",
+                    "enum": [
+                        "lead",
+                        "member"
+                    ]
+                }
+            }
+        },
+        "api.attendanceResponse": {
+            "type": "object",
+            "properties": {
+                "auto_checked_out": {
+                    "description": "AutoCheckedOut tells that the member did not check out and was checked out at the cutoff instead.",
+                    "type": "boolean"
+                },
+                "checked_in_at": {
+                    "type": "string"
+                },
+                "checked_out_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "location_id": {
+                    "type": "string"
+                },
+                "member_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.attendanceSummaryResponse": {
+            "type": "object",
+            "properties": {
+                "auto_checkouts": {
+                    "description": "AutoCheckouts is the number of times the member did not check out and was checked out at the cutoff.",
+                    "type": "integer"
+                },
+                "days_present": {
+                    "description": "DaysPresent is the number of days the member checked in on.",
+                    "type": "integer"
+                },
+                "first_name": {
+                    "type": "string"
+                },
+                "hours_present": {
+                    "description": "HoursPresent adds up the time between the check-ins and check-outs of the member, rounded to the minute.",
+                    "type": "number"
+                },
+                "last_name": {
+                    "type": "string"
+                },
+                "member_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.auditEventResponse": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string"
+                },
+                "actor_id": {
+                    "type": "string"
+                },
+                "changes": {
+                    "type": "object"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "entity_id": {
+                    "type": "string"
+                },
+                "entity_type": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.bookDeskRequest": {
+            "type": "object",
+            "required": [
+                "date",
+                "desk_id",
+                "member_id",
+                "slot"
+            ],
+            "properties": {
+                "date": {
+                    "type": "string",
+                    "format": "date"
+                },
+                "desk_id": {
+                    "type": "string"
+                },
+                "member_id": {
+                    "type": "string"
+                },
+                "slot": {
+                    "type": "string",
+                    "enum": [
+                        "morning",
+                        "afternoon",
+                        "full_day"
+                    ]
+                }
+            }
+        },
+        "api.calendarTokenResponse": {
+            "type": "object",
+            "properties": {
+                "token": {
+                    "type": "string"
+                },
+                "url": {
+                    "description": "URL is the address of the iCalendar feed to subscribe to from a calendar client. Anyone knowing it can read the feed.",
+                    "type": "string",
+                    "example": "https://example.com/api/v1/calendars/8d7c2a3e-3b1f-4a8e-9a57-2f0e8b1b0f6c.ics"
+                }
+            }
+        },
+        "api.cancelRoomReservationOccurrenceRequest": {
+            "type": "object",
+            "required": [
+                "occurrence_start"
+            ],
+            "properties": {
+                "occurrence_start": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.checkInMemberRequest": {
+            "type": "object",
+            "required": [
+                "location_id"
+            ],
+            "properties": {
+                "checked_in_at": {
+                    "description": "CheckedInAt records a check-in made earlier, now by default.",
+                    "type": "string"
+                },
+                "location_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.checkOutMemberRequest": {
+            "type": "object",
+            "properties": {
+                "checked_out_at": {
+                    "description": "CheckedOutAt records a check-out made earlier, now by default.",
+                    "type": "string"
+                }
+            }
+        },
+        "api.checklistTaskResponse": {
+            "type": "object",
+            "properties": {
+                "assignee_id": {
+                    "type": "string"
+                },
+                "completed_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "completed_by": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "due_date": {
+                    "type": "string",
+                    "format": "date"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string",
+                    "enum": [
+                        "onboarding",
+                        "offboarding"
+                    ]
+                },
+                "member_id": {
+                    "type": "string"
+                },
+                "overdue": {
+                    "description": "Overdue tells whether the task was found still open after its due date.",
+                    "type": "boolean"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.checklistTemplateResponse": {
+            "type": "object",
+            "properties": {
+                "kind": {
+                    "type": "string",
+                    "enum": [
+                        "onboarding",
+                        "offboarding"
+                    ]
+                },
+                "tasks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.checklistTemplateTaskResponse"
+                    }
+                }
+            }
+        },
+        "api.checklistTemplateTaskRequest": {
+            "type": "object",
+            "required": [
+                "title"
+            ],
+            "properties": {
+                "assignee_id": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 2000
+                },
+                "due_offset_days": {
+                    "type": "integer",
+                    "maximum": 365,
+                    "minimum": -365
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 200
+                }
+            }
+        },
+        "api.checklistTemplateTaskResponse": {
+            "type": "object",
+            "properties": {
+                "assignee_id": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "due_offset_days": {
+                    "description": "DueOffsetDays is the number of days after the start of onboarding or offboarding the task is due.",
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.createCustomFieldDefinitionRequest": {
+            "type": "object",
+            "required": [
+                "key",
+                "label",
+                "options",
+                "type"
+            ],
+            "properties": {
+                "key": {
+                    "type": "string",
+                    "maxLength": 63,
+                    "example": "employee_number"
+                },
+                "label": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Employee number"
+                },
+                "max_length": {
+                    "type": "integer"
+                },
+                "max_value": {
+                    "type": "number"
+                },
+                "min_length": {
+                    "type": "integer"
+                },
+                "min_value": {
+                    "type": "number"
+                },
+                "options": {
+                    "type": "array",
+                    "uniqueItems": true,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "pattern": {
+                    "type": "string"
+                },
+                "required": {
+                    "type": "boolean"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "text",
+                        "number",
+                        "date",
+                        "select",
+                        "multi_select",
+                        "url"
+                    ]
+                }
+            }
+        },
+        "api.createDeskRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "description": "Name is unique within the floor.",
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "A-12"
+                }
+            }
+        },
+        "api.createFloorRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "level": {
+                    "description": "Level orders the floors of a location, from the lowest.",
+                    "type": "integer",
+                    "example": 3
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "3F"
+                }
+            }
+        },
+        "api.createLeaveRequestRequest": {
+            "type": "object",
+            "required": [
+                "end_date",
+                "leave_type_id",
+                "member_id",
+                "start_date"
+            ],
+            "properties": {
+                "end_date": {
+                    "type": "string",
+                    "format": "date"
+                },
+                "end_half_day": {
+                    "description": "EndHalfDay ends the leave at noon.",
+                    "type": "boolean"
+                },
+                "leave_type_id": {
+                    "type": "string"
+                },
+                "member_id": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string",
+                    "maxLength": 500
+                },
+                "start_date": {
+                    "type": "string",
+                    "format": "date"
+                },
+                "start_half_day": {
+                    "description": "StartHalfDay starts the leave at noon.",
+                    "type": "boolean"
+                }
+            }
+        },
+        "api.createMemberRequest": {
+            "type": "object",
+            "required": [
+                "first_name",
                 "last_name"
             ],
             "properties": {
+                "addresses": {
+                    "type": "array",
+                    "maxItems": 10,
+                    "items": {
+                        "$ref": "#/definitions/api.memberAddressRequest"
+                    }
+                },
+                "custom_fields": {
+                    "type": "object"
+                },
+                "email": {
+                    "type": "string",
+                    "format": "email"
+                },
+                "emails": {
+                    "description": "Emails may mark one email as primary; otherwise the first one is. The primary email is the email of the member.",
+                    "type": "array",
+                    "maxItems": 10,
+                    "items": {
+                        "$ref": "#/definitions/api.memberEmailRequest"
+                    }
+                },
+                "first_name": {
+                    "type": "string"
+                },
+                "last_name": {
+                    "type": "string"
+                },
+                "links": {
+                    "type": "array",
+                    "maxItems": 20,
+                    "items": {
+                        "$ref": "#/definitions/api.memberLinkRequest"
+                    }
+                },
+                "phones": {
+                    "type": "array",
+                    "maxItems": 10,
+                    "items": {
+                        "$ref": "#/definitions/api.memberPhoneRequest"
+                    }
+                },
+                "start_date": {
+                    "type": "string",
+                    "format": "date"
+                },
+                "status": {
+                    "description": "Status is the lifecycle status the member starts in, active by default.",
+                    "type": "string",
+                    "enum": [
+                        "invited",
+                        "onboarding",
+                        "active"
+                    ]
+                }
+            }
+        },
+        "api.createProjectAssignmentRequest": {
+            "type": "object",
+            "required": [
+                "allocation",
+                "end_date",
+                "member_id",
+                "project_id",
+                "start_date"
+            ],
+            "properties": {
+                "allocation": {
+                    "type": "integer",
+                    "maximum": 100,
+                    "minimum": 1,
+                    "example": 50
+                },
+                "end_date": {
+                    "type": "string",
+                    "format": "date"
+                },
+                "member_id": {
+                    "type": "string"
+                },
+                "project_id": {
+                    "type": "string"
+                },
+                "role": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Backend developer"
+                },
+                "start_date": {
+                    "type": "string",
+                    "format": "date"
+                }
+            }
+        },
+        "api.createProjectRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "billable": {
+                    "description": "Billable is what the time entries of the project are by default, true unless given.",
+                    "type": "boolean"
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Website redesign"
+                }
+            }
+        },
+        "api.createRoomRequest": {
+            "type": "object",
+            "required": [
+                "capacity",
+                "equipment",
+                "location_id",
+                "name"
+            ],
+            "properties": {
+                "capacity": {
+                    "type": "integer",
+                    "maximum": 1000,
+                    "minimum": 1,
+                    "example": 8
+                },
+                "equipment": {
+                    "description": "Equipment lists what the room is equipped with, such as a projector or a whiteboard.",
+                    "type": "array",
+                    "maxItems": 20,
+                    "uniqueItems": true,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "projector",
+                        "whiteboard"
+                    ]
+                },
+                "location_id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Everest"
+                }
+            }
+        },
+        "api.createRoomReservationRequest": {
+            "type": "object",
+            "required": [
+                "ends_at",
+                "member_id",
+                "room_id",
+                "starts_at",
+                "title"
+            ],
+            "properties": {
+                "ends_at": {
+                    "type": "string"
+                },
+                "member_id": {
+                    "type": "string"
+                },
+                "room_id": {
+                    "type": "string"
+                },
+                "rrule": {
+                    "description": "RRule repeats the reservation, as the RRULE of RFC 5545. FREQ can be DAILY, WEEKLY or MONTHLY, with INTERVAL,\nBYDAY for a weekly rule, and either COUNT or UNTIL. Occurrences are expanded in UTC.",
+                    "type": "string",
+                    "maxLength": 200,
+                    "example": "FREQ=WEEKLY;BYDAY=MO,WE;COUNT=10"
+                },
+                "starts_at": {
+                    "description": "StartsAt and EndsAt are those of the first occurrence.",
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 200,
+                    "example": "Weekly planning"
+                }
+            }
+        },
+        "api.createTagRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 50,
+                    "example": "Remote"
+                }
+            }
+        },
+        "api.createTeamRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Platform"
+                },
+                "parent_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.createTimeEntryRequest": {
+            "type": "object",
+            "required": [
+                "member_id",
+                "project_id"
+            ],
+            "properties": {
+                "billable": {
+                    "description": "Billable defaults to what the project is.",
+                    "type": "boolean"
+                },
+                "date": {
+                    "description": "Date and Minutes give the entry as a duration on a day instead.",
+                    "type": "string",
+                    "format": "date"
+                },
+                "ended_at": {
+                    "type": "string"
+                },
+                "member_id": {
+                    "type": "string"
+                },
+                "minutes": {
+                    "type": "integer",
+                    "maximum": 1440,
+                    "minimum": 0,
+                    "example": 90
+                },
+                "note": {
+                    "type": "string",
+                    "maxLength": 500
+                },
+                "project_id": {
+                    "type": "string"
+                },
+                "started_at": {
+                    "description": "StartedAt and EndedAt give the entry as a range of time. An entry without an end is a running timer.",
+                    "type": "string"
+                }
+            }
+        },
+        "api.createUserRequest": {
+            "type": "object",
+            "required": [
+                "email",
+                "first_name",
+                "last_name",
+                "password"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "first_name": {
+                    "type": "string"
+                },
+                "last_name": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string",
+                    "minLength": 8
+                }
+            }
+        },
+        "api.customFieldDefinitionResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "label": {
+                    "type": "string"
+                },
+                "max_length": {
+                    "type": "integer"
+                },
+                "max_value": {
+                    "type": "number"
+                },
+                "min_length": {
+                    "type": "integer"
+                },
+                "min_value": {
+                    "type": "number"
+                },
+                "options": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "pattern": {
+                    "type": "string"
+                },
+                "required": {
+                    "type": "boolean"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.decideLeaveRequestRequest": {
+            "type": "object",
+            "properties": {
+                "note": {
+                    "type": "string",
+                    "maxLength": 500
+                }
+            }
+        },
+        "api.deletedMemberResponse": {
+            "type": "object",
+            "properties": {
+                "avatar_thumbnails": {
+                    "type": "object"
+                },
+                "avatar_url": {
+                    "description": "AvatarURL is the URL of the large avatar thumbnail, and AvatarThumbnails the URLs of every thumbnail by name.",
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "custom_fields": {
+                    "type": "object"
+                },
+                "deleted_at": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "end_date": {
+                    "type": "string",
+                    "format": "date"
+                },
+                "first_name": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "last_name": {
+                    "type": "string"
+                },
+                "local_time": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "manager_id": {
+                    "type": "string"
+                },
+                "purge_at": {
+                    "description": "PurgeAt is the time after which the member is permanently deleted and can no longer be restored.",
+                    "type": "string"
+                },
+                "start_date": {
+                    "type": "string",
+                    "format": "date"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "invited",
+                        "onboarding",
+                        "active",
+                        "on_leave",
+                        "offboarded"
+                    ]
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.tagResponse"
+                    }
+                },
+                "time_zone": {
+                    "description": "TimeZone is the IANA time zone the member works in, and LocalTime the time it is there now.",
+                    "type": "string",
+                    "example": "Asia/Tokyo"
+                }
+            }
+        },
+        "api.deskAvailabilityResponse": {
+            "type": "object",
+            "properties": {
+                "available_slots": {
+                    "description": "AvailableSlots lists the slots the desk can still be booked for.",
+                    "type": "array",
+                    "items": {
+                        "type": "string",
+                        "enum": [
+                            "morning",
+                            "afternoon",
+                            "full_day"
+                        ]
+                    }
+                },
+                "bookings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.deskBookingResponse"
+                    }
+                },
+                "floor_id": {
+                    "type": "string"
+                },
+                "floor_level": {
+                    "type": "integer"
+                },
+                "floor_name": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.deskBookingResponse": {
+            "type": "object",
+            "properties": {
+                "canceled_at": {
+                    "description": "CanceledAt is set once the booking is canceled, which frees the desk.",
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "date": {
+                    "type": "string",
+                    "format": "date"
+                },
+                "desk_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "member_id": {
+                    "type": "string"
+                },
+                "slot": {
+                    "type": "string",
+                    "enum": [
+                        "morning",
+                        "afternoon",
+                        "full_day"
+                    ]
+                }
+            }
+        },
+        "api.deskResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "floor_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.errorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.floorResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "level": {
+                    "type": "integer"
+                },
+                "location_id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.leaveBalanceResponse": {
+            "type": "object",
+            "properties": {
+                "allowance": {
+                    "type": "number"
+                },
+                "carried_over": {
+                    "description": "CarriedOver is what was left of the year before, up to the maximum carry-over of the leave type.",
+                    "type": "number"
+                },
+                "leave_type_id": {
+                    "type": "string"
+                },
+                "leave_type_name": {
+                    "type": "string"
+                },
+                "pending": {
+                    "type": "number"
+                },
+                "remaining": {
+                    "description": "Remaining is allowance + carried_over - used.",
+                    "type": "number"
+                },
+                "used": {
+                    "description": "Used counts the days of the approved leaves, and Pending those of the leaves waiting for a decision.",
+                    "type": "number"
+                },
+                "year": {
+                    "type": "integer"
+                }
+            }
+        },
+        "api.leaveRequestResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "days": {
+                    "description": "Days is how many working days the leave takes.",
+                    "type": "number"
+                },
+                "decided_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "decided_by": {
+                    "type": "string"
+                },
+                "decision_note": {
+                    "type": "string"
+                },
+                "end_date": {
+                    "type": "string",
+                    "format": "date"
+                },
+                "end_half_day": {
+                    "description": "EndHalfDay tells that the leave ends at noon.",
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "leave_type_id": {
+                    "type": "string"
+                },
+                "member_id": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "start_date": {
+                    "type": "string",
+                    "format": "date"
+                },
+                "start_half_day": {
+                    "description": "StartHalfDay tells that the leave starts at noon.",
+                    "type": "boolean"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "pending",
+                        "approved",
+                        "rejected",
+                        "canceled"
+                    ]
+                }
+            }
+        },
+        "api.leaveTypeRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "annual_allowance": {
+                    "description": "AnnualAllowance is how many days a member can take in a year. Leaves of a type without one are not counted.",
+                    "type": "number",
+                    "maximum": 366,
+                    "minimum": 0,
+                    "example": 20
+                },
+                "max_carry_over": {
+                    "description": "MaxCarryOver is how many days left at the end of a year can be taken the year after, none by default.",
+                    "type": "number",
+                    "maximum": 366,
+                    "minimum": 0,
+                    "example": 5
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "vacation"
+                }
+            }
+        },
+        "api.leaveTypeResponse": {
+            "type": "object",
+            "properties": {
+                "annual_allowance": {
+                    "description": "AnnualAllowance is how many days a member can take in a year, null for a leave type that is not counted.",
+                    "type": "number"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "max_carry_over": {
+                    "description": "MaxCarryOver is how many days left at the end of a year can be taken the year after.",
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.listAuditEventsResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.auditEventResponse"
+                    }
+                },
+                "meta": {
+                    "$ref": "#/definitions/api.listMembersResponseMeta"
+                }
+            }
+        },
+        "api.listDeletedMembersResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.deletedMemberResponse"
+                    }
+                },
+                "meta": {
+                    "$ref": "#/definitions/api.listMembersResponseMeta"
+                }
+            }
+        },
+        "api.listLeaveRequestsResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.leaveRequestResponse"
+                    }
+                },
+                "meta": {
+                    "$ref": "#/definitions/api.listMembersResponseMeta"
+                }
+            }
+        },
+        "api.listMemberDuplicatesResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.memberDuplicateGroup"
+                    }
+                }
+            }
+        },
+        "api.listMemberReportsResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.memberReportResponse"
+                    }
+                },
+                "meta": {
+                    "$ref": "#/definitions/api.listMembersResponseMeta"
+                }
+            }
+        },
+        "api.listMembersResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.memberResponse"
+                    }
+                },
+                "meta": {
+                    "$ref": "#/definitions/api.listMembersResponseMeta"
+                }
+            }
+        },
+        "api.listMembersResponseMeta": {
+            "type": "object",
+            "properties": {
+                "page_count": {
+                    "type": "integer"
+                },
+                "page_id": {
+                    "type": "integer"
+                },
+                "page_size": {
+                    "type": "integer"
+                },
+                "total_count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "api.listTeamMembersResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.teamMemberResponse"
+                    }
+                },
+                "meta": {
+                    "$ref": "#/definitions/api.listMembersResponseMeta"
+                }
+            }
+        },
+        "api.listTimeEntriesResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.timeEntryResponse"
+                    }
+                },
+                "meta": {
+                    "$ref": "#/definitions/api.listMembersResponseMeta"
+                }
+            }
+        },
+        "api.locationAvailabilityResponse": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "string",
+                    "format": "date"
+                },
+                "desks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.deskAvailabilityResponse"
+                    }
+                },
+                "location_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.locationRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "max_advance_days": {
+                    "description": "MaxAdvanceDays is how many days ahead a desk can be booked, 14 by default.",
+                    "type": "integer",
+                    "maximum": 365,
+                    "minimum": 0,
+                    "example": 14
+                },
+                "max_bookings_per_week": {
+                    "description": "MaxBookingsPerWeek is how many desk bookings a member can hold within a week, 5 by default.",
+                    "type": "integer",
+                    "maximum": 14,
+                    "minimum": 1,
+                    "example": 5
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Shibuya"
+                }
+            }
+        },
+        "api.locationResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "max_advance_days": {
+                    "description": "MaxAdvanceDays is how many days ahead a desk of the location can be booked.",
+                    "type": "integer"
+                },
+                "max_bookings_per_week": {
+                    "description": "MaxBookingsPerWeek is how many desk bookings a member can hold at the location within a week.",
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.loginUserRequest": {
+            "type": "object",
+            "required": [
+                "email",
+                "password"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string",
+                    "minLength": 8
+                }
+            }
+        },
+        "api.loginUserResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.memberAddressRequest": {
+            "type": "object",
+            "required": [
+                "country",
+                "label"
+            ],
+            "properties": {
+                "city": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "country": {
+                    "type": "string",
+                    "example": "JP"
+                },
+                "label": {
+                    "type": "string",
+                    "enum": [
+                        "work",
+                        "home",
+                        "other"
+                    ]
+                },
+                "postal_code": {
+                    "type": "string",
+                    "maxLength": 20
+                },
+                "region": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "street": {
+                    "type": "string",
+                    "maxLength": 200
+                }
+            }
+        },
+        "api.memberAddressResponse": {
+            "type": "object",
+            "properties": {
+                "city": {
+                    "type": "string"
+                },
+                "country": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "label": {
+                    "type": "string"
+                },
+                "postal_code": {
+                    "type": "string"
+                },
+                "region": {
+                    "type": "string"
+                },
+                "street": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.memberCapacityResponse": {
+            "type": "object",
+            "properties": {
+                "allocated_days": {
+                    "description": "AllocatedDays sums up the allocations of the assignments of the member over the days they are available.",
+                    "type": "number"
+                },
+                "available_days": {
+                    "description": "AvailableDays is what is left of the working days besides the leaves.",
+                    "type": "number"
+                },
+                "first_name": {
+                    "type": "string"
+                },
+                "free_days": {
+                    "description": "FreeDays is what is left of the available days besides the allocated ones, negative when the member is overallocated.",
+                    "type": "number"
+                },
+                "last_name": {
+                    "type": "string"
+                },
+                "leave_days": {
+                    "description": "LeaveDays counts the working days taken by approved leaves.",
+                    "type": "number"
+                },
+                "member_id": {
+                    "type": "string"
+                },
+                "projects": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.projectCapacityResponse"
+                    }
+                },
+                "utilization": {
+                    "description": "Utilization is the share of the available days allocated, in percent, null when the member is not available at all.",
+                    "type": "number"
+                },
+                "working_days": {
+                    "description": "WorkingDays counts the days from Monday to Friday of the range.",
+                    "type": "number"
+                }
+            }
+        },
+        "api.memberDetailResponse": {
+            "type": "object",
+            "properties": {
+                "addresses": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.memberAddressResponse"
+                    }
+                },
+                "avatar_thumbnails": {
+                    "type": "object"
+                },
+                "avatar_url": {
+                    "description": "AvatarURL is the URL of the large avatar thumbnail, and AvatarThumbnails the URLs of every thumbnail by name.",
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "custom_fields": {
+                    "type": "object"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "emails": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.memberEmailResponse"
+                    }
+                },
+                "end_date": {
+                    "type": "string",
+                    "format": "date"
+                },
+                "first_name": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "last_name": {
+                    "type": "string"
+                },
+                "links": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.memberLinkResponse"
+                    }
+                },
+                "local_time": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "manager_id": {
+                    "type": "string"
+                },
+                "phones": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.memberPhoneResponse"
+                    }
+                },
+                "start_date": {
+                    "type": "string",
+                    "format": "date"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "invited",
+                        "onboarding",
+                        "active",
+                        "on_leave",
+                        "offboarded"
+                    ]
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.tagResponse"
+                    }
+                },
+                "time_zone": {
+                    "description": "TimeZone is the IANA time zone the member works in, and LocalTime the time it is there now.",
+                    "type": "string",
+                    "example": "Asia/Tokyo"
+                }
+            }
+        },
+        "api.memberDuplicateGroup": {
+            "type": "object",
+            "properties": {
+                "matches": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.memberDuplicateMatch"
+                    }
+                },
+                "members": {
+                    "description": "Members lists the members of the group, oldest first.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.memberResponse"
+                    }
+                }
+            }
+        },
+        "api.memberDuplicateMatch": {
+            "type": "object",
+            "properties": {
+                "duplicate_id": {
+                    "type": "string"
+                },
+                "member_id": {
+                    "type": "string"
+                },
+                "name_similarity": {
+                    "type": "number"
+                },
+                "reasons": {
+                    "description": "Reasons tells why the members look alike: they share a normalized email, or they have similar names.",
+                    "type": "array",
+                    "items": {
+                        "type": "string",
+                        "enum": [
+                            "email",
+                            "name"
+                        ]
+                    }
+                }
+            }
+        },
+        "api.memberEmailRequest": {
+            "type": "object",
+            "required": [
+                "email",
+                "label"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "format": "email",
+                    "maxLength": 254
+                },
+                "label": {
+                    "type": "string",
+                    "enum": [
+                        "work",
+                        "personal",
+                        "other"
+                    ]
+                },
+                "primary": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "api.memberEmailResponse": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "label": {
+                    "type": "string"
+                },
+                "primary": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "api.memberImportJobResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "dry_run": {
+                    "type": "boolean"
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.memberImportRowError"
+                    }
+                },
+                "finished_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "imported_rows": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "running",
+                        "succeeded",
+                        "failed"
+                    ]
+                },
+                "total_rows": {
+                    "type": "integer"
+                }
+            }
+        },
+        "api.memberImportReport": {
+            "type": "object",
+            "properties": {
+                "dry_run": {
+                    "type": "boolean"
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.memberImportRowError"
+                    }
+                },
+                "imported_rows": {
+                    "type": "integer"
+                },
+                "total_rows": {
+                    "type": "integer"
+                },
+                "valid_rows": {
+                    "type": "integer"
+                }
+            }
+        },
+        "api.memberImportRowError": {
+            "type": "object",
+            "properties": {
+                "field": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "row": {
+                    "description": "Row is the 1-based row number in the file, or 0 for errors which are not caused by a single row.",
+                    "type": "integer"
+                }
+            }
+        },
+        "api.memberLinkRequest": {
+            "type": "object",
+            "required": [
+                "label",
+                "url"
+            ],
+            "properties": {
+                "label": {
+                    "type": "string",
+                    "maxLength": 50,
+                    "example": "github"
+                },
+                "url": {
+                    "type": "string",
+                    "maxLength": 2048
+                }
+            }
+        },
+        "api.memberLinkResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "label": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.memberLocalWindowResponse": {
+            "type": "object",
+            "properties": {
+                "end": {
+                    "type": "string"
+                },
+                "member_id": {
+                    "type": "string"
+                },
+                "start": {
+                    "description": "Start and End are the window in the local time of the member, with its UTC offset.",
+                    "type": "string"
+                },
+                "time_zone": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.memberPhoneRequest": {
+            "type": "object",
+            "required": [
+                "label",
+                "number"
+            ],
+            "properties": {
+                "label": {
+                    "type": "string",
+                    "enum": [
+                        "work",
+                        "mobile",
+                        "home",
+                        "other"
+                    ]
+                },
+                "number": {
+                    "description": "Number is an international number, which is stored in the E.164 format.",
+                    "type": "string",
+                    "maxLength": 32,
+                    "example": "+81 3-1234-5678"
+                }
+            }
+        },
+        "api.memberPhoneResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "label": {
+                    "type": "string"
+                },
+                "number": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.memberReportResponse": {
+            "type": "object",
+            "properties": {
+                "avatar_thumbnails": {
+                    "type": "object"
+                },
+                "avatar_url": {
+                    "description": "AvatarURL is the URL of the large avatar thumbnail, and AvatarThumbnails the URLs of every thumbnail by name.",
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "custom_fields": {
+                    "type": "object"
+                },
+                "depth": {
+                    "description": "Depth is 1 for direct reports, 2 for their reports, and so on.",
+                    "type": "integer"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "end_date": {
+                    "type": "string",
+                    "format": "date"
+                },
+                "first_name": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "last_name": {
+                    "type": "string"
+                },
+                "local_time": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "manager_id": {
+                    "type": "string"
+                },
+                "start_date": {
+                    "type": "string",
+                    "format": "date"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "invited",
+                        "onboarding",
+                        "active",
+                        "on_leave",
+                        "offboarded"
+                    ]
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.tagResponse"
+                    }
+                },
+                "time_zone": {
+                    "description": "TimeZone is the IANA time zone the member works in, and LocalTime the time it is there now.",
+                    "type": "string",
+                    "example": "Asia/Tokyo"
+                }
+            }
+        },
+        "api.memberResponse": {
+            "type": "object",
+            "properties": {
+                "avatar_thumbnails": {
+                    "type": "object"
+                },
+                "avatar_url": {
+                    "description": "AvatarURL is the URL of the large avatar thumbnail, and AvatarThumbnails the URLs of every thumbnail by name.",
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "custom_fields": {
+                    "type": "object"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "end_date": {
+                    "type": "string",
+                    "format": "date"
+                },
+                "first_name": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "last_name": {
+                    "type": "string"
+                },
+                "local_time": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "manager_id": {
+                    "type": "string"
+                },
+                "start_date": {
+                    "type": "string",
+                    "format": "date"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "invited",
+                        "onboarding",
+                        "active",
+                        "on_leave",
+                        "offboarded"
+                    ]
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.tagResponse"
+                    }
+                },
+                "time_zone": {
+                    "description": "TimeZone is the IANA time zone the member works in, and LocalTime the time it is there now.",
+                    "type": "string",
+                    "example": "Asia/Tokyo"
+                }
+            }
+        },
+        "api.memberSearchResult": {
+            "type": "object",
+            "properties": {
+                "member": {
+                    "$ref": "#/definitions/api.memberResponse"
+                },
+                "rank": {
+                    "type": "number"
+                },
+                "snippet": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.memberSkillResponse": {
+            "type": "object",
+            "properties": {
+                "endorsed": {
+                    "description": "Endorsed tells whether the signed-in user is one of them.",
+                    "type": "boolean"
+                },
+                "endorsements": {
+                    "description": "Endorsements counts the users vouching for the skill of the member.",
+                    "type": "integer"
+                },
+                "last_used_on": {
+                    "type": "string",
+                    "format": "date"
+                },
+                "level": {
+                    "description": "Level is the proficiency of the member, from 1 (novice) to 5 (expert).",
+                    "type": "integer"
+                },
+                "skill_id": {
+                    "type": "string"
+                },
+                "skill_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.memberWorkingHoursResponse": {
+            "type": "object",
+            "properties": {
+                "hours": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.workingHoursWindow"
+                    }
+                },
+                "local_time": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "member_id": {
+                    "type": "string"
+                },
+                "time_zone": {
+                    "description": "TimeZone is the IANA time zone the member works in, and LocalTime the time it is there now.",
+                    "type": "string",
+                    "example": "Asia/Tokyo"
+                }
+            }
+        },
+        "api.mergeMembersRequest": {
+            "type": "object",
+            "required": [
+                "member_ids",
+                "survivor_id"
+            ],
+            "properties": {
+                "fields": {
+                    "description": "Fields maps a field to the member whose value the survivor takes. The fields are first_name, last_name, email,\nmanager_id, avatar and custom_fields.\u003ckey\u003e. A field left out keeps the value of the survivor or,\nwhen the survivor has none, takes that of the first member listed with one.",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "member_ids": {
+                    "description": "MemberIDs lists the members merged into the survivor, which are moved to the trash.",
+                    "type": "array",
+                    "maxItems": 10,
+                    "minItems": 1,
+                    "uniqueItems": true,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "survivor_id": {
+                    "description": "SurvivorID is the member the others are merged into.",
+                    "type": "string"
+                }
+            }
+        },
+        "api.moveTeamMemberRequestBody": {
+            "type": "object",
+            "required": [
+                "team_id"
+            ],
+            "properties": {
+                "role": {
+                    "type": "string",
+                    "default": "member",
+                    "enum": [
+                        "lead",
+                        "member"
+                    ]
+                },
+                "team_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.orgChartNode": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "first_name": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "last_name": {
+                    "type": "string"
+                },
+                "reports": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.orgChartNode"
+                    }
+                }
+            }
+        },
+        "api.patchMemberRequestBody": {
+            "type": "object",
+            "properties": {
+                "addresses": {
+                    "type": "array",
+                    "maxItems": 10,
+                    "items": {
+                        "$ref": "#/definitions/api.memberAddressRequest"
+                    }
+                },
+                "custom_fields": {
+                    "description": "CustomFields is merged into the custom fields of the member, where null removes a field.",
+                    "type": "object"
+                },
+                "email": {
+                    "type": "string",
+                    "format": "email",
+                    "x-nullable": true
+                },
+                "emails": {
+                    "description": "Emails may mark one email as primary; otherwise the first one is. The primary email is the email of the member.",
+                    "type": "array",
+                    "maxItems": 10,
+                    "items": {
+                        "$ref": "#/definitions/api.memberEmailRequest"
+                    }
+                },
+                "first_name": {
+                    "type": "string"
+                },
+                "last_name": {
+                    "type": "string"
+                },
+                "links": {
+                    "type": "array",
+                    "maxItems": 20,
+                    "items": {
+                        "$ref": "#/definitions/api.memberLinkRequest"
+                    }
+                },
+                "phones": {
+                    "type": "array",
+                    "maxItems": 10,
+                    "items": {
+                        "$ref": "#/definitions/api.memberPhoneRequest"
+                    }
+                }
+            }
+        },
+        "api.presenceResponse": {
+            "type": "object",
+            "properties": {
+                "checked_in_at": {
+                    "description": "CheckedInAt is the first check-in of the day, or the one the member is still checked in by since an earlier day.",
+                    "type": "string"
+                },
+                "checked_out_at": {
+                    "description": "CheckedOutAt is the last check-out of the day, unless the member is present.",
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "first_name": {
+                    "type": "string"
+                },
+                "last_name": {
+                    "type": "string"
+                },
+                "location_id": {
+                    "type": "string"
+                },
+                "location_name": {
+                    "type": "string"
+                },
+                "member_id": {
+                    "type": "string"
+                },
+                "present": {
+                    "description": "Present tells whether the member is checked in right now.",
+                    "type": "boolean"
+                }
+            }
+        },
+        "api.projectAssignmentRequest": {
+            "type": "object",
+            "required": [
+                "allocation",
+                "end_date",
+                "start_date"
+            ],
+            "properties": {
+                "allocation": {
+                    "type": "integer",
+                    "maximum": 100,
+                    "minimum": 1,
+                    "example": 50
+                },
+                "end_date": {
+                    "type": "string",
+                    "format": "date"
+                },
+                "role": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Backend developer"
+                },
+                "start_date": {
+                    "type": "string",
+                    "format": "date"
+                }
+            }
+        },
+        "api.projectAssignmentResponse": {
+            "type": "object",
+            "properties": {
+                "allocation": {
+                    "description": "Allocation is the share of the working time of the member given to the project on every day of the assignment, in percent.",
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "end_date": {
+                    "type": "string",
+                    "format": "date"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "member_id": {
+                    "type": "string"
+                },
+                "project_id": {
+                    "type": "string"
+                },
+                "role": {
+                    "type": "string"
+                },
+                "start_date": {
+                    "description": "StartDate and EndDate are the first and the last day of the assignment.",
+                    "type": "string",
+                    "format": "date"
+                }
+            }
+        },
+        "api.projectCapacityResponse": {
+            "type": "object",
+            "properties": {
+                "allocated_days": {
+                    "type": "number"
+                },
+                "project_id": {
+                    "type": "string"
+                },
+                "project_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.projectResponse": {
+            "type": "object",
+            "properties": {
+                "archived_at": {
+                    "description": "ArchivedAt is when the project was archived. No time can be tracked against an archived project.",
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "billable": {
+                    "description": "Billable is what the time entries of the project are by default.",
+                    "type": "boolean"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.reassignChecklistTaskRequestBody": {
+            "type": "object",
+            "properties": {
+                "assignee_id": {
+                    "description": "AssigneeID is the user the task is assigned to; null leaves the task unassigned.",
+                    "type": "string"
+                }
+            }
+        },
+        "api.renameTagRequestBody": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 50,
+                    "example": "Remote"
+                }
+            }
+        },
+        "api.replaceChecklistTemplateRequestBody": {
+            "type": "object",
+            "properties": {
+                "tasks": {
+                    "description": "Tasks lists the tasks of the template in the order they are listed in the checklists.",
+                    "type": "array",
+                    "maxItems": 100,
+                    "items": {
+                        "$ref": "#/definitions/api.checklistTemplateTaskRequest"
+                    }
+                }
+            }
+        },
+        "api.roomRequestBody": {
+            "type": "object",
+            "required": [
+                "capacity",
+                "equipment",
+                "name"
+            ],
+            "properties": {
+                "capacity": {
+                    "type": "integer",
+                    "maximum": 1000,
+                    "minimum": 1,
+                    "example": 8
+                },
+                "equipment": {
+                    "description": "Equipment lists what the room is equipped with, such as a projector or a whiteboard.",
+                    "type": "array",
+                    "maxItems": 20,
+                    "uniqueItems": true,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "projector",
+                        "whiteboard"
+                    ]
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Everest"
+                }
+            }
+        },
+        "api.roomReservationOccurrenceResponse": {
+            "type": "object",
+            "properties": {
+                "ends_at": {
+                    "type": "string"
+                },
+                "member_id": {
+                    "type": "string"
+                },
+                "recurring": {
+                    "description": "Recurring tells whether the occurrence is one of a series.",
+                    "type": "boolean"
+                },
+                "reservation_id": {
+                    "type": "string"
+                },
+                "room_id": {
+                    "type": "string"
+                },
+                "starts_at": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.roomReservationResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "ends_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "member_id": {
+                    "type": "string"
+                },
+                "room_id": {
+                    "type": "string"
+                },
+                "rrule": {
+                    "description": "RRule repeats the reservation, as the RRULE of RFC 5545.",
+                    "type": "string",
+                    "example": "FREQ=WEEKLY;BYDAY=MO,WE;COUNT=10"
+                },
+                "series_ends_at": {
+                    "description": "SeriesEndsAt is the end of the last occurrence.",
+                    "type": "string"
+                },
+                "starts_at": {
+                    "description": "StartsAt and EndsAt are those of the first occurrence.",
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.roomResponse": {
+            "type": "object",
+            "properties": {
+                "capacity": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "equipment": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "location_id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.searchMembersResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.memberSearchResult"
+                    }
+                }
+            }
+        },
+        "api.setMemberManagerRequestBody": {
+            "type": "object",
+            "required": [
+                "manager_id"
+            ],
+            "properties": {
+                "manager_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.setMemberSkillRequest": {
+            "type": "object",
+            "required": [
+                "level"
+            ],
+            "properties": {
+                "last_used_on": {
+                    "type": "string",
+                    "format": "date"
+                },
+                "level": {
+                    "type": "integer",
+                    "maximum": 5,
+                    "minimum": 1,
+                    "example": 4
+                }
+            }
+        },
+        "api.setMemberWorkingHoursRequestBody": {
+            "type": "object",
+            "required": [
+                "time_zone"
+            ],
+            "properties": {
+                "hours": {
+                    "description": "Hours are the weekly working windows, in local time. No two windows of a weekday can overlap.",
+                    "type": "array",
+                    "maxItems": 50,
+                    "items": {
+                        "$ref": "#/definitions/api.workingHoursWindow"
+                    }
+                },
+                "time_zone": {
+                    "description": "TimeZone is an IANA time zone of the tz database.",
+                    "type": "string",
+                    "maxLength": 64,
+                    "example": "Asia/Tokyo"
+                }
+            }
+        },
+        "api.skillMatchResponse": {
+            "type": "object",
+            "properties": {
+                "endorsements": {
+                    "type": "integer"
+                },
+                "first_name": {
+                    "type": "string"
+                },
+                "last_name": {
+                    "type": "string"
+                },
+                "matched": {
+                    "description": "Matched counts the requirements the member meets, out of Required.",
+                    "type": "integer"
+                },
+                "member_id": {
+                    "type": "string"
+                },
+                "required": {
+                    "type": "integer"
+                },
+                "score": {
+                    "description": "Score averages the level of the member over the level asked for, rounded to the hundredth.",
+                    "type": "number"
+                },
+                "skills": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.skillMatchSkillResponse"
+                    }
+                }
+            }
+        },
+        "api.skillMatchSkillResponse": {
+            "type": "object",
+            "properties": {
+                "endorsements": {
+                    "type": "integer"
+                },
+                "last_used_on": {
+                    "type": "string",
+                    "format": "date"
+                },
+                "level": {
+                    "type": "integer"
+                },
+                "met": {
+                    "description": "Met tells whether the level of the member reaches the level asked for.",
+                    "type": "boolean"
+                },
+                "skill_id": {
+                    "type": "string"
+                },
+                "skill_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.skillRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 50,
+                    "example": "Go"
+                }
+            }
+        },
+        "api.skillResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.startMemberTimerRequest": {
+            "type": "object",
+            "required": [
+                "project_id"
+            ],
+            "properties": {
+                "billable": {
+                    "description": "Billable defaults to what the project is.",
+                    "type": "boolean"
+                },
+                "note": {
+                    "type": "string",
+                    "maxLength": 500
+                },
+                "project_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.tagResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.teamLeaveResponse": {
+            "type": "object",
+            "properties": {
+                "end_date": {
+                    "type": "string",
+                    "format": "date"
+                },
+                "end_half_day": {
+                    "type": "boolean"
+                },
+                "first_name": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "last_name": {
+                    "type": "string"
+                },
+                "leave_type_id": {
+                    "type": "string"
+                },
+                "leave_type_name": {
+                    "type": "string"
+                },
+                "member_id": {
+                    "type": "string"
+                },
+                "start_date": {
+                    "type": "string",
+                    "format": "date"
+                },
+                "start_half_day": {
+                    "type": "boolean"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "pending",
+                        "approved"
+                    ]
+                }
+            }
+        },
+        "api.teamMemberResponse": {
+            "type": "object",
+            "properties": {
+                "avatar_thumbnails": {
+                    "type": "object"
+                },
+                "avatar_url": {
+                    "description": "AvatarURL is the URL of the large avatar thumbnail, and AvatarThumbnails the URLs of every thumbnail by name.",
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "custom_fields": {
+                    "type": "object"
+                },
                 "email": {
+                    "type": "string"
+                },
+                "end_date": {
                     "type": "string",
-                    "format": "email"
+                    "format": "date"
                 },
                 "first_name": {
                     "type": "string"
                 },
+                "id": {
+                    "type": "string"
+                },
                 "last_name": {
                     "type": "string"
+                },
+                "local_time": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "manager_id": {
+                    "type": "string"
+                },
+                "role": {
+                    "type": "string"
+                },
+                "start_date": {
+                    "type": "string",
+                    "format": "date"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "invited",
+                        "onboarding",
+                        "active",
+                        "on_leave",
+                        "offboarded"
+                    ]
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.tagResponse"
+                    }
+                },
+                "team_id": {
+                    "type": "string"
+                },
+                "time_zone": {
+                    "description": "TimeZone is the IANA time zone the member works in, and LocalTime the time it is there now.",
+                    "type": "string",
+                    "example": "Asia/Tokyo"
                 }
             }
         },
-        "api.createUserRequest": {
+        "api.teamMembershipResponse": {
             "type": "object",
-            "required": [
-                "email",
-                "first_name",
-                "last_name",
-                "password"
-            ],
             "properties": {
-                "email": {
+                "created_at": {
                     "type": "string"
                 },
-                "first_name": {
+                "member_id": {
                     "type": "string"
                 },
-                "last_name": {
+                "role": {
                     "type": "string"
                 },
-                "password": {
-                    "type": "string",
-                    "minLength": 8
+                "team_id": {
+                    "type": "string"
                 }
             }
         },
-        "api.errorResponse": {
+        "api.teamResponse": {
             "type": "object",
             "properties": {
-                "error": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "parent_id": {
                     "type": "string"
                 }
             }
         },
-        "api.listMembersResponse": {
+        "api.timeEntryRequest": {
             "type": "object",
+            "required": [
+                "project_id"
+            ],
             "properties": {
-                "data": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/api.memberResponse"
-                    }
+                "billable": {
+                    "description": "Billable defaults to what the project is.",
+                    "type": "boolean"
                 },
-                "meta": {
-                    "$ref": "#/definitions/api.listMembersResponseMeta"
+                "date": {
+                    "description": "Date and Minutes give the entry as a duration on a day instead.",
+                    "type": "string",
+                    "format": "date"
+                },
+                "ended_at": {
+                    "type": "string"
+                },
+                "minutes": {
+                    "type": "integer",
+                    "maximum": 1440,
+                    "minimum": 0,
+                    "example": 90
+                },
+                "note": {
+                    "type": "string",
+                    "maxLength": 500
+                },
+                "project_id": {
+                    "type": "string"
+                },
+                "started_at": {
+                    "description": "StartedAt and EndedAt give the entry as a range of time. An entry without an end is a running timer.",
+                    "type": "string"
                 }
             }
         },
-        "api.listMembersResponseMeta": {
+        "api.timeEntryResponse": {
             "type": "object",
             "properties": {
-                "page_count": {
+                "billable": {
+                    "type": "boolean"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "date": {
+                    "description": "Date is the day the time is tracked on: the day in UTC an entry with a start and an end started on.",
+                    "type": "string",
+                    "format": "date"
+                },
+                "ended_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "member_id": {
+                    "type": "string"
+                },
+                "minutes": {
+                    "description": "Minutes is the duration of the entry, null while its timer is running.",
                     "type": "integer"
                 },
-                "page_id": {
+                "note": {
+                    "type": "string"
+                },
+                "project_id": {
+                    "type": "string"
+                },
+                "running": {
+                    "description": "Running tells that the timer of the entry has not been stopped yet.",
+                    "type": "boolean"
+                },
+                "started_at": {
+                    "description": "StartedAt and EndedAt are null for an entry given as a duration.",
+                    "type": "string",
+                    "format": "date-time"
+                }
+            }
+        },
+        "api.timeReportRowResponse": {
+            "type": "object",
+            "properties": {
+                "billable_hours": {
+                    "type": "number"
+                },
+                "billable_minutes": {
                     "type": "integer"
                 },
-                "page_size": {
+                "entries": {
                     "type": "integer"
                 },
-                "total_count": {
+                "first_name": {
+                    "type": "string"
+                },
+                "hours": {
+                    "description": "Hours and BillableHours are rounded to the hundredth.",
+                    "type": "number"
+                },
+                "last_name": {
+                    "type": "string"
+                },
+                "member_id": {
+                    "type": "string"
+                },
+                "minutes": {
                     "type": "integer"
+                },
+                "project_id": {
+                    "description": "The fields of what the report is not grouped by are null.",
+                    "type": "string"
+                },
+                "project_name": {
+                    "type": "string"
+                },
+                "week_start": {
+                    "description": "WeekStart is the Monday the week starts on.",
+                    "type": "string",
+                    "format": "date"
                 }
             }
         },
-        "api.loginUserRequest": {
+        "api.timesheetDayResponse": {
             "type": "object",
-            "required": [
-                "email",
-                "password"
-            ],
             "properties": {
-                "email": {
-                    "type": "string"
+                "billable_minutes": {
+                    "type": "integer"
                 },
-                "password": {
+                "date": {
                     "type": "string",
-                    "minLength": 8
+                    "format": "date"
+                },
+                "minutes": {
+                    "type": "integer"
                 }
             }
         },
-        "api.loginUserResponse": {
+        "api.timesheetResponse": {
             "type": "object",
             "properties": {
-                "message": {
+                "billable_minutes": {
+                    "type": "integer"
+                },
+                "days": {
+                    "description": "Days sums up the time entries of every day of the week, from Monday to Sunday. Running timers are not counted.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.timesheetDayResponse"
+                    }
+                },
+                "entries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.timeEntryResponse"
+                    }
+                },
+                "member_id": {
+                    "type": "string"
+                },
+                "minutes": {
+                    "type": "integer"
+                },
+                "submitted": {
+                    "description": "Submitted tells that the timesheet is submitted, which locks the time entries of the week.",
+                    "type": "boolean"
+                },
+                "submitted_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "submitted_by": {
                     "type": "string"
+                },
+                "week_start": {
+                    "description": "WeekStart is the Monday the week starts on.",
+                    "type": "string",
+                    "format": "date"
                 }
             }
         },
-        "api.memberResponse": {
+        "api.transitionMemberRequestBody": {
             "type": "object",
+            "required": [
+                "status"
+            ],
             "properties": {
-                "created_at": {
-                    "type": "string"
+                "effective_date": {
+                    "description": "EffectiveDate is the date the change takes effect, today by default.",
+                    "type": "string",
+                    "format": "date"
                 },
-                "email": {
-                    "type": "string"
+                "reason": {
+                    "type": "string",
+                    "maxLength": 500
                 },
-                "first_name": {
-                    "type": "string"
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "invited",
+                        "onboarding",
+                        "active",
+                        "on_leave",
+                        "offboarded"
+                    ]
+                }
+            }
+        },
+        "api.updateCustomFieldDefinitionRequestBody": {
+            "type": "object",
+            "required": [
+                "label",
+                "options"
+            ],
+            "properties": {
+                "label": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Employee number"
                 },
-                "id": {
-                    "type": "string"
+                "max_length": {
+                    "type": "integer"
                 },
-                "last_name": {
+                "max_value": {
+                    "type": "number"
+                },
+                "min_length": {
+                    "type": "integer"
+                },
+                "min_value": {
+                    "type": "number"
+                },
+                "options": {
+                    "type": "array",
+                    "uniqueItems": true,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "pattern": {
                     "type": "string"
+                },
+                "required": {
+                    "type": "boolean"
                 }
             }
         },
         "api.updateMemberRequestBody": {
             "type": "object",
             "properties": {
+                "addresses": {
+                    "type": "array",
+                    "maxItems": 10,
+                    "items": {
+                        "$ref": "#/definitions/api.memberAddressRequest"
+                    }
+                },
+                "custom_fields": {
+                    "description": "CustomFields is merged into the custom fields of the member, where null removes a field.",
+                    "type": "object"
+                },
                 "email": {
                     "type": "string",
                     "format": "email"
                 },
+                "emails": {
+                    "description": "Emails may mark one email as primary; otherwise the first one is. The primary email is the email of the member.",
+                    "type": "array",
+                    "maxItems": 10,
+                    "items": {
+                        "$ref": "#/definitions/api.memberEmailRequest"
+                    }
+                },
                 "first_name": {
                     "type": "string"
                 },
                 "last_name": {
                     "type": "string"
+                },
+                "links": {
+                    "type": "array",
+                    "maxItems": 20,
+                    "items": {
+                        "$ref": "#/definitions/api.memberLinkRequest"
+                    }
+                },
+                "phones": {
+                    "type": "array",
+                    "maxItems": 10,
+                    "items": {
+                        "$ref": "#/definitions/api.memberPhoneRequest"
+                    }
+                }
+            }
+        },
+        "api.updateProjectRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "archived": {
+                    "description": "Archived archives the project, or brings it back.",
+                    "type": "boolean"
+                },
+                "billable": {
+                    "description": "Billable is what the new time entries of the project are by default. Existing entries are left as they are.",
+                    "type": "boolean"
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Website redesign"
+                }
+            }
+        },
+        "api.updateTeamRequestBody": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Platform"
+                },
+                "parent_id": {
+                    "type": "string"
                 }
             }
         },
@@ -557,10 +9754,81 @@ const docTemplate = `{
                 "id": {
                     "type": "string"
                 },
+                "is_admin": {
+                    "description": "IsAdmin tells that the user can decide on the leave requests and reopen the timesheets of every member.",
+                    "type": "boolean"
+                },
                 "last_name": {
                     "type": "string"
                 }
             }
+        },
+        "api.workingHoursOverlapResponse": {
+            "type": "object",
+            "properties": {
+                "from": {
+                    "description": "From and To are the day the windows are searched in, in UTC.",
+                    "type": "string"
+                },
+                "to": {
+                    "type": "string"
+                },
+                "windows": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.workingWindowResponse"
+                    }
+                }
+            }
+        },
+        "api.workingHoursWindow": {
+            "type": "object",
+            "required": [
+                "end",
+                "start",
+                "weekday"
+            ],
+            "properties": {
+                "end": {
+                    "type": "string",
+                    "example": "17:30"
+                },
+                "start": {
+                    "description": "Start and End are local times as HH:MM. A window ending at midnight ends at 24:00.",
+                    "type": "string",
+                    "example": "09:00"
+                },
+                "weekday": {
+                    "type": "string",
+                    "enum": [
+                        "monday",
+                        "tuesday",
+                        "wednesday",
+                        "thursday",
+                        "friday",
+                        "saturday",
+                        "sunday"
+                    ]
+                }
+            }
+        },
+        "api.workingWindowResponse": {
+            "type": "object",
+            "properties": {
+                "end": {
+                    "type": "string"
+                },
+                "local": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.memberLocalWindowResponse"
+                    }
+                },
+                "start": {
+                    "description": "Start and End are the window in UTC.",
+                    "type": "string"
+                }
+            }
         }
     }
 }`
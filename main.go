@@ -1,15 +1,23 @@
 package main
 
 import (
+	"context"
 	"database/sql"
 	"log"
+	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/ot07/coworker-backend/api"
 	db "github.com/ot07/coworker-backend/db/sqlc"
+	"github.com/ot07/coworker-backend/storage"
 	"github.com/ot07/coworker-backend/util"
+	"github.com/ot07/coworker-backend/worker"
 
 	_ "github.com/lib/pq"
 	_ "github.com/ot07/coworker-backend/docs"
+	// The runtime image has no tz database, which member time zones are loaded from.
+	_ "time/tzdata"
 )
 
 // @title Coworker API
@@ -27,13 +35,51 @@ func main() {
 	}
 
 	store := db.NewStore(conn)
-	server, err := api.NewServer(config, store)
+
+	fileStorage, err := storage.New(config)
+	if err != nil {
+		log.Fatal("cannot create storage:", err)
+	}
+
+	// The server shuts down gracefully on SIGINT and SIGTERM.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	scheduler := worker.NewScheduler(
+		worker.NewPurgeDeletedMembersJob(store, fileStorage, config.MemberTrashRetention, config.MemberPurgeInterval),
+		worker.NewMarkOverdueChecklistTasksJob(store, config.ChecklistOverdueInterval),
+		worker.NewAutoCheckOutAttendancesJob(store, config.AttendanceCheckoutCutoff, config.AttendanceCheckoutInterval),
+	)
+	scheduler.Start(ctx)
+
+	server, err := api.NewServer(config, store, fileStorage)
 	if err != nil {
 		log.Fatal("cannot create server:", err)
 	}
 
-	err = server.Start(config.ServerAddress)
+	interrupted, err := server.FailInterruptedMemberImportJobs(ctx)
 	if err != nil {
-		log.Fatal("cannot start to server:", err)
+		log.Fatal("cannot fail interrupted member import jobs:", err)
+	}
+	if interrupted > 0 {
+		log.Printf("failed %d member import jobs interrupted by the previous shutdown", interrupted)
+	}
+
+	go func() {
+		err := server.Start(config.ServerAddress)
+		if err != nil {
+			log.Fatal("cannot start to server:", err)
+		}
+	}()
+
+	<-ctx.Done()
+	stop()
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), config.ShutdownTimeout)
+	defer cancel()
+
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		log.Print("cannot shut down server gracefully:", err)
 	}
+	scheduler.Wait()
 }
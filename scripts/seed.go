@@ -38,6 +38,31 @@ func truncateAllTables(ctx context.Context, store *db.SQLStore) error {
 		log.Fatal("cannot truncate members table:", err)
 	}
 
+	err = store.TruncateMemberImportJobsTable(ctx)
+	if err != nil {
+		log.Fatal("cannot truncate member import jobs table:", err)
+	}
+
+	err = store.TruncateTeamsTable(ctx)
+	if err != nil {
+		log.Fatal("cannot truncate teams table:", err)
+	}
+
+	err = store.TruncateTagsTable(ctx)
+	if err != nil {
+		log.Fatal("cannot truncate tags table:", err)
+	}
+
+	err = store.TruncateCustomFieldDefinitionsTable(ctx)
+	if err != nil {
+		log.Fatal("cannot truncate custom field definitions table:", err)
+	}
+
+	err = store.TruncateAuditEventsTable(ctx)
+	if err != nil {
+		log.Fatal("cannot truncate audit events table:", err)
+	}
+
 	err = store.TruncateSessionsTable(ctx)
 	if err != nil {
 		log.Fatal("cannot truncate sessions table:", err)
@@ -0,0 +1,32 @@
+package storage
+
+import (
+	"context"
+	"log"
+)
+
+// AvatarThumbnails lists the square thumbnails stored for every avatar, with their size in pixels.
+var AvatarThumbnails = []struct {
+	Name string
+	Size int
+}{
+	{"small", 64},
+	{"medium", 128},
+	{"large", 256},
+}
+
+// AvatarThumbnailKey returns the key of a thumbnail of the avatar stored under prefix.
+func AvatarThumbnailKey(prefix string, name string) string {
+	return prefix + "/" + name + ".jpg"
+}
+
+// DeleteAvatarThumbnails removes the first count thumbnails of the avatar stored under prefix.
+// The avatar is no longer referenced by then, so failures are only logged.
+func DeleteAvatarThumbnails(ctx context.Context, storage Storage, prefix string, count int) {
+	for _, thumbnail := range AvatarThumbnails[:count] {
+		key := AvatarThumbnailKey(prefix, thumbnail.Name)
+		if err := storage.Delete(ctx, key); err != nil {
+			log.Printf("cannot delete avatar thumbnail %s: %v", key, err)
+		}
+	}
+}
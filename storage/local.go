@@ -0,0 +1,87 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// LocalStorage stores files in a directory of the local filesystem, served by the app itself.
+type LocalStorage struct {
+	dir       string
+	publicURL string
+}
+
+// NewLocalStorage creates a LocalStorage storing files under dir, whose contents are served at publicURL.
+func NewLocalStorage(dir string, publicURL string) *LocalStorage {
+	return &LocalStorage{
+		dir:       dir,
+		publicURL: publicURL,
+	}
+}
+
+// Dir returns the directory the files are stored in.
+func (storage *LocalStorage) Dir() string {
+	return storage.dir
+}
+
+// Put writes the file through a temporary file, so that readers never see a partially written file.
+func (storage *LocalStorage) Put(ctx context.Context, key string, contentType string, data []byte) error {
+	if err := validateKey(key); err != nil {
+		return err
+	}
+
+	path := storage.path(key)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	file, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(file.Name(), 0o644); err != nil {
+		return err
+	}
+	return os.Rename(file.Name(), path)
+}
+
+func (storage *LocalStorage) Delete(ctx context.Context, key string) error {
+	if err := validateKey(key); err != nil {
+		return err
+	}
+
+	path := storage.path(key)
+	err := os.Remove(path)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	// Directories emptied by the removal are removed too, up to the storage directory.
+	// Removing a directory that is not empty fails, which ends the walk.
+	for dir := filepath.Dir(path); dir != filepath.Clean(storage.dir); dir = filepath.Dir(dir) {
+		if os.Remove(dir) != nil {
+			break
+		}
+	}
+	return nil
+}
+
+func (storage *LocalStorage) URL(key string) string {
+	return joinURL(storage.publicURL, key)
+}
+
+func (storage *LocalStorage) path(key string) string {
+	return filepath.Join(storage.dir, filepath.FromSlash(key))
+}
@@ -0,0 +1,62 @@
+package storage
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalStorage(t *testing.T) {
+	dir := t.TempDir()
+	storage := NewLocalStorage(dir, "http://localhost:8080/uploads/")
+
+	key := "avatars/1/64.jpg"
+	err := storage.Put(context.Background(), key, "image/jpeg", []byte("first"))
+	require.NoError(t, err)
+
+	// Put replaces the file already stored under the key.
+	err = storage.Put(context.Background(), key, "image/jpeg", []byte("second"))
+	require.NoError(t, err)
+
+	data, err := os.ReadFile(filepath.Join(dir, "avatars", "1", "64.jpg"))
+	require.NoError(t, err)
+	require.Equal(t, "second", string(data))
+
+	entries, err := os.ReadDir(filepath.Join(dir, "avatars", "1"))
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+
+	require.Equal(t, "http://localhost:8080/uploads/avatars/1/64.jpg", storage.URL(key))
+
+	err = storage.Delete(context.Background(), key)
+	require.NoError(t, err)
+	_, err = os.Stat(filepath.Join(dir, "avatars", "1", "64.jpg"))
+	require.True(t, os.IsNotExist(err))
+
+	// The directories left empty are removed, but not the storage directory.
+	_, err = os.Stat(filepath.Join(dir, "avatars"))
+	require.True(t, os.IsNotExist(err))
+	_, err = os.Stat(dir)
+	require.NoError(t, err)
+
+	// Deleting a missing file is not an error.
+	err = storage.Delete(context.Background(), key)
+	require.NoError(t, err)
+}
+
+func TestLocalStorageInvalidKey(t *testing.T) {
+	dir := t.TempDir()
+	storage := NewLocalStorage(filepath.Join(dir, "uploads"), "http://localhost:8080/uploads")
+
+	err := storage.Put(context.Background(), "../escaped.jpg", "image/jpeg", []byte("data"))
+	require.ErrorIs(t, err, ErrInvalidKey)
+
+	_, err = os.Stat(filepath.Join(dir, "escaped.jpg"))
+	require.True(t, os.IsNotExist(err))
+
+	err = storage.Delete(context.Background(), "../escaped.jpg")
+	require.ErrorIs(t, err, ErrInvalidKey)
+}
@@ -0,0 +1,68 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ot07/coworker-backend/util"
+)
+
+// Backends selectable through the STORAGE_BACKEND setting.
+const (
+	BackendLocal = "local"
+	BackendS3    = "s3"
+)
+
+var (
+	ErrInvalidKey = errors.New("invalid storage key")
+)
+
+// Storage stores files under keys such as "avatars/<member_id>/<id>/64.jpg"
+// and tells the public URLs they are served at.
+type Storage interface {
+	// Put stores data under key, replacing any file already stored there.
+	Put(ctx context.Context, key string, contentType string, data []byte) error
+	// Delete removes the file stored under key. Deleting a missing file is not an error.
+	Delete(ctx context.Context, key string) error
+	// URL returns the public URL of key.
+	URL(key string) string
+}
+
+// New creates the storage selected by the configuration.
+func New(config util.Config) (Storage, error) {
+	switch config.StorageBackend {
+	case BackendLocal, "":
+		return NewLocalStorage(config.StorageLocalDir, config.StoragePublicURL), nil
+	case BackendS3:
+		return NewS3Storage(S3Config{
+			Endpoint:        config.S3Endpoint,
+			Region:          config.S3Region,
+			Bucket:          config.S3Bucket,
+			AccessKeyID:     config.S3AccessKeyID,
+			SecretAccessKey: config.S3SecretAccessKey,
+			PublicURL:       config.StoragePublicURL,
+		})
+	default:
+		return nil, fmt.Errorf("unknown storage backend %q", config.StorageBackend)
+	}
+}
+
+// validateKey makes sure a key is a relative slash-separated path that cannot escape the storage root.
+func validateKey(key string) error {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, "\\") {
+		return ErrInvalidKey
+	}
+	for _, segment := range strings.Split(key, "/") {
+		if segment == "" || segment == "." || segment == ".." {
+			return ErrInvalidKey
+		}
+	}
+	return nil
+}
+
+// joinURL appends key to a base URL.
+func joinURL(baseURL string, key string) string {
+	return strings.TrimRight(baseURL, "/") + "/" + key
+}
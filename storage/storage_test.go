@@ -0,0 +1,38 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/ot07/coworker-backend/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateKey(t *testing.T) {
+	for _, key := range []string{"avatar.jpg", "avatars/1/64.jpg"} {
+		require.NoError(t, validateKey(key), key)
+	}
+	for _, key := range []string{"", "/avatars/64.jpg", "avatars//64.jpg", "avatars/../64.jpg", "./64.jpg", "avatars/", `avatars\64.jpg`} {
+		require.ErrorIs(t, validateKey(key), ErrInvalidKey, key)
+	}
+}
+
+func TestNew(t *testing.T) {
+	storage, err := New(util.Config{StorageBackend: BackendLocal, StorageLocalDir: t.TempDir()})
+	require.NoError(t, err)
+	require.IsType(t, &LocalStorage{}, storage)
+
+	storage, err = New(util.Config{
+		StorageBackend: BackendS3,
+		S3Endpoint:     "http://localhost:9000",
+		S3Region:       "us-east-1",
+		S3Bucket:       "coworker",
+	})
+	require.NoError(t, err)
+	require.IsType(t, &S3Storage{}, storage)
+
+	_, err = New(util.Config{StorageBackend: BackendS3})
+	require.Error(t, err)
+
+	_, err = New(util.Config{StorageBackend: "ftp"})
+	require.Error(t, err)
+}
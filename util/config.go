@@ -9,11 +9,31 @@ import (
 // Config stores all configuration of the application.
 // The values are read by viper from a config file or environment variables.
 type Config struct {
-	DBDriver             string        `mapstructure:"DB_DRIVER"`
-	DBSource             string        `mapstructure:"DB_SOURCE"`
-	ServerAddress        string        `mapstructure:"SERVER_ADDRESS"`
-	TokenSymmetricKey    string        `mapstructure:"TOKEN_SYMMETRIC_KEY"`
-	SessionTokenDuration time.Duration `mapstructure:"SESSION_TOKEN_DURATION"`
+	DBDriver                   string        `mapstructure:"DB_DRIVER"`
+	DBSource                   string        `mapstructure:"DB_SOURCE"`
+	ServerAddress              string        `mapstructure:"SERVER_ADDRESS"`
+	TokenSymmetricKey          string        `mapstructure:"TOKEN_SYMMETRIC_KEY"`
+	SessionTokenDuration       time.Duration `mapstructure:"SESSION_TOKEN_DURATION"`
+	MemberImportAsyncThreshold int           `mapstructure:"MEMBER_IMPORT_ASYNC_THRESHOLD"`
+	MemberTrashRetention       time.Duration `mapstructure:"MEMBER_TRASH_RETENTION"`
+	MemberPurgeInterval        time.Duration `mapstructure:"MEMBER_PURGE_INTERVAL"`
+	MemberUpdateRequireIfMatch bool          `mapstructure:"MEMBER_UPDATE_REQUIRE_IF_MATCH"`
+	ChecklistOverdueInterval   time.Duration `mapstructure:"CHECKLIST_OVERDUE_INTERVAL"`
+	// AttendanceCheckoutCutoff is the time of day, after midnight UTC, at which members still checked in are checked out.
+	AttendanceCheckoutCutoff   time.Duration `mapstructure:"ATTENDANCE_CHECKOUT_CUTOFF"`
+	AttendanceCheckoutInterval time.Duration `mapstructure:"ATTENDANCE_CHECKOUT_INTERVAL"`
+	StorageBackend             string        `mapstructure:"STORAGE_BACKEND"`
+	StorageLocalDir            string        `mapstructure:"STORAGE_LOCAL_DIR"`
+	StoragePublicURL           string        `mapstructure:"STORAGE_PUBLIC_URL"`
+	S3Endpoint                 string        `mapstructure:"S3_ENDPOINT"`
+	S3Region                   string        `mapstructure:"S3_REGION"`
+	S3Bucket                   string        `mapstructure:"S3_BUCKET"`
+	S3AccessKeyID              string        `mapstructure:"S3_ACCESS_KEY_ID"`
+	S3SecretAccessKey          string        `mapstructure:"S3_SECRET_ACCESS_KEY"`
+	// ProjectAllocationLimit is how much of their working time, in percent, a member can be allocated to projects on a day.
+	ProjectAllocationLimit int `mapstructure:"PROJECT_ALLOCATION_LIMIT"`
+	// ShutdownTimeout is how long background jobs are waited for on shutdown before they are canceled.
+	ShutdownTimeout time.Duration `mapstructure:"SHUTDOWN_TIMEOUT"`
 }
 
 // LoadConfig reads configuration from file or environment variables.
@@ -0,0 +1,44 @@
+package contentline
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// MaxLineLength is the length in octets past which content lines are folded.
+const MaxLineLength = 75
+
+// textEscaper escapes the characters that have a meaning in a content line.
+var textEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	";", `\;`,
+	",", `\,`,
+	"\r\n", `\n`,
+	"\n", `\n`,
+)
+
+// EscapeText escapes a TEXT value of an iCalendar (RFC 5545, section 3.3.11) or vCard (RFC 6350, section 3.4) property.
+func EscapeText(text string) string {
+	return textEscaper.Replace(text)
+}
+
+// FoldLine terminates a content line with CRLF, folding it so that no line is longer than MaxLineLength octets.
+// Lines are folded between characters, never within a UTF-8 sequence (RFC 5545, section 3.1; RFC 6350, section 3.2).
+func FoldLine(line string) string {
+	var sb strings.Builder
+	limit := MaxLineLength
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		sb.WriteString(line[:cut])
+		sb.WriteString("\r\n ")
+		line = line[cut:]
+		// A continuation line starts with a space, which counts towards its length.
+		limit = MaxLineLength - 1
+	}
+	sb.WriteString(line)
+	sb.WriteString("\r\n")
+	return sb.String()
+}
@@ -0,0 +1,23 @@
+package contentline
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEscapeText(t *testing.T) {
+	require.Equal(t, `Yamada\, Taro\; Sales\\Tokyo\nSecond line`, EscapeText("Yamada, Taro; Sales\\Tokyo\r\nSecond line"))
+}
+
+func TestFoldLine(t *testing.T) {
+	require.Equal(t, "FN:Taro Yamada\r\n", FoldLine("FN:Taro Yamada"))
+
+	long := "NOTE:" + strings.Repeat("山", 30)
+	folded := FoldLine(long)
+	for _, line := range strings.Split(strings.TrimSuffix(folded, "\r\n"), "\r\n") {
+		require.LessOrEqual(t, len(line), MaxLineLength)
+	}
+	require.Equal(t, long, strings.ReplaceAll(strings.TrimSuffix(folded, "\r\n"), "\r\n ", ""))
+}
@@ -0,0 +1,194 @@
+package util
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/draw"
+)
+
+// EXIF orientations, telling how a JPEG photo has to be transformed to be displayed upright.
+const (
+	OrientationNormal     = 1
+	OrientationFlipH      = 2
+	OrientationRotate180  = 3
+	OrientationFlipV      = 4
+	OrientationTranspose  = 5
+	OrientationRotate90   = 6
+	OrientationTransverse = 7
+	OrientationRotate270  = 8
+)
+
+const exifOrientationTag = 0x0112
+
+// JPEGOrientation reads the EXIF orientation of a JPEG image,
+// and returns OrientationNormal when the image has none or is not a JPEG.
+func JPEGOrientation(data []byte) int {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return OrientationNormal
+	}
+
+	for offset := 2; offset+4 <= len(data); {
+		if data[offset] != 0xFF {
+			return OrientationNormal
+		}
+		marker := data[offset+1]
+		// The image data starts at the start of scan marker, after which no metadata follows.
+		if marker == 0xDA || marker == 0xD9 {
+			return OrientationNormal
+		}
+		length := int(binary.BigEndian.Uint16(data[offset+2:]))
+		if length < 2 || offset+2+length > len(data) {
+			return OrientationNormal
+		}
+		segment := data[offset+4 : offset+2+length]
+		if marker == 0xE1 && bytes.HasPrefix(segment, []byte("Exif\x00\x00")) {
+			return exifOrientation(segment[6:])
+		}
+		offset += 2 + length
+	}
+	return OrientationNormal
+}
+
+// exifOrientation looks for the orientation tag within the first IFD of a TIFF structure.
+func exifOrientation(tiff []byte) int {
+	if len(tiff) < 8 {
+		return OrientationNormal
+	}
+
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return OrientationNormal
+	}
+
+	ifd := int(order.Uint32(tiff[4:]))
+	if ifd+2 > len(tiff) {
+		return OrientationNormal
+	}
+	count := int(order.Uint16(tiff[ifd:]))
+	for i := 0; i < count; i++ {
+		entry := ifd + 2 + i*12
+		if entry+12 > len(tiff) {
+			return OrientationNormal
+		}
+		if order.Uint16(tiff[entry:]) == exifOrientationTag {
+			orientation := int(order.Uint16(tiff[entry+8:]))
+			if orientation < OrientationNormal || orientation > OrientationRotate270 {
+				return OrientationNormal
+			}
+			return orientation
+		}
+	}
+	return OrientationNormal
+}
+
+// toRGBA converts an image to an RGBA image whose bounds start at the origin.
+func toRGBA(img image.Image) *image.RGBA {
+	bounds := img.Bounds()
+	rgba := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(rgba, rgba.Bounds(), img, bounds.Min, draw.Src)
+	return rgba
+}
+
+// Orient transforms an image according to an EXIF orientation so that it is displayed upright.
+func Orient(img image.Image, orientation int) image.Image {
+	if orientation <= OrientationNormal || orientation > OrientationRotate270 {
+		return img
+	}
+
+	src := toRGBA(img)
+	w, h := src.Rect.Dx(), src.Rect.Dy()
+	dw, dh := w, h
+	if orientation >= OrientationTranspose {
+		dw, dh = h, w
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	for y := 0; y < dh; y++ {
+		for x := 0; x < dw; x++ {
+			var sx, sy int
+			switch orientation {
+			case OrientationFlipH:
+				sx, sy = w-1-x, y
+			case OrientationRotate180:
+				sx, sy = w-1-x, h-1-y
+			case OrientationFlipV:
+				sx, sy = x, h-1-y
+			case OrientationTranspose:
+				sx, sy = y, x
+			case OrientationRotate90:
+				sx, sy = y, h-1-x
+			case OrientationTransverse:
+				sx, sy = w-1-y, h-1-x
+			case OrientationRotate270:
+				sx, sy = w-1-y, x
+			}
+			copy(dst.Pix[dst.PixOffset(x, y):dst.PixOffset(x, y)+4], src.Pix[src.PixOffset(sx, sy):src.PixOffset(sx, sy)+4])
+		}
+	}
+	return dst
+}
+
+// Thumbnail crops the center square of an image and scales it to size x size pixels.
+// Every pixel of the thumbnail is the average of the pixels it covers, and transparent
+// areas are filled with white, so that the thumbnail can be encoded in any format.
+func Thumbnail(img image.Image, size int) *image.RGBA {
+	src := toRGBA(img)
+	w, h := src.Rect.Dx(), src.Rect.Dy()
+	side := w
+	if h < side {
+		side = h
+	}
+	left, top := (w-side)/2, (h-side)/2
+
+	dst := image.NewRGBA(image.Rect(0, 0, size, size))
+	if side == 0 {
+		draw.Draw(dst, dst.Bounds(), image.White, image.Point{}, draw.Src)
+		return dst
+	}
+
+	for dy := 0; dy < size; dy++ {
+		y0, y1 := thumbnailSpan(dy, size, side)
+		for dx := 0; dx < size; dx++ {
+			x0, x1 := thumbnailSpan(dx, size, side)
+
+			var r, g, b, a, n int
+			for sy := top + y0; sy < top+y1; sy++ {
+				offset := src.PixOffset(left+x0, sy)
+				for sx := x0; sx < x1; sx++ {
+					r += int(src.Pix[offset])
+					g += int(src.Pix[offset+1])
+					b += int(src.Pix[offset+2])
+					a += int(src.Pix[offset+3])
+					n++
+					offset += 4
+				}
+			}
+
+			// The colors of an RGBA image are premultiplied by alpha, so blending over white only adds the missing coverage.
+			white := 255 - a/n
+			offset := dst.PixOffset(dx, dy)
+			dst.Pix[offset] = uint8(r/n + white)
+			dst.Pix[offset+1] = uint8(g/n + white)
+			dst.Pix[offset+2] = uint8(b/n + white)
+			dst.Pix[offset+3] = 255
+		}
+	}
+	return dst
+}
+
+// thumbnailSpan returns the range of source pixels covered by the pixel i of a thumbnail,
+// covering at least one pixel when the thumbnail is larger than the source.
+func thumbnailSpan(i int, size int, side int) (int, int) {
+	start := i * side / size
+	end := (i + 1) * side / size
+	if end <= start {
+		end = start + 1
+	}
+	return start, end
+}
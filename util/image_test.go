@@ -0,0 +1,137 @@
+package util
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	red  = color.RGBA{R: 255, A: 255}
+	blue = color.RGBA{B: 255, A: 255}
+)
+
+// jpegWithOrientation encodes an image as a JPEG carrying an EXIF orientation in the given byte order.
+func jpegWithOrientation(t *testing.T, img image.Image, orientation int, order binary.ByteOrder) []byte {
+	var buf bytes.Buffer
+	err := jpeg.Encode(&buf, img, nil)
+	require.NoError(t, err)
+
+	var tiff bytes.Buffer
+	if order == binary.LittleEndian {
+		tiff.WriteString("II")
+	} else {
+		tiff.WriteString("MM")
+	}
+	binary.Write(&tiff, order, uint16(42))
+	binary.Write(&tiff, order, uint32(8))
+	binary.Write(&tiff, order, uint16(1))
+	binary.Write(&tiff, order, uint16(exifOrientationTag))
+	binary.Write(&tiff, order, uint16(3))
+	binary.Write(&tiff, order, uint32(1))
+	binary.Write(&tiff, order, uint16(orientation))
+	binary.Write(&tiff, order, uint16(0))
+	binary.Write(&tiff, order, uint32(0))
+
+	segment := append([]byte("Exif\x00\x00"), tiff.Bytes()...)
+	app1 := []byte{0xFF, 0xE1, 0, 0}
+	binary.BigEndian.PutUint16(app1[2:], uint16(len(segment)+2))
+
+	data := buf.Bytes()
+	return append(append(append([]byte{}, data[:2]...), append(app1, segment...)...), data[2:]...)
+}
+
+func TestJPEGOrientation(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 4, 4))
+
+	for _, order := range []binary.ByteOrder{binary.LittleEndian, binary.BigEndian} {
+		data := jpegWithOrientation(t, img, OrientationRotate90, order)
+		require.Equal(t, OrientationRotate90, JPEGOrientation(data))
+
+		// The image is still a valid JPEG.
+		_, err := jpeg.Decode(bytes.NewReader(data))
+		require.NoError(t, err)
+	}
+
+	var buf bytes.Buffer
+	err := jpeg.Encode(&buf, img, nil)
+	require.NoError(t, err)
+	require.Equal(t, OrientationNormal, JPEGOrientation(buf.Bytes()))
+
+	require.Equal(t, OrientationNormal, JPEGOrientation([]byte("not a jpeg")))
+	require.Equal(t, OrientationNormal, JPEGOrientation([]byte{0xFF, 0xD8, 0xFF, 0xE1, 0xFF}))
+}
+
+func TestOrient(t *testing.T) {
+	// A red pixel on the left of a blue one.
+	img := image.NewRGBA(image.Rect(0, 0, 2, 1))
+	img.Set(0, 0, red)
+	img.Set(1, 0, blue)
+
+	testCases := []struct {
+		orientation int
+		bounds      image.Rectangle
+		pixels      map[image.Point]color.RGBA
+	}{
+		{OrientationNormal, image.Rect(0, 0, 2, 1), map[image.Point]color.RGBA{{0, 0}: red, {1, 0}: blue}},
+		{OrientationFlipH, image.Rect(0, 0, 2, 1), map[image.Point]color.RGBA{{0, 0}: blue, {1, 0}: red}},
+		{OrientationRotate180, image.Rect(0, 0, 2, 1), map[image.Point]color.RGBA{{0, 0}: blue, {1, 0}: red}},
+		{OrientationFlipV, image.Rect(0, 0, 2, 1), map[image.Point]color.RGBA{{0, 0}: red, {1, 0}: blue}},
+		{OrientationTranspose, image.Rect(0, 0, 1, 2), map[image.Point]color.RGBA{{0, 0}: red, {0, 1}: blue}},
+		{OrientationRotate90, image.Rect(0, 0, 1, 2), map[image.Point]color.RGBA{{0, 0}: red, {0, 1}: blue}},
+		{OrientationTransverse, image.Rect(0, 0, 1, 2), map[image.Point]color.RGBA{{0, 0}: blue, {0, 1}: red}},
+		{OrientationRotate270, image.Rect(0, 0, 1, 2), map[image.Point]color.RGBA{{0, 0}: blue, {0, 1}: red}},
+	}
+
+	for _, tc := range testCases {
+		oriented := Orient(img, tc.orientation)
+		require.Equal(t, tc.bounds, oriented.Bounds(), "orientation %d", tc.orientation)
+		for point, want := range tc.pixels {
+			require.Equal(t, want, color.RGBAModel.Convert(oriented.At(point.X, point.Y)), "orientation %d at %v", tc.orientation, point)
+		}
+	}
+}
+
+func TestThumbnail(t *testing.T) {
+	// The center square of the image is a red column next to a blue one.
+	img := image.NewRGBA(image.Rect(0, 0, 4, 2))
+	for y := 0; y < 2; y++ {
+		img.Set(0, y, color.Black)
+		img.Set(1, y, red)
+		img.Set(2, y, blue)
+		img.Set(3, y, color.Black)
+	}
+
+	thumbnail := Thumbnail(img, 2)
+	require.Equal(t, image.Rect(0, 0, 2, 2), thumbnail.Bounds())
+	require.Equal(t, red, thumbnail.RGBAAt(0, 1))
+	require.Equal(t, blue, thumbnail.RGBAAt(1, 0))
+
+	thumbnail = Thumbnail(img, 1)
+	require.Equal(t, color.RGBA{R: 127, B: 127, A: 255}, thumbnail.RGBAAt(0, 0))
+
+	thumbnail = Thumbnail(img, 8)
+	require.Equal(t, image.Rect(0, 0, 8, 8), thumbnail.Bounds())
+	require.Equal(t, red, thumbnail.RGBAAt(3, 7))
+	require.Equal(t, blue, thumbnail.RGBAAt(4, 0))
+}
+
+func TestThumbnailTransparent(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 3, 3))
+	img.Set(1, 1, color.NRGBA{R: 255, A: 128})
+
+	thumbnail := Thumbnail(img, 3)
+	require.Equal(t, color.RGBA{R: 255, G: 255, B: 255, A: 255}, thumbnail.RGBAAt(0, 0))
+
+	// Half transparent red over white is pink.
+	pixel := thumbnail.RGBAAt(1, 1)
+	require.Equal(t, uint8(255), pixel.R)
+	require.InDelta(t, 127, int(pixel.G), 1)
+	require.Equal(t, pixel.G, pixel.B)
+	require.Equal(t, uint8(255), pixel.A)
+}
@@ -0,0 +1,42 @@
+package util
+
+import (
+	"errors"
+	"strings"
+)
+
+// ErrInvalidPhoneNumber is returned for a phone number that cannot be written in the E.164 format.
+var ErrInvalidPhoneNumber = errors.New("phone number must be in international format, starting with + and the country code")
+
+// NormalizePhoneNumber converts an international phone number to the E.164 format, such as +81312345678.
+// The number may be written with spaces, dots, dashes, slashes and parentheses, and may start with 00 instead of +.
+// Without a country code a number is ambiguous, so national numbers are rejected.
+func NormalizePhoneNumber(number string) (string, error) {
+	number = strings.TrimSpace(number)
+	switch {
+	case strings.HasPrefix(number, "+"):
+		number = number[1:]
+	case strings.HasPrefix(number, "00"):
+		number = number[2:]
+	default:
+		return "", ErrInvalidPhoneNumber
+	}
+
+	var digits strings.Builder
+	for _, r := range number {
+		switch {
+		case r >= '0' && r <= '9':
+			digits.WriteRune(r)
+		case strings.ContainsRune(" .-/()", r):
+		default:
+			return "", ErrInvalidPhoneNumber
+		}
+	}
+
+	// E.164 numbers have at most 15 digits, and country codes never start with 0.
+	normalized := digits.String()
+	if len(normalized) < 8 || len(normalized) > 15 || normalized[0] == '0' {
+		return "", ErrInvalidPhoneNumber
+	}
+	return "+" + normalized, nil
+}
@@ -0,0 +1,43 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizePhoneNumber(t *testing.T) {
+	testCases := []struct {
+		number string
+		want   string
+	}{
+		{"+81312345678", "+81312345678"},
+		{"+81 3-1234-5678", "+81312345678"},
+		{" +1 (415) 555.2671 ", "+14155552671"},
+		{"0044 20 7946 0958", "+442079460958"},
+		{"+49 30/1234567", "+49301234567"},
+	}
+
+	for _, tc := range testCases {
+		got, err := NormalizePhoneNumber(tc.number)
+		require.NoError(t, err, tc.number)
+		require.Equal(t, tc.want, got)
+	}
+}
+
+func TestNormalizePhoneNumberInvalid(t *testing.T) {
+	numbers := []string{
+		"",
+		"03-1234-5678",
+		"+0 312345678",
+		"+81 3 1234 5678 ext 1",
+		"+1234567",
+		"+1234567890123456",
+		"+81 3-1234-5678#",
+	}
+
+	for _, number := range numbers {
+		_, err := NormalizePhoneNumber(number)
+		require.ErrorIs(t, err, ErrInvalidPhoneNumber, number)
+	}
+}
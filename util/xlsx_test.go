@@ -0,0 +1,80 @@
+package util
+
+import (
+	"archive/zip"
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestXLSX(t *testing.T, parts map[string]string) *bytes.Reader {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range parts {
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+	return bytes.NewReader(buf.Bytes())
+}
+
+func TestReadXLSX(t *testing.T) {
+	r := newTestXLSX(t, map[string]string{
+		"xl/workbook.xml": `<?xml version="1.0" encoding="UTF-8"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">
+  <sheets><sheet name="Members" sheetId="1" r:id="rId1"/></sheets>
+</workbook>`,
+		"xl/_rels/workbook.xml.rels": `<?xml version="1.0" encoding="UTF-8"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+  <Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>
+</Relationships>`,
+		"xl/sharedStrings.xml": `<?xml version="1.0" encoding="UTF-8"?>
+<sst xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">
+  <si><t>first_name</t></si>
+  <si><t>last_name</t></si>
+  <si><r><t>山</t></r><r><t>田</t></r></si>
+</sst>`,
+		"xl/worksheets/sheet1.xml": `<?xml version="1.0" encoding="UTF-8"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">
+  <sheetData>
+    <row r="1"><c r="A1" t="s"><v>0</v></c><c r="B1" t="s"><v>1</v></c><c r="C1" t="inlineStr"><is><t>email</t></is></c></row>
+    <row r="3"><c r="B3" t="s"><v>2</v></c><c r="C3"><v>42</v></c></row>
+  </sheetData>
+</worksheet>`,
+	})
+
+	records, err := ReadXLSX(r, r.Size())
+	require.NoError(t, err)
+	require.Equal(t, [][]string{
+		{"first_name", "last_name", "email"},
+		nil,
+		{"", "山田", "42"},
+	}, records)
+}
+
+func TestReadXLSXNotZip(t *testing.T) {
+	r := bytes.NewReader([]byte("first_name,last_name\n"))
+	_, err := ReadXLSX(r, r.Size())
+	require.Error(t, err)
+}
+
+func TestXLSXColumnIndex(t *testing.T) {
+	testCases := map[string]int{
+		"A1":   0,
+		"Z9":   25,
+		"AA10": 26,
+		"AB12": 27,
+	}
+
+	for ref, want := range testCases {
+		got, err := xlsxColumnIndex(ref)
+		require.NoError(t, err)
+		require.Equal(t, want, got, ref)
+	}
+
+	_, err := xlsxColumnIndex("12")
+	require.Error(t, err)
+}
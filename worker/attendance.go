@@ -0,0 +1,38 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+
+	db "github.com/ot07/coworker-backend/db/sqlc"
+)
+
+// lastAttendanceCutoff returns the latest cutoff not after now, cutoffs coming every day at cutoff after midnight UTC.
+func lastAttendanceCutoff(now time.Time, cutoff time.Duration) time.Time {
+	now = now.UTC()
+	last := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC).Add(cutoff)
+	if last.After(now) {
+		last = last.AddDate(0, 0, -1)
+	}
+	return last
+}
+
+// NewAutoCheckOutAttendancesJob creates a job checking out the members who did not check out before the daily cutoff,
+// which comes at cutoff after midnight UTC. They are checked out at the first cutoff after they checked in.
+func NewAutoCheckOutAttendancesJob(store db.Store, cutoff time.Duration, interval time.Duration) Job {
+	return Job{
+		Name:     "auto_check_out_attendances",
+		Interval: interval,
+		Run: func(ctx context.Context) error {
+			checkedOut, err := store.AutoCheckOutAttendances(ctx, lastAttendanceCutoff(time.Now(), cutoff))
+			if err != nil {
+				return err
+			}
+			if checkedOut > 0 {
+				log.Printf("checked out %d members automatically", checkedOut)
+			}
+			return nil
+		},
+	}
+}
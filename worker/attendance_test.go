@@ -0,0 +1,64 @@
+package worker
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	mockdb "github.com/ot07/coworker-backend/db/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLastAttendanceCutoff(t *testing.T) {
+	t.Parallel()
+
+	cutoff := 23 * time.Hour
+
+	now := time.Date(2023, 5, 2, 23, 30, 0, 0, time.UTC)
+	require.Equal(t, time.Date(2023, 5, 2, 23, 0, 0, 0, time.UTC), lastAttendanceCutoff(now, cutoff))
+
+	now = time.Date(2023, 5, 2, 9, 0, 0, 0, time.UTC)
+	require.Equal(t, time.Date(2023, 5, 1, 23, 0, 0, 0, time.UTC), lastAttendanceCutoff(now, cutoff))
+
+	// The cutoff of the day is reached at the very time it comes.
+	now = time.Date(2023, 5, 2, 23, 0, 0, 0, time.FixedZone("JST", 9*60*60)).Add(9 * time.Hour)
+	require.Equal(t, time.Date(2023, 5, 2, 23, 0, 0, 0, time.UTC), lastAttendanceCutoff(now, cutoff))
+}
+
+func TestAutoCheckOutAttendancesJob(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().
+		AutoCheckOutAttendances(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(ctx context.Context, cutoffAt time.Time) (int64, error) {
+			require.Equal(t, lastAttendanceCutoff(time.Now(), 20*time.Hour), cutoffAt)
+			return 3, nil
+		})
+
+	job := NewAutoCheckOutAttendancesJob(store, 20*time.Hour, 15*time.Minute)
+	require.Equal(t, 15*time.Minute, job.Interval)
+	require.NoError(t, job.Run(context.Background()))
+}
+
+func TestAutoCheckOutAttendancesJobError(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().
+		AutoCheckOutAttendances(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(int64(0), sql.ErrConnDone)
+
+	job := NewAutoCheckOutAttendancesJob(store, 20*time.Hour, 15*time.Minute)
+	require.ErrorIs(t, job.Run(context.Background()), sql.ErrConnDone)
+}
@@ -0,0 +1,27 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+
+	db "github.com/ot07/coworker-backend/db/sqlc"
+)
+
+// NewMarkOverdueChecklistTasksJob creates a job flagging the checklist tasks still open after their due date.
+func NewMarkOverdueChecklistTasksJob(store db.Store, interval time.Duration) Job {
+	return Job{
+		Name:     "mark_overdue_checklist_tasks",
+		Interval: interval,
+		Run: func(ctx context.Context) error {
+			marked, err := store.MarkOverdueChecklistTasks(ctx, time.Now())
+			if err != nil {
+				return err
+			}
+			if marked > 0 {
+				log.Printf("marked %d checklist tasks overdue", marked)
+			}
+			return nil
+		},
+	}
+}
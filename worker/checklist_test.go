@@ -0,0 +1,48 @@
+package worker
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	mockdb "github.com/ot07/coworker-backend/db/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarkOverdueChecklistTasksJob(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().
+		MarkOverdueChecklistTasks(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(ctx context.Context, today time.Time) (int64, error) {
+			require.WithinDuration(t, time.Now(), today, time.Second)
+			return 2, nil
+		})
+
+	job := NewMarkOverdueChecklistTasksJob(store, time.Hour)
+	require.Equal(t, time.Hour, job.Interval)
+	require.NoError(t, job.Run(context.Background()))
+}
+
+func TestMarkOverdueChecklistTasksJobError(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().
+		MarkOverdueChecklistTasks(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(int64(0), sql.ErrConnDone)
+
+	job := NewMarkOverdueChecklistTasksJob(store, time.Hour)
+	require.ErrorIs(t, job.Run(context.Background()), sql.ErrConnDone)
+}
@@ -0,0 +1,34 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+
+	db "github.com/ot07/coworker-backend/db/sqlc"
+	"github.com/ot07/coworker-backend/storage"
+)
+
+// NewPurgeDeletedMembersJob creates a job permanently deleting the members
+// that have been in the trash for longer than retention, along with the files of their avatars.
+func NewPurgeDeletedMembersJob(store db.Store, fileStorage storage.Storage, retention time.Duration, interval time.Duration) Job {
+	return Job{
+		Name:     "purge_deleted_members",
+		Interval: interval,
+		Run: func(ctx context.Context) error {
+			avatarKeys, err := store.PurgeDeletedMembers(ctx, time.Now().Add(-retention))
+			if err != nil {
+				return err
+			}
+			for _, key := range avatarKeys {
+				if key.Valid {
+					storage.DeleteAvatarThumbnails(ctx, fileStorage, key.String, len(storage.AvatarThumbnails))
+				}
+			}
+			if len(avatarKeys) > 0 {
+				log.Printf("purged %d deleted members", len(avatarKeys))
+			}
+			return nil
+		},
+	}
+}
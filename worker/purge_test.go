@@ -0,0 +1,74 @@
+package worker
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	mockdb "github.com/ot07/coworker-backend/db/mock"
+	"github.com/ot07/coworker-backend/storage"
+	"github.com/ot07/coworker-backend/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPurgeDeletedMembersJob(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	retention := 30 * 24 * time.Hour
+
+	dir := t.TempDir()
+	fileStorage := storage.NewLocalStorage(dir, "http://localhost:8080/uploads/")
+
+	avatarKey := "avatars/" + util.RandomUUID().String() + "/" + util.RandomUUID().String()
+	keptKey := "avatars/" + util.RandomUUID().String() + "/" + util.RandomUUID().String()
+	for _, key := range []string{avatarKey, keptKey} {
+		for _, thumbnail := range storage.AvatarThumbnails {
+			err := fileStorage.Put(context.Background(), storage.AvatarThumbnailKey(key, thumbnail.Name), "image/jpeg", []byte("jpeg"))
+			require.NoError(t, err)
+		}
+	}
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().
+		PurgeDeletedMembers(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(ctx context.Context, deletedBefore time.Time) ([]sql.NullString, error) {
+			require.WithinDuration(t, time.Now().Add(-retention), deletedBefore, time.Second)
+			return []sql.NullString{{String: avatarKey, Valid: true}, {}, {}}, nil
+		})
+
+	job := NewPurgeDeletedMembersJob(store, fileStorage, retention, time.Hour)
+	require.Equal(t, time.Hour, job.Interval)
+	require.NoError(t, job.Run(context.Background()))
+
+	// The avatar of a purged member is removed, while those of the other members are kept.
+	for _, thumbnail := range storage.AvatarThumbnails {
+		_, err := os.Stat(filepath.Join(dir, storage.AvatarThumbnailKey(avatarKey, thumbnail.Name)))
+		require.True(t, os.IsNotExist(err))
+		_, err = os.Stat(filepath.Join(dir, storage.AvatarThumbnailKey(keptKey, thumbnail.Name)))
+		require.NoError(t, err)
+	}
+}
+
+func TestPurgeDeletedMembersJobError(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().
+		PurgeDeletedMembers(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(nil, sql.ErrConnDone)
+
+	job := NewPurgeDeletedMembersJob(store, storage.NewLocalStorage(t.TempDir(), ""), time.Hour, time.Hour)
+	require.ErrorIs(t, job.Run(context.Background()), sql.ErrConnDone)
+}
@@ -0,0 +1,60 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+)
+
+// Job is a task run periodically in the background.
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      func(ctx context.Context) error
+}
+
+// Scheduler runs jobs periodically until its context is cancelled.
+type Scheduler struct {
+	jobs []Job
+	wg   sync.WaitGroup
+}
+
+// NewScheduler creates a new Scheduler running the given jobs.
+func NewScheduler(jobs ...Job) *Scheduler {
+	return &Scheduler{jobs: jobs}
+}
+
+// Start runs every job once right away and then at its interval, each in its own goroutine.
+// It returns immediately; the jobs stop when ctx is cancelled.
+func (scheduler *Scheduler) Start(ctx context.Context) {
+	for _, job := range scheduler.jobs {
+		scheduler.wg.Add(1)
+		go func(job Job) {
+			defer scheduler.wg.Done()
+			scheduler.run(ctx, job)
+		}(job)
+	}
+}
+
+// Wait blocks until every job has stopped after the context passed to Start is cancelled.
+func (scheduler *Scheduler) Wait() {
+	scheduler.wg.Wait()
+}
+
+func (scheduler *Scheduler) run(ctx context.Context, job Job) {
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := job.Run(ctx); err != nil {
+			log.Printf("job %s failed: %v", job.Name, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchedulerRunsJobsPeriodically(t *testing.T) {
+	t.Parallel()
+
+	var runs int32
+	job := Job{
+		Name:     "test",
+		Interval: 10 * time.Millisecond,
+		Run: func(ctx context.Context) error {
+			atomic.AddInt32(&runs, 1)
+			return nil
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	scheduler := NewScheduler(job)
+	scheduler.Start(ctx)
+
+	require.Eventually(t, func() bool {
+		return atomic.LoadInt32(&runs) >= 3
+	}, time.Second, 5*time.Millisecond)
+
+	cancel()
+	scheduler.Wait()
+
+	stopped := atomic.LoadInt32(&runs)
+	time.Sleep(30 * time.Millisecond)
+	require.Equal(t, stopped, atomic.LoadInt32(&runs))
+}
+
+func TestSchedulerKeepsRunningAfterError(t *testing.T) {
+	t.Parallel()
+
+	var runs int32
+	job := Job{
+		Name:     "failing",
+		Interval: 10 * time.Millisecond,
+		Run: func(ctx context.Context) error {
+			atomic.AddInt32(&runs, 1)
+			return errors.New("failed")
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	scheduler := NewScheduler(job)
+	scheduler.Start(ctx)
+
+	require.Eventually(t, func() bool {
+		return atomic.LoadInt32(&runs) >= 2
+	}, time.Second, 5*time.Millisecond)
+}